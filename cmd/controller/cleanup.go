@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/dig"
+
+	"github.com/gemyago/oke-gateway-api/internal/app"
+	"github.com/gemyago/oke-gateway-api/internal/services/k8sapi"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+)
+
+type cleanupParams struct {
+	dig.In
+
+	CleanupModel *app.LoadBalancerCleanupModel
+}
+
+func writeOrphans(out io.Writer, orphans *app.LoadBalancerOrphans) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "GatewayConfig:\t%s\n", orphans.GatewayConfig)
+	fmt.Fprintf(w, "Load Balancer:\t%s\n", orphans.LoadBalancerID)
+	if len(orphans.Resources) == 0 {
+		fmt.Fprintf(w, "\nNo orphaned resources found\n")
+		return w.Flush()
+	}
+	fmt.Fprintf(w, "\nORPHANED RESOURCES\nKIND\tNAME\n")
+	for _, resource := range orphans.Resources {
+		fmt.Fprintf(w, "%s\t%s\n", resource.Kind, resource.Name)
+	}
+	return w.Flush()
+}
+
+func newCleanupCmd(container *dig.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cleanup <namespace>/<gateway-config>",
+		Short: "List OCI resources of the GatewayConfig load balancer not owned by any K8s resource",
+		Long: "Finds OCI routing policies, rule sets, backend sets and certificates named after the " +
+			"controller naming scheme that are neither owned by K8s resources nor used by other OCI " +
+			"resources, and lists them. Use --delete to delete them.",
+		Args: cobra.ExactArgs(1),
+	}
+	deleteOrphans := false
+	cmd.Flags().BoolVar(
+		&deleteOrphans,
+		"delete",
+		false,
+		"Delete listed orphaned resources",
+	)
+	cmd.PreRunE = func(_ *cobra.Command, _ []string) error {
+		return errors.Join(
+			k8sapi.Register(container),
+			ociapi.Register(container),
+		)
+	}
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		configKey, err := parseGatewayConfigKey(args[0])
+		if err != nil {
+			return err
+		}
+		return container.Invoke(func(params cleanupParams) error {
+			ctx := context.Background()
+			orphans, findErr := params.CleanupModel.FindOrphans(ctx, configKey)
+			if findErr != nil {
+				return findErr
+			}
+			if err = writeOrphans(cmd.OutOrStdout(), orphans); err != nil {
+				return err
+			}
+			if !deleteOrphans || len(orphans.Resources) == 0 {
+				return nil
+			}
+			if err = params.CleanupModel.DeleteOrphans(ctx, orphans); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "\nDeleted %d orphaned resources\n", len(orphans.Resources))
+			return nil
+		})
+	}
+	return cmd
+}
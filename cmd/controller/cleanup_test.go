@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gemyago/oke-gateway-api/internal/app"
+)
+
+func TestCleanup(t *testing.T) {
+	t.Run("writes orphaned resources", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, writeOrphans(&out, &app.LoadBalancerOrphans{
+			GatewayConfig:  "oke-gw/edge-config",
+			LoadBalancerID: "ocid1.loadbalancer.oc1..edge",
+			Resources: []app.OrphanedResource{
+				{Kind: app.OrphanedRoutingPolicy, Name: "removed_policy"},
+				{Kind: app.OrphanedBackendSet, Name: "apps-removed-80"},
+			},
+		}))
+
+		text := out.String()
+		assert.Contains(t, text, "ocid1.loadbalancer.oc1..edge")
+		assert.Regexp(t, `RoutingPolicy\s+removed_policy`, text)
+		assert.Regexp(t, `BackendSet\s+apps-removed-80`, text)
+	})
+
+	t.Run("writes no orphaned resources", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, writeOrphans(&out, &app.LoadBalancerOrphans{
+			GatewayConfig:  "oke-gw/edge-config",
+			LoadBalancerID: "ocid1.loadbalancer.oc1..edge",
+		}))
+		assert.Contains(t, out.String(), "No orphaned resources found")
+	})
+
+	t.Run("rejects invalid GatewayConfig argument", func(t *testing.T) {
+		rootCmd := setupCommands()
+		rootCmd.SilenceErrors = true
+		rootCmd.SilenceUsage = true
+		rootCmd.SetArgs([]string{"cleanup", "edge-config", "--logs-file", "../../test.log"})
+		assert.ErrorContains(t, rootCmd.Execute(), "expected <namespace>/<name>")
+	})
+}
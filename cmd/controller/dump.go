@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/dig"
+	apitypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/gemyago/oke-gateway-api/internal/app"
+	"github.com/gemyago/oke-gateway-api/internal/services/k8sapi"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+)
+
+const (
+	dumpOutputText = "text"
+	dumpOutputJSON = "json"
+)
+
+type dumpStateParams struct {
+	dig.In
+
+	StateDumpModel *app.StateDumpModel
+}
+
+func parseGatewayConfigKey(value string) (apitypes.NamespacedName, error) {
+	namespace, name, found := strings.Cut(value, "/")
+	if !found || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return apitypes.NamespacedName{}, fmt.Errorf("expected <namespace>/<name> of GatewayConfig, got %q", value)
+	}
+	return apitypes.NamespacedName{Namespace: namespace, Name: name}, nil
+}
+
+func writeStateDump(out io.Writer, dump *app.StateDump, output string) error {
+	switch output {
+	case dumpOutputJSON:
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(dump)
+	case dumpOutputText:
+		return writeStateDumpText(out, dump)
+	default:
+		return fmt.Errorf("unsupported output %q, expected %s or %s", output, dumpOutputText, dumpOutputJSON)
+	}
+}
+
+func dumpOwners(owners []string) string {
+	if len(owners) == 0 {
+		return "-"
+	}
+	return strings.Join(owners, ", ")
+}
+
+func dumpList(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ",")
+}
+
+func dumpValue(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func writeStateDumpText(out io.Writer, dump *app.StateDump) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "GatewayConfig:\t%s\n", dump.GatewayConfig)
+	fmt.Fprintf(w, "Load Balancer:\t%s\n", dump.LoadBalancerID)
+	fmt.Fprintf(w, "Gateways:\t%s\n", dumpList(dump.Gateways))
+
+	fmt.Fprintf(w, "\nLISTENERS\n")
+	fmt.Fprintf(w, "NAME\tPROTOCOL\tPORT\tDEFAULT BACKEND SET\tROUTING POLICY\tCERTIFICATES\tRULE SETS\tOWNERS\n")
+	for _, listener := range dump.Listeners {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			listener.Name,
+			listener.Protocol,
+			strconv.Itoa(listener.Port),
+			dumpValue(listener.DefaultBackendSet),
+			dumpValue(listener.RoutingPolicy),
+			dumpList(listener.Certificates),
+			dumpList(listener.RuleSets),
+			dumpOwners(listener.Owners),
+		)
+	}
+
+	fmt.Fprintf(w, "\nROUTING POLICIES\nPOLICY\tRULE\tBACKEND SETS\tCONDITION\tOWNERS\n")
+	for _, policy := range dump.RoutingPolicies {
+		fmt.Fprintf(w, "%s\t\t\t\t%s\n", policy.Name, dumpOwners(policy.Owners))
+		for _, rule := range policy.Rules {
+			fmt.Fprintf(w, "\t%s\t%s\t%s\t%s\n",
+				rule.Name,
+				dumpList(rule.BackendSets),
+				rule.Condition,
+				dumpOwners(rule.Owners),
+			)
+		}
+	}
+
+	fmt.Fprintf(w, "\nBACKEND SETS\nNAME\tPOLICY\tHEALTH\tBACKENDS\tOWNERS\n")
+	for _, backendSet := range dump.BackendSets {
+		backends := make([]string, len(backendSet.Backends))
+		for i, backend := range backendSet.Backends {
+			backends[i] = backend.Name
+			if backend.Drain {
+				backends[i] += "(drain)"
+			}
+			if backend.Offline {
+				backends[i] += "(offline)"
+			}
+			if backend.Backup {
+				backends[i] += "(backup)"
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			backendSet.Name,
+			dumpValue(backendSet.Policy),
+			backendSet.Health,
+			dumpList(backends),
+			dumpOwners(backendSet.Owners),
+		)
+	}
+
+	fmt.Fprintf(w, "\nCERTIFICATES\nNAME\tOWNERS\n")
+	for _, certificate := range dump.Certificates {
+		fmt.Fprintf(w, "%s\t%s\n", certificate.Name, dumpOwners(certificate.Owners))
+	}
+	return w.Flush()
+}
+
+func newDumpStateCmd(container *dig.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <namespace>/<gateway-config>",
+		Short: "Print OCI resources programmed for the GatewayConfig and K8s resources owning them",
+		Args:  cobra.ExactArgs(1),
+	}
+	output := dumpOutputText
+	cmd.Flags().StringVarP(
+		&output,
+		"output",
+		"o",
+		dumpOutputText,
+		"Output format: text or json",
+	)
+	cmd.PreRunE = func(_ *cobra.Command, _ []string) error {
+		return errors.Join(
+			k8sapi.Register(container),
+			ociapi.Register(container),
+		)
+	}
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		configKey, err := parseGatewayConfigKey(args[0])
+		if err != nil {
+			return err
+		}
+		return container.Invoke(func(params dumpStateParams) error {
+			dump, dumpErr := params.StateDumpModel.Dump(context.Background(), configKey)
+			if dumpErr != nil {
+				return dumpErr
+			}
+			return writeStateDump(cmd.OutOrStdout(), dump, output)
+		})
+	}
+	return cmd
+}
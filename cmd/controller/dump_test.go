@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apitypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/gemyago/oke-gateway-api/internal/app"
+)
+
+func TestDumpState(t *testing.T) {
+	t.Run("parseGatewayConfigKey", func(t *testing.T) {
+		key, err := parseGatewayConfigKey("oke-gw/edge-config")
+		require.NoError(t, err)
+		assert.Equal(t, apitypes.NamespacedName{Namespace: "oke-gw", Name: "edge-config"}, key)
+
+		for _, value := range []string{"edge-config", "/edge-config", "oke-gw/", "oke-gw/edge/config"} {
+			_, err = parseGatewayConfigKey(value)
+			require.Error(t, err, value)
+		}
+	})
+
+	dump := &app.StateDump{
+		GatewayConfig:  "oke-gw/edge-config",
+		LoadBalancerID: "ocid1.loadbalancer.oc1..edge",
+		Gateways:       []string{"oke-gw/edge"},
+		Listeners: []app.StateDumpListener{
+			{
+				Name:              "http",
+				Protocol:          "HTTP",
+				Port:              80,
+				DefaultBackendSet: "edge-default",
+				RoutingPolicy:     "http_policy",
+				Owners:            []string{"Gateway oke-gw/edge listener http"},
+			},
+		},
+		RoutingPolicies: []app.StateDumpRoutingPolicy{
+			{
+				Name: "http_policy",
+				Rules: []app.StateDumpRoutingRule{
+					{Name: "p0000_web", Condition: "any(http.request.url.path sw '/')", BackendSets: []string{"apps-web-80"}},
+				},
+			},
+		},
+		BackendSets: []app.StateDumpBackendSet{
+			{
+				Name:     "apps-web-80",
+				Health:   "OK",
+				Backends: []app.StateDumpBackend{{Name: "10.0.0.1:8080", Drain: true}},
+				Owners:   []string{"HTTPRoute apps/web"},
+			},
+		},
+		Certificates: []app.StateDumpCertificate{{Name: "manual-cert"}},
+	}
+
+	t.Run("writes text output", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, writeStateDump(&out, dump, dumpOutputText))
+
+		text := out.String()
+		assert.Contains(t, text, "ocid1.loadbalancer.oc1..edge")
+		assert.Regexp(t, `http\s+HTTP\s+80\s+edge-default\s+http_policy\s+-\s+-\s+Gateway oke-gw/edge listener http`, text)
+		assert.Regexp(t, `p0000_web\s+apps-web-80\s+any\(http.request.url.path sw '/'\)\s+-`, text)
+		assert.Regexp(t, `apps-web-80\s+-\s+OK\s+10.0.0.1:8080\(drain\)\s+HTTPRoute apps/web`, text)
+		assert.Regexp(t, `manual-cert\s+-`, text)
+	})
+
+	t.Run("writes json output", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, writeStateDump(&out, dump, dumpOutputJSON))
+
+		var got app.StateDump
+		require.NoError(t, json.Unmarshal(out.Bytes(), &got))
+		assert.Equal(t, *dump, got)
+	})
+
+	t.Run("rejects unsupported output", func(t *testing.T) {
+		require.ErrorContains(t, writeStateDump(&bytes.Buffer{}, dump, "yaml"), "unsupported output")
+	})
+
+	t.Run("rejects invalid GatewayConfig argument", func(t *testing.T) {
+		rootCmd := setupCommands()
+		rootCmd.SilenceErrors = true
+		rootCmd.SilenceUsage = true
+		rootCmd.SetArgs([]string{"dump", "edge-config", "--logs-file", "../../test.log"})
+		assert.ErrorContains(t, rootCmd.Execute(), "expected <namespace>/<name>")
+	})
+}
@@ -0,0 +1,7 @@
+package main
+
+// HTTPRoute ExtensionRef filter extensions are compiled into the controller
+// by adding blank imports of the extension packages below. Each package is
+// expected to call httpfilter.Register from its init function.
+//
+// import _ "example.com/acme/oke-gateway-filters/canary"
@@ -12,6 +12,10 @@ func setupCommands() *cobra.Command {
 	rootCmd := newRootCmd(container)
 	rootCmd.AddCommand(
 		newStartServerCmd(container),
+		newDumpStateCmd(container),
+		newCleanupCmd(container),
+		newSnapshotCmd(container),
+		newRestoreCmd(container),
 	)
 	return rootCmd
 }
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/jaswdr/faker/v2"
@@ -32,5 +34,18 @@ func TestMain(t *testing.T) {
 			gotErr := rootCmd.Execute()
 			assert.ErrorContains(t, gotErr, "failed to read config")
 		})
+		t.Run("should fail if config file is invalid", func(t *testing.T) {
+			configFile := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(configFile, []byte(
+				"version: v1\ncontroller:\n  maxConcurrentReconciles: 0\n",
+			), 0o600))
+
+			rootCmd := setupCommands()
+			rootCmd.SilenceErrors = true
+			rootCmd.SilenceUsage = true
+			rootCmd.SetArgs([]string{"start", "--noop", "--config-file", configFile, "--logs-file", "../../test.log"})
+			gotErr := rootCmd.Execute()
+			assert.ErrorContains(t, gotErr, "invalid config")
+		})
 	})
 }
@@ -42,14 +42,26 @@ func newRootCmd(container *dig.Container) *cobra.Command {
 		"",
 		"Env that the process is running in.",
 	)
+	cmd.PersistentFlags().String(
+		"config-file",
+		"",
+		"Path to the YAML controller config file. Values in the file override env specific defaults.",
+	)
 	cfg := config.New()
 	lo.Must0(cfg.BindPFlags(cmd.PersistentFlags()))
 	cmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
-		err := config.Load(cfg, config.NewLoadOpts().WithEnv(cfg.GetString("env")))
+		err := config.Load(cfg, config.NewLoadOpts().
+			WithEnv(cfg.GetString("env")).
+			WithConfigFile(cfg.GetString("config-file")),
+		)
 		if err != nil {
 			return err
 		}
 
+		if err = config.Validate(cfg); err != nil {
+			return fmt.Errorf("invalid config: %w", err)
+		}
+
 		var logLevel slog.Level
 		if err = logLevel.UnmarshalText([]byte(cfg.GetString("defaultLogLevel"))); err != nil {
 			return err
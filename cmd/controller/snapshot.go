@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"go.uber.org/dig"
+
+	"github.com/gemyago/oke-gateway-api/internal/app"
+	"github.com/gemyago/oke-gateway-api/internal/services/k8sapi"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+)
+
+type snapshotParams struct {
+	dig.In
+
+	SnapshotModel *app.LoadBalancerSnapshotModel
+}
+
+func writeSnapshot(out io.Writer, snapshot *app.LoadBalancerSnapshot) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(snapshot)
+}
+
+func writeRestore(out io.Writer, restore *app.LoadBalancerRestore) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "Load Balancer:\t%s\n", restore.LoadBalancerID)
+	fmt.Fprintf(w, "\nRESOURCES\nKIND\tNAME\tRESULT\tREASON\n")
+	for _, resource := range restore.Resources {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			resource.Kind,
+			resource.Name,
+			resource.Result,
+			dumpValue(resource.Reason),
+		)
+	}
+	return w.Flush()
+}
+
+func newSnapshotCmd(container *dig.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot <namespace>/<gateway-config>",
+		Short: "Print the OCI Load Balancer configuration of the GatewayConfig as a portable JSON document",
+		Long: "Captures listeners, routing policies, rule sets and backend sets of the GatewayConfig " +
+			"load balancer. Use --save to also store the snapshot as the OkeLoadBalancerSnapshot resource " +
+			"named after the GatewayConfig. The snapshot can be restored on another load balancer " +
+			"with the restore command.",
+		Args: cobra.ExactArgs(1),
+	}
+	save := false
+	cmd.Flags().BoolVar(
+		&save,
+		"save",
+		false,
+		"Store the snapshot as the OkeLoadBalancerSnapshot resource in the GatewayConfig namespace",
+	)
+	cmd.PreRunE = func(_ *cobra.Command, _ []string) error {
+		return errors.Join(
+			k8sapi.Register(container),
+			ociapi.Register(container),
+		)
+	}
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		configKey, err := parseGatewayConfigKey(args[0])
+		if err != nil {
+			return err
+		}
+		return container.Invoke(func(params snapshotParams) error {
+			ctx := context.Background()
+			snapshot, captureErr := params.SnapshotModel.Capture(ctx, configKey)
+			if captureErr != nil {
+				return captureErr
+			}
+			if save {
+				if err = params.SnapshotModel.Save(ctx, snapshot); err != nil {
+					return err
+				}
+			}
+			return writeSnapshot(cmd.OutOrStdout(), snapshot)
+		})
+	}
+	return cmd
+}
+
+func newRestoreCmd(container *dig.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Create resources of a load balancer snapshot missing on the given OCI Load Balancer",
+		Long: "Creates backend sets, rule sets, routing policies and listeners of the snapshot on the " +
+			"load balancer. Existing resources are not changed. Certificates are not restored, resources " +
+			"referencing certificates missing on the load balancer are skipped.",
+		Args: cobra.NoArgs,
+	}
+	loadBalancerID := ""
+	region := ""
+	snapshotFile := ""
+	snapshotResource := ""
+	skipBackends := false
+	cmd.Flags().StringVar(
+		&loadBalancerID,
+		"load-balancer-id",
+		"",
+		"OCID of the load balancer to restore the snapshot on",
+	)
+	cmd.Flags().StringVar(
+		&region,
+		"region",
+		"",
+		"OCI region of the load balancer, defaults to the region of the OCI config",
+	)
+	cmd.Flags().StringVarP(
+		&snapshotFile,
+		"file",
+		"f",
+		"",
+		"Path to the snapshot document printed by the snapshot command",
+	)
+	cmd.Flags().StringVar(
+		&snapshotResource,
+		"from-snapshot",
+		"",
+		"<namespace>/<name> of the OkeLoadBalancerSnapshot resource to restore",
+	)
+	cmd.Flags().BoolVar(
+		&skipBackends,
+		"skip-backends",
+		false,
+		"Create backend sets without backends, they are synced by the controller",
+	)
+	cmd.MarkFlagsOneRequired("file", "from-snapshot")
+	cmd.MarkFlagsMutuallyExclusive("file", "from-snapshot")
+	lo.Must0(cmd.MarkFlagRequired("load-balancer-id"))
+	cmd.PreRunE = func(_ *cobra.Command, _ []string) error {
+		return errors.Join(
+			k8sapi.Register(container),
+			ociapi.Register(container),
+		)
+	}
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		return container.Invoke(func(params snapshotParams) error {
+			ctx := context.Background()
+			var snapshot *app.LoadBalancerSnapshot
+			if snapshotFile != "" {
+				data, err := os.ReadFile(snapshotFile)
+				if err != nil {
+					return fmt.Errorf("failed to read snapshot file: %w", err)
+				}
+				if snapshot, err = app.ParseLoadBalancerSnapshot(data); err != nil {
+					return err
+				}
+			} else {
+				key, err := parseGatewayConfigKey(snapshotResource)
+				if err != nil {
+					return err
+				}
+				if snapshot, err = params.SnapshotModel.Load(ctx, key); err != nil {
+					return err
+				}
+			}
+			restore, restoreErr := params.SnapshotModel.Restore(ctx, snapshot, app.RestoreSnapshotParams{
+				LoadBalancerID: loadBalancerID,
+				Region:         region,
+				SkipBackends:   skipBackends,
+			})
+			if restore != nil {
+				if err := writeRestore(cmd.OutOrStdout(), restore); err != nil {
+					return errors.Join(restoreErr, err)
+				}
+			}
+			return restoreErr
+		})
+	}
+	return cmd
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gemyago/oke-gateway-api/internal/app"
+)
+
+func TestSnapshot(t *testing.T) {
+	t.Run("writes restored resources", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, writeRestore(&out, &app.LoadBalancerRestore{
+			LoadBalancerID: "ocid1.loadbalancer.oc1..standby",
+			Resources: []app.RestoredResource{
+				{Kind: app.SnapshotBackendSet, Name: "apps-web-80", Result: app.SnapshotRestoreCreated},
+				{
+					Kind:   app.SnapshotListener,
+					Name:   "https",
+					Result: app.SnapshotRestoreSkipped,
+					Reason: "Certificate edge-rev-1 not found",
+				},
+			},
+		}))
+
+		text := out.String()
+		assert.Contains(t, text, "ocid1.loadbalancer.oc1..standby")
+		assert.Regexp(t, `BackendSet\s+apps-web-80\s+Created\s+-`, text)
+		assert.Regexp(t, `Listener\s+https\s+Skipped\s+Certificate edge-rev-1 not found`, text)
+	})
+
+	t.Run("rejects invalid GatewayConfig argument", func(t *testing.T) {
+		rootCmd := setupCommands()
+		rootCmd.SilenceErrors = true
+		rootCmd.SilenceUsage = true
+		rootCmd.SetArgs([]string{"snapshot", "edge-config", "--logs-file", "../../test.log"})
+		assert.ErrorContains(t, rootCmd.Execute(), "expected <namespace>/<name>")
+	})
+
+	t.Run("requires snapshot source", func(t *testing.T) {
+		rootCmd := setupCommands()
+		rootCmd.SilenceErrors = true
+		rootCmd.SilenceUsage = true
+		rootCmd.SetArgs([]string{
+			"restore", "--load-balancer-id", "ocid1.loadbalancer.oc1..standby", "--logs-file", "../../test.log",
+		})
+		assert.ErrorContains(t, rootCmd.Execute(), "at least one of the flags in the group [file from-snapshot]")
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/dig"
 	"golang.org/x/sys/unix"
 
@@ -27,6 +28,9 @@ type startServerParams struct {
 
 	ManagerDeps k8s.StartManagerDeps
 
+	// Installs the global tracer provider, nil if tracing is disabled
+	TracerProvider *sdktrace.TracerProvider
+
 	noop bool
 }
 
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+// accessPolicyTargetsGateway checks if any of the policy targetRefs points to the gateway.
+func accessPolicyTargetsGateway(policy types.OkeAccessPolicy, gateway *gatewayv1.Gateway) bool {
+	if policy.Namespace != gateway.Namespace {
+		return false
+	}
+	return slices.ContainsFunc(policy.Spec.TargetRefs, func(ref gatewayv1.LocalPolicyTargetReferenceWithSectionName) bool {
+		return ref.Group == gatewayv1.GroupName && ref.Kind == "Gateway" && string(ref.Name) == gateway.Name
+	})
+}
+
+// accessPolicyTargetsListener checks if the policy applies to the listener of the gateway.
+// Target without sectionName applies to all listeners of the gateway.
+func accessPolicyTargetsListener(
+	policy types.OkeAccessPolicy,
+	gateway *gatewayv1.Gateway,
+	listenerName gatewayv1.SectionName,
+) bool {
+	if policy.Namespace != gateway.Namespace {
+		return false
+	}
+	return slices.ContainsFunc(policy.Spec.TargetRefs, func(ref gatewayv1.LocalPolicyTargetReferenceWithSectionName) bool {
+		return ref.Group == gatewayv1.GroupName &&
+			ref.Kind == "Gateway" &&
+			string(ref.Name) == gateway.Name &&
+			(ref.SectionName == nil || *ref.SectionName == listenerName)
+	})
+}
+
+// listenerAllowedSourceCIDRs returns sorted unique CIDRs allowed by all policies targeting the listener.
+// Returns nil if no policy targets the listener, in which case traffic from any address is accepted.
+func listenerAllowedSourceCIDRs(
+	policies []types.OkeAccessPolicy,
+	gateway *gatewayv1.Gateway,
+	listenerName gatewayv1.SectionName,
+) []string {
+	var cidrs []string
+	for _, policy := range policies {
+		if !accessPolicyTargetsListener(policy, gateway, listenerName) {
+			continue
+		}
+		for _, cidr := range policy.Spec.AllowedSourceCIDRs {
+			cidrs = append(cidrs, strings.TrimSpace(cidr))
+		}
+	}
+	slices.Sort(cidrs)
+	return slices.Compact(cidrs)
+}
+
+// accessPolicyRuleSetItems maps allowed CIDRs to OCI allow rules. OCI rejects requests
+// that do not match any of the allow rules of the listener.
+func accessPolicyRuleSetItems(cidrs []string) []loadbalancer.Rule {
+	if len(cidrs) == 0 {
+		return nil
+	}
+	items := make([]loadbalancer.Rule, len(cidrs))
+	for i, cidr := range cidrs {
+		items[i] = loadbalancer.AllowRule{
+			Conditions: []loadbalancer.RuleCondition{
+				loadbalancer.SourceIpAddressCondition{AttributeValue: new(cidr)},
+			},
+		}
+	}
+	return items
+}
+
+// ociListenerAccessRuleSetName returns the name of the OCI rule set holding access rules of the listener.
+// Access rules are listener specific so the name is derived from the OCI listener name.
+func ociListenerAccessRuleSetName(namePrefix string, listenerName gatewayv1.SectionName) string {
+	return ociapi.ConstructOCIResourceName(
+		"ac_"+ociGatewayListenerName(namePrefix, listenerName),
+		ociapi.OCIResourceNameConfig{
+			MaxLength:           maxListenerPolicyNameLength,
+			InvalidCharsPattern: invalidCharsForPolicyNamePattern,
+		},
+	)
+}
+
+// accessPoliciesAnnotationValue returns the value of the GatewayAccessPoliciesAnnotation.
+// It lists names and generations of the policies so any policy change triggers reprogramming.
+func accessPoliciesAnnotationValue(policies []types.OkeAccessPolicy) string {
+	values := make([]string, len(policies))
+	for i, policy := range policies {
+		values[i] = policy.Name + "/" + strconv.FormatInt(policy.Generation, 10)
+	}
+	slices.Sort(values)
+	return strings.Join(values, ",")
+}
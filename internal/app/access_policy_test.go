@@ -0,0 +1,117 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func makeAccessPolicyForGateway(
+	gateway *gatewayv1.Gateway,
+	sectionName *gatewayv1.SectionName,
+	cidrs ...string,
+) types.OkeAccessPolicy {
+	fake := faker.New()
+	return types.OkeAccessPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: gateway.Namespace,
+			Name:      fake.Internet().Domain(),
+		},
+		Spec: types.OkeAccessPolicySpec{
+			TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+				{
+					LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+						Group: gatewayv1.GroupName,
+						Kind:  "Gateway",
+						Name:  gatewayv1.ObjectName(gateway.Name),
+					},
+					SectionName: sectionName,
+				},
+			},
+			AllowedSourceCIDRs: cidrs,
+		},
+	}
+}
+
+func TestAccessPolicyTargets(t *testing.T) {
+	t.Run("gateway", func(t *testing.T) {
+		gateway := newRandomGateway()
+		policy := makeAccessPolicyForGateway(gateway, nil)
+		assert.True(t, accessPolicyTargetsGateway(policy, gateway))
+
+		otherNamespace := policy
+		otherNamespace.Namespace = faker.New().Internet().Slug()
+		assert.False(t, accessPolicyTargetsGateway(otherNamespace, gateway))
+
+		otherKind := makeAccessPolicyForGateway(gateway, nil)
+		otherKind.Spec.TargetRefs[0].Kind = "HTTPRoute"
+		assert.False(t, accessPolicyTargetsGateway(otherKind, gateway))
+
+		assert.False(t, accessPolicyTargetsGateway(policy, newRandomGateway()))
+	})
+
+	t.Run("listener", func(t *testing.T) {
+		gateway := newRandomGateway()
+		adminListener := gatewayv1.SectionName("admin")
+
+		allListeners := makeAccessPolicyForGateway(gateway, nil)
+		assert.True(t, accessPolicyTargetsListener(allListeners, gateway, adminListener))
+		assert.True(t, accessPolicyTargetsListener(allListeners, gateway, "public"))
+
+		adminOnly := makeAccessPolicyForGateway(gateway, &adminListener)
+		assert.True(t, accessPolicyTargetsListener(adminOnly, gateway, adminListener))
+		assert.False(t, accessPolicyTargetsListener(adminOnly, gateway, "public"))
+	})
+}
+
+func TestListenerAllowedSourceCIDRs(t *testing.T) {
+	gateway := newRandomGateway()
+	adminListener := gatewayv1.SectionName("admin")
+	policies := []types.OkeAccessPolicy{
+		makeAccessPolicyForGateway(gateway, nil, "192.168.0.0/16"),
+		makeAccessPolicyForGateway(gateway, &adminListener, "10.0.0.0/8", " 192.168.0.0/16 "),
+	}
+
+	assert.Equal(t, []string{"10.0.0.0/8", "192.168.0.0/16"},
+		listenerAllowedSourceCIDRs(policies, gateway, adminListener))
+	assert.Equal(t, []string{"192.168.0.0/16"}, listenerAllowedSourceCIDRs(policies, gateway, "public"))
+	assert.Empty(t, listenerAllowedSourceCIDRs(policies, newRandomGateway(), adminListener))
+}
+
+func TestAccessPolicyRuleSetItems(t *testing.T) {
+	assert.Nil(t, accessPolicyRuleSetItems(nil))
+	assert.Equal(t, []loadbalancer.Rule{
+		loadbalancer.AllowRule{
+			Conditions: []loadbalancer.RuleCondition{
+				loadbalancer.SourceIpAddressCondition{AttributeValue: new("10.0.0.0/8")},
+			},
+		},
+		loadbalancer.AllowRule{
+			Conditions: []loadbalancer.RuleCondition{
+				loadbalancer.SourceIpAddressCondition{AttributeValue: new("192.168.0.0/16")},
+			},
+		},
+	}, accessPolicyRuleSetItems([]string{"10.0.0.0/8", "192.168.0.0/16"}))
+}
+
+func TestOCIListenerAccessRuleSetName(t *testing.T) {
+	assert.Equal(t, "ac_admin", ociListenerAccessRuleSetName("", "admin"))
+	assert.Equal(t, "ac_gw_0a1b2c3d_admin", ociListenerAccessRuleSetName("gw_0a1b2c3d_", "admin"))
+
+	name := ociListenerAccessRuleSetName("gw_0a1b2c3d_", "listener-"+gatewayv1.SectionName(faker.New().UUID().V4()))
+	assert.True(t, isValidOCIRoutingPolicyName(name), name)
+}
+
+func TestAccessPoliciesAnnotationValue(t *testing.T) {
+	assert.Empty(t, accessPoliciesAnnotationValue(nil))
+	assert.Equal(t, "admin/3,public/1", accessPoliciesAnnotationValue([]types.OkeAccessPolicy{
+		{ObjectMeta: metav1.ObjectMeta{Name: "public", Generation: 1}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "admin", Generation: 3}},
+	}))
+}
@@ -0,0 +1,175 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apitypes "k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	backendCASecretBundleNamePrefix = "oke-sca-"
+	backendCASecretManagedByValue   = "service-ca-secret"
+	backendCASecretServiceTag       = "oke-gateway-api-service"
+	backendCASecretKey              = "ca.crt"
+)
+
+// resolveServiceCASecret returns the backend SSL config verifying backends of the Service with
+// the CA bundle of the Secret referenced by the BackendCASecretAnnotation. It is used for
+// Services not targeted by any BackendTLSPolicy. Returns errBackendTLSPolicyNotFound if the
+// Service has no annotation.
+func (m *backendTLSPolicyModelImpl) resolveServiceCASecret(
+	ctx context.Context,
+	params resolveBackendTLSPolicyParams,
+) (*loadbalancer.SslConfigurationDetails, error) {
+	service := params.service
+	secretName := strings.TrimSpace(service.Annotations[BackendCASecretAnnotation])
+	if secretName == "" {
+		return nil, errBackendTLSPolicyNotFound
+	}
+
+	caPEM, err := m.serviceCASecretPEM(ctx, service, secretName)
+	if err != nil {
+		return nil, err
+	}
+	compartmentID, err := m.caBundleCompartmentID(ctx, params.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Load Balancer %s for Service %s/%s CA secret: %w",
+			params.config.Spec.LoadBalancerID, service.Namespace, service.Name, err)
+	}
+	if compartmentID == "" {
+		return nil, fmt.Errorf("failed to resolve Load Balancer compartment for Service %s/%s CA secret",
+			service.Namespace, service.Name)
+	}
+	caBundleID, err := m.ensureServiceCABundle(ctx, service, compartmentID, caPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &loadbalancer.SslConfigurationDetails{
+		VerifyPeerCertificate:          new(true),
+		VerifyDepth:                    new(defaultBackendTLSVerifyDepth),
+		TrustedCertificateAuthorityIds: []string{caBundleID},
+	}, nil
+}
+
+func (m *backendTLSPolicyModelImpl) serviceCASecretPEM(
+	ctx context.Context,
+	service corev1.Service,
+	secretName string,
+) (string, error) {
+	var secret corev1.Secret
+	if err := m.k8sClient.Get(ctx, apitypes.NamespacedName{
+		Namespace: service.Namespace,
+		Name:      secretName,
+	}, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("CA secret %s/%s of Service %s was not found",
+				service.Namespace, secretName, service.Name)
+		}
+		return "", fmt.Errorf("failed to get CA secret %s/%s: %w", service.Namespace, secretName, err)
+	}
+	caPEM := string(secret.Data[backendCASecretKey])
+	if caPEM == "" {
+		return "", fmt.Errorf("CA secret %s/%s of Service %s is missing %s",
+			service.Namespace, secretName, service.Name, backendCASecretKey)
+	}
+	if err := validateCABundlePEM(caPEM); err != nil {
+		return "", fmt.Errorf("CA secret %s/%s of Service %s has invalid %s: %w",
+			service.Namespace, secretName, service.Name, backendCASecretKey, err)
+	}
+	return caPEM, nil
+}
+
+// ensureServiceCABundle creates or updates the OCI CA bundle of the Service. There is a single
+// bundle per Service, so changes of the CA or of the referenced Secret update it in place.
+func (m *backendTLSPolicyModelImpl) ensureServiceCABundle(
+	ctx context.Context,
+	service corev1.Service,
+	compartmentID string,
+	caPEM string,
+) (string, error) {
+	name := backendCASecretBundleName(service)
+	tags := backendCASecretBundleTags(service, sha256Hex(caPEM))
+	listResp, err := m.certsClient.ListCaBundles(ctx, certificatesmanagement.ListCaBundlesRequest{
+		CompartmentId: &compartmentID,
+		Name:          &name,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list OCI CA bundles for Service %s/%s: %w",
+			service.Namespace, service.Name, err)
+	}
+	for _, bundle := range listResp.Items {
+		if bundle.LifecycleState == certificatesmanagement.CaBundleLifecycleStateDeleted {
+			continue
+		}
+		if !isOwnedBackendCASecretBundle(bundle.FreeformTags, service) {
+			return "", fmt.Errorf("OCI CA bundle %s already exists and is not owned by Service %s/%s",
+				name, service.Namespace, service.Name)
+		}
+		if usableErr := ensureBackendTLSCABundleUsable(bundle); usableErr != nil {
+			return "", usableErr
+		}
+		if bundle.FreeformTags[backendTLSCAHashTag] != tags[backendTLSCAHashTag] {
+			m.logger.InfoContext(ctx, "Updating OCI CA bundle for Service CA secret",
+				slog.String("service", service.Namespace+"/"+service.Name),
+				slog.String("caBundleName", name),
+			)
+			if _, err = m.certsClient.UpdateCaBundle(ctx, certificatesmanagement.UpdateCaBundleRequest{
+				CaBundleId: bundle.Id,
+				UpdateCaBundleDetails: certificatesmanagement.UpdateCaBundleDetails{
+					CaBundlePem:  &caPEM,
+					FreeformTags: tags,
+				},
+			}); err != nil {
+				return "", fmt.Errorf("failed to update OCI CA bundle %s: %w", name, err)
+			}
+		}
+		return lo.FromPtr(bundle.Id), nil
+	}
+
+	m.logger.InfoContext(ctx, "Creating OCI CA bundle for Service CA secret",
+		slog.String("service", service.Namespace+"/"+service.Name),
+		slog.String("caBundleName", name),
+	)
+	createResp, err := m.certsClient.CreateCaBundle(ctx, certificatesmanagement.CreateCaBundleRequest{
+		CreateCaBundleDetails: certificatesmanagement.CreateCaBundleDetails{
+			Name:          &name,
+			CompartmentId: &compartmentID,
+			CaBundlePem:   &caPEM,
+			FreeformTags:  tags,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create OCI CA bundle %s: %w", name, err)
+	}
+	if createResp.CaBundle.LifecycleState != certificatesmanagement.CaBundleLifecycleStateActive {
+		return "", fmt.Errorf("OCI CA bundle %s is %s and is not ready for backend TLS",
+			name, createResp.CaBundle.LifecycleState)
+	}
+	return lo.FromPtr(createResp.CaBundle.Id), nil
+}
+
+func backendCASecretBundleName(service corev1.Service) string {
+	return backendCASecretBundleNamePrefix + sha256Hex(service.Namespace + "/" + service.Name)[:24]
+}
+
+func backendCASecretBundleTags(service corev1.Service, caHash string) map[string]string {
+	return map[string]string{
+		backendTLSManagedByTag:    backendCASecretManagedByValue,
+		backendCASecretServiceTag: service.Namespace + "/" + service.Name,
+		backendTLSCAHashTag:       caHash,
+	}
+}
+
+func isOwnedBackendCASecretBundle(tags map[string]string, service corev1.Service) bool {
+	return tags[backendTLSManagedByTag] == backendCASecretManagedByValue &&
+		tags[backendCASecretServiceTag] == service.Namespace+"/"+service.Name
+}
@@ -0,0 +1,189 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestBackendTLSPolicyModelServiceCASecret(t *testing.T) {
+	fakeData := faker.New()
+	namespace := "sca-" + fakeData.Lorem().Word()
+	serviceName := "svc-" + fakeData.Lorem().Word()
+	secretName := "ca-" + fakeData.Lorem().Word()
+	compartmentID := "ocid1.compartment.oc1.." + fakeData.UUID().V4()
+	service := corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        serviceName,
+			Annotations: map[string]string{BackendCASecretAnnotation: secretName},
+		},
+		Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "tls", Port: 8443}}},
+	}
+	makeSecret := func(caPEM string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: secretName},
+			Data:       map[string][]byte{backendCASecretKey: []byte(caPEM)},
+		}
+	}
+	resolveParams := resolveBackendTLSPolicyParams{
+		gateway: gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "edge"}},
+		config: types.GatewayConfig{Spec: types.GatewayConfigSpec{
+			LoadBalancerID: "ocid1.loadbalancer.oc1.." + fakeData.UUID().V4(),
+			CompartmentID:  compartmentID,
+		}},
+		service: service,
+		backendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{
+			Name: gatewayv1.ObjectName(serviceName),
+			Port: lo.ToPtr(gatewayv1.PortNumber(8443)),
+		}},
+	}
+
+	makeModel := func(
+		t *testing.T,
+		certsClient *stubCertificatesManagementClient,
+		objects ...client.Object,
+	) (*backendTLSPolicyModelImpl, *MockociLoadBalancerClient) {
+		t.Helper()
+		lbClient := NewMockociLoadBalancerClient(t)
+		return newBackendTLSPolicyModel(backendTLSPolicyModelDeps{
+			RootLogger: diag.RootTestLogger(),
+			K8sClient: fake.NewClientBuilder().
+				WithScheme(newL4TestScheme(t)).
+				WithObjects(objects...).
+				Build(),
+			OciLoadBalancerClient:     lbClient,
+			OciCertificatesMgmtClient: certsClient,
+		}), lbClient
+	}
+
+	t.Run("verifies backends with CA bundle of the secret", func(t *testing.T) {
+		certsClient := newStubCertificatesManagementClient()
+		caPEM := testCAPEM(t)
+		model, _ := makeModel(t, certsClient, &service, makeSecret(caPEM))
+
+		sslConfig, err := model.resolveForBackendRef(t.Context(), resolveParams)
+
+		require.NoError(t, err)
+		require.Len(t, certsClient.createCalls, 1)
+		created := certsClient.createCalls[0].CreateCaBundleDetails
+		assert.Equal(t, backendCASecretBundleName(service), lo.FromPtr(created.Name))
+		assert.Equal(t, compartmentID, lo.FromPtr(created.CompartmentId))
+		assert.Equal(t, caPEM, lo.FromPtr(created.CaBundlePem))
+		assert.True(t, isOwnedBackendCASecretBundle(created.FreeformTags, service))
+		assert.True(t, lo.FromPtr(sslConfig.VerifyPeerCertificate))
+		assert.Equal(t, defaultBackendTLSVerifyDepth, lo.FromPtr(sslConfig.VerifyDepth))
+		assert.Equal(t,
+			[]string{lo.FromPtr(certsClient.bundles[backendCASecretBundleName(service)].Id)},
+			sslConfig.TrustedCertificateAuthorityIds,
+		)
+	})
+
+	t.Run("updates CA bundle when secret CA changes", func(t *testing.T) {
+		certsClient := newStubCertificatesManagementClient()
+		model, _ := makeModel(t, certsClient, &service, makeSecret(testCAPEM(t)))
+		bundleID := "ocid1.cabundle.oc1.." + fakeData.UUID().V4()
+		certsClient.bundles[backendCASecretBundleName(service)] = certificatesmanagement.CaBundleSummary{
+			Id:             &bundleID,
+			Name:           new(backendCASecretBundleName(service)),
+			LifecycleState: certificatesmanagement.CaBundleLifecycleStateActive,
+			FreeformTags:   backendCASecretBundleTags(service, sha256Hex("previous")),
+		}
+
+		sslConfig, err := model.resolveForBackendRef(t.Context(), resolveParams)
+
+		require.NoError(t, err)
+		assert.Empty(t, certsClient.createCalls)
+		require.Len(t, certsClient.updateCalls, 1)
+		assert.Equal(t, bundleID, lo.FromPtr(certsClient.updateCalls[0].CaBundleId))
+		assert.Equal(t, []string{bundleID}, sslConfig.TrustedCertificateAuthorityIds)
+	})
+
+	t.Run("rejects CA bundle not owned by the service", func(t *testing.T) {
+		certsClient := newStubCertificatesManagementClient()
+		model, _ := makeModel(t, certsClient, &service, makeSecret(testCAPEM(t)))
+		certsClient.bundles[backendCASecretBundleName(service)] = certificatesmanagement.CaBundleSummary{
+			Id:             new("ocid1.cabundle.oc1.." + fakeData.UUID().V4()),
+			Name:           new(backendCASecretBundleName(service)),
+			LifecycleState: certificatesmanagement.CaBundleLifecycleStateActive,
+		}
+
+		_, err := model.resolveForBackendRef(t.Context(), resolveParams)
+
+		require.ErrorContains(t, err, "is not owned by Service")
+	})
+
+	t.Run("uses load balancer compartment without config compartment", func(t *testing.T) {
+		certsClient := newStubCertificatesManagementClient()
+		model, lbClient := makeModel(t, certsClient, &service, makeSecret(testCAPEM(t)))
+		params := resolveParams
+		params.config.Spec.CompartmentID = ""
+		lbCompartmentID := "ocid1.compartment.oc1.." + fakeData.UUID().V4()
+		lbClient.EXPECT().
+			GetLoadBalancer(t.Context(), mock.Anything).
+			Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadbalancer.LoadBalancer{
+				CompartmentId: &lbCompartmentID,
+			}}, nil)
+
+		_, err := model.resolveForBackendRef(t.Context(), params)
+
+		require.NoError(t, err)
+		require.Len(t, certsClient.createCalls, 1)
+		assert.Equal(t, lbCompartmentID, lo.FromPtr(certsClient.createCalls[0].CreateCaBundleDetails.CompartmentId))
+	})
+
+	t.Run("rejects missing and invalid secrets", func(t *testing.T) {
+		invalidSecret := makeSecret(nonCAPEM(t))
+		emptySecret := makeSecret("")
+
+		for _, tc := range []struct {
+			name    string
+			secret  *corev1.Secret
+			wantErr string
+		}{
+			{name: "missing", wantErr: "was not found"},
+			{name: "empty", secret: emptySecret, wantErr: "is missing ca.crt"},
+			{name: "invalid", secret: invalidSecret, wantErr: "has invalid ca.crt"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				certsClient := newStubCertificatesManagementClient()
+				objects := []client.Object{&service}
+				if tc.secret != nil {
+					objects = append(objects, tc.secret)
+				}
+				model, _ := makeModel(t, certsClient, objects...)
+
+				_, err := model.resolveForBackendRef(t.Context(), resolveParams)
+
+				require.ErrorContains(t, err, tc.wantErr)
+				assert.Empty(t, certsClient.createCalls)
+			})
+		}
+	})
+
+	t.Run("returns sentinel without annotation", func(t *testing.T) {
+		plainService := *service.DeepCopy()
+		plainService.Annotations = nil
+		params := resolveParams
+		params.service = plainService
+		model, _ := makeModel(t, newStubCertificatesManagementClient(), &plainService)
+
+		_, err := model.resolveForBackendRef(t.Context(), params)
+
+		require.ErrorIs(t, err, errBackendTLSPolicyNotFound)
+	})
+}
@@ -0,0 +1,45 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// backendsHealthSummary aggregates OCI health of the backend sets used by a route.
+type backendsHealthSummary struct {
+	total    int
+	warning  int
+	critical int
+	unknown  int
+}
+
+func (s backendsHealthSummary) ok() int {
+	return max(s.total-s.warning-s.critical-s.unknown, 0)
+}
+
+func (s *backendsHealthSummary) add(health loadbalancer.BackendSetHealth) {
+	s.total += lo.FromPtr(health.TotalBackendCount)
+	s.warning += len(health.WarningStateBackendNames)
+	s.critical += len(health.CriticalStateBackendNames)
+	s.unknown += len(health.UnknownStateBackendNames)
+}
+
+// condition maps the summary to the BackendsHealthy condition. Backends that OCI
+// did not check yet are reported as Unknown rather than unhealthy.
+func (s backendsHealthSummary) condition() (metav1.ConditionStatus, string, string) {
+	message := fmt.Sprintf("%d of %d backends healthy, %d critical, %d warning, %d unknown",
+		s.ok(), s.total, s.critical, s.warning, s.unknown)
+	switch {
+	case s.total == 0:
+		return metav1.ConditionFalse, HTTPRouteReasonBackendsUnhealthy, "No backends registered"
+	case s.critical > 0 || s.warning > 0:
+		return metav1.ConditionFalse, HTTPRouteReasonBackendsUnhealthy, message
+	case s.unknown > 0:
+		return metav1.ConditionUnknown, HTTPRouteReasonBackendsPending, message
+	default:
+		return metav1.ConditionTrue, HTTPRouteReasonBackendsHealthy, message
+	}
+}
@@ -0,0 +1,141 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+const (
+	healthCheckProtocolTCP    = "TCP"
+	healthCheckProtocolHTTP   = "HTTP"
+	healthCheckProtocolGRPC   = "GRPC"
+	defaultHealthCheckURLPath = "/"
+	defaultHealthCheckCode    = 200
+	minHealthCheckReturnCode  = 100
+	maxHealthCheckReturnCode  = 599
+	maxHealthCheckRetries     = 10
+	maxHealthCheckMillis      = 1800000
+	maxPortNumber             = 65535
+)
+
+// backendSetHealthChecker builds the backend set health checker from the health check spec.
+// TCP check of the default port is used if not configured otherwise. Optional settings not
+// configured are left to OCI defaults.
+func backendSetHealthChecker(
+	healthCheck types.OkeExternalBackendHealthCheck,
+	defaultPort int,
+) loadbalancer.HealthCheckerDetails {
+	port := int(healthCheck.Port)
+	if port == 0 {
+		port = defaultPort
+	}
+	protocol := lo.CoalesceOrEmpty(healthCheck.Protocol, healthCheckProtocolTCP)
+	if protocol == healthCheckProtocolGRPC {
+		protocol = grpcHealthCheckProtocol(port, defaultPort)
+	}
+
+	healthChecker := loadbalancer.HealthCheckerDetails{
+		Protocol: new(protocol),
+		Port:     new(port),
+	}
+	if protocol == healthCheckProtocolHTTP {
+		healthChecker.UrlPath = new(lo.CoalesceOrEmpty(healthCheck.URLPath, defaultHealthCheckURLPath))
+		healthChecker.ReturnCode = new(int(lo.CoalesceOrEmpty(healthCheck.ReturnCode, defaultHealthCheckCode)))
+		if healthCheck.ResponseBodyRegex != "" {
+			healthChecker.ResponseBodyRegex = new(healthCheck.ResponseBodyRegex)
+		}
+	}
+	if healthCheck.IntervalMillis > 0 {
+		healthChecker.IntervalInMillis = new(int(healthCheck.IntervalMillis))
+	}
+	if healthCheck.TimeoutMillis > 0 {
+		healthChecker.TimeoutInMillis = new(int(healthCheck.TimeoutMillis))
+	}
+	if healthCheck.Retries > 0 {
+		healthChecker.Retries = new(int(healthCheck.Retries))
+	}
+	return healthChecker
+}
+
+// grpcHealthCheckProtocol returns the OCI health check protocol of gRPC backends. OCI health
+// checkers send HTTP/1.1 requests that gRPC servers serving HTTP/2 only reject, so the HTTP
+// health endpoint translating to the gRPC health service is checked when it is served on a
+// dedicated port. The gRPC port itself is checked with TCP.
+func grpcHealthCheckProtocol(port, backendPort int) string {
+	if port == backendPort {
+		return healthCheckProtocolTCP
+	}
+	return healthCheckProtocolHTTP
+}
+
+// serviceHealthCheck returns the health check configured with the Service annotations.
+// Invalid values are ignored and logged, so OCI defaults or the backend port are used then.
+func (m *ociLoadBalancerModelImpl) serviceHealthCheck(
+	ctx context.Context,
+	service corev1.Service,
+) types.OkeExternalBackendHealthCheck {
+	warnInvalid := func(annotation, value string) {
+		m.logger.WarnContext(ctx, "Ignoring invalid health check annotation",
+			slog.String("service", service.Namespace+"/"+service.Name),
+			slog.String("annotation", annotation),
+			slog.String("value", value),
+		)
+	}
+	parseInt := func(annotation string, minValue, maxValue int) int32 {
+		rawValue, ok := service.Annotations[annotation]
+		if !ok {
+			return 0
+		}
+		value, err := strconv.Atoi(rawValue)
+		if err != nil || value < minValue || value > maxValue {
+			warnInvalid(annotation, rawValue)
+			return 0
+		}
+		return int32(value) //nolint:gosec // value is within the range
+	}
+	parseMillis := func(annotation string) int32 {
+		rawValue, ok := service.Annotations[annotation]
+		if !ok {
+			return 0
+		}
+		value, err := time.ParseDuration(rawValue)
+		if err != nil || value < time.Millisecond || value.Milliseconds() > int64(maxHealthCheckMillis) {
+			warnInvalid(annotation, rawValue)
+			return 0
+		}
+		return int32(value.Milliseconds()) //nolint:gosec // value is within the range
+	}
+
+	healthCheck := types.OkeExternalBackendHealthCheck{
+		Port:    parseInt(BackendHealthCheckPortAnnotation, 1, maxPortNumber),
+		URLPath: service.Annotations[BackendHealthCheckPathAnnotation],
+		ReturnCode: parseInt(BackendHealthCheckReturnCodeAnnotation,
+			minHealthCheckReturnCode, maxHealthCheckReturnCode),
+		IntervalMillis: parseMillis(BackendHealthCheckIntervalAnnotation),
+		TimeoutMillis:  parseMillis(BackendHealthCheckTimeoutAnnotation),
+		Retries:        parseInt(BackendHealthCheckRetriesAnnotation, 1, maxHealthCheckRetries),
+	}
+	switch protocol := service.Annotations[BackendHealthCheckProtocolAnnotation]; protocol {
+	case "", healthCheckProtocolTCP, healthCheckProtocolHTTP, healthCheckProtocolGRPC:
+		healthCheck.Protocol = protocol
+	default:
+		warnInvalid(BackendHealthCheckProtocolAnnotation, protocol)
+	}
+	if bodyRegex, ok := service.Annotations[BackendHealthCheckResponseBodyRegexAnnotation]; ok {
+		if _, err := regexp.Compile(bodyRegex); err != nil {
+			warnInvalid(BackendHealthCheckResponseBodyRegexAnnotation, bodyRegex)
+		} else {
+			healthCheck.ResponseBodyRegex = bodyRegex
+		}
+	}
+	return healthCheck
+}
@@ -0,0 +1,145 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestBackendHealthCheck(t *testing.T) {
+	t.Run("backendSetHealthChecker", func(t *testing.T) {
+		t.Run("defaults to TCP check of the default port", func(t *testing.T) {
+			port := faker.New().IntBetween(1, maxPortNumber)
+
+			assert.Equal(t, loadbalancer.HealthCheckerDetails{
+				Protocol: new("TCP"),
+				Port:     new(port),
+			}, backendSetHealthChecker(types.OkeExternalBackendHealthCheck{}, port))
+		})
+
+		t.Run("ignores HTTP settings of TCP check", func(t *testing.T) {
+			port := faker.New().IntBetween(1, maxPortNumber)
+
+			assert.Equal(t, loadbalancer.HealthCheckerDetails{
+				Protocol: new("TCP"),
+				Port:     new(port),
+			}, backendSetHealthChecker(types.OkeExternalBackendHealthCheck{
+				URLPath:           "/healthz",
+				ResponseBodyRegex: "^ok$",
+			}, port))
+		})
+
+		t.Run("HTTP check with response body regex", func(t *testing.T) {
+			port := faker.New().IntBetween(1, maxPortNumber)
+
+			assert.Equal(t, loadbalancer.HealthCheckerDetails{
+				Protocol:          new("HTTP"),
+				Port:              new(port),
+				UrlPath:           new("/"),
+				ReturnCode:        new(200),
+				ResponseBodyRegex: new("^ok$"),
+			}, backendSetHealthChecker(types.OkeExternalBackendHealthCheck{
+				Protocol:          "HTTP",
+				ResponseBodyRegex: "^ok$",
+			}, port))
+		})
+
+		t.Run("GRPC check of the health endpoint on a dedicated port", func(t *testing.T) {
+			port := faker.New().IntBetween(1, maxPortNumber-1)
+
+			assert.Equal(t, loadbalancer.HealthCheckerDetails{
+				Protocol:   new("HTTP"),
+				Port:       new(port + 1),
+				UrlPath:    new("/healthz"),
+				ReturnCode: new(200),
+			}, backendSetHealthChecker(types.OkeExternalBackendHealthCheck{
+				Protocol: "GRPC",
+				Port:     int32(port + 1), //nolint:gosec // within the port range
+				URLPath:  "/healthz",
+			}, port))
+		})
+
+		t.Run("GRPC check of the backend port with TCP", func(t *testing.T) {
+			port := faker.New().IntBetween(1, maxPortNumber)
+
+			assert.Equal(t, loadbalancer.HealthCheckerDetails{
+				Protocol: new("TCP"),
+				Port:     new(port),
+			}, backendSetHealthChecker(types.OkeExternalBackendHealthCheck{
+				Protocol: "GRPC",
+				URLPath:  "/healthz",
+			}, port))
+		})
+	})
+
+	t.Run("serviceHealthCheck", func(t *testing.T) {
+		model := &ociLoadBalancerModelImpl{logger: diag.RootTestLogger()}
+		makeService := func(annotations map[string]string) corev1.Service {
+			return makeRandomService(func(s *corev1.Service) {
+				s.Annotations = annotations
+			})
+		}
+
+		t.Run("returns empty health check without annotations", func(t *testing.T) {
+			assert.Equal(t, types.OkeExternalBackendHealthCheck{},
+				model.serviceHealthCheck(t.Context(), makeService(nil)))
+		})
+
+		t.Run("parses annotations", func(t *testing.T) {
+			service := makeService(map[string]string{
+				BackendHealthCheckProtocolAnnotation:          "HTTP",
+				BackendHealthCheckPortAnnotation:              "8081",
+				BackendHealthCheckPathAnnotation:              "/healthz",
+				BackendHealthCheckReturnCodeAnnotation:        "204",
+				BackendHealthCheckResponseBodyRegexAnnotation: "^(ok|healthy)$",
+				BackendHealthCheckIntervalAnnotation:          "10s",
+				BackendHealthCheckTimeoutAnnotation:           "1500ms",
+				BackendHealthCheckRetriesAnnotation:           "5",
+			})
+
+			assert.Equal(t, types.OkeExternalBackendHealthCheck{
+				Protocol:          "HTTP",
+				Port:              8081,
+				URLPath:           "/healthz",
+				ReturnCode:        204,
+				ResponseBodyRegex: "^(ok|healthy)$",
+				IntervalMillis:    10000,
+				TimeoutMillis:     1500,
+				Retries:           5,
+			}, model.serviceHealthCheck(t.Context(), service))
+		})
+
+		t.Run("ignores invalid annotations", func(t *testing.T) {
+			service := makeService(map[string]string{
+				BackendHealthCheckProtocolAnnotation:          "HTTPS",
+				BackendHealthCheckPortAnnotation:              "70000",
+				BackendHealthCheckReturnCodeAnnotation:        "2xx",
+				BackendHealthCheckResponseBodyRegexAnnotation: "(ok",
+				BackendHealthCheckIntervalAnnotation:          "10",
+				BackendHealthCheckTimeoutAnnotation:           "1h",
+				BackendHealthCheckRetriesAnnotation:           "0",
+			})
+
+			assert.Equal(t, types.OkeExternalBackendHealthCheck{},
+				model.serviceHealthCheck(t.Context(), service))
+		})
+
+		t.Run("accepts GRPC protocol", func(t *testing.T) {
+			service := makeService(map[string]string{
+				BackendHealthCheckProtocolAnnotation: "GRPC",
+				BackendHealthCheckPortAnnotation:     "8081",
+			})
+
+			assert.Equal(t, types.OkeExternalBackendHealthCheck{
+				Protocol: "GRPC",
+				Port:     8081,
+			}, model.serviceHealthCheck(t.Context(), service))
+		})
+	})
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBackendsHealthSummary(t *testing.T) {
+	t.Run("adds backend set health", func(t *testing.T) {
+		var summary backendsHealthSummary
+		summary.add(loadbalancer.BackendSetHealth{
+			TotalBackendCount:         new(5),
+			WarningStateBackendNames:  []string{"a"},
+			CriticalStateBackendNames: []string{"b", "c"},
+		})
+		summary.add(loadbalancer.BackendSetHealth{
+			TotalBackendCount:        new(2),
+			UnknownStateBackendNames: []string{"d"},
+		})
+
+		assert.Equal(t, backendsHealthSummary{total: 7, warning: 1, critical: 2, unknown: 1}, summary)
+		assert.Equal(t, 3, summary.ok())
+	})
+
+	t.Run("condition", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			summary    backendsHealthSummary
+			wantStatus metav1.ConditionStatus
+			wantReason string
+		}{
+			{"healthy", backendsHealthSummary{total: 2}, metav1.ConditionTrue, HTTPRouteReasonBackendsHealthy},
+			{"no backends", backendsHealthSummary{}, metav1.ConditionFalse, HTTPRouteReasonBackendsUnhealthy},
+			{"critical", backendsHealthSummary{total: 2, critical: 1}, metav1.ConditionFalse, HTTPRouteReasonBackendsUnhealthy},
+			{"warning", backendsHealthSummary{total: 2, warning: 1}, metav1.ConditionFalse, HTTPRouteReasonBackendsUnhealthy},
+			{"pending", backendsHealthSummary{total: 2, unknown: 2}, metav1.ConditionUnknown, HTTPRouteReasonBackendsPending},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				status, reason, message := tt.summary.condition()
+				assert.Equal(t, tt.wantStatus, status)
+				assert.Equal(t, tt.wantReason, reason)
+				assert.NotEmpty(t, message)
+			})
+		}
+	})
+}
@@ -102,7 +102,7 @@ func (m *backendTLSPolicyModelImpl) resolveForBackendRef(
 		return nil, err
 	}
 	if len(candidates) == 0 {
-		return nil, errBackendTLSPolicyNotFound
+		return m.resolveServiceCASecret(ctx, params)
 	}
 
 	sortBackendTLSPolicyCandidates(candidates)
@@ -320,16 +320,13 @@ func (m *backendTLSPolicyModelImpl) resolveAcceptedPolicy(
 		return nil, err
 	}
 
-	lbResp, err := m.loadBalancerClient.GetLoadBalancer(ctx, loadbalancer.GetLoadBalancerRequest{
-		LoadBalancerId: &params.config.Spec.LoadBalancerID,
-	})
+	compartmentID, err := m.caBundleCompartmentID(ctx, params.config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get Load Balancer %s for BackendTLSPolicy: %w",
 			params.config.Spec.LoadBalancerID,
 			err,
 		)
 	}
-	compartmentID := lo.FromPtr(lbResp.LoadBalancer.CompartmentId)
 	if compartmentID == "" {
 		return nil, fmt.Errorf("failed to resolve Load Balancer compartment for BackendTLSPolicy %s/%s",
 			policy.Namespace,
@@ -362,6 +359,24 @@ func (m *backendTLSPolicyModelImpl) resolveAcceptedPolicy(
 	return sslConfig, nil
 }
 
+// caBundleCompartmentID returns the compartment of the OCI CA bundles used by backend sets of the
+// gateway config: the compartment of the config, or of its load balancer if not set.
+func (m *backendTLSPolicyModelImpl) caBundleCompartmentID(
+	ctx context.Context,
+	config types.GatewayConfig,
+) (string, error) {
+	if config.Spec.CompartmentID != "" {
+		return config.Spec.CompartmentID, nil
+	}
+	lbResp, err := m.loadBalancerClient.GetLoadBalancer(ctx, loadbalancer.GetLoadBalancerRequest{
+		LoadBalancerId: &config.Spec.LoadBalancerID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return lo.FromPtr(lbResp.LoadBalancer.CompartmentId), nil
+}
+
 func validateBackendTLSPolicyShape(policy gatewayv1.BackendTLSPolicy) error {
 	if policy.Spec.Validation.WellKnownCACertificates != nil &&
 		string(lo.FromPtr(policy.Spec.Validation.WellKnownCACertificates)) != "" {
@@ -1015,7 +1030,27 @@ func (m *backendTLSPolicyModelImpl) backendTLSPolicyCleanupCompartment(
 			getErr,
 		)
 	}
-	lbResp, err := m.loadBalancerClient.GetLoadBalancer(ctx, loadbalancer.GetLoadBalancerRequest{
+	var gatewayClass gatewayv1.GatewayClass
+	classKey := apitypes.NamespacedName{Name: string(gateway.Spec.GatewayClassName)}
+	if getErr := m.k8sClient.Get(ctx, classKey, &gatewayClass); getErr != nil {
+		if !apierrors.IsNotFound(getErr) {
+			return "", fmt.Errorf(
+				"failed to get GatewayClass %s for BackendTLSPolicy cleanup: %w",
+				classKey.Name,
+				getErr,
+			)
+		}
+	} else if err := resolveGatewayClassConfig(ctx, m.k8sClient, &gatewayClass, &config); err != nil {
+		// Invalid class parameters should not block the cleanup, the load balancer compartment is used instead
+		var statusErr *resourceStatusError
+		if !errors.As(err, &statusErr) {
+			return "", fmt.Errorf("failed to resolve GatewayClassConfig for BackendTLSPolicy cleanup: %w", err)
+		}
+	}
+	if config.Spec.CompartmentID != "" {
+		return config.Spec.CompartmentID, nil
+	}
+	lbResp, err := m.loadBalancerClient.GetLoadBalancer(ociRegionContext(ctx, config), loadbalancer.GetLoadBalancerRequest{
 		LoadBalancerId: &config.Spec.LoadBalancerID,
 	})
 	if err != nil {
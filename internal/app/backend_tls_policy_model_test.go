@@ -1306,6 +1306,43 @@ func TestBackendTLSPolicyModelValidationAndLifecycle(t *testing.T) {
 		assert.Empty(t, certsClient.createCalls)
 	})
 
+	t.Run("uses GatewayConfig compartment instead of load balancer compartment", func(t *testing.T) {
+		configCompartmentID := "ocid1.compartment.oc1.." + fakeData.UUID().V4()
+		options := lo.Assign(
+			map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{},
+			baseOptions,
+			map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+				BackendTLSOptionTrustedCABundleOCIDs: gatewayv1.AnnotationValue(
+					"ocid1.cabundle.oc1.." + fakeData.UUID().V4(),
+				),
+			},
+		)
+		policy := backendTLSPolicy(namespace, "config-compartment", serviceName, "tls", options)
+		k8sClient := fake.NewClientBuilder().
+			WithScheme(newL4TestScheme(t)).
+			WithObjects(&service, &policy).
+			WithStatusSubresource(&gatewayv1.BackendTLSPolicy{}).
+			Build()
+		model := newBackendTLSPolicyModel(backendTLSPolicyModelDeps{
+			RootLogger:                diag.RootTestLogger(),
+			K8sClient:                 k8sClient,
+			OciLoadBalancerClient:     NewMockociLoadBalancerClient(t),
+			OciCertificatesMgmtClient: newStubCertificatesManagementClient(),
+		})
+		params := resolveParams
+		params.config.Spec.CompartmentID = configCompartmentID
+
+		_, err := model.resolveForBackendRef(t.Context(), params)
+
+		require.NoError(t, err)
+		var updated gatewayv1.BackendTLSPolicy
+		require.NoError(t, k8sClient.Get(t.Context(), apitypes.NamespacedName{
+			Namespace: namespace,
+			Name:      policy.Name,
+		}, &updated))
+		assert.Equal(t, configCompartmentID, updated.Annotations[BackendTLSPolicyCompartmentsAnnotation])
+	})
+
 	t.Run("cleanup no-ops without finalizer and wraps list errors", func(t *testing.T) {
 		policy := backendTLSPolicy(namespace, "cleanup-no-finalizer", serviceName, "tls", baseOptions, "ca")
 		model, _ := makeModel(t, newStubCertificatesManagementClient(), &policy)
@@ -0,0 +1,68 @@
+package app
+
+import (
+	"slices"
+
+	"github.com/samber/lo"
+	discoveryv1 "k8s.io/api/discovery/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+const (
+	backendTopologyModeRestrict       = "Restrict"
+	defaultBackendTopologyLocalWeight = 10
+)
+
+// backendTopology decides which endpoints are local to the load balancer zones.
+// A nil value is valid and treats all endpoints the same.
+type backendTopology struct {
+	zones       []string
+	restrict    bool
+	localWeight int
+}
+
+func newBackendTopology(config *types.GatewayConfigBackendTopology) *backendTopology {
+	if config == nil || len(config.Zones) == 0 {
+		return nil
+	}
+	return &backendTopology{
+		zones:       config.Zones,
+		restrict:    config.Mode == backendTopologyModeRestrict,
+		localWeight: lo.Ternary(config.LocalWeight > 0, config.LocalWeight, defaultBackendTopologyLocalWeight),
+	}
+}
+
+// endpointLocal reports whether the endpoint is local to the load balancer zones.
+// Topology hints take precedence over the endpoint zone.
+func (t *backendTopology) endpointLocal(endpoint discoveryv1.Endpoint) bool {
+	if endpoint.Hints != nil && len(endpoint.Hints.ForZones) > 0 {
+		return lo.ContainsBy(endpoint.Hints.ForZones, func(forZone discoveryv1.ForZone) bool {
+			return slices.Contains(t.zones, forZone.Name)
+		})
+	}
+	return endpoint.Zone != nil && slices.Contains(t.zones, *endpoint.Zone)
+}
+
+// restrictedTo returns the topology restricting registered endpoints if any of the
+// endpoint slices has a local endpoint, so the backend set is never emptied because
+// of the topology. Returns nil otherwise.
+func (t *backendTopology) restrictedTo(endpointSlices []discoveryv1.EndpointSlice) *backendTopology {
+	if t == nil || !t.restrict {
+		return nil
+	}
+	for _, slice := range endpointSlices {
+		if slices.ContainsFunc(slice.Endpoints, t.endpointLocal) {
+			return t
+		}
+	}
+	return nil
+}
+
+// endpointWeight returns the weight of the endpoint without explicit weight.
+func (t *backendTopology) endpointWeight(endpoint discoveryv1.Endpoint) *int {
+	if t == nil || t.restrict {
+		return nil
+	}
+	return new(lo.Ternary(t.endpointLocal(endpoint), t.localWeight, minBackendWeight))
+}
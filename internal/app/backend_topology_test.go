@@ -0,0 +1,81 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	discoveryv1 "k8s.io/api/discovery/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestBackendTopology(t *testing.T) {
+	makeZonedEndpoint := func(zone string, hintZones ...string) discoveryv1.Endpoint {
+		endpoint := makeRandomEndpoint()
+		endpoint.Zone = new(zone)
+		if len(hintZones) > 0 {
+			endpoint.Hints = &discoveryv1.EndpointHints{}
+			for _, hintZone := range hintZones {
+				endpoint.Hints.ForZones = append(endpoint.Hints.ForZones, discoveryv1.ForZone{Name: hintZone})
+			}
+		}
+		return endpoint
+	}
+
+	t.Run("newBackendTopology", func(t *testing.T) {
+		zone := faker.New().Lorem().Word()
+
+		assert.Nil(t, newBackendTopology(nil))
+		assert.Nil(t, newBackendTopology(&types.GatewayConfigBackendTopology{}))
+		assert.Equal(t,
+			&backendTopology{zones: []string{zone}, localWeight: defaultBackendTopologyLocalWeight},
+			newBackendTopology(&types.GatewayConfigBackendTopology{Zones: []string{zone}}),
+		)
+		assert.Equal(t,
+			&backendTopology{zones: []string{zone}, restrict: true, localWeight: 5},
+			newBackendTopology(&types.GatewayConfigBackendTopology{
+				Zones:       []string{zone},
+				Mode:        backendTopologyModeRestrict,
+				LocalWeight: 5,
+			}),
+		)
+	})
+
+	t.Run("endpointLocal", func(t *testing.T) {
+		topology := &backendTopology{zones: []string{"ad-1"}}
+
+		assert.True(t, topology.endpointLocal(makeZonedEndpoint("ad-1")))
+		assert.False(t, topology.endpointLocal(makeZonedEndpoint("ad-2")))
+		assert.False(t, topology.endpointLocal(makeRandomEndpoint()))
+		assert.True(t, topology.endpointLocal(makeZonedEndpoint("ad-2", "ad-1")))
+		assert.False(t, topology.endpointLocal(makeZonedEndpoint("ad-1", "ad-2")))
+	})
+
+	t.Run("restrictedTo", func(t *testing.T) {
+		topology := &backendTopology{zones: []string{"ad-1"}, restrict: true}
+		localSlices := []discoveryv1.EndpointSlice{
+			{Endpoints: []discoveryv1.Endpoint{makeZonedEndpoint("ad-2")}},
+			{Endpoints: []discoveryv1.Endpoint{makeZonedEndpoint("ad-1")}},
+		}
+		remoteSlices := []discoveryv1.EndpointSlice{
+			{Endpoints: []discoveryv1.Endpoint{makeZonedEndpoint("ad-2")}},
+		}
+
+		assert.Same(t, topology, topology.restrictedTo(localSlices))
+		assert.Nil(t, topology.restrictedTo(remoteSlices))
+		assert.Nil(t, (&backendTopology{zones: []string{"ad-1"}}).restrictedTo(localSlices))
+		assert.Nil(t, (*backendTopology)(nil).restrictedTo(localSlices))
+	})
+
+	t.Run("endpointWeight", func(t *testing.T) {
+		topology := &backendTopology{zones: []string{"ad-1"}, localWeight: 7}
+
+		assert.Equal(t, new(7), topology.endpointWeight(makeZonedEndpoint("ad-1")))
+		assert.Equal(t, new(minBackendWeight), topology.endpointWeight(makeZonedEndpoint("ad-2")))
+		assert.Nil(t, (&backendTopology{zones: []string{"ad-1"}, restrict: true}).endpointWeight(
+			makeZonedEndpoint("ad-1"),
+		))
+		assert.Nil(t, (*backendTopology)(nil).endpointWeight(makeZonedEndpoint("ad-1")))
+	})
+}
@@ -0,0 +1,167 @@
+package app
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	"go.uber.org/dig"
+	corev1 "k8s.io/api/core/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+)
+
+const (
+	// GatewayEventReasonCertificateExpiring is the reason of the warning event emitted on the
+	// Gateway when the certificate served by a listener is about to expire.
+	GatewayEventReasonCertificateExpiring = "CertificateExpiring"
+
+	certificateExpiryEventAction = "CheckCertificateExpiry"
+)
+
+// certificateExpiryMonitor reports expiry of the certificates served by the gateway listeners.
+// Certificates are checked on every reconciliation, so a certificate that is not renewed
+// (e.g. cert-manager renewal is broken) is reported before it expires.
+// A nil value is valid and reports nothing.
+type certificateExpiryMonitor struct {
+	logger   *slog.Logger
+	metrics  *certificateMetrics
+	recorder events.EventRecorder
+	now      func() time.Time
+
+	// warningThreshold is how long before the expiry the warning event is emitted. Zero disables events.
+	warningThreshold time.Duration
+}
+
+// checkGatewayCertificates records expiry of the gateway certificates and emits the warning
+// event for listeners serving a certificate that expires within the threshold.
+func (m *certificateExpiryMonitor) checkGatewayCertificates(ctx context.Context, data *resolvedGatewayDetails) {
+	if m == nil {
+		return
+	}
+	gateway := &data.gateway
+	now := m.now()
+
+	m.metrics.deleteGatewayCertificates(gateway.Namespace, gateway.Name)
+	for _, secret := range data.gatewaySecrets {
+		leaf, err := listenerCertificateLeaf(secret.Data[corev1.TLSCertKey])
+		if err != nil {
+			// Invalid certificates are reported when programming listeners
+			m.logger.DebugContext(ctx, "Skipping expiry check of invalid certificate",
+				slog.String("secret", secret.Namespace+"/"+secret.Name),
+				diag.ErrAttr(err),
+			)
+			continue
+		}
+		m.metrics.setExpiryDays(
+			gateway.Namespace,
+			gateway.Name,
+			ociCertificateNameFromSecret(secret),
+			leaf.NotAfter.Sub(now).Hours()/24,
+		)
+	}
+
+	if m.warningThreshold <= 0 {
+		return
+	}
+	for _, listener := range gateway.Spec.Listeners {
+		secret, found := listenerServingSecret(data, listener)
+		if !found {
+			continue
+		}
+		leaf, err := listenerCertificateLeaf(secret.Data[corev1.TLSCertKey])
+		if err != nil || leaf.NotAfter.Sub(now) > m.warningThreshold {
+			continue
+		}
+		note := fmt.Sprintf("Certificate of secret %s/%s served by listener %s expires in %d days at %s",
+			secret.Namespace, secret.Name, listener.Name,
+			int(math.Floor(leaf.NotAfter.Sub(now).Hours()/24)),
+			leaf.NotAfter.UTC().Format(time.RFC3339),
+		)
+		if leaf.NotAfter.Before(now) {
+			note = fmt.Sprintf("Certificate of secret %s/%s served by listener %s has expired at %s",
+				secret.Namespace, secret.Name, listener.Name, leaf.NotAfter.UTC().Format(time.RFC3339),
+			)
+		}
+		m.logger.WarnContext(ctx, note, slog.String("gateway", gateway.Namespace+"/"+gateway.Name))
+		m.recorder.Eventf(
+			gateway, nil, corev1.EventTypeWarning,
+			GatewayEventReasonCertificateExpiring, certificateExpiryEventAction, "%s", note,
+		)
+	}
+}
+
+// forgetGateway removes expiry metrics of the gateway that is deleted or no longer managed.
+func (m *certificateExpiryMonitor) forgetGateway(name apitypes.NamespacedName) {
+	if m == nil {
+		return
+	}
+	m.metrics.deleteGatewayCertificates(name.Namespace, name.Name)
+}
+
+// listenerServingSecret returns the Secret of the certificate bound to the listener.
+// Listeners using OCI certificates directly are not checked.
+func listenerServingSecret(data *resolvedGatewayDetails, listener gatewayv1.Listener) (corev1.Secret, bool) {
+	if listener.TLS == nil || listenerOCICertificateOCID(listener) != "" {
+		return corev1.Secret{}, false
+	}
+
+	secretsByCertificate := make(map[string]corev1.Secret, len(listener.TLS.CertificateRefs))
+	certificates := make([]loadbalancer.Certificate, 0, len(listener.TLS.CertificateRefs))
+	for _, ref := range listener.TLS.CertificateRefs {
+		secret, found := data.gatewaySecrets[certificateRefNamespacedName(data.gateway.Namespace, ref).String()]
+		if !found {
+			continue
+		}
+		certificateName := ociCertificateNameFromSecret(secret)
+		secretsByCertificate[certificateName] = secret
+		certificates = append(certificates, loadbalancer.Certificate{
+			CertificateName:   &certificateName,
+			PublicCertificate: new(string(secret.Data[corev1.TLSCertKey])),
+		})
+	}
+	if len(certificates) == 0 {
+		return corev1.Secret{}, false
+	}
+
+	serving := selectListenerCertificate(&listener, certificates)
+	return secretsByCertificate[lo.FromPtr(serving.CertificateName)], true
+}
+
+// listenerCertificateLeaf parses the leaf certificate of the PEM certificate chain.
+func listenerCertificateLeaf(certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+type certificateExpiryMonitorDeps struct {
+	dig.In
+
+	RootLogger    *slog.Logger
+	Metrics       *certificateMetrics `optional:"true"`
+	EventRecorder events.EventRecorder
+	WarningDays   int `name:"config.reconcile.certificate-expiry-warning-days"`
+}
+
+func newCertificateExpiryMonitor(deps certificateExpiryMonitorDeps) *certificateExpiryMonitor {
+	return &certificateExpiryMonitor{
+		logger:           deps.RootLogger.WithGroup("certificate-expiry-monitor"),
+		metrics:          deps.Metrics,
+		recorder:         deps.EventRecorder,
+		now:              time.Now,
+		warningThreshold: time.Duration(deps.WarningDays) * 24 * time.Hour,
+	}
+}
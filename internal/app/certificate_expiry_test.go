@@ -0,0 +1,149 @@
+package app
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+)
+
+func TestCertificateExpiryMonitor(t *testing.T) {
+	now := time.Now()
+
+	makeSecret := func(notAfter time.Time, dnsNames ...string) corev1.Secret {
+		certificate := issueTestCertificate("", nil, func(cert *x509.Certificate) {
+			cert.NotBefore = now.Add(-24 * time.Hour)
+			cert.NotAfter = notAfter
+			cert.DNSNames = dnsNames
+		})
+		return makeRandomSecret(func(secret *corev1.Secret) {
+			secret.Data[corev1.TLSCertKey] = certificate.certPEM
+			secret.Data[corev1.TLSPrivateKeyKey] = certificate.keyPEM
+		})
+	}
+
+	makeData := func(listener gatewayv1.Listener, secrets ...corev1.Secret) *resolvedGatewayDetails {
+		gateway := newRandomGateway()
+		listener.TLS.CertificateRefs = nil
+		data := &resolvedGatewayDetails{gatewaySecrets: map[string]corev1.Secret{}}
+		for _, secret := range secrets {
+			listener.TLS.CertificateRefs = append(listener.TLS.CertificateRefs, gatewayv1.SecretObjectReference{
+				Name:      gatewayv1.ObjectName(secret.Name),
+				Namespace: new(gatewayv1.Namespace(secret.Namespace)),
+			})
+			data.gatewaySecrets[secret.Namespace+"/"+secret.Name] = secret
+		}
+		gateway.Spec.Listeners = []gatewayv1.Listener{listener}
+		data.gateway = *gateway
+		return data
+	}
+
+	newMonitor := func(t *testing.T) (*certificateExpiryMonitor, *events.FakeRecorder) {
+		metrics, err := newCertificateMetrics()
+		require.NoError(t, err)
+		recorder := events.NewFakeRecorder(10)
+		monitor := newCertificateExpiryMonitor(certificateExpiryMonitorDeps{
+			RootLogger:    diag.RootTestLogger(),
+			Metrics:       metrics,
+			EventRecorder: recorder,
+			WarningDays:   14,
+		})
+		monitor.now = func() time.Time { return now }
+		return monitor, recorder
+	}
+
+	t.Run("records expiry days of gateway certificates", func(t *testing.T) {
+		monitor, recorder := newMonitor(t)
+		secret := makeSecret(now.Add(30 * 24 * time.Hour))
+		data := makeData(makeRandomListener(randomListenerWithHTTPSParamsOpt()), secret)
+
+		monitor.checkGatewayCertificates(t.Context(), data)
+
+		assert.InDelta(t, 30, testutil.ToFloat64(monitor.metrics.expiryDays.WithLabelValues(
+			data.gateway.Namespace, data.gateway.Name, ociCertificateNameFromSecret(secret),
+		)), 0.001)
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("emits warning event when serving certificate is about to expire", func(t *testing.T) {
+		monitor, recorder := newMonitor(t)
+		secret := makeSecret(now.Add(5*24*time.Hour + time.Hour))
+		listener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+		data := makeData(listener, secret)
+
+		monitor.checkGatewayCertificates(t.Context(), data)
+
+		require.Len(t, recorder.Events, 1)
+		event := <-recorder.Events
+		assert.Contains(t, event, corev1.EventTypeWarning+" "+GatewayEventReasonCertificateExpiring)
+		assert.Contains(t, event, secret.Namespace+"/"+secret.Name)
+		assert.Contains(t, event, "listener "+string(listener.Name)+" expires in 5 days")
+	})
+
+	t.Run("emits warning event when serving certificate has expired", func(t *testing.T) {
+		monitor, recorder := newMonitor(t)
+		secret := makeSecret(now.Add(-time.Hour))
+		data := makeData(makeRandomListener(randomListenerWithHTTPSParamsOpt()), secret)
+
+		monitor.checkGatewayCertificates(t.Context(), data)
+
+		require.Len(t, recorder.Events, 1)
+		assert.Contains(t, <-recorder.Events, "has expired at")
+	})
+
+	t.Run("checks only certificate served by the listener", func(t *testing.T) {
+		monitor, recorder := newMonitor(t)
+		expiring := makeSecret(now.Add(24*time.Hour), "other.example.com")
+		serving := makeSecret(now.Add(60*24*time.Hour), "api.example.com")
+		listener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+		listener.Hostname = new(gatewayv1.Hostname("api.example.com"))
+		data := makeData(listener, expiring, serving)
+
+		monitor.checkGatewayCertificates(t.Context(), data)
+
+		assert.Empty(t, recorder.Events)
+		assert.InDelta(t, 1, testutil.ToFloat64(monitor.metrics.expiryDays.WithLabelValues(
+			data.gateway.Namespace, data.gateway.Name, ociCertificateNameFromSecret(expiring),
+		)), 0.001)
+	})
+
+	t.Run("does not emit events when warning is disabled", func(t *testing.T) {
+		monitor, recorder := newMonitor(t)
+		monitor.warningThreshold = 0
+		data := makeData(makeRandomListener(randomListenerWithHTTPSParamsOpt()), makeSecret(now.Add(time.Hour)))
+
+		monitor.checkGatewayCertificates(t.Context(), data)
+
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("forgetGateway removes gateway metrics", func(t *testing.T) {
+		monitor, _ := newMonitor(t)
+		secret := makeSecret(now.Add(30 * 24 * time.Hour))
+		data := makeData(makeRandomListener(randomListenerWithHTTPSParamsOpt()), secret)
+		monitor.checkGatewayCertificates(t.Context(), data)
+
+		monitor.forgetGateway(apitypes.NamespacedName{Namespace: data.gateway.Namespace, Name: data.gateway.Name})
+
+		assert.InDelta(t, 0, testutil.ToFloat64(monitor.metrics.expiryDays.WithLabelValues(
+			data.gateway.Namespace, data.gateway.Name, ociCertificateNameFromSecret(secret),
+		)), 0)
+	})
+
+	t.Run("nil monitor is noop", func(t *testing.T) {
+		var monitor *certificateExpiryMonitor
+		assert.NotPanics(t, func() {
+			monitor.checkGatewayCertificates(t.Context(), &resolvedGatewayDetails{})
+			monitor.forgetGateway(apitypes.NamespacedName{})
+		})
+	})
+}
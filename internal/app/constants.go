@@ -17,8 +17,38 @@ const (
 	GatewayUsedSecretsAnnotationPrefix = "secrets.oke-gateway-api.gemyago.github.io"
 
 	// GatewayProgrammedCertificatesAnnotation stores OCI certificate names programmed by the controller.
+	// The annotation only detects certificate changes, cleanup relies on OkeGatewayProgrammingState.
 	GatewayProgrammedCertificatesAnnotation = "oke-gateway-api.gemyago.github.io/gateway-programmed-certificates"
 
+	// GatewayListenerPolicyAnnotation stores the name and generation of the programmed OkeListenerPolicy.
+	// The value is empty if the gateway has no listener policy.
+	GatewayListenerPolicyAnnotation = "oke-gateway-api.gemyago.github.io/gateway-listener-policy"
+
+	// GatewayAccessPoliciesAnnotation stores names and generations of OkeAccessPolicies targeting the gateway.
+	GatewayAccessPoliciesAnnotation = "oke-gateway-api.gemyago.github.io/gateway-access-policies"
+
+	// GatewayListenerClientCAAnnotation stores hashes of the programmed client CAs of the gateway listeners.
+	// Non-empty value indicates that CA bundles of the gateway may need to be cleaned up.
+	GatewayListenerClientCAAnnotation = "oke-gateway-api.gemyago.github.io/gateway-listener-client-ca"
+
+	// GatewayDefaultBackendServiceAnnotation stores the name and resource version of the default backend
+	// Service the default backend set health check was derived from. The value is empty without the Service.
+	GatewayDefaultBackendServiceAnnotation = "oke-gateway-api.gemyago.github.io/gateway-default-backend-service"
+
+	// GatewayDNSRecordsAnnotation stores the OCI DNS zone and the listener hostnames with DNS records
+	// managed for the gateway. The value is empty if the gateway has no DNS records.
+	GatewayDNSRecordsAnnotation = "oke-gateway-api.gemyago.github.io/gateway-dns-records"
+
+	// GatewayNetworkSecurityGroupAnnotation stores the OCID of the NSG with security rules managed
+	// for the gateway listeners. The value is empty if the gateway has no NSG rules.
+	GatewayNetworkSecurityGroupAnnotation = "oke-gateway-api.gemyago.github.io/gateway-nsg"
+
+	// GatewayNetworkSecurityGroupFinalizer is used to remove NSG security rules of deleted gateways.
+	GatewayNetworkSecurityGroupFinalizer = "oke-gateway-api.gemyago.github.io/gateway-nsg-rules"
+
+	// GatewayDNSRecordsFinalizer is used to remove DNS records of deleted gateways.
+	GatewayDNSRecordsFinalizer = "oke-gateway-api.gemyago.github.io/gateway-dns-records"
+
 	// ListenerTLSOptionOCICertificateOCID configures an existing OCI Certificates Service certificate for a listener.
 	ListenerTLSOptionOCICertificateOCID = "oci.oraclecloud.com/certificate-ocid"
 
@@ -28,6 +58,21 @@ const (
 	// ListenerTLSOptionCipherSuiteName configures OCI listener SSL cipher suite name.
 	ListenerTLSOptionCipherSuiteName = "oci.oraclecloud.com/cipher-suite-name"
 
+	// ListenerTLSOptionMinTLSVersion configures the lowest OCI listener SSL protocol accepted by the listener.
+	// All supported protocols starting from this version are enabled unless protocols are set explicitly.
+	ListenerTLSOptionMinTLSVersion = "oci.oraclecloud.com/min-tls-version"
+
+	// ListenerTLSOptionListenerProtocol configures the OCI listener protocol of an HTTPS listener: HTTP
+	// (HTTP/1.1, default) or HTTP2. OCI reaches backends of HTTP2 listeners over HTTP/2 with TLS only.
+	ListenerTLSOptionListenerProtocol = "oci.oraclecloud.com/listener-protocol"
+
+	// ListenerTLSOptionClientCAConfigMap enables client certificate verification on the listener.
+	// The value is the name of a ConfigMap in the Gateway namespace with CA certificates in the ca.crt key.
+	ListenerTLSOptionClientCAConfigMap = "oci.oraclecloud.com/client-ca-configmap"
+
+	// ListenerTLSOptionClientVerifyDepth configures the maximum depth of the client certificate chain. Defaults to 1.
+	ListenerTLSOptionClientVerifyDepth = "oci.oraclecloud.com/client-verify-depth"
+
 	// BackendTLSPolicyProgrammedFinalizer is used to clean up controller-managed OCI CA bundles.
 	BackendTLSPolicyProgrammedFinalizer = "oke-gateway-api.gemyago.github.io/backend-tls-policy-programmed"
 
@@ -52,24 +97,95 @@ const (
 	// BackendTLSOptionSessionResumption configures OCI backend SSL session resumption.
 	BackendTLSOptionSessionResumption = "oci.oraclecloud.com/session-resumption"
 
+	// BackendWeightAnnotation is a Pod annotation that sets OCI load balancer backend weight (1-100)
+	// of the pod endpoints.
+	BackendWeightAnnotation = "oke-gateway-api.gemyago.github.io/backend-weight"
+
+	// BackendMaxConnectionsAnnotation is a Pod annotation that sets OCI load balancer backend
+	// max connections (256-65535) of the pod endpoints.
+	BackendMaxConnectionsAnnotation = "oke-gateway-api.gemyago.github.io/backend-max-connections"
+
+	// BackendHealthCheckPortAnnotation is a Service annotation that sets the port probed by the
+	// OCI load balancer health checker of the backend set, e.g. a readiness endpoint served on
+	// another port than the traffic. Defaults to the backend port.
+	BackendHealthCheckPortAnnotation = "oke-gateway-api.gemyago.github.io/health-check-port"
+
+	// BackendHealthCheckProtocolAnnotation is a Service annotation that sets the health check
+	// protocol of the backend set, either TCP, HTTP or GRPC. Defaults to TCP. GRPC checks the
+	// HTTP health endpoint served on the health check port, or the backend port with TCP.
+	BackendHealthCheckProtocolAnnotation = "oke-gateway-api.gemyago.github.io/health-check-protocol"
+
+	// BackendHealthCheckPathAnnotation is a Service annotation that sets the path of HTTP
+	// health check requests. Defaults to /.
+	BackendHealthCheckPathAnnotation = "oke-gateway-api.gemyago.github.io/health-check-path"
+
+	// BackendHealthCheckReturnCodeAnnotation is a Service annotation that sets the expected status
+	// code of HTTP health check responses. Defaults to 200.
+	BackendHealthCheckReturnCodeAnnotation = "oke-gateway-api.gemyago.github.io/health-check-return-code"
+
+	// BackendHealthCheckResponseBodyRegexAnnotation is a Service annotation that sets the regular
+	// expression HTTP health check response bodies must match.
+	BackendHealthCheckResponseBodyRegexAnnotation = "oke-gateway-api.gemyago.github.io/" +
+		"health-check-response-body-regex"
+
+	// BackendHealthCheckIntervalAnnotation is a Service annotation that sets the interval between
+	// health checks as a duration, e.g. 10s.
+	BackendHealthCheckIntervalAnnotation = "oke-gateway-api.gemyago.github.io/health-check-interval"
+
+	// BackendHealthCheckTimeoutAnnotation is a Service annotation that sets the timeout of
+	// health checks as a duration, e.g. 3s.
+	BackendHealthCheckTimeoutAnnotation = "oke-gateway-api.gemyago.github.io/health-check-timeout"
+
+	// BackendHealthCheckRetriesAnnotation is a Service annotation that sets the number of
+	// failed health checks before the backend is considered unhealthy.
+	BackendHealthCheckRetriesAnnotation = "oke-gateway-api.gemyago.github.io/health-check-retries"
+
+	// BackendCASecretAnnotation is a Service annotation with the name of a Secret in the Service
+	// namespace holding the ca.crt CA bundle. Backend sets of the Service use TLS and verify the
+	// backend certificates with it. BackendTLSPolicies targeting the Service take precedence.
+	BackendCASecretAnnotation = "oke-gateway-api.gemyago.github.io/backend-ca-secret"
+
+	// PodReadinessGateBackendHealthy is the Pod readiness gate condition type managed by the controller.
+	// It becomes True once the OCI load balancer reports the pod backend as healthy.
+	PodReadinessGateBackendHealthy = "oke-gateway-api.gemyago.github.io/backend-healthy"
+
+	// PausedAnnotation pauses programming of OCI resources for a Gateway or HTTPRoute when set to "true".
+	// Routes attached to a paused Gateway are not programmed either.
+	PausedAnnotation = "oke-gateway-api.gemyago.github.io/paused"
+
+	// ProtectedListenersAnnotation is a comma separated list of Gateway listener names. OCI listeners
+	// of the named listeners are not deleted when the listeners are removed from the Gateway spec.
+	ProtectedListenersAnnotation = "oke-gateway-api.gemyago.github.io/protected-listeners"
+
+	// WorkRequestHistoryAnnotation stores the last OCI work requests awaited while reconciling a Gateway,
+	// HTTPRoute or GRPCRoute as a JSON array, the most recent last.
+	WorkRequestHistoryAnnotation = "oke-gateway-api.gemyago.github.io/work-requests"
+
 	// HTTPRouteProgrammingRevisionAnnotation is the annotation for the http route programming revision.
 	// The revision may be incremented if additional programming steps are introduced by the controller.
 	HTTPRouteProgrammingRevisionAnnotation = "oke-gateway-api.gemyago.github.io/http-route-programming-revision"
 
 	// HTTPRouteProgrammedPolicyRulesAnnotation is a comma-separated list of load balancer listener/policy rule names.
-	// The value is set by the controller when the http route is programmed.
+	// The value was set by older versions of the controller, bare or suffixed with a hash of the parent gateway.
+	// It is only read for http routes not programmed since rules are recorded in OkeGatewayProgrammingState.
 	HTTPRouteProgrammedPolicyRulesAnnotation = "oke-gateway-api.gemyago.github.io/http-route-programmed-lb-policy-rules"
 
 	// HTTPRouteProgrammedFinalizer is the finalizer that indicates that the http route has been programmed.
 	// It is used to clean up the resources when the http route is deleted.
 	HTTPRouteProgrammedFinalizer = "oke-gateway-api.gemyago.github.io/http-route-programmed"
 
+	// HTTPRouteRulePriorityAnnotation sets the priority (-999 to 999) of the http route rules
+	// within the listener routing policy. Rules with higher priority are evaluated first, rules
+	// without the annotation have priority 0.
+	HTTPRouteRulePriorityAnnotation = "oke-gateway-api.gemyago.github.io/rule-priority"
+
 	// GRPCRouteProgrammingRevisionAnnotation is the annotation for the grpc route programming revision.
 	// The revision may be incremented if additional programming steps are introduced by the controller.
 	GRPCRouteProgrammingRevisionAnnotation = "oke-gateway-api.gemyago.github.io/grpc-route-programming-revision"
 
 	// GRPCRouteProgrammedPolicyRulesAnnotation is a comma-separated list of load balancer listener/policy rule names.
-	// The value is set by the controller when the grpc route is programmed.
+	// The value was set by older versions of the controller, bare or suffixed with a hash of the parent gateway.
+	// It is only read for grpc routes not programmed since rules are recorded in OkeGatewayProgrammingState.
 	GRPCRouteProgrammedPolicyRulesAnnotation = "oke-gateway-api.gemyago.github.io/grpc-route-programmed-lb-policy-rules"
 
 	// GRPCRouteProgrammedFinalizer is the finalizer that indicates that the grpc route has been programmed.
@@ -78,7 +194,7 @@ const (
 
 	// GatewayProgrammingRevisionValue is the value for the gateway programming revision.
 	// Incremented when the controller programming steps are changed.
-	GatewayProgrammingRevisionValue = "2"
+	GatewayProgrammingRevisionValue = "4"
 
 	// NetworkLoadBalancerGatewayProgrammingRevisionAnnotation is the annotation for the L4 gateway programming revision.
 	// The revision may be incremented if additional NLB programming steps are introduced by the controller.
@@ -133,17 +249,81 @@ const (
 
 	// HTTPRouteProgrammingRevisionValue is the value for the http route programming revision.
 	// Incremented when the controller programming steps are changed.
-	HTTPRouteProgrammingRevisionValue = "5"
+	HTTPRouteProgrammingRevisionValue = "6"
 
 	// GRPCRouteProgrammingRevisionValue is the value for the grpc route programming revision.
 	// Incremented when the controller programming steps are changed.
-	GRPCRouteProgrammingRevisionValue = "2"
+	GRPCRouteProgrammingRevisionValue = "3"
 )
 
 const ConfigRefGroup = "oke-gateway-api.gemyago.github.io"
 const ConfigRefKind = "GatewayConfig"
 
+// Load balancer types of the GatewayConfig. The type must match the data plane of the
+// GatewayClass controller: the OCI Load Balancer for application and the OCI Network Load
+// Balancer for network load balancers.
+const (
+	GatewayConfigLoadBalancerTypeApplication = "application"
+	GatewayConfigLoadBalancerTypeNetwork     = "network"
+)
+
+// GatewayConfigConditionInUse indicates that the GatewayConfig is referenced by Gateways.
+// The CRD rejects loadBalancerId changes while the condition is True.
+const (
+	GatewayConfigConditionInUse      = "InUse"
+	GatewayConfigReasonReferenced    = "ReferencedByGateways"
+	GatewayConfigReasonNotReferenced = "NotReferenced"
+)
+
+// GatewayConfigConditionLoadBalancerReachable indicates that the load balancers referenced by
+// the GatewayConfig can be read with the OCI credentials of the controller.
+const (
+	GatewayConfigConditionLoadBalancerReachable = "LoadBalancerReachable"
+	GatewayConfigReasonReachable                = "Reachable"
+	GatewayConfigReasonNotFound                 = "NotFound"
+	GatewayConfigReasonNotAuthorized            = "NotAuthorized"
+	GatewayConfigReasonUnreachable              = "Unreachable"
+)
+
+// HTTPRouteConditionBackendsHealthy reports the OCI health of the backends serving the route.
+// The condition is only maintained when backend health reporting is enabled.
+const (
+	HTTPRouteConditionBackendsHealthy = "BackendsHealthy"
+	HTTPRouteReasonBackendsHealthy    = "Healthy"
+	HTTPRouteReasonBackendsUnhealthy  = "Unhealthy"
+	HTTPRouteReasonBackendsPending    = "Pending"
+)
+
+// ConditionPaused reports that programming of a Gateway or HTTPRoute is paused with PausedAnnotation.
+// The condition is set to False once the resource is resumed.
+const (
+	ConditionPaused = "Paused"
+	ReasonPaused    = "ReconciliationPaused"
+	ReasonResumed   = "ReconciliationResumed"
+)
+
+// GatewayReasonListenerInUse is the Programmed condition reason reported when listeners removed
+// from the Gateway spec are kept since they still have routing policy rules of existing routes.
+const GatewayReasonListenerInUse = "ListenerInUse"
+
+// GatewayReasonListenerProtected is the Programmed condition reason reported when listeners removed
+// from the Gateway spec are kept since they are listed in the ProtectedListenersAnnotation.
+const GatewayReasonListenerProtected = "ListenerProtected"
+
+// ExternalBackendKind is the backendRef kind of the OkeExternalBackend resource.
+const ExternalBackendKind = "OkeExternalBackend"
+
 func isSupportedControllerClassName(controllerName gatewayv1.GatewayController) bool {
 	return controllerName == ControllerClassName ||
 		controllerName == NetworkLoadBalancerControllerClassName
 }
+
+// RouteConditionProgrammed reports whether the latest changes of an HTTPRoute or GRPCRoute are
+// programmed to the load balancer. Gateway API does not define the condition for routes, so it
+// mirrors the Programmed condition of the Gateway: Unknown while the changes are programmed,
+// True once they are programmed and False if the route is rejected.
+const (
+	RouteConditionProgrammed = "Programmed"
+	RouteReasonProgrammed    = "Programmed"
+	RouteReasonPending       = "Pending"
+)
@@ -23,6 +23,18 @@ func driftRequeue(interval time.Duration) reconcile.Result {
 	return reconcile.Result{RequeueAfter: interval}
 }
 
+// shortestRequeueInterval returns the shortest of the enabled (positive) intervals,
+// or zero if none of them is enabled.
+func shortestRequeueInterval(intervals ...time.Duration) time.Duration {
+	var shortest time.Duration
+	for _, interval := range intervals {
+		if interval > 0 && (shortest == 0 || interval < shortest) {
+			shortest = interval
+		}
+	}
+	return shortest
+}
+
 func shouldProgramRoute(programmingRequired bool, driftInterval time.Duration) bool {
 	return programmingRequired || driftInterval > 0
 }
@@ -25,6 +25,12 @@ func TestDriftRequeue(t *testing.T) {
 	})
 }
 
+func TestShortestRequeueInterval(t *testing.T) {
+	assert.Equal(t, time.Duration(0), shortestRequeueInterval(0, 0))
+	assert.Equal(t, 5*time.Minute, shortestRequeueInterval(0, 5*time.Minute))
+	assert.Equal(t, 2*time.Minute, shortestRequeueInterval(10*time.Minute, 2*time.Minute, -time.Minute))
+}
+
 func assertDriftRequeue(t *testing.T, result reconcile.Result, interval time.Duration) {
 	t.Helper()
 
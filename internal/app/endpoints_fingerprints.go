@@ -0,0 +1,143 @@
+package app
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// endpointsFingerprintTTL limits how long the recorded fingerprint is trusted,
+// so changes of backend sets made outside of the controller are still corrected.
+const endpointsFingerprintTTL = 10 * time.Minute
+
+// endpointsFingerprints records fingerprints of the endpoints last programmed to backend sets.
+// Routes are reconciled on changes of EndpointSlices of any of their backends, so syncs of
+// backend sets with unchanged endpoints are skipped without reading the backend set from OCI.
+// Nil value disables the skipping.
+type endpointsFingerprints struct {
+	mu      sync.Mutex
+	entries map[string]endpointsFingerprint
+	now     func() time.Time
+}
+
+type endpointsFingerprint struct {
+	value      string
+	recordedAt time.Time
+}
+
+func newEndpointsFingerprints() *endpointsFingerprints {
+	return &endpointsFingerprints{
+		entries: make(map[string]endpointsFingerprint),
+		now:     time.Now,
+	}
+}
+
+func endpointsFingerprintKey(loadBalancerID, backendSetName string) string {
+	return loadBalancerID + "/" + backendSetName
+}
+
+// recorded reports whether there is a not expired fingerprint of the backend set.
+func (f *endpointsFingerprints) recorded(key string) bool {
+	if f == nil {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	entry, ok := f.entries[key]
+	return ok && f.now().Sub(entry.recordedAt) < endpointsFingerprintTTL
+}
+
+// matches reports whether the fingerprint equals the not expired fingerprint of the backend set.
+func (f *endpointsFingerprints) matches(key, value string) bool {
+	if f == nil {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	entry, ok := f.entries[key]
+	return ok && entry.value == value && f.now().Sub(entry.recordedAt) < endpointsFingerprintTTL
+}
+
+func (f *endpointsFingerprints) record(key, value string) {
+	if f == nil || value == "" {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.entries[key] = endpointsFingerprint{value: value, recordedAt: f.now()}
+}
+
+// forget drops the fingerprint of the backend set, e.g. when the backend set is created,
+// deleted or its backends are changed by other means than the endpoints sync.
+func (f *endpointsFingerprints) forget(key string) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.entries, key)
+}
+
+// endpointSlicesFingerprint returns the fingerprint of the EndpointSlice fields that define
+// the backends of the backend set. Changes of other fields, such as labels or topology hints
+// of backends without topology aware registration, keep the fingerprint. The backend annotation
+// values of the endpoint target Pods are included as well, keyed by the Pod.
+func endpointSlicesFingerprint(
+	params identifyBackendSetBackendsParams,
+	endpointSlices []discoveryv1.EndpointSlice,
+	podBackendAnnotations map[client.ObjectKey][]string,
+) string {
+	hash := sha256.New()
+	write := func(parts ...string) {
+		for _, part := range parts {
+			hash.Write([]byte(part))
+			hash.Write([]byte{0})
+		}
+	}
+	boolPtr := func(value *bool) string {
+		return lo.Ternary(value == nil, "-", strconv.FormatBool(lo.FromPtr(value)))
+	}
+
+	write(
+		strconv.Itoa(int(lo.FromPtr(params.backendRef.Port))),
+		fmt.Sprint(params.ipFamilies),
+		fmt.Sprintf("%+v", lo.FromPtr(params.topology)),
+	)
+	sortedSlices := slices.SortedFunc(slices.Values(endpointSlices), func(a, b discoveryv1.EndpointSlice) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	for _, slice := range sortedSlices {
+		write(slice.Name, string(slice.AddressType))
+		for _, port := range slice.Ports {
+			write(lo.FromPtr(port.Name), strconv.Itoa(int(lo.FromPtr(port.Port))))
+		}
+		for _, endpoint := range slice.Endpoints {
+			write(endpoint.Addresses...)
+			write(
+				boolPtr(endpoint.Conditions.Ready),
+				boolPtr(endpoint.Conditions.Serving),
+				boolPtr(endpoint.Conditions.Terminating),
+				lo.FromPtr(endpoint.Zone),
+			)
+			if endpoint.TargetRef != nil {
+				write(endpoint.TargetRef.Kind, endpoint.TargetRef.Name)
+			}
+			if podKey, ok := endpointPodKey(slice.Namespace, endpoint); ok {
+				write(podBackendAnnotations[podKey]...)
+			}
+			if params.topology != nil && endpoint.Hints != nil {
+				write(fmt.Sprint(endpoint.Hints.ForZones))
+			}
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
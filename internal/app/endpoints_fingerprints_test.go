@@ -0,0 +1,141 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestEndpointsFingerprints(t *testing.T) {
+	t.Run("matches recorded fingerprint", func(t *testing.T) {
+		fingerprints := newEndpointsFingerprints()
+		key := faker.New().UUID().V4()
+
+		fingerprints.record(key, "fingerprint")
+
+		assert.True(t, fingerprints.recorded(key))
+		assert.True(t, fingerprints.matches(key, "fingerprint"))
+		assert.False(t, fingerprints.matches(key, "other"))
+		assert.False(t, fingerprints.matches(faker.New().UUID().V4(), "fingerprint"))
+	})
+
+	t.Run("expires recorded fingerprint", func(t *testing.T) {
+		fingerprints := newEndpointsFingerprints()
+		now := time.Now()
+		fingerprints.now = func() time.Time { return now }
+		key := faker.New().UUID().V4()
+		fingerprints.record(key, "fingerprint")
+
+		now = now.Add(endpointsFingerprintTTL)
+
+		assert.False(t, fingerprints.recorded(key))
+		assert.False(t, fingerprints.matches(key, "fingerprint"))
+	})
+
+	t.Run("forgets recorded fingerprint", func(t *testing.T) {
+		fingerprints := newEndpointsFingerprints()
+		key := faker.New().UUID().V4()
+		fingerprints.record(key, "fingerprint")
+
+		fingerprints.forget(key)
+
+		assert.False(t, fingerprints.matches(key, "fingerprint"))
+	})
+
+	t.Run("is disabled when nil", func(t *testing.T) {
+		var fingerprints *endpointsFingerprints
+		key := faker.New().UUID().V4()
+
+		fingerprints.record(key, "fingerprint")
+		fingerprints.forget(key)
+
+		assert.False(t, fingerprints.recorded(key))
+		assert.False(t, fingerprints.matches(key, "fingerprint"))
+	})
+}
+
+func TestEndpointSlicesFingerprint(t *testing.T) {
+	makeSlices := func() []discoveryv1.EndpointSlice {
+		first := makeRandomEndpointSlice()
+		first.Endpoints = makeFewRandomEndpoints(2, randomEndpointWithConditionsOpt(new(true), new(false)))
+		second := makeRandomEndpointSlice()
+		second.Endpoints = makeFewRandomEndpoints(1)
+		return []discoveryv1.EndpointSlice{first, second}
+	}
+	params := identifyBackendSetBackendsParams{
+		backendRef: makeRandomBackendRef().BackendRef,
+	}
+
+	t.Run("ignores order of slices and not relevant changes", func(t *testing.T) {
+		endpointSlices := makeSlices()
+		want := endpointSlicesFingerprint(params, endpointSlices, nil)
+
+		changed := []discoveryv1.EndpointSlice{*endpointSlices[1].DeepCopy(), *endpointSlices[0].DeepCopy()}
+		changed[0].ResourceVersion = faker.New().UUID().V4()
+		changed[0].Labels["extra"] = faker.New().Lorem().Word()
+		changed[1].Endpoints[0].Hints = &discoveryv1.EndpointHints{
+			ForZones: []discoveryv1.ForZone{{Name: faker.New().Lorem().Word()}},
+		}
+
+		assert.Equal(t, want, endpointSlicesFingerprint(params, changed, nil))
+	})
+
+	t.Run("changes with endpoint conditions", func(t *testing.T) {
+		endpointSlices := makeSlices()
+		want := endpointSlicesFingerprint(params, endpointSlices, nil)
+
+		endpointSlices[0].Endpoints[1].Conditions.Terminating = new(true)
+
+		assert.NotEqual(t, want, endpointSlicesFingerprint(params, endpointSlices, nil))
+	})
+
+	t.Run("changes with endpoint addresses", func(t *testing.T) {
+		endpointSlices := makeSlices()
+		want := endpointSlicesFingerprint(params, endpointSlices, nil)
+
+		endpointSlices[1].Endpoints = append(endpointSlices[1].Endpoints, makeRandomEndpoint())
+
+		assert.NotEqual(t, want, endpointSlicesFingerprint(params, endpointSlices, nil))
+	})
+
+	t.Run("changes with backend annotations of endpoint pods", func(t *testing.T) {
+		endpointSlices := makeSlices()
+		endpointSlices[0].Endpoints[0].TargetRef = &corev1.ObjectReference{
+			Kind: "Pod",
+			Name: faker.New().Internet().Slug(),
+		}
+		podKey := client.ObjectKey{
+			Namespace: endpointSlices[0].Namespace,
+			Name:      endpointSlices[0].Endpoints[0].TargetRef.Name,
+		}
+		want := endpointSlicesFingerprint(params, endpointSlices, map[client.ObjectKey][]string{
+			podKey: {"", ""},
+		})
+
+		got := endpointSlicesFingerprint(params, endpointSlices, map[client.ObjectKey][]string{
+			podKey: {"10", ""},
+		})
+
+		assert.NotEqual(t, want, got)
+	})
+
+	t.Run("changes with topology hints of topology aware backends", func(t *testing.T) {
+		topologyParams := params
+		topologyParams.topology = &types.GatewayConfigBackendTopology{Zones: []string{"ad-1"}}
+		endpointSlices := makeSlices()
+		want := endpointSlicesFingerprint(topologyParams, endpointSlices, nil)
+
+		endpointSlices[0].Endpoints[0].Hints = &discoveryv1.EndpointHints{
+			ForZones: []discoveryv1.ForZone{{Name: "ad-1"}},
+		}
+
+		assert.NotEqual(t, want, endpointSlicesFingerprint(topologyParams, endpointSlices, nil))
+	})
+}
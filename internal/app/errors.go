@@ -1,12 +1,20 @@
 package app
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+)
 
 type resourceStatusError struct {
 	conditionType string
 	reason        string
 	message       string
 	cause         error
+
+	// listenerName is set if the error is related to the gateway listener. The condition
+	// is reported in the listener status and the gateway is not programmed then.
+	listenerName string
 }
 
 func (e resourceStatusError) Error() string {
@@ -18,6 +26,10 @@ func (e resourceStatusError) Error() string {
 	return fmt.Sprintf("resourceStatusError: type=%s, reason=%s, message=%s", e.conditionType, e.reason, e.message)
 }
 
+func (e resourceStatusError) Unwrap() error {
+	return e.cause
+}
+
 type ReconcileError struct {
 	message   string
 	retriable bool
@@ -49,3 +61,14 @@ func (e ReconcileError) Error() string {
 func (e ReconcileError) IsRetriable() bool {
 	return e.retriable
 }
+
+// ociTerminalErrorMessage returns the status message of the terminal OCI error, like an
+// exceeded service limit or an invalid parameter. Terminal errors are reported in the
+// resource status instead of being retried. Returns false for transient errors.
+func ociTerminalErrorMessage(err error) (string, bool) {
+	errorClass := ociapi.ClassifyError(err)
+	if !errorClass.IsTerminal() {
+		return "", false
+	}
+	return fmt.Sprintf("OCI rejected the request (%s): %s", errorClass, err), true
+}
@@ -0,0 +1,146 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+// isExternalBackendRef returns true if the backendRef points to the OkeExternalBackend
+// resource rather than to a Service.
+func isExternalBackendRef(backendRef gatewayv1.BackendObjectReference) bool {
+	return string(lo.FromPtr(backendRef.Group)) == types.GroupName &&
+		string(lo.FromPtr(backendRef.Kind)) == ExternalBackendKind
+}
+
+// getExternalBackend gets the OkeExternalBackend by name. Missing resource
+// or CRD is reported as not found rather than an error.
+func getExternalBackend(
+	ctx context.Context,
+	k8sClient k8sClient,
+	fullName apitypes.NamespacedName,
+) (types.OkeExternalBackend, bool, error) {
+	var externalBackend types.OkeExternalBackend
+	if err := k8sClient.Get(ctx, fullName, &externalBackend); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return types.OkeExternalBackend{}, false, nil
+		}
+		return types.OkeExternalBackend{}, false, fmt.Errorf(
+			"failed to get external backend %s: %w", fullName.String(), err)
+	}
+	return externalBackend, true, nil
+}
+
+// checkExternalBackendRef verifies the OkeExternalBackend referenced by the route can be
+// used. Empty reason is returned if it can, otherwise the reason and message describe why not.
+// Cross namespace references are not supported since ReferenceGrants only cover Services here.
+func checkExternalBackendRef(
+	ctx context.Context,
+	k8sClient k8sClient,
+	routeNamespace string,
+	fullName apitypes.NamespacedName,
+) (gatewayv1.RouteConditionReason, string, error) {
+	if fullName.Namespace != routeNamespace {
+		return gatewayv1.RouteReasonRefNotPermitted, fmt.Sprintf(
+			"backendRef %s %s must be in the route namespace", ExternalBackendKind, fullName.String(),
+		), nil
+	}
+	_, found, err := getExternalBackend(ctx, k8sClient, fullName)
+	if err != nil {
+		return "", "", err
+	}
+	if !found {
+		return gatewayv1.RouteReasonBackendNotFound, fmt.Sprintf(
+			"backendRef %s %s not found", ExternalBackendKind, fullName.String(),
+		), nil
+	}
+	return "", "", nil
+}
+
+// resolveL7ExternalBackends gets all OkeExternalBackend resources referenced by the route
+// keyed by the namespaced name.
+func resolveL7ExternalBackends(
+	ctx context.Context,
+	k8sClient k8sClient,
+	routeNamespace string,
+	backendRefs []gatewayv1.BackendRef,
+) (map[string]types.OkeExternalBackend, error) {
+	externalBackends := make(map[string]types.OkeExternalBackend)
+	for _, backendRef := range backendRefs {
+		if !isExternalBackendRef(backendRef.BackendObjectReference) {
+			continue
+		}
+		fullName := backendObjectRefName(backendRef.BackendObjectReference, routeNamespace)
+		if _, ok := externalBackends[fullName.String()]; ok {
+			continue
+		}
+		externalBackend, found, err := getExternalBackend(ctx, k8sClient, fullName)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, fmt.Errorf("resolved external backend %s not found", fullName.String())
+		}
+		externalBackends[fullName.String()] = externalBackend
+	}
+	return externalBackends, nil
+}
+
+// externalBackendHealthChecker builds the backend set health checker from the
+// OkeExternalBackend health check. TCP check of the first endpoint port is used by default.
+func externalBackendHealthChecker(externalBackend types.OkeExternalBackend) loadbalancer.HealthCheckerDetails {
+	var defaultPort int
+	if len(externalBackend.Spec.Endpoints) > 0 {
+		defaultPort = int(externalBackend.Spec.Endpoints[0].Port)
+	}
+	return backendSetHealthChecker(lo.FromPtr(externalBackend.Spec.HealthCheck), defaultPort)
+}
+
+// identifyExternalBackendsToUpdate compares the backend set backends with the
+// endpoints of the OkeExternalBackend.
+func identifyExternalBackendsToUpdate(
+	currentBackends []loadbalancer.Backend,
+	externalBackend types.OkeExternalBackend,
+) identifyBackendsToUpdateResult {
+	currentKeys := make(map[httpBackendAddressKey]struct{}, len(currentBackends))
+	for _, backend := range currentBackends {
+		currentKeys[httpBackendAddressKey{
+			ipAddress: lo.FromPtr(backend.IpAddress),
+			port:      lo.FromPtr(backend.Port),
+		}] = struct{}{}
+	}
+
+	desiredKeys := make(map[httpBackendAddressKey]struct{}, len(externalBackend.Spec.Endpoints))
+	updatedBackends := make([]loadbalancer.BackendDetails, 0, len(externalBackend.Spec.Endpoints))
+	for _, endpoint := range externalBackend.Spec.Endpoints {
+		key := httpBackendAddressKey{ipAddress: endpoint.IP, port: int(endpoint.Port)}
+		if _, ok := desiredKeys[key]; ok {
+			continue
+		}
+		desiredKeys[key] = struct{}{}
+		updatedBackends = append(updatedBackends, loadbalancer.BackendDetails{
+			IpAddress: new(endpoint.IP),
+			Port:      new(int(endpoint.Port)),
+		})
+	}
+
+	updateRequired := len(currentKeys) != len(desiredKeys)
+	for key := range desiredKeys {
+		if _, ok := currentKeys[key]; !ok {
+			updateRequired = true
+		}
+	}
+
+	return identifyBackendsToUpdateResult{
+		updateRequired:  updateRequired,
+		updatedBackends: updatedBackends,
+	}
+}
@@ -0,0 +1,218 @@
+package app
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestExternalBackend(t *testing.T) {
+	t.Run("isExternalBackendRef", func(t *testing.T) {
+		assert.True(t, isExternalBackendRef(makeRandomBackendRef(
+			randomBackendRefWithExternalBackendOpt(),
+		).BackendObjectReference))
+		assert.False(t, isExternalBackendRef(makeRandomBackendRef().BackendObjectReference))
+		assert.False(t, isExternalBackendRef(gatewayv1.BackendObjectReference{
+			Group: new(gatewayv1.Group(faker.New().Internet().Domain())),
+			Kind:  new(gatewayv1.Kind(ExternalBackendKind)),
+		}))
+	})
+
+	t.Run("checkExternalBackendRef", func(t *testing.T) {
+		t.Run("resolved", func(t *testing.T) {
+			fake := faker.New()
+			k8sClient := NewMockk8sClient(t)
+			fullName := apitypes.NamespacedName{Namespace: fake.Internet().Domain(), Name: fake.Internet().Domain()}
+			setupClientGet(t, k8sClient, fullName, makeRandomExternalBackend(fullName.Namespace, fullName.Name))
+
+			reason, message, err := checkExternalBackendRef(t.Context(), k8sClient, fullName.Namespace, fullName)
+
+			require.NoError(t, err)
+			assert.Empty(t, reason)
+			assert.Empty(t, message)
+		})
+
+		t.Run("not found", func(t *testing.T) {
+			fake := faker.New()
+			k8sClient := NewMockk8sClient(t)
+			fullName := apitypes.NamespacedName{Namespace: fake.Internet().Domain(), Name: fake.Internet().Domain()}
+			k8sClient.EXPECT().Get(t.Context(), fullName, mock.Anything).Return(
+				apierrors.NewNotFound(schema.GroupResource{
+					Group:    types.GroupName,
+					Resource: "oke-external-backends",
+				}, fullName.Name),
+			)
+
+			reason, message, err := checkExternalBackendRef(t.Context(), k8sClient, fullName.Namespace, fullName)
+
+			require.NoError(t, err)
+			assert.Equal(t, gatewayv1.RouteReasonBackendNotFound, reason)
+			assert.Contains(t, message, fullName.String())
+		})
+
+		t.Run("cross namespace reference", func(t *testing.T) {
+			fake := faker.New()
+			fullName := apitypes.NamespacedName{Namespace: fake.Internet().Domain(), Name: fake.Internet().Domain()}
+
+			reason, message, err := checkExternalBackendRef(
+				t.Context(),
+				NewMockk8sClient(t),
+				"other-"+fullName.Namespace,
+				fullName,
+			)
+
+			require.NoError(t, err)
+			assert.Equal(t, gatewayv1.RouteReasonRefNotPermitted, reason)
+			assert.Contains(t, message, fullName.String())
+		})
+
+		t.Run("get error", func(t *testing.T) {
+			fake := faker.New()
+			k8sClient := NewMockk8sClient(t)
+			fullName := apitypes.NamespacedName{Namespace: fake.Internet().Domain(), Name: fake.Internet().Domain()}
+			wantErr := errors.New(fake.Lorem().Sentence(5))
+			k8sClient.EXPECT().Get(t.Context(), fullName, mock.Anything).Return(wantErr)
+
+			_, _, err := checkExternalBackendRef(t.Context(), k8sClient, fullName.Namespace, fullName)
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("resolveL7ExternalBackends", func(t *testing.T) {
+		fake := faker.New()
+		k8sClient := NewMockk8sClient(t)
+		routeNamespace := fake.Internet().Domain()
+		externalRef := makeRandomBackendRef(randomBackendRefWithExternalBackendOpt()).BackendRef
+		externalBackend := makeRandomExternalBackend(routeNamespace, string(externalRef.Name))
+		setupClientGet(t, k8sClient, apitypes.NamespacedName{
+			Namespace: routeNamespace,
+			Name:      externalBackend.Name,
+		}, externalBackend).Once()
+
+		externalBackends, err := resolveL7ExternalBackends(t.Context(), k8sClient, routeNamespace, []gatewayv1.BackendRef{
+			externalRef,
+			makeRandomBackendRef().BackendRef,
+			externalRef,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]types.OkeExternalBackend{
+			routeNamespace + "/" + externalBackend.Name: externalBackend,
+		}, externalBackends)
+	})
+
+	t.Run("externalBackendHealthChecker", func(t *testing.T) {
+		t.Run("defaults to TCP check of the first endpoint port", func(t *testing.T) {
+			externalBackend := makeRandomExternalBackend(faker.New().Internet().Domain(), faker.New().Internet().Domain())
+
+			assert.Equal(t, loadbalancer.HealthCheckerDetails{
+				Protocol: new("TCP"),
+				Port:     new(int(externalBackend.Spec.Endpoints[0].Port)),
+			}, externalBackendHealthChecker(externalBackend))
+		})
+
+		t.Run("HTTP check with defaults", func(t *testing.T) {
+			externalBackend := makeRandomExternalBackend(faker.New().Internet().Domain(), faker.New().Internet().Domain())
+			externalBackend.Spec.HealthCheck = &types.OkeExternalBackendHealthCheck{Protocol: "HTTP"}
+
+			assert.Equal(t, loadbalancer.HealthCheckerDetails{
+				Protocol:   new("HTTP"),
+				Port:       new(int(externalBackend.Spec.Endpoints[0].Port)),
+				UrlPath:    new("/"),
+				ReturnCode: new(200),
+			}, externalBackendHealthChecker(externalBackend))
+		})
+
+		t.Run("explicit settings", func(t *testing.T) {
+			fake := faker.New()
+			externalBackend := makeRandomExternalBackend(fake.Internet().Domain(), fake.Internet().Domain())
+			healthCheck := &types.OkeExternalBackendHealthCheck{
+				Protocol:          "HTTP",
+				Port:              1 + rand.Int32N(65534),
+				URLPath:           "/" + fake.Lorem().Word(),
+				ReturnCode:        204,
+				ResponseBodyRegex: "^" + fake.Lorem().Word() + "$",
+				IntervalMillis:    1000 + rand.Int32N(9000),
+				TimeoutMillis:     1000 + rand.Int32N(9000),
+				Retries:           1 + rand.Int32N(9),
+			}
+			externalBackend.Spec.HealthCheck = healthCheck
+
+			assert.Equal(t, loadbalancer.HealthCheckerDetails{
+				Protocol:          new("HTTP"),
+				Port:              new(int(healthCheck.Port)),
+				UrlPath:           new(healthCheck.URLPath),
+				ReturnCode:        new(204),
+				ResponseBodyRegex: new(healthCheck.ResponseBodyRegex),
+				IntervalInMillis:  new(int(healthCheck.IntervalMillis)),
+				TimeoutInMillis:   new(int(healthCheck.TimeoutMillis)),
+				Retries:           new(int(healthCheck.Retries)),
+			}, externalBackendHealthChecker(externalBackend))
+		})
+	})
+
+	t.Run("identifyExternalBackendsToUpdate", func(t *testing.T) {
+		makeCurrentBackends := func(externalBackend types.OkeExternalBackend) []loadbalancer.Backend {
+			backends := make([]loadbalancer.Backend, 0, len(externalBackend.Spec.Endpoints))
+			for _, endpoint := range externalBackend.Spec.Endpoints {
+				backends = append(backends, loadbalancer.Backend{
+					IpAddress: new(endpoint.IP),
+					Port:      new(int(endpoint.Port)),
+				})
+			}
+			return backends
+		}
+
+		t.Run("up to date", func(t *testing.T) {
+			externalBackend := makeRandomExternalBackend(faker.New().Internet().Domain(), faker.New().Internet().Domain())
+
+			result := identifyExternalBackendsToUpdate(makeCurrentBackends(externalBackend), externalBackend)
+
+			assert.False(t, result.updateRequired)
+			assert.Len(t, result.updatedBackends, len(externalBackend.Spec.Endpoints))
+		})
+
+		t.Run("endpoint added", func(t *testing.T) {
+			externalBackend := makeRandomExternalBackend(faker.New().Internet().Domain(), faker.New().Internet().Domain())
+			currentBackends := makeCurrentBackends(externalBackend)[:1]
+
+			result := identifyExternalBackendsToUpdate(currentBackends, externalBackend)
+
+			assert.True(t, result.updateRequired)
+			assert.Equal(t, []loadbalancer.BackendDetails{
+				{
+					IpAddress: new(externalBackend.Spec.Endpoints[0].IP),
+					Port:      new(int(externalBackend.Spec.Endpoints[0].Port)),
+				},
+				{
+					IpAddress: new(externalBackend.Spec.Endpoints[1].IP),
+					Port:      new(int(externalBackend.Spec.Endpoints[1].Port)),
+				},
+			}, result.updatedBackends)
+		})
+
+		t.Run("endpoint removed", func(t *testing.T) {
+			externalBackend := makeRandomExternalBackend(faker.New().Internet().Domain(), faker.New().Internet().Domain())
+			currentBackends := makeCurrentBackends(externalBackend)
+			externalBackend.Spec.Endpoints = externalBackend.Spec.Endpoints[:1]
+
+			result := identifyExternalBackendsToUpdate(currentBackends, externalBackend)
+
+			assert.True(t, result.updateRequired)
+			assert.Len(t, result.updatedBackends, 1)
+		})
+	})
+}
@@ -1,7 +1,10 @@
 package app
 
 import (
+	"math/rand/v2"
+
 	"github.com/jaswdr/faker/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/gemyago/oke-gateway-api/internal/types"
 )
@@ -15,6 +18,22 @@ func makeRandomGatewayConfig() types.GatewayConfig {
 	}
 }
 
+func makeRandomExternalBackend(namespace, name string) types.OkeExternalBackend {
+	fake := faker.New()
+	return types.OkeExternalBackend{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Spec: types.OkeExternalBackendSpec{
+			Endpoints: []types.OkeExternalBackendEndpoint{
+				{IP: fake.Internet().Ipv4(), Port: 1 + rand.Int32N(65534)},
+				{IP: fake.Internet().Ipv4(), Port: 1 + rand.Int32N(65534)},
+			},
+		},
+	}
+}
+
 type randomResolvedGatewayDetailsOpt func(*resolvedGatewayDetails)
 
 func makeRandomAcceptedGatewayDetails(
@@ -0,0 +1,121 @@
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	apitypes "k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// gatewayCheckpointTTL limits how long the steps completed by a failed attempt are trusted,
+// so changes made outside of the controller meanwhile are still corrected.
+const gatewayCheckpointTTL = 10 * time.Minute
+
+// gatewayCheckpoints records steps of the gateway programming completed by attempts that failed
+// later, so retries of the same gateway generation resume with the failed step instead of
+// repeating the completed ones and waiting for their work requests again. A step is only
+// skipped if its inputs are unchanged. Checkpoints are cleared once the gateway is programmed,
+// so later reconciles program all steps. Nil value disables the checkpointing.
+type gatewayCheckpoints struct {
+	mu      sync.Mutex
+	entries map[apitypes.NamespacedName]gatewayCheckpointsEntry
+	now     func() time.Time
+}
+
+type gatewayCheckpointsEntry struct {
+	generation int64
+	updatedAt  time.Time
+
+	// fingerprints of the inputs of the completed steps
+	steps map[string]string
+}
+
+func newGatewayCheckpoints() *gatewayCheckpoints {
+	return &gatewayCheckpoints{
+		entries: make(map[apitypes.NamespacedName]gatewayCheckpointsEntry),
+		now:     time.Now,
+	}
+}
+
+// completed reports whether the step was completed with the same inputs for the current
+// generation of the gateway.
+func (c *gatewayCheckpoints) completed(gateway *gatewayv1.Gateway, step, fingerprint string) bool {
+	if c == nil || fingerprint == "" {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[apitypes.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name}]
+	return ok &&
+		entry.generation == gateway.Generation &&
+		entry.steps[step] == fingerprint &&
+		c.now().Sub(entry.updatedAt) < gatewayCheckpointTTL
+}
+
+// record marks the step as completed. Steps of other generations are dropped.
+func (c *gatewayCheckpoints) record(gateway *gatewayv1.Gateway, step, fingerprint string) {
+	if c == nil || fingerprint == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := apitypes.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name}
+	entry, ok := c.entries[key]
+	if !ok || entry.generation != gateway.Generation {
+		entry = gatewayCheckpointsEntry{generation: gateway.Generation, steps: make(map[string]string)}
+	}
+	entry.steps[step] = fingerprint
+	entry.updatedAt = c.now()
+	c.entries[key] = entry
+}
+
+// clear drops the checkpoints of the gateway.
+func (c *gatewayCheckpoints) clear(gateway *gatewayv1.Gateway) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, apitypes.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name})
+}
+
+// gatewayCheckpointFingerprint returns the fingerprint of the step inputs.
+// Empty fingerprint is never recorded, so the step always runs.
+func gatewayCheckpointFingerprint(inputs ...any) string {
+	data, err := json.Marshal(inputs)
+	if err != nil {
+		return ""
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// runGatewayStep runs the programming step unless a previous attempt of the same gateway
+// generation completed it with the same inputs.
+func (m *gatewayModelImpl) runGatewayStep(
+	ctx context.Context,
+	gateway *gatewayv1.Gateway,
+	step string,
+	inputs []any,
+	run func() error,
+) error {
+	fingerprint := gatewayCheckpointFingerprint(inputs...)
+	if m.checkpoints.completed(gateway, step, fingerprint) {
+		m.logger.DebugContext(ctx, "Skipping step completed by previous attempt",
+			slog.String("gateway", gateway.Namespace+"/"+gateway.Name),
+			slog.String("step", step),
+		)
+		return nil
+	}
+	if err := run(); err != nil {
+		return err
+	}
+	m.checkpoints.record(gateway, step, fingerprint)
+	return nil
+}
@@ -0,0 +1,122 @@
+package app
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+)
+
+func TestGatewayCheckpoints(t *testing.T) {
+	t.Run("reports steps completed with the same inputs", func(t *testing.T) {
+		checkpoints := newGatewayCheckpoints()
+		gateway := newRandomGateway()
+		step := faker.New().Lorem().Word()
+		fingerprint := gatewayCheckpointFingerprint("listener", 80)
+
+		checkpoints.record(gateway, step, fingerprint)
+
+		assert.True(t, checkpoints.completed(gateway, step, fingerprint))
+		assert.False(t, checkpoints.completed(gateway, step, gatewayCheckpointFingerprint("listener", 443)))
+		assert.False(t, checkpoints.completed(gateway, faker.New().UUID().V4(), fingerprint))
+		assert.False(t, checkpoints.completed(newRandomGateway(), step, fingerprint))
+	})
+
+	t.Run("drops steps of other generations", func(t *testing.T) {
+		checkpoints := newGatewayCheckpoints()
+		gateway := newRandomGateway()
+		fingerprint := gatewayCheckpointFingerprint("listener")
+		checkpoints.record(gateway, "first", fingerprint)
+
+		gateway.Generation++
+		assert.False(t, checkpoints.completed(gateway, "first", fingerprint))
+
+		checkpoints.record(gateway, "second", fingerprint)
+		gateway.Generation--
+		assert.False(t, checkpoints.completed(gateway, "first", fingerprint))
+	})
+
+	t.Run("expires steps", func(t *testing.T) {
+		checkpoints := newGatewayCheckpoints()
+		now := time.Now()
+		checkpoints.now = func() time.Time { return now }
+		gateway := newRandomGateway()
+		fingerprint := gatewayCheckpointFingerprint("listener")
+		checkpoints.record(gateway, "step", fingerprint)
+
+		now = now.Add(gatewayCheckpointTTL)
+
+		assert.False(t, checkpoints.completed(gateway, "step", fingerprint))
+	})
+
+	t.Run("clears steps", func(t *testing.T) {
+		checkpoints := newGatewayCheckpoints()
+		gateway := newRandomGateway()
+		fingerprint := gatewayCheckpointFingerprint("listener")
+		checkpoints.record(gateway, "step", fingerprint)
+
+		checkpoints.clear(gateway)
+
+		assert.False(t, checkpoints.completed(gateway, "step", fingerprint))
+	})
+
+	t.Run("is disabled when nil", func(t *testing.T) {
+		var checkpoints *gatewayCheckpoints
+		gateway := newRandomGateway()
+		fingerprint := gatewayCheckpointFingerprint("listener")
+
+		checkpoints.record(gateway, "step", fingerprint)
+		checkpoints.clear(gateway)
+
+		assert.False(t, checkpoints.completed(gateway, "step", fingerprint))
+	})
+}
+
+func TestGatewayModelRunGatewayStep(t *testing.T) {
+	newModel := func() *gatewayModelImpl {
+		return &gatewayModelImpl{
+			logger:      diag.RootTestLogger(),
+			checkpoints: newGatewayCheckpoints(),
+		}
+	}
+
+	t.Run("skips steps completed by previous attempt", func(t *testing.T) {
+		model := newModel()
+		gateway := newRandomGateway()
+		runs := 0
+		run := func() error {
+			runs++
+			return nil
+		}
+
+		require.NoError(t, model.runGatewayStep(t.Context(), gateway, "step", []any{"listener"}, run))
+		require.NoError(t, model.runGatewayStep(t.Context(), gateway, "step", []any{"listener"}, run))
+		assert.Equal(t, 1, runs)
+
+		require.NoError(t, model.runGatewayStep(t.Context(), gateway, "step", []any{"changed"}, run))
+		assert.Equal(t, 2, runs)
+	})
+
+	t.Run("repeats failed steps", func(t *testing.T) {
+		model := newModel()
+		gateway := newRandomGateway()
+		wantErr := errors.New(faker.New().Lorem().Sentence(3))
+		runs := 0
+		run := func() error {
+			runs++
+			if runs == 1 {
+				return wantErr
+			}
+			return nil
+		}
+
+		require.ErrorIs(t, model.runGatewayStep(t.Context(), gateway, "step", []any{"listener"}, run), wantErr)
+		require.NoError(t, model.runGatewayStep(t.Context(), gateway, "step", []any{"listener"}, run))
+		assert.Equal(t, 2, runs)
+	})
+}
@@ -0,0 +1,131 @@
+package app
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/dig"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+// errLoadBalancerNotFound indicates that the load balancer referenced by the GatewayConfig
+// does not exist, e.g. it was deleted outside of the controller.
+var errLoadBalancerNotFound = errors.New("load balancer not found")
+
+// invalidGatewayConfig is the validation state of the GatewayConfig referencing a missing
+// load balancer.
+type invalidGatewayConfig struct {
+	loadBalancerID string
+	attempts       int
+	retryAt        time.Time
+}
+
+// gatewayConfigValidation tracks GatewayConfigs that reference missing load balancers.
+// Reconciles of Gateways and routes using such configs are backed off exponentially
+// instead of failing against the OCI API, and resume once the config is valid again:
+// either the backoff expires and the load balancer is found, or the config is changed
+// to reference another load balancer. A nil value is valid and tracks nothing.
+type gatewayConfigValidation struct {
+	mu        sync.Mutex
+	invalid   map[apitypes.NamespacedName]*invalidGatewayConfig
+	baseDelay time.Duration
+	maxDelay  time.Duration
+	now       func() time.Time
+}
+
+type gatewayConfigValidationDeps struct {
+	dig.In
+
+	BaseDelay time.Duration `name:"config.reconcile.retry-base-delay"`
+	MaxDelay  time.Duration `name:"config.reconcile.retry-max-delay"`
+}
+
+func newGatewayConfigValidation(deps gatewayConfigValidationDeps) *gatewayConfigValidation {
+	return &gatewayConfigValidation{
+		invalid:   map[apitypes.NamespacedName]*invalidGatewayConfig{},
+		baseDelay: deps.BaseDelay,
+		maxDelay:  deps.MaxDelay,
+		now:       time.Now,
+	}
+}
+
+// markInvalid records the failed validation of the config and returns the delay to back
+// off reconciles of the config for.
+func (v *gatewayConfigValidation) markInvalid(config types.GatewayConfig) time.Duration {
+	if v == nil {
+		return 0
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	key := apitypes.NamespacedName{Namespace: config.Namespace, Name: config.Name}
+	state, found := v.invalid[key]
+	if !found || state.loadBalancerID != config.Spec.LoadBalancerID {
+		state = &invalidGatewayConfig{loadBalancerID: config.Spec.LoadBalancerID}
+		v.invalid[key] = state
+	}
+	delay := v.baseDelay
+	for range state.attempts {
+		if delay >= v.maxDelay {
+			break
+		}
+		delay *= 2
+	}
+	delay = min(delay, v.maxDelay)
+	state.attempts++
+	state.retryAt = v.now().Add(delay)
+	return delay
+}
+
+// markValid forgets the validation failures of the config.
+func (v *gatewayConfigValidation) markValid(config types.GatewayConfig) {
+	if v == nil {
+		return
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	delete(v.invalid, apitypes.NamespacedName{Namespace: config.Namespace, Name: config.Name})
+}
+
+// backoff returns the remaining delay if reconciles of the config are backed off.
+// Changing the load balancer of the config ends the backoff.
+func (v *gatewayConfigValidation) backoff(config types.GatewayConfig) (time.Duration, bool) {
+	if v == nil {
+		return 0, false
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	state, found := v.invalid[apitypes.NamespacedName{Namespace: config.Namespace, Name: config.Name}]
+	if !found || state.loadBalancerID != config.Spec.LoadBalancerID {
+		return 0, false
+	}
+	remaining := state.retryAt.Sub(v.now())
+	return remaining, remaining > 0
+}
+
+// isGatewayRejectedForParameters reports whether the current generation of the gateway is
+// not accepted due to invalid parameters, e.g. a GatewayConfig of a missing load balancer.
+// Such gateways are accepted again once programmed.
+func isGatewayRejectedForParameters(gateway *gatewayv1.Gateway) bool {
+	condition := meta.FindStatusCondition(gateway.Status.Conditions, string(gatewayv1.GatewayConditionAccepted))
+	return condition != nil &&
+		condition.ObservedGeneration == gateway.Generation &&
+		condition.Status == metav1.ConditionFalse &&
+		condition.Reason == string(gatewayv1.GatewayReasonInvalidParameters)
+}
+
+// configBackoffRequeue makes sure routes of the backed off GatewayConfig are programmed
+// once the backoff expires.
+func configBackoffRequeue(result reconcile.Result, delay time.Duration) reconcile.Result {
+	if result.RequeueAfter == 0 || result.RequeueAfter > delay {
+		result.RequeueAfter = delay
+	}
+	return result
+}
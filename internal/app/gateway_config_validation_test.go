@@ -0,0 +1,96 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestGatewayConfigValidation(t *testing.T) {
+	newValidation := func() (*gatewayConfigValidation, *time.Time) {
+		validation := newGatewayConfigValidation(gatewayConfigValidationDeps{
+			BaseDelay: time.Second,
+			MaxDelay:  5 * time.Second,
+		})
+		now := time.Now()
+		validation.now = func() time.Time { return now }
+		return validation, &now
+	}
+
+	t.Run("should back off invalid config exponentially up to max delay", func(t *testing.T) {
+		validation, _ := newValidation()
+		config := makeRandomGatewayConfig()
+
+		delays := make([]time.Duration, 0, 5)
+		for range 5 {
+			delays = append(delays, validation.markInvalid(config))
+		}
+
+		assert.Equal(t, []time.Duration{
+			time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second,
+		}, delays)
+	})
+
+	t.Run("should report remaining backoff", func(t *testing.T) {
+		validation, now := newValidation()
+		config := makeRandomGatewayConfig()
+
+		_, backingOff := validation.backoff(config)
+		assert.False(t, backingOff)
+
+		validation.markInvalid(config)
+		*now = now.Add(300 * time.Millisecond)
+		remaining, backingOff := validation.backoff(config)
+		assert.True(t, backingOff)
+		assert.Equal(t, 700*time.Millisecond, remaining)
+
+		*now = now.Add(time.Second)
+		_, backingOff = validation.backoff(config)
+		assert.False(t, backingOff)
+	})
+
+	t.Run("should end backoff when load balancer of the config changes", func(t *testing.T) {
+		validation, _ := newValidation()
+		config := makeRandomGatewayConfig()
+		validation.markInvalid(config)
+		validation.markInvalid(config)
+
+		config.Spec.LoadBalancerID = faker.New().UUID().V4()
+		_, backingOff := validation.backoff(config)
+		assert.False(t, backingOff)
+		assert.Equal(t, time.Second, validation.markInvalid(config))
+	})
+
+	t.Run("should forget valid config", func(t *testing.T) {
+		validation, _ := newValidation()
+		config := makeRandomGatewayConfig()
+		validation.markInvalid(config)
+
+		validation.markValid(config)
+
+		_, backingOff := validation.backoff(config)
+		assert.False(t, backingOff)
+	})
+
+	t.Run("should be nil safe", func(t *testing.T) {
+		var validation *gatewayConfigValidation
+		config := makeRandomGatewayConfig()
+
+		assert.Zero(t, validation.markInvalid(config))
+		validation.markValid(config)
+		_, backingOff := validation.backoff(config)
+		assert.False(t, backingOff)
+	})
+}
+
+func TestConfigBackoffRequeue(t *testing.T) {
+	assert.Equal(t, reconcile.Result{RequeueAfter: time.Second},
+		configBackoffRequeue(reconcile.Result{}, time.Second))
+	assert.Equal(t, reconcile.Result{RequeueAfter: time.Second},
+		configBackoffRequeue(reconcile.Result{RequeueAfter: time.Minute}, time.Second))
+	assert.Equal(t, reconcile.Result{RequeueAfter: time.Second},
+		configBackoffRequeue(reconcile.Result{RequeueAfter: time.Second}, time.Minute))
+}
@@ -10,8 +10,12 @@ import (
 	"go.uber.org/dig"
 	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
 )
 
 // GatewayController is a simple controller that watches Gateway resources.
@@ -20,18 +24,29 @@ type GatewayController struct {
 	logger         *slog.Logger
 	resourcesModel resourcesModel
 	gatewayModel   gatewayModel
+	backendModel   httpBackendModel
 	driftInterval  time.Duration
+	failures       *reconcileFailures
+	expiryMonitor  *certificateExpiryMonitor
+	utilization    *loadBalancerUtilizationMonitor
+	validation     *gatewayConfigValidation
 }
 
 // GatewayControllerDeps contains the dependencies for the GatewayController.
 type GatewayControllerDeps struct {
 	dig.In
 
-	RootLogger     *slog.Logger
-	K8sClient      k8sClient
-	ResourcesModel resourcesModel
-	GatewayModel   gatewayModel
-	DriftInterval  time.Duration `name:"config.reconcile.drift-interval"`
+	RootLogger       *slog.Logger
+	K8sClient        k8sClient
+	ResourcesModel   resourcesModel
+	GatewayModel     gatewayModel
+	HTTPBackendModel httpBackendModel
+	DriftInterval    time.Duration `name:"config.reconcile.drift-interval"`
+	FailureThreshold int           `name:"config.reconcile.failure-threshold"`
+
+	CertificateExpiryMonitor *certificateExpiryMonitor       `optional:"true"`
+	UtilizationMonitor       *loadBalancerUtilizationMonitor `optional:"true"`
+	ConfigValidation         *gatewayConfigValidation        `optional:"true"`
 }
 
 // NewGatewayController creates a new GatewayController.
@@ -41,7 +56,12 @@ func NewGatewayController(deps GatewayControllerDeps) *GatewayController {
 		logger:         deps.RootLogger.WithGroup("gateway-controller"),
 		resourcesModel: deps.ResourcesModel, // Initialize resourcesModel
 		gatewayModel:   deps.GatewayModel,
+		backendModel:   deps.HTTPBackendModel,
 		driftInterval:  deps.DriftInterval,
+		failures:       newReconcileFailures(deps.FailureThreshold),
+		expiryMonitor:  deps.CertificateExpiryMonitor,
+		utilization:    deps.UtilizationMonitor,
+		validation:     deps.ConfigValidation,
 	}
 }
 
@@ -65,12 +85,38 @@ func (r *GatewayController) processResourceError(
 ) (reconcile.Result, error) {
 	var reasonErr *resourceStatusError
 	if errors.As(err, &reasonErr) {
+		result := driftRequeue(r.driftInterval)
+		if errors.Is(err, errListenerInUse) {
+			// Removed listeners are rechecked until the routes are detached
+			result = listenerInUseRequeue(result)
+		}
+		conditionType, reason := reasonErr.conditionType, reasonErr.reason
+		if reasonErr.listenerName != "" {
+			setListenerStatusCondition(gateway, gatewayv1.SectionName(reasonErr.listenerName), v1.Condition{
+				Type:               reasonErr.conditionType,
+				Status:             v1.ConditionFalse,
+				Reason:             reasonErr.reason,
+				Message:            reasonErr.message,
+				ObservedGeneration: gateway.Generation,
+				LastTransitionTime: v1.Now(),
+			})
+			conditionType = string(gatewayv1.GatewayConditionProgrammed)
+			reason = string(gatewayv1.GatewayReasonInvalid)
+		}
+		failPendingListeners(gateway, gatewayv1.ListenerReasonInvalid, reasonErr.message)
+		failPendingProgramming(
+			&gateway.Status.Conditions,
+			string(gatewayv1.GatewayConditionProgrammed),
+			gateway.Generation,
+			reason,
+			reasonErr.message,
+		)
 		if err = r.resourcesModel.setCondition(ctx, setConditionParams{
 			resource:      gateway,
 			conditions:    &gateway.Status.Conditions,
-			conditionType: reasonErr.conditionType,
+			conditionType: conditionType,
 			status:        v1.ConditionFalse,
-			reason:        reasonErr.reason,
+			reason:        reason,
 			message:       reasonErr.message,
 		}); err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to set condition for Gateway %s: %w", gateway.Name, err)
@@ -79,48 +125,197 @@ func (r *GatewayController) processResourceError(
 			slog.String("gateway", gateway.GetName()),
 			slog.String("reason", reasonErr.Error()),
 		)
-		return driftRequeue(r.driftInterval), nil
+		return result, nil
+	}
+	return reconcile.Result{}, fmt.Errorf("failed to program Gateway %s: %w", gateway.Name, err)
+}
+
+// processProgrammingError handles errors from programming the gateway. Errors that are
+// not reported with a condition are retried, once they persist for the configured number
+// of attempts the Programmed condition is set to False with the last error. Gateways of
+// the GatewayConfig referencing a missing load balancer are backed off until it is found.
+func (r *GatewayController) processProgrammingError(
+	ctx context.Context,
+	err error,
+	data *resolvedGatewayDetails,
+) (reconcile.Result, error) {
+	gateway := &data.gateway
+	var reasonErr *resourceStatusError
+	if errors.As(err, &reasonErr) {
+		result, resultErr := r.processResourceError(ctx, err, gateway)
+		if resultErr == nil && errors.Is(err, errLoadBalancerNotFound) {
+			if delay := r.validation.markInvalid(data.config); delay > 0 {
+				result = reconcile.Result{RequeueAfter: delay}
+			}
+		}
+		return result, resultErr
+	}
+	var limitErr loadBalancerLimitError
+	if errors.As(err, &limitErr) {
+		return r.processResourceError(ctx, &resourceStatusError{
+			conditionType: string(gatewayv1.GatewayConditionProgrammed),
+			reason:        string(gatewayv1.GatewayReasonNoResources),
+			message:       limitErr.Error(),
+			cause:         err,
+		}, gateway)
+	}
+	if message, terminal := ociTerminalErrorMessage(err); terminal {
+		// Terminal errors are not retried until the gateway or its config changes
+		return r.processResourceError(ctx, &resourceStatusError{
+			conditionType: string(gatewayv1.GatewayConditionProgrammed),
+			reason:        string(gatewayv1.GatewayReasonInvalid),
+			message:       message,
+			cause:         err,
+		}, gateway)
+	}
+	failures, thresholdReached := r.failures.recordFailure(client.ObjectKeyFromObject(gateway))
+	if thresholdReached {
+		message := fmt.Sprintf("Failed to program Gateway after %d attempts: %s", failures, err)
+		failPendingListeners(gateway, gatewayv1.ListenerReasonPending, message)
+		if conditionErr := r.resourcesModel.setCondition(ctx, setConditionParams{
+			resource:      gateway,
+			conditions:    &gateway.Status.Conditions,
+			conditionType: string(gatewayv1.GatewayConditionProgrammed),
+			status:        v1.ConditionFalse,
+			reason:        string(gatewayv1.GatewayReasonPending),
+			message:       message,
+		}); conditionErr != nil {
+			r.logger.WarnContext(ctx, "Failed to set Programmed condition for Gateway",
+				slog.String("gateway", gateway.GetName()),
+				diag.ErrAttr(conditionErr),
+			)
+		}
 	}
 	return reconcile.Result{}, fmt.Errorf("failed to program Gateway %s: %w", gateway.Name, err)
 }
 
+// setAccepted sets the Accepted condition of the gateway to True.
+func (r *GatewayController) setAccepted(ctx context.Context, gateway *gatewayv1.Gateway) error {
+	if err := r.resourcesModel.setCondition(ctx, setConditionParams{
+		resource:      gateway,
+		conditions:    &gateway.Status.Conditions,
+		conditionType: string(gatewayv1.GatewayConditionAccepted),
+		status:        v1.ConditionTrue,
+		reason:        string(gatewayv1.GatewayReasonAccepted),
+		message:       fmt.Sprintf("Gateway %s accepted by %s", gateway.Name, ControllerClassName),
+		annotations: map[string]string{
+			ControllerClassName: "true",
+		},
+	}); err != nil {
+		return fmt.Errorf(
+			"failed to set accepted condition for Gateway %s: %w",
+			client.ObjectKeyFromObject(gateway),
+			err,
+		)
+	}
+	return nil
+}
+
+// setProgramming resets the Programmed condition of the previously programmed gateway and
+// its listeners to Pending once programming of the changes starts, so tools waiting for the
+// condition do not observe the status of the previous generation. The condition is set to
+// True or False once programming succeeds or fails.
+func (r *GatewayController) setProgramming(ctx context.Context, gateway *gatewayv1.Gateway) error {
+	if !programmingStarts(gateway.Status.Conditions, string(gatewayv1.GatewayConditionProgrammed)) {
+		return nil
+	}
+	setListenersProgramming(gateway)
+	if err := r.resourcesModel.setCondition(ctx, setConditionParams{
+		resource:      gateway,
+		conditions:    &gateway.Status.Conditions,
+		conditionType: string(gatewayv1.GatewayConditionProgrammed),
+		status:        v1.ConditionUnknown,
+		reason:        string(gatewayv1.GatewayReasonPending),
+		message:       fmt.Sprintf("Programming Gateway %s generation %d", gateway.Name, gateway.Generation),
+	}); err != nil {
+		return fmt.Errorf("failed to set pending programmed condition for Gateway %s: %w", gateway.Name, err)
+	}
+	return nil
+}
+
+// reconcilePaused maintains the Paused condition of the gateway. Returns true if
+// the gateway is paused and should not be programmed.
+func (r *GatewayController) reconcilePaused(ctx context.Context, gateway *gatewayv1.Gateway) (bool, error) {
+	paused := isPaused(gateway)
+	if condition, changed := pausedConditionChange(gateway.Status.Conditions, gateway.Generation, paused); changed {
+		if err := r.resourcesModel.setCondition(ctx, setConditionParams{
+			resource:      gateway,
+			conditions:    &gateway.Status.Conditions,
+			conditionType: ConditionPaused,
+			status:        condition.status,
+			reason:        condition.reason,
+			message:       condition.message,
+		}); err != nil {
+			return false, fmt.Errorf("failed to set paused condition for Gateway %s: %w", gateway.Name, err)
+		}
+	}
+	if paused {
+		r.logger.InfoContext(ctx, "Gateway programming is paused",
+			slog.String("gateway", client.ObjectKeyFromObject(gateway).String()),
+		)
+	}
+	return paused, nil
+}
+
 // Reconcile implements the reconcile.Reconciler interface for Gateway resources.
 func (r *GatewayController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	var data resolvedGatewayDetails
 	relevant, err := r.gatewayModel.resolveReconcileRequest(ctx, req, &data)
+	if data.gateway.DeletionTimestamp != nil &&
+		(controllerutil.ContainsFinalizer(&data.gateway, GatewayNetworkSecurityGroupFinalizer) ||
+			controllerutil.ContainsFinalizer(&data.gateway, GatewayDNSRecordsFinalizer)) {
+		// Other errors are retried, the gateway is not deprovisioned with a partly resolved config
+		if err != nil && !errors.Is(err, errGatewayConfigNotFound) {
+			return reconcile.Result{}, err
+		}
+		// The config may be already deleted, the controller region is used in that case
+		return reconcile.Result{}, r.gatewayModel.deprovisionGateway(ociRegionContext(ctx, data.config), &data)
+	}
 	if err != nil {
 		return r.processResourceError(ctx, err, &data.gateway)
 	}
 	if !relevant {
+		r.expiryMonitor.forgetGateway(req.NamespacedName)
+		r.utilization.forgetGateway(req.NamespacedName)
 		return reconcile.Result{}, nil
 	}
+	ctx = ociRegionContext(ctx, data.config)
 	r.logger.InfoContext(ctx, fmt.Sprintf("Processing reconciliation for Gateway %s", req.NamespacedName),
 		slog.String("resourceVersion", data.gateway.ResourceVersion),
 		slog.Int64("generation", data.gateway.Generation),
 	)
 
-	if !isGatewayAccepted(&data.gateway) {
-		if err = r.resourcesModel.setCondition(ctx, setConditionParams{
-			resource:      &data.gateway,
-			conditions:    &data.gateway.Status.Conditions,
-			conditionType: string(gatewayv1.GatewayConditionAccepted),
-			status:        v1.ConditionTrue,
-			reason:        string(gatewayv1.GatewayReasonAccepted),
-			message:       fmt.Sprintf("Gateway %s accepted by %s", data.gateway.Name, ControllerClassName),
-			annotations: map[string]string{
-				ControllerClassName: "true",
-			},
-		}); err != nil {
-			return reconcile.Result{}, fmt.Errorf(
-				"failed to set accepted condition for Gateway %s: %w",
-				req.NamespacedName,
-				err,
-			)
-		}
+	paused, err := r.reconcilePaused(ctx, &data.gateway)
+	if err != nil || paused {
+		return reconcile.Result{}, err
+	}
+
+	if delay, backingOff := r.validation.backoff(data.config); backingOff {
+		r.logger.DebugContext(ctx, "Backing off Gateway with invalid GatewayConfig",
+			slog.String("gateway", req.NamespacedName.String()),
+			slog.Duration("delay", delay),
+		)
+		return reconcile.Result{RequeueAfter: delay}, nil
 	}
 
+	// Gateways rejected due to invalid parameters, like a load balancer deleted outside of
+	// the controller, are accepted again only once programmed, so the Accepted condition
+	// does not flap while the GatewayConfig is invalid.
 	programmed := r.gatewayModel.isProgrammed(ctx, &data)
-	if !programmed || r.driftInterval > 0 {
+	programRequired := !programmed || r.driftInterval > 0
+	revalidating := programRequired && isGatewayRejectedForParameters(&data.gateway)
+	if !revalidating && !isGatewayAccepted(&data.gateway) {
+		if err = r.setAccepted(ctx, &data.gateway); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	// Certificates expire and load balancer utilization changes without changes of the gateway,
+	// so they are checked on every reconciliation.
+	r.expiryMonitor.checkGatewayCertificates(ctx, &data)
+	r.utilization.checkGatewayUtilization(ctx, &data)
+
+	if programRequired {
 		r.logger.DebugContext(ctx, "Programming gateway",
 			slog.Any("req", req),
 			slog.String("resourceVersion", data.gateway.ResourceVersion),
@@ -130,8 +325,23 @@ func (r *GatewayController) Reconcile(ctx context.Context, req reconcile.Request
 			slog.String("loadBalancerID", data.config.Spec.LoadBalancerID),
 		)
 
+		// Drift checks of the programmed gateway do not change its status
+		if !programmed {
+			if err = r.setProgramming(ctx, &data.gateway); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+
 		if err = r.gatewayModel.programGateway(ctx, &data); err != nil {
-			return r.processResourceError(ctx, err, &data.gateway)
+			return r.processProgrammingError(ctx, err, &data)
+		}
+		r.failures.reset(req.NamespacedName)
+		r.validation.markValid(data.config)
+
+		if revalidating {
+			if err = r.setAccepted(ctx, &data.gateway); err != nil {
+				return reconcile.Result{}, err
+			}
 		}
 
 		if err = r.gatewayModel.setProgrammed(ctx, &data); err != nil {
@@ -153,5 +363,22 @@ func (r *GatewayController) Reconcile(ctx context.Context, req reconcile.Request
 		)
 	}
 
-	return driftRequeue(r.driftInterval), nil
+	// Default backend endpoints change independently of the gateway generation,
+	// so they are synced on every reconciliation.
+	result := driftRequeue(shortestRequeueInterval(r.driftInterval, r.utilization.pollInterval()))
+	err = r.backendModel.syncDefaultBackendEndpoints(ctx, syncDefaultBackendEndpointsParams{
+		gateway: &data.gateway,
+		config:  data.config,
+	})
+	if errors.Is(err, errPodReadinessPending) {
+		r.logger.InfoContext(ctx, "Waiting for default backend pods to become healthy",
+			slog.String("gateway", req.NamespacedName.String()),
+			diag.ErrAttr(err),
+		)
+		result = podReadinessGateRequeue(result)
+	} else if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to sync default backend endpoints: %w", err)
+	}
+
+	return result, nil
 }
@@ -1,8 +1,10 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -22,18 +24,28 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
 	"github.com/gemyago/oke-gateway-api/internal/types"
 )
 
 func TestGatewayController(t *testing.T) {
 	newMockDeps := func(t *testing.T) GatewayControllerDeps {
 		return GatewayControllerDeps{
-			K8sClient:      NewMockk8sClient(t),
-			ResourcesModel: NewMockresourcesModel(t),
-			GatewayModel:   NewMockgatewayModel(t),
-			RootLogger:     diag.RootTestLogger(),
+			K8sClient:        NewMockk8sClient(t),
+			ResourcesModel:   NewMockresourcesModel(t),
+			GatewayModel:     NewMockgatewayModel(t),
+			HTTPBackendModel: NewMockhttpBackendModel(t),
+			RootLogger:       diag.RootTestLogger(),
 		}
 	}
+	expectSyncDefaultBackend := func(t *testing.T, deps GatewayControllerDeps, gateway *gatewayv1.Gateway) {
+		mockBackendModel, _ := deps.HTTPBackendModel.(*MockhttpBackendModel)
+		mockBackendModel.EXPECT().
+			syncDefaultBackendEndpoints(t.Context(), syncDefaultBackendEndpointsParams{
+				gateway: gateway,
+			}).
+			Return(nil).Once()
+	}
 	markGatewayAccepted := func(gateway *gatewayv1.Gateway) {
 		gateway.Status.Conditions = append(gateway.Status.Conditions, metav1.Condition{
 			Type:               string(gatewayv1.GatewayConditionAccepted),
@@ -101,6 +113,7 @@ func TestGatewayController(t *testing.T) {
 					gateway: *gateway,
 				}).
 				Return(nil).Once()
+			expectSyncDefaultBackend(t, deps, gateway)
 
 			result, err := controller.Reconcile(t.Context(), req)
 
@@ -108,6 +121,64 @@ func TestGatewayController(t *testing.T) {
 			assert.Equal(t, reconcile.Result{}, result)
 		})
 
+		t.Run("sets programmed condition pending before programming changes", func(t *testing.T) {
+			gateway := newRandomGateway(randomGatewayWithListenersOpt(
+				makeRandomListener(randomListenerWithHTTPProtocolOpt()),
+			))
+			markGatewayAccepted(gateway)
+			gateway.Status.Conditions = append(gateway.Status.Conditions, metav1.Condition{
+				Type:               string(gatewayv1.GatewayConditionProgrammed),
+				Status:             metav1.ConditionTrue,
+				Reason:             string(gatewayv1.GatewayReasonProgrammed),
+				ObservedGeneration: gateway.Generation - 1,
+			})
+			req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(gateway)}
+
+			deps := newMockDeps(t)
+			controller := NewGatewayController(deps)
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(true, nil).Once()
+			mockGatewayModel.EXPECT().isProgrammed(t.Context(), mock.Anything).Return(false).Once()
+
+			var programmingStarted bool
+			mockResourcesModel.EXPECT().
+				setCondition(t.Context(), mock.MatchedBy(func(params setConditionParams) bool {
+					gw, _ := params.resource.(*gatewayv1.Gateway)
+					return params.conditionType == string(gatewayv1.GatewayConditionProgrammed) &&
+						params.status == metav1.ConditionUnknown &&
+						params.reason == string(gatewayv1.GatewayReasonPending) &&
+						len(gw.Status.Listeners) == 1 &&
+						isProgrammingPending(
+							gw.Status.Listeners[0].Conditions,
+							string(gatewayv1.ListenerConditionProgrammed),
+						)
+				})).
+				RunAndReturn(func(_ context.Context, _ setConditionParams) error {
+					programmingStarted = true
+					return nil
+				}).Once()
+			mockGatewayModel.EXPECT().
+				programGateway(t.Context(), mock.Anything).
+				RunAndReturn(func(_ context.Context, _ *resolvedGatewayDetails) error {
+					assert.True(t, programmingStarted)
+					return nil
+				}).Once()
+			mockGatewayModel.EXPECT().setProgrammed(t.Context(), mock.Anything).Return(nil).Once()
+			mockBackendModel, _ := deps.HTTPBackendModel.(*MockhttpBackendModel)
+			mockBackendModel.EXPECT().syncDefaultBackendEndpoints(t.Context(), mock.Anything).Return(nil).Once()
+
+			_, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+		})
+
 		t.Run("handle regular accept errors", func(t *testing.T) {
 			fake := faker.New()
 			gateway := newRandomGateway()
@@ -227,6 +298,48 @@ func TestGatewayController(t *testing.T) {
 			assert.Equal(t, reconcile.Result{}, result)
 		})
 
+		t.Run("skip program when gateway is paused", func(t *testing.T) {
+			gateway := newRandomGateway()
+			gateway.Annotations = map[string]string{PausedAnnotation: "true"}
+			markGatewayAccepted(gateway)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: gateway.Namespace,
+					Name:      gateway.Name,
+				},
+			}
+
+			deps := newMockDeps(t)
+			controller := NewGatewayController(deps)
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(true, nil).Once()
+
+			mockResourcesModel.EXPECT().
+				setCondition(t.Context(), setConditionParams{
+					resource:      gateway,
+					conditions:    &gateway.Status.Conditions,
+					conditionType: ConditionPaused,
+					status:        metav1.ConditionTrue,
+					reason:        ReasonPaused,
+					message:       fmt.Sprintf("Programming is paused with %s annotation", PausedAnnotation),
+				}).
+				Return(nil).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{}, result)
+		})
+
 		t.Run("handle porgramGateway errors", func(t *testing.T) {
 			fake := faker.New()
 			gateway := newRandomGateway()
@@ -270,6 +383,175 @@ func TestGatewayController(t *testing.T) {
 			assert.Equal(t, reconcile.Result{}, result)
 		})
 
+		t.Run("sets programmed false when programGateway failures reach threshold", func(t *testing.T) {
+			fake := faker.New()
+			gateway := newRandomGateway()
+			markGatewayAccepted(gateway)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: gateway.Namespace,
+					Name:      gateway.Name,
+				},
+			}
+
+			deps := newMockDeps(t)
+			deps.FailureThreshold = 2
+			controller := NewGatewayController(deps)
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(true, nil).Times(2)
+
+			mockGatewayModel.EXPECT().
+				isProgrammed(t.Context(), &resolvedGatewayDetails{
+					gateway: *gateway,
+				}).
+				Return(false).Times(2)
+
+			wantErr := errors.New(fake.Lorem().Sentence(10))
+
+			mockGatewayModel.EXPECT().
+				programGateway(t.Context(), mock.Anything).
+				Return(wantErr).Times(2)
+
+			_, err := controller.Reconcile(t.Context(), req)
+			require.ErrorIs(t, err, wantErr)
+
+			mockResourcesModel.EXPECT().
+				setCondition(t.Context(), setConditionParams{
+					resource:      gateway,
+					conditions:    &gateway.Status.Conditions,
+					conditionType: string(gatewayv1.GatewayConditionProgrammed),
+					status:        metav1.ConditionFalse,
+					reason:        string(gatewayv1.GatewayReasonPending),
+					message:       fmt.Sprintf("Failed to program Gateway after 2 attempts: %s", wantErr),
+				}).
+				Return(nil).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.ErrorIs(t, err, wantErr)
+			assert.Equal(t, reconcile.Result{}, result)
+		})
+
+		t.Run("sets programmed invalid without retrying on terminal OCI error", func(t *testing.T) {
+			gateway := newRandomGateway()
+			markGatewayAccepted(gateway)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: gateway.Namespace,
+					Name:      gateway.Name,
+				},
+			}
+
+			deps := newMockDeps(t)
+			controller := NewGatewayController(deps)
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(true, nil).Once()
+
+			mockGatewayModel.EXPECT().
+				isProgrammed(t.Context(), &resolvedGatewayDetails{
+					gateway: *gateway,
+				}).
+				Return(false).Once()
+
+			ociErr := fmt.Errorf("failed to create listener: %w", ociapi.NewRandomServiceError(
+				ociapi.RandomServiceErrorWithStatusCode(http.StatusBadRequest),
+				ociapi.RandomServiceErrorWithCode("LimitExceeded"),
+			))
+			mockGatewayModel.EXPECT().
+				programGateway(t.Context(), mock.Anything).
+				Return(ociErr).Once()
+
+			mockResourcesModel.EXPECT().
+				setCondition(t.Context(), setConditionParams{
+					resource:      gateway,
+					conditions:    &gateway.Status.Conditions,
+					conditionType: string(gatewayv1.GatewayConditionProgrammed),
+					status:        metav1.ConditionFalse,
+					reason:        string(gatewayv1.GatewayReasonInvalid),
+					message:       fmt.Sprintf("OCI rejected the request (QuotaExceeded): %s", ociErr),
+				}).
+				Return(nil).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, driftRequeue(controller.driftInterval), result)
+		})
+
+		t.Run("sets programmed no resources when load balancer limit is exceeded", func(t *testing.T) {
+			gateway := newRandomGateway()
+			markGatewayAccepted(gateway)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: gateway.Namespace,
+					Name:      gateway.Name,
+				},
+			}
+
+			deps := newMockDeps(t)
+			controller := NewGatewayController(deps)
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(true, nil).Once()
+
+			mockGatewayModel.EXPECT().
+				isProgrammed(t.Context(), &resolvedGatewayDetails{
+					gateway: *gateway,
+				}).
+				Return(false).Once()
+
+			limitErr := loadBalancerLimitError{
+				loadBalancerID: faker.New().UUID().V4(),
+				resource:       "listeners",
+				limit:          defaultLoadBalancerMaxListeners,
+			}
+			mockGatewayModel.EXPECT().
+				programGateway(t.Context(), mock.Anything).
+				Return(fmt.Errorf("failed to create listener: %w", limitErr)).Once()
+
+			mockResourcesModel.EXPECT().
+				setCondition(t.Context(), setConditionParams{
+					resource:      gateway,
+					conditions:    &gateway.Status.Conditions,
+					conditionType: string(gatewayv1.GatewayConditionProgrammed),
+					status:        metav1.ConditionFalse,
+					reason:        string(gatewayv1.GatewayReasonNoResources),
+					message:       limitErr.Error(),
+				}).
+				Return(nil).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, driftRequeue(controller.driftInterval), result)
+		})
+
 		// if error is resourceStatusError then set status to details from the error
 		t.Run("handle program resourceStatusError", func(t *testing.T) {
 			fake := faker.New()
@@ -329,6 +611,64 @@ func TestGatewayController(t *testing.T) {
 			assert.Equal(t, reconcile.Result{}, result)
 		})
 
+		t.Run("reports listener resourceStatusError in listener status", func(t *testing.T) {
+			fake := faker.New()
+			listener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+			gateway := newRandomGateway(randomGatewayWithListenersOpt(listener))
+			markGatewayAccepted(gateway)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(gateway),
+			}
+
+			deps := newMockDeps(t)
+			controller := NewGatewayController(deps)
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(true, nil).Once()
+			mockGatewayModel.EXPECT().isProgrammed(t.Context(), mock.Anything).Return(false).Once()
+
+			wantErr := newInvalidCertificateRefError(listener.Name, fake.Lorem().Sentence(10), errors.New(fake.Lorem().Word()))
+			mockGatewayModel.EXPECT().
+				programGateway(t.Context(), mock.Anything).
+				Return(fmt.Errorf("failed to reconcile listeners certificates: %w", wantErr)).Once()
+
+			var gotGateway *gatewayv1.Gateway
+			mockResourcesModel.EXPECT().
+				setCondition(t.Context(), mock.MatchedBy(func(params setConditionParams) bool {
+					gotGateway, _ = params.resource.(*gatewayv1.Gateway)
+					return params.conditionType == string(gatewayv1.GatewayConditionProgrammed) &&
+						params.status == metav1.ConditionFalse &&
+						params.reason == string(gatewayv1.GatewayReasonInvalid) &&
+						params.message == wantErr.message
+				})).
+				Return(nil).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{}, result)
+			require.NotNil(t, gotGateway)
+			require.Len(t, gotGateway.Status.Listeners, 1)
+			listenerStatus := gotGateway.Status.Listeners[0]
+			assert.Equal(t, listener.Name, listenerStatus.Name)
+			resolvedRefs := meta.FindStatusCondition(
+				listenerStatus.Conditions,
+				string(gatewayv1.ListenerConditionResolvedRefs),
+			)
+			require.NotNil(t, resolvedRefs)
+			assert.Equal(t, metav1.ConditionFalse, resolvedRefs.Status)
+			assert.Equal(t, string(gatewayv1.ListenerReasonInvalidCertificateRef), resolvedRefs.Reason)
+			assert.Equal(t, wantErr.message, resolvedRefs.Message)
+		})
+
 		t.Run("returns drift requeue for program resourceStatusError when drift is enabled", func(t *testing.T) {
 			fake := faker.New()
 			gateway := newRandomGateway()
@@ -389,6 +729,61 @@ func TestGatewayController(t *testing.T) {
 			assertDriftRequeue(t, result, driftInterval)
 		})
 
+		t.Run("requeues when removed listeners are still in use", func(t *testing.T) {
+			gateway := newRandomGateway()
+			markGatewayAccepted(gateway)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: gateway.Namespace,
+					Name:      gateway.Name,
+				},
+			}
+
+			deps := newMockDeps(t)
+			controller := NewGatewayController(deps)
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(true, nil).Once()
+
+			mockGatewayModel.EXPECT().
+				isProgrammed(t.Context(), &resolvedGatewayDetails{
+					gateway: *gateway,
+				}).
+				Return(false).Once()
+
+			wantErr := newListenerInUseError(map[string][]string{
+				"removed": {"HTTPRoute " + gateway.Namespace + "/route"},
+			})
+
+			mockGatewayModel.EXPECT().
+				programGateway(t.Context(), mock.Anything).
+				Return(fmt.Errorf("failed to remove missing listeners: %w", wantErr)).Once()
+
+			mockResourcesModel.EXPECT().
+				setCondition(t.Context(), setConditionParams{
+					resource:      gateway,
+					conditions:    &gateway.Status.Conditions,
+					conditionType: string(gatewayv1.GatewayConditionProgrammed),
+					status:        metav1.ConditionFalse,
+					reason:        GatewayReasonListenerInUse,
+					message:       wantErr.message,
+				}).
+				Return(nil).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{RequeueAfter: listenerInUseRequeueInterval}, result)
+		})
+
 		t.Run("handle set programmed condition error", func(t *testing.T) {
 			fake := faker.New()
 			gateway := newRandomGateway()
@@ -466,6 +861,7 @@ func TestGatewayController(t *testing.T) {
 					gateway: *gateway,
 				}).
 				Return(true).Once()
+			expectSyncDefaultBackend(t, deps, gateway)
 
 			result, err := controller.Reconcile(t.Context(), req)
 
@@ -473,6 +869,73 @@ func TestGatewayController(t *testing.T) {
 			assert.Equal(t, reconcile.Result{}, result)
 		})
 
+		t.Run("handle sync default backend error", func(t *testing.T) {
+			gateway := newRandomGateway()
+			markGatewayAccepted(gateway)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(gateway),
+			}
+
+			deps := newMockDeps(t)
+			controller := NewGatewayController(deps)
+
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(true, nil).Once()
+			mockGatewayModel.EXPECT().
+				isProgrammed(t.Context(), mock.Anything).
+				Return(true).Once()
+
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+			mockBackendModel, _ := deps.HTTPBackendModel.(*MockhttpBackendModel)
+			mockBackendModel.EXPECT().
+				syncDefaultBackendEndpoints(t.Context(), mock.Anything).
+				Return(wantErr).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.ErrorIs(t, err, wantErr)
+			assert.Equal(t, reconcile.Result{}, result)
+		})
+
+		t.Run("requeues when default backend pod readiness is pending", func(t *testing.T) {
+			gateway := newRandomGateway()
+			markGatewayAccepted(gateway)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(gateway),
+			}
+
+			deps := newMockDeps(t)
+			controller := NewGatewayController(deps)
+
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(true, nil).Once()
+			mockGatewayModel.EXPECT().
+				isProgrammed(t.Context(), mock.Anything).
+				Return(true).Once()
+
+			mockBackendModel, _ := deps.HTTPBackendModel.(*MockhttpBackendModel)
+			mockBackendModel.EXPECT().
+				syncDefaultBackendEndpoints(t.Context(), mock.Anything).
+				Return(fmt.Errorf("1 pods: %w", errPodReadinessPending)).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{RequeueAfter: podReadinessGateRequeueInterval}, result)
+		})
+
 		t.Run("programs already programmed gateway when drift interval is enabled", func(t *testing.T) {
 			gateway := newRandomGateway()
 			markGatewayAccepted(gateway)
@@ -515,6 +978,7 @@ func TestGatewayController(t *testing.T) {
 					gateway: *gateway,
 				}).
 				Return(nil).Once()
+			expectSyncDefaultBackend(t, deps, gateway)
 
 			result, err := controller.Reconcile(t.Context(), req)
 
@@ -581,6 +1045,12 @@ func TestGatewayController(t *testing.T) {
 					secretName,
 					secretResourceVersion,
 				),
+				GatewayListenerPolicyAnnotation:        "",
+				GatewayAccessPoliciesAnnotation:        "",
+				GatewayListenerClientCAAnnotation:      "",
+				GatewayDefaultBackendServiceAnnotation: "",
+				GatewayDNSRecordsAnnotation:            "",
+				GatewayNetworkSecurityGroupAnnotation:  "",
 			}
 
 			gatewayClass := newRandomGatewayClass(
@@ -628,11 +1098,14 @@ func TestGatewayController(t *testing.T) {
 				OciClient:            NewMockociLoadBalancerClient(t),
 				OciLoadBalancerModel: NewMockociLoadBalancerModel(t),
 			})
+			backendModel := NewMockhttpBackendModel(t)
+			backendModel.EXPECT().syncDefaultBackendEndpoints(t.Context(), mock.Anything).Return(nil).Once()
 			controller := NewGatewayController(GatewayControllerDeps{
-				K8sClient:      k8sClient,
-				ResourcesModel: resourcesModel,
-				GatewayModel:   gatewayModel,
-				RootLogger:     diag.RootTestLogger(),
+				K8sClient:        k8sClient,
+				ResourcesModel:   resourcesModel,
+				GatewayModel:     gatewayModel,
+				HTTPBackendModel: backendModel,
+				RootLogger:       diag.RootTestLogger(),
 			})
 
 			result, err := controller.Reconcile(t.Context(), reconcile.Request{
@@ -652,6 +1125,207 @@ func TestGatewayController(t *testing.T) {
 			assert.Equal(t, metav1.ConditionTrue, accepted.Status)
 		})
 
+		t.Run("backs off gateway when load balancer is not found", func(t *testing.T) {
+			gateway := newRandomGateway()
+			markGatewayAccepted(gateway)
+			req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(gateway)}
+
+			deps := newMockDeps(t)
+			deps.ConfigValidation = newGatewayConfigValidation(gatewayConfigValidationDeps{
+				BaseDelay: time.Second,
+				MaxDelay:  time.Minute,
+			})
+			controller := NewGatewayController(deps)
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(true, nil).Twice()
+			mockGatewayModel.EXPECT().isProgrammed(t.Context(), mock.Anything).Return(false).Once()
+
+			wantErr := &resourceStatusError{
+				conditionType: string(gatewayv1.GatewayConditionAccepted),
+				reason:        string(gatewayv1.GatewayReasonInvalidParameters),
+				message:       faker.New().Lorem().Sentence(5),
+				cause:         errLoadBalancerNotFound,
+			}
+			mockGatewayModel.EXPECT().programGateway(t.Context(), mock.Anything).Return(wantErr).Once()
+			mockResourcesModel.EXPECT().
+				setCondition(t.Context(), setConditionParams{
+					resource:      gateway,
+					conditions:    &gateway.Status.Conditions,
+					conditionType: wantErr.conditionType,
+					status:        metav1.ConditionFalse,
+					reason:        wantErr.reason,
+					message:       wantErr.message,
+				}).
+				Return(nil).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{RequeueAfter: time.Second}, result)
+
+			// The next reconcile does not reach OCI until the backoff expires
+			result, err = controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Positive(t, result.RequeueAfter)
+			assert.LessOrEqual(t, result.RequeueAfter, time.Second)
+		})
+
+		t.Run("accepts gateway rejected for invalid parameters once programmed", func(t *testing.T) {
+			gateway := newRandomGateway()
+			gateway.Status.Conditions = []metav1.Condition{{
+				Type:               string(gatewayv1.GatewayConditionAccepted),
+				Status:             metav1.ConditionFalse,
+				Reason:             string(gatewayv1.GatewayReasonInvalidParameters),
+				ObservedGeneration: gateway.Generation,
+			}}
+			req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(gateway)}
+
+			deps := newMockDeps(t)
+			controller := NewGatewayController(deps)
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(true, nil).Once()
+			mockGatewayModel.EXPECT().isProgrammed(t.Context(), mock.Anything).Return(false).Once()
+			programCall := mockGatewayModel.EXPECT().programGateway(t.Context(), mock.Anything).Return(nil).Once()
+			mockResourcesModel.EXPECT().
+				setCondition(t.Context(), mock.MatchedBy(func(params setConditionParams) bool {
+					return params.conditionType == string(gatewayv1.GatewayConditionAccepted) &&
+						params.status == metav1.ConditionTrue
+				})).
+				Return(nil).Once().
+				NotBefore(programCall)
+			mockGatewayModel.EXPECT().setProgrammed(t.Context(), mock.Anything).Return(nil).Once()
+			expectSyncDefaultBackend(t, deps, gateway)
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{}, result)
+		})
+
+		t.Run("deprovisions deleted gateway with NSG finalizer", func(t *testing.T) {
+			gateway := newRandomGateway()
+			gateway.DeletionTimestamp = new(metav1.Now())
+			gateway.Finalizers = []string{GatewayNetworkSecurityGroupFinalizer}
+			req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(gateway)}
+
+			deps := newMockDeps(t)
+			controller := NewGatewayController(deps)
+
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(true, nil).Once()
+			wantErr := errors.New(faker.New().Lorem().Sentence(5))
+			mockGatewayModel.EXPECT().
+				deprovisionGateway(t.Context(), mock.MatchedBy(func(data *resolvedGatewayDetails) bool {
+					return data.gateway.Name == gateway.Name
+				})).
+				Return(wantErr).Once()
+
+			_, err := controller.Reconcile(t.Context(), req)
+
+			require.ErrorIs(t, err, wantErr)
+		})
+
+		t.Run("deprovisions deleted gateway with DNS records finalizer", func(t *testing.T) {
+			gateway := newRandomGateway()
+			gateway.DeletionTimestamp = new(metav1.Now())
+			gateway.Finalizers = []string{GatewayDNSRecordsFinalizer}
+			req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(gateway)}
+
+			deps := newMockDeps(t)
+			controller := NewGatewayController(deps)
+
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(true, nil).Once()
+			mockGatewayModel.EXPECT().
+				deprovisionGateway(t.Context(), mock.MatchedBy(func(data *resolvedGatewayDetails) bool {
+					return data.gateway.Name == gateway.Name
+				})).
+				Return(nil).Once()
+
+			_, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+		})
+
+		t.Run("deprovisions deleted gateway with missing GatewayConfig", func(t *testing.T) {
+			gateway := newRandomGateway()
+			gateway.DeletionTimestamp = new(metav1.Now())
+			gateway.Finalizers = []string{GatewayNetworkSecurityGroupFinalizer}
+			req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(gateway)}
+
+			deps := newMockDeps(t)
+			controller := NewGatewayController(deps)
+
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(false, &resourceStatusError{
+					conditionType: string(gatewayv1.GatewayConditionAccepted),
+					reason:        string(gatewayv1.GatewayReasonInvalidParameters),
+					cause:         errGatewayConfigNotFound,
+				}).Once()
+			mockGatewayModel.EXPECT().
+				deprovisionGateway(t.Context(), mock.MatchedBy(func(data *resolvedGatewayDetails) bool {
+					return data.gateway.Name == gateway.Name
+				})).
+				Return(nil).Once()
+
+			_, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+		})
+
+		t.Run("retries deleted gateway on resolve error", func(t *testing.T) {
+			gateway := newRandomGateway()
+			gateway.DeletionTimestamp = new(metav1.Now())
+			gateway.Finalizers = []string{GatewayNetworkSecurityGroupFinalizer}
+			req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(gateway)}
+
+			deps := newMockDeps(t)
+			controller := NewGatewayController(deps)
+
+			wantErr := errors.New(faker.New().Lorem().Sentence(5))
+			mockGatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+			mockGatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), req, mock.MatchedBy(func(receiver *resolvedGatewayDetails) bool {
+					receiver.gateway = *gateway
+					return true
+				})).
+				Return(false, wantErr).Once()
+
+			_, err := controller.Reconcile(t.Context(), req)
+
+			require.ErrorIs(t, err, wantErr)
+		})
+
 		t.Run("ignore irrelevant requests", func(t *testing.T) {
 			gateway := newRandomGateway()
 
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+// gatewayListenerInternal reports whether the listener is programmed on the internal load balancer.
+func gatewayListenerInternal(config types.GatewayConfig, listenerName gatewayv1.SectionName) bool {
+	if config.Spec.InternalLoadBalancerID == "" {
+		return false
+	}
+	for _, listener := range config.Spec.Listeners {
+		if listener.Name == string(listenerName) {
+			return listener.Internal
+		}
+	}
+	return false
+}
+
+// validateGatewayInternalListeners rejects listeners marked as internal that can not be
+// programmed on the internal load balancer.
+func validateGatewayInternalListeners(gateway gatewayv1.Gateway, config types.GatewayConfig) error {
+	for _, configListener := range config.Spec.Listeners {
+		if !configListener.Internal {
+			continue
+		}
+		if config.Spec.InternalLoadBalancerID == "" {
+			return &resourceStatusError{
+				conditionType: string(gatewayv1.GatewayConditionAccepted),
+				reason:        string(gatewayv1.GatewayReasonInvalidParameters),
+				message: fmt.Sprintf(
+					"listener %s is internal, but GatewayConfig %s has no internalLoadBalancerId",
+					configListener.Name, config.Name,
+				),
+			}
+		}
+		for _, listener := range gateway.Spec.Listeners {
+			if string(listener.Name) == configListener.Name && listener.Protocol == gatewayv1.TLSProtocolType {
+				return &resourceStatusError{
+					conditionType: string(gatewayv1.GatewayConditionAccepted),
+					reason:        string(gatewayv1.GatewayReasonInvalidParameters),
+					message:       fmt.Sprintf("listener %s is internal, TLS listeners can not be internal", listener.Name),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// internalLoadBalancerConfig returns the config of the gateway pointing to the internal load balancer.
+func internalLoadBalancerConfig(config types.GatewayConfig) types.GatewayConfig {
+	internalConfig := *config.DeepCopy()
+	internalConfig.Spec.LoadBalancerID = config.Spec.InternalLoadBalancerID
+	internalConfig.Spec.InternalLoadBalancerID = ""
+	return internalConfig
+}
+
+// splitGatewayInternalListeners returns details of the gateway limited to the listeners programmed
+// on the load balancer referenced by the config and details limited to the internal listeners.
+// Internal details are nil if the config has no internal load balancer.
+func splitGatewayInternalListeners(
+	data *resolvedGatewayDetails,
+) (*resolvedGatewayDetails, *resolvedGatewayDetails) {
+	if data.config.Spec.InternalLoadBalancerID == "" {
+		return data, nil
+	}
+	public, internal := *data, *data
+	public.gateway.Spec.Listeners = nil
+	internal.gateway.Spec.Listeners = nil
+	for _, listener := range data.gateway.Spec.Listeners {
+		if gatewayListenerInternal(data.config, listener.Name) {
+			internal.gateway.Spec.Listeners = append(internal.gateway.Spec.Listeners, listener)
+		} else {
+			public.gateway.Spec.Listeners = append(public.gateway.Spec.Listeners, listener)
+		}
+	}
+	internal.config = internalLoadBalancerConfig(data.config)
+	internal.loadBalancer = data.internalLoadBalancer
+	internal.internalLoadBalancer = nil
+	return &public, &internal
+}
+
+// l7RouteParentLoadBalancerConfig returns the config of the load balancer programmed with
+// the listeners the route is attached to. Routes attached to both internal and public
+// listeners of the gateway are not supported, the message explains the problem.
+func l7RouteParentLoadBalancerConfig(
+	config types.GatewayConfig,
+	matchedListeners []gatewayv1.Listener,
+) (types.GatewayConfig, string) {
+	var internal, public []string
+	for _, listener := range matchedListeners {
+		if gatewayListenerInternal(config, listener.Name) {
+			internal = append(internal, string(listener.Name))
+		} else {
+			public = append(public, string(listener.Name))
+		}
+	}
+	switch {
+	case len(internal) == 0:
+		return config, ""
+	case len(public) == 0:
+		return internalLoadBalancerConfig(config), ""
+	default:
+		return config, fmt.Sprintf(
+			"route is attached to internal listeners %s and public listeners %s served by different "+
+				"load balancers, use sectionName to attach the route to listeners of one load balancer",
+			strings.Join(internal, ", "), strings.Join(public, ", "),
+		)
+	}
+}
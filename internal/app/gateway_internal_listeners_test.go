@@ -0,0 +1,144 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestGatewayInternalListeners(t *testing.T) {
+	makeConfig := func(internalListeners ...gatewayv1.SectionName) types.GatewayConfig {
+		config := makeRandomGatewayConfig()
+		config.Spec.InternalLoadBalancerID = faker.New().UUID().V4()
+		for _, name := range internalListeners {
+			config.Spec.Listeners = append(config.Spec.Listeners, types.GatewayConfigListener{
+				Name:     string(name),
+				Internal: true,
+			})
+		}
+		return config
+	}
+
+	t.Run("gatewayListenerInternal", func(t *testing.T) {
+		t.Run("reports internal listeners", func(t *testing.T) {
+			config := makeConfig("internal")
+
+			assert.True(t, gatewayListenerInternal(config, "internal"))
+			assert.False(t, gatewayListenerInternal(config, "public"))
+		})
+
+		t.Run("ignores internal listeners without internal load balancer", func(t *testing.T) {
+			config := makeConfig("internal")
+			config.Spec.InternalLoadBalancerID = ""
+
+			assert.False(t, gatewayListenerInternal(config, "internal"))
+		})
+	})
+
+	t.Run("validateGatewayInternalListeners", func(t *testing.T) {
+		t.Run("accepts internal HTTP listeners", func(t *testing.T) {
+			gateway := newRandomGateway()
+			config := makeConfig(gateway.Spec.Listeners[0].Name)
+
+			require.NoError(t, validateGatewayInternalListeners(*gateway, config))
+		})
+
+		t.Run("rejects internal listeners without internal load balancer", func(t *testing.T) {
+			gateway := newRandomGateway()
+			config := makeConfig(gateway.Spec.Listeners[0].Name)
+			config.Spec.InternalLoadBalancerID = ""
+
+			err := validateGatewayInternalListeners(*gateway, config)
+
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.GatewayConditionAccepted), statusErr.conditionType)
+			assert.Equal(t, string(gatewayv1.GatewayReasonInvalidParameters), statusErr.reason)
+		})
+
+		t.Run("rejects internal TLS listeners", func(t *testing.T) {
+			gateway := newRandomGateway()
+			gateway.Spec.Listeners[0].Protocol = gatewayv1.TLSProtocolType
+			config := makeConfig(gateway.Spec.Listeners[0].Name)
+
+			err := validateGatewayInternalListeners(*gateway, config)
+
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.GatewayReasonInvalidParameters), statusErr.reason)
+		})
+	})
+
+	t.Run("splitGatewayInternalListeners", func(t *testing.T) {
+		t.Run("returns details unchanged without internal load balancer", func(t *testing.T) {
+			data := &resolvedGatewayDetails{gateway: *newRandomGateway(), config: makeRandomGatewayConfig()}
+
+			public, internal := splitGatewayInternalListeners(data)
+
+			assert.Same(t, data, public)
+			assert.Nil(t, internal)
+		})
+
+		t.Run("splits listeners by load balancer", func(t *testing.T) {
+			gateway := newRandomGateway()
+			publicListener := gateway.Spec.Listeners[0]
+			internalListener := makeRandomListener(randomListenerWithHTTPProtocolOpt())
+			gateway.Spec.Listeners = append(gateway.Spec.Listeners, internalListener)
+			config := makeConfig(internalListener.Name)
+			internalLoadBalancer := makeRandomOCILoadBalancer()
+			data := &resolvedGatewayDetails{
+				gateway:              *gateway,
+				config:               config,
+				internalLoadBalancer: &internalLoadBalancer,
+			}
+
+			public, internal := splitGatewayInternalListeners(data)
+
+			assert.Equal(t, []gatewayv1.Listener{publicListener}, public.gateway.Spec.Listeners)
+			assert.Equal(t, config.Spec.LoadBalancerID, public.config.Spec.LoadBalancerID)
+			require.NotNil(t, internal)
+			assert.Equal(t, []gatewayv1.Listener{internalListener}, internal.gateway.Spec.Listeners)
+			assert.Equal(t, config.Spec.InternalLoadBalancerID, internal.config.Spec.LoadBalancerID)
+			assert.Same(t, &internalLoadBalancer, internal.loadBalancer)
+			assert.Len(t, data.gateway.Spec.Listeners, 2)
+		})
+	})
+
+	t.Run("l7RouteParentLoadBalancerConfig", func(t *testing.T) {
+		config := makeConfig("internal")
+		publicListener := gatewayv1.Listener{Name: "public"}
+		internalListener := gatewayv1.Listener{Name: "internal"}
+
+		t.Run("keeps config of routes attached to public listeners", func(t *testing.T) {
+			got, message := l7RouteParentLoadBalancerConfig(config, []gatewayv1.Listener{publicListener})
+
+			assert.Empty(t, message)
+			assert.Equal(t, config, got)
+		})
+
+		t.Run("points routes attached to internal listeners to internal load balancer", func(t *testing.T) {
+			got, message := l7RouteParentLoadBalancerConfig(config, []gatewayv1.Listener{internalListener})
+
+			assert.Empty(t, message)
+			assert.Equal(t, config.Spec.InternalLoadBalancerID, got.Spec.LoadBalancerID)
+		})
+
+		t.Run("reports routes attached to internal and public listeners", func(t *testing.T) {
+			_, message := l7RouteParentLoadBalancerConfig(
+				config,
+				[]gatewayv1.Listener{publicListener, internalListener},
+			)
+
+			assert.Equal(t,
+				"route is attached to internal listeners internal and public listeners public served by different "+
+					"load balancers, use sectionName to attach the route to listeners of one load balancer",
+				message,
+			)
+		})
+	})
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -11,17 +12,26 @@ import (
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/dig"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/gemyago/oke-gateway-api/internal/types"
 )
 
+// errGatewayConfigNotFound is the cause of the status error reported when the GatewayConfig
+// referenced by the gateway does not exist.
+var errGatewayConfigNotFound = errors.New("GatewayConfig not found")
+
 func listenerOCICertificateOCID(listener gatewayv1.Listener) string {
 	if listener.TLS == nil || listener.TLS.Options == nil {
 		return ""
@@ -82,6 +92,85 @@ func validateGatewayCertificateOptions(gateway gatewayv1.Gateway) error {
 	return nil
 }
 
+// validateGatewayTLSVersionOptions checks that listener TLS protocol options are supported by OCI
+// and protocols set explicitly are not below the minimum TLS version.
+func validateGatewayTLSVersionOptions(gateway gatewayv1.Gateway) error {
+	supported := ociListenerTLSProtocols()
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.TLS == nil {
+			continue
+		}
+		newInvalidOptionErr := func(format string, args ...any) error {
+			return &resourceStatusError{
+				conditionType: string(gatewayv1.GatewayConditionAccepted),
+				reason:        string(gatewayv1.GatewayReasonInvalidParameters),
+				message:       fmt.Sprintf("listener %s ", listener.Name) + fmt.Sprintf(format, args...),
+			}
+		}
+
+		allowed := supported
+		if minVersion := strings.TrimSpace(string(listener.TLS.Options[ListenerTLSOptionMinTLSVersion])); minVersion != "" {
+			minVersionIndex := slices.Index(supported, minVersion)
+			if minVersionIndex < 0 {
+				return newInvalidOptionErr("option %s has unsupported TLS version %s, supported versions: %s",
+					ListenerTLSOptionMinTLSVersion, minVersion, strings.Join(supported, ", "))
+			}
+			allowed = supported[minVersionIndex:]
+		}
+
+		for _, protocol := range splitCSVOption(listener.TLS.Options[ListenerTLSOptionProtocols]) {
+			if !slices.Contains(supported, protocol) {
+				return newInvalidOptionErr("option %s has unsupported TLS version %s, supported versions: %s",
+					ListenerTLSOptionProtocols, protocol, strings.Join(supported, ", "))
+			}
+			if !slices.Contains(allowed, protocol) {
+				return newInvalidOptionErr("option %s has TLS version %s below %s %s",
+					ListenerTLSOptionProtocols, protocol, ListenerTLSOptionMinTLSVersion, allowed[0])
+			}
+		}
+	}
+	return nil
+}
+
+// validateGatewayListenerProtocolOptions checks that listener protocol options are set on HTTPS
+// listeners and are supported by OCI. Cleartext HTTP/2 (h2c) backends can not be reached by OCI.
+func validateGatewayListenerProtocolOptions(gateway gatewayv1.Gateway) error {
+	supported := []string{ociListenerProtocolHTTP, ociListenerProtocolHTTP2}
+	for _, listener := range gateway.Spec.Listeners {
+		protocol := listenerProtocolOption(listener.TLS)
+		var message string
+		switch {
+		case protocol == "":
+			continue
+		case listener.Protocol != gatewayv1.HTTPSProtocolType:
+			message = fmt.Sprintf("option %s is only supported on HTTPS listeners", ListenerTLSOptionListenerProtocol)
+		case protocol == "H2C":
+			message = fmt.Sprintf("option %s has unsupported protocol H2C, "+
+				"OCI Load Balancer reaches HTTP/2 backends over TLS only", ListenerTLSOptionListenerProtocol)
+		case !slices.Contains(supported, protocol):
+			message = fmt.Sprintf("option %s has unsupported protocol %s, supported protocols: %s",
+				ListenerTLSOptionListenerProtocol, protocol, strings.Join(supported, ", "))
+		default:
+			continue
+		}
+		return &resourceStatusError{
+			conditionType: string(gatewayv1.GatewayConditionAccepted),
+			reason:        string(gatewayv1.GatewayReasonInvalidParameters),
+			message:       fmt.Sprintf("listener %s ", listener.Name) + message,
+		}
+	}
+	return nil
+}
+
+// listenerProtocolOption returns the OCI listener protocol set by the listener TLS option
+// in upper case, empty if the option is not set.
+func listenerProtocolOption(tlsConfig *gatewayv1.ListenerTLSConfig) string {
+	if tlsConfig == nil {
+		return ""
+	}
+	return strings.ToUpper(strings.TrimSpace(string(tlsConfig.Options[ListenerTLSOptionListenerProtocol])))
+}
+
 type resolvedGatewayDetails struct {
 	gateway      gatewayv1.Gateway
 	gatewayClass gatewayv1.GatewayClass
@@ -92,7 +181,22 @@ type resolvedGatewayDetails struct {
 
 	config types.GatewayConfig
 
+	// Listener policy referenced by the config, nil if not configured
+	listenerPolicy *types.OkeListenerPolicy
+
+	// Access policies targeting the gateway or its listeners
+	accessPolicies []types.OkeAccessPolicy
+
+	// PEM encoded CA certificates to verify client certificates by listener name
+	listenerClientCAs map[string]string
+
+	// Service of the default backend of the config, nil if not configured or not found
+	defaultBackendService *corev1.Service
+
 	loadBalancer *loadbalancer.LoadBalancer
+
+	// Load balancer programmed with the internal listeners, nil if not configured
+	internalLoadBalancer *loadbalancer.LoadBalancer
 }
 
 type gatewayModel interface {
@@ -112,6 +216,10 @@ type gatewayModel interface {
 	isProgrammed(ctx context.Context, data *resolvedGatewayDetails) bool
 
 	setProgrammed(ctx context.Context, data *resolvedGatewayDetails) error
+
+	// deprovisionGateway removes OCI resources of the deleted gateway that are not part of
+	// the load balancer, like NSG security rules, and the finalizer of the gateway.
+	deprovisionGateway(ctx context.Context, data *resolvedGatewayDetails) error
 }
 
 type gatewayModelImpl struct {
@@ -119,7 +227,13 @@ type gatewayModelImpl struct {
 	logger               *slog.Logger
 	ociClient            ociLoadBalancerClient
 	ociLoadBalancerModel ociLoadBalancerModel
+	ociLoggingModel      ociLoggingModel
+	ociNsgModel          ociNetworkSecurityGroupModel
+	ociDNSModel          ociDNSModel
 	resourcesModel       resourcesModel
+	programmingState     programmingStateModel
+	listenerClientCA     listenerClientCAModel
+	checkpoints          *gatewayCheckpoints
 }
 
 func (m *gatewayModelImpl) resolveReconcileRequest(
@@ -153,6 +267,10 @@ func (m *gatewayModelImpl) resolveReconcileRequest(
 		return false, nil
 	}
 
+	if err := validateGatewaySupportedFeatures(receiver.gateway, ControllerClassName); err != nil {
+		return false, err
+	}
+
 	if receiver.gateway.Spec.Infrastructure == nil || receiver.gateway.Spec.Infrastructure.ParametersRef == nil {
 		return false, &resourceStatusError{
 			conditionType: string(gatewayv1.GatewayConditionAccepted),
@@ -172,24 +290,201 @@ func (m *gatewayModelImpl) resolveReconcileRequest(
 				conditionType: string(gatewayv1.GatewayConditionAccepted),
 				reason:        string(gatewayv1.GatewayReasonInvalidParameters),
 				message:       "spec.infrastructure is pointing to a non-existent GatewayConfig",
+				cause:         fmt.Errorf("%w: %w", errGatewayConfigNotFound, err),
 			}
 		}
 		return false, fmt.Errorf("failed to get GatewayConfig %s: %w", configName, err)
 	}
 
+	if err := resolveGatewayClassConfig(ctx, m.client, &receiver.gatewayClass, &receiver.config); err != nil {
+		return false, err
+	}
+
+	if err := validateGatewayConfigLoadBalancerType(receiver.config, ControllerClassName); err != nil {
+		return false, err
+	}
+
+	if err := validateGatewayInternalListeners(receiver.gateway, receiver.config); err != nil {
+		return false, err
+	}
+
+	if err := m.populateListenerPolicy(ctx, receiver); err != nil {
+		return false, err
+	}
+
+	if err := m.populateAccessPolicies(ctx, receiver); err != nil {
+		return false, err
+	}
+
 	if err := validateGatewayCertificateOptions(receiver.gateway); err != nil {
 		return false, err
 	}
 
+	if err := validateGatewayTLSVersionOptions(receiver.gateway); err != nil {
+		return false, err
+	}
+
+	if err := validateGatewayListenerProtocolOptions(receiver.gateway); err != nil {
+		return false, err
+	}
+
+	if err := m.populateListenerClientCAs(ctx, receiver); err != nil {
+		return false, err
+	}
+
 	if err := m.populateGatewaySecrets(ctx, receiver); err != nil {
 		return false, err
 	}
 
+	if err := m.populateDefaultBackendService(ctx, receiver); err != nil {
+		return false, err
+	}
+
 	// TODO: Make sure config is complete
 
 	return true, nil
 }
 
+func (m *gatewayModelImpl) populateListenerPolicy(
+	ctx context.Context,
+	receiver *resolvedGatewayDetails,
+) error {
+	if receiver.config.Spec.ListenerPolicyName == "" {
+		return nil
+	}
+
+	policyName := apitypes.NamespacedName{
+		Namespace: receiver.config.Namespace,
+		Name:      receiver.config.Spec.ListenerPolicyName,
+	}
+	var policy types.OkeListenerPolicy
+	if err := m.client.Get(ctx, policyName, &policy); err != nil {
+		// The CRD may be missing if it was not applied after upgrading the controller
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return &resourceStatusError{
+				conditionType: string(gatewayv1.GatewayConditionAccepted),
+				reason:        string(gatewayv1.GatewayReasonInvalidParameters),
+				message:       fmt.Sprintf("referenced OkeListenerPolicy %s not found", policyName),
+			}
+		}
+		return fmt.Errorf("failed to get OkeListenerPolicy %s: %w", policyName, err)
+	}
+
+	receiver.listenerPolicy = &policy
+	return nil
+}
+
+// populateDefaultBackendService loads the default backend Service to derive the default
+// backend set health check from. A missing Service is not an error: the default backend
+// set then probes the configured port.
+func (m *gatewayModelImpl) populateDefaultBackendService(
+	ctx context.Context,
+	receiver *resolvedGatewayDetails,
+) error {
+	defaultBackend := receiver.config.Spec.DefaultBackend
+	if defaultBackend == nil {
+		return nil
+	}
+
+	serviceName := apitypes.NamespacedName{
+		Namespace: receiver.config.Namespace,
+		Name:      defaultBackend.ServiceName,
+	}
+	var service corev1.Service
+	if err := m.client.Get(ctx, serviceName, &service); err != nil {
+		if apierrors.IsNotFound(err) {
+			m.logger.WarnContext(ctx, "Default backend Service not found",
+				slog.String("service", serviceName.String()),
+			)
+			return nil
+		}
+		return fmt.Errorf("failed to get default backend Service %s: %w", serviceName, err)
+	}
+
+	receiver.defaultBackendService = &service
+	return nil
+}
+
+// defaultBackendServiceAnnotationValue returns the value of the GatewayDefaultBackendServiceAnnotation,
+// so changes of the default backend Service trigger reprogramming of the default backend set.
+func defaultBackendServiceAnnotationValue(service *corev1.Service) string {
+	if service == nil {
+		return ""
+	}
+	return service.Name + "/" + service.ResourceVersion
+}
+
+func (m *gatewayModelImpl) populateAccessPolicies(
+	ctx context.Context,
+	receiver *resolvedGatewayDetails,
+) error {
+	var policyList types.OkeAccessPolicyList
+	if err := m.client.List(ctx, &policyList, client.InNamespace(receiver.gateway.Namespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			receiver.accessPolicies = nil
+			return nil
+		}
+		return fmt.Errorf("failed to list OkeAccessPolicies in namespace %s: %w", receiver.gateway.Namespace, err)
+	}
+
+	receiver.accessPolicies = lo.Filter(policyList.Items, func(policy types.OkeAccessPolicy, _ int) bool {
+		return policy.DeletionTimestamp == nil && accessPolicyTargetsGateway(policy, &receiver.gateway)
+	})
+	return nil
+}
+
+func (m *gatewayModelImpl) populateListenerClientCAs(
+	ctx context.Context,
+	receiver *resolvedGatewayDetails,
+) error {
+	receiver.listenerClientCAs = nil
+	for _, listener := range receiver.gateway.Spec.Listeners {
+		if listener.TLS == nil {
+			continue
+		}
+		configMapName := strings.TrimSpace(string(listener.TLS.Options[ListenerTLSOptionClientCAConfigMap]))
+		if configMapName == "" {
+			continue
+		}
+		newInvalidOptionErr := func(format string, args ...any) error {
+			return &resourceStatusError{
+				conditionType: string(gatewayv1.GatewayConditionAccepted),
+				reason:        string(gatewayv1.GatewayReasonInvalidParameters),
+				message: fmt.Sprintf("listener %s option %s ", listener.Name, ListenerTLSOptionClientCAConfigMap) +
+					fmt.Sprintf(format, args...),
+			}
+		}
+		if listener.Protocol != gatewayv1.HTTPSProtocolType {
+			return newInvalidOptionErr("can only be used with HTTPS listeners")
+		}
+		if _, err := listenerClientVerifyDepth(listener.TLS); err != nil {
+			return newInvalidOptionErr("is used with %s: %v", ListenerTLSOptionClientVerifyDepth, err)
+		}
+
+		configMapKey := apitypes.NamespacedName{Namespace: receiver.gateway.Namespace, Name: configMapName}
+		var configMap corev1.ConfigMap
+		if err := m.client.Get(ctx, configMapKey, &configMap); err != nil {
+			if apierrors.IsNotFound(err) {
+				return newInvalidOptionErr("references ConfigMap %s that was not found", configMapKey)
+			}
+			return fmt.Errorf("failed to get client CA ConfigMap %s: %w", configMapKey, err)
+		}
+		caPEM, ok := configMap.Data["ca.crt"]
+		if !ok {
+			return newInvalidOptionErr("references ConfigMap %s that is missing ca.crt", configMapKey)
+		}
+		if err := validateCABundlePEM(caPEM); err != nil {
+			return newInvalidOptionErr("references ConfigMap %s with invalid ca.crt: %v", configMapKey, err)
+		}
+
+		if receiver.listenerClientCAs == nil {
+			receiver.listenerClientCAs = make(map[string]string)
+		}
+		receiver.listenerClientCAs[string(listener.Name)] = caPEM
+	}
+	return nil
+}
+
 func (m *gatewayModelImpl) populateGatewaySecrets(
 	ctx context.Context,
 	receiver *resolvedGatewayDetails,
@@ -223,7 +518,24 @@ func (m *gatewayModelImpl) populateGatewayListenerSecrets(
 			secretNamespace = string(*certRef.Namespace)
 		}
 
-		if err := m.populateGatewaySecret(ctx, receiver, secretNamespace, secretName); err != nil {
+		allowed, err := referenceGrantAllowsGatewaySecret(ctx, m.client, receiver.gateway.Namespace,
+			apitypes.NamespacedName{Namespace: secretNamespace, Name: secretName})
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return &resourceStatusError{
+				conditionType: string(gatewayv1.ListenerConditionResolvedRefs),
+				reason:        string(gatewayv1.ListenerReasonRefNotPermitted),
+				message: fmt.Sprintf(
+					"Listener %s: certificateRef %s/%s is not permitted by a ReferenceGrant",
+					listener.Name, secretNamespace, secretName,
+				),
+				listenerName: string(listener.Name),
+			}
+		}
+
+		if err = m.populateGatewaySecret(ctx, receiver, secretNamespace, secretName); err != nil {
 			return err
 		}
 	}
@@ -299,56 +611,234 @@ func normalizeProgrammedCertificateNames(certNames []string) []string {
 	return slices.Compact(normalized)
 }
 
-func (m *gatewayModelImpl) programGateway(ctx context.Context, data *resolvedGatewayDetails) error {
+func (m *gatewayModelImpl) programGateway(ctx context.Context, data *resolvedGatewayDetails) (err error) {
 	loadBalancerID := data.config.Spec.LoadBalancerID
-	m.logger.DebugContext(ctx, "Fetching OCI Load Balancer details",
-		slog.String("loadBalancerId", loadBalancerID),
+	ctx, endSpan := startSpan(ctx, "gatewayModel.programGateway",
+		attribute.String("k8s.gateway", data.gateway.Namespace+"/"+data.gateway.Name),
+		attribute.String("oci.load_balancer_id", loadBalancerID),
 	)
+	defer func() {
+		if err == nil {
+			// Retries after failures resume with the failed step, later reconciles run all steps
+			m.checkpoints.clear(&data.gateway)
+		}
+		endSpan(err)
+	}()
 
 	// TODO: We probably need to reset Programmed condition if we're here
 
-	request := loadbalancer.GetLoadBalancerRequest{
-		LoadBalancerId: &loadBalancerID,
+	if data.loadBalancer, err = m.getLoadBalancer(ctx, loadBalancerID); err != nil {
+		return err
+	}
+	if internalLoadBalancerID := data.config.Spec.InternalLoadBalancerID; internalLoadBalancerID != "" {
+		if data.internalLoadBalancer, err = m.getLoadBalancer(ctx, internalLoadBalancerID); err != nil {
+			return err
+		}
+	}
+	if err = checkGatewaySpecAddressesUsable(
+		data.gateway,
+		loadBalancerID,
+		gatewayStatusAddressesFromLoadBalancer(data.loadBalancer, data.internalLoadBalancer),
+	); err != nil {
+		return err
 	}
 
-	response, err := m.ociClient.GetLoadBalancer(ctx, request)
+	// CA bundles are only managed if the gateway has or had listeners with client verification
+	manageClientCAs := len(data.listenerClientCAs) > 0 ||
+		data.gateway.Annotations[GatewayListenerClientCAAnnotation] != ""
+	clientCACompartmentID := lo.CoalesceOrEmpty(
+		data.config.Spec.CompartmentID,
+		lo.FromPtr(data.loadBalancer.CompartmentId),
+	)
+	var clientCABundleIDs map[string]string
+	if manageClientCAs {
+		clientCABundleIDs, err = m.listenerClientCA.reconcileListenersClientCA(ctx, reconcileListenersClientCAParams{
+			gateway:       &data.gateway,
+			compartmentID: clientCACompartmentID,
+			freeformTags:  data.config.Spec.FreeformTags,
+			clientCAs:     data.listenerClientCAs,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to reconcile listeners client CA: %w", err)
+		}
+	}
+
+	// Internal listeners are programmed on the internal load balancer, others on the load balancer of the config
+	public, internal := splitGatewayInternalListeners(data)
+	if err = m.programGatewayLoadBalancer(ctx, public, clientCABundleIDs); err != nil {
+		return err
+	}
+	if internal != nil {
+		if err = m.programGatewayLoadBalancer(ctx, internal, clientCABundleIDs); err != nil {
+			return fmt.Errorf(
+				"failed to program internal load balancer %s: %w",
+				internal.config.Spec.LoadBalancerID,
+				err,
+			)
+		}
+	}
+
+	if manageClientCAs {
+		if err = m.listenerClientCA.removeUnusedListenersClientCA(ctx, removeUnusedListenersClientCAParams{
+			gateway:       &data.gateway,
+			compartmentID: clientCACompartmentID,
+			caBundleIDs:   lo.Values(clientCABundleIDs),
+		}); err != nil {
+			return fmt.Errorf("failed to remove unused listeners client CA: %w", err)
+		}
+	}
+
+	// Rules are removed from the previously used NSG once the NSG is removed or replaced
+	programmedNsgID := data.gateway.Annotations[GatewayNetworkSecurityGroupAnnotation]
+	if programmedNsgID != "" && programmedNsgID != gatewayNetworkSecurityGroupAnnotationValue(data.config) {
+		if err = m.runGatewayStep(ctx, &data.gateway, "nsg-cleanup/"+programmedNsgID, []any{programmedNsgID},
+			func() error {
+				return m.ociNsgModel.removeListenersSecurityRules(ctx, removeListenersSecurityRulesParams{
+					gateway:                &data.gateway,
+					networkSecurityGroupID: programmedNsgID,
+				})
+			},
+		); err != nil {
+			return fmt.Errorf("failed to remove security rules of NSG %s: %w", programmedNsgID, err)
+		}
+	}
+
+	if nsg := data.config.Spec.NetworkSecurityGroup; nsg != nil {
+		if err = m.runGatewayStep(ctx, &data.gateway, "nsg/"+nsg.ID, []any{nsg, data.gateway.Spec.Listeners},
+			func() error {
+				return m.ociNsgModel.reconcileListenersSecurityRules(ctx, reconcileListenersSecurityRulesParams{
+					gateway:              &data.gateway,
+					networkSecurityGroup: *nsg,
+				})
+			},
+		); err != nil {
+			return fmt.Errorf("failed to reconcile NSG security rules: %w", err)
+		}
+	}
+
+	// DNS records are also reconciled after DNS config is removed, so records of the gateway are deleted
+	programmedDNSRecords := parseGatewayDNSRecordsAnnotation(data.gateway.Annotations[GatewayDNSRecordsAnnotation])
+	if data.config.Spec.DNS != nil || programmedDNSRecords.zoneID != "" {
+		// DNS records are not removed with the load balancer, so they are removed before the gateway is deleted
+		if err = m.addGatewayFinalizer(ctx, &data.gateway, GatewayDNSRecordsFinalizer); err != nil {
+			return err
+		}
+		dnsParams := reconcileListenersDNSRecordsParams{
+			gateway:           &data.gateway,
+			dns:               data.config.Spec.DNS,
+			hostnameAddresses: listenerHostnameAddresses(data),
+			programmedRecords: programmedDNSRecords,
+		}
+		if err = m.runGatewayStep(ctx, &data.gateway, "dns",
+			[]any{dnsParams.dns, dnsParams.hostnameAddresses, data.gateway.Annotations[GatewayDNSRecordsAnnotation]},
+			func() error { return m.ociDNSModel.reconcileListenersDNSRecords(ctx, dnsParams) },
+		); err != nil {
+			return fmt.Errorf("failed to reconcile listeners DNS records: %w", err)
+		}
+	}
+
+	if logging := data.config.Spec.Logging; logging != nil {
+		if err = m.runGatewayStep(ctx, &data.gateway, "logs/"+loadBalancerID, []any{logging},
+			func() error {
+				return m.ociLoggingModel.reconcileLoadBalancerLogs(ctx, reconcileLoadBalancerLogsParams{
+					loadBalancerID: loadBalancerID,
+					gateway:        &data.gateway,
+					logging:        *logging,
+				})
+			},
+		); err != nil {
+			return fmt.Errorf("failed to reconcile load balancer logs: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// getLoadBalancer fetches the load balancer referenced by the config of the gateway.
+func (m *gatewayModelImpl) getLoadBalancer(
+	ctx context.Context,
+	loadBalancerID string,
+) (*loadbalancer.LoadBalancer, error) {
+	m.logger.DebugContext(ctx, "Fetching OCI Load Balancer details",
+		slog.String("loadBalancerId", loadBalancerID),
+	)
+
+	response, err := m.ociClient.GetLoadBalancer(ctx, loadbalancer.GetLoadBalancerRequest{
+		LoadBalancerId: &loadBalancerID,
+	})
 	if err != nil {
 		if serviceErr, ok := common.IsServiceError(err); ok &&
 			serviceErr.GetHTTPStatusCode() == http.StatusNotFound {
-			return &resourceStatusError{
-				conditionType: string(gatewayv1.GatewayConditionProgrammed),
-				reason:        string(gatewayv1.GatewayReasonPending),
+			return nil, &resourceStatusError{
+				conditionType: string(gatewayv1.GatewayConditionAccepted),
+				reason:        string(gatewayv1.GatewayReasonInvalidParameters),
 				message:       fmt.Sprintf("referenced OCI Load Balancer %s not found", loadBalancerID),
+				cause:         errLoadBalancerNotFound,
 			}
 		}
-		return fmt.Errorf("failed to get OCI Load Balancer %s: %w", loadBalancerID, err)
+		return nil, fmt.Errorf("failed to get OCI Load Balancer %s: %w", loadBalancerID, err)
 	}
-	data.loadBalancer = &response.LoadBalancer
 
 	// This is very verbose, uncomment if needed
 	// m.logger.DebugContext(ctx, "Successfully retrieved OCI Load Balancer details",
 	// 	slog.Any("loadBalancer", response.LoadBalancer),
 	// )
 
+	return &response.LoadBalancer, nil
+}
+
+// programGatewayLoadBalancer programs listeners of the gateway and resources they use
+// on the load balancer of the given details.
+func (m *gatewayModelImpl) programGatewayLoadBalancer(
+	ctx context.Context,
+	data *resolvedGatewayDetails,
+	clientCABundleIDs map[string]string,
+) error {
+	loadBalancerID := data.config.Spec.LoadBalancerID
+	namePrefix := ociGatewayNamePrefix(&data.gateway, data.config)
+	naming := ociResourceNamingFromConfig(data.config)
+
 	defaultBackendSet, err := m.ociLoadBalancerModel.reconcileDefaultBackendSet(ctx, reconcileDefaultBackendParams{
-		loadBalancerID:   loadBalancerID,
-		knownBackendSets: response.LoadBalancer.BackendSets,
-		gateway:          &data.gateway,
+		loadBalancerID:    loadBalancerID,
+		knownBackendSets:  data.loadBalancer.BackendSets,
+		gateway:           &data.gateway,
+		namePrefix:        namePrefix,
+		naming:            naming,
+		defaultBackend:    data.config.Spec.DefaultBackend,
+		defaultBackendSet: data.config.Spec.DefaultBackendSet,
+		service:           data.defaultBackendService,
+		nodePortBackends:  data.config.Spec.BackendMode == backendModeNodePort,
+		shapeName:         lo.FromPtr(data.loadBalancer.ShapeName),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to program default backend set: %w", err)
 	}
 
+	ruleSetParams := reconcileListenerRuleSetParams{
+		loadBalancerID: loadBalancerID,
+		knownRuleSets:  data.loadBalancer.RuleSets,
+		ruleSetName:    ociListenerRuleSetName(&data.gateway, namePrefix),
+		items:          listenerPolicyRuleSetItems(data.listenerPolicy),
+	}
+	if err = m.reconcileListenerRuleSetStep(ctx, &data.gateway, ruleSetParams); err != nil {
+		return fmt.Errorf("failed to reconcile listener rule set: %w", err)
+	}
+	var listenerRuleSetNames []string
+	if len(ruleSetParams.items) > 0 {
+		listenerRuleSetNames = []string{ruleSetParams.ruleSetName}
+	}
+
 	reconcileListenersCertificatesResult, err := m.ociLoadBalancerModel.reconcileListenersCertificates(ctx,
 		reconcileListenersCertificatesParams{
 			loadBalancerID:    loadBalancerID,
 			gateway:           &data.gateway,
-			knownCertificates: response.LoadBalancer.Certificates,
+			knownCertificates: data.loadBalancer.Certificates,
 		})
 	if err != nil {
 		return fmt.Errorf("failed to reconcile listeners certificates: %w", err)
 	}
 
+	var accessRuleSets []reconcileListenerRuleSetParams
 	for _, listener := range data.gateway.Spec.Listeners {
 		// TODO: Support listener with hostname
 		if listener.Protocol == gatewayv1.TLSProtocolType {
@@ -357,38 +847,125 @@ func (m *gatewayModelImpl) programGateway(ctx context.Context, data *resolvedGat
 
 		listenerName := string(listener.Name)
 
+		accessRuleSetParams := reconcileListenerRuleSetParams{
+			loadBalancerID: loadBalancerID,
+			knownRuleSets:  data.loadBalancer.RuleSets,
+			ruleSetName:    ociListenerAccessRuleSetName(namePrefix, listener.Name),
+			items: accessPolicyRuleSetItems(
+				listenerAllowedSourceCIDRs(data.accessPolicies, &data.gateway, listener.Name),
+			),
+		}
+		if err = m.reconcileListenerRuleSetStep(ctx, &data.gateway, accessRuleSetParams); err != nil {
+			return fmt.Errorf("failed to reconcile access rule set of listener %s: %w", listener.Name, err)
+		}
+		ruleSetNames := slices.Clone(listenerRuleSetNames)
+		if len(accessRuleSetParams.items) > 0 {
+			ruleSetNames = append(ruleSetNames, accessRuleSetParams.ruleSetName)
+		}
+		accessRuleSets = append(accessRuleSets, accessRuleSetParams)
+
+		listenerBackendSetName, backendSetErr := listenerDefaultBackendSetName(
+			data.config,
+			listener.Name,
+			data.loadBalancer.BackendSets,
+			*defaultBackendSet.Name,
+		)
+		if backendSetErr != nil {
+			return backendSetErr
+		}
+
 		params := reconcileHTTPListenerParams{
 			loadBalancerID:        loadBalancerID,
-			knownListeners:        response.LoadBalancer.Listeners,
-			knownRoutingPolicies:  response.LoadBalancer.RoutingPolicies,
+			knownListeners:        data.loadBalancer.Listeners,
+			knownRoutingPolicies:  data.loadBalancer.RoutingPolicies,
 			listenerCertificates:  reconcileListenersCertificatesResult.certificatesByListener[listenerName],
 			listenerCertificateID: reconcileListenersCertificatesResult.certificateIDsByListener[listenerName],
-			defaultBackendSetName: *defaultBackendSet.Name,
+			defaultBackendSetName: listenerBackendSetName,
 			listenerSpec:          &listener,
+			namePrefix:            namePrefix,
+			naming:                naming,
+			ruleSetNames:          ruleSetNames,
+			managedRuleSetNames:   []string{ruleSetParams.ruleSetName, accessRuleSetParams.ruleSetName},
+			clientCABundleID:      clientCABundleIDs[listenerName],
+			shapeName:             lo.FromPtr(data.loadBalancer.ShapeName),
 		}
 
-		if err = m.ociLoadBalancerModel.reconcileHTTPListener(ctx, params); err != nil {
+		if err = m.runGatewayStep(ctx, &data.gateway,
+			"listener/"+loadBalancerID+"/"+naming.listenerName(namePrefix, listener.Name),
+			[]any{
+				listener,
+				params.listenerCertificates,
+				params.listenerCertificateID,
+				params.defaultBackendSetName,
+				params.ruleSetNames,
+				params.clientCABundleID,
+			},
+			func() error { return m.ociLoadBalancerModel.reconcileHTTPListener(ctx, params) },
+		); err != nil {
 			return fmt.Errorf("failed to reconcile listener %s: %w", listener.Name, err)
 		}
 	}
 
+	removedListeners := removedGatewayListeners(
+		namePrefix,
+		naming,
+		data.loadBalancer.Listeners,
+		data.gateway,
+		data.config.Spec.AdoptedListeners,
+	)
+	routeRuleOwners, err := m.removedListenersRuleOwners(ctx, data.gateway, removedListeners)
+	if err != nil {
+		return fmt.Errorf("failed to resolve routes of removed listeners: %w", err)
+	}
+
 	if err = m.ociLoadBalancerModel.removeMissingListeners(ctx, removeMissingListenersParams{
-		loadBalancerID:   loadBalancerID,
-		knownListeners:   response.LoadBalancer.Listeners,
-		gatewayListeners: data.gateway.Spec.Listeners,
+		loadBalancerID:       loadBalancerID,
+		knownListeners:       data.loadBalancer.Listeners,
+		knownRoutingPolicies: data.loadBalancer.RoutingPolicies,
+		gatewayListeners:     data.gateway.Spec.Listeners,
+		routeRuleOwners:      routeRuleOwners,
+		namePrefix:           namePrefix,
+		naming:               naming,
+		adoptedListeners:     data.config.Spec.AdoptedListeners,
+		protectedListeners:   protectedGatewayListeners(data.gateway),
 	}); err != nil {
 		return fmt.Errorf("failed to remove missing listeners: %w", err)
 	}
 
+	if err = m.ociLoadBalancerModel.removeUnusedListenerRuleSet(ctx, ruleSetParams); err != nil {
+		return fmt.Errorf("failed to remove unused listener rule set: %w", err)
+	}
+
+	// Access rule sets of removed listeners are removed as well
+	for _, listenerName := range removedListeners {
+		accessRuleSets = append(accessRuleSets, reconcileListenerRuleSetParams{
+			loadBalancerID: loadBalancerID,
+			knownRuleSets:  data.loadBalancer.RuleSets,
+			ruleSetName:    ociListenerAccessRuleSetName(namePrefix, listenerName),
+		})
+	}
+	for _, accessRuleSetParams := range accessRuleSets {
+		if err = m.ociLoadBalancerModel.removeUnusedListenerRuleSet(ctx, accessRuleSetParams); err != nil {
+			return fmt.Errorf("failed to remove unused access rule set: %w", err)
+		}
+	}
+
+	recordedCertificates, err := m.programmingState.programmedCertificates(ctx, data.gateway)
+	if err != nil {
+		return fmt.Errorf("failed to get programmed certificates: %w", err)
+	}
+
+	// Certificates recorded by older versions of the controller are only known from the annotation
 	if err = m.ociLoadBalancerModel.removeUnusedCertificates(ctx, removeUnusedCertificatesParams{
 		loadBalancerID: loadBalancerID,
-		previouslyProgrammedCertificates: parseProgrammedGatewayCertificatesAnnotation(
-			data.gateway.Annotations[GatewayProgrammedCertificatesAnnotation],
-		),
+		previouslyProgrammedCertificates: normalizeProgrammedCertificateNames(slices.Concat(
+			recordedCertificates,
+			parseProgrammedGatewayCertificatesAnnotation(data.gateway.Annotations[GatewayProgrammedCertificatesAnnotation]),
+		)),
 		desiredCertificates: certificateNamesFromListenerCertificates(
 			reconcileListenersCertificatesResult.certificatesByListener,
 		),
-		knownCertificates: response.LoadBalancer.Certificates,
+		knownCertificates: data.loadBalancer.Certificates,
 	}); err != nil {
 		return fmt.Errorf("failed to remove unused certificates: %w", err)
 	}
@@ -396,27 +973,177 @@ func (m *gatewayModelImpl) programGateway(ctx context.Context, data *resolvedGat
 	return nil
 }
 
-func gatewayStatusAddressesFromLoadBalancer(lb *loadbalancer.LoadBalancer) []gatewayv1.GatewayStatusAddress {
-	if lb == nil || len(lb.IpAddresses) == 0 {
-		return nil
+func gatewayStatusAddressesFromLoadBalancer(lbs ...*loadbalancer.LoadBalancer) []gatewayv1.GatewayStatusAddress {
+	var values []string
+	for _, lb := range lbs {
+		if lb == nil {
+			continue
+		}
+		for _, ipAddress := range lb.IpAddresses {
+			if ipAddress.IpAddress == nil || *ipAddress.IpAddress == "" {
+				continue
+			}
+			values = append(values, *ipAddress.IpAddress)
+		}
+	}
+	return gatewayStatusAddressesFromValues(values)
+}
+
+// listenerDefaultBackendSetName returns the default backend set of the listener. It is the backend
+// set configured for the listener in the GatewayConfig, or the default backend set of the gateway.
+func listenerDefaultBackendSetName(
+	config types.GatewayConfig,
+	listenerName gatewayv1.SectionName,
+	knownBackendSets map[string]loadbalancer.BackendSet,
+	gatewayDefaultBackendSetName string,
+) (string, error) {
+	listenerConfig, found := lo.Find(config.Spec.Listeners, func(item types.GatewayConfigListener) bool {
+		return item.Name == string(listenerName)
+	})
+	if !found || listenerConfig.DefaultBackendSetName == "" {
+		return gatewayDefaultBackendSetName, nil
+	}
+	if _, ok := knownBackendSets[listenerConfig.DefaultBackendSetName]; !ok {
+		return "", &resourceStatusError{
+			conditionType: string(gatewayv1.GatewayConditionAccepted),
+			reason:        string(gatewayv1.GatewayReasonInvalidParameters),
+			message: fmt.Sprintf(
+				"listener %s default backend set %s not found on the load balancer",
+				listenerName,
+				listenerConfig.DefaultBackendSetName,
+			),
+		}
 	}
+	return listenerConfig.DefaultBackendSetName, nil
+}
+
+// reconcileListenerRuleSetStep reconciles the rule set unless a previous attempt
+// of the gateway generation did it with the same items.
+func (m *gatewayModelImpl) reconcileListenerRuleSetStep(
+	ctx context.Context,
+	gateway *gatewayv1.Gateway,
+	params reconcileListenerRuleSetParams,
+) error {
+	return m.runGatewayStep(ctx, gateway,
+		"ruleSet/"+params.loadBalancerID+"/"+params.ruleSetName,
+		[]any{params.items},
+		func() error { return m.ociLoadBalancerModel.reconcileListenerRuleSet(ctx, params) },
+	)
+}
 
-	values := make([]string, 0, len(lb.IpAddresses))
-	for _, ipAddress := range lb.IpAddresses {
-		if ipAddress.IpAddress == nil || *ipAddress.IpAddress == "" {
+// removedGatewayListeners returns names of the gateway listeners programmed on the load balancer
+// that are no longer present in the gateway spec. Adopted listeners are never removed.
+func removedGatewayListeners(
+	namePrefix string,
+	naming ociResourceNaming,
+	knownListeners map[string]loadbalancer.Listener,
+	gateway gatewayv1.Gateway,
+	adoptedListeners []string,
+) []gatewayv1.SectionName {
+	var removed []gatewayv1.SectionName
+	for listenerName, listener := range knownListeners {
+		if slices.Contains(adoptedListeners, listenerName) {
+			continue
+		}
+		if !strings.HasPrefix(listenerName, namePrefix) || slices.ContainsFunc(
+			gateway.Spec.Listeners, func(l gatewayv1.Listener) bool {
+				return naming.listenerName(namePrefix, l.Name) == listenerName ||
+					naming.supersedesListener(namePrefix, l, listenerName, listener)
+			}) {
 			continue
 		}
-		values = append(values, *ipAddress.IpAddress)
+		removed = append(removed, naming.listenerSectionName(namePrefix, listenerName))
 	}
-	return gatewayStatusAddressesFromValues(values)
+	slices.Sort(removed)
+	return removed
+}
+
+// removedListenersRuleOwners maps policy rules recorded on the removed listeners to the routes
+// owning them. Routes that no longer exist or are being deleted are not reported.
+func (m *gatewayModelImpl) removedListenersRuleOwners(
+	ctx context.Context,
+	gateway gatewayv1.Gateway,
+	removedListeners []gatewayv1.SectionName,
+) (map[string][]string, error) {
+	if len(removedListeners) == 0 {
+		return nil, nil
+	}
+
+	routes, err := m.programmingState.programmedRoutes(ctx, gateway)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := map[string][]string{}
+	for _, route := range routes {
+		routeRules := lo.Filter(route.PolicyRules, func(rule string, _ int) bool {
+			listenerName, _, _ := strings.Cut(rule, "/")
+			return slices.Contains(removedListeners, gatewayv1.SectionName(listenerName))
+		})
+		if len(routeRules) == 0 {
+			continue
+		}
+
+		exists, existsErr := m.programmedRouteExists(ctx, route)
+		if existsErr != nil {
+			return nil, existsErr
+		}
+		if !exists {
+			continue
+		}
+
+		owner := fmt.Sprintf("%s %s/%s", route.Kind, route.Namespace, route.Name)
+		for _, rule := range routeRules {
+			owners[rule] = append(owners[rule], owner)
+		}
+	}
+	return owners, nil
+}
+
+func (m *gatewayModelImpl) programmedRouteExists(
+	ctx context.Context,
+	route types.OkeGatewayProgrammedRoute,
+) (bool, error) {
+	var obj client.Object
+	switch route.Kind {
+	case "HTTPRoute":
+		obj = &gatewayv1.HTTPRoute{}
+	case "GRPCRoute":
+		obj = &gatewayv1.GRPCRoute{}
+	default:
+		// Other routes are not programmed with listener policy rules
+		return false, nil
+	}
+
+	key := apitypes.NamespacedName{Namespace: route.Namespace, Name: route.Name}
+	if err := m.client.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get %s %s: %w", route.Kind, key, err)
+	}
+	return obj.GetDeletionTimestamp() == nil, nil
 }
 
 func (m *gatewayModelImpl) isProgrammed(_ context.Context, data *resolvedGatewayDetails) bool {
+	// Programming of the changes has started but not completed yet
+	if isProgrammingPending(data.gateway.Status.Conditions, string(gatewayv1.GatewayConditionProgrammed)) {
+		return false
+	}
+
 	annotations := map[string]string{
 		GatewayProgrammingRevisionAnnotation: GatewayProgrammingRevisionValue,
 		GatewayProgrammedCertificatesAnnotation: programmedGatewayCertificatesAnnotation(
 			programmedCertificateNamesFromSecrets(data.gatewaySecrets),
 		),
+		GatewayListenerPolicyAnnotation:   listenerPolicyAnnotationValue(data.listenerPolicy),
+		GatewayAccessPoliciesAnnotation:   accessPoliciesAnnotationValue(data.accessPolicies),
+		GatewayListenerClientCAAnnotation: listenerClientCAAnnotationValue(data.listenerClientCAs),
+		GatewayDefaultBackendServiceAnnotation: defaultBackendServiceAnnotationValue(
+			data.defaultBackendService,
+		),
+		GatewayDNSRecordsAnnotation:           gatewayDNSRecordsAnnotationValue(data.gateway, data.config),
+		GatewayNetworkSecurityGroupAnnotation: gatewayNetworkSecurityGroupAnnotationValue(data.config),
 	}
 
 	// Include secrets annotations in the check
@@ -437,11 +1164,27 @@ func (m *gatewayModelImpl) isProgrammed(_ context.Context, data *resolvedGateway
 }
 
 func (m *gatewayModelImpl) setProgrammed(ctx context.Context, data *resolvedGatewayDetails) error {
+	programmedCertificates := programmedCertificateNamesFromSecrets(data.gatewaySecrets)
+	if err := m.programmingState.recordProgrammedCertificates(ctx, recordProgrammedCertificatesParams{
+		gateway:      data.gateway,
+		certificates: programmedCertificates,
+	}); err != nil {
+		return fmt.Errorf("failed to record programmed certificates of Gateway %s: %w", data.gateway.Name, err)
+	}
+
 	annotations := map[string]string{
 		GatewayProgrammingRevisionAnnotation: GatewayProgrammingRevisionValue,
 		GatewayProgrammedCertificatesAnnotation: programmedGatewayCertificatesAnnotation(
-			programmedCertificateNamesFromSecrets(data.gatewaySecrets),
+			programmedCertificates,
 		),
+		GatewayListenerPolicyAnnotation:   listenerPolicyAnnotationValue(data.listenerPolicy),
+		GatewayAccessPoliciesAnnotation:   accessPoliciesAnnotationValue(data.accessPolicies),
+		GatewayListenerClientCAAnnotation: listenerClientCAAnnotationValue(data.listenerClientCAs),
+		GatewayDefaultBackendServiceAnnotation: defaultBackendServiceAnnotationValue(
+			data.defaultBackendService,
+		),
+		GatewayDNSRecordsAnnotation:           gatewayDNSRecordsAnnotationValue(data.gateway, data.config),
+		GatewayNetworkSecurityGroupAnnotation: gatewayNetworkSecurityGroupAnnotationValue(data.config),
 	}
 
 	if len(data.gatewaySecrets) > 0 {
@@ -452,7 +1195,14 @@ func (m *gatewayModelImpl) setProgrammed(ctx context.Context, data *resolvedGate
 		}
 	}
 
-	data.gateway.Status.Addresses = gatewayStatusAddressesFromLoadBalancer(data.loadBalancer)
+	// NSG rules are not removed with the load balancer, so they are removed before the gateway is deleted
+	var finalizer string
+	if data.config.Spec.NetworkSecurityGroup != nil {
+		finalizer = GatewayNetworkSecurityGroupFinalizer
+	}
+
+	data.gateway.Status.Addresses = gatewayStatusAddressesFromLoadBalancer(data.loadBalancer, data.internalLoadBalancer)
+	resolveListenerStatusRefs(&data.gateway)
 	if err := m.resourcesModel.setCondition(ctx, setConditionParams{
 		resource:      &data.gateway,
 		conditions:    &data.gateway.Status.Conditions,
@@ -461,9 +1211,81 @@ func (m *gatewayModelImpl) setProgrammed(ctx context.Context, data *resolvedGate
 		reason:        string(gatewayv1.GatewayReasonProgrammed),
 		message:       fmt.Sprintf("Gateway %s programmed by %s", data.gateway.Name, ControllerClassName),
 		annotations:   annotations,
+		finalizer:     finalizer,
 	}); err != nil {
 		return fmt.Errorf("failed to set programmed condition for Gateway %s: %w", data.gateway.Name, err)
 	}
+	return m.removeStaleGatewayFinalizers(ctx, data)
+}
+
+// addGatewayFinalizer adds the finalizer to the gateway unless it is already present.
+func (m *gatewayModelImpl) addGatewayFinalizer(
+	ctx context.Context,
+	gateway *gatewayv1.Gateway,
+	finalizer string,
+) error {
+	if !controllerutil.AddFinalizer(gateway, finalizer) {
+		return nil
+	}
+	if err := m.client.Update(ctx, gateway); err != nil {
+		return fmt.Errorf("failed to add finalizer %s to Gateway %s: %w", finalizer, gateway.Name, err)
+	}
+	return nil
+}
+
+// removeStaleGatewayFinalizers removes finalizers of NSG rules and DNS records the gateway no longer has.
+// The status update refreshes the gateway, so finalizers are removed after the programmed condition is set.
+func (m *gatewayModelImpl) removeStaleGatewayFinalizers(ctx context.Context, data *resolvedGatewayDetails) error {
+	removed := data.config.Spec.NetworkSecurityGroup == nil &&
+		controllerutil.RemoveFinalizer(&data.gateway, GatewayNetworkSecurityGroupFinalizer)
+	if gatewayDNSRecordsAnnotationValue(data.gateway, data.config) == "" &&
+		controllerutil.RemoveFinalizer(&data.gateway, GatewayDNSRecordsFinalizer) {
+		removed = true
+	}
+	if !removed {
+		return nil
+	}
+	if err := m.client.Update(ctx, &data.gateway); err != nil {
+		return fmt.Errorf("failed to remove stale finalizers of Gateway %s: %w", data.gateway.Name, err)
+	}
+	return nil
+}
+
+func (m *gatewayModelImpl) deprovisionGateway(ctx context.Context, data *resolvedGatewayDetails) error {
+	nsgID := lo.CoalesceOrEmpty(
+		data.gateway.Annotations[GatewayNetworkSecurityGroupAnnotation],
+		gatewayNetworkSecurityGroupAnnotationValue(data.config),
+	)
+	if nsgID != "" {
+		if err := m.ociNsgModel.removeListenersSecurityRules(ctx, removeListenersSecurityRulesParams{
+			gateway:                &data.gateway,
+			networkSecurityGroupID: nsgID,
+		}); err != nil {
+			return fmt.Errorf("failed to remove NSG security rules of Gateway %s: %w", data.gateway.Name, err)
+		}
+	}
+
+	// Records of all hostnames are stale without the DNS config, so all of them are removed
+	dnsRecords := parseGatewayDNSRecordsAnnotation(lo.CoalesceOrEmpty(
+		data.gateway.Annotations[GatewayDNSRecordsAnnotation],
+		gatewayDNSRecordsAnnotationValue(data.gateway, data.config),
+	))
+	if dnsRecords.zoneID != "" {
+		if err := m.ociDNSModel.reconcileListenersDNSRecords(ctx, reconcileListenersDNSRecordsParams{
+			gateway:           &data.gateway,
+			programmedRecords: dnsRecords,
+		}); err != nil {
+			return fmt.Errorf("failed to remove DNS records of Gateway %s: %w", data.gateway.Name, err)
+		}
+	}
+
+	nsgFinalizerRemoved := controllerutil.RemoveFinalizer(&data.gateway, GatewayNetworkSecurityGroupFinalizer)
+	dnsFinalizerRemoved := controllerutil.RemoveFinalizer(&data.gateway, GatewayDNSRecordsFinalizer)
+	if nsgFinalizerRemoved || dnsFinalizerRemoved {
+		if err := m.client.Update(ctx, &data.gateway); err != nil {
+			return fmt.Errorf("failed to remove finalizer of Gateway %s: %w", data.gateway.Name, err)
+		}
+	}
 	return nil
 }
 
@@ -475,6 +1297,12 @@ type gatewayModelDeps struct {
 	RootLogger           *slog.Logger
 	OciClient            ociLoadBalancerClient
 	OciLoadBalancerModel ociLoadBalancerModel
+	OciLoggingModel      ociLoggingModel
+	OciNsgModel          ociNetworkSecurityGroupModel
+	OciDNSModel          ociDNSModel
+	ProgrammingState     programmingStateModel
+	ListenerClientCA     listenerClientCAModel
+	Checkpoints          *gatewayCheckpoints `optional:"true"`
 }
 
 func newGatewayModel(deps gatewayModelDeps) *gatewayModelImpl {
@@ -483,6 +1311,12 @@ func newGatewayModel(deps gatewayModelDeps) *gatewayModelImpl {
 		logger:               deps.RootLogger.WithGroup("gateway-model"),
 		ociClient:            deps.OciClient,
 		ociLoadBalancerModel: deps.OciLoadBalancerModel,
+		ociLoggingModel:      deps.OciLoggingModel,
+		ociNsgModel:          deps.OciNsgModel,
+		ociDNSModel:          deps.OciDNSModel,
 		resourcesModel:       deps.ResourcesModel,
+		programmingState:     deps.ProgrammingState,
+		listenerClientCA:     deps.ListenerClientCA,
+		checkpoints:          deps.Checkpoints,
 	}
 }
@@ -15,12 +15,14 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/gemyago/oke-gateway-api/internal/diag"
 	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
@@ -35,6 +37,11 @@ func TestGatewayModelImpl(t *testing.T) {
 			RootLogger:           diag.RootTestLogger(),
 			OciClient:            NewMockociLoadBalancerClient(t),
 			OciLoadBalancerModel: NewMockociLoadBalancerModel(t),
+			OciLoggingModel:      NewMockociLoggingModel(t),
+			OciNsgModel:          NewMockociNetworkSecurityGroupModel(t),
+			OciDNSModel:          NewMockociDNSModel(t),
+			ProgrammingState:     NewMockprogrammingStateModel(t),
+			ListenerClientCA:     NewMocklistenerClientCAModel(t),
 		}
 	}
 
@@ -114,6 +121,10 @@ func TestGatewayModelImpl(t *testing.T) {
 					return nil
 				})
 
+			mockClient.EXPECT().
+				List(t.Context(), &types.OkeAccessPolicyList{}, client.InNamespace(gateway.Namespace)).
+				Return(nil)
+
 			var receiver resolvedGatewayDetails
 			relevant, err := model.resolveReconcileRequest(t.Context(), req, &receiver)
 
@@ -491,7 +502,7 @@ func TestGatewayModelImpl(t *testing.T) {
 			assert.Equal(t, string(gatewayv1.GatewayConditionAccepted), statusErr.conditionType)
 			assert.Equal(t, string(gatewayv1.GatewayReasonInvalidParameters), statusErr.reason)
 			assert.Equal(t, "spec.infrastructure is pointing to a non-existent GatewayConfig", statusErr.message)
-			assert.NoError(t, statusErr.cause)
+			assert.ErrorIs(t, err, errGatewayConfigNotFound)
 		})
 
 		t.Run("error getting GatewayConfig", func(t *testing.T) {
@@ -671,6 +682,11 @@ func TestGatewayModelImpl(t *testing.T) {
 					return nil
 				})
 
+			mockClient.EXPECT().
+				List(t.Context(), &types.OkeAccessPolicyList{}, client.InNamespace(gateway.Namespace)).
+				Return(nil)
+			setupGatewaySecretGrants(t, mockClient, gateway.Namespace)
+
 			// Expect calls to get secrets
 			for _, listener := range gateway.Spec.Listeners {
 				if listener.TLS != nil {
@@ -811,6 +827,11 @@ func TestGatewayModelImpl(t *testing.T) {
 					return nil
 				})
 
+			mockClient.EXPECT().
+				List(t.Context(), &types.OkeAccessPolicyList{}, client.InNamespace(gateway.Namespace)).
+				Return(nil)
+			setupGatewaySecretGrants(t, mockClient, gateway.Namespace)
+
 			// Make one of the secret fetches fail with NotFound
 			certRef := listener.TLS.CertificateRefs[0]
 			secretName := string(certRef.Name)
@@ -846,6 +867,521 @@ func TestGatewayModelImpl(t *testing.T) {
 		})
 	})
 
+	t.Run("populateListenerPolicy", func(t *testing.T) {
+		t.Run("skips config without listener policy", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			receiver := resolvedGatewayDetails{config: makeRandomGatewayConfig()}
+
+			require.NoError(t, model.populateListenerPolicy(t.Context(), &receiver))
+			assert.Nil(t, receiver.listenerPolicy)
+		})
+
+		t.Run("populates referenced listener policy", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			config := makeRandomGatewayConfig()
+			config.Namespace = fake.Internet().Domain()
+			config.Spec.ListenerPolicyName = fake.Internet().Domain()
+			policy := types.OkeListenerPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  config.Namespace,
+					Name:       config.Spec.ListenerPolicyName,
+					Generation: fake.Int64Between(1, 100),
+				},
+				Spec: types.OkeListenerPolicySpec{
+					AllowedMethods: &types.OkeListenerPolicyAllowedMethods{Methods: []string{"GET"}},
+				},
+			}
+
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockClient.EXPECT().
+				Get(t.Context(), apitypes.NamespacedName{
+					Namespace: config.Namespace,
+					Name:      config.Spec.ListenerPolicyName,
+				}, mock.Anything).
+				RunAndReturn(func(
+					_ context.Context,
+					_ apitypes.NamespacedName,
+					receiver client.Object,
+					_ ...client.GetOption,
+				) error {
+					reflect.ValueOf(receiver).Elem().Set(reflect.ValueOf(policy))
+					return nil
+				})
+
+			receiver := resolvedGatewayDetails{config: config}
+			require.NoError(t, model.populateListenerPolicy(t.Context(), &receiver))
+			assert.Equal(t, &policy, receiver.listenerPolicy)
+		})
+
+		t.Run("returns status error if listener policy not found", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			config := makeRandomGatewayConfig()
+			config.Namespace = fake.Internet().Domain()
+			config.Spec.ListenerPolicyName = fake.Internet().Domain()
+
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockClient.EXPECT().
+				Get(t.Context(), mock.Anything, mock.Anything).
+				Return(apierrors.NewNotFound(schema.GroupResource{
+					Group:    types.GroupName,
+					Resource: "oke-listener-policies",
+				}, config.Spec.ListenerPolicyName))
+
+			err := model.populateListenerPolicy(t.Context(), &resolvedGatewayDetails{config: config})
+
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.GatewayConditionAccepted), statusErr.conditionType)
+			assert.Equal(t, string(gatewayv1.GatewayReasonInvalidParameters), statusErr.reason)
+			assert.Equal(t,
+				fmt.Sprintf("referenced OkeListenerPolicy %s/%s not found", config.Namespace, config.Spec.ListenerPolicyName),
+				statusErr.message,
+			)
+		})
+
+		t.Run("returns listener policy get errors", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			config := makeRandomGatewayConfig()
+			config.Spec.ListenerPolicyName = fake.Internet().Domain()
+
+			wantErr := errors.New(fake.Lorem().Sentence(10))
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockClient.EXPECT().
+				Get(t.Context(), mock.Anything, mock.Anything).
+				Return(wantErr)
+
+			err := model.populateListenerPolicy(t.Context(), &resolvedGatewayDetails{config: config})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("populateAccessPolicies", func(t *testing.T) {
+		t.Run("populates policies targeting the gateway", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			gateway := newRandomGateway()
+
+			makePolicy := func(targetName string) types.OkeAccessPolicy {
+				return types.OkeAccessPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: gateway.Namespace,
+						Name:      fake.Internet().Domain(),
+					},
+					Spec: types.OkeAccessPolicySpec{
+						TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+							{
+								LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+									Group: gatewayv1.GroupName,
+									Kind:  "Gateway",
+									Name:  gatewayv1.ObjectName(targetName),
+								},
+							},
+						},
+						AllowedSourceCIDRs: []string{"10.0.0.0/8"},
+					},
+				}
+			}
+			matchingPolicy := makePolicy(gateway.Name)
+			otherPolicy := makePolicy(fake.Internet().Domain())
+			deletedPolicy := makePolicy(gateway.Name)
+			now := metav1.Now()
+			deletedPolicy.DeletionTimestamp = &now
+
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockClient.EXPECT().
+				List(t.Context(), &types.OkeAccessPolicyList{}, client.InNamespace(gateway.Namespace)).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					list.(*types.OkeAccessPolicyList).Items = []types.OkeAccessPolicy{
+						matchingPolicy, otherPolicy, deletedPolicy,
+					}
+					return nil
+				})
+
+			receiver := resolvedGatewayDetails{gateway: *gateway}
+			require.NoError(t, model.populateAccessPolicies(t.Context(), &receiver))
+			assert.Equal(t, []types.OkeAccessPolicy{matchingPolicy}, receiver.accessPolicies)
+		})
+
+		t.Run("ignores missing CRD", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockClient.EXPECT().
+				List(t.Context(), mock.Anything, mock.Anything).
+				Return(&meta.NoKindMatchError{
+					GroupKind: schema.GroupKind{Group: types.GroupName, Kind: "OkeAccessPolicy"},
+				})
+
+			receiver := resolvedGatewayDetails{gateway: *newRandomGateway()}
+			require.NoError(t, model.populateAccessPolicies(t.Context(), &receiver))
+			assert.Empty(t, receiver.accessPolicies)
+		})
+
+		t.Run("returns list errors", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			wantErr := errors.New(fake.Lorem().Sentence(10))
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockClient.EXPECT().
+				List(t.Context(), mock.Anything, mock.Anything).
+				Return(wantErr)
+
+			err := model.populateAccessPolicies(t.Context(), &resolvedGatewayDetails{gateway: *newRandomGateway()})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("populateListenerClientCAs", func(t *testing.T) {
+		makeGateway := func(
+			protocol gatewayv1.ProtocolType,
+			options map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue,
+		) *gatewayv1.Gateway {
+			return newRandomGateway(func(gw *gatewayv1.Gateway) {
+				gw.Spec.Listeners = []gatewayv1.Listener{
+					{Name: "http", Protocol: gatewayv1.HTTPProtocolType, Port: 80},
+					{
+						Name:     "mtls",
+						Protocol: protocol,
+						Port:     443,
+						TLS:      &gatewayv1.ListenerTLSConfig{Options: options},
+					},
+				}
+			})
+		}
+		clientCAOptions := func(configMapName string) map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue {
+			return map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+				ListenerTLSOptionClientCAConfigMap: gatewayv1.AnnotationValue(configMapName),
+			}
+		}
+		expectConfigMap := func(
+			t *testing.T,
+			deps gatewayModelDeps,
+			gateway *gatewayv1.Gateway,
+			configMapName string,
+			data map[string]string,
+		) {
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockClient.EXPECT().
+				Get(t.Context(), apitypes.NamespacedName{
+					Namespace: gateway.Namespace,
+					Name:      configMapName,
+				}, mock.Anything).
+				RunAndReturn(func(
+					_ context.Context,
+					_ apitypes.NamespacedName,
+					receiver client.Object,
+					_ ...client.GetOption,
+				) error {
+					receiver.(*corev1.ConfigMap).Data = data
+					return nil
+				})
+		}
+
+		t.Run("skips listeners without client CA", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			receiver := resolvedGatewayDetails{gateway: *makeGateway(gatewayv1.HTTPSProtocolType, nil)}
+
+			require.NoError(t, model.populateListenerClientCAs(t.Context(), &receiver))
+			assert.Nil(t, receiver.listenerClientCAs)
+		})
+
+		t.Run("populates client CA of the listener", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			configMapName := fake.Internet().Domain()
+			gateway := makeGateway(gatewayv1.HTTPSProtocolType, clientCAOptions(configMapName))
+			caPEM := testCAPEM(t)
+			expectConfigMap(t, deps, gateway, configMapName, map[string]string{"ca.crt": caPEM})
+
+			receiver := resolvedGatewayDetails{gateway: *gateway}
+			require.NoError(t, model.populateListenerClientCAs(t.Context(), &receiver))
+			assert.Equal(t, map[string]string{"mtls": caPEM}, receiver.listenerClientCAs)
+		})
+
+		t.Run("returns status error for invalid client CA", func(t *testing.T) {
+			fake := faker.New()
+			configMapName := fake.Internet().Domain()
+			tests := []struct {
+				name        string
+				protocol    gatewayv1.ProtocolType
+				options     map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue
+				data        map[string]string
+				notFound    bool
+				wantMessage string
+			}{
+				{
+					name:        "non HTTPS listener",
+					protocol:    gatewayv1.TLSProtocolType,
+					options:     clientCAOptions(configMapName),
+					wantMessage: "can only be used with HTTPS listeners",
+				},
+				{
+					name:     "invalid verify depth",
+					protocol: gatewayv1.HTTPSProtocolType,
+					options: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+						ListenerTLSOptionClientCAConfigMap: gatewayv1.AnnotationValue(configMapName),
+						ListenerTLSOptionClientVerifyDepth: "0",
+					},
+					wantMessage: "must be a positive integer",
+				},
+				{
+					name:        "config map not found",
+					protocol:    gatewayv1.HTTPSProtocolType,
+					options:     clientCAOptions(configMapName),
+					notFound:    true,
+					wantMessage: "that was not found",
+				},
+				{
+					name:        "missing ca.crt",
+					protocol:    gatewayv1.HTTPSProtocolType,
+					options:     clientCAOptions(configMapName),
+					data:        map[string]string{"tls.crt": testCAPEM(t)},
+					wantMessage: "that is missing ca.crt",
+				},
+				{
+					name:        "invalid ca.crt",
+					protocol:    gatewayv1.HTTPSProtocolType,
+					options:     clientCAOptions(configMapName),
+					data:        map[string]string{"ca.crt": nonCAPEM(t)},
+					wantMessage: "with invalid ca.crt",
+				},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					deps := newMockDeps(t)
+					model := newGatewayModel(deps)
+					gateway := makeGateway(tt.protocol, tt.options)
+					switch {
+					case tt.notFound:
+						mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+						mockClient.EXPECT().
+							Get(t.Context(), mock.Anything, mock.Anything).
+							Return(apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, configMapName))
+					case tt.data != nil:
+						expectConfigMap(t, deps, gateway, configMapName, tt.data)
+					}
+
+					err := model.populateListenerClientCAs(t.Context(), &resolvedGatewayDetails{gateway: *gateway})
+
+					var statusErr *resourceStatusError
+					require.ErrorAs(t, err, &statusErr)
+					assert.Equal(t, string(gatewayv1.GatewayConditionAccepted), statusErr.conditionType)
+					assert.Equal(t, string(gatewayv1.GatewayReasonInvalidParameters), statusErr.reason)
+					assert.Contains(t, statusErr.message, "listener mtls option "+ListenerTLSOptionClientCAConfigMap)
+					assert.Contains(t, statusErr.message, tt.wantMessage)
+				})
+			}
+		})
+
+		t.Run("returns config map get errors", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			gateway := makeGateway(gatewayv1.HTTPSProtocolType, clientCAOptions(fake.Internet().Domain()))
+
+			wantErr := errors.New(fake.Lorem().Sentence(10))
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockClient.EXPECT().
+				Get(t.Context(), mock.Anything, mock.Anything).
+				Return(wantErr)
+
+			err := model.populateListenerClientCAs(t.Context(), &resolvedGatewayDetails{gateway: *gateway})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("populateDefaultBackendService", func(t *testing.T) {
+		makeReceiver := func() resolvedGatewayDetails {
+			config := makeRandomGatewayConfig()
+			config.Spec.DefaultBackend = &types.GatewayConfigDefaultBackend{
+				ServiceName: faker.New().Internet().Slug(),
+				Port:        8080,
+			}
+			return resolvedGatewayDetails{config: config}
+		}
+		expectGetService := func(
+			t *testing.T,
+			deps gatewayModelDeps,
+			receiver resolvedGatewayDetails,
+		) *Mockk8sClient_Get_Call {
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			return mockClient.EXPECT().
+				Get(t.Context(), apitypes.NamespacedName{
+					Namespace: receiver.config.Namespace,
+					Name:      receiver.config.Spec.DefaultBackend.ServiceName,
+				}, mock.AnythingOfType("*v1.Service"))
+		}
+
+		t.Run("skips config without default backend", func(t *testing.T) {
+			model := newGatewayModel(newMockDeps(t))
+			receiver := resolvedGatewayDetails{config: makeRandomGatewayConfig()}
+
+			require.NoError(t, model.populateDefaultBackendService(t.Context(), &receiver))
+			assert.Nil(t, receiver.defaultBackendService)
+		})
+
+		t.Run("populates default backend service", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			receiver := makeReceiver()
+			service := makeRandomService()
+			expectGetService(t, deps, receiver).
+				RunAndReturn(func(
+					_ context.Context,
+					_ apitypes.NamespacedName,
+					obj client.Object,
+					_ ...client.GetOption,
+				) error {
+					*obj.(*corev1.Service) = service
+					return nil
+				})
+
+			require.NoError(t, model.populateDefaultBackendService(t.Context(), &receiver))
+			assert.Equal(t, &service, receiver.defaultBackendService)
+			assert.Equal(t, service.Name+"/"+service.ResourceVersion,
+				defaultBackendServiceAnnotationValue(receiver.defaultBackendService))
+		})
+
+		t.Run("ignores missing default backend service", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			receiver := makeReceiver()
+			expectGetService(t, deps, receiver).
+				Return(apierrors.NewNotFound(schema.GroupResource{Resource: "services"},
+					receiver.config.Spec.DefaultBackend.ServiceName))
+
+			require.NoError(t, model.populateDefaultBackendService(t.Context(), &receiver))
+			assert.Nil(t, receiver.defaultBackendService)
+			assert.Empty(t, defaultBackendServiceAnnotationValue(receiver.defaultBackendService))
+		})
+
+		t.Run("returns error if failed to get default backend service", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			receiver := makeReceiver()
+			wantErr := errors.New(faker.New().Lorem().Sentence(3))
+			expectGetService(t, deps, receiver).Return(wantErr)
+
+			require.ErrorIs(t, model.populateDefaultBackendService(t.Context(), &receiver), wantErr)
+		})
+	})
+
+	t.Run("removedListenersRuleOwners", func(t *testing.T) {
+		t.Run("returns existing routes owning rules of removed listeners", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			gateway := newRandomGateway()
+			removedListener := gatewayv1.SectionName("removed-" + fake.Lorem().Word())
+			keptListener := gatewayv1.SectionName("kept-" + fake.Lorem().Word())
+
+			liveRoute := types.OkeGatewayProgrammedRoute{
+				Kind:      "HTTPRoute",
+				Namespace: gateway.Namespace,
+				Name:      "live-" + fake.Lorem().Word(),
+				PolicyRules: []string{
+					string(removedListener) + "/live-rule",
+					string(keptListener) + "/live-rule",
+				},
+			}
+			deletedRoute := types.OkeGatewayProgrammedRoute{
+				Kind:        "GRPCRoute",
+				Namespace:   gateway.Namespace,
+				Name:        "deleted-" + fake.Lorem().Word(),
+				PolicyRules: []string{string(removedListener) + "/deleted-rule"},
+			}
+			keptRoute := types.OkeGatewayProgrammedRoute{
+				Kind:        "HTTPRoute",
+				Namespace:   gateway.Namespace,
+				Name:        "kept-" + fake.Lorem().Word(),
+				PolicyRules: []string{string(keptListener) + "/kept-rule"},
+			}
+
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedRoutes(t.Context(), *gateway).
+				Return([]types.OkeGatewayProgrammedRoute{liveRoute, deletedRoute, keptRoute}, nil)
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockClient.EXPECT().
+				Get(t.Context(), apitypes.NamespacedName{Namespace: liveRoute.Namespace, Name: liveRoute.Name},
+					mock.AnythingOfType("*v1.HTTPRoute")).
+				Return(nil).
+				Once()
+			mockClient.EXPECT().
+				Get(t.Context(), apitypes.NamespacedName{Namespace: deletedRoute.Namespace, Name: deletedRoute.Name},
+					mock.AnythingOfType("*v1.GRPCRoute")).
+				Return(apierrors.NewNotFound(schema.GroupResource{
+					Group:    gatewayv1.GroupName,
+					Resource: "GRPCRoute",
+				}, deletedRoute.Name)).
+				Once()
+
+			owners, err := model.removedListenersRuleOwners(
+				t.Context(), *gateway, []gatewayv1.SectionName{removedListener},
+			)
+
+			require.NoError(t, err)
+			assert.Equal(t, map[string][]string{
+				string(removedListener) + "/live-rule": {"HTTPRoute " + liveRoute.Namespace + "/" + liveRoute.Name},
+			}, owners)
+		})
+
+		t.Run("skips lookup without removed listeners", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			owners, err := model.removedListenersRuleOwners(t.Context(), *newRandomGateway(), nil)
+
+			require.NoError(t, err)
+			assert.Nil(t, owners)
+		})
+
+		t.Run("returns route get errors", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			gateway := newRandomGateway()
+			removedListener := gatewayv1.SectionName("removed-" + fake.Lorem().Word())
+
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedRoutes(t.Context(), *gateway).
+				Return([]types.OkeGatewayProgrammedRoute{{
+					Kind:        "HTTPRoute",
+					Namespace:   gateway.Namespace,
+					Name:        fake.Lorem().Word(),
+					PolicyRules: []string{string(removedListener) + "/rule"},
+				}}, nil)
+			wantErr := errors.New(fake.Lorem().Sentence(10))
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockClient.EXPECT().
+				Get(t.Context(), mock.Anything, mock.Anything).
+				Return(wantErr)
+
+			_, err := model.removedListenersRuleOwners(
+				t.Context(), *gateway, []gatewayv1.SectionName{removedListener},
+			)
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
 	t.Run("programGateway", func(t *testing.T) {
 		t.Run("programSucceeded", func(t *testing.T) {
 			deps := newMockDeps(t)
@@ -895,7 +1431,27 @@ func TestGatewayModelImpl(t *testing.T) {
 				}).
 				Return(defaultBackendSet, nil)
 
-			reconcileCertificatesCall := loadBalancerModel.EXPECT().
+			wantRuleSetParams := reconcileListenerRuleSetParams{
+				loadBalancerID: config.Spec.LoadBalancerID,
+				knownRuleSets:  loadBalancer.RuleSets,
+				ruleSetName:    ociListenerRuleSetName(gateway, ""),
+			}
+			loadBalancerModel.EXPECT().
+				reconcileListenerRuleSet(t.Context(), wantRuleSetParams).
+				Return(nil).
+				Once()
+			for _, listener := range gateway.Spec.Listeners {
+				loadBalancerModel.EXPECT().
+					reconcileListenerRuleSet(t.Context(), reconcileListenerRuleSetParams{
+						loadBalancerID: config.Spec.LoadBalancerID,
+						knownRuleSets:  loadBalancer.RuleSets,
+						ruleSetName:    ociListenerAccessRuleSetName("", listener.Name),
+					}).
+					Return(nil).
+					Once()
+			}
+
+			reconcileCertificatesCall := loadBalancerModel.EXPECT().
 				reconcileListenersCertificates(t.Context(), reconcileListenersCertificatesParams{
 					loadBalancerID:    config.Spec.LoadBalancerID,
 					gateway:           gateway,
@@ -916,6 +1472,10 @@ func TestGatewayModelImpl(t *testing.T) {
 						knownRoutingPolicies:  loadBalancer.RoutingPolicies,
 						listenerCertificates:  certificatesByListener[string(listener.Name)],
 						listenerSpec:          &listener,
+						managedRuleSetNames: []string{
+							wantRuleSetParams.ruleSetName,
+							ociListenerAccessRuleSetName("", listener.Name),
+						},
 					}).
 					Return(nil).
 					NotBefore(reconcileCertificatesCall)
@@ -923,16 +1483,39 @@ func TestGatewayModelImpl(t *testing.T) {
 
 			removeCall := loadBalancerModel.EXPECT().
 				removeMissingListeners(t.Context(), removeMissingListenersParams{
-					loadBalancerID:   config.Spec.LoadBalancerID,
-					knownListeners:   loadBalancer.Listeners,
-					gatewayListeners: gateway.Spec.Listeners,
+					loadBalancerID:       config.Spec.LoadBalancerID,
+					knownListeners:       loadBalancer.Listeners,
+					knownRoutingPolicies: loadBalancer.RoutingPolicies,
+					gatewayListeners:     gateway.Spec.Listeners,
 				}).
 				Return(nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedListenerRuleSet(t.Context(), wantRuleSetParams).
+				Return(nil).
+				Once().
+				NotBefore(removeCall.Call)
+			for _, listener := range gateway.Spec.Listeners {
+				loadBalancerModel.EXPECT().
+					removeUnusedListenerRuleSet(t.Context(), reconcileListenerRuleSetParams{
+						loadBalancerID: config.Spec.LoadBalancerID,
+						knownRuleSets:  loadBalancer.RuleSets,
+						ruleSetName:    ociListenerAccessRuleSetName("", listener.Name),
+					}).
+					Return(nil).
+					Once().
+					NotBefore(removeCall.Call)
+			}
+
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedCertificates(t.Context(), *gateway).
+				Return([]string{"recorded-cert", "previous-cert"}, nil).
+				Once()
 
 			loadBalancerModel.EXPECT().
 				removeUnusedCertificates(t.Context(), removeUnusedCertificatesParams{
 					loadBalancerID:                   config.Spec.LoadBalancerID,
-					previouslyProgrammedCertificates: []string{"previous-cert"},
+					previouslyProgrammedCertificates: []string{"previous-cert", "recorded-cert"},
 					desiredCertificates:              certificateNamesFromListenerCertificates(certificatesByListener),
 					knownCertificates:                loadBalancer.Certificates,
 				}).
@@ -946,68 +1529,709 @@ func TestGatewayModelImpl(t *testing.T) {
 
 			require.NoError(t, err)
 		})
-		t.Run("skips TLS listeners because TLSRoute owns ALB TLS listener reconciliation", func(t *testing.T) {
+		t.Run("skips TLS listeners because TLSRoute owns ALB TLS listener reconciliation", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			config := makeRandomGatewayConfig()
+			httpsListener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+			tlsListener := makeRandomListener(func(listener *gatewayv1.Listener) {
+				listener.Protocol = gatewayv1.TLSProtocolType
+				listener.TLS = &gatewayv1.ListenerTLSConfig{
+					CertificateRefs: []gatewayv1.SecretObjectReference{randomSecretObjectReference()},
+				}
+			})
+			gateway := newRandomGateway()
+			gateway.Spec.Listeners = []gatewayv1.Listener{httpsListener, tlsListener}
+			loadBalancer := makeRandomOCILoadBalancer(
+				randomOCILoadBalancerWithRandomBackendSetsOpt(),
+				randomOCILoadBalancerWithRandomPoliciesOpt(),
+				randomOCILoadBalancerWithRandomCertificatesOpt(),
+			)
+			defaultBackendSet := makeRandomOCIBackendSet()
+			certificatesByListener := map[string][]loadbalancer.Certificate{
+				string(httpsListener.Name): {makeRandomOCICertificate()},
+				string(tlsListener.Name):   {makeRandomOCICertificate()},
+			}
+
+			mockOciClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().
+				GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+					LoadBalancerId: &config.Spec.LoadBalancerID,
+				}).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadBalancer}, nil)
+
+			loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+			loadBalancerModel.EXPECT().
+				reconcileDefaultBackendSet(t.Context(), mock.Anything).
+				Return(defaultBackendSet, nil)
+			loadBalancerModel.EXPECT().
+				reconcileListenerRuleSet(t.Context(), mock.Anything).
+				Return(nil)
+			reconcileCertificatesCall := loadBalancerModel.EXPECT().
+				reconcileListenersCertificates(t.Context(), mock.Anything).
+				Return(reconcileListenersCertificatesResult{
+					certificatesByListener: certificatesByListener,
+				}, nil)
+			loadBalancerModel.EXPECT().
+				reconcileHTTPListener(t.Context(), mock.MatchedBy(func(params reconcileHTTPListenerParams) bool {
+					return params.listenerSpec != nil && params.listenerSpec.Name == httpsListener.Name
+				})).
+				Return(nil).
+				Once().
+				NotBefore(reconcileCertificatesCall.Call)
+			removeCall := loadBalancerModel.EXPECT().
+				removeMissingListeners(t.Context(), mock.Anything).
+				Return(nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedListenerRuleSet(t.Context(), mock.Anything).
+				Return(nil)
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedCertificates(t.Context(), mock.Anything).
+				Return(nil, nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedCertificates(t.Context(), mock.Anything).
+				Return(nil).
+				NotBefore(removeCall.Call)
+
+			err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+				gateway: *gateway,
+				config:  config,
+			})
+
+			require.NoError(t, err)
+		})
+		t.Run("programs gateway resources with name prefix on shared load balancer", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			config := makeRandomGatewayConfig()
+			config.Spec.SharedLoadBalancer = true
+			gateway := newRandomGateway()
+			wantPrefix := ociGatewayNamePrefix(gateway, config)
+			require.NotEmpty(t, wantPrefix)
+			loadBalancer := makeRandomOCILoadBalancer(
+				randomOCILoadBalancerWithRandomBackendSetsOpt(),
+			)
+			defaultBackendSet := makeRandomOCIBackendSet()
+
+			mockOciClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().
+				GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+					LoadBalancerId: &config.Spec.LoadBalancerID,
+				}).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadBalancer}, nil)
+
+			loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+			loadBalancerModel.EXPECT().
+				reconcileDefaultBackendSet(t.Context(), mock.MatchedBy(func(params reconcileDefaultBackendParams) bool {
+					return params.namePrefix == wantPrefix
+				})).
+				Return(defaultBackendSet, nil).
+				Once()
+			loadBalancerModel.EXPECT().
+				reconcileListenerRuleSet(t.Context(), mock.Anything).
+				Return(nil)
+			loadBalancerModel.EXPECT().
+				reconcileListenersCertificates(t.Context(), mock.Anything).
+				Return(reconcileListenersCertificatesResult{}, nil)
+			loadBalancerModel.EXPECT().
+				reconcileHTTPListener(t.Context(), mock.MatchedBy(func(params reconcileHTTPListenerParams) bool {
+					return params.namePrefix == wantPrefix
+				})).
+				Return(nil).
+				Times(len(gateway.Spec.Listeners))
+			loadBalancerModel.EXPECT().
+				removeMissingListeners(t.Context(), removeMissingListenersParams{
+					loadBalancerID:       config.Spec.LoadBalancerID,
+					knownListeners:       loadBalancer.Listeners,
+					knownRoutingPolicies: loadBalancer.RoutingPolicies,
+					gatewayListeners:     gateway.Spec.Listeners,
+					namePrefix:           wantPrefix,
+				}).
+				Return(nil).
+				Once()
+			loadBalancerModel.EXPECT().
+				removeUnusedListenerRuleSet(t.Context(), mock.MatchedBy(func(params reconcileListenerRuleSetParams) bool {
+					return params.ruleSetName == ociListenerRuleSetName(gateway, wantPrefix)
+				})).
+				Return(nil).
+				Once()
+			for _, listener := range gateway.Spec.Listeners {
+				loadBalancerModel.EXPECT().
+					removeUnusedListenerRuleSet(t.Context(), mock.MatchedBy(func(params reconcileListenerRuleSetParams) bool {
+						return params.ruleSetName == ociListenerAccessRuleSetName(wantPrefix, listener.Name)
+					})).
+					Return(nil).
+					Once()
+			}
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedCertificates(t.Context(), mock.Anything).
+				Return(nil, nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedCertificates(t.Context(), mock.Anything).
+				Return(nil)
+
+			err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+				gateway: *gateway,
+				config:  config,
+			})
+
+			require.NoError(t, err)
+		})
+		t.Run("programs listener policy rule set", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			config := makeRandomGatewayConfig()
+			gateway := newRandomGateway()
+			policy := &types.OkeListenerPolicy{
+				Spec: types.OkeListenerPolicySpec{
+					HTTPHeader: &types.OkeListenerPolicyHTTPHeader{MaxSizeInKB: 16},
+				},
+			}
+			loadBalancer := makeRandomOCILoadBalancer()
+			defaultBackendSet := makeRandomOCIBackendSet()
+			wantRuleSetParams := reconcileListenerRuleSetParams{
+				loadBalancerID: config.Spec.LoadBalancerID,
+				knownRuleSets:  loadBalancer.RuleSets,
+				ruleSetName:    ociListenerRuleSetName(gateway, ""),
+				items:          listenerPolicyRuleSetItems(policy),
+			}
+
+			mockOciClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().
+				GetLoadBalancer(t.Context(), mock.Anything).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadBalancer}, nil)
+
+			loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+			loadBalancerModel.EXPECT().
+				reconcileDefaultBackendSet(t.Context(), mock.Anything).
+				Return(defaultBackendSet, nil)
+			reconcileRuleSetCall := loadBalancerModel.EXPECT().
+				reconcileListenerRuleSet(t.Context(), wantRuleSetParams).
+				Return(nil).
+				Once()
+			isAccessRuleSet := func(params reconcileListenerRuleSetParams) bool {
+				return params.ruleSetName != wantRuleSetParams.ruleSetName && len(params.items) == 0
+			}
+			loadBalancerModel.EXPECT().
+				reconcileListenerRuleSet(t.Context(), mock.MatchedBy(isAccessRuleSet)).
+				Return(nil)
+			loadBalancerModel.EXPECT().
+				reconcileListenersCertificates(t.Context(), mock.Anything).
+				Return(reconcileListenersCertificatesResult{}, nil)
+			loadBalancerModel.EXPECT().
+				reconcileHTTPListener(t.Context(), mock.MatchedBy(func(params reconcileHTTPListenerParams) bool {
+					return reflect.DeepEqual(params.ruleSetNames, []string{wantRuleSetParams.ruleSetName})
+				})).
+				Return(nil).
+				Times(len(gateway.Spec.Listeners)).
+				NotBefore(reconcileRuleSetCall)
+			removeCall := loadBalancerModel.EXPECT().
+				removeMissingListeners(t.Context(), mock.Anything).
+				Return(nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedListenerRuleSet(t.Context(), wantRuleSetParams).
+				Return(nil).
+				Once().
+				NotBefore(removeCall.Call)
+			loadBalancerModel.EXPECT().
+				removeUnusedListenerRuleSet(t.Context(), mock.MatchedBy(isAccessRuleSet)).
+				Return(nil)
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedCertificates(t.Context(), mock.Anything).
+				Return(nil, nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedCertificates(t.Context(), mock.Anything).
+				Return(nil)
+
+			err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+				gateway:        *gateway,
+				config:         config,
+				listenerPolicy: policy,
+			})
+
+			require.NoError(t, err)
+		})
+		t.Run("programs listener default backend set", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			gateway := newRandomGateway()
+			internalListener := makeRandomListener(randomListenerWithHTTPProtocolOpt())
+			gateway.Spec.Listeners = append(gateway.Spec.Listeners, internalListener)
+			listenerBackendSet := makeRandomOCIBackendSet()
+			config := makeRandomGatewayConfig()
+			config.Spec.Listeners = []types.GatewayConfigListener{
+				{Name: string(internalListener.Name), DefaultBackendSetName: *listenerBackendSet.Name},
+			}
+			loadBalancer := makeRandomOCILoadBalancer()
+			loadBalancer.BackendSets = map[string]loadbalancer.BackendSet{
+				*listenerBackendSet.Name: listenerBackendSet,
+			}
+			defaultBackendSet := makeRandomOCIBackendSet()
+
+			mockOciClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().
+				GetLoadBalancer(t.Context(), mock.Anything).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadBalancer}, nil)
+
+			loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+			loadBalancerModel.EXPECT().
+				reconcileDefaultBackendSet(t.Context(), mock.Anything).
+				Return(defaultBackendSet, nil)
+			loadBalancerModel.EXPECT().
+				reconcileListenerRuleSet(t.Context(), mock.Anything).
+				Return(nil)
+			loadBalancerModel.EXPECT().
+				reconcileListenersCertificates(t.Context(), mock.Anything).
+				Return(reconcileListenersCertificatesResult{}, nil)
+			loadBalancerModel.EXPECT().
+				reconcileHTTPListener(t.Context(), mock.MatchedBy(func(params reconcileHTTPListenerParams) bool {
+					return params.listenerSpec.Name == internalListener.Name &&
+						params.defaultBackendSetName == *listenerBackendSet.Name
+				})).
+				Return(nil).
+				Once()
+			loadBalancerModel.EXPECT().
+				reconcileHTTPListener(t.Context(), mock.MatchedBy(func(params reconcileHTTPListenerParams) bool {
+					return params.listenerSpec.Name == gateway.Spec.Listeners[0].Name &&
+						params.defaultBackendSetName == *defaultBackendSet.Name
+				})).
+				Return(nil).
+				Once()
+			loadBalancerModel.EXPECT().
+				removeMissingListeners(t.Context(), mock.Anything).
+				Return(nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedListenerRuleSet(t.Context(), mock.Anything).
+				Return(nil)
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedCertificates(t.Context(), mock.Anything).
+				Return(nil, nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedCertificates(t.Context(), mock.Anything).
+				Return(nil)
+
+			err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+				gateway: *gateway,
+				config:  config,
+			})
+
+			require.NoError(t, err)
+		})
+		t.Run("programs internal listeners on internal load balancer", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			gateway := newRandomGateway()
+			publicListener := gateway.Spec.Listeners[0]
+			internalListener := makeRandomListener(randomListenerWithHTTPProtocolOpt())
+			gateway.Spec.Listeners = append(gateway.Spec.Listeners, internalListener)
+			config := makeRandomGatewayConfig()
+			config.Spec.InternalLoadBalancerID = faker.New().UUID().V4()
+			config.Spec.Listeners = []types.GatewayConfigListener{
+				{Name: string(internalListener.Name), Internal: true},
+			}
+			loadBalancer := makeRandomOCILoadBalancer()
+			loadBalancer.IpAddresses = []loadbalancer.IpAddress{{IpAddress: new("10.0.0.1")}}
+			internalLoadBalancer := makeRandomOCILoadBalancer()
+			internalLoadBalancer.IpAddresses = []loadbalancer.IpAddress{{IpAddress: new("10.0.1.1")}}
+
+			mockOciClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().
+				GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+					LoadBalancerId: &config.Spec.LoadBalancerID,
+				}).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadBalancer}, nil)
+			mockOciClient.EXPECT().
+				GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+					LoadBalancerId: &config.Spec.InternalLoadBalancerID,
+				}).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: internalLoadBalancer}, nil)
+
+			loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+			loadBalancerModel.EXPECT().
+				reconcileDefaultBackendSet(t.Context(), mock.Anything).
+				Return(makeRandomOCIBackendSet(), nil).
+				Twice()
+			loadBalancerModel.EXPECT().
+				reconcileListenerRuleSet(t.Context(), mock.Anything).
+				Return(nil)
+			loadBalancerModel.EXPECT().
+				reconcileListenersCertificates(t.Context(), mock.Anything).
+				Return(reconcileListenersCertificatesResult{}, nil)
+			loadBalancerModel.EXPECT().
+				reconcileHTTPListener(t.Context(), mock.MatchedBy(func(params reconcileHTTPListenerParams) bool {
+					return params.listenerSpec.Name == publicListener.Name &&
+						params.loadBalancerID == config.Spec.LoadBalancerID
+				})).
+				Return(nil).
+				Once()
+			loadBalancerModel.EXPECT().
+				reconcileHTTPListener(t.Context(), mock.MatchedBy(func(params reconcileHTTPListenerParams) bool {
+					return params.listenerSpec.Name == internalListener.Name &&
+						params.loadBalancerID == config.Spec.InternalLoadBalancerID
+				})).
+				Return(nil).
+				Once()
+			loadBalancerModel.EXPECT().
+				removeMissingListeners(t.Context(), mock.MatchedBy(func(params removeMissingListenersParams) bool {
+					return params.loadBalancerID == config.Spec.LoadBalancerID &&
+						reflect.DeepEqual(params.gatewayListeners, []gatewayv1.Listener{publicListener})
+				})).
+				Return(nil).
+				Once()
+			loadBalancerModel.EXPECT().
+				removeMissingListeners(t.Context(), mock.MatchedBy(func(params removeMissingListenersParams) bool {
+					return params.loadBalancerID == config.Spec.InternalLoadBalancerID &&
+						reflect.DeepEqual(params.gatewayListeners, []gatewayv1.Listener{internalListener})
+				})).
+				Return(nil).
+				Once()
+			loadBalancerModel.EXPECT().
+				removeUnusedListenerRuleSet(t.Context(), mock.Anything).
+				Return(nil)
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedCertificates(t.Context(), mock.Anything).
+				Return(nil, nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedCertificates(t.Context(), mock.Anything).
+				Return(nil)
+
+			data := &resolvedGatewayDetails{
+				gateway: *gateway,
+				config:  config,
+			}
+			err := model.programGateway(t.Context(), data)
+
+			require.NoError(t, err)
+			assert.Equal(t, &internalLoadBalancer, data.internalLoadBalancer)
+			assert.Len(t, data.gateway.Spec.Listeners, 2)
+			assert.Len(t, gatewayStatusAddressesFromLoadBalancer(data.loadBalancer, data.internalLoadBalancer), 2)
+		})
+		t.Run("programs listener access rule sets", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			config := makeRandomGatewayConfig()
+			adminListener := makeRandomListener()
+			publicListener := makeRandomListener()
+			gateway := newRandomGateway()
+			gateway.Spec.Listeners = []gatewayv1.Listener{adminListener, publicListener}
+			policy := types.OkeAccessPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: gateway.Namespace, Name: "admin-access"},
+				Spec: types.OkeAccessPolicySpec{
+					TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+						{
+							LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+								Group: gatewayv1.GroupName,
+								Kind:  "Gateway",
+								Name:  gatewayv1.ObjectName(gateway.Name),
+							},
+							SectionName: &adminListener.Name,
+						},
+					},
+					AllowedSourceCIDRs: []string{"10.0.0.0/8"},
+				},
+			}
+			removedListenerName := gatewayv1.SectionName("removed-listener")
+			loadBalancer := makeRandomOCILoadBalancer()
+			loadBalancer.Listeners = map[string]loadbalancer.Listener{
+				string(adminListener.Name):  makeRandomOCIListener(),
+				string(publicListener.Name): makeRandomOCIListener(),
+				string(removedListenerName): makeRandomOCIListener(),
+			}
+			makeAccessParams := func(listenerName gatewayv1.SectionName, cidrs ...string) reconcileListenerRuleSetParams {
+				return reconcileListenerRuleSetParams{
+					loadBalancerID: config.Spec.LoadBalancerID,
+					knownRuleSets:  loadBalancer.RuleSets,
+					ruleSetName:    ociListenerAccessRuleSetName("", listenerName),
+					items:          accessPolicyRuleSetItems(cidrs),
+				}
+			}
+			adminAccessParams := makeAccessParams(adminListener.Name, "10.0.0.0/8")
+			publicAccessParams := makeAccessParams(publicListener.Name)
+			removedAccessParams := makeAccessParams(removedListenerName)
+
+			mockOciClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().
+				GetLoadBalancer(t.Context(), mock.Anything).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadBalancer}, nil)
+
+			loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+			loadBalancerModel.EXPECT().
+				reconcileDefaultBackendSet(t.Context(), mock.Anything).
+				Return(makeRandomOCIBackendSet(), nil)
+			loadBalancerModel.EXPECT().
+				reconcileListenerRuleSet(t.Context(), mock.MatchedBy(func(params reconcileListenerRuleSetParams) bool {
+					return params.ruleSetName == ociListenerRuleSetName(gateway, "")
+				})).
+				Return(nil).
+				Once()
+			adminRuleSetCall := loadBalancerModel.EXPECT().
+				reconcileListenerRuleSet(t.Context(), adminAccessParams).
+				Return(nil).
+				Once()
+			loadBalancerModel.EXPECT().
+				reconcileListenerRuleSet(t.Context(), publicAccessParams).
+				Return(nil).
+				Once()
+			loadBalancerModel.EXPECT().
+				reconcileListenersCertificates(t.Context(), mock.Anything).
+				Return(reconcileListenersCertificatesResult{}, nil)
+			loadBalancerModel.EXPECT().
+				reconcileHTTPListener(t.Context(), mock.MatchedBy(func(params reconcileHTTPListenerParams) bool {
+					return params.listenerSpec.Name == adminListener.Name &&
+						reflect.DeepEqual(params.ruleSetNames, []string{adminAccessParams.ruleSetName})
+				})).
+				Return(nil).
+				Once().
+				NotBefore(adminRuleSetCall)
+			loadBalancerModel.EXPECT().
+				reconcileHTTPListener(t.Context(), mock.MatchedBy(func(params reconcileHTTPListenerParams) bool {
+					return params.listenerSpec.Name == publicListener.Name && params.ruleSetNames == nil
+				})).
+				Return(nil).
+				Once()
+			removeCall := loadBalancerModel.EXPECT().
+				removeMissingListeners(t.Context(), mock.Anything).
+				Return(nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedListenerRuleSet(t.Context(), mock.MatchedBy(func(params reconcileListenerRuleSetParams) bool {
+					return params.ruleSetName == ociListenerRuleSetName(gateway, "")
+				})).
+				Return(nil).
+				Once()
+			for _, params := range []reconcileListenerRuleSetParams{
+				adminAccessParams, publicAccessParams, removedAccessParams,
+			} {
+				loadBalancerModel.EXPECT().
+					removeUnusedListenerRuleSet(t.Context(), params).
+					Return(nil).
+					Once().
+					NotBefore(removeCall.Call)
+			}
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedRoutes(t.Context(), mock.Anything).
+				Return(nil, nil)
+			programmingState.EXPECT().
+				programmedCertificates(t.Context(), mock.Anything).
+				Return(nil, nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedCertificates(t.Context(), mock.Anything).
+				Return(nil)
+
+			err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+				gateway:        *gateway,
+				config:         config,
+				accessPolicies: []types.OkeAccessPolicy{policy},
+			})
+
+			require.NoError(t, err)
+		})
+		t.Run("programs listener client CA bundles", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			config := makeRandomGatewayConfig()
+			config.Spec.CompartmentID = ""
+			mtlsListener := makeRandomListener()
+			gateway := newRandomGateway()
+			gateway.Spec.Listeners = []gatewayv1.Listener{mtlsListener}
+			loadBalancer := makeRandomOCILoadBalancer()
+			loadBalancer.CompartmentId = new(faker.New().UUID().V4())
+			clientCAs := map[string]string{string(mtlsListener.Name): testCAPEM(t)}
+			caBundleID := faker.New().UUID().V4()
+
+			mockOciClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().
+				GetLoadBalancer(t.Context(), mock.Anything).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadBalancer}, nil)
+
+			clientCAModel, _ := deps.ListenerClientCA.(*MocklistenerClientCAModel)
+			reconcileCACall := clientCAModel.EXPECT().
+				reconcileListenersClientCA(t.Context(), reconcileListenersClientCAParams{
+					gateway:       gateway,
+					compartmentID: *loadBalancer.CompartmentId,
+					clientCAs:     clientCAs,
+				}).
+				Return(map[string]string{string(mtlsListener.Name): caBundleID}, nil).
+				Once()
+
+			loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+			loadBalancerModel.EXPECT().
+				reconcileDefaultBackendSet(t.Context(), mock.Anything).
+				Return(makeRandomOCIBackendSet(), nil)
+			loadBalancerModel.EXPECT().
+				reconcileListenerRuleSet(t.Context(), mock.Anything).
+				Return(nil)
+			loadBalancerModel.EXPECT().
+				reconcileListenersCertificates(t.Context(), mock.Anything).
+				Return(reconcileListenersCertificatesResult{}, nil)
+			loadBalancerModel.EXPECT().
+				reconcileHTTPListener(t.Context(), mock.MatchedBy(func(params reconcileHTTPListenerParams) bool {
+					return params.clientCABundleID == caBundleID
+				})).
+				Return(nil).
+				Once().
+				NotBefore(reconcileCACall)
+			removeCall := loadBalancerModel.EXPECT().
+				removeMissingListeners(t.Context(), mock.Anything).
+				Return(nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedListenerRuleSet(t.Context(), mock.Anything).
+				Return(nil)
+			clientCAModel.EXPECT().
+				removeUnusedListenersClientCA(t.Context(), removeUnusedListenersClientCAParams{
+					gateway:       gateway,
+					compartmentID: *loadBalancer.CompartmentId,
+					caBundleIDs:   []string{caBundleID},
+				}).
+				Return(nil).
+				Once().
+				NotBefore(removeCall.Call)
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedCertificates(t.Context(), mock.Anything).
+				Return(nil, nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedCertificates(t.Context(), mock.Anything).
+				Return(nil)
+
+			err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+				gateway:           *gateway,
+				config:            config,
+				listenerClientCAs: clientCAs,
+			})
+
+			require.NoError(t, err)
+		})
+		t.Run("removes client CA bundles when client verification is disabled", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			config := makeRandomGatewayConfig()
+			config.Spec.CompartmentID = faker.New().UUID().V4()
+			gateway := newRandomGateway()
+			gateway.Annotations = map[string]string{
+				GatewayListenerClientCAAnnotation: listenerClientCAAnnotationValue(map[string]string{"https": testCAPEM(t)}),
+			}
+
+			mockOciClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().
+				GetLoadBalancer(t.Context(), mock.Anything).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: makeRandomOCILoadBalancer()}, nil)
+
+			clientCAModel, _ := deps.ListenerClientCA.(*MocklistenerClientCAModel)
+			clientCAModel.EXPECT().
+				reconcileListenersClientCA(t.Context(), reconcileListenersClientCAParams{
+					gateway:       gateway,
+					compartmentID: config.Spec.CompartmentID,
+				}).
+				Return(map[string]string{}, nil).
+				Once()
+			clientCAModel.EXPECT().
+				removeUnusedListenersClientCA(t.Context(), removeUnusedListenersClientCAParams{
+					gateway:       gateway,
+					compartmentID: config.Spec.CompartmentID,
+					caBundleIDs:   []string{},
+				}).
+				Return(nil).
+				Once()
+
+			loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+			loadBalancerModel.EXPECT().
+				reconcileDefaultBackendSet(t.Context(), mock.Anything).
+				Return(makeRandomOCIBackendSet(), nil)
+			loadBalancerModel.EXPECT().
+				reconcileListenerRuleSet(t.Context(), mock.Anything).
+				Return(nil)
+			loadBalancerModel.EXPECT().
+				reconcileListenersCertificates(t.Context(), mock.Anything).
+				Return(reconcileListenersCertificatesResult{}, nil)
+			loadBalancerModel.EXPECT().
+				reconcileHTTPListener(t.Context(), mock.Anything).
+				Return(nil)
+			loadBalancerModel.EXPECT().
+				removeMissingListeners(t.Context(), mock.Anything).
+				Return(nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedListenerRuleSet(t.Context(), mock.Anything).
+				Return(nil)
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedCertificates(t.Context(), mock.Anything).
+				Return(nil, nil)
+			loadBalancerModel.EXPECT().
+				removeUnusedCertificates(t.Context(), mock.Anything).
+				Return(nil)
+
+			err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+				gateway: *gateway,
+				config:  config,
+			})
+
+			require.NoError(t, err)
+		})
+		t.Run("failed to reconcile listeners client CA", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newGatewayModel(deps)
 
 			config := makeRandomGatewayConfig()
-			httpsListener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
-			tlsListener := makeRandomListener(func(listener *gatewayv1.Listener) {
-				listener.Protocol = gatewayv1.TLSProtocolType
-				listener.TLS = &gatewayv1.ListenerTLSConfig{
-					CertificateRefs: []gatewayv1.SecretObjectReference{randomSecretObjectReference()},
-				}
-			})
 			gateway := newRandomGateway()
-			gateway.Spec.Listeners = []gatewayv1.Listener{httpsListener, tlsListener}
-			loadBalancer := makeRandomOCILoadBalancer(
-				randomOCILoadBalancerWithRandomBackendSetsOpt(),
-				randomOCILoadBalancerWithRandomPoliciesOpt(),
-				randomOCILoadBalancerWithRandomCertificatesOpt(),
-			)
-			defaultBackendSet := makeRandomOCIBackendSet()
-			certificatesByListener := map[string][]loadbalancer.Certificate{
-				string(httpsListener.Name): {makeRandomOCICertificate()},
-				string(tlsListener.Name):   {makeRandomOCICertificate()},
-			}
+			mockOciClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().
+				GetLoadBalancer(t.Context(), mock.Anything).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: makeRandomOCILoadBalancer()}, nil)
+
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+			clientCAModel, _ := deps.ListenerClientCA.(*MocklistenerClientCAModel)
+			clientCAModel.EXPECT().
+				reconcileListenersClientCA(t.Context(), mock.Anything).
+				Return(nil, wantErr)
+
+			err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+				gateway:           *gateway,
+				config:            config,
+				listenerClientCAs: map[string]string{"https": testCAPEM(t)},
+			})
 
+			require.ErrorIs(t, err, wantErr)
+		})
+		t.Run("failed to reconcile listener rule set", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			config := makeRandomGatewayConfig()
+			gateway := newRandomGateway()
 			mockOciClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
 			mockOciClient.EXPECT().
-				GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
-					LoadBalancerId: &config.Spec.LoadBalancerID,
-				}).
-				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadBalancer}, nil)
+				GetLoadBalancer(t.Context(), mock.Anything).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: makeRandomOCILoadBalancer()}, nil)
 
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
 			loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
 			loadBalancerModel.EXPECT().
 				reconcileDefaultBackendSet(t.Context(), mock.Anything).
-				Return(defaultBackendSet, nil)
-			reconcileCertificatesCall := loadBalancerModel.EXPECT().
-				reconcileListenersCertificates(t.Context(), mock.Anything).
-				Return(reconcileListenersCertificatesResult{
-					certificatesByListener: certificatesByListener,
-				}, nil)
-			loadBalancerModel.EXPECT().
-				reconcileHTTPListener(t.Context(), mock.MatchedBy(func(params reconcileHTTPListenerParams) bool {
-					return params.listenerSpec != nil && params.listenerSpec.Name == httpsListener.Name
-				})).
-				Return(nil).
-				Once().
-				NotBefore(reconcileCertificatesCall.Call)
-			removeCall := loadBalancerModel.EXPECT().
-				removeMissingListeners(t.Context(), mock.Anything).
-				Return(nil)
+				Return(makeRandomOCIBackendSet(), nil)
 			loadBalancerModel.EXPECT().
-				removeUnusedCertificates(t.Context(), mock.Anything).
-				Return(nil).
-				NotBefore(removeCall.Call)
+				reconcileListenerRuleSet(t.Context(), mock.Anything).
+				Return(wantErr)
 
 			err := model.programGateway(t.Context(), &resolvedGatewayDetails{
 				gateway: *gateway,
 				config:  config,
 			})
 
-			require.NoError(t, err)
+			require.ErrorIs(t, err, wantErr)
 		})
 		t.Run("failed to get OCI Load Balancer", func(t *testing.T) {
 			fake := faker.New()
@@ -1067,13 +2291,47 @@ func TestGatewayModelImpl(t *testing.T) {
 
 			var statusErr *resourceStatusError
 			require.ErrorAs(t, err, &statusErr)
-			assert.Equal(t, string(gatewayv1.GatewayConditionProgrammed), statusErr.conditionType)
-			assert.Equal(t, string(gatewayv1.GatewayReasonPending), statusErr.reason)
+			assert.Equal(t, string(gatewayv1.GatewayConditionAccepted), statusErr.conditionType)
+			assert.Equal(t, string(gatewayv1.GatewayReasonInvalidParameters), statusErr.reason)
+			require.ErrorIs(t, err, errLoadBalancerNotFound)
 			assert.Equal(t,
 				fmt.Sprintf("referenced OCI Load Balancer %s not found", config.Spec.LoadBalancerID),
 				statusErr.message,
 			)
 		})
+		t.Run("returns address not usable status error for not assigned address", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			config := makeRandomGatewayConfig()
+			gateway := newRandomGateway(
+				randomGatewayWithRandomListenersOpt(),
+			)
+			gateway.Spec.Addresses = []gatewayv1.GatewaySpecAddress{{Value: "10.0.0.2"}}
+			loadBalancer := makeRandomOCILoadBalancer()
+			loadBalancer.IpAddresses = []loadbalancer.IpAddress{{IpAddress: new("10.0.0.1")}}
+
+			mockOciClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().
+				GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+					LoadBalancerId: &config.Spec.LoadBalancerID,
+				}).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadBalancer}, nil)
+
+			err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+				gateway: *gateway,
+				config:  config,
+			})
+
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.GatewayConditionProgrammed), statusErr.conditionType)
+			assert.Equal(t, string(gatewayv1.GatewayReasonAddressNotUsable), statusErr.reason)
+			assert.Equal(t,
+				fmt.Sprintf("address 10.0.0.2 is not assigned to the load balancer %s", config.Spec.LoadBalancerID),
+				statusErr.message,
+			)
+		})
 		t.Run("failed to reconcile default backend set", func(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
@@ -1144,6 +2402,9 @@ func TestGatewayModelImpl(t *testing.T) {
 
 			wantKnownCertificates := makeFewRandomOCICertificatesMap()
 			loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+			loadBalancerModel.EXPECT().
+				reconcileListenerRuleSet(t.Context(), mock.Anything).
+				Return(nil)
 			reconcileCertificatesCall := loadBalancerModel.EXPECT().
 				reconcileListenersCertificates(t.Context(), reconcileListenersCertificatesParams{
 					loadBalancerID:    config.Spec.LoadBalancerID,
@@ -1197,6 +2458,9 @@ func TestGatewayModelImpl(t *testing.T) {
 				reconcileDefaultBackendSet(t.Context(), mock.Anything).
 				Return(defaultBackendSet, nil)
 			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+			loadBalancerModel.EXPECT().
+				reconcileListenerRuleSet(t.Context(), mock.Anything).
+				Return(nil)
 			loadBalancerModel.EXPECT().
 				reconcileListenersCertificates(t.Context(), mock.Anything).
 				Return(reconcileListenersCertificatesResult{}, wantErr)
@@ -1234,6 +2498,9 @@ func TestGatewayModelImpl(t *testing.T) {
 					loadBalancerModel.EXPECT().
 						reconcileDefaultBackendSet(t.Context(), mock.Anything).
 						Return(defaultBackendSet, nil)
+					loadBalancerModel.EXPECT().
+						reconcileListenerRuleSet(t.Context(), mock.Anything).
+						Return(nil)
 					loadBalancerModel.EXPECT().
 						reconcileListenersCertificates(t.Context(), mock.Anything).
 						Return(reconcileListenersCertificatesResult{}, nil)
@@ -1251,6 +2518,13 @@ func TestGatewayModelImpl(t *testing.T) {
 						removeMissingListeners(t.Context(), mock.Anything).
 						Return(removeMissingErr)
 					if failCertificates {
+						loadBalancerModel.EXPECT().
+							removeUnusedListenerRuleSet(t.Context(), mock.Anything).
+							Return(nil)
+						programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+						programmingState.EXPECT().
+							programmedCertificates(t.Context(), mock.Anything).
+							Return(nil, nil)
 						loadBalancerModel.EXPECT().
 							removeUnusedCertificates(t.Context(), mock.Anything).
 							Return(wantErr).
@@ -1266,6 +2540,449 @@ func TestGatewayModelImpl(t *testing.T) {
 				})
 			}
 		})
+
+		t.Run("reconcile NSG security rules", func(t *testing.T) {
+			setupProgramGatewayMocks := func(
+				t *testing.T,
+				deps gatewayModelDeps,
+				config types.GatewayConfig,
+			) *mock.Call {
+				loadBalancer := makeRandomOCILoadBalancer(
+					randomOCILoadBalancerWithRandomBackendSetsOpt(),
+				)
+				mockOciClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+				mockOciClient.EXPECT().
+					GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+						LoadBalancerId: &config.Spec.LoadBalancerID,
+					}).
+					Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadBalancer}, nil)
+				loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+				loadBalancerModel.EXPECT().
+					reconcileDefaultBackendSet(t.Context(), mock.Anything).
+					Return(makeRandomOCIBackendSet(), nil)
+				loadBalancerModel.EXPECT().
+					reconcileListenerRuleSet(t.Context(), mock.Anything).
+					Return(nil)
+				loadBalancerModel.EXPECT().
+					reconcileListenersCertificates(t.Context(), mock.Anything).
+					Return(reconcileListenersCertificatesResult{}, nil)
+				loadBalancerModel.EXPECT().
+					reconcileHTTPListener(t.Context(), mock.Anything).
+					Return(nil)
+				loadBalancerModel.EXPECT().
+					removeUnusedListenerRuleSet(t.Context(), mock.Anything).
+					Return(nil)
+				return loadBalancerModel.EXPECT().
+					removeMissingListeners(t.Context(), mock.Anything).
+					Return(nil).Call
+			}
+
+			t.Run("when NSG configured", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newGatewayModel(deps)
+				config := makeRandomGatewayConfig()
+				config.Spec.NetworkSecurityGroup = &types.GatewayConfigNetworkSecurityGroup{
+					ID: faker.New().UUID().V4(),
+				}
+				gateway := newRandomGateway()
+				removeListenersCall := setupProgramGatewayMocks(t, deps, config)
+				loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+				programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+				programmingState.EXPECT().
+					programmedCertificates(t.Context(), mock.Anything).
+					Return(nil, nil)
+				loadBalancerModel.EXPECT().
+					removeUnusedCertificates(t.Context(), mock.Anything).
+					Return(nil)
+
+				nsgModel, _ := deps.OciNsgModel.(*MockociNetworkSecurityGroupModel)
+				nsgModel.EXPECT().
+					reconcileListenersSecurityRules(t.Context(), reconcileListenersSecurityRulesParams{
+						gateway:              gateway,
+						networkSecurityGroup: *config.Spec.NetworkSecurityGroup,
+					}).
+					Return(nil).
+					NotBefore(removeListenersCall)
+
+				err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+					gateway: *gateway,
+					config:  config,
+				})
+
+				require.NoError(t, err)
+			})
+
+			t.Run("when NSG reconciliation fails", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newGatewayModel(deps)
+				config := makeRandomGatewayConfig()
+				config.Spec.NetworkSecurityGroup = &types.GatewayConfigNetworkSecurityGroup{
+					ID: faker.New().UUID().V4(),
+				}
+				gateway := newRandomGateway()
+				setupProgramGatewayMocks(t, deps, config)
+				loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+				programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+				programmingState.EXPECT().
+					programmedCertificates(t.Context(), mock.Anything).
+					Return(nil, nil)
+				loadBalancerModel.EXPECT().
+					removeUnusedCertificates(t.Context(), mock.Anything).
+					Return(nil)
+
+				wantErr := errors.New(faker.New().Lorem().Sentence(10))
+				nsgModel, _ := deps.OciNsgModel.(*MockociNetworkSecurityGroupModel)
+				nsgModel.EXPECT().
+					reconcileListenersSecurityRules(t.Context(), mock.Anything).
+					Return(wantErr)
+
+				err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+					gateway: *gateway,
+					config:  config,
+				})
+
+				require.ErrorIs(t, err, wantErr)
+			})
+
+			t.Run("when NSG replaced", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newGatewayModel(deps)
+				config := makeRandomGatewayConfig()
+				config.Spec.NetworkSecurityGroup = &types.GatewayConfigNetworkSecurityGroup{
+					ID: faker.New().UUID().V4(),
+				}
+				previousNsgID := faker.New().UUID().V4()
+				gateway := newRandomGateway()
+				gateway.Annotations = map[string]string{
+					GatewayNetworkSecurityGroupAnnotation: previousNsgID,
+				}
+				setupProgramGatewayMocks(t, deps, config)
+				loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+				programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+				programmingState.EXPECT().
+					programmedCertificates(t.Context(), mock.Anything).
+					Return(nil, nil)
+				loadBalancerModel.EXPECT().
+					removeUnusedCertificates(t.Context(), mock.Anything).
+					Return(nil)
+
+				nsgModel, _ := deps.OciNsgModel.(*MockociNetworkSecurityGroupModel)
+				removeRulesCall := nsgModel.EXPECT().
+					removeListenersSecurityRules(t.Context(), removeListenersSecurityRulesParams{
+						gateway:                gateway,
+						networkSecurityGroupID: previousNsgID,
+					}).
+					Return(nil).Call
+				nsgModel.EXPECT().
+					reconcileListenersSecurityRules(t.Context(), reconcileListenersSecurityRulesParams{
+						gateway:              gateway,
+						networkSecurityGroup: *config.Spec.NetworkSecurityGroup,
+					}).
+					Return(nil).
+					NotBefore(removeRulesCall)
+
+				err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+					gateway: *gateway,
+					config:  config,
+				})
+
+				require.NoError(t, err)
+			})
+
+			t.Run("when NSG removed", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newGatewayModel(deps)
+				config := makeRandomGatewayConfig()
+				config.Spec.NetworkSecurityGroup = nil
+				previousNsgID := faker.New().UUID().V4()
+				gateway := newRandomGateway()
+				gateway.Annotations = map[string]string{
+					GatewayNetworkSecurityGroupAnnotation: previousNsgID,
+				}
+				setupProgramGatewayMocks(t, deps, config)
+				loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+				programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+				programmingState.EXPECT().
+					programmedCertificates(t.Context(), mock.Anything).
+					Return(nil, nil)
+				loadBalancerModel.EXPECT().
+					removeUnusedCertificates(t.Context(), mock.Anything).
+					Return(nil)
+
+				wantErr := errors.New(faker.New().Lorem().Sentence(10))
+				nsgModel, _ := deps.OciNsgModel.(*MockociNetworkSecurityGroupModel)
+				nsgModel.EXPECT().
+					removeListenersSecurityRules(t.Context(), removeListenersSecurityRulesParams{
+						gateway:                gateway,
+						networkSecurityGroupID: previousNsgID,
+					}).
+					Return(wantErr)
+
+				err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+					gateway: *gateway,
+					config:  config,
+				})
+
+				require.ErrorIs(t, err, wantErr)
+			})
+		})
+
+		t.Run("reconcile DNS records", func(t *testing.T) {
+			setupProgramGatewayMocks := func(
+				t *testing.T,
+				deps gatewayModelDeps,
+				config types.GatewayConfig,
+			) loadbalancer.LoadBalancer {
+				loadBalancer := makeRandomOCILoadBalancer(
+					randomOCILoadBalancerWithRandomBackendSetsOpt(),
+				)
+				mockOciClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+				mockOciClient.EXPECT().
+					GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+						LoadBalancerId: &config.Spec.LoadBalancerID,
+					}).
+					Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadBalancer}, nil)
+				loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+				loadBalancerModel.EXPECT().
+					reconcileDefaultBackendSet(t.Context(), mock.Anything).
+					Return(makeRandomOCIBackendSet(), nil)
+				loadBalancerModel.EXPECT().
+					reconcileListenerRuleSet(t.Context(), mock.Anything).
+					Return(nil)
+				loadBalancerModel.EXPECT().
+					reconcileListenersCertificates(t.Context(), mock.Anything).
+					Return(reconcileListenersCertificatesResult{}, nil)
+				loadBalancerModel.EXPECT().
+					reconcileHTTPListener(t.Context(), mock.Anything).
+					Return(nil)
+				loadBalancerModel.EXPECT().
+					removeUnusedListenerRuleSet(t.Context(), mock.Anything).
+					Return(nil)
+				loadBalancerModel.EXPECT().
+					removeMissingListeners(t.Context(), mock.Anything).
+					Return(nil)
+				programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+				programmingState.EXPECT().
+					programmedCertificates(t.Context(), mock.Anything).
+					Return(nil, nil)
+				loadBalancerModel.EXPECT().
+					removeUnusedCertificates(t.Context(), mock.Anything).
+					Return(nil)
+				return loadBalancer
+			}
+
+			t.Run("when DNS configured", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newGatewayModel(deps)
+				config := makeRandomGatewayConfig()
+				config.Spec.DNS = &types.GatewayConfigDNS{ZoneID: faker.New().UUID().V4()}
+				gateway := newRandomGateway()
+				hostname := "app." + faker.New().Internet().Domain()
+				gateway.Spec.Listeners[0].Hostname = new(gatewayv1.Hostname(hostname))
+				loadBalancer := setupProgramGatewayMocks(t, deps, config)
+
+				mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+				addFinalizerCall := mockClient.EXPECT().
+					Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+						return assert.Equal(t, []string{GatewayDNSRecordsFinalizer}, obj.GetFinalizers())
+					})).
+					Return(nil).Once()
+				dnsModel, _ := deps.OciDNSModel.(*MockociDNSModel)
+				dnsModel.EXPECT().
+					reconcileListenersDNSRecords(t.Context(), mock.MatchedBy(
+						func(params reconcileListenersDNSRecordsParams) bool {
+							return params.dns == config.Spec.DNS &&
+								assert.ElementsMatch(t, loadBalancerIPAddresses(&loadBalancer),
+									params.hostnameAddresses[hostname]) &&
+								params.programmedRecords.zoneID == ""
+						},
+					)).
+					Return(nil).
+					NotBefore(addFinalizerCall)
+
+				err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+					gateway: *gateway,
+					config:  config,
+				})
+
+				require.NoError(t, err)
+			})
+
+			t.Run("removes records when DNS config is removed", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newGatewayModel(deps)
+				config := makeRandomGatewayConfig()
+				gateway := newRandomGateway()
+				zoneID := faker.New().UUID().V4()
+				gateway.Annotations = map[string]string{
+					GatewayDNSRecordsAnnotation: zoneID + "=app.example.com",
+				}
+				gateway.Finalizers = []string{GatewayDNSRecordsFinalizer}
+				setupProgramGatewayMocks(t, deps, config)
+
+				dnsModel, _ := deps.OciDNSModel.(*MockociDNSModel)
+				dnsModel.EXPECT().
+					reconcileListenersDNSRecords(t.Context(), mock.MatchedBy(
+						func(params reconcileListenersDNSRecordsParams) bool {
+							return params.dns == nil &&
+								assert.Equal(t, gatewayDNSRecords{
+									zoneID:    zoneID,
+									hostnames: []string{"app.example.com"},
+								}, params.programmedRecords)
+						},
+					)).
+					Return(nil)
+
+				err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+					gateway: *gateway,
+					config:  config,
+				})
+
+				require.NoError(t, err)
+			})
+
+			t.Run("fails when DNS records finalizer can not be added", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newGatewayModel(deps)
+				config := makeRandomGatewayConfig()
+				config.Spec.DNS = &types.GatewayConfigDNS{ZoneID: faker.New().UUID().V4()}
+				gateway := newRandomGateway()
+				setupProgramGatewayMocks(t, deps, config)
+
+				wantErr := errors.New(faker.New().Lorem().Sentence(10))
+				mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+				mockClient.EXPECT().Update(t.Context(), mock.Anything).Return(wantErr).Once()
+
+				err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+					gateway: *gateway,
+					config:  config,
+				})
+
+				require.ErrorIs(t, err, wantErr)
+			})
+
+			t.Run("when DNS reconciliation fails", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newGatewayModel(deps)
+				config := makeRandomGatewayConfig()
+				config.Spec.DNS = &types.GatewayConfigDNS{ZoneID: faker.New().UUID().V4()}
+				gateway := newRandomGateway()
+				setupProgramGatewayMocks(t, deps, config)
+				mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+				mockClient.EXPECT().Update(t.Context(), mock.Anything).Return(nil).Once()
+
+				wantErr := errors.New(faker.New().Lorem().Sentence(10))
+				dnsModel, _ := deps.OciDNSModel.(*MockociDNSModel)
+				dnsModel.EXPECT().
+					reconcileListenersDNSRecords(t.Context(), mock.Anything).
+					Return(wantErr)
+
+				err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+					gateway: *gateway,
+					config:  config,
+				})
+
+				require.ErrorIs(t, err, wantErr)
+			})
+		})
+
+		t.Run("reconcile load balancer logs", func(t *testing.T) {
+			setupProgramGatewayMocks := func(
+				t *testing.T,
+				deps gatewayModelDeps,
+				config types.GatewayConfig,
+			) *mock.Call {
+				loadBalancer := makeRandomOCILoadBalancer(
+					randomOCILoadBalancerWithRandomBackendSetsOpt(),
+				)
+				mockOciClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+				mockOciClient.EXPECT().
+					GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+						LoadBalancerId: &config.Spec.LoadBalancerID,
+					}).
+					Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadBalancer}, nil)
+				loadBalancerModel, _ := deps.OciLoadBalancerModel.(*MockociLoadBalancerModel)
+				loadBalancerModel.EXPECT().
+					reconcileDefaultBackendSet(t.Context(), mock.Anything).
+					Return(makeRandomOCIBackendSet(), nil)
+				loadBalancerModel.EXPECT().
+					reconcileListenerRuleSet(t.Context(), mock.Anything).
+					Return(nil)
+				loadBalancerModel.EXPECT().
+					reconcileListenersCertificates(t.Context(), mock.Anything).
+					Return(reconcileListenersCertificatesResult{}, nil)
+				loadBalancerModel.EXPECT().
+					reconcileHTTPListener(t.Context(), mock.Anything).
+					Return(nil)
+				loadBalancerModel.EXPECT().
+					removeMissingListeners(t.Context(), mock.Anything).
+					Return(nil)
+				loadBalancerModel.EXPECT().
+					removeUnusedListenerRuleSet(t.Context(), mock.Anything).
+					Return(nil)
+				programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+				programmingState.EXPECT().
+					programmedCertificates(t.Context(), mock.Anything).
+					Return(nil, nil)
+				return loadBalancerModel.EXPECT().
+					removeUnusedCertificates(t.Context(), mock.Anything).
+					Return(nil).Call
+			}
+
+			t.Run("when logging configured", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newGatewayModel(deps)
+				config := makeRandomGatewayConfig()
+				config.Spec.Logging = &types.GatewayConfigLogging{
+					LogGroupID: faker.New().UUID().V4(),
+					AccessLog:  &types.GatewayConfigLog{Enabled: true},
+				}
+				gateway := newRandomGateway()
+				removeCertificatesCall := setupProgramGatewayMocks(t, deps, config)
+
+				loggingModel, _ := deps.OciLoggingModel.(*MockociLoggingModel)
+				loggingModel.EXPECT().
+					reconcileLoadBalancerLogs(t.Context(), reconcileLoadBalancerLogsParams{
+						loadBalancerID: config.Spec.LoadBalancerID,
+						gateway:        gateway,
+						logging:        *config.Spec.Logging,
+					}).
+					Return(nil).
+					NotBefore(removeCertificatesCall)
+
+				err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+					gateway: *gateway,
+					config:  config,
+				})
+
+				require.NoError(t, err)
+			})
+
+			t.Run("when logging reconciliation fails", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newGatewayModel(deps)
+				config := makeRandomGatewayConfig()
+				config.Spec.Logging = &types.GatewayConfigLogging{
+					LogGroupID: faker.New().UUID().V4(),
+					ErrorLog:   &types.GatewayConfigLog{Enabled: true},
+				}
+				gateway := newRandomGateway()
+				setupProgramGatewayMocks(t, deps, config)
+
+				wantErr := errors.New(faker.New().Lorem().Sentence(10))
+				loggingModel, _ := deps.OciLoggingModel.(*MockociLoggingModel)
+				loggingModel.EXPECT().
+					reconcileLoadBalancerLogs(t.Context(), mock.Anything).
+					Return(wantErr)
+
+				err := model.programGateway(t.Context(), &resolvedGatewayDetails{
+					gateway: *gateway,
+					config:  config,
+				})
+
+				require.ErrorIs(t, err, wantErr)
+			})
+		})
 	})
 
 	t.Run("setProgrammed", func(t *testing.T) {
@@ -1287,32 +3004,100 @@ func TestGatewayModelImpl(t *testing.T) {
 				},
 			}
 
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				recordProgrammedCertificates(t.Context(), recordProgrammedCertificatesParams{
+					gateway:      *gateway,
+					certificates: []string{},
+				}).
+				Return(nil).
+				Once()
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockResourcesModel.EXPECT().setCondition(
+				t.Context(),
+				setConditionParams{
+					resource:      &data.gateway,
+					conditions:    &data.gateway.Status.Conditions,
+					conditionType: string(gatewayv1.GatewayConditionProgrammed),
+					status:        metav1.ConditionTrue,
+					reason:        string(gatewayv1.GatewayReasonProgrammed),
+					message:       fmt.Sprintf("Gateway %s programmed by %s", data.gateway.Name, ControllerClassName),
+					annotations: map[string]string{
+						GatewayProgrammingRevisionAnnotation:    GatewayProgrammingRevisionValue,
+						GatewayProgrammedCertificatesAnnotation: "",
+						GatewayListenerPolicyAnnotation:         "",
+						GatewayAccessPoliciesAnnotation:         "",
+						GatewayListenerClientCAAnnotation:       "",
+						GatewayDefaultBackendServiceAnnotation:  "",
+						GatewayDNSRecordsAnnotation:             "",
+						GatewayNetworkSecurityGroupAnnotation:   "",
+					},
+				},
+			).Return(nil)
+
+			err := model.setProgrammed(t.Context(), data)
+			require.NoError(t, err)
+			addressType := gatewayv1.IPAddressType
+			assert.Equal(t, []gatewayv1.GatewayStatusAddress{
+				{Type: &addressType, Value: "198.51.100.20"},
+				{Type: &addressType, Value: "10.0.0.12"},
+			}, data.gateway.Status.Addresses)
+
+			mockResourcesModel.AssertExpectations(t)
+		})
+
+		t.Run("removes finalizers of no longer managed NSG rules and DNS records", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			gateway := newRandomGateway()
+			gateway.Finalizers = []string{GatewayNetworkSecurityGroupFinalizer, GatewayDNSRecordsFinalizer}
+			data := &resolvedGatewayDetails{gateway: *gateway}
+
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				recordProgrammedCertificates(t.Context(), mock.Anything).
+				Return(nil).
+				Once()
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			setConditionCall := mockResourcesModel.EXPECT().
+				setCondition(t.Context(), mock.MatchedBy(func(params setConditionParams) bool {
+					return params.finalizer == ""
+				})).
+				Return(nil).Once()
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockClient.EXPECT().
+				Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+					return assert.Empty(t, obj.GetFinalizers())
+				})).
+				Return(nil).
+				Once().
+				NotBefore(setConditionCall)
+
+			require.NoError(t, model.setProgrammed(t.Context(), data))
+		})
+
+		t.Run("keeps DNS records finalizer while records are managed", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			config := makeRandomGatewayConfig()
+			config.Spec.DNS = &types.GatewayConfigDNS{ZoneID: faker.New().UUID().V4()}
+			gateway := newRandomGateway()
+			gateway.Finalizers = []string{GatewayDNSRecordsFinalizer}
+			data := &resolvedGatewayDetails{gateway: *gateway, config: config}
+
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				recordProgrammedCertificates(t.Context(), mock.Anything).
+				Return(nil).
+				Once()
 			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
-			mockResourcesModel.EXPECT().setCondition(
-				t.Context(),
-				setConditionParams{
-					resource:      &data.gateway,
-					conditions:    &data.gateway.Status.Conditions,
-					conditionType: string(gatewayv1.GatewayConditionProgrammed),
-					status:        metav1.ConditionTrue,
-					reason:        string(gatewayv1.GatewayReasonProgrammed),
-					message:       fmt.Sprintf("Gateway %s programmed by %s", data.gateway.Name, ControllerClassName),
-					annotations: map[string]string{
-						GatewayProgrammingRevisionAnnotation:    GatewayProgrammingRevisionValue,
-						GatewayProgrammedCertificatesAnnotation: "",
-					},
-				},
-			).Return(nil)
-
-			err := model.setProgrammed(t.Context(), data)
-			require.NoError(t, err)
-			addressType := gatewayv1.IPAddressType
-			assert.Equal(t, []gatewayv1.GatewayStatusAddress{
-				{Type: &addressType, Value: "198.51.100.20"},
-				{Type: &addressType, Value: "10.0.0.12"},
-			}, data.gateway.Status.Addresses)
+			mockResourcesModel.EXPECT().setCondition(t.Context(), mock.Anything).Return(nil).Once()
 
-			mockResourcesModel.AssertExpectations(t)
+			require.NoError(t, model.setProgrammed(t.Context(), data))
+			assert.Equal(t, []string{GatewayDNSRecordsFinalizer}, data.gateway.Finalizers)
 		})
 
 		t.Run("should set programmed condition with secrets", func(t *testing.T) {
@@ -1323,7 +3108,13 @@ func TestGatewayModelImpl(t *testing.T) {
 			numSecrets := 2 + rand.IntN(2) // Generate 2 or 3 secrets
 			gatewaySecretsMap := make(map[string]corev1.Secret)
 			expectedAnnotations := map[string]string{
-				GatewayProgrammingRevisionAnnotation: GatewayProgrammingRevisionValue,
+				GatewayProgrammingRevisionAnnotation:   GatewayProgrammingRevisionValue,
+				GatewayListenerPolicyAnnotation:        "",
+				GatewayAccessPoliciesAnnotation:        "",
+				GatewayListenerClientCAAnnotation:      "",
+				GatewayDefaultBackendServiceAnnotation: "",
+				GatewayDNSRecordsAnnotation:            "",
+				GatewayNetworkSecurityGroupAnnotation:  "",
 			}
 
 			for range numSecrets {
@@ -1341,6 +3132,15 @@ func TestGatewayModelImpl(t *testing.T) {
 				gatewaySecrets: gatewaySecretsMap,
 			}
 
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				recordProgrammedCertificates(t.Context(), recordProgrammedCertificatesParams{
+					gateway:      *gateway,
+					certificates: programmedCertificateNamesFromSecrets(gatewaySecretsMap),
+				}).
+				Return(nil).
+				Once()
+
 			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
 			mockResourcesModel.EXPECT().setCondition(
 				t.Context(),
@@ -1370,6 +3170,9 @@ func TestGatewayModelImpl(t *testing.T) {
 				gateway: *gateway,
 			}
 
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().recordProgrammedCertificates(t.Context(), mock.Anything).Return(nil).Once()
+
 			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
 			expectedErr := errors.New("setCondition error")
 			mockResourcesModel.EXPECT().setCondition(
@@ -1385,6 +3188,22 @@ func TestGatewayModelImpl(t *testing.T) {
 
 			mockResourcesModel.AssertExpectations(t)
 		})
+
+		t.Run("should return error when recording certificates fails", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			data := &resolvedGatewayDetails{
+				gateway: *newRandomGateway(),
+			}
+
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			expectedErr := errors.New(faker.New().Lorem().Sentence(10))
+			programmingState.EXPECT().recordProgrammedCertificates(t.Context(), mock.Anything).Return(expectedErr).Once()
+
+			err := model.setProgrammed(t.Context(), data)
+			require.ErrorIs(t, err, expectedErr)
+		})
 	})
 
 	t.Run("isProgrammed", func(t *testing.T) {
@@ -1406,6 +3225,12 @@ func TestGatewayModelImpl(t *testing.T) {
 					annotations: map[string]string{
 						GatewayProgrammingRevisionAnnotation:    GatewayProgrammingRevisionValue,
 						GatewayProgrammedCertificatesAnnotation: "",
+						GatewayListenerPolicyAnnotation:         "",
+						GatewayAccessPoliciesAnnotation:         "",
+						GatewayListenerClientCAAnnotation:       "",
+						GatewayDefaultBackendServiceAnnotation:  "",
+						GatewayDNSRecordsAnnotation:             "",
+						GatewayNetworkSecurityGroupAnnotation:   "",
 					},
 				},
 			).Return(true)
@@ -1416,6 +3241,21 @@ func TestGatewayModelImpl(t *testing.T) {
 			mockResourcesModel.AssertExpectations(t)
 		})
 
+		t.Run("should return false while programming is pending", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			gateway := newRandomGateway()
+			gateway.Status.Conditions = []metav1.Condition{{
+				Type:               string(gatewayv1.GatewayConditionProgrammed),
+				Status:             metav1.ConditionUnknown,
+				Reason:             string(gatewayv1.GatewayReasonPending),
+				ObservedGeneration: gateway.Generation,
+			}}
+
+			assert.False(t, model.isProgrammed(t.Context(), &resolvedGatewayDetails{gateway: *gateway}))
+		})
+
 		t.Run("should return false when programmed condition is not set", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newGatewayModel(deps)
@@ -1434,6 +3274,12 @@ func TestGatewayModelImpl(t *testing.T) {
 					annotations: map[string]string{
 						GatewayProgrammingRevisionAnnotation:    GatewayProgrammingRevisionValue,
 						GatewayProgrammedCertificatesAnnotation: "",
+						GatewayListenerPolicyAnnotation:         "",
+						GatewayAccessPoliciesAnnotation:         "",
+						GatewayListenerClientCAAnnotation:       "",
+						GatewayDefaultBackendServiceAnnotation:  "",
+						GatewayDNSRecordsAnnotation:             "",
+						GatewayNetworkSecurityGroupAnnotation:   "",
 					},
 				},
 			).Return(false)
@@ -1452,7 +3298,13 @@ func TestGatewayModelImpl(t *testing.T) {
 			numSecrets := 2 + rand.IntN(2) // Generate 2 or 3 secrets
 			gatewaySecretsMap := make(map[string]corev1.Secret)
 			expectedAnnotations := map[string]string{
-				GatewayProgrammingRevisionAnnotation: GatewayProgrammingRevisionValue,
+				GatewayProgrammingRevisionAnnotation:   GatewayProgrammingRevisionValue,
+				GatewayListenerPolicyAnnotation:        "",
+				GatewayAccessPoliciesAnnotation:        "",
+				GatewayListenerClientCAAnnotation:      "",
+				GatewayDefaultBackendServiceAnnotation: "",
+				GatewayDNSRecordsAnnotation:            "",
+				GatewayNetworkSecurityGroupAnnotation:  "",
 			}
 
 			for range numSecrets {
@@ -1485,6 +3337,212 @@ func TestGatewayModelImpl(t *testing.T) {
 
 			mockResourcesModel.AssertExpectations(t)
 		})
+
+		t.Run("should check with listener policy generation", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			policy := &types.OkeListenerPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       fake.Internet().Domain(),
+					Generation: fake.Int64Between(1, 100),
+				},
+			}
+			data := &resolvedGatewayDetails{
+				gateway:        *newRandomGateway(),
+				listenerPolicy: policy,
+			}
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockResourcesModel.EXPECT().isConditionSet(
+				isConditionSetParams{
+					resource:      &data.gateway,
+					conditions:    data.gateway.Status.Conditions,
+					conditionType: string(gatewayv1.GatewayConditionProgrammed),
+					annotations: map[string]string{
+						GatewayProgrammingRevisionAnnotation:    GatewayProgrammingRevisionValue,
+						GatewayProgrammedCertificatesAnnotation: "",
+						GatewayListenerPolicyAnnotation:         fmt.Sprintf("%s/%d", policy.Name, policy.Generation),
+						GatewayAccessPoliciesAnnotation:         "",
+						GatewayListenerClientCAAnnotation:       "",
+						GatewayDefaultBackendServiceAnnotation:  "",
+						GatewayDNSRecordsAnnotation:             "",
+						GatewayNetworkSecurityGroupAnnotation:   "",
+					},
+				},
+			).Return(false)
+
+			assert.False(t, model.isProgrammed(t.Context(), data))
+		})
+
+		t.Run("should check with access policies generations", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+
+			data := &resolvedGatewayDetails{
+				gateway: *newRandomGateway(),
+				accessPolicies: []types.OkeAccessPolicy{
+					{ObjectMeta: metav1.ObjectMeta{Name: "public", Generation: 2}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "admin", Generation: 5}},
+				},
+			}
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockResourcesModel.EXPECT().isConditionSet(
+				isConditionSetParams{
+					resource:      &data.gateway,
+					conditions:    data.gateway.Status.Conditions,
+					conditionType: string(gatewayv1.GatewayConditionProgrammed),
+					annotations: map[string]string{
+						GatewayProgrammingRevisionAnnotation:    GatewayProgrammingRevisionValue,
+						GatewayProgrammedCertificatesAnnotation: "",
+						GatewayListenerPolicyAnnotation:         "",
+						GatewayAccessPoliciesAnnotation:         "admin/5,public/2",
+						GatewayListenerClientCAAnnotation:       "",
+						GatewayDefaultBackendServiceAnnotation:  "",
+						GatewayDNSRecordsAnnotation:             "",
+						GatewayNetworkSecurityGroupAnnotation:   "",
+					},
+				},
+			).Return(true)
+
+			assert.True(t, model.isProgrammed(t.Context(), data))
+		})
+	})
+
+	t.Run("deprovisionGateway", func(t *testing.T) {
+		t.Run("removes NSG rules and finalizer", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			nsgID := faker.New().UUID().V4()
+			gateway := newRandomGateway()
+			gateway.Annotations = map[string]string{
+				GatewayNetworkSecurityGroupAnnotation: nsgID,
+			}
+			gateway.Finalizers = []string{GatewayNetworkSecurityGroupFinalizer}
+			data := &resolvedGatewayDetails{gateway: *gateway}
+
+			nsgModel, _ := deps.OciNsgModel.(*MockociNetworkSecurityGroupModel)
+			removeRulesCall := nsgModel.EXPECT().
+				removeListenersSecurityRules(t.Context(), removeListenersSecurityRulesParams{
+					gateway:                &data.gateway,
+					networkSecurityGroupID: nsgID,
+				}).
+				Return(nil).Call
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockClient.EXPECT().
+				Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+					return assert.Empty(t, obj.GetFinalizers())
+				})).
+				Return(nil).
+				NotBefore(removeRulesCall)
+
+			require.NoError(t, model.deprovisionGateway(t.Context(), data))
+		})
+
+		t.Run("uses configured NSG if not programmed yet", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			config := makeRandomGatewayConfig()
+			config.Spec.NetworkSecurityGroup = &types.GatewayConfigNetworkSecurityGroup{
+				ID: faker.New().UUID().V4(),
+			}
+			gateway := newRandomGateway()
+			gateway.Finalizers = []string{GatewayNetworkSecurityGroupFinalizer}
+			data := &resolvedGatewayDetails{gateway: *gateway, config: config}
+
+			nsgModel, _ := deps.OciNsgModel.(*MockociNetworkSecurityGroupModel)
+			nsgModel.EXPECT().
+				removeListenersSecurityRules(t.Context(), removeListenersSecurityRulesParams{
+					gateway:                &data.gateway,
+					networkSecurityGroupID: config.Spec.NetworkSecurityGroup.ID,
+				}).
+				Return(nil)
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockClient.EXPECT().Update(t.Context(), &data.gateway).Return(nil)
+
+			require.NoError(t, model.deprovisionGateway(t.Context(), data))
+		})
+
+		t.Run("removes DNS records and finalizer", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			zoneID := faker.New().UUID().V4()
+			gateway := newRandomGateway()
+			gateway.Annotations = map[string]string{
+				GatewayDNSRecordsAnnotation: zoneID + "=api.example.com,app.example.com",
+			}
+			gateway.Finalizers = []string{GatewayDNSRecordsFinalizer}
+			data := &resolvedGatewayDetails{gateway: *gateway}
+
+			dnsModel, _ := deps.OciDNSModel.(*MockociDNSModel)
+			removeRecordsCall := dnsModel.EXPECT().
+				reconcileListenersDNSRecords(t.Context(), reconcileListenersDNSRecordsParams{
+					gateway: &data.gateway,
+					programmedRecords: gatewayDNSRecords{
+						zoneID:    zoneID,
+						hostnames: []string{"api.example.com", "app.example.com"},
+					},
+				}).
+				Return(nil).Once()
+			mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockClient.EXPECT().
+				Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+					return assert.Empty(t, obj.GetFinalizers())
+				})).
+				Return(nil).
+				Once().
+				NotBefore(removeRecordsCall)
+
+			require.NoError(t, model.deprovisionGateway(t.Context(), data))
+		})
+
+		t.Run("keeps finalizer if DNS records removal fails", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			config := makeRandomGatewayConfig()
+			config.Spec.DNS = &types.GatewayConfigDNS{ZoneID: faker.New().UUID().V4()}
+			gateway := newRandomGateway()
+			gateway.Spec.Listeners[0].Hostname = new(gatewayv1.Hostname("app.example.com"))
+			gateway.Finalizers = []string{GatewayDNSRecordsFinalizer}
+			data := &resolvedGatewayDetails{gateway: *gateway, config: config}
+
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+			dnsModel, _ := deps.OciDNSModel.(*MockociDNSModel)
+			dnsModel.EXPECT().
+				reconcileListenersDNSRecords(t.Context(), mock.MatchedBy(
+					func(params reconcileListenersDNSRecordsParams) bool {
+						return params.dns == nil && params.programmedRecords.zoneID == config.Spec.DNS.ZoneID
+					},
+				)).
+				Return(wantErr).Once()
+
+			err := model.deprovisionGateway(t.Context(), data)
+			require.ErrorIs(t, err, wantErr)
+			assert.Equal(t, []string{GatewayDNSRecordsFinalizer}, data.gateway.Finalizers)
+		})
+
+		t.Run("keeps finalizer if rules removal fails", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGatewayModel(deps)
+			gateway := newRandomGateway()
+			gateway.Annotations = map[string]string{
+				GatewayNetworkSecurityGroupAnnotation: faker.New().UUID().V4(),
+			}
+			gateway.Finalizers = []string{GatewayNetworkSecurityGroupFinalizer}
+			data := &resolvedGatewayDetails{gateway: *gateway}
+
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+			nsgModel, _ := deps.OciNsgModel.(*MockociNetworkSecurityGroupModel)
+			nsgModel.EXPECT().
+				removeListenersSecurityRules(t.Context(), mock.Anything).
+				Return(wantErr)
+
+			err := model.deprovisionGateway(t.Context(), data)
+			require.ErrorIs(t, err, wantErr)
+			assert.Equal(t, []string{GatewayNetworkSecurityGroupFinalizer}, data.gateway.Finalizers)
+		})
 	})
 }
 
@@ -1521,6 +3579,112 @@ func TestProgrammedGatewayCertificatesAnnotation(t *testing.T) {
 	})
 }
 
+func TestListenerDefaultBackendSetName(t *testing.T) {
+	makeConfig := func(listeners ...types.GatewayConfigListener) types.GatewayConfig {
+		config := makeRandomGatewayConfig()
+		config.Spec.Listeners = listeners
+		return config
+	}
+
+	t.Run("returns gateway default backend set if listener is not configured", func(t *testing.T) {
+		config := makeConfig(types.GatewayConfigListener{Name: "internal", DefaultBackendSetName: "internal-default"})
+
+		got, err := listenerDefaultBackendSetName(config, "http", nil, "gateway-default")
+
+		require.NoError(t, err)
+		assert.Equal(t, "gateway-default", got)
+	})
+
+	t.Run("returns configured backend set of the listener", func(t *testing.T) {
+		config := makeConfig(types.GatewayConfigListener{Name: "internal", DefaultBackendSetName: "internal-default"})
+
+		got, err := listenerDefaultBackendSetName(config, "internal", map[string]loadbalancer.BackendSet{
+			"internal-default": makeRandomOCIBackendSet(),
+		}, "gateway-default")
+
+		require.NoError(t, err)
+		assert.Equal(t, "internal-default", got)
+	})
+
+	t.Run("returns status error if configured backend set is not found", func(t *testing.T) {
+		config := makeConfig(types.GatewayConfigListener{Name: "internal", DefaultBackendSetName: "internal-default"})
+
+		_, err := listenerDefaultBackendSetName(config, "internal", nil, "gateway-default")
+
+		var statusErr *resourceStatusError
+		require.ErrorAs(t, err, &statusErr)
+		assert.Equal(t, string(gatewayv1.GatewayConditionAccepted), statusErr.conditionType)
+		assert.Equal(t, string(gatewayv1.GatewayReasonInvalidParameters), statusErr.reason)
+		assert.Equal(t,
+			"listener internal default backend set internal-default not found on the load balancer",
+			statusErr.message,
+		)
+	})
+}
+
+func TestGatewayTLSVersionOptionsValidation(t *testing.T) {
+	makeGateway := func(options map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue) gatewayv1.Gateway {
+		return gatewayv1.Gateway{
+			Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+				{Name: "http", Protocol: gatewayv1.HTTPProtocolType, Port: 80},
+				{
+					Name:     "https",
+					Protocol: gatewayv1.HTTPSProtocolType,
+					Port:     443,
+					TLS:      &gatewayv1.ListenerTLSConfig{Options: options},
+				},
+			}},
+		}
+	}
+
+	t.Run("accepts supported options", func(t *testing.T) {
+		require.NoError(t, validateGatewayTLSVersionOptions(makeGateway(nil)))
+		require.NoError(t, validateGatewayTLSVersionOptions(makeGateway(
+			map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{ListenerTLSOptionMinTLSVersion: "TLSv1.2"},
+		)))
+		require.NoError(t, validateGatewayTLSVersionOptions(makeGateway(
+			map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+				ListenerTLSOptionMinTLSVersion: "TLSv1.2",
+				ListenerTLSOptionProtocols:     "TLSv1.3",
+			},
+		)))
+	})
+
+	for name, tc := range map[string]struct {
+		options     map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue
+		wantMessage string
+	}{
+		"unsupported minimum TLS version": {
+			options: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{ListenerTLSOptionMinTLSVersion: "TLSv1.4"},
+			wantMessage: "listener https option " + ListenerTLSOptionMinTLSVersion +
+				" has unsupported TLS version TLSv1.4, supported versions: TLSv1, TLSv1.1, TLSv1.2, TLSv1.3",
+		},
+		"unsupported protocol": {
+			options: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{ListenerTLSOptionProtocols: "TLSv1.2,SSLv3"},
+			wantMessage: "listener https option " + ListenerTLSOptionProtocols +
+				" has unsupported TLS version SSLv3, supported versions: TLSv1, TLSv1.1, TLSv1.2, TLSv1.3",
+		},
+		"protocol below minimum TLS version": {
+			options: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+				ListenerTLSOptionMinTLSVersion: "TLSv1.2",
+				ListenerTLSOptionProtocols:     "TLSv1.1,TLSv1.2",
+			},
+			wantMessage: "listener https option " + ListenerTLSOptionProtocols +
+				" has TLS version TLSv1.1 below " + ListenerTLSOptionMinTLSVersion + " TLSv1.2",
+		},
+	} {
+		t.Run("rejects "+name, func(t *testing.T) {
+			err := validateGatewayTLSVersionOptions(makeGateway(tc.options))
+
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.GatewayConditionAccepted), statusErr.conditionType)
+			assert.Equal(t, string(gatewayv1.GatewayReasonInvalidParameters), statusErr.reason)
+			assert.Equal(t, tc.wantMessage, statusErr.message)
+		})
+	}
+}
+
 func TestGatewayCertificateOptionsValidation(t *testing.T) {
 	makeGateway := func(listeners ...gatewayv1.Listener) gatewayv1.Gateway {
 		return gatewayv1.Gateway{
@@ -1688,4 +3852,147 @@ func TestGatewayCertificateOptionsValidation(t *testing.T) {
 		require.ErrorIs(t, err, wantErr)
 		require.ErrorContains(t, err, "failed to get secret gateway-ns/tls-secret")
 	})
+
+	t.Run("loads cross namespace secret permitted by ReferenceGrant", func(t *testing.T) {
+		model := &gatewayModelImpl{client: NewMockk8sClient(t)}
+		listener := httpsListener
+		listener.TLS = &gatewayv1.ListenerTLSConfig{
+			CertificateRefs: []gatewayv1.SecretObjectReference{{
+				Name:      "tls-secret",
+				Namespace: new(gatewayv1.Namespace("certs")),
+			}},
+		}
+		details := resolvedGatewayDetails{
+			gateway: makeGateway(listener),
+		}
+		details.gateway.Namespace = "gateway-ns"
+		secret := makeRandomSecret(
+			randomSecretWithNameOpt("tls-secret"),
+			randomSecretWithTLSDataOpt(),
+		)
+		secret.Namespace = "certs"
+
+		mockClient, _ := model.client.(*Mockk8sClient)
+		setupGatewaySecretGrants(t, mockClient, details.gateway.Namespace)
+		setupClientGet(t, mockClient, apitypes.NamespacedName{
+			Namespace: "certs",
+			Name:      secret.Name,
+		}, secret).Once()
+
+		err := model.populateGatewaySecrets(t.Context(), &details)
+
+		require.NoError(t, err)
+		assert.Contains(t, details.gatewaySecrets, "certs/tls-secret")
+	})
+
+	t.Run("rejects cross namespace secret not permitted by ReferenceGrant", func(t *testing.T) {
+		model := &gatewayModelImpl{client: NewMockk8sClient(t)}
+		listener := httpsListener
+		listener.TLS = &gatewayv1.ListenerTLSConfig{
+			CertificateRefs: []gatewayv1.SecretObjectReference{{
+				Name:      "tls-secret",
+				Namespace: new(gatewayv1.Namespace("certs")),
+			}},
+		}
+		details := resolvedGatewayDetails{
+			gateway: makeGateway(listener),
+		}
+		details.gateway.Namespace = "gateway-ns"
+
+		mockClient, _ := model.client.(*Mockk8sClient)
+		mockClient.EXPECT().
+			List(t.Context(), mock.AnythingOfType("*v1beta1.ReferenceGrantList"), client.InNamespace("certs")).
+			Return(nil)
+
+		err := model.populateGatewaySecrets(t.Context(), &details)
+
+		var statusErr *resourceStatusError
+		require.ErrorAs(t, err, &statusErr)
+		assert.Equal(t, string(gatewayv1.ListenerConditionResolvedRefs), statusErr.conditionType)
+		assert.Equal(t, string(gatewayv1.ListenerReasonRefNotPermitted), statusErr.reason)
+		assert.Equal(t, string(listener.Name), statusErr.listenerName)
+		assert.Contains(t, statusErr.message, "certificateRef certs/tls-secret is not permitted")
+		assert.Empty(t, details.gatewaySecrets)
+	})
+}
+
+// setupGatewaySecretGrants permits the gateways of the namespace to reference secrets of any namespace.
+func setupGatewaySecretGrants(t *testing.T, mockClient *Mockk8sClient, gatewayNamespace string) {
+	mockClient.EXPECT().
+		List(t.Context(), mock.AnythingOfType("*v1beta1.ReferenceGrantList"), mock.Anything).
+		RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+			*list.(*gatewayv1beta1.ReferenceGrantList) = gatewayv1beta1.ReferenceGrantList{
+				Items: []gatewayv1beta1.ReferenceGrant{{
+					Spec: gatewayv1beta1.ReferenceGrantSpec{
+						From: []gatewayv1beta1.ReferenceGrantFrom{{
+							Group:     gatewayAPIGroup,
+							Kind:      "Gateway",
+							Namespace: gatewayv1.Namespace(gatewayNamespace),
+						}},
+						To: []gatewayv1beta1.ReferenceGrantTo{{Kind: secretKind}},
+					},
+				}},
+			}
+			return nil
+		}).
+		Maybe()
+}
+
+func TestGatewayListenerProtocolOptionsValidation(t *testing.T) {
+	makeGateway := func(protocol gatewayv1.ProtocolType, value gatewayv1.AnnotationValue) gatewayv1.Gateway {
+		return gatewayv1.Gateway{
+			Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+				{Name: "http", Protocol: gatewayv1.HTTPProtocolType, Port: 80},
+				{
+					Name:     "secure",
+					Protocol: protocol,
+					Port:     443,
+					TLS: &gatewayv1.ListenerTLSConfig{Options: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+						ListenerTLSOptionListenerProtocol: value,
+					}},
+				},
+			}},
+		}
+	}
+
+	t.Run("accepts supported options", func(t *testing.T) {
+		for _, value := range []gatewayv1.AnnotationValue{"", "HTTP", "HTTP2", " http2 "} {
+			require.NoError(t, validateGatewayListenerProtocolOptions(makeGateway(gatewayv1.HTTPSProtocolType, value)))
+		}
+	})
+
+	for name, tc := range map[string]struct {
+		protocol    gatewayv1.ProtocolType
+		value       gatewayv1.AnnotationValue
+		wantMessage string
+	}{
+		"cleartext HTTP/2 backends": {
+			protocol: gatewayv1.HTTPSProtocolType,
+			value:    "h2c",
+			wantMessage: "listener secure option " + ListenerTLSOptionListenerProtocol +
+				" has unsupported protocol H2C, OCI Load Balancer reaches HTTP/2 backends over TLS only",
+		},
+		"unsupported protocol": {
+			protocol: gatewayv1.HTTPSProtocolType,
+			value:    "GRPC",
+			wantMessage: "listener secure option " + ListenerTLSOptionListenerProtocol +
+				" has unsupported protocol GRPC, supported protocols: HTTP, HTTP2",
+		},
+		"non HTTPS listener": {
+			protocol: gatewayv1.TLSProtocolType,
+			value:    "HTTP2",
+			wantMessage: "listener secure option " + ListenerTLSOptionListenerProtocol +
+				" is only supported on HTTPS listeners",
+		},
+	} {
+		t.Run("rejects "+name, func(t *testing.T) {
+			err := validateGatewayListenerProtocolOptions(makeGateway(tc.protocol, tc.value))
+
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.GatewayConditionAccepted), statusErr.conditionType)
+			assert.Equal(t, string(gatewayv1.GatewayReasonInvalidParameters), statusErr.reason)
+			assert.Equal(t, tc.wantMessage, statusErr.message)
+		})
+	}
 }
@@ -0,0 +1,68 @@
+package app
+
+import (
+	"fmt"
+	"net"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// validateGatewaySpecAddresses rejects addresses requested in the Gateway spec.addresses that
+// can not be served. Load balancers are referenced by the GatewayConfig rather than provisioned,
+// so only IP addresses are supported and they are checked against the load balancer when programmed.
+func validateGatewaySpecAddresses(gateway gatewayv1.Gateway) error {
+	for _, address := range gateway.Spec.Addresses {
+		if address.Type != nil && *address.Type != gatewayv1.IPAddressType {
+			return &resourceStatusError{
+				conditionType: string(gatewayv1.GatewayConditionAccepted),
+				reason:        string(gatewayv1.GatewayReasonUnsupportedAddress),
+				message: fmt.Sprintf(
+					"address type %s is not supported, only %s addresses assigned by OCI "+
+						"to the load balancer referenced by the GatewayConfig can be requested",
+					*address.Type, gatewayv1.IPAddressType,
+				),
+			}
+		}
+		if address.Value != "" && net.ParseIP(address.Value) == nil {
+			return &resourceStatusError{
+				conditionType: string(gatewayv1.GatewayConditionAccepted),
+				reason:        string(gatewayv1.GatewayReasonUnsupportedAddress),
+				message:       fmt.Sprintf("address %s is not a valid IP address", address.Value),
+			}
+		}
+	}
+	return nil
+}
+
+// checkGatewaySpecAddressesUsable reports requested addresses of the Gateway that are not
+// assigned to the load balancer. Requests without a value are satisfied by any address.
+func checkGatewaySpecAddressesUsable(
+	gateway gatewayv1.Gateway,
+	loadBalancerID string,
+	assigned []gatewayv1.GatewayStatusAddress,
+) error {
+	for _, address := range gateway.Spec.Addresses {
+		if address.Value == "" {
+			continue
+		}
+		requested := net.ParseIP(address.Value)
+		usable := false
+		for _, assignedAddress := range assigned {
+			if requested.Equal(net.ParseIP(assignedAddress.Value)) {
+				usable = true
+				break
+			}
+		}
+		if !usable {
+			return &resourceStatusError{
+				conditionType: string(gatewayv1.GatewayConditionProgrammed),
+				reason:        string(gatewayv1.GatewayReasonAddressNotUsable),
+				message: fmt.Sprintf(
+					"address %s is not assigned to the load balancer %s",
+					address.Value, loadBalancerID,
+				),
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestGatewaySpecAddresses(t *testing.T) {
+	t.Run("validateGatewaySpecAddresses", func(t *testing.T) {
+		t.Run("accepts IP addresses and requests without value", func(t *testing.T) {
+			gateway := newRandomGateway()
+			gateway.Spec.Addresses = []gatewayv1.GatewaySpecAddress{
+				{Value: "10.0.0.1"},
+				{Type: new(gatewayv1.IPAddressType), Value: "2001:db8::1"},
+				{Type: new(gatewayv1.IPAddressType)},
+			}
+
+			require.NoError(t, validateGatewaySpecAddresses(*gateway))
+		})
+
+		t.Run("rejects invalid IP address", func(t *testing.T) {
+			gateway := newRandomGateway()
+			gateway.Spec.Addresses = []gatewayv1.GatewaySpecAddress{{Value: "10.0.0.256"}}
+
+			err := validateGatewaySpecAddresses(*gateway)
+
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.GatewayConditionAccepted), statusErr.conditionType)
+			assert.Equal(t, string(gatewayv1.GatewayReasonUnsupportedAddress), statusErr.reason)
+			assert.Equal(t, "address 10.0.0.256 is not a valid IP address", statusErr.message)
+		})
+
+		t.Run("rejects not IP address types", func(t *testing.T) {
+			gateway := newRandomGateway()
+			gateway.Spec.Addresses = []gatewayv1.GatewaySpecAddress{
+				{Type: new(gatewayv1.NamedAddressType), Value: faker.New().Lorem().Word()},
+			}
+
+			err := validateGatewaySpecAddresses(*gateway)
+
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.GatewayReasonUnsupportedAddress), statusErr.reason)
+		})
+	})
+
+	t.Run("checkGatewaySpecAddressesUsable", func(t *testing.T) {
+		assigned := gatewayStatusAddressesFromValues([]string{"10.0.0.1", "2001:db8::1"})
+
+		t.Run("accepts addresses assigned to the load balancer", func(t *testing.T) {
+			gateway := newRandomGateway()
+			gateway.Spec.Addresses = []gatewayv1.GatewaySpecAddress{
+				{Value: "10.0.0.1"},
+				{Value: "2001:0db8:0:0:0:0:0:1"},
+				{},
+			}
+
+			require.NoError(t, checkGatewaySpecAddressesUsable(*gateway, faker.New().UUID().V4(), assigned))
+		})
+
+		t.Run("reports address not assigned to the load balancer", func(t *testing.T) {
+			gateway := newRandomGateway()
+			gateway.Spec.Addresses = []gatewayv1.GatewaySpecAddress{{Value: "10.0.0.1"}, {Value: "10.0.0.2"}}
+			loadBalancerID := faker.New().UUID().V4()
+
+			err := checkGatewaySpecAddressesUsable(*gateway, loadBalancerID, assigned)
+
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.GatewayConditionProgrammed), statusErr.conditionType)
+			assert.Equal(t, string(gatewayv1.GatewayReasonAddressNotUsable), statusErr.reason)
+			assert.Equal(t,
+				"address 10.0.0.2 is not assigned to the load balancer "+loadBalancerID,
+				statusErr.message,
+			)
+		})
+	})
+}
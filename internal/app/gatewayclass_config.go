@@ -0,0 +1,94 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/samber/lo"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+const gatewayClassConfigKind = "GatewayClassConfig"
+
+// gatewayClassReferencesConfig checks if the GatewayClass parametersRef points to the GatewayClassConfig.
+func gatewayClassReferencesConfig(gatewayClass *gatewayv1.GatewayClass, configName string) bool {
+	ref := gatewayClass.Spec.ParametersRef
+	return ref != nil &&
+		string(ref.Group) == types.GroupName &&
+		string(ref.Kind) == gatewayClassConfigKind &&
+		ref.Name == configName
+}
+
+// resolveGatewayClassConfig applies defaults of the GatewayClassConfig referenced by the GatewayClass
+// parametersRef to the GatewayConfig. Values set in the GatewayConfig take precedence.
+// Classes without parametersRef leave the GatewayConfig as is.
+func resolveGatewayClassConfig(
+	ctx context.Context,
+	reader client.Reader,
+	gatewayClass *gatewayv1.GatewayClass,
+	config *types.GatewayConfig,
+) error {
+	ref := gatewayClass.Spec.ParametersRef
+	if ref == nil {
+		return nil
+	}
+	if string(ref.Group) != types.GroupName || string(ref.Kind) != gatewayClassConfigKind {
+		return &resourceStatusError{
+			conditionType: string(gatewayv1.GatewayConditionAccepted),
+			reason:        string(gatewayv1.GatewayReasonInvalidParameters),
+			message: fmt.Sprintf(
+				"GatewayClass %s parametersRef must reference %s.%s, got %s.%s",
+				gatewayClass.Name, gatewayClassConfigKind, types.GroupName, ref.Kind, ref.Group,
+			),
+		}
+	}
+
+	var classConfig types.GatewayClassConfig
+	if err := reader.Get(ctx, apitypes.NamespacedName{Name: ref.Name}, &classConfig); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return &resourceStatusError{
+				conditionType: string(gatewayv1.GatewayConditionAccepted),
+				reason:        string(gatewayv1.GatewayReasonInvalidParameters),
+				message: fmt.Sprintf(
+					"GatewayClass %s parametersRef is pointing to a non-existent GatewayClassConfig %s",
+					gatewayClass.Name, ref.Name,
+				),
+			}
+		}
+		return fmt.Errorf("failed to get GatewayClassConfig %s: %w", ref.Name, err)
+	}
+
+	applyGatewayClassConfigDefaults(config, classConfig)
+	return nil
+}
+
+func applyGatewayClassConfigDefaults(config *types.GatewayConfig, classConfig types.GatewayClassConfig) {
+	config.Spec.CompartmentID = lo.CoalesceOrEmpty(config.Spec.CompartmentID, classConfig.Spec.CompartmentID)
+	config.Spec.NamePrefix = lo.CoalesceOrEmpty(config.Spec.NamePrefix, classConfig.Spec.NamePrefix)
+	if config.Spec.Naming == nil && classConfig.Spec.Naming != nil {
+		config.Spec.Naming = classConfig.Spec.Naming.DeepCopy()
+	}
+	if len(classConfig.Spec.FreeformTags) > 0 {
+		tags := maps.Clone(classConfig.Spec.FreeformTags)
+		maps.Copy(tags, config.Spec.FreeformTags)
+		config.Spec.FreeformTags = tags
+	}
+}
+
+// mergeFreeformTags adds the configured free-form tags to the tags set by the controller.
+// Tags set by the controller identify owned resources and take precedence.
+func mergeFreeformTags(freeformTags, controllerTags map[string]string) map[string]string {
+	if len(freeformTags) == 0 {
+		return controllerTags
+	}
+	tags := maps.Clone(freeformTags)
+	maps.Copy(tags, controllerTags)
+	return tags
+}
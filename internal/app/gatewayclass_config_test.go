@@ -0,0 +1,156 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestResolveGatewayClassConfig(t *testing.T) {
+	makeGatewayClass := func(classConfigName string) *gatewayv1.GatewayClass {
+		return newRandomGatewayClass(func(gc *gatewayv1.GatewayClass) {
+			gc.Spec.ParametersRef = &gatewayv1.ParametersReference{
+				Group: types.GroupName,
+				Kind:  gatewayClassConfigKind,
+				Name:  classConfigName,
+			}
+		})
+	}
+
+	t.Run("keeps config when class has no parametersRef", func(t *testing.T) {
+		mockClient := NewMockk8sClient(t)
+		config := makeRandomGatewayConfig()
+		want := config
+
+		err := resolveGatewayClassConfig(t.Context(), mockClient, newRandomGatewayClass(), &config)
+
+		require.NoError(t, err)
+		assert.Equal(t, want, config)
+	})
+
+	t.Run("applies class config defaults", func(t *testing.T) {
+		fake := faker.New()
+		mockClient := NewMockk8sClient(t)
+		classConfig := types.GatewayClassConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: fake.Internet().Domain()},
+			Spec: types.GatewayClassConfigSpec{
+				CompartmentID: "ocid1.compartment.oc1..class" + fake.Lorem().Word(),
+				FreeformTags:  map[string]string{"team": "platform", "env": "prod"},
+				NamePrefix:    "edge",
+				Naming:        &types.OCIResourceNaming{Separator: "_", HashSuffixLength: 8},
+			},
+		}
+		gatewayClass := makeGatewayClass(classConfig.Name)
+		config := makeRandomGatewayConfig()
+		config.Spec.CompartmentID = ""
+		config.Spec.FreeformTags = map[string]string{"env": "dev"}
+
+		mockClient.EXPECT().
+			Get(t.Context(), apitypes.NamespacedName{Name: classConfig.Name}, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ apitypes.NamespacedName, obj client.Object, _ ...client.GetOption) error {
+				reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(classConfig))
+				return nil
+			})
+
+		err := resolveGatewayClassConfig(t.Context(), mockClient, gatewayClass, &config)
+
+		require.NoError(t, err)
+		assert.Equal(t, classConfig.Spec.CompartmentID, config.Spec.CompartmentID)
+		assert.Equal(t, "edge", config.Spec.NamePrefix)
+		assert.Equal(t, classConfig.Spec.Naming, config.Spec.Naming)
+		assert.NotSame(t, classConfig.Spec.Naming, config.Spec.Naming)
+		assert.Equal(t, map[string]string{"team": "platform", "env": "dev"}, config.Spec.FreeformTags)
+		assert.Equal(t, map[string]string{"team": "platform", "env": "prod"}, classConfig.Spec.FreeformTags)
+	})
+
+	t.Run("keeps values set in the gateway config", func(t *testing.T) {
+		config := makeRandomGatewayConfig()
+		config.Spec.CompartmentID = "ocid1.compartment.oc1..gateway"
+		config.Spec.NamePrefix = "gateway"
+		config.Spec.Naming = &types.OCIResourceNaming{HashSuffixLength: 4}
+
+		applyGatewayClassConfigDefaults(&config, types.GatewayClassConfig{
+			Spec: types.GatewayClassConfigSpec{
+				CompartmentID: "ocid1.compartment.oc1..class",
+				NamePrefix:    "class",
+				Naming:        &types.OCIResourceNaming{Separator: "_"},
+			},
+		})
+
+		assert.Equal(t, "ocid1.compartment.oc1..gateway", config.Spec.CompartmentID)
+		assert.Equal(t, "gateway", config.Spec.NamePrefix)
+		assert.Equal(t, &types.OCIResourceNaming{HashSuffixLength: 4}, config.Spec.Naming)
+	})
+
+	t.Run("rejects parametersRef of other kinds", func(t *testing.T) {
+		mockClient := NewMockk8sClient(t)
+		gatewayClass := makeGatewayClass("defaults")
+		gatewayClass.Spec.ParametersRef.Kind = "ConfigMap"
+		gatewayClass.Spec.ParametersRef.Group = ""
+		config := makeRandomGatewayConfig()
+
+		err := resolveGatewayClassConfig(t.Context(), mockClient, gatewayClass, &config)
+
+		var statusErr *resourceStatusError
+		require.ErrorAs(t, err, &statusErr)
+		assert.Equal(t, string(gatewayv1.GatewayReasonInvalidParameters), statusErr.reason)
+		assert.Contains(t, statusErr.message, "must reference GatewayClassConfig")
+	})
+
+	t.Run("rejects missing class config", func(t *testing.T) {
+		mockClient := NewMockk8sClient(t)
+		gatewayClass := makeGatewayClass("defaults")
+		config := makeRandomGatewayConfig()
+		mockClient.EXPECT().
+			Get(t.Context(), apitypes.NamespacedName{Name: "defaults"}, mock.Anything).
+			Return(apierrors.NewNotFound(schema.GroupResource{
+				Group:    types.GroupName,
+				Resource: "gateway-class-configs",
+			}, "defaults"))
+
+		err := resolveGatewayClassConfig(t.Context(), mockClient, gatewayClass, &config)
+
+		var statusErr *resourceStatusError
+		require.ErrorAs(t, err, &statusErr)
+		assert.Equal(t, string(gatewayv1.GatewayConditionAccepted), statusErr.conditionType)
+		assert.Contains(t, statusErr.message, "non-existent GatewayClassConfig defaults")
+	})
+
+	t.Run("fails when class config can not be fetched", func(t *testing.T) {
+		mockClient := NewMockk8sClient(t)
+		gatewayClass := makeGatewayClass("defaults")
+		config := makeRandomGatewayConfig()
+		getErr := errors.New("get failed")
+		mockClient.EXPECT().
+			Get(t.Context(), apitypes.NamespacedName{Name: "defaults"}, mock.Anything).
+			Return(getErr)
+
+		err := resolveGatewayClassConfig(t.Context(), mockClient, gatewayClass, &config)
+
+		require.ErrorIs(t, err, getErr)
+	})
+}
+
+func TestMergeFreeformTags(t *testing.T) {
+	controllerTags := map[string]string{"managed-by": "controller"}
+
+	assert.Equal(t, controllerTags, mergeFreeformTags(nil, controllerTags))
+	assert.Equal(t,
+		map[string]string{"team": "platform", "managed-by": "controller"},
+		mergeFreeformTags(map[string]string{"team": "platform", "managed-by": "user"}, controllerTags),
+	)
+}
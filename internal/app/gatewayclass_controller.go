@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	"go.uber.org/dig"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -12,7 +13,8 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
-// GatewayClassController is a simple controller that watches GatewayClass resources.
+// GatewayClassController accepts GatewayClass resources of this controller and publishes
+// the supported features in the GatewayClass status.
 type GatewayClassController struct {
 	client         k8sClient
 	logger         *slog.Logger
@@ -62,12 +64,14 @@ func (r *GatewayClassController) Reconcile(ctx context.Context, req reconcile.Re
 
 	r.logger.InfoContext(ctx, fmt.Sprintf("Processing reconciliation for GatewayClass %s", req.NamespacedName))
 
+	supportedFeatures := gatewayClassSupportedFeatures(gatewayClass.Spec.ControllerName)
+
 	// Check if the GatewayClass is already in the desired state
 	if r.resourcesModel.isConditionSet(isConditionSetParams{
 		resource:      &gatewayClass,
 		conditions:    gatewayClass.Status.Conditions,
 		conditionType: string(gatewayv1.GatewayClassConditionStatusAccepted),
-	}) {
+	}) && slices.Equal(gatewayClass.Status.SupportedFeatures, supportedFeatures) {
 		r.logger.DebugContext(ctx, "GatewayClass is already accepted",
 			slog.String("gatewayClass", req.NamespacedName.String()),
 		)
@@ -79,6 +83,8 @@ func (r *GatewayClassController) Reconcile(ctx context.Context, req reconcile.Re
 		slog.Any("gatewayClass", gatewayClass),
 	)
 
+	// Supported features are written together with the Accepted condition
+	gatewayClass.Status.SupportedFeatures = supportedFeatures
 	if err := r.resourcesModel.setCondition(ctx, setConditionParams{
 		resource:      &gatewayClass,
 		conditions:    &gatewayClass.Status.Conditions,
@@ -66,10 +66,12 @@ func TestGatewayClassController(t *testing.T) {
 			}).
 			Return(false)
 
+		wantGatewayClass := gatewayClass.DeepCopy()
+		wantGatewayClass.Status.SupportedFeatures = gatewayClassSupportedFeatures(ControllerClassName)
 		mockResourcesModel.EXPECT().
 			setCondition(t.Context(), setConditionParams{
-				resource:      gatewayClass,
-				conditions:    &gatewayClass.Status.Conditions,
+				resource:      wantGatewayClass,
+				conditions:    &wantGatewayClass.Status.Conditions,
 				conditionType: string(gatewayv1.GatewayClassConditionStatusAccepted),
 				status:        metav1.ConditionTrue,
 				reason:        string(gatewayv1.GatewayClassReasonAccepted),
@@ -230,6 +232,7 @@ func TestGatewayClassController(t *testing.T) {
 		gatewayClass := newRandomGatewayClass(
 			randomGatewayClassWithControllerNameOpt(ControllerClassName),
 		)
+		gatewayClass.Status.SupportedFeatures = gatewayClassSupportedFeatures(ControllerClassName)
 
 		req := reconcile.Request{
 			NamespacedName: client.ObjectKey{
@@ -266,4 +269,45 @@ func TestGatewayClassController(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, reconcile.Result{}, result)
 	})
+
+	t.Run("AcceptedWithoutSupportedFeatures", func(t *testing.T) {
+		gatewayClass := newRandomGatewayClass(
+			randomGatewayClassWithControllerNameOpt(NetworkLoadBalancerControllerClassName),
+		)
+		req := reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Name: gatewayClass.Name,
+			},
+		}
+
+		deps := newMockDeps(t)
+		controller := NewGatewayClassController(deps)
+		mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+		mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+
+		mockClient.EXPECT().
+			Get(t.Context(), req.NamespacedName, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ types.NamespacedName, receiver client.Object, _ ...client.GetOption) error {
+				reflect.ValueOf(receiver).Elem().Set(reflect.ValueOf(*gatewayClass))
+				return nil
+			})
+		mockResourcesModel.EXPECT().
+			isConditionSet(mock.Anything).
+			Return(true)
+		mockResourcesModel.EXPECT().
+			setCondition(t.Context(), mock.Anything).
+			RunAndReturn(func(_ context.Context, params setConditionParams) error {
+				updatedGatewayClass, _ := params.resource.(*gatewayv1.GatewayClass)
+				assert.Equal(t,
+					gatewayClassSupportedFeatures(NetworkLoadBalancerControllerClassName),
+					updatedGatewayClass.Status.SupportedFeatures,
+				)
+				return nil
+			})
+
+		result, err := controller.Reconcile(t.Context(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, reconcile.Result{}, result)
+	})
 }
@@ -0,0 +1,108 @@
+package app
+
+import (
+	"fmt"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+// Gateway API feature names reported in the GatewayClass status.supportedFeatures.
+// Names match the features defined by the Gateway API conformance suite.
+const (
+	featureBackendTLSPolicy = "BackendTLSPolicy"
+	featureGateway          = "Gateway"
+	featureGRPCRoute        = "GRPCRoute"
+	featureHTTPRoute        = "HTTPRoute"
+	featureReferenceGrant   = "ReferenceGrant"
+	featureTCPRoute         = "TCPRoute"
+	featureTLSRoute         = "TLSRoute"
+	featureUDPRoute         = "UDPRoute"
+)
+
+// gatewayClassSupportedFeatures returns features supported by the controller of the GatewayClass.
+// Names are listed in ascending order as required by the GatewayClass status.
+func gatewayClassSupportedFeatures(controllerName gatewayv1.GatewayController) []gatewayv1.SupportedFeature {
+	var names []string
+	switch controllerName {
+	case ControllerClassName:
+		names = []string{
+			featureBackendTLSPolicy, featureGRPCRoute, featureGateway,
+			featureHTTPRoute, featureReferenceGrant, featureTLSRoute,
+		}
+	case NetworkLoadBalancerControllerClassName:
+		names = []string{featureGateway, featureReferenceGrant, featureTCPRoute, featureTLSRoute, featureUDPRoute}
+	default:
+		return nil
+	}
+	features := make([]gatewayv1.SupportedFeature, len(names))
+	for i, name := range names {
+		features[i] = gatewayv1.SupportedFeature{Name: gatewayv1.FeatureName(name)}
+	}
+	return features
+}
+
+// validateGatewaySupportedFeatures rejects gateways using features the controller does not support.
+// Unsupported listener protocols are reported in the listener status.
+func validateGatewaySupportedFeatures(gateway gatewayv1.Gateway, controllerName gatewayv1.GatewayController) error {
+	if err := validateGatewaySpecAddresses(gateway); err != nil {
+		return err
+	}
+
+	if controllerName != ControllerClassName {
+		// Network Load Balancer listener protocols are checked when programming listeners
+		return nil
+	}
+	for _, listener := range gateway.Spec.Listeners {
+		switch listener.Protocol {
+		case gatewayv1.HTTPProtocolType, gatewayv1.HTTPSProtocolType, gatewayv1.TLSProtocolType:
+			continue
+		default:
+			return &resourceStatusError{
+				conditionType: string(gatewayv1.ListenerConditionAccepted),
+				reason:        string(gatewayv1.ListenerReasonUnsupportedProtocol),
+				message: fmt.Sprintf(
+					"listener %s uses unsupported protocol %s, use the %s GatewayClass controller for L4 listeners",
+					listener.Name,
+					listener.Protocol,
+					NetworkLoadBalancerControllerClassName,
+				),
+				listenerName: string(listener.Name),
+			}
+		}
+	}
+	return nil
+}
+
+// gatewayControllerLoadBalancerType returns the load balancer type programmed by the controller.
+func gatewayControllerLoadBalancerType(controllerName gatewayv1.GatewayController) string {
+	if controllerName == NetworkLoadBalancerControllerClassName {
+		return GatewayConfigLoadBalancerTypeNetwork
+	}
+	return GatewayConfigLoadBalancerTypeApplication
+}
+
+// validateGatewayConfigLoadBalancerType rejects gateways of a GatewayClass programming a different
+// load balancer type than the one declared by the GatewayConfig. Configs not declaring the type
+// are accepted by both controllers.
+func validateGatewayConfigLoadBalancerType(
+	config types.GatewayConfig,
+	controllerName gatewayv1.GatewayController,
+) error {
+	if config.Spec.LoadBalancerType == "" {
+		return nil
+	}
+	if want := gatewayControllerLoadBalancerType(controllerName); config.Spec.LoadBalancerType != want {
+		return &resourceStatusError{
+			conditionType: string(gatewayv1.GatewayConditionAccepted),
+			reason:        string(gatewayv1.GatewayReasonInvalidParameters),
+			message: fmt.Sprintf(
+				"GatewayConfig %s has loadBalancerType %s, but the GatewayClass controller %s "+
+					"programs %s load balancers",
+				config.Name, config.Spec.LoadBalancerType, controllerName, want,
+			),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package app
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestGatewayClassSupportedFeatures(t *testing.T) {
+	featureNames := func(features []gatewayv1.SupportedFeature) []string {
+		names := make([]string, len(features))
+		for i, feature := range features {
+			names[i] = string(feature.Name)
+		}
+		return names
+	}
+
+	t.Run("reports load balancer features", func(t *testing.T) {
+		names := featureNames(gatewayClassSupportedFeatures(ControllerClassName))
+
+		assert.Equal(t, []string{
+			featureBackendTLSPolicy, featureGRPCRoute, featureGateway,
+			featureHTTPRoute, featureReferenceGrant, featureTLSRoute,
+		}, names)
+		assert.True(t, slices.IsSorted(names))
+	})
+
+	t.Run("reports network load balancer features", func(t *testing.T) {
+		names := featureNames(gatewayClassSupportedFeatures(NetworkLoadBalancerControllerClassName))
+
+		assert.Equal(t, []string{
+			featureGateway, featureReferenceGrant, featureTCPRoute, featureTLSRoute, featureUDPRoute,
+		}, names)
+		assert.True(t, slices.IsSorted(names))
+	})
+
+	t.Run("reports nothing for other controllers", func(t *testing.T) {
+		assert.Nil(t, gatewayClassSupportedFeatures("example.com/other-controller"))
+	})
+}
+
+func TestValidateGatewaySupportedFeatures(t *testing.T) {
+	t.Run("accepts supported gateway", func(t *testing.T) {
+		gateway := newRandomGateway()
+		gateway.Spec.Listeners = []gatewayv1.Listener{
+			makeRandomListener(randomListenerWithHTTPProtocolOpt()),
+			makeRandomListener(randomListenerWithHTTPSParamsOpt()),
+		}
+
+		require.NoError(t, validateGatewaySupportedFeatures(*gateway, ControllerClassName))
+	})
+
+	t.Run("accepts static IP addresses", func(t *testing.T) {
+		for _, controllerName := range []gatewayv1.GatewayController{
+			ControllerClassName,
+			NetworkLoadBalancerControllerClassName,
+		} {
+			gateway := newRandomGateway()
+			gateway.Spec.Addresses = []gatewayv1.GatewaySpecAddress{{Value: "10.0.0.1"}}
+
+			require.NoError(t, validateGatewaySupportedFeatures(*gateway, controllerName))
+		}
+	})
+
+	t.Run("rejects static hostname addresses", func(t *testing.T) {
+		for _, controllerName := range []gatewayv1.GatewayController{
+			ControllerClassName,
+			NetworkLoadBalancerControllerClassName,
+		} {
+			gateway := newRandomGateway()
+			gateway.Spec.Addresses = []gatewayv1.GatewaySpecAddress{
+				{Type: new(gatewayv1.HostnameAddressType), Value: "gateway.example.com"},
+			}
+
+			err := validateGatewaySupportedFeatures(*gateway, controllerName)
+
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.GatewayConditionAccepted), statusErr.conditionType)
+			assert.Equal(t, string(gatewayv1.GatewayReasonUnsupportedAddress), statusErr.reason)
+			assert.Empty(t, statusErr.listenerName)
+		}
+	})
+
+	t.Run("rejects L4 listeners of load balancer gateway", func(t *testing.T) {
+		gateway := newRandomGateway()
+		listener := makeRandomListener()
+		listener.Protocol = gatewayv1.TCPProtocolType
+		gateway.Spec.Listeners = append(gateway.Spec.Listeners, listener)
+
+		err := validateGatewaySupportedFeatures(*gateway, ControllerClassName)
+
+		var statusErr *resourceStatusError
+		require.ErrorAs(t, err, &statusErr)
+		assert.Equal(t, string(gatewayv1.ListenerConditionAccepted), statusErr.conditionType)
+		assert.Equal(t, string(gatewayv1.ListenerReasonUnsupportedProtocol), statusErr.reason)
+		assert.Equal(t, string(listener.Name), statusErr.listenerName)
+		assert.Contains(t, statusErr.message, "unsupported protocol TCP")
+	})
+
+	t.Run("leaves listener protocols of network load balancer gateway to programming", func(t *testing.T) {
+		gateway := newRandomGateway()
+
+		require.NoError(t, validateGatewaySupportedFeatures(*gateway, NetworkLoadBalancerControllerClassName))
+	})
+}
+
+func TestValidateGatewayConfigLoadBalancerType(t *testing.T) {
+	t.Run("accepts config without load balancer type", func(t *testing.T) {
+		config := makeRandomGatewayConfig()
+
+		require.NoError(t, validateGatewayConfigLoadBalancerType(config, ControllerClassName))
+		require.NoError(t, validateGatewayConfigLoadBalancerType(config, NetworkLoadBalancerControllerClassName))
+	})
+
+	t.Run("accepts load balancer type of the controller", func(t *testing.T) {
+		config := makeRandomGatewayConfig()
+
+		config.Spec.LoadBalancerType = GatewayConfigLoadBalancerTypeApplication
+		require.NoError(t, validateGatewayConfigLoadBalancerType(config, ControllerClassName))
+
+		config.Spec.LoadBalancerType = GatewayConfigLoadBalancerTypeNetwork
+		require.NoError(t, validateGatewayConfigLoadBalancerType(config, NetworkLoadBalancerControllerClassName))
+	})
+
+	t.Run("rejects load balancer type of another controller", func(t *testing.T) {
+		for controllerName, loadBalancerType := range map[gatewayv1.GatewayController]string{
+			ControllerClassName:                    GatewayConfigLoadBalancerTypeNetwork,
+			NetworkLoadBalancerControllerClassName: GatewayConfigLoadBalancerTypeApplication,
+		} {
+			config := makeRandomGatewayConfig()
+			config.Spec.LoadBalancerType = loadBalancerType
+
+			err := validateGatewayConfigLoadBalancerType(config, controllerName)
+
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.GatewayConditionAccepted), statusErr.conditionType)
+			assert.Equal(t, string(gatewayv1.GatewayReasonInvalidParameters), statusErr.reason)
+			assert.Contains(t, statusErr.message, "loadBalancerType "+loadBalancerType)
+		}
+	})
+}
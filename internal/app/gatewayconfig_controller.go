@@ -0,0 +1,278 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/samber/lo"
+	"go.uber.org/dig"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+// GatewayConfigController maintains the InUse condition of GatewayConfig resources
+// so the CRD validation can reject load balancer changes of configs used by Gateways.
+// It also reports whether the referenced load balancers are reachable with the
+// LoadBalancerReachable condition, so misconfigured OCIDs are visible before a Gateway
+// uses the config.
+type GatewayConfigController struct {
+	client                    k8sClient
+	logger                    *slog.Logger
+	resourcesModel            resourcesModel
+	loadBalancerClient        ociLoadBalancerClient
+	networkLoadBalancerClient ociNetworkLoadBalancerClient
+}
+
+// GatewayConfigControllerDeps contains the dependencies for the GatewayConfigController.
+type GatewayConfigControllerDeps struct {
+	dig.In
+
+	RootLogger     *slog.Logger
+	K8sClient      k8sClient
+	ResourcesModel resourcesModel
+
+	// OCI clients to check the load balancers with. The LoadBalancerReachable
+	// condition is not maintained without them.
+	OciLoadBalancerClient        ociLoadBalancerClient        `optional:"true"`
+	OciNetworkLoadBalancerClient ociNetworkLoadBalancerClient `optional:"true"`
+}
+
+// NewGatewayConfigController creates a new GatewayConfigController.
+func NewGatewayConfigController(deps GatewayConfigControllerDeps) *GatewayConfigController {
+	return &GatewayConfigController{
+		client:                    deps.K8sClient,
+		logger:                    deps.RootLogger.WithGroup("gateway-config-controller"),
+		resourcesModel:            deps.ResourcesModel,
+		loadBalancerClient:        deps.OciLoadBalancerClient,
+		networkLoadBalancerClient: deps.OciNetworkLoadBalancerClient,
+	}
+}
+
+// gatewayReferencesConfig returns true if the gateway is using the given GatewayConfig.
+func gatewayReferencesConfig(gateway *gatewayv1.Gateway, configName string) bool {
+	if gateway.DeletionTimestamp != nil ||
+		gateway.Spec.Infrastructure == nil ||
+		gateway.Spec.Infrastructure.ParametersRef == nil {
+		return false
+	}
+	return gateway.Spec.Infrastructure.ParametersRef.Name == configName
+}
+
+// Reconcile implements the reconcile.Reconciler interface.
+func (r *GatewayConfigController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	var config types.GatewayConfig
+	if err := r.client.Get(ctx, req.NamespacedName, &config); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.logger.DebugContext(ctx, fmt.Sprintf("GatewayConfig not present: %s", req.NamespacedName))
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed to get GatewayConfig %s: %w", req.NamespacedName, err)
+	}
+
+	if err := r.reconcileInUse(ctx, req.NamespacedName, &config); err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := r.reconcileLoadBalancerReachable(ctx, req.NamespacedName, &config); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, nil
+}
+
+func (r *GatewayConfigController) reconcileInUse(
+	ctx context.Context,
+	name apitypes.NamespacedName,
+	config *types.GatewayConfig,
+) error {
+	var gateways gatewayv1.GatewayList
+	if err := r.client.List(ctx, &gateways, client.InNamespace(config.Namespace)); err != nil {
+		return fmt.Errorf("failed to list Gateways for GatewayConfig %s: %w", name, err)
+	}
+	var gatewayNames []string
+	for _, gateway := range gateways.Items {
+		if gatewayReferencesConfig(&gateway, config.Name) {
+			gatewayNames = append(gatewayNames, gateway.Name)
+		}
+	}
+
+	status := metav1.ConditionFalse
+	reason := GatewayConfigReasonNotReferenced
+	message := "GatewayConfig is not referenced by any Gateway"
+	if len(gatewayNames) > 0 {
+		status = metav1.ConditionTrue
+		reason = GatewayConfigReasonReferenced
+		message = "GatewayConfig is referenced by Gateways: " + strings.Join(gatewayNames, ", ")
+	}
+
+	current := meta.FindStatusCondition(config.Status.Conditions, GatewayConfigConditionInUse)
+	if current != nil && current.Status == status && current.Message == message {
+		return nil
+	}
+
+	r.logger.InfoContext(ctx, "Updating GatewayConfig InUse condition",
+		slog.String("gatewayConfig", name.String()),
+		slog.String("status", string(status)),
+		slog.Any("gateways", gatewayNames),
+	)
+	if err := r.resourcesModel.setCondition(ctx, setConditionParams{
+		resource:      config,
+		conditions:    &config.Status.Conditions,
+		conditionType: GatewayConfigConditionInUse,
+		status:        status,
+		reason:        reason,
+		message:       message,
+	}); err != nil {
+		return fmt.Errorf("failed to set InUse condition for GatewayConfig %s: %w", name, err)
+	}
+	return nil
+}
+
+// reconcileLoadBalancerReachable reads the load balancers of the GatewayConfig from OCI and
+// reports the result with the LoadBalancerReachable condition. A successful check is done once
+// per generation, failed checks are repeated on the next reconcile. Unexpected OCI errors are
+// returned so the check is retried with backoff.
+func (r *GatewayConfigController) reconcileLoadBalancerReachable(
+	ctx context.Context,
+	name apitypes.NamespacedName,
+	config *types.GatewayConfig,
+) error {
+	if r.loadBalancerClient == nil || r.networkLoadBalancerClient == nil {
+		return nil
+	}
+	current := meta.FindStatusCondition(config.Status.Conditions, GatewayConfigConditionLoadBalancerReachable)
+	if current != nil &&
+		current.Status == metav1.ConditionTrue &&
+		current.ObservedGeneration == config.Generation {
+		return nil
+	}
+
+	result := r.checkLoadBalancersReachable(ctx, *config)
+	if current == nil ||
+		current.Status != result.status ||
+		current.Reason != result.reason ||
+		current.Message != result.message ||
+		current.ObservedGeneration != config.Generation {
+		r.logger.InfoContext(ctx, "Updating GatewayConfig LoadBalancerReachable condition",
+			slog.String("gatewayConfig", name.String()),
+			slog.String("status", string(result.status)),
+			slog.String("reason", result.reason),
+		)
+		if err := r.resourcesModel.setCondition(ctx, setConditionParams{
+			resource:      config,
+			conditions:    &config.Status.Conditions,
+			conditionType: GatewayConfigConditionLoadBalancerReachable,
+			status:        result.status,
+			reason:        result.reason,
+			message:       result.message,
+		}); err != nil {
+			return fmt.Errorf("failed to set LoadBalancerReachable condition for GatewayConfig %s: %w", name, err)
+		}
+	}
+	if result.err != nil {
+		return fmt.Errorf("failed to check load balancers of GatewayConfig %s: %w", name, result.err)
+	}
+	return nil
+}
+
+type loadBalancerReachableResult struct {
+	status  metav1.ConditionStatus
+	reason  string
+	message string
+
+	// Unexpected error of the check, set for the Unreachable reason only.
+	err error
+}
+
+type loadBalancerReachableCheck struct {
+	kind string
+	id   string
+	get  func(ctx context.Context, id string) error
+}
+
+func (r *GatewayConfigController) checkLoadBalancersReachable(
+	ctx context.Context,
+	config types.GatewayConfig,
+) loadBalancerReachableResult {
+	ctx = ociRegionContext(ctx, config)
+	checks := []loadBalancerReachableCheck{{
+		kind: "OCI Load Balancer",
+		id:   config.Spec.LoadBalancerID,
+		get:  r.getLoadBalancer,
+	}}
+	if strings.HasPrefix(config.Spec.LoadBalancerID, "ocid1.networkloadbalancer.") {
+		checks[0].kind = "OCI Network Load Balancer"
+		checks[0].get = r.getNetworkLoadBalancer
+	}
+	if config.Spec.InternalLoadBalancerID != "" {
+		checks = append(checks, loadBalancerReachableCheck{
+			kind: "internal OCI Load Balancer",
+			id:   config.Spec.InternalLoadBalancerID,
+			get:  r.getLoadBalancer,
+		})
+	}
+
+	for _, check := range checks {
+		err := check.get(ctx, check.id)
+		if err == nil {
+			continue
+		}
+		serviceErr, ok := common.IsServiceError(err)
+		switch {
+		case ok && serviceErr.GetHTTPStatusCode() == http.StatusNotFound:
+			return loadBalancerReachableResult{
+				status: metav1.ConditionFalse,
+				reason: GatewayConfigReasonNotFound,
+				message: fmt.Sprintf("%s %s was not found or the controller is not authorized to read it",
+					check.kind, check.id),
+			}
+		case ok && (serviceErr.GetHTTPStatusCode() == http.StatusUnauthorized ||
+			serviceErr.GetHTTPStatusCode() == http.StatusForbidden):
+			return loadBalancerReachableResult{
+				status: metav1.ConditionFalse,
+				reason: GatewayConfigReasonNotAuthorized,
+				message: fmt.Sprintf("OCI API rejected the request to read %s %s: %s",
+					check.kind, check.id, serviceErr.GetMessage()),
+			}
+		default:
+			return loadBalancerReachableResult{
+				status:  metav1.ConditionFalse,
+				reason:  GatewayConfigReasonUnreachable,
+				message: fmt.Sprintf("failed to read %s %s: %s", check.kind, check.id, err),
+				err:     err,
+			}
+		}
+	}
+
+	ids := lo.Map(checks, func(check loadBalancerReachableCheck, _ int) string { return check.id })
+	return loadBalancerReachableResult{
+		status:  metav1.ConditionTrue,
+		reason:  GatewayConfigReasonReachable,
+		message: "Load balancers are reachable: " + strings.Join(ids, ", "),
+	}
+}
+
+func (r *GatewayConfigController) getLoadBalancer(ctx context.Context, id string) error {
+	_, err := r.loadBalancerClient.GetLoadBalancer(ctx, loadbalancer.GetLoadBalancerRequest{
+		LoadBalancerId: &id,
+	})
+	return err
+}
+
+func (r *GatewayConfigController) getNetworkLoadBalancer(ctx context.Context, id string) error {
+	_, err := r.networkLoadBalancerClient.GetNetworkLoadBalancer(ctx, networkloadbalancer.GetNetworkLoadBalancerRequest{
+		NetworkLoadBalancerId: &id,
+	})
+	return err
+}
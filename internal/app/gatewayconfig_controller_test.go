@@ -0,0 +1,380 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestGatewayConfigController(t *testing.T) {
+	newMockDeps := func(t *testing.T) GatewayConfigControllerDeps {
+		return GatewayConfigControllerDeps{
+			K8sClient:      NewMockk8sClient(t),
+			ResourcesModel: NewMockresourcesModel(t),
+			RootLogger:     diag.RootTestLogger(),
+		}
+	}
+
+	newGatewayReferencing := func(namespace, name, configName string) gatewayv1.Gateway {
+		return gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: gatewayv1.GatewaySpec{
+				Infrastructure: &gatewayv1.GatewayInfrastructure{
+					ParametersRef: &gatewayv1.LocalParametersReference{
+						Group: ConfigRefGroup,
+						Kind:  ConfigRefKind,
+						Name:  configName,
+					},
+				},
+			},
+		}
+	}
+
+	setupConfig := func(t *testing.T, deps GatewayConfigControllerDeps, config types.GatewayConfig) {
+		mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+		mockClient.EXPECT().
+			Get(t.Context(), client.ObjectKeyFromObject(&config), mock.Anything).
+			RunAndReturn(func(_ context.Context, _ client.ObjectKey, receiver client.Object, _ ...client.GetOption) error {
+				reflect.ValueOf(receiver).Elem().Set(reflect.ValueOf(config))
+				return nil
+			})
+	}
+
+	setupGateways := func(
+		t *testing.T,
+		deps GatewayConfigControllerDeps,
+		namespace string,
+		gateways ...gatewayv1.Gateway,
+	) {
+		mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+		mockClient.EXPECT().
+			List(t.Context(), &gatewayv1.GatewayList{}, client.InNamespace(namespace)).
+			RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+				reflect.ValueOf(list).Elem().FieldByName("Items").Set(reflect.ValueOf(gateways))
+				return nil
+			})
+	}
+
+	newConfig := func() types.GatewayConfig {
+		fake := faker.New()
+		return types.GatewayConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns-" + fake.Internet().Slug(),
+				Name:      "config-" + fake.Internet().Slug(),
+			},
+			Spec: types.GatewayConfigSpec{
+				LoadBalancerID: "ocid1.loadbalancer.oc1..lb" + fake.Lorem().Word(),
+			},
+		}
+	}
+
+	t.Run("sets InUse condition when referenced by gateways", func(t *testing.T) {
+		deps := newMockDeps(t)
+		controller := NewGatewayConfigController(deps)
+		config := newConfig()
+		now := metav1.Now()
+		deleting := newGatewayReferencing(config.Namespace, "deleting", config.Name)
+		deleting.DeletionTimestamp = &now
+
+		setupConfig(t, deps, config)
+		setupGateways(t, deps, config.Namespace,
+			newGatewayReferencing(config.Namespace, "edge", config.Name),
+			newGatewayReferencing(config.Namespace, "other", "other-config"),
+			gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: config.Namespace, Name: "no-ref"}},
+			deleting,
+			newGatewayReferencing(config.Namespace, "edge-l4", config.Name),
+		)
+
+		mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+		mockResourcesModel.EXPECT().
+			setCondition(t.Context(), setConditionParams{
+				resource:      &config,
+				conditions:    &config.Status.Conditions,
+				conditionType: GatewayConfigConditionInUse,
+				status:        metav1.ConditionTrue,
+				reason:        GatewayConfigReasonReferenced,
+				message:       "GatewayConfig is referenced by Gateways: edge, edge-l4",
+			}).
+			Return(nil)
+
+		result, err := controller.Reconcile(t.Context(), reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(&config),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, reconcile.Result{}, result)
+	})
+
+	t.Run("clears InUse condition when no longer referenced", func(t *testing.T) {
+		deps := newMockDeps(t)
+		controller := NewGatewayConfigController(deps)
+		config := newConfig()
+		config.Status.Conditions = []metav1.Condition{{
+			Type:    GatewayConfigConditionInUse,
+			Status:  metav1.ConditionTrue,
+			Reason:  GatewayConfigReasonReferenced,
+			Message: "GatewayConfig is referenced by Gateways: edge",
+		}}
+
+		setupConfig(t, deps, config)
+		setupGateways(t, deps, config.Namespace)
+
+		mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+		mockResourcesModel.EXPECT().
+			setCondition(t.Context(), setConditionParams{
+				resource:      &config,
+				conditions:    &config.Status.Conditions,
+				conditionType: GatewayConfigConditionInUse,
+				status:        metav1.ConditionFalse,
+				reason:        GatewayConfigReasonNotReferenced,
+				message:       "GatewayConfig is not referenced by any Gateway",
+			}).
+			Return(nil)
+
+		_, err := controller.Reconcile(t.Context(), reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(&config),
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("skips status update when condition is up to date", func(t *testing.T) {
+		deps := newMockDeps(t)
+		controller := NewGatewayConfigController(deps)
+		config := newConfig()
+		config.Status.Conditions = []metav1.Condition{{
+			Type:    GatewayConfigConditionInUse,
+			Status:  metav1.ConditionTrue,
+			Reason:  GatewayConfigReasonReferenced,
+			Message: "GatewayConfig is referenced by Gateways: edge",
+		}}
+
+		setupConfig(t, deps, config)
+		setupGateways(t, deps, config.Namespace, newGatewayReferencing(config.Namespace, "edge", config.Name))
+
+		_, err := controller.Reconcile(t.Context(), reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(&config),
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("ignores missing config", func(t *testing.T) {
+		deps := newMockDeps(t)
+		controller := NewGatewayConfigController(deps)
+		config := newConfig()
+
+		mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+		mockClient.EXPECT().
+			Get(t.Context(), client.ObjectKeyFromObject(&config), mock.Anything).
+			Return(apierrors.NewNotFound(schema.GroupResource{}, config.Name))
+
+		result, err := controller.Reconcile(t.Context(), reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(&config),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, reconcile.Result{}, result)
+	})
+
+	t.Run("returns gateway list errors", func(t *testing.T) {
+		deps := newMockDeps(t)
+		controller := NewGatewayConfigController(deps)
+		config := newConfig()
+		wantErr := errors.New("list failed")
+
+		setupConfig(t, deps, config)
+		mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+		mockClient.EXPECT().
+			List(t.Context(), &gatewayv1.GatewayList{}, client.InNamespace(config.Namespace)).
+			Return(wantErr)
+
+		_, err := controller.Reconcile(t.Context(), reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(&config),
+		})
+
+		require.ErrorIs(t, err, wantErr)
+	})
+}
+
+func TestGatewayConfigControllerLoadBalancerReachable(t *testing.T) {
+	type testDeps struct {
+		k8sClient      *Mockk8sClient
+		resourcesModel *MockresourcesModel
+		lbClient       *MockociLoadBalancerClient
+		nlbClient      *MockociNetworkLoadBalancerClient
+	}
+
+	newController := func(t *testing.T, config types.GatewayConfig) (*GatewayConfigController, testDeps) {
+		deps := testDeps{
+			k8sClient:      NewMockk8sClient(t),
+			resourcesModel: NewMockresourcesModel(t),
+			lbClient:       NewMockociLoadBalancerClient(t),
+			nlbClient:      NewMockociNetworkLoadBalancerClient(t),
+		}
+		deps.k8sClient.EXPECT().
+			Get(t.Context(), client.ObjectKeyFromObject(&config), mock.Anything).
+			RunAndReturn(func(_ context.Context, _ client.ObjectKey, receiver client.Object, _ ...client.GetOption) error {
+				reflect.ValueOf(receiver).Elem().Set(reflect.ValueOf(config))
+				return nil
+			})
+		deps.k8sClient.EXPECT().
+			List(t.Context(), &gatewayv1.GatewayList{}, client.InNamespace(config.Namespace)).
+			Return(nil)
+		return NewGatewayConfigController(GatewayConfigControllerDeps{
+			RootLogger:                   diag.RootTestLogger(),
+			K8sClient:                    deps.k8sClient,
+			ResourcesModel:               deps.resourcesModel,
+			OciLoadBalancerClient:        deps.lbClient,
+			OciNetworkLoadBalancerClient: deps.nlbClient,
+		}), deps
+	}
+
+	newConfig := func(loadBalancerID string) types.GatewayConfig {
+		fake := faker.New()
+		return types.GatewayConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  "ns-" + fake.Internet().Slug(),
+				Name:       "config-" + fake.Internet().Slug(),
+				Generation: int64(fake.IntBetween(1, 10)),
+			},
+			Spec: types.GatewayConfigSpec{LoadBalancerID: loadBalancerID},
+			Status: types.GatewayConfigStatus{Conditions: []metav1.Condition{{
+				Type:    GatewayConfigConditionInUse,
+				Status:  metav1.ConditionFalse,
+				Reason:  GatewayConfigReasonNotReferenced,
+				Message: "GatewayConfig is not referenced by any Gateway",
+			}}},
+		}
+	}
+
+	expectReachableCondition := func(
+		t *testing.T,
+		deps testDeps,
+		status metav1.ConditionStatus,
+		reason string,
+	) *setConditionParams {
+		var params setConditionParams
+		deps.resourcesModel.EXPECT().
+			setCondition(t.Context(), mock.Anything).
+			RunAndReturn(func(_ context.Context, got setConditionParams) error {
+				params = got
+				return nil
+			}).
+			Once()
+		t.Cleanup(func() {
+			assert.Equal(t, GatewayConfigConditionLoadBalancerReachable, params.conditionType)
+			assert.Equal(t, status, params.status)
+			assert.Equal(t, reason, params.reason)
+		})
+		return &params
+	}
+
+	reconcileConfig := func(
+		t *testing.T,
+		controller *GatewayConfigController,
+		config types.GatewayConfig,
+	) error {
+		_, err := controller.Reconcile(t.Context(), reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(&config),
+		})
+		return err
+	}
+
+	t.Run("reports reachable load balancers", func(t *testing.T) {
+		config := newConfig("ocid1.loadbalancer.oc1..public" + faker.New().Lorem().Word())
+		config.Spec.InternalLoadBalancerID = "ocid1.loadbalancer.oc1..private" + faker.New().Lorem().Word()
+		controller, deps := newController(t, config)
+		deps.lbClient.EXPECT().
+			GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+				LoadBalancerId: &config.Spec.LoadBalancerID,
+			}).
+			Return(loadbalancer.GetLoadBalancerResponse{}, nil)
+		deps.lbClient.EXPECT().
+			GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+				LoadBalancerId: &config.Spec.InternalLoadBalancerID,
+			}).
+			Return(loadbalancer.GetLoadBalancerResponse{}, nil)
+		params := expectReachableCondition(t, deps, metav1.ConditionTrue, GatewayConfigReasonReachable)
+
+		require.NoError(t, reconcileConfig(t, controller, config))
+		assert.Equal(t,
+			"Load balancers are reachable: "+config.Spec.LoadBalancerID+", "+config.Spec.InternalLoadBalancerID,
+			params.message,
+		)
+	})
+
+	t.Run("skips check of reachable load balancers of the same generation", func(t *testing.T) {
+		config := newConfig("ocid1.loadbalancer.oc1..lb" + faker.New().Lorem().Word())
+		config.Status.Conditions = append(config.Status.Conditions, metav1.Condition{
+			Type:               GatewayConfigConditionLoadBalancerReachable,
+			Status:             metav1.ConditionTrue,
+			Reason:             GatewayConfigReasonReachable,
+			ObservedGeneration: config.Generation,
+		})
+		controller, _ := newController(t, config)
+
+		require.NoError(t, reconcileConfig(t, controller, config))
+	})
+
+	t.Run("reports missing network load balancer", func(t *testing.T) {
+		config := newConfig("ocid1.networkloadbalancer.oc1..nlb" + faker.New().Lorem().Word())
+		controller, deps := newController(t, config)
+		deps.nlbClient.EXPECT().
+			GetNetworkLoadBalancer(t.Context(), networkloadbalancer.GetNetworkLoadBalancerRequest{
+				NetworkLoadBalancerId: &config.Spec.LoadBalancerID,
+			}).
+			Return(networkloadbalancer.GetNetworkLoadBalancerResponse{}, ociapi.NewRandomServiceError(
+				ociapi.RandomServiceErrorWithStatusCode(http.StatusNotFound),
+			))
+		params := expectReachableCondition(t, deps, metav1.ConditionFalse, GatewayConfigReasonNotFound)
+
+		require.NoError(t, reconcileConfig(t, controller, config))
+		assert.Contains(t, params.message, "OCI Network Load Balancer "+config.Spec.LoadBalancerID)
+	})
+
+	t.Run("reports rejected credentials", func(t *testing.T) {
+		config := newConfig("ocid1.loadbalancer.oc1..lb" + faker.New().Lorem().Word())
+		controller, deps := newController(t, config)
+		deps.lbClient.EXPECT().
+			GetLoadBalancer(t.Context(), mock.Anything).
+			Return(loadbalancer.GetLoadBalancerResponse{}, ociapi.NewRandomServiceError(
+				ociapi.RandomServiceErrorWithStatusCode(http.StatusUnauthorized),
+			))
+		expectReachableCondition(t, deps, metav1.ConditionFalse, GatewayConfigReasonNotAuthorized)
+
+		require.NoError(t, reconcileConfig(t, controller, config))
+	})
+
+	t.Run("reports and returns unexpected errors", func(t *testing.T) {
+		config := newConfig("ocid1.loadbalancer.oc1..lb" + faker.New().Lorem().Word())
+		controller, deps := newController(t, config)
+		wantErr := errors.New(faker.New().Lorem().Sentence(3))
+		deps.lbClient.EXPECT().
+			GetLoadBalancer(t.Context(), mock.Anything).
+			Return(loadbalancer.GetLoadBalancerResponse{}, wantErr)
+		params := expectReachableCondition(t, deps, metav1.ConditionFalse, GatewayConfigReasonUnreachable)
+
+		require.ErrorIs(t, reconcileConfig(t, controller, config), wantErr)
+		assert.Contains(t, params.message, wantErr.Error())
+	})
+}
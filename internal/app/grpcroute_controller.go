@@ -9,6 +9,9 @@ import (
 
 	"go.uber.org/dig"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
 )
 
 // GRPCRouteController watches GRPCRoute resources.
@@ -17,6 +20,7 @@ type GRPCRouteController struct {
 	grpcRouteModel   grpcRouteModel
 	httpBackendModel httpBackendModel
 	driftInterval    time.Duration
+	configValidation *gatewayConfigValidation
 }
 
 // GRPCRouteControllerDeps contains the dependencies for the GRPCRouteController.
@@ -26,7 +30,8 @@ type GRPCRouteControllerDeps struct {
 	RootLogger       *slog.Logger
 	GRPCRouteModel   grpcRouteModel
 	HTTPBackendModel httpBackendModel
-	DriftInterval    time.Duration `name:"config.reconcile.drift-interval"`
+	DriftInterval    time.Duration            `name:"config.reconcile.drift-interval"`
+	ConfigValidation *gatewayConfigValidation `optional:"true"`
 }
 
 // NewGRPCRouteController creates a new GRPCRouteController.
@@ -36,6 +41,7 @@ func NewGRPCRouteController(deps GRPCRouteControllerDeps) *GRPCRouteController {
 		grpcRouteModel:   deps.GRPCRouteModel,
 		httpBackendModel: deps.HTTPBackendModel,
 		driftInterval:    deps.DriftInterval,
+		configValidation: deps.ConfigValidation,
 	}
 }
 
@@ -51,6 +57,7 @@ func (r *GRPCRouteController) reconcileResolvedRoute(
 ) (bool, error) {
 	if resolvedData.grpcRoute.DeletionTimestamp != nil {
 		err := r.grpcRouteModel.deprovisionRoute(ctx, deprovisionGRPCRouteParams{
+			gateway:          resolvedData.gatewayDetails.gateway,
 			config:           resolvedData.gatewayDetails.config,
 			grpcRoute:        resolvedData.grpcRoute,
 			matchedListeners: resolvedData.matchedListeners,
@@ -71,6 +78,7 @@ func (r *GRPCRouteController) reconcileResolvedRoute(
 	}
 
 	err = r.grpcRouteModel.ensureGRPCListenersProtocol(ctx, ensureGRPCListenersProtocolParams{
+		gateway:          resolvedData.gatewayDetails.gateway,
 		config:           resolvedData.gatewayDetails.config,
 		matchedListeners: resolvedData.matchedListeners,
 	})
@@ -83,6 +91,23 @@ func (r *GRPCRouteController) reconcileResolvedRoute(
 		return true, nil
 	}
 
+	// Drift checks of the programmed route do not change its status
+	parentStatus := findRouteParentStatus(
+		acceptedRoute.Status.Parents,
+		resolvedData.gatewayDetails.gatewayClass.Spec.ControllerName,
+		resolvedData.matchedRef,
+	)
+	if programmingRequired && parentStatus != nil &&
+		programmingStarts(parentStatus.Conditions, RouteConditionProgrammed) {
+		if err = r.grpcRouteModel.setProgramming(ctx, setGRPCRouteProgrammingParams{
+			grpcRoute:    acceptedRoute,
+			gatewayClass: resolvedData.gatewayDetails.gatewayClass,
+			matchedRef:   resolvedData.matchedRef,
+		}); err != nil {
+			return false, fmt.Errorf("failed to set programming status: %w", err)
+		}
+	}
+
 	knownBackends, err := r.grpcRouteModel.resolveBackendRefs(ctx, resolveGRPCBackendRefsParams{
 		grpcRoute: *acceptedRoute,
 	})
@@ -107,6 +132,56 @@ func (r *GRPCRouteController) reconcileResolvedRoute(
 		knownBackends:    knownBackends,
 	})
 	if err != nil {
+		var capacityErr routingPolicyCapacityError
+		if errors.As(err, &capacityErr) {
+			rejectedRouteDetails := resolvedData
+			rejectedRouteDetails.grpcRoute = *acceptedRoute
+			if rejectErr := r.grpcRouteModel.setRejected(ctx, rejectedRouteDetails, grpcRouteStatusError{
+				conditionType: gatewayv1.RouteConditionAccepted,
+				reason:        routeReasonRoutingPolicyCapacityExceeded,
+				message:       capacityErr.Error(),
+			}); rejectErr != nil {
+				return false, fmt.Errorf("failed to reject route: %w", rejectErr)
+			}
+			return false, nil
+		}
+		var limitErr loadBalancerLimitError
+		if errors.As(err, &limitErr) {
+			rejectedRouteDetails := resolvedData
+			rejectedRouteDetails.grpcRoute = *acceptedRoute
+			if rejectErr := r.grpcRouteModel.setRejected(ctx, rejectedRouteDetails, grpcRouteStatusError{
+				conditionType: gatewayv1.RouteConditionAccepted,
+				reason:        routeReasonLoadBalancerLimitExceeded,
+				message:       limitErr.Error(),
+			}); rejectErr != nil {
+				return false, fmt.Errorf("failed to reject route: %w", rejectErr)
+			}
+			return false, nil
+		}
+		if errors.Is(err, errUnsupportedMatch) {
+			rejectedRouteDetails := resolvedData
+			rejectedRouteDetails.grpcRoute = *acceptedRoute
+			if rejectErr := r.grpcRouteModel.setRejected(ctx, rejectedRouteDetails, grpcRouteStatusError{
+				conditionType: gatewayv1.RouteConditionResolvedRefs,
+				reason:        gatewayv1.RouteReasonUnsupportedValue,
+				message:       err.Error(),
+			}); rejectErr != nil {
+				return false, fmt.Errorf("failed to reject route: %w", rejectErr)
+			}
+			return false, nil
+		}
+		if message, terminal := ociTerminalErrorMessage(err); terminal {
+			rejectedRouteDetails := resolvedData
+			rejectedRouteDetails.grpcRoute = *acceptedRoute
+			if rejectErr := r.grpcRouteModel.setRejected(ctx, rejectedRouteDetails, grpcRouteStatusError{
+				conditionType: gatewayv1.RouteConditionAccepted,
+				reason:        routeReasonOCIRequestRejected,
+				message:       message,
+			}); rejectErr != nil {
+				return false, fmt.Errorf("failed to reject route: %w", rejectErr)
+			}
+			return false, nil
+		}
 		return false, fmt.Errorf("failed to program route: %w", err)
 	}
 
@@ -115,6 +190,7 @@ func (r *GRPCRouteController) reconcileResolvedRoute(
 		gateway:               resolvedData.gatewayDetails.gateway,
 		grpcRoute:             *acceptedRoute,
 		matchedRef:            resolvedData.matchedRef,
+		naming:                ociResourceNamingFromConfig(resolvedData.gatewayDetails.config),
 		programmedPolicyRules: programResult.programmedPolicyRules,
 	}); err != nil {
 		return false, fmt.Errorf("failed to set programmed status: %w", err)
@@ -134,20 +210,45 @@ func (r *GRPCRouteController) Reconcile(ctx context.Context, req reconcile.Reque
 		return reconcile.Result{}, nil
 	}
 
+	result := driftRequeue(r.driftInterval)
 	for _, resolvedData := range resolvedRequests {
+		gatewayCtx := ociRegionContext(ctx, resolvedData.gatewayDetails.config)
+		if delay, backingOff := r.configValidation.backoff(resolvedData.gatewayDetails.config); backingOff {
+			r.logger.DebugContext(gatewayCtx, "Backing off GRPCRoute of Gateway with invalid GatewayConfig",
+				slog.String("grpcRoute", resolvedData.grpcRoute.Name),
+				slog.String("gateway", resolvedData.gatewayDetails.gateway.Name),
+			)
+			result = configBackoffRequeue(result, delay)
+			continue
+		}
 		var syncEndpointsRequired bool
-		syncEndpointsRequired, err = r.reconcileResolvedRoute(ctx, resolvedData)
+		syncEndpointsRequired, err = r.reconcileResolvedRoute(gatewayCtx, resolvedData)
 		if err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to reconcile gateway %s for route %s: %w",
 				resolvedData.gatewayDetails.gateway.Name, resolvedData.grpcRoute.Name, err)
 		}
 
 		if syncEndpointsRequired {
-			err = r.httpBackendModel.syncGRPCRouteEndpoints(ctx, syncGRPCRouteEndpointsParams{
+			var limitErr loadBalancerLimitError
+			err = r.httpBackendModel.syncGRPCRouteEndpoints(gatewayCtx, syncGRPCRouteEndpointsParams{
 				grpcRoute: resolvedData.grpcRoute,
 				config:    resolvedData.gatewayDetails.config,
 			})
-			if err != nil {
+			if errors.Is(err, errPodReadinessPending) {
+				r.logger.InfoContext(gatewayCtx, "Waiting for pod backends to become healthy",
+					slog.String("grpcRoute", resolvedData.grpcRoute.Name),
+					diag.ErrAttr(err),
+				)
+				result = podReadinessGateRequeue(result)
+			} else if errors.As(err, &limitErr) {
+				if rejectErr := r.grpcRouteModel.setRejected(gatewayCtx, resolvedData, grpcRouteStatusError{
+					conditionType: gatewayv1.RouteConditionAccepted,
+					reason:        routeReasonLoadBalancerLimitExceeded,
+					message:       limitErr.Error(),
+				}); rejectErr != nil {
+					return reconcile.Result{}, fmt.Errorf("failed to reject route: %w", rejectErr)
+				}
+			} else if err != nil {
 				return reconcile.Result{}, fmt.Errorf("failed to sync backend endpoints: %w", err)
 			}
 		}
@@ -155,5 +256,5 @@ func (r *GRPCRouteController) Reconcile(ctx context.Context, req reconcile.Reque
 
 	r.logger.InfoContext(ctx, fmt.Sprintf("Reconciled GRPCRoute %s", req.NamespacedName))
 
-	return driftRequeue(r.driftInterval), nil
+	return result, nil
 }
@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -28,6 +29,7 @@ type fakeGRPCRouteModel struct {
 	programRouteFunc        func(context.Context, programGRPCRouteParams) (programGRPCRouteResult, error)
 	deprovisionRouteFunc    func(context.Context, deprovisionGRPCRouteParams) error
 	setRejectedFunc         func(context.Context, resolvedGRPCRouteDetails, grpcRouteStatusError) error
+	setProgrammingFunc      func(context.Context, setGRPCRouteProgrammingParams) error
 	setProgrammedFunc       func(context.Context, setGRPCRouteProgrammedParams) error
 }
 
@@ -91,6 +93,16 @@ func (m fakeGRPCRouteModel) setRejected(
 	return m.setRejectedFunc(ctx, details, statusErr)
 }
 
+func (m fakeGRPCRouteModel) setProgramming(
+	ctx context.Context,
+	params setGRPCRouteProgrammingParams,
+) error {
+	if m.setProgrammingFunc == nil {
+		return nil
+	}
+	return m.setProgrammingFunc(ctx, params)
+}
+
 func (m fakeGRPCRouteModel) setProgrammed(
 	ctx context.Context,
 	params setGRPCRouteProgrammedParams,
@@ -224,6 +236,7 @@ func TestGRPCRouteController(t *testing.T) {
 			isProgrammingRequiredFn: func(resolvedGRPCRouteDetails) bool { return false },
 			ensureProtocolFunc: func(_ context.Context, params ensureGRPCListenersProtocolParams) error {
 				assert.Equal(t, resolved.gatewayDetails.config.Spec.LoadBalancerID, params.config.Spec.LoadBalancerID)
+				assert.Equal(t, resolved.gatewayDetails.gateway.Name, params.gateway.Name)
 				assert.Equal(t, resolved.matchedListeners, params.matchedListeners)
 				return nil
 			},
@@ -439,6 +452,124 @@ func TestGRPCRouteController(t *testing.T) {
 		assertDriftRequeue(t, got, 2*time.Minute)
 	})
 
+	t.Run("sets rejected status when routing policy capacity is exceeded", func(t *testing.T) {
+		route := makeRoute()
+		resolved := makeResolved(route)
+		capacityErr := routingPolicyCapacityError{message: faker.New().Lorem().Sentence(10)}
+		routeModel := fakeGRPCRouteModel{
+			resolveRequestFunc: func(
+				_ context.Context,
+				_ reconcile.Request,
+			) (map[apitypes.NamespacedName]resolvedGRPCRouteDetails, error) {
+				return resolvedMap(route, resolved), nil
+			},
+			isProgrammingRequiredFn: func(resolvedGRPCRouteDetails) bool { return true },
+			acceptRouteFunc: func(_ context.Context, details resolvedGRPCRouteDetails) (*gatewayv1.GRPCRoute, error) {
+				return &details.grpcRoute, nil
+			},
+			resolveBackendRefsFunc: func(context.Context, resolveGRPCBackendRefsParams) (map[string]corev1.Service, error) {
+				return map[string]corev1.Service{}, nil
+			},
+			programRouteFunc: func(context.Context, programGRPCRouteParams) (programGRPCRouteResult, error) {
+				return programGRPCRouteResult{}, fmt.Errorf("failed to commit routing policy: %w", capacityErr)
+			},
+			setRejectedFunc: func(_ context.Context, details resolvedGRPCRouteDetails, gotErr grpcRouteStatusError) error {
+				assert.Equal(t, route.Name, details.grpcRoute.Name)
+				assert.Equal(t, grpcRouteStatusError{
+					conditionType: gatewayv1.RouteConditionAccepted,
+					reason:        routeReasonRoutingPolicyCapacityExceeded,
+					message:       capacityErr.message,
+				}, gotErr)
+				return nil
+			},
+		}
+
+		got, err := newController(routeModel, NewMockhttpBackendModel(t)).Reconcile(t.Context(), reconcile.Request{})
+
+		require.NoError(t, err)
+		assertDriftRequeue(t, got, 2*time.Minute)
+	})
+
+	t.Run("sets rejected status when load balancer limit is exceeded", func(t *testing.T) {
+		route := makeRoute()
+		resolved := makeResolved(route)
+		limitErr := loadBalancerLimitError{
+			loadBalancerID: resolved.gatewayDetails.config.Spec.LoadBalancerID,
+			resource:       "backend sets",
+			limit:          defaultLoadBalancerMaxBackendSets,
+		}
+		routeModel := fakeGRPCRouteModel{
+			resolveRequestFunc: func(
+				_ context.Context,
+				_ reconcile.Request,
+			) (map[apitypes.NamespacedName]resolvedGRPCRouteDetails, error) {
+				return resolvedMap(route, resolved), nil
+			},
+			isProgrammingRequiredFn: func(resolvedGRPCRouteDetails) bool { return true },
+			acceptRouteFunc: func(_ context.Context, details resolvedGRPCRouteDetails) (*gatewayv1.GRPCRoute, error) {
+				return &details.grpcRoute, nil
+			},
+			resolveBackendRefsFunc: func(context.Context, resolveGRPCBackendRefsParams) (map[string]corev1.Service, error) {
+				return map[string]corev1.Service{}, nil
+			},
+			programRouteFunc: func(context.Context, programGRPCRouteParams) (programGRPCRouteResult, error) {
+				return programGRPCRouteResult{}, fmt.Errorf("failed to create backend set: %w", limitErr)
+			},
+			setRejectedFunc: func(_ context.Context, details resolvedGRPCRouteDetails, gotErr grpcRouteStatusError) error {
+				assert.Equal(t, route.Name, details.grpcRoute.Name)
+				assert.Equal(t, grpcRouteStatusError{
+					conditionType: gatewayv1.RouteConditionAccepted,
+					reason:        routeReasonLoadBalancerLimitExceeded,
+					message:       limitErr.Error(),
+				}, gotErr)
+				return nil
+			},
+		}
+
+		got, err := newController(routeModel, NewMockhttpBackendModel(t)).Reconcile(t.Context(), reconcile.Request{})
+
+		require.NoError(t, err)
+		assertDriftRequeue(t, got, 2*time.Minute)
+	})
+
+	t.Run("sets unresolved refs status when route match is not supported", func(t *testing.T) {
+		route := makeRoute()
+		resolved := makeResolved(route)
+		programErr := fmt.Errorf("failed to map matches: %w", errUnsupportedMatch)
+		routeModel := fakeGRPCRouteModel{
+			resolveRequestFunc: func(
+				_ context.Context,
+				_ reconcile.Request,
+			) (map[apitypes.NamespacedName]resolvedGRPCRouteDetails, error) {
+				return resolvedMap(route, resolved), nil
+			},
+			isProgrammingRequiredFn: func(resolvedGRPCRouteDetails) bool { return true },
+			acceptRouteFunc: func(_ context.Context, details resolvedGRPCRouteDetails) (*gatewayv1.GRPCRoute, error) {
+				return &details.grpcRoute, nil
+			},
+			resolveBackendRefsFunc: func(context.Context, resolveGRPCBackendRefsParams) (map[string]corev1.Service, error) {
+				return map[string]corev1.Service{}, nil
+			},
+			programRouteFunc: func(context.Context, programGRPCRouteParams) (programGRPCRouteResult, error) {
+				return programGRPCRouteResult{}, programErr
+			},
+			setRejectedFunc: func(_ context.Context, details resolvedGRPCRouteDetails, gotErr grpcRouteStatusError) error {
+				assert.Equal(t, route.Name, details.grpcRoute.Name)
+				assert.Equal(t, grpcRouteStatusError{
+					conditionType: gatewayv1.RouteConditionResolvedRefs,
+					reason:        gatewayv1.RouteReasonUnsupportedValue,
+					message:       programErr.Error(),
+				}, gotErr)
+				return nil
+			},
+		}
+
+		got, err := newController(routeModel, NewMockhttpBackendModel(t)).Reconcile(t.Context(), reconcile.Request{})
+
+		require.NoError(t, err)
+		assertDriftRequeue(t, got, 2*time.Minute)
+	})
+
 	t.Run("deprovisions deleted routes", func(t *testing.T) {
 		route := makeRoute()
 		now := metav1.Now()
@@ -451,6 +582,7 @@ func TestGRPCRouteController(t *testing.T) {
 			deprovisionRouteFunc: func(_ context.Context, params deprovisionGRPCRouteParams) error {
 				assert.Equal(t, route.Name, params.grpcRoute.Name)
 				assert.Equal(t, resolved.gatewayDetails.config, params.config)
+				assert.Equal(t, resolved.gatewayDetails.gateway, params.gateway)
 				return nil
 			},
 		}
@@ -530,6 +662,30 @@ func TestGRPCRouteController(t *testing.T) {
 		require.ErrorIs(t, err, wantErr)
 	})
 
+	t.Run("requeues when pod readiness gates are pending", func(t *testing.T) {
+		route := makeRoute()
+		resolved := makeResolved(route)
+		backendModel := NewMockhttpBackendModel(t)
+		routeModel := fakeGRPCRouteModel{
+			resolveRequestFunc: func(context.Context, reconcile.Request) (map[apitypes.NamespacedName]resolvedGRPCRouteDetails, error) {
+				return resolvedMap(route, resolved), nil
+			},
+			isProgrammingRequiredFn: func(resolvedGRPCRouteDetails) bool { return false },
+		}
+		backendModel.EXPECT().
+			syncGRPCRouteEndpoints(t.Context(), mock.Anything).
+			Return(fmt.Errorf("backend set %s: %w", faker.New().Lorem().Word(), errPodReadinessPending)).
+			Once()
+
+		result, err := newControllerWithDriftInterval(routeModel, backendModel, 0).Reconcile(
+			t.Context(),
+			reconcile.Request{},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, podReadinessGateRequeueInterval, result.RequeueAfter)
+	})
+
 	t.Run("returns set programmed errors", func(t *testing.T) {
 		route := makeRoute()
 		resolved := makeResolved(route)
@@ -14,7 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apitypes "k8s.io/apimachinery/pkg/types"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -45,11 +45,13 @@ type programGRPCRouteResult struct {
 }
 
 type ensureGRPCListenersProtocolParams struct {
+	gateway          gatewayv1.Gateway
 	config           types.GatewayConfig
 	matchedListeners []gatewayv1.Listener
 }
 
 type deprovisionGRPCRouteParams struct {
+	gateway          gatewayv1.Gateway
 	config           types.GatewayConfig
 	grpcRoute        gatewayv1.GRPCRoute
 	matchedListeners []gatewayv1.Listener
@@ -60,10 +62,18 @@ type setGRPCRouteProgrammedParams struct {
 	gatewayClass gatewayv1.GatewayClass
 	gateway      gatewayv1.Gateway
 	matchedRef   gatewayv1.ParentReference
+	naming       ociResourceNaming
 
 	programmedPolicyRules []string
 }
 
+type setGRPCRouteProgrammingParams struct {
+	// Updated in place, so the route can be used for further status updates
+	grpcRoute    *gatewayv1.GRPCRoute
+	gatewayClass gatewayv1.GatewayClass
+	matchedRef   gatewayv1.ParentReference
+}
+
 type grpcRouteModel interface {
 	resolveRequest(
 		ctx context.Context,
@@ -103,6 +113,11 @@ type grpcRouteModel interface {
 		statusErr grpcRouteStatusError,
 	) error
 
+	setProgramming(
+		ctx context.Context,
+		params setGRPCRouteProgrammingParams,
+	) error
+
 	setProgrammed(
 		ctx context.Context,
 		params setGRPCRouteProgrammedParams,
@@ -132,9 +147,11 @@ type grpcRouteModelImpl struct {
 	logger               *slog.Logger
 	gatewayModel         gatewayModel
 	resourcesModel       resourcesModel
+	programmingState     programmingStateModel
 	ociLoadBalancerModel ociLoadBalancerModel
 	backendTLSPolicy     backendTLSPolicyModel
 	backendTLSDisabled   bool
+	admissionHook        routeAdmissionHook
 }
 
 func (m *grpcRouteModelImpl) resolveRouteParentRefData(
@@ -180,9 +197,6 @@ func (m *grpcRouteModelImpl) resolveRouteParentRefData(
 				return listener.Name == sectionName && grpcRouteListenerProtocolSupported(listener.Protocol)
 			},
 		)
-		if len(matchingListeners) == 0 {
-			return nil, nil, nil
-		}
 		return &resolvedGatewayData, matchingListeners, nil
 	}
 
@@ -248,6 +262,7 @@ func (m *grpcRouteModelImpl) resolveRequest(
 	}
 
 	results := make(map[apitypes.NamespacedName]resolvedGRPCRouteDetails)
+	unattachedParents := make(map[apitypes.NamespacedName]l7RouteUnattachedParent)
 	for _, parentRef := range grpcRoute.Spec.ParentRefs {
 		resolvedGatewayData, matchedListeners, err := m.resolveRouteParentRefData(
 			ctx,
@@ -258,15 +273,65 @@ func (m *grpcRouteModelImpl) resolveRequest(
 		if err != nil {
 			return nil, err
 		}
-		if resolvedGatewayData != nil {
-			m.aggregateRouteParentRefData(
-				ctx,
-				results,
-				grpcRoute,
-				*resolvedGatewayData,
-				makeTargetOnlyParentRef(parentRef),
-				matchedListeners,
-			)
+		if resolvedGatewayData == nil {
+			continue
+		}
+		reason, message := l7RouteUnattachedReason(
+			resolvedGatewayData.gateway,
+			parentRef,
+			matchedListeners,
+			grpcRoute.Spec.Hostnames,
+		)
+		if reason != "" {
+			unattachedParents[client.ObjectKeyFromObject(&resolvedGatewayData.gateway)] = l7RouteUnattachedParent{
+				gatewayDetails: *resolvedGatewayData,
+				matchedRef:     makeTargetOnlyParentRef(parentRef),
+				reason:         reason,
+				message:        message,
+			}
+			continue
+		}
+		m.aggregateRouteParentRefData(
+			ctx,
+			results,
+			grpcRoute,
+			*resolvedGatewayData,
+			makeTargetOnlyParentRef(parentRef),
+			matchedListeners,
+		)
+	}
+
+	for parentName, result := range results {
+		config, message := l7RouteParentLoadBalancerConfig(result.gatewayDetails.config, result.matchedListeners)
+		if message != "" {
+			unattachedParents[parentName] = l7RouteUnattachedParent{
+				gatewayDetails: result.gatewayDetails,
+				matchedRef:     result.matchedRef,
+				reason:         gatewayv1.RouteReasonUnsupportedValue,
+				message:        message,
+			}
+			delete(results, parentName)
+			continue
+		}
+		result.gatewayDetails.config = config
+		results[parentName] = result
+		delete(unattachedParents, parentName)
+	}
+	if err := rejectL7RouteUnattachedParents(
+		ctx,
+		m.client,
+		&grpcRoute,
+		&grpcRoute.Status.Parents,
+		"GRPCRoute",
+		unattachedParents,
+	); err != nil {
+		return nil, err
+	}
+	if len(unattachedParents) > 0 {
+		// Rejected status update bumps the route resource version
+		for parentName, result := range results {
+			result.grpcRoute = grpcRoute
+			results[parentName] = result
 		}
 	}
 
@@ -297,7 +362,7 @@ func (m *grpcRouteModelImpl) acceptRoute(
 		return nil, err
 	}
 	if conflicted {
-		return nil, m.rejectRoute(ctx, routeDetails, l7RouteConflictMessage(winner))
+		return nil, m.rejectRoute(ctx, routeDetails, routeReasonConflicted, l7RouteConflictMessage(winner))
 	}
 
 	parentStatus, parentStatusIndex, found := lo.FindIndexOf(
@@ -323,6 +388,15 @@ func (m *grpcRouteModelImpl) acceptRoute(
 		}
 	}
 
+	admissionMessage, err := admitL7Route(ctx, m.admissionHook, "GRPCRoute",
+		&routeDetails.grpcRoute, routeDetails.gatewayDetails.gateway, routeDetails.matchedListeners)
+	if err != nil {
+		return nil, err
+	}
+	if admissionMessage != "" {
+		return nil, m.rejectRoute(ctx, routeDetails, routeReasonAdmissionDenied, admissionMessage)
+	}
+
 	grpcRoute := routeDetails.grpcRoute.DeepCopy()
 	meta.SetStatusCondition(&parentStatus.Conditions, metav1.Condition{
 		Type:               string(gatewayv1.RouteConditionAccepted),
@@ -371,19 +445,19 @@ func (m *grpcRouteModelImpl) listHTTPRouteConflictCandidates(ctx context.Context
 func (m *grpcRouteModelImpl) rejectRoute(
 	ctx context.Context,
 	routeDetails resolvedGRPCRouteDetails,
+	reason gatewayv1.RouteConditionReason,
 	message string,
 ) error {
 	grpcRoute := routeDetails.grpcRoute.DeepCopy()
-	if programmedPolicyRulesAnnotation, ok := grpcRoute.Annotations[GRPCRouteProgrammedPolicyRulesAnnotation]; ok {
-		if err := removeL7RoutePolicyRules(
-			ctx,
-			m.ociLoadBalancerModel,
-			routeDetails.gatewayDetails.config.Spec.LoadBalancerID,
-			routeDetails.matchedListeners,
-			programmedPolicyRulesAnnotation,
-		); err != nil {
-			return fmt.Errorf("failed to remove rejected GRPCRoute policy rules: %w", err)
-		}
+	if err := removeRejectedL7RoutePolicyRules(ctx, m.ociLoadBalancerModel, m.programmingState, l7RouteStateParams{
+		gateway:               routeDetails.gatewayDetails.gateway,
+		config:                routeDetails.gatewayDetails.config,
+		matchedListeners:      routeDetails.matchedListeners,
+		route:                 grpcRoute,
+		routeKind:             "GRPCRoute",
+		policyRulesAnnotation: GRPCRouteProgrammedPolicyRulesAnnotation,
+	}); err != nil {
+		return fmt.Errorf("failed to remove rejected GRPCRoute policy rules: %w", err)
 	}
 
 	return rejectL7Route(ctx, m.client, rejectL7RouteParams{
@@ -391,6 +465,7 @@ func (m *grpcRouteModelImpl) rejectRoute(
 		parentStatuses: &grpcRoute.Status.Parents,
 		gatewayClass:   routeDetails.gatewayDetails.gatewayClass,
 		matchedRef:     routeDetails.matchedRef,
+		reason:         reason,
 		message:        message,
 		routeKind:      "GRPCRoute",
 	})
@@ -404,6 +479,20 @@ func (m *grpcRouteModelImpl) resolveBackendRefs(
 	for _, rule := range params.grpcRoute.Spec.Rules {
 		for _, backendRef := range rule.BackendRefs {
 			fullName := backendObjectRefName(backendRef.BackendObjectReference, params.grpcRoute.Namespace)
+			if isExternalBackendRef(backendRef.BackendObjectReference) {
+				reason, message, err := checkExternalBackendRef(ctx, m.client, params.grpcRoute.Namespace, fullName)
+				if err != nil {
+					return nil, err
+				}
+				if reason != "" {
+					return nil, grpcRouteStatusError{
+						conditionType: gatewayv1.RouteConditionResolvedRefs,
+						reason:        reason,
+						message:       message,
+					}
+				}
+				continue
+			}
 			allowed, err := referenceGrantAllowsServiceBackend(
 				ctx,
 				m.client,
@@ -424,6 +513,13 @@ func (m *grpcRouteModelImpl) resolveBackendRefs(
 			if getErr := m.client.Get(ctx, fullName, &service); getErr != nil {
 				return nil, fmt.Errorf("failed to get service %s: %w", fullName.String(), getErr)
 			}
+			if message, unsupported := unsupportedBackendServiceMessage(service, fullName); unsupported {
+				return nil, grpcRouteStatusError{
+					conditionType: gatewayv1.RouteConditionResolvedRefs,
+					reason:        gatewayv1.RouteReasonUnsupportedValue,
+					message:       message,
+				}
+			}
 
 			resolvedBackendRefs[fullName.String()] = service
 		}
@@ -436,9 +532,20 @@ func (m *grpcRouteModelImpl) programRoute(
 	ctx context.Context,
 	params programGRPCRouteParams,
 ) (programGRPCRouteResult, error) {
-	var previousRules []programmedHTTPRoutePolicyRule
-	if prevPolicyRulesStr, ok := params.grpcRoute.Annotations[GRPCRouteProgrammedPolicyRulesAnnotation]; ok {
-		previousRules = parseProgrammedHTTPRoutePolicyRules(prevPolicyRulesStr)
+	previousRules, _, err := resolveL7RouteProgrammedPolicyRules(ctx, m.programmingState, l7RouteStateParams{
+		gateway:               params.gateway,
+		route:                 &params.grpcRoute,
+		routeKind:             "GRPCRoute",
+		policyRulesAnnotation: GRPCRouteProgrammedPolicyRulesAnnotation,
+	})
+	if err != nil {
+		return programGRPCRouteResult{}, err
+	}
+
+	backendRefs := grpcRouteBackendRefs(params.grpcRoute)
+	externalBackends, err := resolveL7ExternalBackends(ctx, m.client, params.grpcRoute.Namespace, backendRefs)
+	if err != nil {
+		return programGRPCRouteResult{}, err
 	}
 
 	routePolicyParams := programL7RoutePolicyParams{
@@ -447,17 +554,19 @@ func (m *grpcRouteModelImpl) programRoute(
 		config:              params.config,
 		routeName:           params.grpcRoute.Name,
 		routeNamespace:      params.grpcRoute.Namespace,
-		backendRefs:         grpcRouteBackendRefs(params.grpcRoute),
+		backendRefs:         backendRefs,
 		knownBackends:       params.knownBackends,
+		externalBackends:    externalBackends,
 		matchedListeners:    params.matchedListeners,
 		previousPolicyRules: previousRules,
 		backendTLSPolicy:    m.backendTLSPolicy,
 		backendTLSDisabled:  m.backendTLSDisabled,
 		ruleCount:           len(params.grpcRoute.Spec.Rules),
-		makeRoutingRule: func(ruleIndex int) (loadbalancer.RoutingRule, error) {
-			return m.ociLoadBalancerModel.makeGRPCRoutingRule(ctx, makeGRPCRoutingRuleParams{
+		makeRoutingRules: func(ruleIndex int) ([]loadbalancer.RoutingRule, error) {
+			return m.ociLoadBalancerModel.makeGRPCRoutingRules(ctx, makeGRPCRoutingRuleParams{
 				grpcRoute:          params.grpcRoute,
 				grpcRouteRuleIndex: ruleIndex,
+				naming:             ociResourceNamingFromConfig(params.config),
 			})
 		},
 	}
@@ -476,10 +585,12 @@ func (m *grpcRouteModelImpl) ensureGRPCListenersProtocol(
 	ctx context.Context,
 	params ensureGRPCListenersProtocolParams,
 ) error {
+	namePrefix := ociGatewayNamePrefix(&params.gateway, params.config)
+	naming := ociResourceNamingFromConfig(params.config)
 	for _, listener := range params.matchedListeners {
 		if err := m.ociLoadBalancerModel.ensureHTTP2ListenerProtocol(ctx, ensureHTTP2ListenerProtocolParams{
 			loadBalancerID: params.config.Spec.LoadBalancerID,
-			listenerName:   string(listener.Name),
+			listenerName:   naming.listenerName(namePrefix, listener.Name),
 		}); err != nil {
 			return fmt.Errorf(
 				"failed to ensure listener %s supports HTTP2: %w",
@@ -506,18 +617,25 @@ func (m *grpcRouteModelImpl) deprovisionRoute(
 	ctx context.Context,
 	params deprovisionGRPCRouteParams,
 ) error {
-	var previousRules []programmedHTTPRoutePolicyRule
-	if prevPolicyRulesStr, ok := params.grpcRoute.Annotations[GRPCRouteProgrammedPolicyRulesAnnotation]; ok {
-		previousRules = parseProgrammedHTTPRoutePolicyRules(prevPolicyRulesStr)
+	previousRules, _, err := resolveL7RouteProgrammedPolicyRules(ctx, m.programmingState, l7RouteStateParams{
+		gateway:               params.gateway,
+		route:                 &params.grpcRoute,
+		routeKind:             "GRPCRoute",
+		policyRulesAnnotation: GRPCRouteProgrammedPolicyRulesAnnotation,
+	})
+	if err != nil {
+		return err
 	}
 
-	prevRulesByListener := previousPolicyRulesByListener(previousRules, params.matchedListeners)
+	namePrefix := ociGatewayNamePrefix(&params.gateway, params.config)
+	naming := ociResourceNamingFromConfig(params.config)
+	prevRulesByListener := deprovisionPolicyRulesByListener(previousRules, params.gateway, params.matchedListeners)
 	listenerNames := lo.Keys(prevRulesByListener)
 	sort.Strings(listenerNames)
 	for _, listenerName := range listenerNames {
-		err := m.ociLoadBalancerModel.commitRoutingPolicy(ctx, commitRoutingPolicyParams{
+		err = m.ociLoadBalancerModel.commitRoutingPolicy(ctx, commitRoutingPolicyParams{
 			loadBalancerID:  params.config.Spec.LoadBalancerID,
-			listenerName:    listenerName,
+			listenerName:    naming.listenerName(namePrefix, gatewayv1.SectionName(listenerName)),
 			policyRules:     []loadbalancer.RoutingRule{},
 			prevPolicyRules: prevRulesByListener[listenerName],
 		})
@@ -526,37 +644,39 @@ func (m *grpcRouteModelImpl) deprovisionRoute(
 		}
 	}
 
-	processedBackendRefs := make(map[string]struct{})
-	for _, backendRef := range grpcRouteBackendRefs(params.grpcRoute) {
-		key := l7BackendRefKey(backendRef, params.grpcRoute.Namespace)
-		if _, ok := processedBackendRefs[key]; ok {
-			continue
-		}
-		err := m.ociLoadBalancerModel.deprovisionBackendSet(ctx, deprovisionBackendSetParams{
-			loadBalancerID: params.config.Spec.LoadBalancerID,
-			routeNamespace: params.grpcRoute.Namespace,
-			backendRef:     backendRef,
+	err = deprovisionL7RouteBackendSets(ctx, m.logger, m.ociLoadBalancerModel, m.programmingState,
+		deprovisionL7RouteBackendSetsParams{
+			gateway:     params.gateway,
+			config:      params.config,
+			route:       &params.grpcRoute,
+			routeKind:   "GRPCRoute",
+			backendRefs: grpcRouteBackendRefs(params.grpcRoute),
 		})
-		if err != nil {
-			return fmt.Errorf(
-				"failed to deprovision backend set for rule %s/%s: %w",
-				params.grpcRoute.Namespace,
-				params.grpcRoute.Name,
-				err,
-			)
-		}
-		processedBackendRefs[key] = struct{}{}
+	if err != nil {
+		return err
 	}
 
-	routeToUpdate := params.grpcRoute.DeepCopy()
-	controllerutil.RemoveFinalizer(routeToUpdate, GRPCRouteProgrammedFinalizer)
-
-	if err := m.client.Update(ctx, routeToUpdate); err != nil {
-		return fmt.Errorf("failed to update GRPCRoute %s/%s after deprovisioning: %w",
-			routeToUpdate.Namespace, routeToUpdate.Name, err)
+	var routeToUpdate gatewayv1.GRPCRoute
+	if err = m.client.Get(ctx, client.ObjectKeyFromObject(&params.grpcRoute), &routeToUpdate); err != nil {
+		if apierrors.IsNotFound(err) {
+			return m.programmingState.forgetProgrammedRoute(ctx, routeProgrammingStateParams{
+				gateway:   params.gateway,
+				routeKind: "GRPCRoute",
+				route:     &params.grpcRoute,
+			})
+		}
+		return fmt.Errorf("failed to get GRPCRoute %s/%s after deprovisioning: %w",
+			params.grpcRoute.Namespace, params.grpcRoute.Name, err)
 	}
 
-	return nil
+	return releaseL7RouteParent(ctx, m.client, m.programmingState, releaseL7RouteParentParams{
+		route:                 &routeToUpdate,
+		parentRefs:            routeToUpdate.Spec.ParentRefs,
+		gateway:               params.gateway,
+		policyRulesAnnotation: GRPCRouteProgrammedPolicyRulesAnnotation,
+		finalizer:             GRPCRouteProgrammedFinalizer,
+		routeKind:             "GRPCRoute",
+	})
 }
 
 func (m *grpcRouteModelImpl) setRejected(
@@ -579,6 +699,13 @@ func (m *grpcRouteModelImpl) setRejected(
 		)
 	}
 
+	failPendingProgramming(
+		&grpcRoute.Status.Parents[statusIndex].Conditions,
+		RouteConditionProgrammed,
+		grpcRoute.Generation,
+		string(statusErr.reason),
+		statusErr.message,
+	)
 	return m.resourcesModel.setCondition(ctx, setConditionParams{
 		resource:      grpcRoute,
 		conditions:    &grpcRoute.Status.Parents[statusIndex].Conditions,
@@ -608,22 +735,42 @@ func (m *grpcRouteModelImpl) isProgrammingRequired(details resolvedGRPCRouteDeta
 	})
 }
 
+func (m *grpcRouteModelImpl) setProgramming(
+	ctx context.Context,
+	params setGRPCRouteProgrammingParams,
+) error {
+	if err := setL7RouteProgramming(ctx, m.resourcesModel, setL7RouteProgrammingParams{
+		resource:       params.grpcRoute,
+		parentStatuses: params.grpcRoute.Status.Parents,
+		gatewayClass:   params.gatewayClass,
+		matchedRef:     params.matchedRef,
+	}); err != nil {
+		return fmt.Errorf("failed to update programming status for GRPCRoute %s: %w", params.grpcRoute.Name, err)
+	}
+	return nil
+}
+
 func (m *grpcRouteModelImpl) setProgrammed(
 	ctx context.Context,
 	params setGRPCRouteProgrammedParams,
 ) error {
 	grpcRoute := params.grpcRoute.DeepCopy()
 
-	err := setL7RouteProgrammed(ctx, m.resourcesModel, setL7RouteProgrammedParams{
+	err := setL7RouteProgrammed(ctx, m.resourcesModel, m.programmingState, setL7RouteProgrammedParams{
 		resource:              grpcRoute,
 		parentStatuses:        grpcRoute.Status.Parents,
 		gatewayClass:          params.gatewayClass,
 		gateway:               params.gateway,
 		matchedRef:            params.matchedRef,
 		programmedPolicyRules: params.programmedPolicyRules,
+		backendSets: l7RouteBackendSetNames(
+			params.naming,
+			grpcRoute.Namespace,
+			grpcRouteBackendRefs(*grpcRoute),
+		),
+		routeKind:             "GRPCRoute",
 		programmingAnnotation: GRPCRouteProgrammingRevisionAnnotation,
 		programmingRevision:   GRPCRouteProgrammingRevisionValue,
-		policyRulesAnnotation: GRPCRouteProgrammedPolicyRulesAnnotation,
 		finalizer:             GRPCRouteProgrammedFinalizer,
 	})
 	if err != nil {
@@ -640,12 +787,14 @@ func (m *grpcRouteModelImpl) setBackendTLSPolicyEnabled(enabled bool) {
 type grpcRouteModelDeps struct {
 	dig.In
 
-	K8sClient      k8sClient
-	RootLogger     *slog.Logger
-	GatewayModel   gatewayModel
-	OciLBModel     ociLoadBalancerModel
-	ResourcesModel resourcesModel
-	BackendTLS     backendTLSPolicyModel
+	K8sClient        k8sClient
+	RootLogger       *slog.Logger
+	GatewayModel     gatewayModel
+	OciLBModel       ociLoadBalancerModel
+	ResourcesModel   resourcesModel
+	ProgrammingState programmingStateModel
+	BackendTLS       backendTLSPolicyModel
+	AdmissionHook    routeAdmissionHook `optional:"true"`
 }
 
 func newGRPCRouteModel(deps grpcRouteModelDeps) *grpcRouteModelImpl {
@@ -655,6 +804,8 @@ func newGRPCRouteModel(deps grpcRouteModelDeps) *grpcRouteModelImpl {
 		gatewayModel:         deps.GatewayModel,
 		ociLoadBalancerModel: deps.OciLBModel,
 		resourcesModel:       deps.ResourcesModel,
+		programmingState:     deps.ProgrammingState,
 		backendTLSPolicy:     deps.BackendTLS,
+		admissionHook:        deps.AdmissionHook,
 	}
 }
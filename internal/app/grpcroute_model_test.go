@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"testing"
 	"time"
 
@@ -33,13 +32,23 @@ import (
 func TestGRPCRouteModelImpl(t *testing.T) {
 	newMockDeps := func(t *testing.T) grpcRouteModelDeps {
 		return grpcRouteModelDeps{
-			K8sClient:      NewMockk8sClient(t),
-			RootLogger:     diag.RootTestLogger(),
-			GatewayModel:   NewMockgatewayModel(t),
-			OciLBModel:     NewMockociLoadBalancerModel(t),
-			ResourcesModel: NewMockresourcesModel(t),
+			K8sClient:        NewMockk8sClient(t),
+			RootLogger:       diag.RootTestLogger(),
+			GatewayModel:     NewMockgatewayModel(t),
+			OciLBModel:       NewMockociLoadBalancerModel(t),
+			ResourcesModel:   NewMockresourcesModel(t),
+			ProgrammingState: NewMockprogrammingStateModel(t),
 		}
 	}
+	expectNoRecordedPolicyRules := func(t *testing.T, deps grpcRouteModelDeps) {
+		programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+		programmingState.EXPECT().
+			programmedRoutePolicyRules(t.Context(), mock.MatchedBy(func(params routeProgrammingStateParams) bool {
+				return params.routeKind == "GRPCRoute"
+			})).
+			Return(nil, false, nil).
+			Once()
+	}
 	makeGRPCRoute := func(opts ...func(*gatewayv1.GRPCRoute)) gatewayv1.GRPCRoute {
 		fake := faker.New()
 		route := gatewayv1.GRPCRoute{
@@ -149,7 +158,7 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 			assert.Equal(t, []gatewayv1.Listener{grpcListener}, gotListeners)
 		})
 
-		t.Run("returns nil when section listener protocol is unsupported", func(t *testing.T) {
+		t.Run("returns no listeners when section listener protocol is unsupported", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newGRPCRouteModel(deps)
 			gatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
@@ -177,8 +186,9 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 			)
 
 			require.NoError(t, err)
-			assert.Nil(t, gotGatewayData)
-			assert.Nil(t, gotListeners)
+			require.NotNil(t, gotGatewayData)
+			assert.Equal(t, gatewayData.gateway.Name, gotGatewayData.gateway.Name)
+			assert.Empty(t, gotListeners)
 		})
 
 		t.Run("uses parent ref namespace when provided", func(t *testing.T) {
@@ -324,6 +334,59 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 			assert.Len(t, result.matchedListeners, 2)
 		})
 
+		t.Run("rejects parent without compatible listeners", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGRPCRouteModel(deps)
+			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			gatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+			mockStatusWriter := k8sapi.NewMockSubResourceWriter(t)
+			grpcSection := gatewayv1.SectionName("grpc")
+			route := makeGRPCRoute(func(route *gatewayv1.GRPCRoute) {
+				route.Spec.ParentRefs = []gatewayv1.ParentReference{{Name: "gw", SectionName: &grpcSection}}
+			})
+			req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&route)}
+			gatewayData := makeResolvedGateway(gatewayv1.Listener{
+				Name:     grpcSection,
+				Port:     50051,
+				Protocol: gatewayv1.TCPProtocolType,
+			})
+
+			k8sClient.EXPECT().Get(t.Context(), req.NamespacedName, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+					*obj.(*gatewayv1.GRPCRoute) = route
+					return nil
+				})
+			gatewayModel.EXPECT().
+				resolveReconcileRequest(t.Context(), mock.Anything, mock.Anything).
+				RunAndReturn(func(
+					_ context.Context,
+					_ reconcile.Request,
+					receiver *resolvedGatewayDetails,
+				) (bool, error) {
+					*receiver = gatewayData
+					return true, nil
+				})
+			k8sClient.EXPECT().Status().Return(mockStatusWriter)
+			mockStatusWriter.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				updatedRoute, ok := obj.(*gatewayv1.GRPCRoute)
+				if !ok || len(updatedRoute.Status.Parents) != 1 {
+					return false
+				}
+				condition := meta.FindStatusCondition(
+					updatedRoute.Status.Parents[0].Conditions,
+					string(gatewayv1.RouteConditionAccepted),
+				)
+				return condition != nil &&
+					condition.Status == metav1.ConditionFalse &&
+					condition.Reason == string(gatewayv1.RouteReasonNoMatchingParent)
+			})).Return(nil)
+
+			got, err := model.resolveRequest(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Empty(t, got)
+		})
+
 		t.Run("skips parent refs when gateway does not resolve", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newGRPCRouteModel(deps)
@@ -598,6 +661,7 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 		t.Run("sets rejected parent status", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newGRPCRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
 			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
 			mockStatusWriter := k8sapi.NewMockSubResourceWriter(t)
 			gatewayData := makeResolvedGateway()
@@ -626,7 +690,7 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 				gatewayDetails: gatewayData,
 				grpcRoute:      route,
 				matchedRef:     parentRef,
-			}, wantMessage)
+			}, routeReasonConflicted, wantMessage)
 
 			require.NoError(t, err)
 		})
@@ -634,6 +698,7 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 		t.Run("returns policy cleanup errors", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newGRPCRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
 			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
 			fake := faker.New()
 			listenerName := gatewayv1.SectionName("grpc")
@@ -657,7 +722,7 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 				gatewayDetails:   gatewayData,
 				grpcRoute:        route,
 				matchedListeners: []gatewayv1.Listener{gatewayData.gateway.Spec.Listeners[0]},
-			}, fake.Lorem().Sentence(5))
+			}, routeReasonConflicted, fake.Lorem().Sentence(5))
 
 			require.ErrorIs(t, err, wantErr)
 		})
@@ -711,6 +776,36 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 			require.ErrorIs(t, err, wantErr)
 		})
 
+		t.Run("rejects ExternalName services", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newGRPCRouteModel(deps)
+			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			backendRef := makeGRPCBackendRef()
+			route := makeGRPCRoute(func(route *gatewayv1.GRPCRoute) {
+				route.Spec.Rules = []gatewayv1.GRPCRouteRule{{BackendRefs: []gatewayv1.GRPCBackendRef{backendRef}}}
+			})
+			service := corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: route.Namespace, Name: string(backendRef.Name)},
+				Spec: corev1.ServiceSpec{
+					Type:         corev1.ServiceTypeExternalName,
+					ExternalName: fake.Internet().Domain(),
+				},
+			}
+			k8sClient.EXPECT().Get(t.Context(), mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+					*obj.(*corev1.Service) = service
+					return nil
+				}).Once()
+
+			_, err := model.resolveBackendRefs(t.Context(), resolveGRPCBackendRefsParams{grpcRoute: route})
+
+			var statusErr grpcRouteStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, gatewayv1.RouteConditionResolvedRefs, statusErr.conditionType)
+			assert.Equal(t, gatewayv1.RouteReasonUnsupportedValue, statusErr.reason)
+		})
+
 		t.Run("rejects cross namespace backend without reference grant", func(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
@@ -827,6 +922,7 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
 			model := newGRPCRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
 			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
 			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
 			config := makeRandomGatewayConfig()
@@ -845,10 +941,18 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 				policyRules:     []loadbalancer.RoutingRule{},
 				prevPolicyRules: []string{ruleName},
 			}).Return(nil).Once()
+			setupClientGet(t, k8sClient, client.ObjectKeyFromObject(&route), route)
 			k8sClient.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
 				updatedRoute, ok := obj.(*gatewayv1.GRPCRoute)
 				return ok && !controllerutil.ContainsFinalizer(updatedRoute, GRPCRouteProgrammedFinalizer)
 			})).Return(nil).Once()
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				forgetProgrammedRoute(t.Context(), mock.MatchedBy(func(params routeProgrammingStateParams) bool {
+					return params.routeKind == "GRPCRoute" && params.route.GetName() == route.Name
+				})).
+				Return(nil).
+				Once()
 
 			err := model.deprovisionRoute(t.Context(), deprovisionGRPCRouteParams{
 				config:           config,
@@ -863,6 +967,7 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
 			model := newGRPCRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
 			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
 			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
 			config := makeRandomGatewayConfig()
@@ -886,15 +991,32 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 				policyRules:     []loadbalancer.RoutingRule{},
 				prevPolicyRules: []string{ruleName},
 			}).Return(nil).Once()
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				backendSetReferences(t.Context(), mock.MatchedBy(func(params backendSetReferencesParams) bool {
+					return params.routeKind == "GRPCRoute" &&
+						params.backendSetName == ociBackendSetNameFromGRPCBackendRef(
+							ociResourceNaming{}, route, backendRef,
+						)
+				})).
+				Return(nil, nil).
+				Once()
 			ociLBModel.EXPECT().deprovisionBackendSet(t.Context(), deprovisionBackendSetParams{
 				loadBalancerID: config.Spec.LoadBalancerID,
 				routeNamespace: route.Namespace,
 				backendRef:     backendRef.BackendRef,
 			}).Return(nil).Once().NotBefore(commitCall)
+			setupClientGet(t, k8sClient, client.ObjectKeyFromObject(&route), route)
 			k8sClient.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
 				updatedRoute, ok := obj.(*gatewayv1.GRPCRoute)
 				return ok && !controllerutil.ContainsFinalizer(updatedRoute, GRPCRouteProgrammedFinalizer)
 			})).Return(nil).Once()
+			programmingState.EXPECT().
+				forgetProgrammedRoute(t.Context(), mock.MatchedBy(func(params routeProgrammingStateParams) bool {
+					return params.routeKind == "GRPCRoute" && params.route.GetName() == route.Name
+				})).
+				Return(nil).
+				Once()
 
 			err := model.deprovisionRoute(t.Context(), deprovisionGRPCRouteParams{
 				config:           config,
@@ -908,11 +1030,13 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 		t.Run("returns update errors", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newGRPCRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
 			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
 			route := makeGRPCRoute(func(route *gatewayv1.GRPCRoute) {
 				controllerutil.AddFinalizer(route, GRPCRouteProgrammedFinalizer)
 			})
 			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+			setupClientGet(t, k8sClient, client.ObjectKeyFromObject(&route), route)
 			k8sClient.EXPECT().Update(t.Context(), mock.Anything).Return(wantErr).Once()
 
 			err := model.deprovisionRoute(t.Context(), deprovisionGRPCRouteParams{
@@ -923,10 +1047,111 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 			require.ErrorIs(t, err, wantErr)
 		})
 
+		t.Run("keeps finalizer while other parent gateway is programmed", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newGRPCRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
+			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
+			config := makeRandomGatewayConfig()
+			listenerName := gatewayv1.SectionName("grpc")
+			ruleName := "rule-" + fake.Lorem().Word()
+			gateway := newRandomGateway()
+			otherGateway := newRandomGateway()
+			gatewayAnnotation := l7RouteParentPolicyRulesAnnotation(
+				GRPCRouteProgrammedPolicyRulesAnnotation,
+				client.ObjectKeyFromObject(gateway).String(),
+			)
+			otherGatewayAnnotation := l7RouteParentPolicyRulesAnnotation(
+				GRPCRouteProgrammedPolicyRulesAnnotation,
+				client.ObjectKeyFromObject(otherGateway).String(),
+			)
+			otherRules := fmt.Sprintf("%s/other-rule-%s", listenerName, fake.Lorem().Word())
+			route := makeGRPCRoute(func(route *gatewayv1.GRPCRoute) {
+				route.Spec.ParentRefs = []gatewayv1.ParentReference{
+					{Name: gatewayv1.ObjectName(gateway.Name), Namespace: new(gatewayv1.Namespace(gateway.Namespace))},
+					{
+						Name:      gatewayv1.ObjectName(otherGateway.Name),
+						Namespace: new(gatewayv1.Namespace(otherGateway.Namespace)),
+					},
+				}
+				route.Annotations = map[string]string{
+					gatewayAnnotation:      fmt.Sprintf("%s/%s", listenerName, ruleName),
+					otherGatewayAnnotation: otherRules,
+				}
+				controllerutil.AddFinalizer(route, GRPCRouteProgrammedFinalizer)
+			})
+
+			ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+				loadBalancerID:  config.Spec.LoadBalancerID,
+				listenerName:    string(listenerName),
+				policyRules:     []loadbalancer.RoutingRule{},
+				prevPolicyRules: []string{ruleName},
+			}).Return(nil).Once()
+			setupClientGet(t, k8sClient, client.ObjectKeyFromObject(&route), route)
+			setupClientGet(t, k8sClient, client.ObjectKeyFromObject(otherGateway), *otherGateway)
+			k8sClient.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				updatedRoute, ok := obj.(*gatewayv1.GRPCRoute)
+				return ok &&
+					controllerutil.ContainsFinalizer(updatedRoute, GRPCRouteProgrammedFinalizer) &&
+					assert.Equal(t, map[string]string{otherGatewayAnnotation: otherRules}, updatedRoute.Annotations)
+			})).Return(nil).Once()
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				forgetProgrammedRoute(t.Context(), mock.MatchedBy(func(params routeProgrammingStateParams) bool {
+					return params.routeKind == "GRPCRoute" && params.route.GetName() == route.Name
+				})).
+				Return(nil).
+				Once()
+
+			err := model.deprovisionRoute(t.Context(), deprovisionGRPCRouteParams{
+				gateway:          *gateway,
+				config:           config,
+				grpcRoute:        route,
+				matchedListeners: []gatewayv1.Listener{{Name: listenerName}},
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("forgets state of route removed by other parent deprovisioning", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newGRPCRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
+			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			route := makeGRPCRoute(func(route *gatewayv1.GRPCRoute) {
+				controllerutil.AddFinalizer(route, GRPCRouteProgrammedFinalizer)
+			})
+			k8sClient.EXPECT().
+				Get(t.Context(), client.ObjectKeyFromObject(&route), mock.Anything).
+				Return(apierrors.NewNotFound(schema.GroupResource{
+					Group:    gatewayv1.GroupName,
+					Resource: "GRPCRoute",
+				}, route.Name)).
+				Once()
+			gateway := newRandomGateway()
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().forgetProgrammedRoute(t.Context(), routeProgrammingStateParams{
+				gateway:   *gateway,
+				routeKind: "GRPCRoute",
+				route:     &route,
+			}).Return(nil).Once()
+
+			err := model.deprovisionRoute(t.Context(), deprovisionGRPCRouteParams{
+				gateway:   *gateway,
+				config:    makeRandomGatewayConfig(),
+				grpcRoute: route,
+			})
+
+			require.NoError(t, err)
+		})
+
 		t.Run("returns routing policy cleanup errors", func(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
 			model := newGRPCRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
 			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
 			listenerName := gatewayv1.SectionName("grpc")
 			ruleName := "rule-" + fake.Lorem().Word()
@@ -958,6 +1183,7 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
 			model := newGRPCRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
 			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
 			listenerName := gatewayv1.SectionName("grpc")
 			ruleName := "rule-" + fake.Lorem().Word()
@@ -977,6 +1203,8 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 				policyRules:     []loadbalancer.RoutingRule{},
 				prevPolicyRules: []string{ruleName},
 			}).Return(nil).Once()
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().backendSetReferences(t.Context(), mock.Anything).Return(nil, nil).Once()
 			ociLBModel.EXPECT().deprovisionBackendSet(t.Context(), deprovisionBackendSetParams{
 				loadBalancerID: config.Spec.LoadBalancerID,
 				routeNamespace: route.Namespace,
@@ -1037,6 +1265,7 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 		fake := faker.New()
 		deps := newMockDeps(t)
 		model := newGRPCRouteModel(deps)
+		expectNoRecordedPolicyRules(t, deps)
 		ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
 		backendRef := makeGRPCBackendRef()
 		previousRuleName := "previous-grpc-rule-" + fake.Lorem().Word()
@@ -1067,10 +1296,10 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 			routeNS:        route.Namespace,
 			backendRef:     backendRef.BackendRef,
 		}).Return(nil).Once()
-		ociLBModel.EXPECT().makeGRPCRoutingRule(t.Context(), makeGRPCRoutingRuleParams{
+		ociLBModel.EXPECT().makeGRPCRoutingRules(t.Context(), makeGRPCRoutingRuleParams{
 			grpcRoute:          route,
 			grpcRouteRuleIndex: 0,
-		}).Return(routingRule, nil).Once()
+		}).Return([]loadbalancer.RoutingRule{routingRule}, nil).Once()
 		ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
 			loadBalancerID:  config.Spec.LoadBalancerID,
 			listenerName:    string(listener.Name),
@@ -1097,6 +1326,7 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 		fake := faker.New()
 		deps := newMockDeps(t)
 		model := newGRPCRouteModel(deps)
+		expectNoRecordedPolicyRules(t, deps)
 		model.backendTLSPolicy = &stubBackendTLSPolicyModel{resolveErr: errBackendTLSPolicyNotFound}
 		ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
 		config := makeRandomGatewayConfig()
@@ -1123,10 +1353,10 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 			})).
 			Return(nil).
 			Once()
-		ociLBModel.EXPECT().makeGRPCRoutingRule(t.Context(), makeGRPCRoutingRuleParams{
+		ociLBModel.EXPECT().makeGRPCRoutingRules(t.Context(), makeGRPCRoutingRuleParams{
 			grpcRoute:          route,
 			grpcRouteRuleIndex: 0,
-		}).Return(routingRule, nil).Once()
+		}).Return([]loadbalancer.RoutingRule{routingRule}, nil).Once()
 		ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
 			loadBalancerID: config.Spec.LoadBalancerID,
 			listenerName:   string(listener.Name),
@@ -1169,6 +1399,29 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("ensureGRPCListenersProtocol updates prefixed listeners of shared load balancer", func(t *testing.T) {
+		deps := newMockDeps(t)
+		model := newGRPCRouteModel(deps)
+		ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
+		gateway := newRandomGateway()
+		config := makeRandomGatewayConfig()
+		config.Spec.SharedLoadBalancer = true
+		listener := gatewayv1.Listener{Name: gatewayv1.SectionName("grpc"), Port: 50051}
+
+		ociLBModel.EXPECT().ensureHTTP2ListenerProtocol(t.Context(), ensureHTTP2ListenerProtocolParams{
+			loadBalancerID: config.Spec.LoadBalancerID,
+			listenerName:   ociGatewayNamePrefix(gateway, config) + "grpc",
+		}).Return(nil).Once()
+
+		err := model.ensureGRPCListenersProtocol(t.Context(), ensureGRPCListenersProtocolParams{
+			gateway:          *gateway,
+			config:           config,
+			matchedListeners: []gatewayv1.Listener{listener},
+		})
+
+		require.NoError(t, err)
+	})
+
 	t.Run("ensureGRPCListenersProtocol returns listener protocol update errors", func(t *testing.T) {
 		fake := faker.New()
 		deps := newMockDeps(t)
@@ -1195,6 +1448,7 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 		fake := faker.New()
 		deps := newMockDeps(t)
 		model := newGRPCRouteModel(deps)
+		expectNoRecordedPolicyRules(t, deps)
 		ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
 		backendRef := makeGRPCBackendRef()
 		route := makeGRPCRoute(func(route *gatewayv1.GRPCRoute) {
@@ -1208,8 +1462,8 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 
 		ociLBModel.EXPECT().reconcileBackendSet(t.Context(), mock.Anything).Return(nil).Once()
 		ociLBModel.EXPECT().
-			makeGRPCRoutingRule(t.Context(), mock.Anything).
-			Return(loadbalancer.RoutingRule{}, wantErr).
+			makeGRPCRoutingRules(t.Context(), mock.Anything).
+			Return(nil, wantErr).
 			Once()
 
 		_, err := model.programRoute(t.Context(), programGRPCRouteParams{
@@ -1239,12 +1493,21 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 		})
 		programmedRules := []string{"grpc/rule-" + fake.Lorem().Word()}
 
+		programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+		recordCall := programmingState.EXPECT().recordProgrammedRoute(t.Context(), recordProgrammedRouteParams{
+			gateway:     gateway,
+			routeKind:   "GRPCRoute",
+			route:       &route,
+			policyRules: programmedRules,
+			backendSets: l7RouteBackendSetNames(ociResourceNaming{}, route.Namespace, grpcRouteBackendRefs(route)),
+		}).Return(nil).Once()
 		resourcesModel.EXPECT().setCondition(t.Context(), mock.MatchedBy(func(params setConditionParams) bool {
 			return params.conditionType == string(gatewayv1.RouteConditionResolvedRefs) &&
 				params.finalizer == GRPCRouteProgrammedFinalizer &&
-				params.annotations[GRPCRouteProgrammingRevisionAnnotation] == GRPCRouteProgrammingRevisionValue &&
-				params.annotations[GRPCRouteProgrammedPolicyRulesAnnotation] == strings.Join(programmedRules, ",")
-		})).Return(nil).Once()
+				assert.Equal(t, map[string]string{
+					GRPCRouteProgrammingRevisionAnnotation: GRPCRouteProgrammingRevisionValue,
+				}, params.annotations)
+		})).Return(nil).Once().NotBefore(recordCall)
 
 		err := model.setProgrammed(t.Context(), setGRPCRouteProgrammedParams{
 			grpcRoute:             route,
@@ -1321,6 +1584,8 @@ func TestGRPCRouteModelImpl(t *testing.T) {
 		resourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
 		gateway := gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gw-" + fake.Lorem().Word()}}
 		wantErr := errors.New(fake.Lorem().Sentence(10))
+		programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+		programmingState.EXPECT().recordProgrammedRoute(t.Context(), mock.Anything).Return(nil).Once()
 		resourcesModel.EXPECT().setCondition(t.Context(), mock.Anything).Return(wantErr).Once()
 
 		err := model.setProgrammed(t.Context(), setGRPCRouteProgrammedParams{
@@ -2,13 +2,20 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strconv"
 
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/dig"
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -20,6 +27,11 @@ type syncRouteEndpointsParams struct {
 	config    types.GatewayConfig
 }
 
+type routeBackendsHealthParams struct {
+	httpRoute gatewayv1.HTTPRoute
+	config    types.GatewayConfig
+}
+
 type syncGRPCRouteEndpointsParams struct {
 	grpcRoute gatewayv1.GRPCRoute
 	config    types.GatewayConfig
@@ -34,6 +46,11 @@ type syncL7RouteEndpointsParams struct {
 	backendLabel string
 }
 
+type syncDefaultBackendEndpointsParams struct {
+	gateway *gatewayv1.Gateway
+	config  types.GatewayConfig
+}
+
 type syncRouteBackendRefEndpointsParams struct {
 	config     types.GatewayConfig
 	routeKind  string
@@ -42,16 +59,72 @@ type syncRouteBackendRefEndpointsParams struct {
 	backendRef gatewayv1.BackendRef
 }
 
+type identifyBackendSetBackendsParams struct {
+	existingBackendSet  loadbalancer.BackendSet
+	backendRefNamespace string
+	backendRef          gatewayv1.BackendRef
+	ipFamilies          []corev1.IPFamily
+	topology            *types.GatewayConfigBackendTopology
+}
+
 type identifyBackendsToUpdateParams struct {
-	endpointPort    int32
+	// endpointPort is used when the endpoint port can not be resolved from the servicePort.
+	endpointPort int32
+
+	// servicePort is the backendRef port of the service. Its targetPort
+	// is resolved to the actual container port of each EndpointSlice.
+	servicePort *corev1.ServicePort
+
 	currentBackends []loadbalancer.Backend
 	endpointSlices  []discoveryv1.EndpointSlice
+
+	// ipFamilies are the GatewayConfig backend IP families, defaults to IPv4 if empty.
+	ipFamilies []corev1.IPFamily
+
+	// topology is the GatewayConfig backend topology, endpoint zones are ignored if nil.
+	topology *types.GatewayConfigBackendTopology
 }
 
 type identifyBackendsToUpdateResult struct {
 	updateRequired  bool
 	updatedBackends []loadbalancer.BackendDetails
 	drainingCount   int
+
+	// Pods registered while waiting for the backend health readiness gate.
+	gatedPods []gatedBackendPod
+	// Pods with the backend health readiness gate that are not registered yet
+	// since their containers are not ready.
+	startingGatedPods int
+
+	// Endpoints of address types that are not enabled by the backend IP families.
+	skippedEndpoints map[discoveryv1.AddressType]int
+
+	// Fingerprint of the EndpointSlices the backends are identified from,
+	// empty if the backends do not depend on EndpointSlices only.
+	endpointsFingerprint string
+}
+
+const (
+	minBackendWeight         = 1
+	maxBackendWeight         = 100
+	minBackendMaxConnections = 256
+	maxBackendMaxConnections = 65535
+)
+
+// httpBackendTuning holds per backend settings configured with Pod annotations.
+type httpBackendTuning struct {
+	weight         *int
+	maxConnections *int
+}
+
+// backendAddressTypes returns address types of EndpointSlices registered in the backend sets.
+func backendAddressTypes(ipFamilies []corev1.IPFamily) []discoveryv1.AddressType {
+	if len(ipFamilies) == 0 {
+		return []discoveryv1.AddressType{discoveryv1.AddressTypeIPv4}
+	}
+	return lo.Map(ipFamilies, func(family corev1.IPFamily, _ int) discoveryv1.AddressType {
+		return discoveryv1.AddressType(family)
+	})
 }
 
 type httpBackendAddressKey struct {
@@ -64,6 +137,8 @@ type httpBackendModel interface {
 	// syncRouteEndpoints synchronizes the OCI Load Balancer Backend Sets associated with the
 	// provided HTTPRoute, ensuring they contain the correct set of ready endpoints
 	// derived from the referenced Kubernetes Services' EndpointSlices.
+	// It returns errPodReadinessPending if pods with the backend health readiness gate
+	// are not healthy yet, all backend sets are synced in this case.
 	syncRouteEndpoints(ctx context.Context, params syncRouteEndpointsParams) error
 
 	// syncGRPCRouteEndpoints synchronizes the OCI Load Balancer Backend Sets associated with
@@ -80,6 +155,15 @@ type httpBackendModel interface {
 	// syncRouteBackendRefEndpoints synchronizes the OCI Load Balancer Backend Sets associated with the
 	// single backend ref of the provided HTTPRoute.
 	syncRouteBackendRefEndpoints(ctx context.Context, params syncRouteBackendRefEndpointsParams) error
+
+	// routeBackendsHealth aggregates OCI health of the backend sets used by the provided HTTPRoute.
+	// Backend sets that are not created yet are ignored.
+	routeBackendsHealth(ctx context.Context, params routeBackendsHealthParams) (backendsHealthSummary, error)
+
+	// syncDefaultBackendEndpoints synchronizes the default backend set of the gateway with
+	// the endpoints of the GatewayConfig default backend Service. The default backend set
+	// is emptied if the default backend is not configured.
+	syncDefaultBackendEndpoints(ctx context.Context, params syncDefaultBackendEndpointsParams) error
 }
 
 type httpBackendModelImpl struct {
@@ -87,6 +171,13 @@ type httpBackendModelImpl struct {
 	k8sClient           k8sClient
 	ociClient           ociLoadBalancerClient
 	workRequestsWatcher workRequestsWatcher
+	endpointMetrics     *backendEndpointMetrics
+
+	// Maximum number of backend sets of a route synced at the same time.
+	backendSyncConcurrency int
+
+	// Fingerprints of endpoints programmed to backend sets, nil disables skipping unchanged syncs.
+	endpointsFingerprints *endpointsFingerprints
 
 	// Used to allow mocking own methods in tests
 	self httpBackendModel
@@ -135,30 +226,163 @@ func (m *httpBackendModelImpl) syncGRPCRouteEndpoints(
 func (m *httpBackendModelImpl) syncL7RouteEndpoints(
 	ctx context.Context,
 	params syncL7RouteEndpointsParams,
-) error {
+) (err error) {
+	ctx, endSpan := startSpan(ctx, "httpBackendModel.syncRouteEndpoints",
+		attribute.String("k8s.route_kind", params.routeKind),
+		attribute.String("k8s.route", params.routeNS+"/"+params.routeName),
+	)
+	defer func() { endSpan(err) }()
+
 	m.logger.InfoContext(ctx, "Syncing backend endpoints",
 		slog.String(params.backendLabel, params.routeName),
 		slog.String("config", params.config.Name),
 	)
 
+	// Backend sets of distinct backend refs are synced concurrently, each sync waits for
+	// its own work request. Results are collected by the backend ref index.
 	processedBackendRefs := make(map[string]bool)
+	syncErrs := make([]error, len(params.backendRefs))
+	syncGroup := errgroup.Group{}
+	syncGroup.SetLimit(m.backendSyncConcurrency)
 	for index, backendRef := range params.backendRefs {
 		refKey := l7BackendRefKey(backendRef, params.routeNS)
 		if _, ok := processedBackendRefs[refKey]; ok {
 			continue
 		}
-		if err := m.self.syncRouteBackendRefEndpoints(ctx, syncRouteBackendRefEndpointsParams{
-			routeKind:  params.routeKind,
-			routeName:  params.routeName,
-			routeNS:    params.routeNS,
-			config:     params.config,
-			backendRef: backendRef,
-		}); err != nil {
-			return fmt.Errorf("failed to sync route backend endpoints for backend ref %d: %w", index, err)
-		}
 		processedBackendRefs[refKey] = true
+		syncGroup.Go(func() error {
+			syncErrs[index] = m.self.syncRouteBackendRefEndpoints(ctx, syncRouteBackendRefEndpointsParams{
+				routeKind:  params.routeKind,
+				routeName:  params.routeName,
+				routeNS:    params.routeNS,
+				config:     params.config,
+				backendRef: backendRef,
+			})
+			return nil
+		})
+	}
+	_ = syncGroup.Wait() // Errors are collected in syncErrs
+
+	var readinessPendingErr error
+	var failures []error
+	for index, syncErr := range syncErrs {
+		if syncErr == nil {
+			continue
+		}
+		// Pending readiness gates must not block other backend sets of the route.
+		if errors.Is(syncErr, errPodReadinessPending) {
+			readinessPendingErr = syncErr
+			continue
+		}
+		failures = append(failures,
+			fmt.Errorf("failed to sync route backend endpoints for backend ref %d: %w", index, syncErr))
+	}
+	if len(failures) > 0 {
+		return errors.Join(failures...)
+	}
+
+	return readinessPendingErr
+}
+
+func (m *httpBackendModelImpl) routeBackendsHealth(
+	ctx context.Context,
+	params routeBackendsHealthParams,
+) (backendsHealthSummary, error) {
+	var summary backendsHealthSummary
+	processedBackendSets := make(map[string]bool)
+	naming := ociResourceNamingFromConfig(params.config)
+	for _, rule := range params.httpRoute.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			backendSetName := ociBackendSetNameFromBackendRef(naming, params.httpRoute, backendRef)
+			if processedBackendSets[backendSetName] {
+				continue
+			}
+			processedBackendSets[backendSetName] = true
+
+			res, err := m.ociClient.GetBackendSetHealth(ctx, loadbalancer.GetBackendSetHealthRequest{
+				LoadBalancerId: &params.config.Spec.LoadBalancerID,
+				BackendSetName: &backendSetName,
+			})
+			found, lookupErr := backendSetLookupFound(err)
+			if lookupErr != nil {
+				return backendsHealthSummary{}, fmt.Errorf(
+					"failed to get backend set %s health: %w", backendSetName, lookupErr)
+			}
+			if !found {
+				continue
+			}
+			summary.add(res.BackendSetHealth)
+		}
 	}
+	return summary, nil
+}
 
+func (m *httpBackendModelImpl) syncDefaultBackendEndpoints(
+	ctx context.Context,
+	params syncDefaultBackendEndpointsParams,
+) error {
+	backendSetName := ociDefaultBackendSetName(
+		ociResourceNamingFromConfig(params.config),
+		params.gateway,
+		ociGatewayNamePrefix(params.gateway, params.config),
+	)
+	defaultBackend := params.config.Spec.DefaultBackend
+	if defaultBackend == nil {
+		return m.clearBackendSetEndpoints(ctx, params.config.Spec.LoadBalancerID, backendSetName)
+	}
+	return m.updateBackendSetEndpoints(ctx, syncRouteBackendRefEndpointsParams{
+		config:    params.config,
+		routeKind: "Gateway",
+		routeName: params.gateway.Name,
+		routeNS:   params.config.Namespace,
+		backendRef: gatewayv1.BackendRef{
+			BackendObjectReference: gatewayv1.BackendObjectReference{
+				Name:      gatewayv1.ObjectName(defaultBackend.ServiceName),
+				Namespace: new(gatewayv1.Namespace(params.config.Namespace)),
+				Port:      new(defaultBackend.Port),
+			},
+		},
+	}, params.config.Namespace, backendSetName)
+}
+
+// clearBackendSetEndpoints removes all backends of the backend set, e.g. when
+// the default backend is no longer configured.
+func (m *httpBackendModelImpl) clearBackendSetEndpoints(
+	ctx context.Context,
+	loadBalancerID string,
+	backendSetName string,
+) error {
+	m.endpointsFingerprints.forget(endpointsFingerprintKey(loadBalancerID, backendSetName))
+	getResp, err := m.ociClient.GetBackendSet(ctx, loadbalancer.GetBackendSetRequest{
+		LoadBalancerId: &loadBalancerID,
+		BackendSetName: &backendSetName,
+	})
+	found, lookupErr := backendSetLookupFound(err)
+	if lookupErr != nil {
+		return fmt.Errorf("failed to get backend set %s: %w", backendSetName, lookupErr)
+	}
+	if !found || len(getResp.BackendSet.Backends) == 0 {
+		return nil
+	}
+
+	m.logger.InfoContext(ctx, "Removing backends of backend set",
+		slog.String("backendSetName", backendSetName),
+		slog.Int("currentBackends", len(getResp.BackendSet.Backends)),
+	)
+	updateResp, err := m.ociClient.UpdateBackendSet(ctx, loadbalancer.UpdateBackendSetRequest{
+		LoadBalancerId:          &loadBalancerID,
+		BackendSetName:          &backendSetName,
+		UpdateBackendSetDetails: makeUpdateOciBackendSetDetails(getResp.BackendSet, []loadbalancer.BackendDetails{}),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update backend set %s: %w", backendSetName, err)
+	}
+	if updateResp.OpcWorkRequestId == nil {
+		return fmt.Errorf("failed to update backend set %s: missing work request id", backendSetName)
+	}
+	if err = m.workRequestsWatcher.WaitFor(ctx, *updateResp.OpcWorkRequestId); err != nil {
+		return fmt.Errorf("failed to wait for backend set %s to be updated: %w", backendSetName, err)
+	}
 	return nil
 }
 
@@ -168,37 +392,112 @@ func (m *httpBackendModelImpl) identifyBackendsToUpdate(
 ) (identifyBackendsToUpdateResult, error) {
 	desiredBackendsMap := make(map[httpBackendAddressKey]loadbalancer.BackendDetails)
 	var drainingCount int
+	var gatedPods []gatedBackendPod
+	var startingGatedPods int
+	var skippedEndpoints map[discoveryv1.AddressType]int
+	addressTypes := backendAddressTypes(params.ipFamilies)
 
+	endpointSlices := make([]discoveryv1.EndpointSlice, 0, len(params.endpointSlices))
 	for _, slice := range params.endpointSlices {
+		if slices.Contains(addressTypes, slice.AddressType) {
+			endpointSlices = append(endpointSlices, slice)
+			continue
+		}
+		// FQDN slices and slices of other IP families can not be programmed
+		// to the load balancer backend sets.
+		if len(slice.Endpoints) > 0 {
+			if skippedEndpoints == nil {
+				skippedEndpoints = make(map[discoveryv1.AddressType]int)
+			}
+			skippedEndpoints[slice.AddressType] += len(slice.Endpoints)
+		}
+	}
+	topology := newBackendTopology(params.topology)
+	restrictedTopology := topology.restrictedTo(endpointSlices)
+
+	for _, slice := range endpointSlices {
+		endpointPort := int(params.endpointPort)
+		if params.servicePort != nil {
+			if targetPort, ok := l4EndpointPortForServicePort(*params.servicePort, slice); ok {
+				endpointPort = targetPort
+			}
+		}
+
 		for _, endpoint := range slice.Endpoints {
-			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+			isReady := endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+			if !isReady && (endpoint.TargetRef == nil || endpoint.TargetRef.Kind != "Pod") {
 				continue
 			}
 			if len(endpoint.Addresses) == 0 {
 				m.logger.WarnContext(ctx, "Endpoint has no addresses", slog.Any("endpoint", endpoint))
 				continue
 			}
+			if restrictedTopology != nil && !restrictedTopology.endpointLocal(endpoint) {
+				continue
+			}
+
+			pod, err := m.endpointPod(ctx, slice.Namespace, endpoint)
+			if err != nil {
+				return identifyBackendsToUpdateResult{}, err
+			}
 			ipAddress := endpoint.Addresses[0]
+			if !isReady {
+				// Pods with the backend health readiness gate only become ready once
+				// the load balancer reports them healthy, so they are registered as
+				// soon as their containers are ready.
+				if !podAwaitsBackendHealth(pod) {
+					continue
+				}
+				if !podConditionTrue(pod, corev1.ContainersReady) {
+					startingGatedPods++
+					continue
+				}
+				gatedPods = append(gatedPods, gatedBackendPod{
+					pod:       client.ObjectKeyFromObject(pod),
+					ipAddress: ipAddress,
+					port:      endpointPort,
+				})
+			}
 			isDraining := endpoint.Conditions.Terminating != nil && *endpoint.Conditions.Terminating
 
 			if isDraining {
 				drainingCount++
 			}
 
+			tuning := m.resolveBackendTuning(ctx, pod)
+
 			desiredBackendsMap[httpBackendAddressKey{
 				ipAddress: ipAddress,
-				port:      int(params.endpointPort),
+				port:      endpointPort,
 			}] = loadbalancer.BackendDetails{
-				Port:      new(int(params.endpointPort)),
-				IpAddress: &ipAddress,
-				Drain:     new(isDraining),
-				// Weight, MaxConnections, Backup, Offline are not managed here
+				Port:           new(endpointPort),
+				IpAddress:      &ipAddress,
+				Drain:          new(isDraining),
+				Weight:         lo.CoalesceOrEmpty(tuning.weight, topology.endpointWeight(endpoint)),
+				MaxConnections: tuning.maxConnections,
+				// Backup, Offline are not managed here
 			}
 		}
 	}
 
+	return identifyBackendsToUpdateResult{
+		updateRequired:    backendsUpdateRequired(params.currentBackends, desiredBackendsMap),
+		updatedBackends:   lo.Values(desiredBackendsMap),
+		drainingCount:     drainingCount,
+		gatedPods:         gatedPods,
+		startingGatedPods: startingGatedPods,
+		skippedEndpoints:  skippedEndpoints,
+	}, nil
+}
+
+// backendsUpdateRequired reports whether the current backends of the backend set
+// differ from the desired ones.
+func backendsUpdateRequired(
+	currentBackends []loadbalancer.Backend,
+	desiredBackendsMap map[httpBackendAddressKey]loadbalancer.BackendDetails,
+) bool {
 	currentBackendsMap := lo.SliceToMap(
-		lo.Filter(params.currentBackends, func(b loadbalancer.Backend, _ int) bool {
+		lo.Filter(currentBackends, func(b loadbalancer.Backend, _ int) bool {
 			return b.IpAddress != nil
 		}),
 		func(b loadbalancer.Backend) (httpBackendAddressKey, loadbalancer.Backend) {
@@ -209,26 +508,118 @@ func (m *httpBackendModelImpl) identifyBackendsToUpdate(
 		},
 	)
 
-	updateRequired := false
 	if len(desiredBackendsMap) != len(currentBackendsMap) {
-		updateRequired = true
-	} else {
-		for ip, desired := range desiredBackendsMap {
-			current, exists := currentBackendsMap[ip]
-			if !exists || lo.FromPtr(desired.Drain) != lo.FromPtr(current.Drain) {
-				updateRequired = true
-				break
+		return true
+	}
+	for ip, desired := range desiredBackendsMap {
+		current, exists := currentBackendsMap[ip]
+		if !exists ||
+			lo.FromPtr(desired.Drain) != lo.FromPtr(current.Drain) ||
+			lo.FromPtrOr(desired.Weight, minBackendWeight) != lo.FromPtrOr(current.Weight, minBackendWeight) ||
+			lo.FromPtr(desired.MaxConnections) != lo.FromPtr(current.MaxConnections) {
+			return true
+		}
+	}
+	return false
+}
+
+// endpointPod returns the target Pod of the endpoint, or nil if the endpoint
+// has no Pod target or the Pod is already gone.
+func (m *httpBackendModelImpl) endpointPod(
+	ctx context.Context,
+	sliceNamespace string,
+	endpoint discoveryv1.Endpoint,
+) (*corev1.Pod, error) {
+	podKey, ok := endpointPodKey(sliceNamespace, endpoint)
+	if !ok {
+		return nil, nil //nolint:nilnil // no pod is a valid result
+	}
+
+	var pod corev1.Pod
+	if err := m.k8sClient.Get(ctx, podKey, &pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil //nolint:nilnil // no pod is a valid result
+		}
+		return nil, fmt.Errorf("failed to get endpoint pod %s: %w", podKey, err)
+	}
+	return &pod, nil
+}
+
+// endpointPodKey returns the key of the endpoint target Pod, false if the endpoint has no Pod target.
+func endpointPodKey(sliceNamespace string, endpoint discoveryv1.Endpoint) (client.ObjectKey, bool) {
+	targetRef := endpoint.TargetRef
+	if targetRef == nil || targetRef.Kind != "Pod" || targetRef.Name == "" {
+		return client.ObjectKey{}, false
+	}
+	return client.ObjectKey{
+		Namespace: lo.Ternary(targetRef.Namespace != "", targetRef.Namespace, sliceNamespace),
+		Name:      targetRef.Name,
+	}, true
+}
+
+// endpointPodsBackendAnnotations returns the backend annotation values of the endpoint target Pods.
+// The annotations are not reflected in the EndpointSlices, so they are part of the endpoints fingerprint.
+func (m *httpBackendModelImpl) endpointPodsBackendAnnotations(
+	ctx context.Context,
+	endpointSlices []discoveryv1.EndpointSlice,
+) (map[client.ObjectKey][]string, error) {
+	annotations := make(map[client.ObjectKey][]string)
+	for _, slice := range endpointSlices {
+		for _, endpoint := range slice.Endpoints {
+			pod, err := m.endpointPod(ctx, slice.Namespace, endpoint)
+			if err != nil {
+				return nil, err
+			}
+			if pod == nil {
+				continue
+			}
+			annotations[client.ObjectKeyFromObject(pod)] = []string{
+				pod.Annotations[BackendWeightAnnotation],
+				pod.Annotations[BackendMaxConnectionsAnnotation],
 			}
 		}
 	}
+	return annotations, nil
+}
+
+// resolveBackendTuning reads backend settings from the annotations of the endpoint target Pod.
+// Endpoints without Pod get default settings.
+func (m *httpBackendModelImpl) resolveBackendTuning(
+	ctx context.Context,
+	pod *corev1.Pod,
+) httpBackendTuning {
+	if pod == nil {
+		return httpBackendTuning{}
+	}
+	podKey := client.ObjectKeyFromObject(pod)
 
-	updatedBackends := lo.Values(desiredBackendsMap)
+	parseAnnotation := func(annotation string, minValue, maxValue int) *int {
+		rawValue, ok := pod.Annotations[annotation]
+		if !ok {
+			return nil
+		}
+		value, err := strconv.Atoi(rawValue)
+		if err != nil || value < minValue || value > maxValue {
+			m.logger.WarnContext(ctx, "Ignoring invalid backend annotation",
+				slog.String("pod", podKey.String()),
+				slog.String("annotation", annotation),
+				slog.String("value", rawValue),
+				slog.Int("min", minValue),
+				slog.Int("max", maxValue),
+			)
+			return nil
+		}
+		return &value
+	}
 
-	return identifyBackendsToUpdateResult{
-		updateRequired:  updateRequired,
-		updatedBackends: updatedBackends,
-		drainingCount:   drainingCount,
-	}, nil
+	return httpBackendTuning{
+		weight: parseAnnotation(BackendWeightAnnotation, minBackendWeight, maxBackendWeight),
+		maxConnections: parseAnnotation(
+			BackendMaxConnectionsAnnotation,
+			minBackendMaxConnections,
+			maxBackendMaxConnections,
+		),
+	}
 }
 
 func (m *httpBackendModelImpl) syncRouteBackendRefEndpoints(
@@ -242,10 +633,61 @@ func (m *httpBackendModelImpl) syncRouteBackendRefEndpoints(
 		params.routeNS,
 	)
 	backendSetName := ociBackendSetNameFromBackendObjectRef(
+		ociResourceNamingFromConfig(params.config),
 		params.routeNS,
 		backendRef.BackendObjectReference,
 	)
 
+	return m.updateBackendSetEndpoints(ctx, params, backendRefNamespace, backendSetName)
+}
+
+func (m *httpBackendModelImpl) updateBackendSetEndpoints(
+	ctx context.Context,
+	params syncRouteBackendRefEndpointsParams,
+	backendRefNamespace string,
+	backendSetName string,
+) (err error) {
+	ctx, endSpan := startSpan(ctx, "httpBackendModel.updateBackendSetEndpoints",
+		attribute.String("oci.load_balancer_id", params.config.Spec.LoadBalancerID),
+		attribute.String("oci.backend_set", backendSetName),
+	)
+	defer func() { endSpan(err) }()
+
+	backendRef := params.backendRef
+	backendSetParams := identifyBackendSetBackendsParams{
+		backendRefNamespace: backendRefNamespace,
+		backendRef:          backendRef,
+		ipFamilies:          params.config.Spec.BackendIPFamilies,
+		topology:            params.config.Spec.BackendTopology,
+	}
+
+	// Backends of Service backendRefs in the PodIP mode are derived from the EndpointSlices,
+	// so the backend set is not read from OCI if they did not change since the last sync.
+	fingerprintKey := endpointsFingerprintKey(params.config.Spec.LoadBalancerID, backendSetName)
+	fingerprintable := !isExternalBackendRef(backendRef.BackendObjectReference) &&
+		params.config.Spec.BackendMode != backendModeNodePort &&
+		params.config.Spec.ScaleToZero == nil
+	if fingerprintable && m.endpointsFingerprints.recorded(fingerprintKey) {
+		endpointSlices, listErr := m.listBackendRefEndpointSlices(ctx, backendRefNamespace, backendRef)
+		if listErr != nil {
+			return listErr
+		}
+		podAnnotations, podsErr := m.endpointPodsBackendAnnotations(ctx, endpointSlices)
+		if podsErr != nil {
+			return podsErr
+		}
+		fingerprint := endpointSlicesFingerprint(backendSetParams, endpointSlices, podAnnotations)
+		if m.endpointsFingerprints.matches(fingerprintKey, fingerprint) {
+			m.logger.DebugContext(ctx, "Backend endpoints not changed since last sync, skipping",
+				slog.String("backendSetName", backendSetName),
+				slog.String("routeKind", params.routeKind),
+				slog.String("route", params.routeName),
+			)
+			return nil
+		}
+	}
+	m.endpointsFingerprints.forget(fingerprintKey)
+
 	getResp, err := m.ociClient.GetBackendSet(ctx, loadbalancer.GetBackendSetRequest{
 		LoadBalancerId: &params.config.Spec.LoadBalancerID,
 		BackendSetName: &backendSetName,
@@ -254,32 +696,43 @@ func (m *httpBackendModelImpl) syncRouteBackendRefEndpoints(
 		return fmt.Errorf("failed to get backend set %s: %w", backendSetName, err)
 	}
 	existingBackendSet := getResp.BackendSet
-
-	backendPort := lo.FromPtr(params.backendRef.BackendObjectReference.Port)
-
-	var endpointSlices discoveryv1.EndpointSliceList
-
-	if err = m.k8sClient.List(ctx, &endpointSlices,
-		client.MatchingLabels{
-			discoveryv1.LabelServiceName: string(backendRef.BackendObjectReference.Name),
-		},
-		client.InNamespace(backendRefNamespace),
-	); err != nil {
-		return fmt.Errorf(
-			"failed to list endpoint slices for backend %s: %w",
-			backendRef.BackendObjectReference.Name,
-			err,
-		)
+	backendSetParams.existingBackendSet = existingBackendSet
+
+	identifyBackends := m.identifyServiceBackendSetBackends
+	switch {
+	case isExternalBackendRef(backendRef.BackendObjectReference):
+		identifyBackends = m.identifyExternalBackendSetBackends
+	case params.config.Spec.BackendMode == backendModeNodePort:
+		identifyBackends = m.identifyNodePortBackendSetBackends
 	}
-
-	backendsToUpdate, err := m.self.identifyBackendsToUpdate(ctx, identifyBackendsToUpdateParams{
-		endpointPort:    backendPort,
-		currentBackends: existingBackendSet.Backends,
-		endpointSlices:  endpointSlices.Items,
-	})
+	backendsToUpdate, err := identifyBackends(ctx, backendSetParams)
 	if err != nil {
-		return fmt.Errorf("failed to identify backends to update: %w", err)
+		return err
+	}
+	if !isExternalBackendRef(backendRef.BackendObjectReference) {
+		backendsToUpdate, err = m.identifyScaledToZeroBackends(ctx, identifyScaledToZeroBackendsParams{
+			scaleToZero:      params.config.Spec.ScaleToZero,
+			configNamespace:  params.config.Namespace,
+			identifyBackends: identifyBackends,
+			backendSetParams: backendSetParams,
+			backends:         backendsToUpdate,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for addressType, count := range backendsToUpdate.skippedEndpoints {
+		m.logger.WarnContext(ctx, "Skipping endpoints of not enabled address type",
+			slog.String("backendSetName", backendSetName),
+			slog.String("addressType", string(addressType)),
+			slog.Int("count", count),
+		)
 	}
+	m.endpointMetrics.setSkippedEndpoints(
+		params.config.Spec.LoadBalancerID,
+		backendSetName,
+		backendsToUpdate.skippedEndpoints,
+	)
 
 	if !backendsToUpdate.updateRequired {
 		m.logger.InfoContext(ctx, "Backend set already up-to-date, skipping update",
@@ -289,7 +742,7 @@ func (m *httpBackendModelImpl) syncRouteBackendRefEndpoints(
 			slog.String("backendRefName", string(backendRef.Name)),
 			slog.String("backendRefNamespace", backendRefNamespace),
 		)
-		return nil
+		return m.completeBackendSetEndpointsSync(ctx, params, backendSetName, fingerprintable, backendsToUpdate)
 	}
 
 	m.logger.InfoContext(ctx, "Syncing backend endpoints for backendRef",
@@ -303,6 +756,14 @@ func (m *httpBackendModelImpl) syncRouteBackendRefEndpoints(
 		slog.Int("drainingCount", backendsToUpdate.drainingCount),
 	)
 
+	if err = m.checkBackendsLimit(
+		ctx,
+		params.config.Spec.LoadBalancerID,
+		len(backendsToUpdate.updatedBackends),
+	); err != nil {
+		return fmt.Errorf("failed to update backend set %s: %w", backendSetName, err)
+	}
+
 	ociUpdateResp, err := m.ociClient.UpdateBackendSet(ctx, loadbalancer.UpdateBackendSetRequest{
 		LoadBalancerId:          &params.config.Spec.LoadBalancerID,
 		BackendSetName:          &backendSetName,
@@ -319,9 +780,132 @@ func (m *httpBackendModelImpl) syncRouteBackendRefEndpoints(
 	if err != nil {
 		return fmt.Errorf("failed to wait for backend set %s to be updated: %w", backendSetName, err)
 	}
+	return m.completeBackendSetEndpointsSync(ctx, params, backendSetName, fingerprintable, backendsToUpdate)
+}
+
+// completeBackendSetEndpointsSync updates readiness gates of the backend pods and records
+// the endpoints fingerprint once the backend set is in sync with them. Fingerprints are not
+// recorded while pods wait for readiness gates, since these depend on the backend health.
+func (m *httpBackendModelImpl) completeBackendSetEndpointsSync(
+	ctx context.Context,
+	params syncRouteBackendRefEndpointsParams,
+	backendSetName string,
+	fingerprintable bool,
+	backendsToUpdate identifyBackendsToUpdateResult,
+) error {
+	loadBalancerID := params.config.Spec.LoadBalancerID
+	if err := m.updatePodReadinessGates(ctx, loadBalancerID, backendSetName, backendsToUpdate); err != nil {
+		return err
+	}
+	if fingerprintable && len(backendsToUpdate.gatedPods) == 0 && backendsToUpdate.startingGatedPods == 0 {
+		m.endpointsFingerprints.record(
+			endpointsFingerprintKey(loadBalancerID, backendSetName),
+			backendsToUpdate.endpointsFingerprint,
+		)
+	}
 	return nil
 }
 
+// identifyServiceBackendSetBackends resolves the backends of the Service backendRef
+// from its EndpointSlices.
+func (m *httpBackendModelImpl) identifyServiceBackendSetBackends(
+	ctx context.Context,
+	params identifyBackendSetBackendsParams,
+) (identifyBackendsToUpdateResult, error) {
+	backendRef, backendRefNamespace := params.backendRef, params.backendRefNamespace
+	backendPort := lo.FromPtr(backendRef.BackendObjectReference.Port)
+
+	endpointSlices, err := m.listBackendRefEndpointSlices(ctx, backendRefNamespace, backendRef)
+	if err != nil {
+		return identifyBackendsToUpdateResult{}, err
+	}
+
+	servicePort, err := m.backendRefServicePort(ctx, backendRefNamespace, backendRef)
+	if err != nil {
+		return identifyBackendsToUpdateResult{}, err
+	}
+
+	backendsToUpdate, err := m.self.identifyBackendsToUpdate(ctx, identifyBackendsToUpdateParams{
+		endpointPort:    backendPort,
+		servicePort:     servicePort,
+		currentBackends: params.existingBackendSet.Backends,
+		endpointSlices:  endpointSlices,
+		ipFamilies:      params.ipFamilies,
+		topology:        params.topology,
+	})
+	if err != nil {
+		return identifyBackendsToUpdateResult{}, fmt.Errorf("failed to identify backends to update: %w", err)
+	}
+	podAnnotations, err := m.endpointPodsBackendAnnotations(ctx, endpointSlices)
+	if err != nil {
+		return identifyBackendsToUpdateResult{}, err
+	}
+	backendsToUpdate.endpointsFingerprint = endpointSlicesFingerprint(params, endpointSlices, podAnnotations)
+	return backendsToUpdate, nil
+}
+
+// listBackendRefEndpointSlices returns the EndpointSlices of the Service referenced by the backendRef.
+func (m *httpBackendModelImpl) listBackendRefEndpointSlices(
+	ctx context.Context,
+	backendRefNamespace string,
+	backendRef gatewayv1.BackendRef,
+) ([]discoveryv1.EndpointSlice, error) {
+	var endpointSlices discoveryv1.EndpointSliceList
+	if err := m.k8sClient.List(ctx, &endpointSlices,
+		client.MatchingLabels{
+			discoveryv1.LabelServiceName: string(backendRef.BackendObjectReference.Name),
+		},
+		client.InNamespace(backendRefNamespace),
+	); err != nil {
+		return nil, fmt.Errorf(
+			"failed to list endpoint slices for backend %s: %w",
+			backendRef.BackendObjectReference.Name,
+			err,
+		)
+	}
+	return endpointSlices.Items, nil
+}
+
+// backendRefServicePort returns the port of the Service referenced by the backendRef.
+func (m *httpBackendModelImpl) backendRefServicePort(
+	ctx context.Context,
+	backendRefNamespace string,
+	backendRef gatewayv1.BackendRef,
+) (*corev1.ServicePort, error) {
+	var service corev1.Service
+	if err := m.k8sClient.Get(ctx, client.ObjectKey{
+		Namespace: backendRefNamespace,
+		Name:      string(backendRef.BackendObjectReference.Name),
+	}, &service); err != nil {
+		return nil, fmt.Errorf(
+			"failed to get service for backend %s: %w",
+			backendRef.BackendObjectReference.Name,
+			err,
+		)
+	}
+	return l4ServicePortForBackendRef(service, backendRef)
+}
+
+// identifyExternalBackendSetBackends resolves the backends of the OkeExternalBackend backendRef
+// from its endpoints.
+func (m *httpBackendModelImpl) identifyExternalBackendSetBackends(
+	ctx context.Context,
+	params identifyBackendSetBackendsParams,
+) (identifyBackendsToUpdateResult, error) {
+	fullName := client.ObjectKey{
+		Namespace: params.backendRefNamespace,
+		Name:      string(params.backendRef.BackendObjectReference.Name),
+	}
+	externalBackend, found, err := getExternalBackend(ctx, m.k8sClient, fullName)
+	if err != nil {
+		return identifyBackendsToUpdateResult{}, err
+	}
+	if !found {
+		return identifyBackendsToUpdateResult{}, fmt.Errorf("external backend %s not found", fullName.String())
+	}
+	return identifyExternalBackendsToUpdate(params.existingBackendSet.Backends, externalBackend), nil
+}
+
 func makeUpdateOciBackendSetDetails(
 	existingBackendSet loadbalancer.BackendSet,
 	newBackends []loadbalancer.BackendDetails,
@@ -375,6 +959,11 @@ type httpBackendModelDeps struct {
 	K8sClient             k8sClient
 	OciLoadBalancerClient ociLoadBalancerClient
 	WorkRequestsWatcher   workRequestsWatcher
+	EndpointMetrics       *backendEndpointMetrics `optional:"true"`
+	EndpointsFingerprints *endpointsFingerprints  `optional:"true"`
+
+	// Maximum number of backend sets of a route synced at the same time, values below 1 sync them serially.
+	BackendSyncConcurrency int `name:"config.reconcile.backend-sync-concurrency"`
 
 	// Used to allow mocking own methods in tests
 	self httpBackendModel
@@ -383,11 +972,14 @@ type httpBackendModelDeps struct {
 // newHTTPBackendModel creates a new HTTPBackendModel.
 func newHTTPBackendModel(deps httpBackendModelDeps) *httpBackendModelImpl {
 	model := &httpBackendModelImpl{
-		logger:              deps.RootLogger.WithGroup("http-backend-model"),
-		k8sClient:           deps.K8sClient,
-		ociClient:           deps.OciLoadBalancerClient,
-		workRequestsWatcher: deps.WorkRequestsWatcher,
-		self:                deps.self,
+		logger:                 deps.RootLogger.WithGroup("http-backend-model"),
+		k8sClient:              deps.K8sClient,
+		ociClient:              deps.OciLoadBalancerClient,
+		workRequestsWatcher:    deps.WorkRequestsWatcher,
+		endpointMetrics:        deps.EndpointMetrics,
+		backendSyncConcurrency: max(deps.BackendSyncConcurrency, 1),
+		endpointsFingerprints:  deps.EndpointsFingerprints,
+		self:                   deps.self,
 	}
 	model.self = lo.Ternary[httpBackendModel](model.self != nil, model.self, model)
 	return model
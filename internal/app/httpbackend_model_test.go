@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"math/rand/v2"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/jaswdr/faker/v2"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
@@ -13,12 +15,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
 	"github.com/gemyago/oke-gateway-api/internal/types"
 )
 
@@ -33,6 +39,32 @@ func TestHTTPBackendModel(t *testing.T) {
 		}
 	}
 
+	expectBackendService := func(
+		t *testing.T,
+		deps httpBackendModelDeps,
+		backendRef gatewayv1.BackendRef,
+		namespace string,
+	) *corev1.ServicePort {
+		servicePort := corev1.ServicePort{
+			Name:       faker.New().Lorem().Word(),
+			Port:       *backendRef.Port,
+			TargetPort: intstr.FromInt32(*backendRef.Port),
+		}
+		mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+		mockK8sClient.EXPECT().Get(t.Context(), client.ObjectKey{
+			Namespace: namespace,
+			Name:      string(backendRef.Name),
+		}, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+				service, ok := obj.(*corev1.Service)
+				require.True(t, ok, "expected a Service")
+				service.Spec.Ports = []corev1.ServicePort{servicePort}
+				return nil
+			}).
+			Once()
+		return &servicePort
+	}
+
 	t.Run("syncRouteBackendRefsEndpoints", func(t *testing.T) {
 		t.Run("sync all rules", func(t *testing.T) {
 			deps := newMockDeps(t)
@@ -242,6 +274,144 @@ func TestHTTPBackendModel(t *testing.T) {
 			require.Error(t, err)
 			require.ErrorIs(t, err, expectedErr)
 		})
+
+		t.Run("continue sync when pod readiness is pending", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+
+			rules := []gatewayv1.HTTPRouteRule{
+				makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(
+					makeRandomBackendRef(),
+				)),
+				makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(
+					makeRandomBackendRef(),
+				)),
+			}
+
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(rules...),
+			)
+
+			config := makeRandomGatewayConfig()
+
+			mockSelf, _ := deps.self.(*MockhttpBackendModel)
+
+			// First rule has pods waiting for the readiness gate
+			mockSelf.EXPECT().syncRouteBackendRefEndpoints(
+				t.Context(),
+				syncRouteBackendRefEndpointsParams{
+					routeKind:  "HTTPRoute",
+					routeName:  httpRoute.Name,
+					routeNS:    httpRoute.Namespace,
+					config:     config,
+					backendRef: rules[0].BackendRefs[0].BackendRef,
+				},
+			).Return(fmt.Errorf("1 pods: %w", errPodReadinessPending)).Once()
+
+			// Second rule is still synced
+			mockSelf.EXPECT().syncRouteBackendRefEndpoints(
+				t.Context(),
+				syncRouteBackendRefEndpointsParams{
+					routeKind:  "HTTPRoute",
+					routeName:  httpRoute.Name,
+					routeNS:    httpRoute.Namespace,
+					config:     config,
+					backendRef: rules[1].BackendRefs[0].BackendRef,
+				},
+			).Return(nil).Once()
+
+			err := model.syncRouteEndpoints(t.Context(), syncRouteEndpointsParams{
+				httpRoute: httpRoute,
+				config:    config,
+			})
+
+			require.ErrorIs(t, err, errPodReadinessPending)
+		})
+
+		t.Run("sync remaining backend refs and report all errors", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+
+			rules := []gatewayv1.HTTPRouteRule{
+				makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(
+					makeRandomBackendRef(),
+					makeRandomBackendRef(),
+					makeRandomBackendRef(),
+				)),
+			}
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(rules...),
+			)
+			config := makeRandomGatewayConfig()
+
+			mockSelf, _ := deps.self.(*MockhttpBackendModel)
+			firstErr := errors.New(faker.New().Lorem().Sentence(10))
+			lastErr := errors.New(faker.New().Lorem().Sentence(10))
+			for index, wantErr := range []error{firstErr, nil, lastErr} {
+				mockSelf.EXPECT().syncRouteBackendRefEndpoints(
+					t.Context(),
+					syncRouteBackendRefEndpointsParams{
+						routeKind:  "HTTPRoute",
+						routeName:  httpRoute.Name,
+						routeNS:    httpRoute.Namespace,
+						config:     config,
+						backendRef: rules[0].BackendRefs[index].BackendRef,
+					},
+				).Return(wantErr).Once()
+			}
+
+			err := model.syncRouteEndpoints(t.Context(), syncRouteEndpointsParams{
+				httpRoute: httpRoute,
+				config:    config,
+			})
+
+			require.ErrorIs(t, err, firstErr)
+			require.ErrorIs(t, err, lastErr)
+			assert.ErrorContains(t, err, "backend ref 0")
+			assert.ErrorContains(t, err, "backend ref 2")
+		})
+
+		t.Run("sync backend refs concurrently", func(t *testing.T) {
+			deps := newMockDeps(t)
+			deps.BackendSyncConcurrency = 2
+			model := newHTTPBackendModel(deps)
+
+			rules := []gatewayv1.HTTPRouteRule{
+				makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(
+					makeRandomBackendRef(),
+					makeRandomBackendRef(),
+				)),
+			}
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(rules...),
+			)
+			config := makeRandomGatewayConfig()
+
+			// Each sync waits for the other one to start, so serial syncs time out
+			started := make(chan struct{}, len(rules[0].BackendRefs))
+			mockSelf, _ := deps.self.(*MockhttpBackendModel)
+			mockSelf.EXPECT().syncRouteBackendRefEndpoints(t.Context(), mock.Anything).
+				RunAndReturn(func(_ context.Context, _ syncRouteBackendRefEndpointsParams) error {
+					started <- struct{}{}
+					deadline := time.After(5 * time.Second)
+					for len(started) < cap(started) {
+						select {
+						case <-deadline:
+							return errors.New("backend refs are not synced concurrently")
+						case <-time.After(time.Millisecond):
+						}
+					}
+					return nil
+				}).
+				Times(len(rules[0].BackendRefs))
+
+			err := model.syncRouteEndpoints(t.Context(), syncRouteEndpointsParams{
+				httpRoute: httpRoute,
+				config:    config,
+			})
+
+			require.NoError(t, err)
+		})
 	})
 
 	t.Run("syncGRPCRouteEndpoints", func(t *testing.T) {
@@ -282,6 +452,226 @@ func TestHTTPBackendModel(t *testing.T) {
 		})
 	})
 
+	t.Run("routeBackendsHealth", func(t *testing.T) {
+		t.Run("aggregates health of distinct backend sets", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+
+			sharedRef := makeRandomBackendRef()
+			missingRef := makeRandomBackendRef()
+			httpRoute := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(
+				makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(sharedRef, missingRef)),
+				makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(sharedRef)),
+			))
+			config := makeRandomGatewayConfig()
+
+			mockOciClient, _ := deps.OciLoadBalancerClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().GetBackendSetHealth(t.Context(), loadbalancer.GetBackendSetHealthRequest{
+				LoadBalancerId: &config.Spec.LoadBalancerID,
+				BackendSetName: new(ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, sharedRef)),
+			}).Return(loadbalancer.GetBackendSetHealthResponse{
+				BackendSetHealth: loadbalancer.BackendSetHealth{
+					TotalBackendCount:         new(4),
+					WarningStateBackendNames:  []string{"10.0.0.1:8080"},
+					CriticalStateBackendNames: []string{"10.0.0.2:8080"},
+				},
+			}, nil).Once()
+			mockOciClient.EXPECT().GetBackendSetHealth(t.Context(), loadbalancer.GetBackendSetHealthRequest{
+				LoadBalancerId: &config.Spec.LoadBalancerID,
+				BackendSetName: new(ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, missingRef)),
+			}).Return(
+				loadbalancer.GetBackendSetHealthResponse{},
+				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(404)),
+			).Once()
+
+			health, err := model.routeBackendsHealth(t.Context(), routeBackendsHealthParams{
+				httpRoute: httpRoute,
+				config:    config,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, backendsHealthSummary{total: 4, warning: 1, critical: 1}, health)
+		})
+
+		t.Run("returns health lookup errors", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+
+			httpRoute := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(
+				makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(makeRandomBackendRef())),
+			))
+			wantErr := errors.New(faker.New().Lorem().Sentence(5))
+
+			mockOciClient, _ := deps.OciLoadBalancerClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().GetBackendSetHealth(t.Context(), mock.Anything).
+				Return(loadbalancer.GetBackendSetHealthResponse{}, wantErr).Once()
+
+			_, err := model.routeBackendsHealth(t.Context(), routeBackendsHealthParams{
+				httpRoute: httpRoute,
+				config:    makeRandomGatewayConfig(),
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("syncDefaultBackendEndpoints", func(t *testing.T) {
+		t.Run("syncs default backend service endpoints", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+
+			gateway := newRandomGateway()
+			config := makeRandomGatewayConfig()
+			config.Namespace = gateway.Namespace
+			config.Spec.DefaultBackend = &types.GatewayConfigDefaultBackend{
+				ServiceName: faker.New().Internet().Slug(),
+				Port:        rand.Int32N(65534) + 1,
+			}
+			config.Spec.BackendIPFamilies = []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}
+			backendRef := gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{
+					Name:      gatewayv1.ObjectName(config.Spec.DefaultBackend.ServiceName),
+					Namespace: new(gatewayv1.Namespace(config.Namespace)),
+					Port:      new(config.Spec.DefaultBackend.Port),
+				},
+			}
+			backendSetName := ociDefaultBackendSetName(ociResourceNaming{}, gateway, "")
+			endpointSlice := makeRandomEndpointSlice()
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().List(
+				t.Context(),
+				mock.Anything,
+				client.MatchingLabels{
+					discoveryv1.LabelServiceName: config.Spec.DefaultBackend.ServiceName,
+				},
+				client.InNamespace(config.Namespace),
+			).RunAndReturn(func(_ context.Context, ol client.ObjectList, _ ...client.ListOption) error {
+				epSliceList, ok := ol.(*discoveryv1.EndpointSliceList)
+				require.True(t, ok, "expected an EndpointSliceList")
+				epSliceList.Items = append(epSliceList.Items, endpointSlice)
+				return nil
+			}).Once()
+			servicePort := expectBackendService(t, deps, backendRef, config.Namespace)
+
+			sampleBackendSet := makeRandomOCIBackendSet(randomOCIBackendSetWithNameOpt(backendSetName))
+			mockOciLoadBalancerClient, _ := deps.OciLoadBalancerClient.(*MockociLoadBalancerClient)
+			mockOciLoadBalancerClient.EXPECT().GetBackendSet(
+				t.Context(),
+				loadbalancer.GetBackendSetRequest{
+					LoadBalancerId: &config.Spec.LoadBalancerID,
+					BackendSetName: &backendSetName,
+				},
+			).Return(loadbalancer.GetBackendSetResponse{BackendSet: sampleBackendSet}, nil).Once()
+
+			wantUpdatedBackends := []loadbalancer.BackendDetails{
+				{
+					IpAddress: new(faker.New().Internet().Ipv4()),
+					Port:      new(int(config.Spec.DefaultBackend.Port)),
+					Drain:     new(false),
+				},
+			}
+			mockSelf, _ := deps.self.(*MockhttpBackendModel)
+			mockSelf.EXPECT().identifyBackendsToUpdate(
+				t.Context(),
+				identifyBackendsToUpdateParams{
+					endpointPort:    config.Spec.DefaultBackend.Port,
+					servicePort:     servicePort,
+					currentBackends: sampleBackendSet.Backends,
+					endpointSlices:  []discoveryv1.EndpointSlice{endpointSlice},
+					ipFamilies:      config.Spec.BackendIPFamilies,
+				},
+			).Return(identifyBackendsToUpdateResult{
+				updateRequired:  true,
+				updatedBackends: wantUpdatedBackends,
+			}, nil).Once()
+
+			wantOperationID := faker.New().UUID().V4()
+			mockOciLoadBalancerClient.EXPECT().UpdateBackendSet(
+				t.Context(),
+				mock.MatchedBy(func(req loadbalancer.UpdateBackendSetRequest) bool {
+					return *req.BackendSetName == backendSetName &&
+						assert.ElementsMatch(t, wantUpdatedBackends, req.Backends)
+				}),
+			).Return(loadbalancer.UpdateBackendSetResponse{
+				OpcWorkRequestId: &wantOperationID,
+			}, nil).Once()
+
+			mockWorkRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			mockWorkRequestsWatcher.EXPECT().WaitFor(t.Context(), wantOperationID).Return(nil).Once()
+
+			err := model.syncDefaultBackendEndpoints(t.Context(), syncDefaultBackendEndpointsParams{
+				gateway: gateway,
+				config:  config,
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("removes backends when default backend is not configured", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+
+			gateway := newRandomGateway()
+			config := makeRandomGatewayConfig()
+			backendSetName := ociDefaultBackendSetName(ociResourceNaming{}, gateway, "")
+			sampleBackendSet := makeRandomOCIBackendSet(
+				randomOCIBackendSetWithNameOpt(backendSetName),
+				randomOCIBackendSetWithBackendsOpt(makeFewRandomOCIBackends()),
+			)
+
+			mockOciLoadBalancerClient, _ := deps.OciLoadBalancerClient.(*MockociLoadBalancerClient)
+			mockOciLoadBalancerClient.EXPECT().GetBackendSet(
+				t.Context(),
+				loadbalancer.GetBackendSetRequest{
+					LoadBalancerId: &config.Spec.LoadBalancerID,
+					BackendSetName: &backendSetName,
+				},
+			).Return(loadbalancer.GetBackendSetResponse{BackendSet: sampleBackendSet}, nil).Once()
+
+			wantOperationID := faker.New().UUID().V4()
+			mockOciLoadBalancerClient.EXPECT().UpdateBackendSet(
+				t.Context(),
+				mock.MatchedBy(func(req loadbalancer.UpdateBackendSetRequest) bool {
+					return *req.BackendSetName == backendSetName && len(req.Backends) == 0
+				}),
+			).Return(loadbalancer.UpdateBackendSetResponse{
+				OpcWorkRequestId: &wantOperationID,
+			}, nil).Once()
+
+			mockWorkRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			mockWorkRequestsWatcher.EXPECT().WaitFor(t.Context(), wantOperationID).Return(nil).Once()
+
+			err := model.syncDefaultBackendEndpoints(t.Context(), syncDefaultBackendEndpointsParams{
+				gateway: gateway,
+				config:  config,
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("skips empty backend set when default backend is not configured", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+
+			gateway := newRandomGateway()
+			config := makeRandomGatewayConfig()
+
+			mockOciLoadBalancerClient, _ := deps.OciLoadBalancerClient.(*MockociLoadBalancerClient)
+			mockOciLoadBalancerClient.EXPECT().GetBackendSet(t.Context(), mock.Anything).
+				Return(loadbalancer.GetBackendSetResponse{
+					BackendSet: makeRandomOCIBackendSet(randomOCIBackendSetWithBackendsOpt(nil)),
+				}, nil).Once()
+
+			err := model.syncDefaultBackendEndpoints(t.Context(), syncDefaultBackendEndpointsParams{
+				gateway: gateway,
+				config:  config,
+			})
+
+			require.NoError(t, err)
+		})
+	})
+
 	t.Run("syncRouteBackendRefEndpoints", func(t *testing.T) {
 		t.Run("update backend set", func(t *testing.T) {
 			deps := newMockDeps(t)
@@ -311,7 +701,7 @@ func TestHTTPBackendModel(t *testing.T) {
 			}).Once()
 
 			wantUpdatedBackends := makeFewRandomOCIBackendDetails()
-			backendSetName := ociBackendSetNameFromBackendRef(httpRoute, backendRef)
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
 
 			// Create a sample existing BackendSet using the fixture
 			currentBackends := makeFewRandomOCIBackends()
@@ -321,12 +711,15 @@ func TestHTTPBackendModel(t *testing.T) {
 			)
 
 			backendRefPort := *backendRef.BackendObjectReference.Port
+			servicePort := expectBackendService(t, deps, backendRef.BackendRef,
+				string(lo.FromPtr(backendRef.BackendObjectReference.Namespace)))
 
 			mockSelf, _ := deps.self.(*MockhttpBackendModel)
 			mockSelf.EXPECT().identifyBackendsToUpdate(
 				t.Context(),
 				mock.MatchedBy(func(params identifyBackendsToUpdateParams) bool {
 					return assert.Equal(t, backendRefPort, params.endpointPort) &&
+						assert.Equal(t, servicePort, params.servicePort) &&
 						assert.ElementsMatch(t, currentBackends, params.currentBackends) &&
 						assert.ElementsMatch(t, []discoveryv1.EndpointSlice{endpointSlice}, params.endpointSlices)
 				}),
@@ -400,6 +793,153 @@ func TestHTTPBackendModel(t *testing.T) {
 			require.NoError(t, err)
 		})
 
+		t.Run("skip backend set with unchanged endpoints", func(t *testing.T) {
+			deps := newMockDeps(t)
+			deps.EndpointsFingerprints = newEndpointsFingerprints()
+			model := newHTTPBackendModel(deps)
+
+			backendRef := makeRandomBackendRef()
+			httpRoute := makeRandomHTTPRoute()
+			config := makeRandomGatewayConfig()
+			endpointSlice := makeRandomEndpointSlice()
+			endpointSlice.Endpoints = makeFewRandomEndpoints(2)
+			backendRefNamespace := string(lo.FromPtr(backendRef.BackendObjectReference.Namespace))
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
+
+			deps.EndpointsFingerprints.record(
+				endpointsFingerprintKey(config.Spec.LoadBalancerID, backendSetName),
+				endpointSlicesFingerprint(identifyBackendSetBackendsParams{
+					backendRefNamespace: backendRefNamespace,
+					backendRef:          backendRef.BackendRef,
+				}, []discoveryv1.EndpointSlice{endpointSlice}, nil),
+			)
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().List(
+				t.Context(),
+				mock.Anything,
+				client.MatchingLabels{
+					discoveryv1.LabelServiceName: string(backendRef.BackendObjectReference.Name),
+				},
+				client.InNamespace(backendRefNamespace),
+			).RunAndReturn(func(_ context.Context, ol client.ObjectList, _ ...client.ListOption) error {
+				epSliceList, ok := ol.(*discoveryv1.EndpointSliceList)
+				require.True(t, ok, "expected an EndpointSliceList")
+				epSliceList.Items = []discoveryv1.EndpointSlice{*endpointSlice.DeepCopy()}
+				return nil
+			}).Once()
+
+			err := model.syncRouteBackendRefEndpoints(t.Context(), syncRouteBackendRefEndpointsParams{
+				routeKind:  "HTTPRoute",
+				routeName:  httpRoute.Name,
+				routeNS:    httpRoute.Namespace,
+				config:     config,
+				backendRef: backendRef.BackendRef,
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("sync backend set with changed endpoints", func(t *testing.T) {
+			deps := newMockDeps(t)
+			deps.EndpointsFingerprints = newEndpointsFingerprints()
+			model := newHTTPBackendModel(deps)
+
+			backendRef := makeRandomBackendRef()
+			httpRoute := makeRandomHTTPRoute()
+			config := makeRandomGatewayConfig()
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
+			fingerprintKey := endpointsFingerprintKey(config.Spec.LoadBalancerID, backendSetName)
+			deps.EndpointsFingerprints.record(fingerprintKey, faker.New().UUID().V4())
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().List(t.Context(), mock.Anything, mock.Anything, mock.Anything).
+				Return(nil).
+				Once()
+			wantErr := errors.New(faker.New().Lorem().Sentence(5))
+			mockOciClient, _ := deps.OciLoadBalancerClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().GetBackendSet(
+				t.Context(),
+				loadbalancer.GetBackendSetRequest{
+					LoadBalancerId: &config.Spec.LoadBalancerID,
+					BackendSetName: &backendSetName,
+				},
+			).Return(loadbalancer.GetBackendSetResponse{}, wantErr).Once()
+
+			err := model.syncRouteBackendRefEndpoints(t.Context(), syncRouteBackendRefEndpointsParams{
+				routeKind:  "HTTPRoute",
+				routeName:  httpRoute.Name,
+				routeNS:    httpRoute.Namespace,
+				config:     config,
+				backendRef: backendRef.BackendRef,
+			})
+
+			require.ErrorIs(t, err, wantErr)
+			assert.False(t, deps.EndpointsFingerprints.recorded(fingerprintKey))
+		})
+
+		t.Run("update external backend set", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+
+			backendRef := makeRandomBackendRef(randomBackendRefWithExternalBackendOpt())
+			httpRoute := makeRandomHTTPRoute()
+			config := makeRandomGatewayConfig()
+			externalBackend := makeRandomExternalBackend(httpRoute.Namespace, string(backendRef.Name))
+			setupClientGet(t, deps.K8sClient, client.ObjectKey{
+				Namespace: httpRoute.Namespace,
+				Name:      externalBackend.Name,
+			}, externalBackend)
+
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
+			sampleBackendSet := makeRandomOCIBackendSet(
+				randomOCIBackendSetWithNameOpt(backendSetName),
+				randomOCIBackendSetWithBackendsOpt(makeFewRandomOCIBackends()),
+			)
+
+			mockOciLoadBalancerClient, _ := deps.OciLoadBalancerClient.(*MockociLoadBalancerClient)
+			mockOciLoadBalancerClient.EXPECT().GetBackendSet(
+				t.Context(),
+				loadbalancer.GetBackendSetRequest{
+					LoadBalancerId: &config.Spec.LoadBalancerID,
+					BackendSetName: &backendSetName,
+				},
+			).Return(loadbalancer.GetBackendSetResponse{BackendSet: sampleBackendSet}, nil).Once()
+
+			wantBackends := lo.Map(
+				externalBackend.Spec.Endpoints,
+				func(endpoint types.OkeExternalBackendEndpoint, _ int) loadbalancer.BackendDetails {
+					return loadbalancer.BackendDetails{
+						IpAddress: new(endpoint.IP),
+						Port:      new(int(endpoint.Port)),
+					}
+				},
+			)
+			wantOperationID := faker.New().UUID().V4()
+			mockOciLoadBalancerClient.EXPECT().UpdateBackendSet(
+				t.Context(),
+				mock.MatchedBy(func(req loadbalancer.UpdateBackendSetRequest) bool {
+					return assert.Equal(t, backendSetName, *req.BackendSetName) &&
+						assert.ElementsMatch(t, wantBackends, req.Backends)
+				}),
+			).Return(loadbalancer.UpdateBackendSetResponse{
+				OpcWorkRequestId: &wantOperationID,
+			}, nil).Once()
+
+			mockWorkRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			mockWorkRequestsWatcher.EXPECT().WaitFor(t.Context(), wantOperationID).Return(nil).Once()
+
+			err := model.syncRouteBackendRefEndpoints(t.Context(), syncRouteBackendRefEndpointsParams{
+				routeKind:  "HTTPRoute",
+				routeName:  httpRoute.Name,
+				routeNS:    httpRoute.Namespace,
+				config:     config,
+				backendRef: backendRef.BackendRef,
+			})
+
+			require.NoError(t, err)
+		})
+
 		t.Run("update backend without explicit namespace", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newHTTPBackendModel(deps)
@@ -430,7 +970,7 @@ func TestHTTPBackendModel(t *testing.T) {
 			}).Once()
 
 			wantUpdatedBackends := makeFewRandomOCIBackendDetails()
-			backendSetName := ociBackendSetNameFromBackendRef(httpRoute, backendRef)
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
 
 			// Create a sample existing BackendSet using the fixture
 			currentBackends := makeFewRandomOCIBackends()
@@ -439,6 +979,8 @@ func TestHTTPBackendModel(t *testing.T) {
 				randomOCIBackendSetWithBackendsOpt(currentBackends),
 			)
 
+			expectBackendService(t, deps, backendRef.BackendRef, httpRoute.Namespace)
+
 			mockSelf, _ := deps.self.(*MockhttpBackendModel)
 			mockSelf.EXPECT().identifyBackendsToUpdate(
 				t.Context(),
@@ -504,7 +1046,7 @@ func TestHTTPBackendModel(t *testing.T) {
 				return nil
 			}).Once()
 
-			backendSetName := ociBackendSetNameFromBackendRef(httpRoute, backendRef)
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
 
 			currentBackends := makeFewRandomOCIBackends()
 			sampleBackendSet := makeRandomOCIBackendSet(
@@ -522,12 +1064,15 @@ func TestHTTPBackendModel(t *testing.T) {
 			).Return(loadbalancer.GetBackendSetResponse{BackendSet: sampleBackendSet}, nil).Once()
 
 			backendRefPort := *backendRef.BackendObjectReference.Port
+			servicePort := expectBackendService(t, deps, backendRef.BackendRef,
+				string(lo.FromPtr(backendRef.BackendObjectReference.Namespace)))
 
 			mockSelf, _ := deps.self.(*MockhttpBackendModel)
 			mockSelf.EXPECT().identifyBackendsToUpdate(
 				t.Context(),
 				identifyBackendsToUpdateParams{
 					endpointPort:    backendRefPort,
+					servicePort:     servicePort,
 					currentBackends: currentBackends,
 					endpointSlices:  []discoveryv1.EndpointSlice{endpointSlice},
 				},
@@ -564,7 +1109,7 @@ func TestHTTPBackendModel(t *testing.T) {
 					_ loadbalancer.BackendSet,
 					wantErr error,
 				) {
-					backendSetName := ociBackendSetNameFromBackendRef(httpRoute, backendRef)
+					backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
 					mockOciClient, _ := deps.OciLoadBalancerClient.(*MockociLoadBalancerClient)
 					mockOciClient.EXPECT().GetBackendSet(
 						t.Context(),
@@ -595,6 +1140,21 @@ func TestHTTPBackendModel(t *testing.T) {
 						client.InNamespace(string(lo.FromPtr(backendRef.BackendObjectReference.Namespace))),
 					).Return(wantErr)
 				},
+				"get service": func(
+					deps httpBackendModelDeps,
+					_ types.GatewayConfig,
+					_ gatewayv1.HTTPRoute,
+					backendRef gatewayv1.HTTPBackendRef,
+					backendSet loadbalancer.BackendSet,
+					wantErr error,
+				) {
+					mockOciClient, _ := deps.OciLoadBalancerClient.(*MockociLoadBalancerClient)
+					mockOciClient.EXPECT().GetBackendSet(t.Context(), mock.Anything).
+						Return(loadbalancer.GetBackendSetResponse{BackendSet: backendSet}, nil)
+					mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+					mockK8sClient.EXPECT().List(t.Context(), mock.Anything, mock.Anything, mock.Anything).Return(nil)
+					mockK8sClient.EXPECT().Get(t.Context(), mock.Anything, mock.Anything).Return(wantErr)
+				},
 				"identify updates": func(
 					deps httpBackendModelDeps,
 					_ types.GatewayConfig,
@@ -615,6 +1175,8 @@ func TestHTTPBackendModel(t *testing.T) {
 						},
 						client.InNamespace(string(lo.FromPtr(backendRef.BackendObjectReference.Namespace))),
 					).Return(nil)
+					expectBackendService(t, deps, backendRef.BackendRef,
+						string(lo.FromPtr(backendRef.BackendObjectReference.Namespace)))
 					mockSelf, _ := deps.self.(*MockhttpBackendModel)
 					mockSelf.EXPECT().identifyBackendsToUpdate(t.Context(), mock.Anything).
 						Return(identifyBackendsToUpdateResult{}, wantErr)
@@ -639,6 +1201,8 @@ func TestHTTPBackendModel(t *testing.T) {
 						},
 						client.InNamespace(string(lo.FromPtr(backendRef.BackendObjectReference.Namespace))),
 					).Return(nil)
+					expectBackendService(t, deps, backendRef.BackendRef,
+						string(lo.FromPtr(backendRef.BackendObjectReference.Namespace)))
 					mockSelf, _ := deps.self.(*MockhttpBackendModel)
 					mockSelf.EXPECT().identifyBackendsToUpdate(t.Context(), mock.Anything).
 						Return(identifyBackendsToUpdateResult{
@@ -668,6 +1232,8 @@ func TestHTTPBackendModel(t *testing.T) {
 						},
 						client.InNamespace(string(lo.FromPtr(backendRef.BackendObjectReference.Namespace))),
 					).Return(nil)
+					expectBackendService(t, deps, backendRef.BackendRef,
+						string(lo.FromPtr(backendRef.BackendObjectReference.Namespace)))
 					mockSelf, _ := deps.self.(*MockhttpBackendModel)
 					mockSelf.EXPECT().identifyBackendsToUpdate(t.Context(), mock.Anything).
 						Return(identifyBackendsToUpdateResult{
@@ -697,6 +1263,8 @@ func TestHTTPBackendModel(t *testing.T) {
 						},
 						client.InNamespace(string(lo.FromPtr(backendRef.BackendObjectReference.Namespace))),
 					).Return(nil)
+					expectBackendService(t, deps, backendRef.BackendRef,
+						string(lo.FromPtr(backendRef.BackendObjectReference.Namespace)))
 					mockSelf, _ := deps.self.(*MockhttpBackendModel)
 					mockSelf.EXPECT().identifyBackendsToUpdate(t.Context(), mock.Anything).
 						Return(identifyBackendsToUpdateResult{
@@ -716,9 +1284,8 @@ func TestHTTPBackendModel(t *testing.T) {
 					backendRef := makeRandomBackendRef()
 					httpRoute := makeRandomHTTPRoute()
 					config := makeRandomGatewayConfig()
-					backendSet := makeRandomOCIBackendSet(
-						randomOCIBackendSetWithNameOpt(ociBackendSetNameFromBackendRef(httpRoute, backendRef)),
-					)
+					backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
+					backendSet := makeRandomOCIBackendSet(randomOCIBackendSetWithNameOpt(backendSetName))
 					wantErr := errors.New(faker.New().Lorem().Sentence(10))
 					setup(deps, config, httpRoute, backendRef, backendSet, wantErr)
 
@@ -757,12 +1324,14 @@ func TestHTTPBackendModel(t *testing.T) {
 
 			// Distribute endpoints into multiple slices and lists
 			slice1 := discoveryv1.EndpointSlice{
-				ObjectMeta: metav1.ObjectMeta{Name: faker.New().UUID().V4()},
-				Endpoints:  endpoints[:numEndpoints/2], // First half
+				AddressType: discoveryv1.AddressTypeIPv4,
+				ObjectMeta:  metav1.ObjectMeta{Name: faker.New().UUID().V4()},
+				Endpoints:   endpoints[:numEndpoints/2], // First half
 			}
 			slice2 := discoveryv1.EndpointSlice{
-				ObjectMeta: metav1.ObjectMeta{Name: faker.New().UUID().V4()},
-				Endpoints:  endpoints[numEndpoints/2:], // Second half
+				AddressType: discoveryv1.AddressTypeIPv4,
+				ObjectMeta:  metav1.ObjectMeta{Name: faker.New().UUID().V4()},
+				Endpoints:   endpoints[numEndpoints/2:], // Second half
 			}
 
 			endpointSlices := []discoveryv1.EndpointSlice{slice1, slice2}
@@ -819,7 +1388,8 @@ func TestHTTPBackendModel(t *testing.T) {
 			remainingEndpoints := initialEndpoints[:2]
 			endpointSlices := []discoveryv1.EndpointSlice{
 				{
-					Endpoints: remainingEndpoints,
+					AddressType: discoveryv1.AddressTypeIPv4,
+					Endpoints:   remainingEndpoints,
 				},
 			}
 
@@ -868,7 +1438,7 @@ func TestHTTPBackendModel(t *testing.T) {
 			drainingEndpoint := initialEndpoint
 			drainingEndpoint.Conditions.Terminating = new(true)
 			endpointSlices := []discoveryv1.EndpointSlice{
-				{Endpoints: []discoveryv1.Endpoint{drainingEndpoint}},
+				{AddressType: discoveryv1.AddressTypeIPv4, Endpoints: []discoveryv1.Endpoint{drainingEndpoint}},
 			}
 
 			params := identifyBackendsToUpdateParams{
@@ -912,7 +1482,7 @@ func TestHTTPBackendModel(t *testing.T) {
 				},
 			}
 			endpointSlices := []discoveryv1.EndpointSlice{
-				{Endpoints: []discoveryv1.Endpoint{endpoint}},
+				{AddressType: discoveryv1.AddressTypeIPv4, Endpoints: []discoveryv1.Endpoint{endpoint}},
 			}
 
 			result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
@@ -950,7 +1520,7 @@ func TestHTTPBackendModel(t *testing.T) {
 			notDrainingEndpoint := initialEndpoint
 			notDrainingEndpoint.Conditions.Terminating = new(false)
 			endpointSlices := []discoveryv1.EndpointSlice{
-				{Endpoints: []discoveryv1.Endpoint{notDrainingEndpoint}},
+				{AddressType: discoveryv1.AddressTypeIPv4, Endpoints: []discoveryv1.Endpoint{notDrainingEndpoint}},
 			}
 
 			params := identifyBackendsToUpdateParams{
@@ -996,7 +1566,7 @@ func TestHTTPBackendModel(t *testing.T) {
 			})
 
 			endpointSlices := []discoveryv1.EndpointSlice{
-				{Endpoints: initialEndpoints},
+				{AddressType: discoveryv1.AddressTypeIPv4, Endpoints: initialEndpoints},
 			}
 
 			params := identifyBackendsToUpdateParams{
@@ -1084,7 +1654,7 @@ func TestHTTPBackendModel(t *testing.T) {
 
 			nonReadyEndpoints := makeFewRandomEndpoints(2, randomEndpointWithConditionsOpt(new(false), nil))
 			endpointSlices := []discoveryv1.EndpointSlice{
-				{Endpoints: nonReadyEndpoints},
+				{AddressType: discoveryv1.AddressTypeIPv4, Endpoints: nonReadyEndpoints},
 			}
 
 			params := identifyBackendsToUpdateParams{
@@ -1143,7 +1713,10 @@ func TestHTTPBackendModel(t *testing.T) {
 
 			currentBackends := []loadbalancer.Backend{}
 			endpointSlices := []discoveryv1.EndpointSlice{
-				{Endpoints: []discoveryv1.Endpoint{endpointWithAddr, endpointWithoutAddr}},
+				{
+					AddressType: discoveryv1.AddressTypeIPv4,
+					Endpoints:   []discoveryv1.Endpoint{endpointWithAddr, endpointWithoutAddr},
+				},
 			}
 
 			params := identifyBackendsToUpdateParams{
@@ -1172,5 +1745,390 @@ func TestHTTPBackendModel(t *testing.T) {
 			assert.Equal(t, expectedResult.updateRequired, result.updateRequired)
 			assert.Equal(t, expectedResult.drainingCount, result.drainingCount)
 		})
+
+		t.Run("resolves named target port per endpoint slice", func(t *testing.T) {
+			model := newHTTPBackendModel(newMockDeps(t))
+			servicePort := corev1.ServicePort{
+				Name:       faker.New().Lorem().Word(),
+				Port:       rand.Int32N(1000) + 1,
+				TargetPort: intstr.FromString(faker.New().Lorem().Word()),
+			}
+			targetPort1 := rand.Int32N(1000) + 2000
+			targetPort2 := rand.Int32N(1000) + 4000
+			endpoint1 := makeRandomEndpoint(randomEndpointWithConditionsOpt(new(true), new(false)))
+			endpoint2 := makeRandomEndpoint(randomEndpointWithConditionsOpt(new(true), new(false)))
+			endpointWithoutPort := makeRandomEndpoint(randomEndpointWithConditionsOpt(new(true), new(false)))
+
+			result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+				endpointPort: servicePort.Port,
+				servicePort:  &servicePort,
+				endpointSlices: []discoveryv1.EndpointSlice{
+					{
+						AddressType: discoveryv1.AddressTypeIPv4,
+						Ports: []discoveryv1.EndpointPort{
+							{Name: new(faker.New().Lorem().Word() + "-other"), Port: new(rand.Int32N(1000) + 6000)},
+							{Name: &servicePort.Name, Port: &targetPort1},
+						},
+						Endpoints: []discoveryv1.Endpoint{endpoint1},
+					},
+					{
+						AddressType: discoveryv1.AddressTypeIPv4,
+						Ports:       []discoveryv1.EndpointPort{{Name: &servicePort.Name, Port: &targetPort2}},
+						Endpoints:   []discoveryv1.Endpoint{endpoint2},
+					},
+					{
+						AddressType: discoveryv1.AddressTypeIPv4,
+						Endpoints:   []discoveryv1.Endpoint{endpointWithoutPort},
+					},
+				},
+			})
+
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []loadbalancer.BackendDetails{
+				{IpAddress: &endpoint1.Addresses[0], Port: new(int(targetPort1)), Drain: new(false)},
+				{IpAddress: &endpoint2.Addresses[0], Port: new(int(targetPort2)), Drain: new(false)},
+				{IpAddress: &endpointWithoutPort.Addresses[0], Port: new(int(servicePort.Port)), Drain: new(false)},
+			}, result.updatedBackends)
+		})
+
+		t.Run("uses numeric target port", func(t *testing.T) {
+			model := newHTTPBackendModel(newMockDeps(t))
+			targetPort := rand.Int32N(1000) + 2000
+			servicePort := corev1.ServicePort{
+				Port:       rand.Int32N(1000) + 1,
+				TargetPort: intstr.FromInt32(targetPort),
+			}
+			endpoint := makeRandomEndpoint(randomEndpointWithConditionsOpt(new(true), new(false)))
+
+			result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+				endpointPort: servicePort.Port,
+				servicePort:  &servicePort,
+				endpointSlices: []discoveryv1.EndpointSlice{
+					{AddressType: discoveryv1.AddressTypeIPv4, Endpoints: []discoveryv1.Endpoint{endpoint}},
+				},
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, []loadbalancer.BackendDetails{
+				{IpAddress: &endpoint.Addresses[0], Port: new(int(targetPort)), Drain: new(false)},
+			}, result.updatedBackends)
+		})
+
+		t.Run("ip families", func(t *testing.T) {
+			makeDualStackSlices := func() (discoveryv1.Endpoint, discoveryv1.Endpoint, []discoveryv1.EndpointSlice) {
+				ipv4Endpoint := makeRandomEndpoint(randomEndpointWithConditionsOpt(new(true), new(false)))
+				ipv6Endpoint := makeRandomEndpoint(randomEndpointWithConditionsOpt(new(true), new(false)))
+				ipv6Endpoint.Addresses = []string{faker.New().Internet().Ipv6()}
+				return ipv4Endpoint, ipv6Endpoint, []discoveryv1.EndpointSlice{
+					{AddressType: discoveryv1.AddressTypeIPv4, Endpoints: []discoveryv1.Endpoint{ipv4Endpoint}},
+					{AddressType: discoveryv1.AddressTypeIPv6, Endpoints: []discoveryv1.Endpoint{ipv6Endpoint}},
+					{
+						AddressType: discoveryv1.AddressTypeFQDN,
+						Endpoints: []discoveryv1.Endpoint{
+							{Addresses: []string{faker.New().Internet().Domain()}},
+						},
+					},
+				}
+			}
+
+			t.Run("skips endpoints of other families by default", func(t *testing.T) {
+				model := newHTTPBackendModel(newMockDeps(t))
+				refPort := rand.Int32N(65534) + 1
+				ipv4Endpoint, _, endpointSlices := makeDualStackSlices()
+
+				result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+					endpointPort:   refPort,
+					endpointSlices: endpointSlices,
+				})
+
+				require.NoError(t, err)
+				assert.Equal(t, []loadbalancer.BackendDetails{
+					{IpAddress: &ipv4Endpoint.Addresses[0], Port: new(int(refPort)), Drain: new(false)},
+				}, result.updatedBackends)
+				assert.Equal(t, map[discoveryv1.AddressType]int{
+					discoveryv1.AddressTypeIPv6: 1,
+					discoveryv1.AddressTypeFQDN: 1,
+				}, result.skippedEndpoints)
+			})
+
+			t.Run("registers IPv6 endpoints", func(t *testing.T) {
+				model := newHTTPBackendModel(newMockDeps(t))
+				refPort := rand.Int32N(65534) + 1
+				_, ipv6Endpoint, endpointSlices := makeDualStackSlices()
+
+				result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+					endpointPort:   refPort,
+					endpointSlices: endpointSlices,
+					ipFamilies:     []corev1.IPFamily{corev1.IPv6Protocol},
+				})
+
+				require.NoError(t, err)
+				assert.Equal(t, []loadbalancer.BackendDetails{
+					{IpAddress: &ipv6Endpoint.Addresses[0], Port: new(int(refPort)), Drain: new(false)},
+				}, result.updatedBackends)
+				assert.Equal(t, map[discoveryv1.AddressType]int{
+					discoveryv1.AddressTypeIPv4: 1,
+					discoveryv1.AddressTypeFQDN: 1,
+				}, result.skippedEndpoints)
+			})
+
+			t.Run("registers both families of dual-stack services", func(t *testing.T) {
+				model := newHTTPBackendModel(newMockDeps(t))
+				refPort := rand.Int32N(65534) + 1
+				ipv4Endpoint, ipv6Endpoint, endpointSlices := makeDualStackSlices()
+
+				result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+					endpointPort:   refPort,
+					endpointSlices: endpointSlices,
+					ipFamilies:     []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+				})
+
+				require.NoError(t, err)
+				assert.ElementsMatch(t, []loadbalancer.BackendDetails{
+					{IpAddress: &ipv4Endpoint.Addresses[0], Port: new(int(refPort)), Drain: new(false)},
+					{IpAddress: &ipv6Endpoint.Addresses[0], Port: new(int(refPort)), Drain: new(false)},
+				}, result.updatedBackends)
+				assert.Equal(t, map[discoveryv1.AddressType]int{
+					discoveryv1.AddressTypeFQDN: 1,
+				}, result.skippedEndpoints)
+			})
+		})
+
+		t.Run("topology", func(t *testing.T) {
+			makeZonedSlice := func() (discoveryv1.Endpoint, discoveryv1.Endpoint, discoveryv1.EndpointSlice) {
+				localEndpoint := makeRandomEndpoint(randomEndpointWithConditionsOpt(new(true), new(false)))
+				localEndpoint.Zone = new("ad-1")
+				remoteEndpoint := makeRandomEndpoint(randomEndpointWithConditionsOpt(new(true), new(false)))
+				remoteEndpoint.Zone = new("ad-2")
+				return localEndpoint, remoteEndpoint, discoveryv1.EndpointSlice{
+					AddressType: discoveryv1.AddressTypeIPv4,
+					Endpoints:   []discoveryv1.Endpoint{localEndpoint, remoteEndpoint},
+				}
+			}
+
+			t.Run("prefers local endpoints with weight", func(t *testing.T) {
+				model := newHTTPBackendModel(newMockDeps(t))
+				refPort := rand.Int32N(65534) + 1
+				localEndpoint, remoteEndpoint, slice := makeZonedSlice()
+
+				result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+					endpointPort:   refPort,
+					endpointSlices: []discoveryv1.EndpointSlice{slice},
+					topology:       &types.GatewayConfigBackendTopology{Zones: []string{"ad-1"}},
+				})
+
+				require.NoError(t, err)
+				assert.ElementsMatch(t, []loadbalancer.BackendDetails{
+					{
+						IpAddress: &localEndpoint.Addresses[0],
+						Port:      new(int(refPort)),
+						Drain:     new(false),
+						Weight:    new(defaultBackendTopologyLocalWeight),
+					},
+					{
+						IpAddress: &remoteEndpoint.Addresses[0],
+						Port:      new(int(refPort)),
+						Drain:     new(false),
+						Weight:    new(minBackendWeight),
+					},
+				}, result.updatedBackends)
+			})
+
+			t.Run("registers local endpoints only when restricted", func(t *testing.T) {
+				model := newHTTPBackendModel(newMockDeps(t))
+				refPort := rand.Int32N(65534) + 1
+				localEndpoint, _, slice := makeZonedSlice()
+
+				result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+					endpointPort:   refPort,
+					endpointSlices: []discoveryv1.EndpointSlice{slice},
+					topology: &types.GatewayConfigBackendTopology{
+						Zones: []string{"ad-1"},
+						Mode:  backendTopologyModeRestrict,
+					},
+				})
+
+				require.NoError(t, err)
+				assert.Equal(t, []loadbalancer.BackendDetails{
+					{IpAddress: &localEndpoint.Addresses[0], Port: new(int(refPort)), Drain: new(false)},
+				}, result.updatedBackends)
+			})
+
+			t.Run("registers all endpoints when none is local", func(t *testing.T) {
+				model := newHTTPBackendModel(newMockDeps(t))
+				refPort := rand.Int32N(65534) + 1
+				_, _, slice := makeZonedSlice()
+
+				result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+					endpointPort:   refPort,
+					endpointSlices: []discoveryv1.EndpointSlice{slice},
+					topology: &types.GatewayConfigBackendTopology{
+						Zones: []string{"ad-3"},
+						Mode:  backendTopologyModeRestrict,
+					},
+				})
+
+				require.NoError(t, err)
+				assert.Len(t, result.updatedBackends, 2)
+			})
+		})
+
+		t.Run("pod annotations", func(t *testing.T) {
+			makePodEndpointSlice := func(endpoint discoveryv1.Endpoint) discoveryv1.EndpointSlice {
+				endpoint.TargetRef = &corev1.ObjectReference{
+					Kind: "Pod",
+					Name: faker.New().Internet().Slug(),
+				}
+				return discoveryv1.EndpointSlice{
+					AddressType: discoveryv1.AddressTypeIPv4,
+					ObjectMeta:  metav1.ObjectMeta{Namespace: faker.New().Internet().Slug()},
+					Endpoints:   []discoveryv1.Endpoint{endpoint},
+				}
+			}
+			expectPod := func(
+				t *testing.T,
+				deps httpBackendModelDeps,
+				slice discoveryv1.EndpointSlice,
+				annotations map[string]string,
+			) {
+				mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+				mockK8sClient.EXPECT().Get(t.Context(), client.ObjectKey{
+					Namespace: slice.Namespace,
+					Name:      slice.Endpoints[0].TargetRef.Name,
+				}, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+						pod, ok := obj.(*corev1.Pod)
+						require.True(t, ok)
+						pod.Annotations = annotations
+						return nil
+					}).
+					Once()
+			}
+
+			t.Run("applies weight and max connections", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newHTTPBackendModel(deps)
+				refPort := rand.Int32N(65534) + 1
+				endpoint := makeRandomEndpoint(randomEndpointWithConditionsOpt(new(true), new(false)))
+				slice := makePodEndpointSlice(endpoint)
+				weight := rand.IntN(maxBackendWeight) + minBackendWeight
+				maxConnections := rand.IntN(maxBackendMaxConnections-minBackendMaxConnections) + minBackendMaxConnections
+				expectPod(t, deps, slice, map[string]string{
+					BackendWeightAnnotation:         strconv.Itoa(weight),
+					BackendMaxConnectionsAnnotation: strconv.Itoa(maxConnections),
+				})
+
+				result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+					endpointPort: refPort,
+					currentBackends: []loadbalancer.Backend{
+						{
+							IpAddress: &endpoint.Addresses[0],
+							Port:      new(int(refPort)),
+							Drain:     new(false),
+						},
+					},
+					endpointSlices: []discoveryv1.EndpointSlice{slice},
+				})
+
+				require.NoError(t, err)
+				assert.True(t, result.updateRequired)
+				assert.Equal(t, []loadbalancer.BackendDetails{
+					{
+						IpAddress:      &endpoint.Addresses[0],
+						Port:           new(int(refPort)),
+						Drain:          new(false),
+						Weight:         &weight,
+						MaxConnections: &maxConnections,
+					},
+				}, result.updatedBackends)
+			})
+
+			t.Run("skips update when settings are already applied", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newHTTPBackendModel(deps)
+				refPort := rand.Int32N(65534) + 1
+				endpoint := makeRandomEndpoint(randomEndpointWithConditionsOpt(new(true), new(false)))
+				slice := makePodEndpointSlice(endpoint)
+				expectPod(t, deps, slice, map[string]string{
+					BackendWeightAnnotation: "5",
+				})
+
+				result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+					endpointPort: refPort,
+					currentBackends: []loadbalancer.Backend{
+						{
+							IpAddress: &endpoint.Addresses[0],
+							Port:      new(int(refPort)),
+							Drain:     new(false),
+							Weight:    new(5),
+						},
+					},
+					endpointSlices: []discoveryv1.EndpointSlice{slice},
+				})
+
+				require.NoError(t, err)
+				assert.False(t, result.updateRequired)
+			})
+
+			t.Run("ignores invalid values", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newHTTPBackendModel(deps)
+				refPort := rand.Int32N(65534) + 1
+				endpoint := makeRandomEndpoint(randomEndpointWithConditionsOpt(new(true), new(false)))
+				slice := makePodEndpointSlice(endpoint)
+				expectPod(t, deps, slice, map[string]string{
+					BackendWeightAnnotation:         strconv.Itoa(maxBackendWeight + 1),
+					BackendMaxConnectionsAnnotation: faker.New().Lorem().Word(),
+				})
+
+				result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+					endpointPort:   refPort,
+					endpointSlices: []discoveryv1.EndpointSlice{slice},
+				})
+
+				require.NoError(t, err)
+				require.Len(t, result.updatedBackends, 1)
+				assert.Nil(t, result.updatedBackends[0].Weight)
+				assert.Nil(t, result.updatedBackends[0].MaxConnections)
+			})
+
+			t.Run("uses defaults when pod is not found", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newHTTPBackendModel(deps)
+				endpoint := makeRandomEndpoint(randomEndpointWithConditionsOpt(new(true), new(false)))
+				slice := makePodEndpointSlice(endpoint)
+				mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+				mockK8sClient.EXPECT().Get(t.Context(), mock.Anything, mock.Anything).
+					Return(apierrors.NewNotFound(corev1.Resource("pods"), slice.Endpoints[0].TargetRef.Name)).
+					Once()
+
+				result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+					endpointPort:   rand.Int32N(65534) + 1,
+					endpointSlices: []discoveryv1.EndpointSlice{slice},
+				})
+
+				require.NoError(t, err)
+				require.Len(t, result.updatedBackends, 1)
+				assert.Nil(t, result.updatedBackends[0].Weight)
+			})
+
+			t.Run("fails when pod can not be fetched", func(t *testing.T) {
+				deps := newMockDeps(t)
+				model := newHTTPBackendModel(deps)
+				wantErr := errors.New(faker.New().Lorem().Sentence(10))
+				slice := makePodEndpointSlice(
+					makeRandomEndpoint(randomEndpointWithConditionsOpt(new(true), new(false))),
+				)
+				mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+				mockK8sClient.EXPECT().Get(t.Context(), mock.Anything, mock.Anything).Return(wantErr).Once()
+
+				_, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+					endpointPort:   rand.Int32N(65534) + 1,
+					endpointSlices: []discoveryv1.EndpointSlice{slice},
+				})
+
+				require.ErrorIs(t, err, wantErr)
+			})
+		})
 	})
 }
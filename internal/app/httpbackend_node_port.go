@@ -0,0 +1,114 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+)
+
+// backendModeNodePort registers node IPs with the Service nodePort instead of pod IPs,
+// for clusters where pod IPs are not reachable from the load balancer subnet.
+const backendModeNodePort = "NodePort"
+
+// identifyNodePortBackendSetBackends resolves the backends of the Service backendRef
+// from the cluster nodes and the Service nodePort.
+func (m *httpBackendModelImpl) identifyNodePortBackendSetBackends(
+	ctx context.Context,
+	params identifyBackendSetBackendsParams,
+) (identifyBackendsToUpdateResult, error) {
+	servicePort, err := m.backendRefServicePort(ctx, params.backendRefNamespace, params.backendRef)
+	if err != nil {
+		return identifyBackendsToUpdateResult{}, err
+	}
+	if servicePort.NodePort == 0 {
+		return identifyBackendsToUpdateResult{}, fmt.Errorf(
+			"backendRef service %s port %d has no nodePort, NodePort backend mode requires "+
+				"NodePort or LoadBalancer services",
+			params.backendRef.BackendObjectReference.Name,
+			servicePort.Port,
+		)
+	}
+
+	var nodes corev1.NodeList
+	if err = m.k8sClient.List(ctx, &nodes); err != nil {
+		return identifyBackendsToUpdateResult{}, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	return identifyNodePortBackendsToUpdate(identifyNodePortBackendsToUpdateParams{
+		nodePort:        int(servicePort.NodePort),
+		currentBackends: params.existingBackendSet.Backends,
+		nodes:           nodes.Items,
+		ipFamilies:      params.ipFamilies,
+	}), nil
+}
+
+type identifyNodePortBackendsToUpdateParams struct {
+	nodePort        int
+	currentBackends []loadbalancer.Backend
+	nodes           []corev1.Node
+	ipFamilies      []corev1.IPFamily
+}
+
+// identifyNodePortBackendsToUpdate registers internal IPs of ready nodes. Nodes labeled to be
+// excluded from external load balancers are skipped, cordoned and deleted nodes are drained.
+func identifyNodePortBackendsToUpdate(
+	params identifyNodePortBackendsToUpdateParams,
+) identifyBackendsToUpdateResult {
+	addressTypes := backendAddressTypes(params.ipFamilies)
+	desiredBackendsMap := make(map[httpBackendAddressKey]loadbalancer.BackendDetails)
+	var drainingCount int
+
+	for _, node := range params.nodes {
+		if _, excluded := node.Labels[corev1.LabelNodeExcludeBalancers]; excluded || !nodeReady(node) {
+			continue
+		}
+		isDraining := node.Spec.Unschedulable || node.DeletionTimestamp != nil
+		if isDraining {
+			drainingCount++
+		}
+
+		for _, address := range node.Status.Addresses {
+			if address.Type != corev1.NodeInternalIP {
+				continue
+			}
+			addressType, ok := nodeAddressType(address.Address)
+			if !ok || !slices.Contains(addressTypes, addressType) {
+				continue
+			}
+			desiredBackendsMap[httpBackendAddressKey{
+				ipAddress: address.Address,
+				port:      params.nodePort,
+			}] = loadbalancer.BackendDetails{
+				Port:      new(params.nodePort),
+				IpAddress: new(address.Address),
+				Drain:     new(isDraining),
+			}
+		}
+	}
+
+	return identifyBackendsToUpdateResult{
+		updateRequired:  backendsUpdateRequired(params.currentBackends, desiredBackendsMap),
+		updatedBackends: lo.Values(desiredBackendsMap),
+		drainingCount:   drainingCount,
+	}
+}
+
+func nodeReady(node corev1.Node) bool {
+	return lo.ContainsBy(node.Status.Conditions, func(condition corev1.NodeCondition) bool {
+		return condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue
+	})
+}
+
+func nodeAddressType(address string) (discoveryv1.AddressType, bool) {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return "", false
+	}
+	return lo.Ternary(addr.Unmap().Is4(), discoveryv1.AddressTypeIPv4, discoveryv1.AddressTypeIPv6), true
+}
@@ -0,0 +1,222 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+)
+
+func TestHTTPBackendNodePort(t *testing.T) {
+	makeNode := func(ready bool, addresses ...string) corev1.Node {
+		node := corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: faker.New().Internet().Slug()},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{
+						Type:   corev1.NodeReady,
+						Status: lo.Ternary(ready, corev1.ConditionTrue, corev1.ConditionFalse),
+					},
+				},
+			},
+		}
+		for _, address := range addresses {
+			node.Status.Addresses = append(node.Status.Addresses, corev1.NodeAddress{
+				Type:    corev1.NodeInternalIP,
+				Address: address,
+			})
+		}
+		return node
+	}
+
+	t.Run("identifyNodePortBackendsToUpdate", func(t *testing.T) {
+		t.Run("registers internal IPs of ready nodes", func(t *testing.T) {
+			nodePort := rand.IntN(2767) + 30000
+			readyNode := makeNode(true, faker.New().Internet().Ipv4())
+			readyNode.Status.Addresses = append(readyNode.Status.Addresses, corev1.NodeAddress{
+				Type:    corev1.NodeExternalIP,
+				Address: faker.New().Internet().Ipv4(),
+			})
+			cordonedNode := makeNode(true, faker.New().Internet().Ipv4())
+			cordonedNode.Spec.Unschedulable = true
+			excludedNode := makeNode(true, faker.New().Internet().Ipv4())
+			excludedNode.Labels = map[string]string{corev1.LabelNodeExcludeBalancers: ""}
+			notReadyNode := makeNode(false, faker.New().Internet().Ipv4())
+
+			result := identifyNodePortBackendsToUpdate(identifyNodePortBackendsToUpdateParams{
+				nodePort: nodePort,
+				nodes:    []corev1.Node{readyNode, cordonedNode, excludedNode, notReadyNode},
+			})
+
+			assert.True(t, result.updateRequired)
+			assert.Equal(t, 1, result.drainingCount)
+			assert.ElementsMatch(t, []loadbalancer.BackendDetails{
+				{IpAddress: &readyNode.Status.Addresses[0].Address, Port: new(nodePort), Drain: new(false)},
+				{IpAddress: &cordonedNode.Status.Addresses[0].Address, Port: new(nodePort), Drain: new(true)},
+			}, result.updatedBackends)
+		})
+
+		t.Run("filters node addresses by ip families", func(t *testing.T) {
+			nodePort := rand.IntN(2767) + 30000
+			ipv4Address := faker.New().Internet().Ipv4()
+			ipv6Address := faker.New().Internet().Ipv6()
+			node := makeNode(true, ipv4Address, ipv6Address)
+
+			ipv4Result := identifyNodePortBackendsToUpdate(identifyNodePortBackendsToUpdateParams{
+				nodePort: nodePort,
+				nodes:    []corev1.Node{node},
+			})
+			dualStackResult := identifyNodePortBackendsToUpdate(identifyNodePortBackendsToUpdateParams{
+				nodePort:   nodePort,
+				nodes:      []corev1.Node{node},
+				ipFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+			})
+
+			assert.Equal(t, []loadbalancer.BackendDetails{
+				{IpAddress: &ipv4Address, Port: new(nodePort), Drain: new(false)},
+			}, ipv4Result.updatedBackends)
+			assert.ElementsMatch(t, []loadbalancer.BackendDetails{
+				{IpAddress: &ipv4Address, Port: new(nodePort), Drain: new(false)},
+				{IpAddress: &ipv6Address, Port: new(nodePort), Drain: new(false)},
+			}, dualStackResult.updatedBackends)
+		})
+
+		t.Run("does not require update when backends are registered", func(t *testing.T) {
+			nodePort := rand.IntN(2767) + 30000
+			node := makeNode(true, faker.New().Internet().Ipv4())
+
+			result := identifyNodePortBackendsToUpdate(identifyNodePortBackendsToUpdateParams{
+				nodePort: nodePort,
+				nodes:    []corev1.Node{node},
+				currentBackends: []loadbalancer.Backend{
+					{
+						IpAddress: &node.Status.Addresses[0].Address,
+						Port:      new(nodePort),
+						Drain:     new(false),
+					},
+				},
+			})
+
+			assert.False(t, result.updateRequired)
+		})
+	})
+
+	t.Run("identifyNodePortBackendSetBackends", func(t *testing.T) {
+		newDeps := func(t *testing.T) httpBackendModelDeps {
+			return httpBackendModelDeps{
+				K8sClient:             NewMockk8sClient(t),
+				RootLogger:            diag.RootTestLogger(),
+				OciLoadBalancerClient: NewMockociLoadBalancerClient(t),
+				WorkRequestsWatcher:   NewMockworkRequestsWatcher(t),
+				self:                  NewMockhttpBackendModel(t),
+			}
+		}
+		makeBackendRef := func() gatewayv1.BackendRef {
+			return gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{
+					Name: gatewayv1.ObjectName(faker.New().Internet().Slug()),
+					Port: new(rand.Int32N(65534) + 1),
+				},
+			}
+		}
+		expectService := func(
+			t *testing.T,
+			mockK8sClient *Mockk8sClient,
+			namespace string,
+			backendRef gatewayv1.BackendRef,
+			nodePort int32,
+		) {
+			mockK8sClient.EXPECT().Get(t.Context(), client.ObjectKey{
+				Namespace: namespace,
+				Name:      string(backendRef.Name),
+			}, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+					service, ok := obj.(*corev1.Service)
+					require.True(t, ok, "expected a Service")
+					service.Spec.Ports = []corev1.ServicePort{{Port: *backendRef.Port, NodePort: nodePort}}
+					return nil
+				}).
+				Once()
+		}
+
+		t.Run("registers nodes with the service node port", func(t *testing.T) {
+			deps := newDeps(t)
+			model := newHTTPBackendModel(deps)
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			namespace := faker.New().Internet().Slug()
+			backendRef := makeBackendRef()
+			nodePort := rand.Int32N(2767) + 30000
+			node := makeNode(true, faker.New().Internet().Ipv4())
+			expectService(t, mockK8sClient, namespace, backendRef, nodePort)
+			mockK8sClient.EXPECT().List(t.Context(), mock.Anything).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					nodes, ok := list.(*corev1.NodeList)
+					require.True(t, ok, "expected a NodeList")
+					nodes.Items = []corev1.Node{node}
+					return nil
+				}).
+				Once()
+
+			result, err := model.identifyNodePortBackendSetBackends(t.Context(), identifyBackendSetBackendsParams{
+				existingBackendSet:  makeRandomOCIBackendSet(),
+				backendRefNamespace: namespace,
+				backendRef:          backendRef,
+			})
+
+			require.NoError(t, err)
+			assert.True(t, result.updateRequired)
+			assert.Equal(t, []loadbalancer.BackendDetails{
+				{IpAddress: &node.Status.Addresses[0].Address, Port: new(int(nodePort)), Drain: new(false)},
+			}, result.updatedBackends)
+		})
+
+		t.Run("fails when the service port has no node port", func(t *testing.T) {
+			deps := newDeps(t)
+			model := newHTTPBackendModel(deps)
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			namespace := faker.New().Internet().Slug()
+			backendRef := makeBackendRef()
+			expectService(t, mockK8sClient, namespace, backendRef, 0)
+
+			_, err := model.identifyNodePortBackendSetBackends(t.Context(), identifyBackendSetBackendsParams{
+				existingBackendSet:  makeRandomOCIBackendSet(),
+				backendRefNamespace: namespace,
+				backendRef:          backendRef,
+			})
+
+			require.ErrorContains(t, err, "has no nodePort")
+		})
+
+		t.Run("fails when nodes can not be listed", func(t *testing.T) {
+			deps := newDeps(t)
+			model := newHTTPBackendModel(deps)
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			namespace := faker.New().Internet().Slug()
+			backendRef := makeBackendRef()
+			wantErr := errors.New(faker.New().Lorem().Sentence(3))
+			expectService(t, mockK8sClient, namespace, backendRef, rand.Int32N(2767)+30000)
+			mockK8sClient.EXPECT().List(t.Context(), mock.Anything).Return(wantErr).Once()
+
+			_, err := model.identifyNodePortBackendSetBackends(t.Context(), identifyBackendSetBackendsParams{
+				existingBackendSet:  makeRandomOCIBackendSet(),
+				backendRefNamespace: namespace,
+				backendRef:          backendRef,
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+}
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+const (
+	// scaleToZeroModeDrain keeps the last known backends registered in the drain state,
+	// so connections in flight complete instead of failing with 502.
+	scaleToZeroModeDrain = "Drain"
+
+	// scaleToZeroModeFallback registers the endpoints of the fallback Service until
+	// the endpoints of the backendRef return.
+	scaleToZeroModeFallback = "Fallback"
+)
+
+type identifyBackendSetBackendsFunc func(
+	ctx context.Context,
+	params identifyBackendSetBackendsParams,
+) (identifyBackendsToUpdateResult, error)
+
+type identifyScaledToZeroBackendsParams struct {
+	scaleToZero      *types.GatewayConfigScaleToZero
+	configNamespace  string
+	identifyBackends identifyBackendSetBackendsFunc
+	backendSetParams identifyBackendSetBackendsParams
+	backends         identifyBackendsToUpdateResult
+}
+
+// identifyScaledToZeroBackends replaces the backends of the backendRef without endpoints
+// according to the scale to zero mode. Backends are returned as is if the backendRef
+// has endpoints or the scale to zero is not configured.
+func (m *httpBackendModelImpl) identifyScaledToZeroBackends(
+	ctx context.Context,
+	params identifyScaledToZeroBackendsParams,
+) (identifyBackendsToUpdateResult, error) {
+	if params.scaleToZero == nil || len(params.backends.updatedBackends) > 0 {
+		return params.backends, nil
+	}
+
+	switch params.scaleToZero.Mode {
+	case scaleToZeroModeDrain:
+		return drainScaledToZeroBackends(params.backendSetParams.existingBackendSet.Backends), nil
+	case scaleToZeroModeFallback:
+		fallback := params.scaleToZero.Fallback
+		if fallback == nil {
+			return identifyBackendsToUpdateResult{}, fmt.Errorf(
+				"scaleToZero fallback is required in the %s mode", scaleToZeroModeFallback,
+			)
+		}
+		backendSetParams := params.backendSetParams
+		backendSetParams.backendRefNamespace = params.configNamespace
+		backendSetParams.backendRef = gatewayv1.BackendRef{
+			BackendObjectReference: gatewayv1.BackendObjectReference{
+				Name:      gatewayv1.ObjectName(fallback.ServiceName),
+				Namespace: new(gatewayv1.Namespace(params.configNamespace)),
+				Port:      new(fallback.Port),
+			},
+		}
+		fallbackBackends, err := params.identifyBackends(ctx, backendSetParams)
+		if err != nil {
+			return identifyBackendsToUpdateResult{}, fmt.Errorf(
+				"failed to identify backends of fallback service %s: %w", fallback.ServiceName, err,
+			)
+		}
+
+		// Readiness gates of the fallback pods are not related to the backend set
+		fallbackBackends.gatedPods = nil
+		fallbackBackends.startingGatedPods = 0
+		return fallbackBackends, nil
+	default:
+		return identifyBackendsToUpdateResult{}, fmt.Errorf(
+			"unsupported scaleToZero mode %s", params.scaleToZero.Mode,
+		)
+	}
+}
+
+// drainScaledToZeroBackends keeps the current backends registered in the drain state.
+func drainScaledToZeroBackends(currentBackends []loadbalancer.Backend) identifyBackendsToUpdateResult {
+	desiredBackendsMap := make(map[httpBackendAddressKey]loadbalancer.BackendDetails)
+	for _, backend := range currentBackends {
+		if backend.IpAddress == nil {
+			continue
+		}
+		desiredBackendsMap[httpBackendAddressKey{
+			ipAddress: *backend.IpAddress,
+			port:      lo.FromPtr(backend.Port),
+		}] = loadbalancer.BackendDetails{
+			Port:           backend.Port,
+			IpAddress:      backend.IpAddress,
+			Drain:          new(true),
+			Weight:         backend.Weight,
+			MaxConnections: backend.MaxConnections,
+		}
+	}
+
+	return identifyBackendsToUpdateResult{
+		updateRequired:  backendsUpdateRequired(currentBackends, desiredBackendsMap),
+		updatedBackends: lo.Values(desiredBackendsMap),
+		drainingCount:   len(desiredBackendsMap),
+	}
+}
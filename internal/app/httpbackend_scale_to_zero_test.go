@@ -0,0 +1,195 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestHTTPBackendScaleToZero(t *testing.T) {
+	newModel := func(t *testing.T) *httpBackendModelImpl {
+		return newHTTPBackendModel(httpBackendModelDeps{
+			K8sClient:             NewMockk8sClient(t),
+			RootLogger:            diag.RootTestLogger(),
+			OciLoadBalancerClient: NewMockociLoadBalancerClient(t),
+			WorkRequestsWatcher:   NewMockworkRequestsWatcher(t),
+			self:                  NewMockhttpBackendModel(t),
+		})
+	}
+	unexpectedIdentify := func(t *testing.T) identifyBackendSetBackendsFunc {
+		return func(context.Context, identifyBackendSetBackendsParams) (identifyBackendsToUpdateResult, error) {
+			require.Fail(t, "unexpected identifyBackends call")
+			return identifyBackendsToUpdateResult{}, nil
+		}
+	}
+	makeBackendSetParams := func(currentBackends []loadbalancer.Backend) identifyBackendSetBackendsParams {
+		return identifyBackendSetBackendsParams{
+			existingBackendSet:  makeRandomOCIBackendSet(randomOCIBackendSetWithBackendsOpt(currentBackends)),
+			backendRefNamespace: faker.New().Internet().Slug(),
+			backendRef: gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{
+					Name: gatewayv1.ObjectName(faker.New().Internet().Slug()),
+					Port: new(rand.Int32N(65534) + 1),
+				},
+			},
+		}
+	}
+
+	t.Run("keeps backends when endpoints are available", func(t *testing.T) {
+		backends := identifyBackendsToUpdateResult{
+			updateRequired:  true,
+			updatedBackends: []loadbalancer.BackendDetails{makeRandomOCIBackendDetails()},
+		}
+
+		result, err := newModel(t).identifyScaledToZeroBackends(t.Context(), identifyScaledToZeroBackendsParams{
+			scaleToZero:      &types.GatewayConfigScaleToZero{Mode: scaleToZeroModeDrain},
+			identifyBackends: unexpectedIdentify(t),
+			backendSetParams: makeBackendSetParams(makeFewRandomOCIBackends()),
+			backends:         backends,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, backends, result)
+	})
+
+	t.Run("keeps backends when scale to zero is not configured", func(t *testing.T) {
+		backends := identifyBackendsToUpdateResult{updateRequired: true}
+
+		result, err := newModel(t).identifyScaledToZeroBackends(t.Context(), identifyScaledToZeroBackendsParams{
+			identifyBackends: unexpectedIdentify(t),
+			backendSetParams: makeBackendSetParams(makeFewRandomOCIBackends()),
+			backends:         backends,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, backends, result)
+	})
+
+	t.Run("drains current backends", func(t *testing.T) {
+		currentBackends := makeFewRandomOCIBackends()
+		currentBackends[0].Weight = new(rand.IntN(maxBackendWeight) + 1)
+
+		result, err := newModel(t).identifyScaledToZeroBackends(t.Context(), identifyScaledToZeroBackendsParams{
+			scaleToZero:      &types.GatewayConfigScaleToZero{Mode: scaleToZeroModeDrain},
+			identifyBackends: unexpectedIdentify(t),
+			backendSetParams: makeBackendSetParams(currentBackends),
+			backends:         identifyBackendsToUpdateResult{updateRequired: true},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, result.updateRequired)
+		assert.Equal(t, len(currentBackends), result.drainingCount)
+		wantBackends := make([]loadbalancer.BackendDetails, len(currentBackends))
+		for i, backend := range currentBackends {
+			wantBackends[i] = loadbalancer.BackendDetails{
+				IpAddress: backend.IpAddress,
+				Port:      backend.Port,
+				Weight:    backend.Weight,
+				Drain:     new(true),
+			}
+		}
+		assert.ElementsMatch(t, wantBackends, result.updatedBackends)
+	})
+
+	t.Run("does not require update when backends are drained", func(t *testing.T) {
+		currentBackends := makeFewRandomOCIBackends()
+		for i := range currentBackends {
+			currentBackends[i].Drain = new(true)
+		}
+
+		result, err := newModel(t).identifyScaledToZeroBackends(t.Context(), identifyScaledToZeroBackendsParams{
+			scaleToZero:      &types.GatewayConfigScaleToZero{Mode: scaleToZeroModeDrain},
+			identifyBackends: unexpectedIdentify(t),
+			backendSetParams: makeBackendSetParams(currentBackends),
+			backends:         identifyBackendsToUpdateResult{updateRequired: true},
+		})
+
+		require.NoError(t, err)
+		assert.False(t, result.updateRequired)
+	})
+
+	t.Run("registers fallback service backends", func(t *testing.T) {
+		configNamespace := faker.New().Internet().Slug()
+		fallback := &types.GatewayConfigDefaultBackend{
+			ServiceName: faker.New().Internet().Slug(),
+			Port:        rand.Int32N(65534) + 1,
+		}
+		backendSetParams := makeBackendSetParams(makeFewRandomOCIBackends())
+		fallbackBackends := identifyBackendsToUpdateResult{
+			updateRequired:    true,
+			updatedBackends:   []loadbalancer.BackendDetails{makeRandomOCIBackendDetails()},
+			gatedPods:         []gatedBackendPod{{ipAddress: faker.New().Internet().Ipv4()}},
+			startingGatedPods: 1,
+		}
+
+		result, err := newModel(t).identifyScaledToZeroBackends(t.Context(), identifyScaledToZeroBackendsParams{
+			scaleToZero: &types.GatewayConfigScaleToZero{
+				Mode:     scaleToZeroModeFallback,
+				Fallback: fallback,
+			},
+			configNamespace: configNamespace,
+			identifyBackends: func(
+				_ context.Context,
+				params identifyBackendSetBackendsParams,
+			) (identifyBackendsToUpdateResult, error) {
+				assert.Equal(t, configNamespace, params.backendRefNamespace)
+				assert.Equal(t, gatewayv1.ObjectName(fallback.ServiceName), params.backendRef.Name)
+				assert.Equal(t, new(gatewayv1.Namespace(configNamespace)), params.backendRef.Namespace)
+				assert.Equal(t, new(fallback.Port), params.backendRef.Port)
+				assert.Equal(t, backendSetParams.existingBackendSet, params.existingBackendSet)
+				return fallbackBackends, nil
+			},
+			backendSetParams: backendSetParams,
+			backends:         identifyBackendsToUpdateResult{updateRequired: true},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, identifyBackendsToUpdateResult{
+			updateRequired:  true,
+			updatedBackends: fallbackBackends.updatedBackends,
+		}, result)
+	})
+
+	t.Run("fails when fallback backends can not be identified", func(t *testing.T) {
+		wantErr := errors.New(faker.New().Lorem().Sentence(3))
+
+		_, err := newModel(t).identifyScaledToZeroBackends(t.Context(), identifyScaledToZeroBackendsParams{
+			scaleToZero: &types.GatewayConfigScaleToZero{
+				Mode: scaleToZeroModeFallback,
+				Fallback: &types.GatewayConfigDefaultBackend{
+					ServiceName: faker.New().Internet().Slug(),
+					Port:        rand.Int32N(65534) + 1,
+				},
+			},
+			identifyBackends: func(
+				context.Context,
+				identifyBackendSetBackendsParams,
+			) (identifyBackendsToUpdateResult, error) {
+				return identifyBackendsToUpdateResult{}, wantErr
+			},
+			backendSetParams: makeBackendSetParams(makeFewRandomOCIBackends()),
+		})
+
+		require.ErrorIs(t, err, wantErr)
+	})
+
+	t.Run("fails when fallback is not configured", func(t *testing.T) {
+		_, err := newModel(t).identifyScaledToZeroBackends(t.Context(), identifyScaledToZeroBackendsParams{
+			scaleToZero:      &types.GatewayConfigScaleToZero{Mode: scaleToZeroModeFallback},
+			identifyBackends: unexpectedIdentify(t),
+			backendSetParams: makeBackendSetParams(makeFewRandomOCIBackends()),
+		})
+
+		require.ErrorContains(t, err, "fallback is required")
+	})
+}
@@ -2,12 +2,16 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
 	"go.uber.org/dig"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
 )
 
 // HTTPRouteController is a simple controller that watches HTTPRoute resources.
@@ -16,6 +20,11 @@ type HTTPRouteController struct {
 	httpRouteModel   httpRouteModel
 	httpBackendModel httpBackendModel
 	driftInterval    time.Duration
+
+	backendHealthInterval time.Duration
+	backendHealthMetrics  *backendHealthMetrics
+	programmingMetrics    *routeProgrammingMetrics
+	configValidation      *gatewayConfigValidation
 }
 
 // HTTPRouteControllerDeps contains the dependencies for the HTTPRouteController.
@@ -26,6 +35,12 @@ type HTTPRouteControllerDeps struct {
 	HTTPRouteModel   httpRouteModel
 	HTTPBackendModel httpBackendModel
 	DriftInterval    time.Duration `name:"config.reconcile.drift-interval"`
+
+	// Interval to refresh the BackendsHealthy route condition, zero disables it.
+	BackendHealthInterval time.Duration            `name:"config.reconcile.backend-health-interval"`
+	BackendHealthMetrics  *backendHealthMetrics    `optional:"true"`
+	ProgrammingMetrics    *routeProgrammingMetrics `optional:"true"`
+	ConfigValidation      *gatewayConfigValidation `optional:"true"`
 }
 
 // NewHTTPRouteController creates a new HTTPRouteController.
@@ -35,6 +50,11 @@ func NewHTTPRouteController(deps HTTPRouteControllerDeps) *HTTPRouteController {
 		httpRouteModel:   deps.HTTPRouteModel,
 		httpBackendModel: deps.HTTPBackendModel,
 		driftInterval:    deps.DriftInterval,
+
+		backendHealthInterval: deps.BackendHealthInterval,
+		backendHealthMetrics:  deps.BackendHealthMetrics,
+		programmingMetrics:    deps.ProgrammingMetrics,
+		configValidation:      deps.ConfigValidation,
 	}
 }
 
@@ -65,6 +85,8 @@ func (r *HTTPRouteController) reconcileResolvedRoute(
 				resolvedData.gatewayDetails.gateway.Name, err)
 		}
 
+		r.backendHealthMetrics.deleteRouteBackends(resolvedData.httpRoute.Namespace, resolvedData.httpRoute.Name)
+
 		r.logger.InfoContext(ctx, "Successfully deprovisioned HTTProute",
 			slog.String("httpRoute", resolvedData.httpRoute.Name),
 			slog.String("gateway", resolvedData.gatewayDetails.gateway.Name),
@@ -101,10 +123,36 @@ func (r *HTTPRouteController) reconcileResolvedRoute(
 		slog.String("gateway", resolvedData.gatewayDetails.gateway.Name),
 	)
 
+	// Drift checks of the programmed route do not change its status
+	parentStatus := findRouteParentStatus(
+		acceptedRoute.Status.Parents,
+		resolvedData.gatewayDetails.gatewayClass.Spec.ControllerName,
+		resolvedData.matchedRef,
+	)
+	if programmingRequired && parentStatus != nil &&
+		programmingStarts(parentStatus.Conditions, RouteConditionProgrammed) {
+		if err = r.httpRouteModel.setProgramming(ctx, setRouteProgrammingParams{
+			httpRoute:    acceptedRoute,
+			gatewayClass: resolvedData.gatewayDetails.gatewayClass,
+			matchedRef:   resolvedData.matchedRef,
+		}); err != nil {
+			return false, fmt.Errorf("failed to set programming status: %w", err)
+		}
+	}
+
 	knownBackends, err := r.httpRouteModel.resolveBackendRefs(ctx, resolveBackendRefsParams{
 		httpRoute: *acceptedRoute,
 	})
 	if err != nil {
+		var statusErr httpRouteStatusError
+		if errors.As(err, &statusErr) {
+			rejectedRouteDetails := resolvedData
+			rejectedRouteDetails.httpRoute = *acceptedRoute
+			if rejectErr := r.httpRouteModel.setRejected(ctx, rejectedRouteDetails, statusErr); rejectErr != nil {
+				return false, fmt.Errorf("failed to reject route: %w", rejectErr)
+			}
+			return false, nil
+		}
 		return false, fmt.Errorf("failed to resolve backend refs: %w", err)
 	}
 
@@ -116,16 +164,70 @@ func (r *HTTPRouteController) reconcileResolvedRoute(
 		knownBackends:    knownBackends,
 	})
 	if err != nil {
+		var capacityErr routingPolicyCapacityError
+		if errors.As(err, &capacityErr) {
+			rejectedRouteDetails := resolvedData
+			rejectedRouteDetails.httpRoute = *acceptedRoute
+			if rejectErr := r.httpRouteModel.setRejected(ctx, rejectedRouteDetails, httpRouteStatusError{
+				conditionType: gatewayv1.RouteConditionAccepted,
+				reason:        routeReasonRoutingPolicyCapacityExceeded,
+				message:       capacityErr.Error(),
+			}); rejectErr != nil {
+				return false, fmt.Errorf("failed to reject route: %w", rejectErr)
+			}
+			return false, nil
+		}
+		var limitErr loadBalancerLimitError
+		if errors.As(err, &limitErr) {
+			rejectedRouteDetails := resolvedData
+			rejectedRouteDetails.httpRoute = *acceptedRoute
+			if rejectErr := r.httpRouteModel.setRejected(ctx, rejectedRouteDetails, httpRouteStatusError{
+				conditionType: gatewayv1.RouteConditionAccepted,
+				reason:        routeReasonLoadBalancerLimitExceeded,
+				message:       limitErr.Error(),
+			}); rejectErr != nil {
+				return false, fmt.Errorf("failed to reject route: %w", rejectErr)
+			}
+			return false, nil
+		}
+		if errors.Is(err, errUnsupportedMatch) ||
+			errors.Is(err, errUnsupportedFilter) ||
+			errors.Is(err, errInvalidRulePriority) {
+			rejectedRouteDetails := resolvedData
+			rejectedRouteDetails.httpRoute = *acceptedRoute
+			if rejectErr := r.httpRouteModel.setRejected(ctx, rejectedRouteDetails, httpRouteStatusError{
+				conditionType: gatewayv1.RouteConditionResolvedRefs,
+				reason:        gatewayv1.RouteReasonUnsupportedValue,
+				message:       err.Error(),
+			}); rejectErr != nil {
+				return false, fmt.Errorf("failed to reject route: %w", rejectErr)
+			}
+			return false, nil
+		}
+		if message, terminal := ociTerminalErrorMessage(err); terminal {
+			rejectedRouteDetails := resolvedData
+			rejectedRouteDetails.httpRoute = *acceptedRoute
+			if rejectErr := r.httpRouteModel.setRejected(ctx, rejectedRouteDetails, httpRouteStatusError{
+				conditionType: gatewayv1.RouteConditionAccepted,
+				reason:        routeReasonOCIRequestRejected,
+				message:       message,
+			}); rejectErr != nil {
+				return false, fmt.Errorf("failed to reject route: %w", rejectErr)
+			}
+			return false, nil
+		}
 		return false, fmt.Errorf("failed to program route: %w", err)
 	}
 
 	// Mark the route as programmed by setting the ResolvedRefs condition
 	if err = r.httpRouteModel.setProgrammed(ctx, setProgrammedParams{
-		gatewayClass:          resolvedData.gatewayDetails.gatewayClass,
-		gateway:               resolvedData.gatewayDetails.gateway,
-		httpRoute:             *acceptedRoute,
-		matchedRef:            resolvedData.matchedRef,
-		programmedPolicyRules: programResult.programmedPolicyRules,
+		gatewayClass:            resolvedData.gatewayDetails.gatewayClass,
+		gateway:                 resolvedData.gatewayDetails.gateway,
+		httpRoute:               *acceptedRoute,
+		matchedRef:              resolvedData.matchedRef,
+		naming:                  ociResourceNamingFromConfig(resolvedData.gatewayDetails.config),
+		programmedPolicyRules:   programResult.programmedPolicyRules,
+		partiallyInvalidMessage: programResult.partiallyInvalidMessage,
 	}); err != nil {
 		return false, fmt.Errorf("failed to set programmed status: %w", err)
 	}
@@ -138,8 +240,77 @@ func (r *HTTPRouteController) reconcileResolvedRoute(
 	return true, nil
 }
 
+// reconcilePaused maintains the Paused condition of the route parent. Returns true if
+// the route or its gateway is paused and the route should not be programmed.
+func (r *HTTPRouteController) reconcilePaused(
+	ctx context.Context,
+	resolvedData resolvedRouteDetails,
+) (bool, error) {
+	paused := isPaused(&resolvedData.httpRoute) || isPaused(&resolvedData.gatewayDetails.gateway)
+	parentStatus := findRouteParentStatus(
+		resolvedData.httpRoute.Status.Parents,
+		resolvedData.gatewayDetails.gatewayClass.Spec.ControllerName,
+		resolvedData.matchedRef,
+	)
+	if parentStatus != nil {
+		condition, changed := pausedConditionChange(parentStatus.Conditions, resolvedData.httpRoute.Generation, paused)
+		if changed {
+			if err := r.httpRouteModel.setPaused(ctx, setPausedParams{
+				httpRoute:    resolvedData.httpRoute,
+				gatewayClass: resolvedData.gatewayDetails.gatewayClass,
+				matchedRef:   resolvedData.matchedRef,
+				condition:    condition,
+			}); err != nil {
+				return false, fmt.Errorf("failed to set paused condition: %w", err)
+			}
+		}
+	}
+	if paused {
+		r.logger.InfoContext(ctx, "HTTPRoute programming is paused",
+			slog.String("httpRoute", resolvedData.httpRoute.Name),
+			slog.String("gateway", resolvedData.gatewayDetails.gateway.Name),
+		)
+	}
+	return paused, nil
+}
+
+// reportBackendsHealth refreshes the BackendsHealthy condition of the route parent.
+// Failures are only logged since the health is informational and will be retried
+// with the next health interval.
+func (r *HTTPRouteController) reportBackendsHealth(
+	ctx context.Context,
+	resolvedData resolvedRouteDetails,
+) {
+	health, err := r.httpBackendModel.routeBackendsHealth(ctx, routeBackendsHealthParams{
+		httpRoute: resolvedData.httpRoute,
+		config:    resolvedData.gatewayDetails.config,
+	})
+	if err == nil {
+		r.backendHealthMetrics.setRouteBackends(
+			resolvedData.httpRoute.Namespace,
+			resolvedData.httpRoute.Name,
+			resolvedData.gatewayDetails.gateway.Name,
+			health,
+		)
+		err = r.httpRouteModel.setBackendsHealth(ctx, setBackendsHealthParams{
+			httpRoute:    resolvedData.httpRoute,
+			gatewayClass: resolvedData.gatewayDetails.gatewayClass,
+			matchedRef:   resolvedData.matchedRef,
+			health:       health,
+		})
+	}
+	if err != nil {
+		r.logger.WarnContext(ctx, "Failed to report HTTPRoute backends health",
+			slog.String("httpRoute", resolvedData.httpRoute.Name),
+			slog.String("gateway", resolvedData.gatewayDetails.gateway.Name),
+			diag.ErrAttr(err),
+		)
+	}
+}
+
 func (r *HTTPRouteController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	r.logger.InfoContext(ctx, fmt.Sprintf("Processing reconciliation for HTTProute %s", req.NamespacedName))
+	r.programmingMetrics.observeChange(req.NamespacedName)
 
 	resolvedRequests, err := r.httpRouteModel.resolveRequest(ctx, req)
 	if err != nil {
@@ -149,31 +320,88 @@ func (r *HTTPRouteController) Reconcile(ctx context.Context, req reconcile.Reque
 		r.logger.InfoContext(ctx, "Ignoring irrelevant HTTPRoute route",
 			slog.String("httpRoute", req.NamespacedName.String()),
 		)
+		r.programmingMetrics.forget(req.NamespacedName)
 		return reconcile.Result{}, nil
 	}
 
+	result := driftRequeue(shortestRequeueInterval(r.driftInterval, r.backendHealthInterval))
+	// Lag is recorded once the route is programmed for all its gateways.
+	programmed := true
+	deleted := false
+
 	// Route may be attached to multiple gateways in theory, so we need to reconcile the route
 	// for each gateway separately.
 	for _, resolvedData := range resolvedRequests {
+		deleted = resolvedData.httpRoute.DeletionTimestamp != nil
+		gatewayCtx := ociRegionContext(ctx, resolvedData.gatewayDetails.config)
+		if delay, backingOff := r.configValidation.backoff(resolvedData.gatewayDetails.config); backingOff {
+			r.logger.DebugContext(gatewayCtx, "Backing off HTTPRoute of Gateway with invalid GatewayConfig",
+				slog.String("httpRoute", resolvedData.httpRoute.Name),
+				slog.String("gateway", resolvedData.gatewayDetails.gateway.Name),
+			)
+			result = configBackoffRequeue(result, delay)
+			programmed = false
+			continue
+		}
+		paused, pausedErr := r.reconcilePaused(gatewayCtx, resolvedData)
+		if pausedErr != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to reconcile gateway %s for route %s: %w",
+				resolvedData.gatewayDetails.gateway.Name, resolvedData.httpRoute.Name, pausedErr)
+		}
+		if paused {
+			if isPaused(&resolvedData.gatewayDetails.gateway) {
+				result = pausedGatewayRequeue(result)
+			}
+			programmed = false
+			continue
+		}
+
 		var syncEndpointsRequired bool
-		syncEndpointsRequired, err = r.reconcileResolvedRoute(ctx, resolvedData)
+		syncEndpointsRequired, err = r.reconcileResolvedRoute(gatewayCtx, resolvedData)
 		if err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to reconcile gateway %s for route %s: %w",
 				resolvedData.gatewayDetails.gateway.Name, resolvedData.httpRoute.Name, err)
 		}
 
 		if syncEndpointsRequired {
-			err = r.httpBackendModel.syncRouteEndpoints(ctx, syncRouteEndpointsParams{
+			var limitErr loadBalancerLimitError
+			err = r.httpBackendModel.syncRouteEndpoints(gatewayCtx, syncRouteEndpointsParams{
 				httpRoute: resolvedData.httpRoute,
 				config:    resolvedData.gatewayDetails.config,
 			})
-			if err != nil {
+			if errors.Is(err, errPodReadinessPending) {
+				r.logger.InfoContext(gatewayCtx, "Waiting for pod backends to become healthy",
+					slog.String("httpRoute", resolvedData.httpRoute.Name),
+					diag.ErrAttr(err),
+				)
+				result = podReadinessGateRequeue(result)
+				programmed = false
+			} else if errors.As(err, &limitErr) {
+				if rejectErr := r.httpRouteModel.setRejected(gatewayCtx, resolvedData, httpRouteStatusError{
+					conditionType: gatewayv1.RouteConditionAccepted,
+					reason:        routeReasonLoadBalancerLimitExceeded,
+					message:       limitErr.Error(),
+				}); rejectErr != nil {
+					return reconcile.Result{}, fmt.Errorf("failed to reject route: %w", rejectErr)
+				}
+				programmed = false
+			} else if err != nil {
 				return reconcile.Result{}, fmt.Errorf("failed to sync backend endpoints: %w", err)
 			}
+
+			if r.backendHealthInterval > 0 {
+				r.reportBackendsHealth(gatewayCtx, resolvedData)
+			}
 		}
 	}
 
 	r.logger.InfoContext(ctx, fmt.Sprintf("Reconciled HTTProute %s", req.NamespacedName))
+	switch {
+	case deleted:
+		r.programmingMetrics.forget(req.NamespacedName)
+	case programmed:
+		r.programmingMetrics.observeProgrammed(req.NamespacedName)
+	}
 
-	return driftRequeue(r.driftInterval), nil
+	return result, nil
 }
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -14,6 +15,7 @@ import (
 	types "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client" // Import client for ObjectKey
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/gemyago/oke-gateway-api/internal/diag"
 )
@@ -165,6 +167,94 @@ func TestHTTPRouteController(t *testing.T) {
 			assert.Equal(t, reconcile.Result{}, result)
 		})
 
+		t.Run("PausedRoute", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			controller := NewHTTPRouteController(deps)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: fake.Internet().Domain(),
+					Name:      fake.Lorem().Word(),
+				},
+			}
+
+			gatewayClass := gatewayv1.GatewayClass{
+				Spec: gatewayv1.GatewayClassSpec{ControllerName: ControllerClassName},
+			}
+			gateway := newRandomGateway()
+			matchedRef := gatewayv1.ParentReference{Name: gatewayv1.ObjectName(gateway.Name)}
+			httpRoute := makeRandomHTTPRoute()
+			httpRoute.Annotations = map[string]string{PausedAnnotation: "true"}
+			httpRoute.Status.Parents = []gatewayv1.RouteParentStatus{
+				{ParentRef: matchedRef, ControllerName: ControllerClassName},
+			}
+			resolvedData := resolvedRouteDetails{
+				httpRoute:  httpRoute,
+				matchedRef: matchedRef,
+				gatewayDetails: resolvedGatewayDetails{
+					gateway:      *gateway,
+					gatewayClass: gatewayClass,
+				},
+			}
+
+			mockModel, _ := deps.HTTPRouteModel.(*MockhttpRouteModel)
+			mockModel.EXPECT().resolveRequest(
+				t.Context(),
+				req,
+			).Return(map[types.NamespacedName]resolvedRouteDetails{
+				req.NamespacedName: resolvedData,
+			}, (error)(nil))
+
+			mockModel.EXPECT().setPaused(t.Context(), setPausedParams{
+				httpRoute:    httpRoute,
+				gatewayClass: gatewayClass,
+				matchedRef:   matchedRef,
+				condition: pausedCondition{
+					status:  metav1.ConditionTrue,
+					reason:  ReasonPaused,
+					message: fmt.Sprintf("Programming is paused with %s annotation", PausedAnnotation),
+				},
+			}).Return(nil).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{}, result)
+		})
+
+		t.Run("PausedGateway", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			controller := NewHTTPRouteController(deps)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: fake.Internet().Domain(),
+					Name:      fake.Lorem().Word(),
+				},
+			}
+
+			gateway := newRandomGateway()
+			gateway.Annotations = map[string]string{PausedAnnotation: "true"}
+
+			mockModel, _ := deps.HTTPRouteModel.(*MockhttpRouteModel)
+			mockModel.EXPECT().resolveRequest(
+				t.Context(),
+				req,
+			).Return(map[types.NamespacedName]resolvedRouteDetails{
+				req.NamespacedName: {
+					httpRoute:      makeRandomHTTPRoute(),
+					gatewayDetails: resolvedGatewayDetails{gateway: *gateway},
+				},
+			}, (error)(nil))
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{RequeueAfter: pausedGatewayRequeueInterval}, result)
+		})
+
 		t.Run("RelevantRouteDeleted", func(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
@@ -405,6 +495,313 @@ func TestHTTPRouteController(t *testing.T) {
 			assert.Equal(t, reconcile.Result{}, result)
 		})
 
+		t.Run("SetsProgrammingOfPreviouslyProgrammedRoute", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			controller := NewHTTPRouteController(deps)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: fake.Internet().Domain(),
+					Name:      fake.Lorem().Word(),
+				},
+			}
+
+			wantResolvedData := resolvedRouteDetails{
+				httpRoute: makeRandomHTTPRoute(),
+				gatewayDetails: resolvedGatewayDetails{
+					gateway: *newRandomGateway(),
+					config:  makeRandomGatewayConfig(),
+				},
+			}
+
+			mockModel, _ := deps.HTTPRouteModel.(*MockhttpRouteModel)
+			mockModel.EXPECT().resolveRequest(
+				t.Context(),
+				req,
+			).Return(map[types.NamespacedName]resolvedRouteDetails{
+				req.NamespacedName: wantResolvedData,
+			}, (error)(nil))
+
+			mockModel.EXPECT().isProgrammingRequired(wantResolvedData).Return(true, nil)
+
+			wantAcceptedRoute := makeRandomHTTPRoute()
+			wantAcceptedRoute.Status.Parents = []gatewayv1.RouteParentStatus{{
+				Conditions: []metav1.Condition{{
+					Type:   RouteConditionProgrammed,
+					Status: metav1.ConditionTrue,
+					Reason: RouteReasonProgrammed,
+				}},
+			}}
+			mockModel.EXPECT().acceptRoute(
+				t.Context(),
+				wantResolvedData,
+			).Return(&wantAcceptedRoute, nil)
+
+			mockModel.EXPECT().setProgramming(
+				t.Context(),
+				setRouteProgrammingParams{httpRoute: &wantAcceptedRoute},
+			).Return(nil).Once()
+
+			wantErr := errors.New(fake.Lorem().Sentence(10))
+			mockModel.EXPECT().resolveBackendRefs(
+				t.Context(),
+				resolveBackendRefsParams{
+					httpRoute: wantAcceptedRoute,
+				},
+			).Return(nil, wantErr)
+
+			_, err := controller.Reconcile(t.Context(), req)
+
+			require.ErrorIs(t, err, wantErr)
+		})
+
+		t.Run("ResolveBackendRefsStatusError", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			controller := NewHTTPRouteController(deps)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: fake.Internet().Domain(),
+					Name:      fake.Lorem().Word(),
+				},
+			}
+
+			wantResolvedData := resolvedRouteDetails{
+				httpRoute: makeRandomHTTPRoute(),
+				gatewayDetails: resolvedGatewayDetails{
+					gateway: *newRandomGateway(),
+					config:  makeRandomGatewayConfig(),
+				},
+			}
+
+			mockModel, _ := deps.HTTPRouteModel.(*MockhttpRouteModel)
+			mockModel.EXPECT().resolveRequest(t.Context(), req).
+				Return(map[types.NamespacedName]resolvedRouteDetails{
+					req.NamespacedName: wantResolvedData,
+				}, nil)
+			mockModel.EXPECT().isProgrammingRequired(wantResolvedData).Return(true, nil)
+
+			wantAcceptedRoute := makeRandomHTTPRoute()
+			mockModel.EXPECT().acceptRoute(t.Context(), wantResolvedData).Return(&wantAcceptedRoute, nil)
+			statusErr := httpRouteStatusError{
+				conditionType: gatewayv1.RouteConditionResolvedRefs,
+				reason:        gatewayv1.RouteReasonUnsupportedValue,
+				message:       fake.Lorem().Sentence(10),
+			}
+			mockModel.EXPECT().resolveBackendRefs(t.Context(), mock.Anything).Return(nil, statusErr)
+
+			wantRejectedDetails := wantResolvedData
+			wantRejectedDetails.httpRoute = wantAcceptedRoute
+			mockModel.EXPECT().setRejected(t.Context(), wantRejectedDetails, statusErr).Return(nil).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{}, result)
+		})
+
+		t.Run("ProgramRouteCapacityExceeded", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			controller := NewHTTPRouteController(deps)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: fake.Internet().Domain(),
+					Name:      fake.Lorem().Word(),
+				},
+			}
+
+			wantResolvedData := resolvedRouteDetails{
+				httpRoute: makeRandomHTTPRoute(),
+				gatewayDetails: resolvedGatewayDetails{
+					gateway: *newRandomGateway(),
+					config:  makeRandomGatewayConfig(),
+				},
+			}
+
+			mockModel, _ := deps.HTTPRouteModel.(*MockhttpRouteModel)
+			mockModel.EXPECT().resolveRequest(t.Context(), req).
+				Return(map[types.NamespacedName]resolvedRouteDetails{
+					req.NamespacedName: wantResolvedData,
+				}, nil)
+			mockModel.EXPECT().isProgrammingRequired(wantResolvedData).Return(true, nil)
+
+			wantAcceptedRoute := makeRandomHTTPRoute()
+			mockModel.EXPECT().acceptRoute(t.Context(), wantResolvedData).Return(&wantAcceptedRoute, nil)
+			mockModel.EXPECT().resolveBackendRefs(t.Context(), mock.Anything).Return(map[string]v1.Service{}, nil)
+
+			capacityErr := routingPolicyCapacityError{message: fake.Lorem().Sentence(10)}
+			mockModel.EXPECT().programRoute(t.Context(), mock.Anything).
+				Return(programRouteResult{}, fmt.Errorf("failed to commit routing policy: %w", capacityErr))
+
+			wantRejectedDetails := wantResolvedData
+			wantRejectedDetails.httpRoute = wantAcceptedRoute
+			mockModel.EXPECT().setRejected(t.Context(), wantRejectedDetails, httpRouteStatusError{
+				conditionType: gatewayv1.RouteConditionAccepted,
+				reason:        routeReasonRoutingPolicyCapacityExceeded,
+				message:       capacityErr.message,
+			}).Return(nil).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{}, result)
+		})
+
+		t.Run("ProgramRouteLoadBalancerLimitExceeded", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			controller := NewHTTPRouteController(deps)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: fake.Internet().Domain(),
+					Name:      fake.Lorem().Word(),
+				},
+			}
+
+			wantResolvedData := resolvedRouteDetails{
+				httpRoute: makeRandomHTTPRoute(),
+				gatewayDetails: resolvedGatewayDetails{
+					gateway: *newRandomGateway(),
+					config:  makeRandomGatewayConfig(),
+				},
+			}
+
+			mockModel, _ := deps.HTTPRouteModel.(*MockhttpRouteModel)
+			mockModel.EXPECT().resolveRequest(t.Context(), req).
+				Return(map[types.NamespacedName]resolvedRouteDetails{
+					req.NamespacedName: wantResolvedData,
+				}, nil)
+			mockModel.EXPECT().isProgrammingRequired(wantResolvedData).Return(true, nil)
+
+			wantAcceptedRoute := makeRandomHTTPRoute()
+			mockModel.EXPECT().acceptRoute(t.Context(), wantResolvedData).Return(&wantAcceptedRoute, nil)
+			mockModel.EXPECT().resolveBackendRefs(t.Context(), mock.Anything).Return(map[string]v1.Service{}, nil)
+
+			limitErr := loadBalancerLimitError{
+				loadBalancerID: wantResolvedData.gatewayDetails.config.Spec.LoadBalancerID,
+				resource:       "backend sets",
+				limit:          defaultLoadBalancerMaxBackendSets,
+			}
+			mockModel.EXPECT().programRoute(t.Context(), mock.Anything).
+				Return(programRouteResult{}, fmt.Errorf("failed to create backend set: %w", limitErr))
+
+			wantRejectedDetails := wantResolvedData
+			wantRejectedDetails.httpRoute = wantAcceptedRoute
+			mockModel.EXPECT().setRejected(t.Context(), wantRejectedDetails, httpRouteStatusError{
+				conditionType: gatewayv1.RouteConditionAccepted,
+				reason:        routeReasonLoadBalancerLimitExceeded,
+				message:       limitErr.Error(),
+			}).Return(nil).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{}, result)
+		})
+
+		t.Run("ProgramRouteUnsupportedMatch", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			controller := NewHTTPRouteController(deps)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: fake.Internet().Domain(),
+					Name:      fake.Lorem().Word(),
+				},
+			}
+
+			wantResolvedData := resolvedRouteDetails{
+				httpRoute: makeRandomHTTPRoute(),
+				gatewayDetails: resolvedGatewayDetails{
+					gateway: *newRandomGateway(),
+					config:  makeRandomGatewayConfig(),
+				},
+			}
+
+			mockModel, _ := deps.HTTPRouteModel.(*MockhttpRouteModel)
+			mockModel.EXPECT().resolveRequest(t.Context(), req).
+				Return(map[types.NamespacedName]resolvedRouteDetails{
+					req.NamespacedName: wantResolvedData,
+				}, nil)
+			mockModel.EXPECT().isProgrammingRequired(wantResolvedData).Return(true, nil)
+
+			wantAcceptedRoute := makeRandomHTTPRoute()
+			mockModel.EXPECT().acceptRoute(t.Context(), wantResolvedData).Return(&wantAcceptedRoute, nil)
+			mockModel.EXPECT().resolveBackendRefs(t.Context(), mock.Anything).Return(map[string]v1.Service{}, nil)
+
+			programErr := fmt.Errorf("failed to map matches: %w", errUnsupportedMatch)
+			mockModel.EXPECT().programRoute(t.Context(), mock.Anything).
+				Return(programRouteResult{}, programErr)
+
+			wantRejectedDetails := wantResolvedData
+			wantRejectedDetails.httpRoute = wantAcceptedRoute
+			mockModel.EXPECT().setRejected(t.Context(), wantRejectedDetails, httpRouteStatusError{
+				conditionType: gatewayv1.RouteConditionResolvedRefs,
+				reason:        gatewayv1.RouteReasonUnsupportedValue,
+				message:       programErr.Error(),
+			}).Return(nil).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{}, result)
+		})
+
+		t.Run("ProgramRouteInvalidRulePriority", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			controller := NewHTTPRouteController(deps)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: fake.Internet().Domain(),
+					Name:      fake.Lorem().Word(),
+				},
+			}
+
+			wantResolvedData := resolvedRouteDetails{
+				httpRoute: makeRandomHTTPRoute(),
+				gatewayDetails: resolvedGatewayDetails{
+					gateway: *newRandomGateway(),
+					config:  makeRandomGatewayConfig(),
+				},
+			}
+
+			mockModel, _ := deps.HTTPRouteModel.(*MockhttpRouteModel)
+			mockModel.EXPECT().resolveRequest(t.Context(), req).
+				Return(map[types.NamespacedName]resolvedRouteDetails{
+					req.NamespacedName: wantResolvedData,
+				}, nil)
+			mockModel.EXPECT().isProgrammingRequired(wantResolvedData).Return(true, nil)
+
+			wantAcceptedRoute := makeRandomHTTPRoute()
+			mockModel.EXPECT().acceptRoute(t.Context(), wantResolvedData).Return(&wantAcceptedRoute, nil)
+			mockModel.EXPECT().resolveBackendRefs(t.Context(), mock.Anything).Return(map[string]v1.Service{}, nil)
+
+			programErr := fmt.Errorf("failed to make routing rule: %w", errInvalidRulePriority)
+			mockModel.EXPECT().programRoute(t.Context(), mock.Anything).
+				Return(programRouteResult{}, programErr)
+
+			wantRejectedDetails := wantResolvedData
+			wantRejectedDetails.httpRoute = wantAcceptedRoute
+			mockModel.EXPECT().setRejected(t.Context(), wantRejectedDetails, httpRouteStatusError{
+				conditionType: gatewayv1.RouteConditionResolvedRefs,
+				reason:        gatewayv1.RouteReasonUnsupportedValue,
+				message:       programErr.Error(),
+			}).Return(nil).Once()
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{}, result)
+		})
+
 		t.Run("ProgrammingNotRequired", func(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
@@ -459,6 +856,104 @@ func TestHTTPRouteController(t *testing.T) {
 			assert.Equal(t, reconcile.Result{}, result)
 		})
 
+		t.Run("ReportsBackendsHealth", func(t *testing.T) {
+			fake := faker.New()
+			healthInterval := 3 * time.Minute
+			deps := newMockDeps(t)
+			deps.BackendHealthInterval = healthInterval
+			controller := NewHTTPRouteController(deps)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: fake.Internet().Domain(),
+					Name:      fake.Lorem().Word(),
+				},
+			}
+
+			wantResolvedData := resolvedRouteDetails{
+				httpRoute: makeRandomHTTPRoute(),
+				gatewayDetails: resolvedGatewayDetails{
+					gatewayClass: *newRandomGatewayClass(),
+					gateway:      *newRandomGateway(),
+					config:       makeRandomGatewayConfig(),
+				},
+				matchedRef: makeRandomParentRef(),
+			}
+			wantHealth := backendsHealthSummary{
+				total:    fake.IntBetween(2, 10),
+				critical: 1,
+			}
+
+			mockModel, _ := deps.HTTPRouteModel.(*MockhttpRouteModel)
+			mockModel.EXPECT().resolveRequest(t.Context(), req).Return(map[types.NamespacedName]resolvedRouteDetails{
+				req.NamespacedName: wantResolvedData,
+			}, nil)
+			wantAcceptedRoute := makeRandomHTTPRoute()
+			mockModel.EXPECT().acceptRoute(t.Context(), wantResolvedData).Return(&wantAcceptedRoute, nil)
+			mockModel.EXPECT().isProgrammingRequired(wantResolvedData).Return(false, nil)
+
+			mockBackendModel, _ := deps.HTTPBackendModel.(*MockhttpBackendModel)
+			mockBackendModel.EXPECT().syncRouteEndpoints(t.Context(), syncRouteEndpointsParams{
+				httpRoute: wantResolvedData.httpRoute,
+				config:    wantResolvedData.gatewayDetails.config,
+			}).Return(nil)
+			mockBackendModel.EXPECT().routeBackendsHealth(t.Context(), routeBackendsHealthParams{
+				httpRoute: wantResolvedData.httpRoute,
+				config:    wantResolvedData.gatewayDetails.config,
+			}).Return(wantHealth, nil)
+			mockModel.EXPECT().setBackendsHealth(t.Context(), setBackendsHealthParams{
+				httpRoute:    wantResolvedData.httpRoute,
+				gatewayClass: wantResolvedData.gatewayDetails.gatewayClass,
+				matchedRef:   wantResolvedData.matchedRef,
+				health:       wantHealth,
+			}).Return(nil)
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assertDriftRequeue(t, result, healthInterval)
+		})
+
+		t.Run("IgnoresBackendsHealthError", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			deps.BackendHealthInterval = 5 * time.Minute
+			controller := NewHTTPRouteController(deps)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: fake.Internet().Domain(),
+					Name:      fake.Lorem().Word(),
+				},
+			}
+
+			wantResolvedData := resolvedRouteDetails{
+				httpRoute: makeRandomHTTPRoute(),
+				gatewayDetails: resolvedGatewayDetails{
+					gateway: *newRandomGateway(),
+					config:  makeRandomGatewayConfig(),
+				},
+			}
+
+			mockModel, _ := deps.HTTPRouteModel.(*MockhttpRouteModel)
+			mockModel.EXPECT().resolveRequest(t.Context(), req).Return(map[types.NamespacedName]resolvedRouteDetails{
+				req.NamespacedName: wantResolvedData,
+			}, nil)
+			wantAcceptedRoute := makeRandomHTTPRoute()
+			mockModel.EXPECT().acceptRoute(t.Context(), wantResolvedData).Return(&wantAcceptedRoute, nil)
+			mockModel.EXPECT().isProgrammingRequired(wantResolvedData).Return(false, nil)
+
+			mockBackendModel, _ := deps.HTTPBackendModel.(*MockhttpBackendModel)
+			mockBackendModel.EXPECT().syncRouteEndpoints(t.Context(), mock.Anything).Return(nil)
+			mockBackendModel.EXPECT().routeBackendsHealth(t.Context(), mock.Anything).
+				Return(backendsHealthSummary{}, errors.New(fake.Lorem().Sentence(5)))
+
+			_, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			mockModel.AssertNotCalled(t, "setBackendsHealth", mock.Anything, mock.Anything)
+		})
+
 		t.Run("ProgrammingNotRequiredWithDriftInterval", func(t *testing.T) {
 			fake := faker.New()
 			driftInterval := 13 * time.Minute
@@ -720,6 +1215,51 @@ func TestHTTPRouteController(t *testing.T) {
 			assert.Equal(t, reconcile.Result{}, result)
 		})
 
+		t.Run("syncRouteEndpointsReadinessPending", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			controller := NewHTTPRouteController(deps)
+
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: fake.Internet().Domain(),
+					Name:      fake.Lorem().Word(),
+				},
+			}
+
+			wantResolvedData := resolvedRouteDetails{
+				httpRoute: makeRandomHTTPRoute(),
+				gatewayDetails: resolvedGatewayDetails{
+					gateway: *newRandomGateway(),
+					config:  makeRandomGatewayConfig(),
+				},
+			}
+
+			mockModel, _ := deps.HTTPRouteModel.(*MockhttpRouteModel)
+			mockModel.EXPECT().resolveRequest(
+				t.Context(),
+				req,
+			).Return(map[types.NamespacedName]resolvedRouteDetails{
+				req.NamespacedName: wantResolvedData,
+			}, (error)(nil))
+
+			mockModel.EXPECT().isProgrammingRequired(wantResolvedData).Return(false, nil)
+
+			mockBackendModel, _ := deps.HTTPBackendModel.(*MockhttpBackendModel)
+			mockBackendModel.EXPECT().syncRouteEndpoints(
+				t.Context(),
+				syncRouteEndpointsParams{
+					httpRoute: wantResolvedData.httpRoute,
+					config:    wantResolvedData.gatewayDetails.config,
+				},
+			).Return(fmt.Errorf("backend set %s: %w", fake.Lorem().Word(), errPodReadinessPending))
+
+			result, err := controller.Reconcile(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{RequeueAfter: podReadinessGateRequeueInterval}, result)
+		})
+
 		t.Run("deprovisionRouteError", func(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
@@ -0,0 +1,45 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/pkg/httpfilter"
+)
+
+var errUnsupportedFilter = errors.New("unsupported filter")
+
+// mapHTTPRouteExtensionRefFilters returns the routing condition fragments of the
+// ExtensionRef filters of the rule. Other filter types are not handled here.
+func mapHTTPRouteExtensionRefFilters(
+	ctx context.Context,
+	httpRoute gatewayv1.HTTPRoute,
+	ruleIndex int,
+) ([]string, error) {
+	rule := httpRoute.Spec.Rules[ruleIndex]
+	var conditions []string
+	for _, filter := range rule.Filters {
+		if filter.Type != gatewayv1.HTTPRouteFilterExtensionRef || filter.ExtensionRef == nil {
+			continue
+		}
+		ref := *filter.ExtensionRef
+		extension, found := httpfilter.Lookup(ref.Group, ref.Kind)
+		if !found {
+			return nil, fmt.Errorf("%w: no extension registered for ExtensionRef %s/%s %s",
+				errUnsupportedFilter, ref.Group, ref.Kind, ref.Name)
+		}
+		condition, err := extension.RoutingCondition(ctx, httpfilter.Request{
+			Route:     &httpRoute,
+			RuleIndex: ruleIndex,
+			Ref:       ref,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to map ExtensionRef %s/%s %s: %w", ref.Group, ref.Kind, ref.Name, err)
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
@@ -5,11 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sort"
 	"strings"
 
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/dig"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -17,7 +19,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -46,6 +47,9 @@ type programRouteParams struct {
 type programRouteResult struct {
 	// Names of the policy rules that were programmed for this particular route
 	programmedPolicyRules []string
+
+	// Message of the PartiallyInvalid condition, empty if all rules of the route are programmed
+	partiallyInvalidMessage string
 }
 
 type deprovisionRouteParams struct {
@@ -60,9 +64,34 @@ type setProgrammedParams struct {
 	gatewayClass gatewayv1.GatewayClass
 	gateway      gatewayv1.Gateway
 	matchedRef   gatewayv1.ParentReference
+	naming       ociResourceNaming
 
 	// List of load balancer policy rules that were programmed for this route
 	programmedPolicyRules []string
+
+	// Message of the PartiallyInvalid condition, the condition is removed if empty
+	partiallyInvalidMessage string
+}
+
+type setRouteProgrammingParams struct {
+	// Updated in place, so the route can be used for further status updates
+	httpRoute    *gatewayv1.HTTPRoute
+	gatewayClass gatewayv1.GatewayClass
+	matchedRef   gatewayv1.ParentReference
+}
+
+type setBackendsHealthParams struct {
+	httpRoute    gatewayv1.HTTPRoute
+	gatewayClass gatewayv1.GatewayClass
+	matchedRef   gatewayv1.ParentReference
+	health       backendsHealthSummary
+}
+
+type setPausedParams struct {
+	httpRoute    gatewayv1.HTTPRoute
+	gatewayClass gatewayv1.GatewayClass
+	matchedRef   gatewayv1.ParentReference
+	condition    pausedCondition
 }
 
 type programmedHTTPRoutePolicyRule struct {
@@ -79,6 +108,32 @@ const (
 
 const routeReasonConflicted gatewayv1.RouteConditionReason = "Conflicted"
 
+// routeReasonUnsupportedProtocol is used when none of the listeners matched by the parentRef
+// can serve the route, e.g. TLS passthrough listeners or HTTPS listeners without certificates.
+const routeReasonUnsupportedProtocol gatewayv1.RouteConditionReason = "UnsupportedProtocol"
+
+// routeReasonRoutingPolicyCapacityExceeded is used when the route rules do not fit
+// into the OCI routing policy limits of the listener.
+const routeReasonRoutingPolicyCapacityExceeded gatewayv1.RouteConditionReason = "RoutingPolicyCapacityExceeded"
+
+// routeReasonOCIRequestRejected is used when OCI rejects programming of the route with a
+// terminal error, e.g. a service limit is exceeded. Such routes are not retried until changed.
+const routeReasonOCIRequestRejected gatewayv1.RouteConditionReason = "OCIRequestRejected"
+
+// routeReasonLoadBalancerLimitExceeded is used when backends of the route do not fit
+// into the limits of the load balancer shape.
+const routeReasonLoadBalancerLimitExceeded gatewayv1.RouteConditionReason = "LoadBalancerLimitExceeded"
+
+type httpRouteStatusError struct {
+	conditionType gatewayv1.RouteConditionType
+	reason        gatewayv1.RouteConditionReason
+	message       string
+}
+
+func (e httpRouteStatusError) Error() string {
+	return e.message
+}
+
 type l7RouteIdentity struct {
 	kind              l7RouteKind
 	namespace         string
@@ -112,10 +167,19 @@ type rejectL7RouteParams struct {
 	parentStatuses *[]gatewayv1.RouteParentStatus
 	gatewayClass   gatewayv1.GatewayClass
 	matchedRef     gatewayv1.ParentReference
+	reason         gatewayv1.RouteConditionReason
 	message        string
 	routeKind      string
 }
 
+// l7RouteUnattachedParent is a resolved parent Gateway the route can not attach to.
+type l7RouteUnattachedParent struct {
+	gatewayDetails resolvedGatewayDetails
+	matchedRef     gatewayv1.ParentReference
+	reason         gatewayv1.RouteConditionReason
+	message        string
+}
+
 type programL7RoutePolicyParams struct {
 	loadBalancerID      string
 	gateway             gatewayv1.Gateway
@@ -124,14 +188,22 @@ type programL7RoutePolicyParams struct {
 	routeNamespace      string
 	backendRefs         []gatewayv1.BackendRef
 	knownBackends       map[string]v1.Service
+	externalBackends    map[string]types.OkeExternalBackend
 	matchedListeners    []gatewayv1.Listener
 	previousPolicyRules []programmedHTTPRoutePolicyRule
 	ruleCount           int
-	makeRoutingRule     func(ruleIndex int) (loadbalancer.RoutingRule, error)
+	makeRoutingRules    func(ruleIndex int) ([]loadbalancer.RoutingRule, error)
 	backendTLSPolicy    backendTLSPolicyModel
 	backendTLSDisabled  bool
 }
 
+type setL7RouteProgrammingParams struct {
+	resource       client.Object
+	parentStatuses []gatewayv1.RouteParentStatus
+	gatewayClass   gatewayv1.GatewayClass
+	matchedRef     gatewayv1.ParentReference
+}
+
 type setL7RouteProgrammedParams struct {
 	resource              client.Object
 	parentStatuses        []gatewayv1.RouteParentStatus
@@ -139,10 +211,14 @@ type setL7RouteProgrammedParams struct {
 	gateway               gatewayv1.Gateway
 	matchedRef            gatewayv1.ParentReference
 	programmedPolicyRules []string
+	backendSets           []string
+	routeKind             string
 	programmingAnnotation string
 	programmingRevision   string
-	policyRulesAnnotation string
 	finalizer             string
+
+	// Message of the PartiallyInvalid condition, the condition is removed if empty
+	partiallyInvalidMessage string
 }
 
 // httpRouteModel defines the interface for managing HTTPRoute resources.
@@ -187,11 +263,39 @@ type httpRouteModel interface {
 		params deprovisionRouteParams,
 	) error
 
+	// setRejected sets the route parent condition to False using the status error details.
+	setRejected(
+		ctx context.Context,
+		routeDetails resolvedRouteDetails,
+		statusErr httpRouteStatusError,
+	) error
+
+	// setProgramming reports that programming of the route changes has started
+	// by setting the Programmed condition of the route parent to Unknown.
+	setProgramming(
+		ctx context.Context,
+		params setRouteProgrammingParams,
+	) error
+
 	// setProgrammed marks the route as successfully programmed by updating its status.
 	setProgrammed(
 		ctx context.Context,
 		params setProgrammedParams,
 	) error
+
+	// setBackendsHealth reports the backends health on the matched route parent status.
+	// The route is re-read since earlier status updates make the given copy stale.
+	setBackendsHealth(
+		ctx context.Context,
+		params setBackendsHealthParams,
+	) error
+
+	// setPaused reports the Paused condition on the matched route parent status.
+	// The route is re-read since earlier status updates make the given copy stale.
+	setPaused(
+		ctx context.Context,
+		params setPausedParams,
+	) error
 }
 
 // parentRefSameTarget checks if two parent references target the same resource.
@@ -250,6 +354,115 @@ func checkL7RouteConflict(ctx context.Context, params checkL7RouteConflictParams
 	return winner, conflicted, nil
 }
 
+// httpRouteListenerIncompatibility returns why HTTPRoutes can not attach to the listener,
+// or an empty string if they can.
+func httpRouteListenerIncompatibility(listener gatewayv1.Listener) string {
+	switch listener.Protocol {
+	case gatewayv1.TLSProtocolType, gatewayv1.TCPProtocolType, gatewayv1.UDPProtocolType:
+		return fmt.Sprintf("listener %s has %s protocol", listener.Name, listener.Protocol)
+	case gatewayv1.HTTPSProtocolType:
+		if listener.TLS == nil || len(listener.TLS.CertificateRefs) == 0 {
+			return fmt.Sprintf("HTTPS listener %s has no certificateRefs", listener.Name)
+		}
+	case gatewayv1.HTTPProtocolType:
+	}
+	return ""
+}
+
+// httpRouteCompatibleListeners drops the matched listeners HTTPRoutes can not attach to.
+// If none of the matched listeners is compatible, the reason and the message of the rejection
+// name the incompatible listeners.
+func httpRouteCompatibleListeners(
+	gateway gatewayv1.Gateway,
+	matchedListeners []gatewayv1.Listener,
+) ([]gatewayv1.Listener, gatewayv1.RouteConditionReason, string) {
+	var compatible []gatewayv1.Listener
+	var incompatibilities []string
+	for _, listener := range matchedListeners {
+		if incompatibility := httpRouteListenerIncompatibility(listener); incompatibility != "" {
+			incompatibilities = append(incompatibilities, incompatibility)
+			continue
+		}
+		compatible = append(compatible, listener)
+	}
+	if len(incompatibilities) == 0 {
+		return matchedListeners, "", ""
+	}
+	if len(compatible) > 0 {
+		return compatible, "", ""
+	}
+	return nil, routeReasonUnsupportedProtocol, fmt.Sprintf(
+		"Gateway %s/%s has no listeners compatible with HTTPRoute: %s",
+		gateway.Namespace, gateway.Name, strings.Join(incompatibilities, ", "),
+	)
+}
+
+// l7RouteUnattachedReason returns the reason the route can not attach to the listeners
+// matched by the parentRef. The reason is empty if the route attaches to at least one listener.
+func l7RouteUnattachedReason(
+	gateway gatewayv1.Gateway,
+	parentRef gatewayv1.ParentReference,
+	matchedListeners []gatewayv1.Listener,
+	routeHostnames []gatewayv1.Hostname,
+) (gatewayv1.RouteConditionReason, string) {
+	if len(matchedListeners) == 0 {
+		if parentRef.SectionName != nil {
+			return gatewayv1.RouteReasonNoMatchingParent, fmt.Sprintf(
+				"Gateway %s/%s has no compatible listener named %s",
+				gateway.Namespace, gateway.Name, *parentRef.SectionName,
+			)
+		}
+		return gatewayv1.RouteReasonNoMatchingParent, fmt.Sprintf(
+			"Gateway %s/%s has no compatible listeners",
+			gateway.Namespace, gateway.Name,
+		)
+	}
+	hostnameMatched := lo.SomeBy(matchedListeners, func(listener gatewayv1.Listener) bool {
+		return len(l7RouteHostnamesForListener(routeHostnames, listener)) > 0
+	})
+	if !hostnameMatched {
+		return gatewayv1.RouteReasonNoMatchingListenerHostname, fmt.Sprintf(
+			"Route hostnames do not match hostnames of Gateway %s/%s listeners",
+			gateway.Namespace, gateway.Name,
+		)
+	}
+	return "", ""
+}
+
+// rejectL7RouteUnattachedParents sets Accepted=False parent status for each unattached parent.
+// Parents are expected to be excluded if any other parentRef of the route attaches to the same Gateway.
+func rejectL7RouteUnattachedParents(
+	ctx context.Context,
+	k8sClient k8sClient,
+	resource client.Object,
+	parentStatuses *[]gatewayv1.RouteParentStatus,
+	routeKind string,
+	unattachedParents map[apitypes.NamespacedName]l7RouteUnattachedParent,
+) error {
+	if resource.GetDeletionTimestamp() != nil {
+		return nil
+	}
+	parentNames := lo.Keys(unattachedParents)
+	sort.Slice(parentNames, func(i, j int) bool {
+		return parentNames[i].String() < parentNames[j].String()
+	})
+	for _, parentName := range parentNames {
+		parent := unattachedParents[parentName]
+		if err := rejectL7Route(ctx, k8sClient, rejectL7RouteParams{
+			resource:       resource,
+			parentStatuses: parentStatuses,
+			gatewayClass:   parent.gatewayDetails.gatewayClass,
+			matchedRef:     parent.matchedRef,
+			reason:         parent.reason,
+			message:        parent.message,
+			routeKind:      routeKind,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func rejectL7Route(ctx context.Context, k8sClient k8sClient, params rejectL7RouteParams) error {
 	parentStatus, parentStatusIndex, found := lo.FindIndexOf(
 		*params.parentStatuses,
@@ -258,7 +471,16 @@ func rejectL7Route(ctx context.Context, k8sClient k8sClient, params rejectL7Rout
 				parentRefSameTarget(status.ParentRef, params.matchedRef)
 		},
 	)
-	if !found {
+	if found {
+		existingCondition := meta.FindStatusCondition(parentStatus.Conditions, string(gatewayv1.RouteConditionAccepted))
+		if existingCondition != nil &&
+			existingCondition.Status == metav1.ConditionFalse &&
+			existingCondition.Reason == string(params.reason) &&
+			existingCondition.Message == params.message &&
+			existingCondition.ObservedGeneration == params.resource.GetGeneration() {
+			return nil
+		}
+	} else {
 		parentStatus = gatewayv1.RouteParentStatus{
 			ParentRef:      makeTargetOnlyParentRef(params.matchedRef),
 			ControllerName: params.gatewayClass.Spec.ControllerName,
@@ -268,7 +490,7 @@ func rejectL7Route(ctx context.Context, k8sClient k8sClient, params rejectL7Rout
 	meta.SetStatusCondition(&parentStatus.Conditions, metav1.Condition{
 		Type:               string(gatewayv1.RouteConditionAccepted),
 		Status:             metav1.ConditionFalse,
-		Reason:             string(routeReasonConflicted),
+		Reason:             string(params.reason),
 		ObservedGeneration: params.resource.GetGeneration(),
 		LastTransitionTime: metav1.Now(),
 		Message:            params.message,
@@ -295,10 +517,11 @@ func removeL7RoutePolicyRules(
 	ctx context.Context,
 	ociLoadBalancerModel ociLoadBalancerModel,
 	loadBalancerID string,
+	namePrefix string,
+	naming ociResourceNaming,
 	matchedListeners []gatewayv1.Listener,
-	programmedPolicyRulesAnnotation string,
+	previousRules []programmedHTTPRoutePolicyRule,
 ) error {
-	previousRules := parseProgrammedHTTPRoutePolicyRules(programmedPolicyRulesAnnotation)
 	prevRulesByListener := previousPolicyRulesByListener(previousRules, matchedListeners)
 	listenerNames := lo.Keys(prevRulesByListener)
 	sort.Strings(listenerNames)
@@ -306,7 +529,7 @@ func removeL7RoutePolicyRules(
 	for _, listenerName := range listenerNames {
 		err := ociLoadBalancerModel.commitRoutingPolicy(ctx, commitRoutingPolicyParams{
 			loadBalancerID:  loadBalancerID,
-			listenerName:    listenerName,
+			listenerName:    naming.listenerName(namePrefix, gatewayv1.SectionName(listenerName)),
 			policyRules:     []loadbalancer.RoutingRule{},
 			prevPolicyRules: prevRulesByListener[listenerName],
 		})
@@ -470,6 +693,20 @@ func backendRefName(
 	return backendObjectRefName(backendRef.BackendObjectReference, defaultNamespace)
 }
 
+// unsupportedBackendServiceMessage returns the reason why the service can not be
+// used as a load balancer backend. ExternalName services have no endpoints and
+// the Gateway API recommends to not follow them since they may point outside the cluster.
+func unsupportedBackendServiceMessage(service v1.Service, fullName apitypes.NamespacedName) (string, bool) {
+	if service.Spec.Type == v1.ServiceTypeExternalName {
+		return fmt.Sprintf(
+			"backendRef service %s of type ExternalName (%s) is not supported",
+			fullName.String(),
+			service.Spec.ExternalName,
+		), true
+	}
+	return "", false
+}
+
 func backendObjectRefName(
 	backendRef gatewayv1.BackendObjectReference,
 	defaultNamespace string,
@@ -483,6 +720,19 @@ func backendObjectRefName(
 	}
 }
 
+// l7RouteBackendSetNames returns sorted unique names of backend sets referenced by the route.
+func l7RouteBackendSetNames(
+	naming ociResourceNaming,
+	routeNamespace string,
+	backendRefs []gatewayv1.BackendRef,
+) []string {
+	names := lo.Uniq(lo.Map(backendRefs, func(backendRef gatewayv1.BackendRef, _ int) string {
+		return ociBackendSetNameFromBackendObjectRef(naming, routeNamespace, backendRef.BackendObjectReference)
+	}))
+	sort.Strings(names)
+	return names
+}
+
 func l7BackendRefKey(backendRef gatewayv1.BackendRef, defaultNamespace string) string {
 	refName := backendObjectRefName(backendRef.BackendObjectReference, defaultNamespace)
 	port := lo.FromPtr(backendRef.BackendObjectReference.Port)
@@ -494,7 +744,10 @@ func parseProgrammedHTTPRoutePolicyRules(annotationValue string) []programmedHTT
 		return nil
 	}
 
-	entries := strings.Split(annotationValue, ",")
+	return parseProgrammedPolicyRuleEntries(strings.Split(annotationValue, ","))
+}
+
+func parseProgrammedPolicyRuleEntries(entries []string) []programmedHTTPRoutePolicyRule {
 	rules := make([]programmedHTTPRoutePolicyRule, 0, len(entries))
 	for _, entry := range entries {
 		entry = strings.TrimSpace(entry)
@@ -560,20 +813,44 @@ func previousPolicyRulesByListener(
 	return previousByListener
 }
 
+// deprovisionPolicyRulesByListener groups previous rules of the route being deprovisioned by
+// listener. Rules recorded with the listener are removed from that listener even if the route
+// is attached to other listeners now. Legacy rules recorded without the listener may have been
+// committed to any listener of the gateway, so they are removed from all of them.
+func deprovisionPolicyRulesByListener(
+	previousRules []programmedHTTPRoutePolicyRule,
+	gateway gatewayv1.Gateway,
+	matchedListeners []gatewayv1.Listener,
+) map[string][]string {
+	listeners := slices.Clone(matchedListeners)
+	for _, listener := range gateway.Spec.Listeners {
+		// TLS listeners are programmed by TLSRoutes and have no routing policy
+		if listener.Protocol != gatewayv1.TLSProtocolType {
+			listeners = append(listeners, listener)
+		}
+	}
+	listeners = lo.UniqBy(listeners, func(listener gatewayv1.Listener) gatewayv1.SectionName {
+		return listener.Name
+	})
+	return previousPolicyRulesByListener(previousRules, listeners)
+}
+
 type httpRouteModelImpl struct {
 	client               k8sClient
 	logger               *slog.Logger
 	gatewayModel         gatewayModel
 	resourcesModel       resourcesModel
+	programmingState     programmingStateModel
 	ociLoadBalancerModel ociLoadBalancerModel
 	backendTLSPolicy     backendTLSPolicyModel
 	backendTLSDisabled   bool
+	admissionHook        routeAdmissionHook
 }
 
 // resolveRouteParentRefData attempts to resolve a single parent reference for an HTTPRoute.
 // It returns the resolved gateway details, the matched listeners based on SectionName (if any),
-// and an error if resolution fails. If the gateway is not found or no listeners match the
-// SectionName, it returns nil details/listeners without an error.
+// and an error if resolution fails. If the gateway is not found, it returns nil details/listeners
+// without an error. If no listeners match the SectionName, it returns the details with no listeners.
 func (m *httpRouteModelImpl) resolveRouteParentRefData(
 	ctx context.Context,
 	httpRoute gatewayv1.HTTPRoute,
@@ -626,7 +903,7 @@ func (m *httpRouteModelImpl) resolveRouteParentRefData(
 				slog.String("parentName", parentName.String()),
 				slog.String("sectionName", string(sectionName)),
 			)
-			return nil, nil, nil
+			return &resolvedGatewayData, nil, nil
 		}
 
 		m.logger.DebugContext(ctx, "Gateway resolved with matching section name listener(s)",
@@ -702,6 +979,7 @@ func (m *httpRouteModelImpl) resolveRequest(
 	}
 
 	results := make(map[apitypes.NamespacedName]resolvedRouteDetails)
+	unattachedParents := make(map[apitypes.NamespacedName]l7RouteUnattachedParent)
 
 	for _, parentRef := range httpRoute.Spec.ParentRefs {
 		resolvedGatewayData, matchedListeners, err := m.resolveRouteParentRefData(
@@ -713,16 +991,73 @@ func (m *httpRouteModelImpl) resolveRequest(
 		if err != nil {
 			return nil, err
 		}
+		if resolvedGatewayData == nil {
+			continue
+		}
 
-		if resolvedGatewayData != nil {
-			m.aggregateRouteParentRefData(ctx,
-				results,
-				httpRoute,
-				*resolvedGatewayData,
-				makeTargetOnlyParentRef(parentRef),
+		matchedListeners, reason, message := httpRouteCompatibleListeners(
+			resolvedGatewayData.gateway,
+			matchedListeners,
+		)
+		if reason == "" {
+			reason, message = l7RouteUnattachedReason(
+				resolvedGatewayData.gateway,
+				parentRef,
 				matchedListeners,
+				httpRoute.Spec.Hostnames,
 			)
 		}
+		if reason != "" {
+			unattachedParents[client.ObjectKeyFromObject(&resolvedGatewayData.gateway)] = l7RouteUnattachedParent{
+				gatewayDetails: *resolvedGatewayData,
+				matchedRef:     makeTargetOnlyParentRef(parentRef),
+				reason:         reason,
+				message:        message,
+			}
+			continue
+		}
+
+		m.aggregateRouteParentRefData(ctx,
+			results,
+			httpRoute,
+			*resolvedGatewayData,
+			makeTargetOnlyParentRef(parentRef),
+			matchedListeners,
+		)
+	}
+
+	for parentName, result := range results {
+		config, message := l7RouteParentLoadBalancerConfig(result.gatewayDetails.config, result.matchedListeners)
+		if message != "" {
+			unattachedParents[parentName] = l7RouteUnattachedParent{
+				gatewayDetails: result.gatewayDetails,
+				matchedRef:     result.matchedRef,
+				reason:         gatewayv1.RouteReasonUnsupportedValue,
+				message:        message,
+			}
+			delete(results, parentName)
+			continue
+		}
+		result.gatewayDetails.config = config
+		results[parentName] = result
+		delete(unattachedParents, parentName)
+	}
+	if err := rejectL7RouteUnattachedParents(
+		ctx,
+		m.client,
+		&httpRoute,
+		&httpRoute.Status.Parents,
+		"HTTPRoute",
+		unattachedParents,
+	); err != nil {
+		return nil, err
+	}
+	if len(unattachedParents) > 0 {
+		// Rejected status update bumps the route resource version
+		for parentName, result := range results {
+			result.httpRoute = httpRoute
+			results[parentName] = result
+		}
 	}
 
 	if len(results) == 0 {
@@ -762,7 +1097,15 @@ func (m *httpRouteModelImpl) acceptRoute(
 		return nil, err
 	}
 	if conflicted {
-		return nil, m.rejectRoute(ctx, routeDetails, l7RouteConflictMessage(winner))
+		return nil, m.rejectRoute(ctx, routeDetails, routeReasonConflicted, l7RouteConflictMessage(winner))
+	}
+
+	winner, conflicted, err = m.checkRulePriorityConflict(ctx, routeDetails)
+	if err != nil {
+		return nil, err
+	}
+	if conflicted {
+		return nil, m.rejectRoute(ctx, routeDetails, routeReasonConflicted, httpRouteRulePriorityConflictMessage(winner))
 	}
 
 	parentStatus, parentStatusIndex, found := lo.FindIndexOf(
@@ -795,6 +1138,15 @@ func (m *httpRouteModelImpl) acceptRoute(
 		}
 	}
 
+	admissionMessage, err := admitL7Route(ctx, m.admissionHook, "HTTPRoute",
+		&routeDetails.httpRoute, routeDetails.gatewayDetails.gateway, routeDetails.matchedListeners)
+	if err != nil {
+		return nil, err
+	}
+	if admissionMessage != "" {
+		return nil, m.rejectRoute(ctx, routeDetails, routeReasonAdmissionDenied, admissionMessage)
+	}
+
 	httpRoute := routeDetails.httpRoute.DeepCopy()
 	meta.SetStatusCondition(&parentStatus.Conditions, metav1.Condition{
 		Type:               string(gatewayv1.RouteConditionAccepted),
@@ -848,6 +1200,63 @@ func (m *httpRouteModelImpl) listGRPCRouteConflictCandidates(ctx context.Context
 	}), nil
 }
 
+// checkRulePriorityConflict checks if an older HTTPRoute with the same explicit rule priority
+// shares a listener hostname with the route. Order of such rules within the listener policy
+// would not follow the priority, so only the older route is accepted.
+func (m *httpRouteModelImpl) checkRulePriorityConflict(
+	ctx context.Context,
+	routeDetails resolvedRouteDetails,
+) (l7RouteCandidate, bool, error) {
+	priority := httpRouteRulePriorityOrDefault(routeDetails.httpRoute)
+	if priority == 0 {
+		return l7RouteCandidate{}, false, nil
+	}
+	return checkL7RouteConflict(ctx, checkL7RouteConflictParams{
+		gateway:               routeDetails.gatewayDetails.gateway,
+		matchedListeners:      routeDetails.matchedListeners,
+		current:               httpRouteConflictCandidate(routeDetails.httpRoute),
+		oppositeRouteListName: "HTTPRoutes",
+		listOppositeRoutes: func(ctx context.Context) ([]l7RouteCandidate, error) {
+			var httpRoutes gatewayv1.HTTPRouteList
+			if listErr := m.client.List(ctx, &httpRoutes); listErr != nil {
+				return nil, listErr
+			}
+			return lo.FilterMap(httpRoutes.Items, func(route gatewayv1.HTTPRoute, _ int) (l7RouteCandidate, bool) {
+				if route.DeletionTimestamp != nil ||
+					(route.Namespace == routeDetails.httpRoute.Namespace && route.Name == routeDetails.httpRoute.Name) {
+					return l7RouteCandidate{}, false
+				}
+				if httpRouteRulePriorityOrDefault(route) != priority {
+					return l7RouteCandidate{}, false
+				}
+				return httpRouteConflictCandidate(route), true
+			}), nil
+		},
+	})
+}
+
+func httpRouteConflictCandidate(route gatewayv1.HTTPRoute) l7RouteCandidate {
+	return l7RouteCandidate{
+		identity: l7RouteIdentity{
+			kind:              l7HTTPRouteKind,
+			namespace:         route.Namespace,
+			name:              route.Name,
+			creationTimestamp: route.CreationTimestamp,
+		},
+		parentRefs: route.Spec.ParentRefs,
+		hostnames:  route.Spec.Hostnames,
+	}
+}
+
+func httpRouteRulePriorityConflictMessage(winner l7RouteCandidate) string {
+	return fmt.Sprintf(
+		"Route rule priority conflicts with %s %s/%s on an overlapping listener hostname",
+		winner.identity.kind,
+		winner.identity.namespace,
+		winner.identity.name,
+	)
+}
+
 func l7RouteConflictMessage(winner l7RouteCandidate) string {
 	return fmt.Sprintf(
 		"Route conflicts with %s %s/%s on an overlapping listener hostname",
@@ -860,19 +1269,19 @@ func l7RouteConflictMessage(winner l7RouteCandidate) string {
 func (m *httpRouteModelImpl) rejectRoute(
 	ctx context.Context,
 	routeDetails resolvedRouteDetails,
+	reason gatewayv1.RouteConditionReason,
 	message string,
 ) error {
 	httpRoute := routeDetails.httpRoute.DeepCopy()
-	if programmedPolicyRulesAnnotation, ok := httpRoute.Annotations[HTTPRouteProgrammedPolicyRulesAnnotation]; ok {
-		if err := removeL7RoutePolicyRules(
-			ctx,
-			m.ociLoadBalancerModel,
-			routeDetails.gatewayDetails.config.Spec.LoadBalancerID,
-			routeDetails.matchedListeners,
-			programmedPolicyRulesAnnotation,
-		); err != nil {
-			return fmt.Errorf("failed to remove rejected HTTPRoute policy rules: %w", err)
-		}
+	if err := removeRejectedL7RoutePolicyRules(ctx, m.ociLoadBalancerModel, m.programmingState, l7RouteStateParams{
+		gateway:               routeDetails.gatewayDetails.gateway,
+		config:                routeDetails.gatewayDetails.config,
+		matchedListeners:      routeDetails.matchedListeners,
+		route:                 httpRoute,
+		routeKind:             "HTTPRoute",
+		policyRulesAnnotation: HTTPRouteProgrammedPolicyRulesAnnotation,
+	}); err != nil {
+		return fmt.Errorf("failed to remove rejected HTTPRoute policy rules: %w", err)
 	}
 
 	return rejectL7Route(ctx, m.client, rejectL7RouteParams{
@@ -880,6 +1289,7 @@ func (m *httpRouteModelImpl) rejectRoute(
 		parentStatuses: &httpRoute.Status.Parents,
 		gatewayClass:   routeDetails.gatewayDetails.gatewayClass,
 		matchedRef:     routeDetails.matchedRef,
+		reason:         reason,
 		message:        message,
 		routeKind:      "HTTPRoute",
 	})
@@ -893,11 +1303,32 @@ func (m *httpRouteModelImpl) resolveBackendRefs(
 	for _, rule := range params.httpRoute.Spec.Rules {
 		for _, backendRef := range rule.BackendRefs {
 			fullName := backendRefName(backendRef, params.httpRoute.Namespace)
+			if isExternalBackendRef(backendRef.BackendObjectReference) {
+				reason, message, err := checkExternalBackendRef(ctx, m.client, params.httpRoute.Namespace, fullName)
+				if err != nil {
+					return nil, err
+				}
+				if reason != "" {
+					return nil, httpRouteStatusError{
+						conditionType: gatewayv1.RouteConditionResolvedRefs,
+						reason:        reason,
+						message:       message,
+					}
+				}
+				continue
+			}
 
 			var service v1.Service
 			if err := m.client.Get(ctx, fullName, &service); err != nil {
 				return nil, fmt.Errorf("failed to get service %s: %w", fullName.String(), err)
 			}
+			if message, unsupported := unsupportedBackendServiceMessage(service, fullName); unsupported {
+				return nil, httpRouteStatusError{
+					conditionType: gatewayv1.RouteConditionResolvedRefs,
+					reason:        gatewayv1.RouteReasonUnsupportedValue,
+					message:       message,
+				}
+			}
 
 			m.logger.DebugContext(ctx, "Backend ref resolved",
 				slog.String("fullName", fullName.String()),
@@ -926,6 +1357,13 @@ func programL7RoutePolicy(
 		if _, ok := processedBackendRefs[key]; ok {
 			continue
 		}
+		if isExternalBackendRef(backendRef.BackendObjectReference) {
+			if err := reconcileL7ExternalBackendSet(ctx, ociLoadBalancerModel, params, backendRef); err != nil {
+				return nil, err
+			}
+			processedBackendRefs[key] = struct{}{}
+			continue
+		}
 		serviceName := backendObjectRefName(backendRef.BackendObjectReference, params.routeNamespace).String()
 		service, ok := params.knownBackends[serviceName]
 		if !ok {
@@ -936,12 +1374,14 @@ func programL7RoutePolicy(
 			return nil, fmt.Errorf("failed to resolve BackendTLSPolicy for service %s: %w", key, err)
 		}
 		err = ociLoadBalancerModel.reconcileBackendSet(ctx, reconcileBackendSetParams{
-			loadBalancerID:  params.loadBalancerID,
-			service:         service,
-			routeNS:         params.routeNamespace,
-			backendRef:      backendRef,
-			sslConfig:       backendSSLConfig,
-			manageSSLConfig: manageSSLConfig,
+			loadBalancerID:   params.loadBalancerID,
+			service:          service,
+			routeNS:          params.routeNamespace,
+			backendRef:       backendRef,
+			sslConfig:        backendSSLConfig,
+			manageSSLConfig:  manageSSLConfig,
+			naming:           ociResourceNamingFromConfig(params.config),
+			nodePortBackends: params.config.Spec.BackendMode == backendModeNodePort,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to reconcile backend set for service %s: %w", key, err)
@@ -952,14 +1392,18 @@ func programL7RoutePolicy(
 	policyRules := make([]loadbalancer.RoutingRule, 0, params.ruleCount)
 	policyRuleNames := make([]string, 0, params.ruleCount)
 	for ruleIndex := range params.ruleCount {
-		rule, err := params.makeRoutingRule(ruleIndex)
+		rules, err := params.makeRoutingRules(ruleIndex)
 		if err != nil {
 			return nil, fmt.Errorf("failed to make routing rule %d for route %s: %w", ruleIndex, params.routeName, err)
 		}
-		policyRules = append(policyRules, rule)
-		policyRuleNames = append(policyRuleNames, *rule.Name)
+		for _, rule := range rules {
+			policyRules = append(policyRules, rule)
+			policyRuleNames = append(policyRuleNames, *rule.Name)
+		}
 	}
 
+	namePrefix := ociGatewayNamePrefix(&params.gateway, params.config)
+	naming := ociResourceNamingFromConfig(params.config)
 	prevRulesByListener := previousPolicyRulesByListener(params.previousPolicyRules, params.matchedListeners)
 	currentListenerNames := lo.SliceToMap(
 		params.matchedListeners,
@@ -972,7 +1416,7 @@ func programL7RoutePolicy(
 		listenerName := string(listener.Name)
 		err := ociLoadBalancerModel.commitRoutingPolicy(ctx, commitRoutingPolicyParams{
 			loadBalancerID:  params.loadBalancerID,
-			listenerName:    listenerName,
+			listenerName:    naming.listenerName(namePrefix, listener.Name),
 			policyRules:     policyRules,
 			prevPolicyRules: prevRulesByListener[listenerName],
 		})
@@ -989,7 +1433,7 @@ func programL7RoutePolicy(
 		}
 		err := ociLoadBalancerModel.commitRoutingPolicy(ctx, commitRoutingPolicyParams{
 			loadBalancerID:  params.loadBalancerID,
-			listenerName:    listenerName,
+			listenerName:    naming.listenerName(namePrefix, gatewayv1.SectionName(listenerName)),
 			policyRules:     []loadbalancer.RoutingRule{},
 			prevPolicyRules: prevRulesByListener[listenerName],
 		})
@@ -1001,6 +1445,30 @@ func programL7RoutePolicy(
 	return programmedHTTPRoutePolicyRulesAnnotation(params.matchedListeners, policyRuleNames), nil
 }
 
+func reconcileL7ExternalBackendSet(
+	ctx context.Context,
+	ociLoadBalancerModel ociLoadBalancerModel,
+	params programL7RoutePolicyParams,
+	backendRef gatewayv1.BackendRef,
+) error {
+	backendName := backendObjectRefName(backendRef.BackendObjectReference, params.routeNamespace).String()
+	externalBackend, ok := params.externalBackends[backendName]
+	if !ok {
+		return fmt.Errorf("resolved external backend %s not found", backendName)
+	}
+	err := ociLoadBalancerModel.reconcileBackendSet(ctx, reconcileBackendSetParams{
+		loadBalancerID:  params.loadBalancerID,
+		routeNS:         params.routeNamespace,
+		backendRef:      backendRef,
+		externalBackend: &externalBackend,
+		naming:          ociResourceNamingFromConfig(params.config),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reconcile backend set for external backend %s: %w", backendName, err)
+	}
+	return nil
+}
+
 func resolveL7BackendSSLConfig(
 	ctx context.Context,
 	params programL7RoutePolicyParams,
@@ -1029,38 +1497,87 @@ func resolveL7BackendSSLConfig(
 func (m *httpRouteModelImpl) programRoute(
 	ctx context.Context,
 	params programRouteParams,
-) (programRouteResult, error) {
-	var previousRules []programmedHTTPRoutePolicyRule
-	if prevPolicyRulesStr, ok := params.httpRoute.Annotations[HTTPRouteProgrammedPolicyRulesAnnotation]; ok {
-		previousRules = parseProgrammedHTTPRoutePolicyRules(prevPolicyRulesStr)
+) (_ programRouteResult, err error) {
+	ctx, endSpan := startSpan(ctx, "httpRouteModel.programRoute",
+		attribute.String("k8s.httproute", params.httpRoute.Namespace+"/"+params.httpRoute.Name),
+		attribute.String("oci.load_balancer_id", params.config.Spec.LoadBalancerID),
+	)
+	defer func() { endSpan(err) }()
+
+	previousRules, _, err := resolveL7RouteProgrammedPolicyRules(ctx, m.programmingState, l7RouteStateParams{
+		gateway:               params.gateway,
+		route:                 &params.httpRoute,
+		routeKind:             "HTTPRoute",
+		policyRulesAnnotation: HTTPRouteProgrammedPolicyRulesAnnotation,
+	})
+	if err != nil {
+		return programRouteResult{}, err
+	}
+
+	backendRefs := httpRouteBackendRefs(params.httpRoute)
+	externalBackends, err := resolveL7ExternalBackends(ctx, m.client, params.httpRoute.Namespace, backendRefs)
+	if err != nil {
+		return programRouteResult{}, err
+	}
+
+	redirectRuleSet, err := httpRouteRedirectRuleSetParams(
+		params.gateway,
+		params.config,
+		params.httpRoute,
+		params.matchedListeners,
+	)
+	if err != nil {
+		return programRouteResult{}, err
 	}
 
+	ruleConditions := newHTTPRouteRuleConditions()
 	programmedPolicyRules, err := programL7RoutePolicy(ctx, m.ociLoadBalancerModel, programL7RoutePolicyParams{
 		loadBalancerID:      params.config.Spec.LoadBalancerID,
 		gateway:             params.gateway,
 		config:              params.config,
 		routeName:           params.httpRoute.Name,
 		routeNamespace:      params.httpRoute.Namespace,
-		backendRefs:         httpRouteBackendRefs(params.httpRoute),
+		backendRefs:         backendRefs,
 		knownBackends:       params.knownBackends,
+		externalBackends:    externalBackends,
 		matchedListeners:    params.matchedListeners,
 		previousPolicyRules: previousRules,
 		backendTLSPolicy:    m.backendTLSPolicy,
 		backendTLSDisabled:  m.backendTLSDisabled,
 		ruleCount:           len(params.httpRoute.Spec.Rules),
-		makeRoutingRule: func(ruleIndex int) (loadbalancer.RoutingRule, error) {
-			return m.ociLoadBalancerModel.makeRoutingRule(ctx, makeRoutingRuleParams{
+		makeRoutingRules: func(ruleIndex int) ([]loadbalancer.RoutingRule, error) {
+			rules, rulesErr := m.ociLoadBalancerModel.makeRoutingRules(ctx, makeRoutingRuleParams{
 				httpRoute:          params.httpRoute,
 				httpRouteRuleIndex: ruleIndex,
+				naming:             ociResourceNamingFromConfig(params.config),
 			})
+			if rulesErr != nil {
+				return nil, rulesErr
+			}
+			return ruleConditions.dedupe(ruleIndex, rules), nil
 		},
 	})
 	if err != nil {
 		return programRouteResult{}, err
 	}
 
+	if err = m.ociLoadBalancerModel.reconcileRouteRedirectRuleSet(ctx, redirectRuleSet); err != nil {
+		return programRouteResult{}, fmt.Errorf("failed to program redirect rules: %w", err)
+	}
+
+	if message := ruleConditions.conflictsMessage(); message != "" {
+		m.logger.WarnContext(ctx, "HTTPRoute has conflicting rules",
+			slog.String("route", params.httpRoute.Name),
+			slog.String("conflicts", message),
+		)
+	}
+
 	return programRouteResult{
 		programmedPolicyRules: programmedPolicyRules,
+		partiallyInvalidMessage: joinPartiallyInvalidMessages(
+			ruleConditions.conflictsMessage(),
+			httpRouteFilterOnlyRulesMessage(params.httpRoute),
+		),
 	}, nil
 }
 
@@ -1077,21 +1594,45 @@ func httpRouteBackendRefs(route gatewayv1.HTTPRoute) []gatewayv1.BackendRef {
 func (m *httpRouteModelImpl) deprovisionRoute(
 	ctx context.Context,
 	params deprovisionRouteParams,
-) error {
-	var previousRules []programmedHTTPRoutePolicyRule
-	if prevPolicyRulesStr, ok := params.httpRoute.Annotations[HTTPRouteProgrammedPolicyRulesAnnotation]; ok {
-		previousRules = parseProgrammedHTTPRoutePolicyRules(prevPolicyRulesStr)
+) (err error) {
+	ctx, endSpan := startSpan(ctx, "httpRouteModel.deprovisionRoute",
+		attribute.String("k8s.httproute", params.httpRoute.Namespace+"/"+params.httpRoute.Name),
+		attribute.String("oci.load_balancer_id", params.config.Spec.LoadBalancerID),
+	)
+	defer func() { endSpan(err) }()
+
+	previousRules, _, err := resolveL7RouteProgrammedPolicyRules(ctx, m.programmingState, l7RouteStateParams{
+		gateway:               params.gateway,
+		route:                 &params.httpRoute,
+		routeKind:             "HTTPRoute",
+		policyRulesAnnotation: HTTPRouteProgrammedPolicyRulesAnnotation,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = m.ociLoadBalancerModel.reconcileRouteRedirectRuleSet(ctx, reconcileRouteRedirectRuleSetParams{
+		loadBalancerID: params.config.Spec.LoadBalancerID,
+		ruleSetName: ociHTTPRouteRedirectRuleSetName(
+			ociGatewayNamePrefix(&params.gateway, params.config),
+			&params.httpRoute,
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to deprovision redirect rules: %w", err)
 	}
 
 	if len(previousRules) == 0 {
-		m.logger.InfoContext(ctx, "No previous policy rules found in annotation, skipping deprovisioning.",
+		m.logger.InfoContext(ctx, "No previous policy rules found, skipping deprovisioning.",
 			slog.String("route", params.httpRoute.Name),
-			slog.String("annotationKey", HTTPRouteProgrammedPolicyRulesAnnotation),
+			slog.String("gateway", params.gateway.Name),
 		)
 		return nil
 	}
 
-	prevRulesByListener := previousPolicyRulesByListener(previousRules, params.matchedListeners)
+	namePrefix := ociGatewayNamePrefix(&params.gateway, params.config)
+	naming := ociResourceNamingFromConfig(params.config)
+	prevRulesByListener := deprovisionPolicyRulesByListener(previousRules, params.gateway, params.matchedListeners)
 	listenerNames := lo.Keys(prevRulesByListener)
 	sort.Strings(listenerNames)
 
@@ -1102,9 +1643,9 @@ func (m *httpRouteModelImpl) deprovisionRoute(
 			slog.String("loadBalancerID", params.config.Spec.LoadBalancerID),
 			slog.Any("prevPolicyRules", prevRulesByListener[listenerName]),
 		)
-		err := m.ociLoadBalancerModel.commitRoutingPolicy(ctx, commitRoutingPolicyParams{
+		err = m.ociLoadBalancerModel.commitRoutingPolicy(ctx, commitRoutingPolicyParams{
 			loadBalancerID:  params.config.Spec.LoadBalancerID,
-			listenerName:    listenerName,
+			listenerName:    naming.listenerName(namePrefix, gatewayv1.SectionName(listenerName)),
 			policyRules:     []loadbalancer.RoutingRule{}, // Empty rules for deprovisioning
 			prevPolicyRules: prevRulesByListener[listenerName],
 		})
@@ -1113,34 +1654,40 @@ func (m *httpRouteModelImpl) deprovisionRoute(
 		}
 	}
 
-	// TODO: Dedup and filter-out non service refs
-	for _, rule := range params.httpRoute.Spec.Rules {
-		for _, backendRef := range rule.BackendRefs {
-			err := m.ociLoadBalancerModel.deprovisionBackendSet(ctx, deprovisionBackendSetParams{
-				loadBalancerID: params.config.Spec.LoadBalancerID,
-				routeNamespace: params.httpRoute.Namespace,
-				backendRef:     backendRef.BackendRef,
-			})
-			if err != nil {
-				return fmt.Errorf(
-					"failed to deprovision backend set for rule %s/%s: %w",
-					params.httpRoute.Namespace,
-					params.httpRoute.Name,
-					err,
-				)
-			}
-		}
+	err = deprovisionL7RouteBackendSets(ctx, m.logger, m.ociLoadBalancerModel, m.programmingState,
+		deprovisionL7RouteBackendSetsParams{
+			gateway:     params.gateway,
+			config:      params.config,
+			route:       &params.httpRoute,
+			routeKind:   "HTTPRoute",
+			backendRefs: httpRouteBackendRefs(params.httpRoute),
+		})
+	if err != nil {
+		return err
 	}
 
-	routeToUpdate := params.httpRoute.DeepCopy()
-	controllerutil.RemoveFinalizer(routeToUpdate, HTTPRouteProgrammedFinalizer)
-
-	if err := m.client.Update(ctx, routeToUpdate); err != nil {
-		return fmt.Errorf("failed to update HTTPRoute %s/%s after deprovisioning: %w",
-			routeToUpdate.Namespace, routeToUpdate.Name, err)
+	// The route may have been updated by deprovisioning of other parents
+	var routeToUpdate gatewayv1.HTTPRoute
+	if err = m.client.Get(ctx, client.ObjectKeyFromObject(&params.httpRoute), &routeToUpdate); err != nil {
+		if apierrors.IsNotFound(err) {
+			return m.programmingState.forgetProgrammedRoute(ctx, routeProgrammingStateParams{
+				gateway:   params.gateway,
+				routeKind: "HTTPRoute",
+				route:     &params.httpRoute,
+			})
+		}
+		return fmt.Errorf("failed to get HTTPRoute %s/%s after deprovisioning: %w",
+			params.httpRoute.Namespace, params.httpRoute.Name, err)
 	}
 
-	return nil
+	return releaseL7RouteParent(ctx, m.client, m.programmingState, releaseL7RouteParentParams{
+		route:                 &routeToUpdate,
+		parentRefs:            routeToUpdate.Spec.ParentRefs,
+		gateway:               params.gateway,
+		policyRulesAnnotation: HTTPRouteProgrammedPolicyRulesAnnotation,
+		finalizer:             HTTPRouteProgrammedFinalizer,
+		routeKind:             "HTTPRoute",
+	})
 }
 
 func (m *httpRouteModelImpl) isProgrammingRequired(
@@ -1167,14 +1714,85 @@ func (m *httpRouteModelImpl) isProgrammingRequired(
 		conditionType: string(gatewayv1.RouteConditionResolvedRefs),
 
 		annotations: map[string]string{
-			HTTPRouteProgrammingRevisionAnnotation: HTTPRouteProgrammingRevisionValue,
+			HTTPRouteProgrammingRevisionAnnotation: httpRouteProgrammingRevision(details.httpRoute),
 		},
 	}), nil
 }
 
+func (m *httpRouteModelImpl) setRejected(
+	ctx context.Context,
+	routeDetails resolvedRouteDetails,
+	statusErr httpRouteStatusError,
+) error {
+	httpRoute := routeDetails.httpRoute.DeepCopy()
+	_, statusIndex, found := lo.FindIndexOf(
+		httpRoute.Status.Parents,
+		func(status gatewayv1.RouteParentStatus) bool {
+			return status.ControllerName == routeDetails.gatewayDetails.gatewayClass.Spec.ControllerName &&
+				parentRefSameTarget(status.ParentRef, routeDetails.matchedRef)
+		},
+	)
+	if !found {
+		return fmt.Errorf("parent status not found for controller %s and parentRef %s",
+			routeDetails.gatewayDetails.gatewayClass.Spec.ControllerName,
+			routeDetails.matchedRef.Name,
+		)
+	}
+
+	m.logger.InfoContext(ctx, "Rejecting HTTPRoute",
+		slog.String("route", httpRoute.Name),
+		slog.String("gateway", routeDetails.gatewayDetails.gateway.Name),
+		slog.String("reason", string(statusErr.reason)),
+	)
+
+	failPendingProgramming(
+		&httpRoute.Status.Parents[statusIndex].Conditions,
+		RouteConditionProgrammed,
+		httpRoute.Generation,
+		string(statusErr.reason),
+		statusErr.message,
+	)
+	return m.resourcesModel.setCondition(ctx, setConditionParams{
+		resource:      httpRoute,
+		conditions:    &httpRoute.Status.Parents[statusIndex].Conditions,
+		conditionType: string(statusErr.conditionType),
+		status:        metav1.ConditionFalse,
+		reason:        string(statusErr.reason),
+		message:       statusErr.message,
+	})
+}
+
+// setL7RouteProgramming sets the Programmed condition of the route parent to Unknown.
+// Routes are only reset once they were programmed before, see programmingStarts.
+func setL7RouteProgramming(
+	ctx context.Context,
+	resourcesModel resourcesModel,
+	params setL7RouteProgrammingParams,
+) error {
+	parentStatus := findRouteParentStatus(
+		params.parentStatuses, params.gatewayClass.Spec.ControllerName, params.matchedRef,
+	)
+	if parentStatus == nil {
+		return fmt.Errorf("parent status not found for controller %s and parentRef %s",
+			params.gatewayClass.Spec.ControllerName,
+			params.matchedRef.Name,
+		)
+	}
+
+	return resourcesModel.setCondition(ctx, setConditionParams{
+		resource:      params.resource,
+		conditions:    &parentStatus.Conditions,
+		conditionType: RouteConditionProgrammed,
+		status:        metav1.ConditionUnknown,
+		reason:        RouteReasonPending,
+		message:       fmt.Sprintf("Programming route generation %d", params.resource.GetGeneration()),
+	})
+}
+
 func setL7RouteProgrammed(
 	ctx context.Context,
 	resourcesModel resourcesModel,
+	programmingState programmingStateModel,
 	params setL7RouteProgrammedParams,
 ) error {
 	_, statusIndex, found := lo.FindIndexOf(
@@ -1191,38 +1809,91 @@ func setL7RouteProgrammed(
 		)
 	}
 
+	// State is recorded before the finalizer is added so deprovisioning can always find it
+	if err := programmingState.recordProgrammedRoute(ctx, recordProgrammedRouteParams{
+		gateway:     params.gateway,
+		routeKind:   params.routeKind,
+		route:       params.resource,
+		policyRules: params.programmedPolicyRules,
+		backendSets: params.backendSets,
+	}); err != nil {
+		return fmt.Errorf("failed to record programming state of %s %s: %w",
+			params.routeKind, params.resource.GetName(), err)
+	}
+
+	// Persisted along with the ResolvedRefs condition below
+	conditions := &params.parentStatuses[statusIndex].Conditions
+	if params.partiallyInvalidMessage != "" {
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:               string(gatewayv1.RouteConditionPartiallyInvalid),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gatewayv1.RouteReasonUnsupportedValue),
+			Message:            params.partiallyInvalidMessage,
+			ObservedGeneration: params.resource.GetGeneration(),
+		})
+	} else {
+		meta.RemoveStatusCondition(conditions, string(gatewayv1.RouteConditionPartiallyInvalid))
+	}
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               RouteConditionProgrammed,
+		Status:             metav1.ConditionTrue,
+		Reason:             RouteReasonProgrammed,
+		Message:            fmt.Sprintf("Route programmed by %s", params.gateway.Name),
+		ObservedGeneration: params.resource.GetGeneration(),
+	})
+
 	return resourcesModel.setCondition(ctx, setConditionParams{
 		resource:      params.resource,
-		conditions:    &params.parentStatuses[statusIndex].Conditions,
+		conditions:    conditions,
 		conditionType: string(gatewayv1.RouteConditionResolvedRefs),
 		status:        metav1.ConditionTrue,
 		reason:        string(gatewayv1.RouteReasonResolvedRefs),
 		message:       fmt.Sprintf("Route programmed by %s", params.gateway.Name),
 		annotations: map[string]string{
 			params.programmingAnnotation: params.programmingRevision,
-			params.policyRulesAnnotation: strings.Join(params.programmedPolicyRules, ","),
 		},
 		finalizer: params.finalizer,
 	})
 }
 
+func (m *httpRouteModelImpl) setProgramming(
+	ctx context.Context,
+	params setRouteProgrammingParams,
+) error {
+	if err := setL7RouteProgramming(ctx, m.resourcesModel, setL7RouteProgrammingParams{
+		resource:       params.httpRoute,
+		parentStatuses: params.httpRoute.Status.Parents,
+		gatewayClass:   params.gatewayClass,
+		matchedRef:     params.matchedRef,
+	}); err != nil {
+		return fmt.Errorf("failed to update programming status for HTTProute %s: %w", params.httpRoute.Name, err)
+	}
+	return nil
+}
+
 func (m *httpRouteModelImpl) setProgrammed(
 	ctx context.Context,
 	params setProgrammedParams,
 ) error {
 	httpRoute := params.httpRoute.DeepCopy()
 
-	err := setL7RouteProgrammed(ctx, m.resourcesModel, setL7RouteProgrammedParams{
+	err := setL7RouteProgrammed(ctx, m.resourcesModel, m.programmingState, setL7RouteProgrammedParams{
 		resource:              httpRoute,
 		parentStatuses:        httpRoute.Status.Parents,
 		gatewayClass:          params.gatewayClass,
 		gateway:               params.gateway,
 		matchedRef:            params.matchedRef,
 		programmedPolicyRules: params.programmedPolicyRules,
-		programmingAnnotation: HTTPRouteProgrammingRevisionAnnotation,
-		programmingRevision:   HTTPRouteProgrammingRevisionValue,
-		policyRulesAnnotation: HTTPRouteProgrammedPolicyRulesAnnotation,
-		finalizer:             HTTPRouteProgrammedFinalizer,
+		backendSets: l7RouteBackendSetNames(
+			params.naming,
+			httpRoute.Namespace,
+			httpRouteBackendRefs(*httpRoute),
+		),
+		routeKind:               "HTTPRoute",
+		programmingAnnotation:   HTTPRouteProgrammingRevisionAnnotation,
+		programmingRevision:     httpRouteProgrammingRevision(*httpRoute),
+		finalizer:               HTTPRouteProgrammedFinalizer,
+		partiallyInvalidMessage: params.partiallyInvalidMessage,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update programmed status for HTTProute %s: %w", httpRoute.Name, err)
@@ -1231,16 +1902,111 @@ func (m *httpRouteModelImpl) setProgrammed(
 	return nil
 }
 
+func (m *httpRouteModelImpl) setBackendsHealth(
+	ctx context.Context,
+	params setBackendsHealthParams,
+) error {
+	var httpRoute gatewayv1.HTTPRoute
+	if err := m.client.Get(ctx, client.ObjectKeyFromObject(&params.httpRoute), &httpRoute); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get HTTPRoute %s: %w", params.httpRoute.Name, err)
+	}
+
+	_, statusIndex, found := lo.FindIndexOf(
+		httpRoute.Status.Parents,
+		func(status gatewayv1.RouteParentStatus) bool {
+			return status.ControllerName == params.gatewayClass.Spec.ControllerName &&
+				parentRefSameTarget(status.ParentRef, params.matchedRef)
+		},
+	)
+	if !found {
+		return nil
+	}
+
+	status, reason, message := params.health.condition()
+	conditions := &httpRoute.Status.Parents[statusIndex].Conditions
+	existing := meta.FindStatusCondition(*conditions, HTTPRouteConditionBackendsHealthy)
+	if existing != nil &&
+		existing.Status == status &&
+		existing.Reason == reason &&
+		existing.Message == message &&
+		existing.ObservedGeneration == httpRoute.Generation {
+		return nil
+	}
+
+	if err := m.resourcesModel.setCondition(ctx, setConditionParams{
+		resource:      &httpRoute,
+		conditions:    conditions,
+		conditionType: HTTPRouteConditionBackendsHealthy,
+		status:        status,
+		reason:        reason,
+		message:       message,
+	}); err != nil {
+		return fmt.Errorf("failed to update backends health status for HTTPRoute %s: %w", httpRoute.Name, err)
+	}
+	return nil
+}
+
+func (m *httpRouteModelImpl) setPaused(
+	ctx context.Context,
+	params setPausedParams,
+) error {
+	var httpRoute gatewayv1.HTTPRoute
+	if err := m.client.Get(ctx, client.ObjectKeyFromObject(&params.httpRoute), &httpRoute); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get HTTPRoute %s: %w", params.httpRoute.Name, err)
+	}
+
+	parentStatus := findRouteParentStatus(
+		httpRoute.Status.Parents, params.gatewayClass.Spec.ControllerName, params.matchedRef,
+	)
+	if parentStatus == nil {
+		return nil
+	}
+
+	if err := m.resourcesModel.setCondition(ctx, setConditionParams{
+		resource:      &httpRoute,
+		conditions:    &parentStatus.Conditions,
+		conditionType: ConditionPaused,
+		status:        params.condition.status,
+		reason:        params.condition.reason,
+		message:       params.condition.message,
+	}); err != nil {
+		return fmt.Errorf("failed to update paused status for HTTPRoute %s: %w", httpRoute.Name, err)
+	}
+	return nil
+}
+
+// findRouteParentStatus returns the route parent status of the controller for the parent reference.
+func findRouteParentStatus(
+	parents []gatewayv1.RouteParentStatus,
+	controllerName gatewayv1.GatewayController,
+	parentRef gatewayv1.ParentReference,
+) *gatewayv1.RouteParentStatus {
+	for i := range parents {
+		if parents[i].ControllerName == controllerName && parentRefSameTarget(parents[i].ParentRef, parentRef) {
+			return &parents[i]
+		}
+	}
+	return nil
+}
+
 // httpRouteModelDeps defines the dependencies required for the httpRouteModel.
 type httpRouteModelDeps struct {
 	dig.In
 
-	K8sClient      k8sClient
-	RootLogger     *slog.Logger
-	GatewayModel   gatewayModel
-	OciLBModel     ociLoadBalancerModel
-	ResourcesModel resourcesModel
-	BackendTLS     backendTLSPolicyModel
+	K8sClient        k8sClient
+	RootLogger       *slog.Logger
+	GatewayModel     gatewayModel
+	OciLBModel       ociLoadBalancerModel
+	ResourcesModel   resourcesModel
+	ProgrammingState programmingStateModel
+	BackendTLS       backendTLSPolicyModel
+	AdmissionHook    routeAdmissionHook `optional:"true"`
 }
 
 // newHTTPRouteModel creates a new instance of httpRouteModel.
@@ -1251,7 +2017,9 @@ func newHTTPRouteModel(deps httpRouteModelDeps) *httpRouteModelImpl {
 		gatewayModel:         deps.GatewayModel,
 		ociLoadBalancerModel: deps.OciLBModel,
 		resourcesModel:       deps.ResourcesModel,
+		programmingState:     deps.ProgrammingState,
 		backendTLSPolicy:     deps.BackendTLS,
+		admissionHook:        deps.AdmissionHook,
 	}
 }
 
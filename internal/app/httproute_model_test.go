@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand/v2"
+	"net/http"
 	"strings"
 	"testing"
 	"time"
@@ -27,19 +28,42 @@ import (
 
 	"github.com/gemyago/oke-gateway-api/internal/diag"
 	k8sapi "github.com/gemyago/oke-gateway-api/internal/services/k8sapi"
+	configtypes "github.com/gemyago/oke-gateway-api/internal/types"
 )
 
 func TestHTTPRouteModelImpl(t *testing.T) {
 	newMockDeps := func(t *testing.T) httpRouteModelDeps {
 		return httpRouteModelDeps{
-			K8sClient:      NewMockk8sClient(t),
-			RootLogger:     diag.RootTestLogger(),
-			GatewayModel:   NewMockgatewayModel(t),
-			OciLBModel:     NewMockociLoadBalancerModel(t),
-			ResourcesModel: NewMockresourcesModel(t),
+			K8sClient:        NewMockk8sClient(t),
+			RootLogger:       diag.RootTestLogger(),
+			GatewayModel:     NewMockgatewayModel(t),
+			OciLBModel:       NewMockociLoadBalancerModel(t),
+			ResourcesModel:   NewMockresourcesModel(t),
+			ProgrammingState: NewMockprogrammingStateModel(t),
 		}
 	}
 
+	expectNoRecordedPolicyRules := func(t *testing.T, deps httpRouteModelDeps) {
+		programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+		programmingState.EXPECT().
+			programmedRoutePolicyRules(t.Context(), mock.MatchedBy(func(params routeProgrammingStateParams) bool {
+				return params.routeKind == "HTTPRoute"
+			})).
+			Return(nil, false, nil).
+			Once()
+	}
+
+	expectNoRedirectRules := func(t *testing.T, deps httpRouteModelDeps) {
+		ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
+		noRedirectRules := func(params reconcileRouteRedirectRuleSetParams) bool {
+			return strings.HasPrefix(params.ruleSetName, "rd_") && len(params.items) == 0
+		}
+		ociLBModel.EXPECT().
+			reconcileRouteRedirectRuleSet(t.Context(), mock.MatchedBy(noRedirectRules)).
+			Return(nil).
+			Once()
+	}
+
 	t.Run("programmedHTTPRoutePolicyRulesAnnotation", func(t *testing.T) {
 		t.Run("formats listener scoped policy rules", func(t *testing.T) {
 			listenerA := makeRandomListener()
@@ -259,19 +283,24 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			return obj.GetName() == route.Name &&
 				condition != nil &&
 				condition.Status == metav1.ConditionFalse
-		})).Return(nil)
+		})).Return(nil).Once()
 
-		err = rejectL7Route(t.Context(), k8sClient, rejectL7RouteParams{
+		rejectParams := rejectL7RouteParams{
 			resource:       &route,
 			parentStatuses: &parentStatuses,
 			gatewayClass: gatewayv1.GatewayClass{
 				Spec: gatewayv1.GatewayClassSpec{ControllerName: ControllerClassName},
 			},
 			matchedRef: parentRef,
+			reason:     routeReasonConflicted,
 			message:    "conflicted",
 			routeKind:  "HTTPRoute",
-		})
+		}
+		err = rejectL7Route(t.Context(), k8sClient, rejectParams)
+		require.NoError(t, err)
 
+		// Same rejection is not written again
+		err = rejectL7Route(t.Context(), k8sClient, rejectParams)
 		require.NoError(t, err)
 	})
 
@@ -631,6 +660,26 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 				return true, nil
 			})
 
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockStatusWriter := k8sapi.NewMockSubResourceWriter(t)
+			mockK8sClient.EXPECT().Status().Return(mockStatusWriter)
+			mockStatusWriter.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				route, ok := obj.(*gatewayv1.HTTPRoute)
+				if !ok {
+					return false
+				}
+				parentStatus, found := lo.Find(route.Status.Parents, func(status gatewayv1.RouteParentStatus) bool {
+					return parentRefSameTarget(status.ParentRef, refWithNonMatchingSection)
+				})
+				if !found {
+					return false
+				}
+				condition := meta.FindStatusCondition(parentStatus.Conditions, string(gatewayv1.RouteConditionAccepted))
+				return condition != nil &&
+					condition.Status == metav1.ConditionFalse &&
+					condition.Reason == string(gatewayv1.RouteReasonNoMatchingParent)
+			})).Return(nil)
+
 			results, err := model.resolveRequest(t.Context(), req)
 
 			require.NoError(t, err)
@@ -653,7 +702,7 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			assert.Equal(t, allListeners, res.matchedListeners)
 		})
 
-		t.Run("no relevant parent when section name does not match", func(t *testing.T) {
+		t.Run("rejects parent when section name does not match", func(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
@@ -702,12 +751,94 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 				return true, nil
 			})
 
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockStatusWriter := k8sapi.NewMockSubResourceWriter(t)
+			mockK8sClient.EXPECT().Status().Return(mockStatusWriter)
+			mockStatusWriter.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				route, ok := obj.(*gatewayv1.HTTPRoute)
+				if !ok || len(route.Status.Parents) != 1 {
+					return false
+				}
+				parentStatus := route.Status.Parents[0]
+				condition := meta.FindStatusCondition(parentStatus.Conditions, string(gatewayv1.RouteConditionAccepted))
+				return parentRefSameTarget(parentStatus.ParentRef, workingRef) &&
+					parentStatus.ControllerName == gatewayData.gatewayClass.Spec.ControllerName &&
+					condition != nil &&
+					condition.Status == metav1.ConditionFalse &&
+					condition.Reason == string(gatewayv1.RouteReasonNoMatchingParent) &&
+					strings.Contains(condition.Message, string(nonMatchingSectionName))
+			})).Return(nil)
+
 			results, err := model.resolveRequest(t.Context(), req)
 
 			require.NoError(t, err)
 			assert.Empty(t, results, "parent should not resolve when section name does not match any listener")
 		})
 
+		t.Run("rejects parent when route hostnames do not match listeners", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: fake.Lorem().Word(),
+					Name:      fake.Lorem().Word(),
+				},
+			}
+			workingRef := makeRandomParentRef()
+			route := makeRandomHTTPRoute(
+				randomHTTPRouteWithRandomParentRefOpt(workingRef),
+			)
+			route.Spec.Hostnames = []gatewayv1.Hostname{"api.example.com"}
+
+			setupClientGet(t, deps.K8sClient, req.NamespacedName, route)
+
+			gatewayModel, _ := deps.GatewayModel.(*MockgatewayModel)
+			listener := makeRandomListener()
+			listener.Hostname = lo.ToPtr(gatewayv1.Hostname("*.other.com"))
+			gatewayData := makeRandomAcceptedGatewayDetails(
+				randomResolvedGatewayDetailsWithGatewayOpts(
+					randomGatewayWithNameFromParentRefOpt(workingRef),
+					randomGatewayWithListenersOpt(listener),
+				),
+			)
+			gatewayModel.EXPECT().resolveReconcileRequest(
+				t.Context(),
+				mock.Anything,
+				mock.Anything,
+			).RunAndReturn(func(
+				_ context.Context,
+				_ reconcile.Request,
+				receiver *resolvedGatewayDetails,
+			) (bool, error) {
+				*receiver = *gatewayData
+				return true, nil
+			})
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockStatusWriter := k8sapi.NewMockSubResourceWriter(t)
+			mockK8sClient.EXPECT().Status().Return(mockStatusWriter)
+			mockStatusWriter.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				route, ok := obj.(*gatewayv1.HTTPRoute)
+				if !ok || len(route.Status.Parents) != 1 {
+					return false
+				}
+				condition := meta.FindStatusCondition(
+					route.Status.Parents[0].Conditions,
+					string(gatewayv1.RouteConditionAccepted),
+				)
+				return condition != nil &&
+					condition.Status == metav1.ConditionFalse &&
+					condition.Reason == string(gatewayv1.RouteReasonNoMatchingListenerHostname)
+			})).Return(nil)
+
+			results, err := model.resolveRequest(t.Context(), req)
+
+			require.NoError(t, err)
+			assert.Empty(t, results)
+		})
+
 		t.Run("no relevant parent", func(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
@@ -1018,6 +1149,112 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			assert.Same(t, updatedRoute, got)
 		})
 
+		t.Run("rejects when an older HTTPRoute has the same rule priority", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockStatusWriter := k8sapi.NewMockSubResourceWriter(t)
+			listenerName := gatewayv1.SectionName("https")
+			hostname := gatewayv1.Hostname("api.example.com")
+			gateway := newRandomGateway(randomGatewayWithListenersOpt(gatewayv1.Listener{
+				Name:     listenerName,
+				Hostname: &hostname,
+				Port:     443,
+				Protocol: gatewayv1.HTTPSProtocolType,
+			}))
+			gatewayNamespace := gatewayv1.Namespace(gateway.Namespace)
+			parentRef := gatewayv1.ParentReference{
+				Namespace:   &gatewayNamespace,
+				Name:        gatewayv1.ObjectName(gateway.Name),
+				SectionName: &listenerName,
+			}
+			makePrioritizedRoute := func(name string, priority string, day int) gatewayv1.HTTPRoute {
+				route := makeRandomHTTPRoute(
+					randomHTTPRouteWithNamespaceOpt(gateway.Namespace),
+					randomHTTPRouteWithNameOpt(name),
+					randomHTTPRouteWithRandomParentRefOpt(parentRef),
+				)
+				route.CreationTimestamp = metav1.NewTime(time.Date(2026, 1, day, 0, 0, 0, 0, time.UTC))
+				route.Spec.Hostnames = []gatewayv1.Hostname{hostname}
+				route.Annotations = map[string]string{HTTPRouteRulePriorityAnnotation: priority}
+				return route
+			}
+			currentRoute := makePrioritizedRoute("current", "-10", 3)
+			olderSamePriorityRoute := makePrioritizedRoute("older-same", "-10", 1)
+			olderOtherPriorityRoute := makePrioritizedRoute("older-other", "10", 2)
+			wantMessage := httpRouteRulePriorityConflictMessage(httpRouteConflictCandidate(olderSamePriorityRoute))
+
+			k8sClient.EXPECT().List(t.Context(), &gatewayv1.GRPCRouteList{}).Return(nil).Once()
+			k8sClient.EXPECT().List(t.Context(), &gatewayv1.HTTPRouteList{}).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					list.(*gatewayv1.HTTPRouteList).Items = []gatewayv1.HTTPRoute{
+						currentRoute,
+						olderOtherPriorityRoute,
+						olderSamePriorityRoute,
+					}
+					return nil
+				}).
+				Once()
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedRoutePolicyRules(t.Context(), mock.MatchedBy(func(params routeProgrammingStateParams) bool {
+					return params.routeKind == "HTTPRoute" && params.route.GetName() == currentRoute.Name
+				})).
+				Return(nil, false, nil).
+				Once()
+			k8sClient.EXPECT().Status().Return(mockStatusWriter)
+			mockStatusWriter.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				route, ok := obj.(*gatewayv1.HTTPRoute)
+				if !ok {
+					return false
+				}
+				parentStatus := route.Status.Parents[0]
+				condition := meta.FindStatusCondition(parentStatus.Conditions, string(gatewayv1.RouteConditionAccepted))
+				return condition != nil &&
+					condition.Status == metav1.ConditionFalse &&
+					condition.Reason == string(routeReasonConflicted) &&
+					condition.Message == wantMessage
+			})).Return(nil).Once()
+
+			got, err := model.acceptRoute(t.Context(), resolvedRouteDetails{
+				gatewayDetails: resolvedGatewayDetails{
+					gateway: *gateway,
+					gatewayClass: gatewayv1.GatewayClass{
+						Spec: gatewayv1.GatewayClassSpec{ControllerName: ControllerClassName},
+					},
+					config: makeRandomGatewayConfig(),
+				},
+				httpRoute:        currentRoute,
+				matchedRef:       parentRef,
+				matchedListeners: []gatewayv1.Listener{gateway.Spec.Listeners[0]},
+			})
+
+			require.NoError(t, err)
+			assert.Nil(t, got)
+		})
+
+		t.Run("fails when listing HTTPRoutes for rule priority conflicts fails", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			gateway := newRandomGateway()
+			route := makeRandomHTTPRoute()
+			route.Annotations = map[string]string{HTTPRouteRulePriorityAnnotation: "1"}
+			wantErr := errors.New(faker.New().Lorem().Sentence(5))
+
+			k8sClient.EXPECT().List(t.Context(), &gatewayv1.GRPCRouteList{}).Return(nil).Once()
+			k8sClient.EXPECT().List(t.Context(), &gatewayv1.HTTPRouteList{}).Return(wantErr).Once()
+
+			_, err := model.acceptRoute(t.Context(), resolvedRouteDetails{
+				gatewayDetails:   resolvedGatewayDetails{gateway: *gateway},
+				httpRoute:        route,
+				matchedRef:       makeRandomParentRef(),
+				matchedListeners: gateway.Spec.Listeners,
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+
 		t.Run("rejectRoute sets conflicted condition", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
@@ -1034,6 +1271,13 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			}
 			wantMessage := l7RouteConflictMessage(winner)
 
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedRoutePolicyRules(t.Context(), mock.MatchedBy(func(params routeProgrammingStateParams) bool {
+					return params.routeKind == "HTTPRoute" && params.route.GetName() == httpRoute.Name
+				})).
+				Return(nil, false, nil).
+				Once()
 			k8sClient.EXPECT().Status().Return(mockStatusWriter)
 			mockStatusWriter.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
 				route, ok := obj.(*gatewayv1.HTTPRoute)
@@ -1058,9 +1302,84 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 				},
 				httpRoute:  httpRoute,
 				matchedRef: parentRef,
-			}, wantMessage)
+			}, routeReasonConflicted, wantMessage)
+
+			require.NoError(t, err)
+		})
+
+		t.Run("rejects when admission hook denies the route", func(t *testing.T) {
+			deps := newMockDeps(t)
+			httpRoute := makeRandomHTTPRoute()
+			gateway := newRandomGateway()
+			listener := makeRandomListener(randomListenerWithHTTPProtocolOpt())
+			wantMessage := faker.New().Lorem().Sentence(5)
+			var gotReview routeAdmissionReview
+			deps.AdmissionHook = routeAdmissionHookFunc(
+				func(_ context.Context, review routeAdmissionReview) (routeAdmissionResponse, error) {
+					gotReview = review
+					return routeAdmissionResponse{Allowed: false, Message: wantMessage}, nil
+				},
+			)
+			model := newHTTPRouteModel(deps)
+			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockStatusWriter := k8sapi.NewMockSubResourceWriter(t)
+			parentRef := makeRandomParentRef()
+
+			k8sClient.EXPECT().List(t.Context(), &gatewayv1.GRPCRouteList{}).Return(nil).Once()
+			expectNoRecordedPolicyRules(t, deps)
+			k8sClient.EXPECT().Status().Return(mockStatusWriter)
+			mockStatusWriter.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				route, ok := obj.(*gatewayv1.HTTPRoute)
+				if !ok || len(route.Status.Parents) != 1 {
+					return false
+				}
+				condition := meta.FindStatusCondition(
+					route.Status.Parents[0].Conditions,
+					string(gatewayv1.RouteConditionAccepted),
+				)
+				return condition != nil &&
+					condition.Status == metav1.ConditionFalse &&
+					condition.Reason == string(routeReasonAdmissionDenied) &&
+					condition.Message == wantMessage
+			})).Return(nil)
+
+			acceptedRoute, err := model.acceptRoute(t.Context(), resolvedRouteDetails{
+				gatewayDetails: resolvedGatewayDetails{
+					gateway: *gateway,
+					gatewayClass: gatewayv1.GatewayClass{
+						Spec: gatewayv1.GatewayClassSpec{ControllerName: ControllerClassName},
+					},
+				},
+				httpRoute:        httpRoute,
+				matchedRef:       parentRef,
+				matchedListeners: []gatewayv1.Listener{listener},
+			})
 
 			require.NoError(t, err)
+			assert.Nil(t, acceptedRoute)
+			assert.Equal(t, "HTTPRoute", gotReview.Kind)
+			assert.Equal(t, httpRoute.Name, gotReview.Route.GetName())
+			assert.Equal(t, routeAdmissionGateway{Namespace: gateway.Namespace, Name: gateway.Name}, gotReview.Gateway)
+			assert.Equal(t, []string{string(listener.Name)}, gotReview.Listeners)
+		})
+
+		t.Run("fails when admission hook fails", func(t *testing.T) {
+			deps := newMockDeps(t)
+			wantErr := errors.New(faker.New().Lorem().Sentence(3))
+			deps.AdmissionHook = routeAdmissionHookFunc(
+				func(context.Context, routeAdmissionReview) (routeAdmissionResponse, error) {
+					return routeAdmissionResponse{}, wantErr
+				},
+			)
+			model := newHTTPRouteModel(deps)
+
+			_, err := model.acceptRoute(t.Context(), resolvedRouteDetails{
+				gatewayDetails: resolvedGatewayDetails{gateway: *newRandomGateway()},
+				httpRoute:      makeRandomHTTPRoute(),
+				matchedRef:     makeRandomParentRef(),
+			})
+
+			require.ErrorIs(t, err, wantErr)
 		})
 
 		t.Run("set condition of existing parent", func(t *testing.T) {
@@ -1357,12 +1676,111 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			require.Error(t, err)
 			require.ErrorIs(t, err, expectedErr)
 		})
+
+		t.Run("rejects ExternalName services", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			backendRef := makeRandomBackendRef()
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(
+					makeRandomHTTPRouteRule(
+						randomHTTPRouteRuleWithRandomBackendRefsOpt(backendRef),
+					),
+				),
+			)
+			service := makeRandomService(randomServiceFromBackendRef(backendRef, &httpRoute))
+			service.Spec.Type = corev1.ServiceTypeExternalName
+			service.Spec.ExternalName = faker.New().Internet().Domain()
+
+			setupClientGet(t, deps.K8sClient, types.NamespacedName{
+				Namespace: service.Namespace,
+				Name:      service.Name,
+			}, service)
+
+			_, err := model.resolveBackendRefs(t.Context(), resolveBackendRefsParams{
+				httpRoute: httpRoute,
+			})
+
+			var statusErr httpRouteStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, gatewayv1.RouteConditionResolvedRefs, statusErr.conditionType)
+			assert.Equal(t, gatewayv1.RouteReasonUnsupportedValue, statusErr.reason)
+			assert.Contains(t, statusErr.message, service.Spec.ExternalName)
+		})
+
+		t.Run("skips external backends", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			serviceRef := makeRandomBackendRef()
+			externalRef := makeRandomBackendRef(randomBackendRefWithExternalBackendOpt())
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(
+					makeRandomHTTPRouteRule(
+						randomHTTPRouteRuleWithRandomBackendRefsOpt(serviceRef, externalRef),
+					),
+				),
+			)
+			service := makeRandomService(randomServiceFromBackendRef(serviceRef, &httpRoute))
+			setupClientGet(t, deps.K8sClient, types.NamespacedName{
+				Namespace: service.Namespace,
+				Name:      service.Name,
+			}, service)
+			setupClientGet(t, deps.K8sClient, types.NamespacedName{
+				Namespace: httpRoute.Namespace,
+				Name:      string(externalRef.Name),
+			}, makeRandomExternalBackend(httpRoute.Namespace, string(externalRef.Name)))
+
+			resolvedBackendRefs, err := model.resolveBackendRefs(t.Context(), resolveBackendRefsParams{
+				httpRoute: httpRoute,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, map[string]corev1.Service{
+				types.NamespacedName{Namespace: service.Namespace, Name: service.Name}.String(): service,
+			}, resolvedBackendRefs)
+		})
+
+		t.Run("rejects missing external backends", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			externalRef := makeRandomBackendRef(randomBackendRefWithExternalBackendOpt())
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(
+					makeRandomHTTPRouteRule(
+						randomHTTPRouteRuleWithRandomBackendRefsOpt(externalRef),
+					),
+				),
+			)
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().Get(t.Context(), types.NamespacedName{
+				Namespace: httpRoute.Namespace,
+				Name:      string(externalRef.Name),
+			}, mock.Anything).Return(apierrors.NewNotFound(schema.GroupResource{
+				Group:    configtypes.GroupName,
+				Resource: "oke-external-backends",
+			}, string(externalRef.Name)))
+
+			_, err := model.resolveBackendRefs(t.Context(), resolveBackendRefsParams{
+				httpRoute: httpRoute,
+			})
+
+			var statusErr httpRouteStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, gatewayv1.RouteConditionResolvedRefs, statusErr.conditionType)
+			assert.Equal(t, gatewayv1.RouteReasonBackendNotFound, statusErr.reason)
+			assert.Contains(t, statusErr.message, string(externalRef.Name))
+		})
 	})
 
 	t.Run("programRoute", func(t *testing.T) {
 		t.Run("successfully programs route with multiple listeners", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
 
 			// Setup test data
 			gateway := newRandomGateway()
@@ -1420,10 +1838,10 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 				rule := makeRandomOCIRoutingRule()
 				expectedRules = append(expectedRules, rule)
 
-				ociLBModel.EXPECT().makeRoutingRule(t.Context(), makeRoutingRuleParams{
+				ociLBModel.EXPECT().makeRoutingRules(t.Context(), makeRoutingRuleParams{
 					httpRoute:          httpRoute,
 					httpRouteRuleIndex: i,
-				}).Return(rule, nil)
+				}).Return([]loadbalancer.RoutingRule{rule}, nil)
 			}
 
 			// Expect commitRoutingPolicyV2 to be called for each listener
@@ -1439,10 +1857,156 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			require.NoError(t, err)
 		})
 
-		t.Run("programs separate backend sets for the same service on different ports", func(t *testing.T) {
-			fake := faker.New()
+		t.Run("reports conflicting rules with the same condition", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
+
+			// Setup test data
+			gateway := newRandomGateway()
+			config := makeRandomGatewayConfig()
+
+			// Create HTTP route with multiple rules
+			backendRefs := []gatewayv1.HTTPBackendRef{
+				makeRandomBackendRef(),
+				makeRandomBackendRef(),
+			}
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(
+					makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(backendRefs[0])),
+					makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(backendRefs[1])),
+				),
+			)
+
+			knownServices := []corev1.Service{
+				makeRandomService(randomServiceFromBackendRef(backendRefs[0], &httpRoute)),
+				makeRandomService(randomServiceFromBackendRef(backendRefs[1], &httpRoute)),
+			}
+			knownServicesByName := lo.SliceToMap(knownServices, func(s corev1.Service) (string, corev1.Service) {
+				return types.NamespacedName{
+					Namespace: s.Namespace,
+					Name:      s.Name,
+				}.String(), s
+			})
+
+			listeners := makeFewRandomListeners()
+
+			params := programRouteParams{
+				gateway:          *gateway,
+				config:           config,
+				httpRoute:        httpRoute,
+				knownBackends:    knownServicesByName,
+				matchedListeners: listeners,
+			}
+
+			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
+
+			// Expect reconciliation of backend sets for each backendRef.
+			for _, ref := range backendRefs {
+				service := knownServicesByName[backendObjectRefName(ref.BackendObjectReference, httpRoute.Namespace).String()]
+				ociLBModel.EXPECT().reconcileBackendSet(t.Context(), reconcileBackendSetParams{
+					loadBalancerID: config.Spec.LoadBalancerID,
+					service:        service,
+					routeNS:        httpRoute.Namespace,
+					backendRef:     ref.BackendRef,
+				}).Return(nil)
+			}
+
+			// Both rules collapse to the same condition but forward to different backends
+			condition := "http.request.url.path sw '/" + faker.New().Internet().Slug() + "'"
+			expectedRules := make([]loadbalancer.RoutingRule, 0, len(httpRoute.Spec.Rules))
+			for i := range httpRoute.Spec.Rules {
+				rule := makeRandomOCIRoutingRule()
+				rule.Condition = new(condition)
+				rule.Actions = []loadbalancer.Action{
+					loadbalancer.ForwardToBackendSet{BackendSetName: new(faker.New().UUID().V4())},
+				}
+				if i == 0 {
+					expectedRules = append(expectedRules, rule)
+				}
+
+				ociLBModel.EXPECT().makeRoutingRules(t.Context(), makeRoutingRuleParams{
+					httpRoute:          httpRoute,
+					httpRouteRuleIndex: i,
+				}).Return([]loadbalancer.RoutingRule{rule}, nil)
+			}
+
+			// Expect commitRoutingPolicyV2 to be called for each listener
+			for _, listener := range listeners {
+				ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+					loadBalancerID: config.Spec.LoadBalancerID,
+					listenerName:   string(listener.Name),
+					policyRules:    expectedRules,
+				}).Return(nil)
+			}
+
+			result, err := model.programRoute(t.Context(), params)
+			require.NoError(t, err)
+			assert.Equal(t,
+				"rules 0 and 1 have the same matches but route differently, only the first rule of each is programmed",
+				result.partiallyInvalidMessage,
+			)
+		})
+
+		t.Run("programs prefixed listeners of shared load balancer", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
+
+			gateway := newRandomGateway()
+			config := makeRandomGatewayConfig()
+			config.Spec.SharedLoadBalancer = true
+			namePrefix := ociGatewayNamePrefix(gateway, config)
+			require.NotEmpty(t, namePrefix)
+
+			backendRef := makeRandomBackendRef()
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(
+					makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(backendRef)),
+				),
+			)
+			service := makeRandomService(randomServiceFromBackendRef(backendRef, &httpRoute))
+			serviceKey := types.NamespacedName{Namespace: service.Namespace, Name: service.Name}.String()
+			listener := makeRandomListener()
+
+			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
+			ociLBModel.EXPECT().reconcileBackendSet(t.Context(), reconcileBackendSetParams{
+				loadBalancerID: config.Spec.LoadBalancerID,
+				service:        service,
+				routeNS:        httpRoute.Namespace,
+				backendRef:     backendRef.BackendRef,
+			}).Return(nil).Once()
+			rule := makeRandomOCIRoutingRule()
+			ociLBModel.EXPECT().makeRoutingRules(t.Context(), makeRoutingRuleParams{
+				httpRoute:          httpRoute,
+				httpRouteRuleIndex: 0,
+			}).Return([]loadbalancer.RoutingRule{rule}, nil).Once()
+			ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+				loadBalancerID: config.Spec.LoadBalancerID,
+				listenerName:   namePrefix + string(listener.Name),
+				policyRules:    []loadbalancer.RoutingRule{rule},
+			}).Return(nil).Once()
+
+			result, err := model.programRoute(t.Context(), programRouteParams{
+				gateway:          *gateway,
+				config:           config,
+				httpRoute:        httpRoute,
+				knownBackends:    map[string]corev1.Service{serviceKey: service},
+				matchedListeners: []gatewayv1.Listener{listener},
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, []string{string(listener.Name) + "/" + *rule.Name}, result.programmedPolicyRules)
+		})
+
+		t.Run("programs separate backend sets for the same service on different ports", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
 
 			gateway := newRandomGateway()
 			config := makeRandomGatewayConfig()
@@ -1492,10 +2056,10 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			for i := range httpRoute.Spec.Rules {
 				rule := makeRandomOCIRoutingRule()
 				expectedRules = append(expectedRules, rule)
-				ociLBModel.EXPECT().makeRoutingRule(t.Context(), makeRoutingRuleParams{
+				ociLBModel.EXPECT().makeRoutingRules(t.Context(), makeRoutingRuleParams{
 					httpRoute:          httpRoute,
 					httpRouteRuleIndex: i,
-				}).Return(rule, nil).Once()
+				}).Return([]loadbalancer.RoutingRule{rule}, nil).Once()
 			}
 			ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
 				loadBalancerID: config.Spec.LoadBalancerID,
@@ -1556,8 +2120,8 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 				matchedListeners: []gatewayv1.Listener{listener},
 				backendTLSPolicy: &stubBackendTLSPolicyModel{resolveErr: errBackendTLSPolicyNotFound},
 				ruleCount:        1,
-				makeRoutingRule: func(int) (loadbalancer.RoutingRule, error) {
-					return rule, nil
+				makeRoutingRules: func(int) ([]loadbalancer.RoutingRule, error) {
+					return []loadbalancer.RoutingRule{rule}, nil
 				},
 			})
 
@@ -1568,6 +2132,8 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 		t.Run("deduplicates backend set reconciliation for the same backend ref", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
 
 			gateway := newRandomGateway()
 			config := makeRandomGatewayConfig()
@@ -1593,10 +2159,10 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			for i := range httpRoute.Spec.Rules {
 				rule := makeRandomOCIRoutingRule()
 				expectedRules = append(expectedRules, rule)
-				ociLBModel.EXPECT().makeRoutingRule(t.Context(), makeRoutingRuleParams{
+				ociLBModel.EXPECT().makeRoutingRules(t.Context(), makeRoutingRuleParams{
 					httpRoute:          httpRoute,
 					httpRouteRuleIndex: i,
-				}).Return(rule, nil).Once()
+				}).Return([]loadbalancer.RoutingRule{rule}, nil).Once()
 			}
 			ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
 				loadBalancerID: config.Spec.LoadBalancerID,
@@ -1621,9 +2187,149 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			require.NoError(t, err)
 		})
 
+		t.Run("programs redirect rules as listener rule set", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
+
+			gateway := newRandomGateway()
+			config := makeRandomGatewayConfig()
+			backendRef := makeRandomBackendRef()
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(
+					makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(backendRef)),
+					gatewayv1.HTTPRouteRule{
+						Matches: []gatewayv1.HTTPRouteMatch{{
+							Path: &gatewayv1.HTTPPathMatch{
+								Type:  new(gatewayv1.PathMatchExact),
+								Value: new("/old"),
+							},
+						}},
+						Filters: []gatewayv1.HTTPRouteFilter{{
+							Type: gatewayv1.HTTPRouteFilterRequestRedirect,
+							RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{
+								Scheme:     new("https"),
+								StatusCode: new(http.StatusMovedPermanently),
+							},
+						}},
+					},
+				),
+			)
+			service := makeRandomService(randomServiceFromBackendRef(backendRef, &httpRoute))
+			serviceKey := types.NamespacedName{Namespace: service.Namespace, Name: service.Name}.String()
+			listener := makeRandomListener()
+
+			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
+			ociLBModel.EXPECT().reconcileBackendSet(t.Context(), reconcileBackendSetParams{
+				loadBalancerID: config.Spec.LoadBalancerID,
+				service:        service,
+				routeNS:        httpRoute.Namespace,
+				backendRef:     backendRef.BackendRef,
+			}).Return(nil).Once()
+			rule := makeRandomOCIRoutingRule()
+			ociLBModel.EXPECT().makeRoutingRules(t.Context(), makeRoutingRuleParams{
+				httpRoute:          httpRoute,
+				httpRouteRuleIndex: 0,
+			}).Return([]loadbalancer.RoutingRule{rule}, nil).Once()
+			ociLBModel.EXPECT().makeRoutingRules(t.Context(), makeRoutingRuleParams{
+				httpRoute:          httpRoute,
+				httpRouteRuleIndex: 1,
+			}).Return(nil, nil).Once()
+			commitCall := ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+				loadBalancerID: config.Spec.LoadBalancerID,
+				listenerName:   string(listener.Name),
+				policyRules:    []loadbalancer.RoutingRule{rule},
+			}).Return(nil).Once()
+			ociLBModel.EXPECT().reconcileRouteRedirectRuleSet(t.Context(), reconcileRouteRedirectRuleSetParams{
+				loadBalancerID: config.Spec.LoadBalancerID,
+				ruleSetName:    ociHTTPRouteRedirectRuleSetName(ociGatewayNamePrefix(gateway, config), &httpRoute),
+				items: []loadbalancer.Rule{
+					loadbalancer.RedirectRule{
+						Conditions: []loadbalancer.RuleCondition{
+							loadbalancer.PathMatchCondition{
+								AttributeValue: new("/old"),
+								Operator:       loadbalancer.PathMatchConditionOperatorExactMatch,
+							},
+						},
+						ResponseCode: new(http.StatusMovedPermanently),
+						RedirectUri: &loadbalancer.RedirectUri{
+							Protocol: new("HTTPS"),
+							Host:     new("{host}"),
+							Port:     new(443),
+							Path:     new("{path}"),
+							Query:    new("{query}"),
+						},
+					},
+				},
+				listenerNames: []string{string(listener.Name)},
+			}).Return(nil).Once().NotBefore(commitCall)
+
+			result, err := model.programRoute(t.Context(), programRouteParams{
+				gateway:          *gateway,
+				config:           config,
+				httpRoute:        httpRoute,
+				knownBackends:    map[string]corev1.Service{serviceKey: service},
+				matchedListeners: []gatewayv1.Listener{listener},
+			})
+
+			require.NoError(t, err)
+			assert.Empty(t, result.partiallyInvalidMessage)
+		})
+
+		t.Run("rejects redirect rules matching on headers before programming", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
+
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(gatewayv1.HTTPRouteRule{
+					Matches: []gatewayv1.HTTPRouteMatch{{
+						Headers: []gatewayv1.HTTPHeaderMatch{{Name: "X-Version", Value: "v1"}},
+					}},
+					Filters: []gatewayv1.HTTPRouteFilter{{
+						Type:            gatewayv1.HTTPRouteFilterRequestRedirect,
+						RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{Scheme: new("https")},
+					}},
+				}),
+			)
+
+			_, err := model.programRoute(t.Context(), programRouteParams{
+				gateway:          *newRandomGateway(),
+				config:           makeRandomGatewayConfig(),
+				httpRoute:        httpRoute,
+				matchedListeners: []gatewayv1.Listener{makeRandomListener()},
+			})
+
+			require.ErrorIs(t, err, errUnsupportedMatch)
+		})
+
+		t.Run("fails when redirect rule set can not be programmed", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
+
+			httpRoute := makeRandomHTTPRoute()
+			listener := makeRandomListener()
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+
+			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
+			ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), mock.Anything).Return(nil).Once()
+			ociLBModel.EXPECT().reconcileRouteRedirectRuleSet(t.Context(), mock.Anything).Return(wantErr).Once()
+
+			_, err := model.programRoute(t.Context(), programRouteParams{
+				gateway:          *newRandomGateway(),
+				config:           makeRandomGatewayConfig(),
+				httpRoute:        httpRoute,
+				matchedListeners: []gatewayv1.Listener{listener},
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+
 		t.Run("fails when resolved backend service is missing", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
 
 			gateway := newRandomGateway()
 			config := makeRandomGatewayConfig()
@@ -1648,6 +2354,8 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 		t.Run("program with previously programmed annotations passes stale rules for cleanup", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
 
 			// Setup test data
 			gateway := newRandomGateway()
@@ -1715,10 +2423,10 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 				rule.Name = new(ociListerPolicyRuleName(httpRoute, i))
 				expectedRules = append(expectedRules, rule)
 
-				ociLBModel.EXPECT().makeRoutingRule(t.Context(), makeRoutingRuleParams{
+				ociLBModel.EXPECT().makeRoutingRules(t.Context(), makeRoutingRuleParams{
 					httpRoute:          httpRoute,
 					httpRouteRuleIndex: i,
-				}).Return(rule, nil).Once()
+				}).Return([]loadbalancer.RoutingRule{rule}, nil).Once()
 			}
 
 			// Expect commitRoutingPolicyV2 to be called for each listener
@@ -1735,10 +2443,10 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			require.NoError(t, err)
 		})
 
-		t.Run("removes previously programmed rules from no longer matched listeners", func(t *testing.T) {
-			fake := faker.New()
+		t.Run("program with recorded programming state ignores legacy annotations", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
 
 			gateway := newRandomGateway()
 			config := makeRandomGatewayConfig()
@@ -1748,36 +2456,32 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 					makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(backendRef)),
 				),
 			)
-
-			currentListener := makeRandomListener()
-			staleListenerName := "stale-" + fake.Lorem().Word()
-			staleRules := []string{
-				"stale-rule-a-" + fake.Lorem().Word(),
-				"stale-rule-b-" + fake.Lorem().Word(),
-			}
 			httpRoute.Annotations = map[string]string{
-				HTTPRouteProgrammedPolicyRulesAnnotation: strings.Join([]string{
-					fmt.Sprintf("%s/%s", staleListenerName, staleRules[0]),
-					fmt.Sprintf("%s/%s", staleListenerName, staleRules[1]),
-				}, ","),
+				HTTPRouteProgrammedPolicyRulesAnnotation: "legacy_" + httpRoute.Name,
 			}
-
+			wantPreviousRules := []string{fmt.Sprintf("p0000_%s", httpRoute.Name)}
 			service := makeRandomService(randomServiceFromBackendRef(backendRef, &httpRoute))
-			knownServicesByName := map[string]corev1.Service{
-				types.NamespacedName{
-					Namespace: service.Namespace,
-					Name:      service.Name,
-				}.String(): service,
-			}
+			serviceKey := types.NamespacedName{Namespace: service.Namespace, Name: service.Name}.String()
+			listener := makeRandomListener()
 
 			params := programRouteParams{
 				gateway:          *gateway,
 				config:           config,
 				httpRoute:        httpRoute,
-				knownBackends:    knownServicesByName,
-				matchedListeners: []gatewayv1.Listener{currentListener},
+				knownBackends:    map[string]corev1.Service{serviceKey: service},
+				matchedListeners: []gatewayv1.Listener{listener},
 			}
 
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedRoutePolicyRules(t.Context(), mock.MatchedBy(func(params routeProgrammingStateParams) bool {
+					return params.gateway.Name == gateway.Name &&
+						params.routeKind == "HTTPRoute" &&
+						params.route.GetName() == httpRoute.Name
+				})).
+				Return(wantPreviousRules, true, nil).
+				Once()
+
 			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
 			ociLBModel.EXPECT().reconcileBackendSet(t.Context(), reconcileBackendSetParams{
 				loadBalancerID: config.Spec.LoadBalancerID,
@@ -1788,46 +2492,137 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 
 			rule := makeRandomOCIRoutingRule()
 			rule.Name = new(ociListerPolicyRuleName(httpRoute, 0))
-			ociLBModel.EXPECT().makeRoutingRule(t.Context(), makeRoutingRuleParams{
+			ociLBModel.EXPECT().makeRoutingRules(t.Context(), makeRoutingRuleParams{
 				httpRoute:          httpRoute,
 				httpRouteRuleIndex: 0,
-			}).Return(rule, nil)
-
-			currentCommit := ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
-				loadBalancerID: config.Spec.LoadBalancerID,
-				listenerName:   string(currentListener.Name),
-				policyRules:    []loadbalancer.RoutingRule{rule},
-			}).Return(nil).Once()
-
+			}).Return([]loadbalancer.RoutingRule{rule}, nil).Once()
 			ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
 				loadBalancerID:  config.Spec.LoadBalancerID,
-				listenerName:    staleListenerName,
-				policyRules:     []loadbalancer.RoutingRule{},
-				prevPolicyRules: staleRules,
-			}).Return(nil).Once().NotBefore(currentCommit)
+				listenerName:    string(listener.Name),
+				policyRules:     []loadbalancer.RoutingRule{rule},
+				prevPolicyRules: wantPreviousRules,
+			}).Return(nil)
 
-			result, err := model.programRoute(t.Context(), params)
+			_, err := model.programRoute(t.Context(), params)
 			require.NoError(t, err)
-			assert.Equal(t, []string{
-				fmt.Sprintf("%s/%s", currentListener.Name, lo.FromPtr(rule.Name)),
-			}, result.programmedPolicyRules)
 		})
 
-		t.Run("fails when reconcile backend set fails", func(t *testing.T) {
-			fake := faker.New()
+		t.Run("fails when programming state lookup fails", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
 
-			// Setup test data
-			gateway := newRandomGateway()
-			config := makeRandomGatewayConfig()
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedRoutePolicyRules(t.Context(), mock.Anything).
+				Return(nil, false, wantErr).
+				Once()
+
+			_, err := model.programRoute(t.Context(), programRouteParams{
+				gateway:   *newRandomGateway(),
+				config:    makeRandomGatewayConfig(),
+				httpRoute: makeRandomHTTPRoute(),
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+
+		t.Run("removes previously programmed rules from no longer matched listeners", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
+
+			gateway := newRandomGateway()
+			config := makeRandomGatewayConfig()
+			backendRef := makeRandomBackendRef()
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(
+					makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(backendRef)),
+				),
+			)
+
+			currentListener := makeRandomListener()
+			staleListenerName := "stale-" + fake.Lorem().Word()
+			staleRules := []string{
+				"stale-rule-a-" + fake.Lorem().Word(),
+				"stale-rule-b-" + fake.Lorem().Word(),
+			}
+			httpRoute.Annotations = map[string]string{
+				HTTPRouteProgrammedPolicyRulesAnnotation: strings.Join([]string{
+					fmt.Sprintf("%s/%s", staleListenerName, staleRules[0]),
+					fmt.Sprintf("%s/%s", staleListenerName, staleRules[1]),
+				}, ","),
+			}
 
-			backendRef := makeRandomBackendRef()
-			httpRoute := makeRandomHTTPRoute(
-				randomHTTPRouteWithRulesOpt(
-					makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(backendRef)),
-				),
-			)
+			service := makeRandomService(randomServiceFromBackendRef(backendRef, &httpRoute))
+			knownServicesByName := map[string]corev1.Service{
+				types.NamespacedName{
+					Namespace: service.Namespace,
+					Name:      service.Name,
+				}.String(): service,
+			}
+
+			params := programRouteParams{
+				gateway:          *gateway,
+				config:           config,
+				httpRoute:        httpRoute,
+				knownBackends:    knownServicesByName,
+				matchedListeners: []gatewayv1.Listener{currentListener},
+			}
+
+			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
+			ociLBModel.EXPECT().reconcileBackendSet(t.Context(), reconcileBackendSetParams{
+				loadBalancerID: config.Spec.LoadBalancerID,
+				service:        service,
+				routeNS:        httpRoute.Namespace,
+				backendRef:     backendRef.BackendRef,
+			}).Return(nil)
+
+			rule := makeRandomOCIRoutingRule()
+			rule.Name = new(ociListerPolicyRuleName(httpRoute, 0))
+			ociLBModel.EXPECT().makeRoutingRules(t.Context(), makeRoutingRuleParams{
+				httpRoute:          httpRoute,
+				httpRouteRuleIndex: 0,
+			}).Return([]loadbalancer.RoutingRule{rule}, nil)
+
+			currentCommit := ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+				loadBalancerID: config.Spec.LoadBalancerID,
+				listenerName:   string(currentListener.Name),
+				policyRules:    []loadbalancer.RoutingRule{rule},
+			}).Return(nil).Once()
+
+			ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+				loadBalancerID:  config.Spec.LoadBalancerID,
+				listenerName:    staleListenerName,
+				policyRules:     []loadbalancer.RoutingRule{},
+				prevPolicyRules: staleRules,
+			}).Return(nil).Once().NotBefore(currentCommit)
+
+			result, err := model.programRoute(t.Context(), params)
+			require.NoError(t, err)
+			assert.Equal(t, []string{
+				fmt.Sprintf("%s/%s", currentListener.Name, lo.FromPtr(rule.Name)),
+			}, result.programmedPolicyRules)
+		})
+
+		t.Run("fails when reconcile backend set fails", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
+
+			// Setup test data
+			gateway := newRandomGateway()
+			config := makeRandomGatewayConfig()
+
+			backendRef := makeRandomBackendRef()
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(
+					makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(backendRef)),
+				),
+			)
 			service := makeRandomService(randomServiceFromBackendRef(backendRef, &httpRoute))
 			services := map[string]corev1.Service{
 				types.NamespacedName{Namespace: service.Namespace, Name: service.Name}.String(): service,
@@ -1856,10 +2651,11 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			assert.ErrorIs(t, err, wantErr)
 		})
 
-		t.Run("fails when makeRoutingRule fails", func(t *testing.T) {
+		t.Run("fails when makeRoutingRules fails", func(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
 
 			// Setup test data
 			gateway := newRandomGateway()
@@ -1895,7 +2691,7 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			wantErr := errors.New(fake.Lorem().Sentence(10))
 
 			// Making routing rule fails
-			ociLBModel.EXPECT().makeRoutingRule(t.Context(), mock.Anything).Return(loadbalancer.RoutingRule{}, wantErr)
+			ociLBModel.EXPECT().makeRoutingRules(t.Context(), mock.Anything).Return(nil, wantErr)
 
 			_, err := model.programRoute(t.Context(), params)
 			require.Error(t, err)
@@ -1906,6 +2702,7 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
+			expectNoRecordedPolicyRules(t, deps)
 
 			// Setup test data
 			gateway := newRandomGateway()
@@ -1940,7 +2737,8 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 
 			// Making routing rule succeeds
 			rule := makeRandomOCIRoutingRule()
-			ociLBModel.EXPECT().makeRoutingRule(t.Context(), mock.Anything).Return(rule, nil)
+			ociLBModel.EXPECT().makeRoutingRules(t.Context(), mock.Anything).
+				Return([]loadbalancer.RoutingRule{rule}, nil)
 
 			wantErr := errors.New(fake.Lorem().Sentence(10))
 
@@ -2057,6 +2855,45 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			assert.True(t, required)
 		})
 
+		t.Run("ProgrammingRequired/RulePriorityChanged", func(t *testing.T) {
+			deps := newMockDeps(t)
+			deps.ResourcesModel = newResourcesModel(resourcesModelDeps{
+				K8sClient:  deps.K8sClient,
+				RootLogger: diag.RootTestLogger(),
+			})
+			model := newHTTPRouteModel(deps)
+			controllerName, details := newIsProgrammingRequiredDetails()
+
+			details.httpRoute.Annotations = map[string]string{
+				HTTPRouteProgrammingRevisionAnnotation: HTTPRouteProgrammingRevisionValue,
+				HTTPRouteRulePriorityAnnotation:        "-100",
+			}
+			details.httpRoute.Status.Parents = []gatewayv1.RouteParentStatus{
+				{
+					ControllerName: controllerName,
+					ParentRef:      details.matchedRef,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(gatewayv1.RouteConditionResolvedRefs),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: details.httpRoute.Generation,
+						},
+					},
+				},
+			}
+
+			required, err := model.isProgrammingRequired(details)
+			require.NoError(t, err)
+			assert.True(t, required)
+
+			details.httpRoute.Annotations[HTTPRouteProgrammingRevisionAnnotation] = httpRouteProgrammingRevision(
+				details.httpRoute,
+			)
+			required, err = model.isProgrammingRequired(details)
+			require.NoError(t, err)
+			assert.False(t, required)
+		})
+
 		t.Run("ProgrammingRequired/ParentRefMismatch", func(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
@@ -2101,6 +2938,18 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			}
 			// Set the correct parent ref for the target index
 			route.Status.Parents[parentStatusIndex].ParentRef = matchedRef
+			// Already reported, so setting it again does not change the conditions
+			route.Status.Parents[parentStatusIndex].Conditions = append(
+				route.Status.Parents[parentStatusIndex].Conditions,
+				metav1.Condition{
+					Type:               RouteConditionProgrammed,
+					Status:             metav1.ConditionTrue,
+					Reason:             RouteReasonProgrammed,
+					Message:            fmt.Sprintf("Route programmed by %s", gatewayData.gateway.Name),
+					ObservedGeneration: route.Generation,
+					LastTransitionTime: metav1.Now(),
+				},
+			)
 
 			params := setProgrammedParams{
 				httpRoute:    route,
@@ -2114,6 +2963,15 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 				},
 			}
 
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().recordProgrammedRoute(t.Context(), recordProgrammedRouteParams{
+				gateway:     params.gateway,
+				routeKind:   "HTTPRoute",
+				route:       &route,
+				policyRules: params.programmedPolicyRules,
+				backendSets: l7RouteBackendSetNames(ociResourceNaming{}, route.Namespace, httpRouteBackendRefs(route)),
+			}).Return(nil).Once()
+
 			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
 			mockResourcesModel.EXPECT().setCondition(t.Context(), setConditionParams{
 				resource:      &route,
@@ -2123,8 +2981,7 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 				reason:        string(gatewayv1.RouteReasonResolvedRefs),
 				message:       fmt.Sprintf("Route programmed by %s", params.gateway.Name),
 				annotations: map[string]string{
-					HTTPRouteProgrammingRevisionAnnotation:   HTTPRouteProgrammingRevisionValue,
-					HTTPRouteProgrammedPolicyRulesAnnotation: strings.Join(params.programmedPolicyRules, ","),
+					HTTPRouteProgrammingRevisionAnnotation: HTTPRouteProgrammingRevisionValue,
 				},
 				finalizer: HTTPRouteProgrammedFinalizer,
 			}).Return(nil)
@@ -2134,6 +2991,117 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			require.NoError(t, err)
 		})
 
+		t.Run("sets partially invalid condition of conflicting rules", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			route := makeRandomHTTPRoute()
+			gatewayData := makeRandomAcceptedGatewayDetails()
+			matchedRef := makeRandomParentRef()
+			route.Status.Parents = []gatewayv1.RouteParentStatus{
+				{
+					ParentRef:      matchedRef,
+					ControllerName: gatewayData.gatewayClass.Spec.ControllerName,
+				},
+			}
+			wantMessage := faker.New().Lorem().Sentence(5)
+
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().recordProgrammedRoute(t.Context(), mock.Anything).Return(nil).Once()
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockResourcesModel.EXPECT().setCondition(t.Context(), mock.MatchedBy(func(params setConditionParams) bool {
+				condition := meta.FindStatusCondition(
+					*params.conditions, string(gatewayv1.RouteConditionPartiallyInvalid),
+				)
+				return assert.NotNil(t, condition) &&
+					assert.Equal(t, metav1.ConditionTrue, condition.Status) &&
+					assert.Equal(t, string(gatewayv1.RouteReasonUnsupportedValue), condition.Reason) &&
+					assert.Equal(t, wantMessage, condition.Message)
+			})).Return(nil).Once()
+
+			err := model.setProgrammed(t.Context(), setProgrammedParams{
+				httpRoute:               route,
+				gatewayClass:            gatewayData.gatewayClass,
+				gateway:                 gatewayData.gateway,
+				matchedRef:              matchedRef,
+				partiallyInvalidMessage: wantMessage,
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("sets programmed condition", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			route := makeRandomHTTPRoute()
+			gatewayData := makeRandomAcceptedGatewayDetails()
+			matchedRef := makeRandomParentRef()
+			route.Status.Parents = []gatewayv1.RouteParentStatus{
+				{
+					ParentRef:      matchedRef,
+					ControllerName: gatewayData.gatewayClass.Spec.ControllerName,
+					Conditions: []metav1.Condition{
+						{Type: RouteConditionProgrammed, Status: metav1.ConditionUnknown, Reason: RouteReasonPending},
+					},
+				},
+			}
+
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().recordProgrammedRoute(t.Context(), mock.Anything).Return(nil).Once()
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockResourcesModel.EXPECT().setCondition(t.Context(), mock.MatchedBy(func(params setConditionParams) bool {
+				condition := meta.FindStatusCondition(*params.conditions, RouteConditionProgrammed)
+				return assert.NotNil(t, condition) &&
+					assert.Equal(t, metav1.ConditionTrue, condition.Status) &&
+					assert.Equal(t, RouteReasonProgrammed, condition.Reason)
+			})).Return(nil).Once()
+
+			err := model.setProgrammed(t.Context(), setProgrammedParams{
+				httpRoute:    route,
+				gatewayClass: gatewayData.gatewayClass,
+				gateway:      gatewayData.gateway,
+				matchedRef:   matchedRef,
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("removes partially invalid condition once rules are valid", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			route := makeRandomHTTPRoute()
+			gatewayData := makeRandomAcceptedGatewayDetails()
+			matchedRef := makeRandomParentRef()
+			route.Status.Parents = []gatewayv1.RouteParentStatus{
+				{
+					ParentRef:      matchedRef,
+					ControllerName: gatewayData.gatewayClass.Spec.ControllerName,
+					Conditions: []metav1.Condition{
+						{Type: string(gatewayv1.RouteConditionPartiallyInvalid), Status: metav1.ConditionTrue},
+					},
+				},
+			}
+
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().recordProgrammedRoute(t.Context(), mock.Anything).Return(nil).Once()
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockResourcesModel.EXPECT().setCondition(t.Context(), mock.MatchedBy(func(params setConditionParams) bool {
+				return assert.Nil(t,
+					meta.FindStatusCondition(*params.conditions, string(gatewayv1.RouteConditionPartiallyInvalid)))
+			})).Return(nil).Once()
+
+			err := model.setProgrammed(t.Context(), setProgrammedParams{
+				httpRoute:    route,
+				gatewayClass: gatewayData.gatewayClass,
+				gateway:      gatewayData.gateway,
+				matchedRef:   matchedRef,
+			})
+			require.NoError(t, err)
+		})
+
 		t.Run("parent status not found (wrong controller)", func(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
@@ -2163,45 +3131,356 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			assert.Contains(t, err.Error(), "parent status not found for controller")
 		})
 
-		t.Run("parent status not found (wrong parentRef)", func(t *testing.T) {
+		t.Run("parent status not found (wrong parentRef)", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			route := makeRandomHTTPRoute()
+			gatewayData := makeRandomAcceptedGatewayDetails()
+			matchedRef := makeRandomParentRef()
+			wrongParentRef := makeRandomParentRef()
+
+			// Add a status with the correct controller, but wrong parentRef
+			route.Status.Parents = []gatewayv1.RouteParentStatus{
+				{
+					ParentRef:      wrongParentRef,
+					ControllerName: gatewayData.gatewayClass.Spec.ControllerName,
+				},
+			}
+
+			details := setProgrammedParams{
+				httpRoute:    route,
+				gatewayClass: gatewayData.gatewayClass,
+				gateway:      gatewayData.gateway,
+				matchedRef:   matchedRef, // The ref we are looking for
+			}
+
+			err := model.setProgrammed(t.Context(), details)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "parent status not found for controller")
+		})
+
+		t.Run("update fails", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			route := makeRandomHTTPRoute()
+			gatewayData := makeRandomAcceptedGatewayDetails()
+			matchedRef := makeRandomParentRef()
+
+			// Add a matching parent status entry
+			route.Status.Parents = []gatewayv1.RouteParentStatus{
+				{
+					ParentRef:      matchedRef,
+					ControllerName: gatewayData.gatewayClass.Spec.ControllerName,
+				},
+			}
+
+			details := setProgrammedParams{
+				httpRoute:    route,
+				gatewayClass: gatewayData.gatewayClass,
+				gateway:      gatewayData.gateway,
+				matchedRef:   matchedRef,
+			}
+
+			updateErr := errors.New(fake.Lorem().Sentence(10))
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().recordProgrammedRoute(t.Context(), mock.Anything).Return(nil).Once()
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockResourcesModel.EXPECT().setCondition(t.Context(), mock.Anything).Return(updateErr)
+
+			err := model.setProgrammed(t.Context(), details)
+			require.ErrorIs(t, err, updateErr)
+		})
+
+		t.Run("fails when recording programming state fails", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			route := makeRandomHTTPRoute()
+			gatewayData := makeRandomAcceptedGatewayDetails()
+			matchedRef := makeRandomParentRef()
+			route.Status.Parents = []gatewayv1.RouteParentStatus{
+				{
+					ParentRef:      matchedRef,
+					ControllerName: gatewayData.gatewayClass.Spec.ControllerName,
+				},
+			}
+
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().recordProgrammedRoute(t.Context(), mock.Anything).Return(wantErr).Once()
+
+			err := model.setProgrammed(t.Context(), setProgrammedParams{
+				httpRoute:    route,
+				gatewayClass: gatewayData.gatewayClass,
+				gateway:      gatewayData.gateway,
+				matchedRef:   matchedRef,
+			})
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("setBackendsHealth", func(t *testing.T) {
+		setupRoute := func(t *testing.T, deps httpRouteModelDeps, route gatewayv1.HTTPRoute) {
+			setupClientGet(t, deps.K8sClient, client.ObjectKeyFromObject(&route), route)
+		}
+
+		t.Run("sets condition on matched parent", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			route := makeRandomHTTPRoute()
+			gatewayData := makeRandomAcceptedGatewayDetails()
+			matchedRef := makeRandomParentRef()
+			route.Status.Parents = []gatewayv1.RouteParentStatus{
+				{
+					ParentRef:      makeRandomParentRef(),
+					ControllerName: gatewayData.gatewayClass.Spec.ControllerName,
+				},
+				{
+					ParentRef:      matchedRef,
+					ControllerName: gatewayData.gatewayClass.Spec.ControllerName,
+				},
+			}
+			setupRoute(t, deps, route)
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockResourcesModel.EXPECT().setCondition(t.Context(), setConditionParams{
+				resource:      &route,
+				conditions:    &route.Status.Parents[1].Conditions,
+				conditionType: HTTPRouteConditionBackendsHealthy,
+				status:        metav1.ConditionFalse,
+				reason:        HTTPRouteReasonBackendsUnhealthy,
+				message:       "2 of 3 backends healthy, 1 critical, 0 warning, 0 unknown",
+			}).Return(nil)
+
+			err := model.setBackendsHealth(t.Context(), setBackendsHealthParams{
+				httpRoute: makeRandomHTTPRoute(
+					randomHTTPRouteWithNameOpt(route.Name),
+					randomHTTPRouteWithNamespaceOpt(route.Namespace),
+				),
+				gatewayClass: gatewayData.gatewayClass,
+				matchedRef:   matchedRef,
+				health:       backendsHealthSummary{total: 3, critical: 1},
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("skips update when condition is up to date", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			route := makeRandomHTTPRoute()
+			gatewayData := makeRandomAcceptedGatewayDetails()
+			matchedRef := makeRandomParentRef()
+			route.Status.Parents = []gatewayv1.RouteParentStatus{{
+				ParentRef:      matchedRef,
+				ControllerName: gatewayData.gatewayClass.Spec.ControllerName,
+				Conditions: []metav1.Condition{{
+					Type:               HTTPRouteConditionBackendsHealthy,
+					Status:             metav1.ConditionTrue,
+					Reason:             HTTPRouteReasonBackendsHealthy,
+					Message:            "2 of 2 backends healthy, 0 critical, 0 warning, 0 unknown",
+					ObservedGeneration: route.Generation,
+				}},
+			}}
+			setupRoute(t, deps, route)
+
+			err := model.setBackendsHealth(t.Context(), setBackendsHealthParams{
+				httpRoute:    route,
+				gatewayClass: gatewayData.gatewayClass,
+				matchedRef:   matchedRef,
+				health:       backendsHealthSummary{total: 2},
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("ignores routes without matching parent status", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			route := makeRandomHTTPRoute()
+			setupRoute(t, deps, route)
+
+			err := model.setBackendsHealth(t.Context(), setBackendsHealthParams{
+				httpRoute:    route,
+				gatewayClass: makeRandomAcceptedGatewayDetails().gatewayClass,
+				matchedRef:   makeRandomParentRef(),
+				health:       backendsHealthSummary{total: 2},
+			})
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("setPaused", func(t *testing.T) {
+		t.Run("sets condition on matched parent", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			route := makeRandomHTTPRoute()
+			gatewayData := makeRandomAcceptedGatewayDetails()
+			matchedRef := makeRandomParentRef()
+			route.Status.Parents = []gatewayv1.RouteParentStatus{
+				{
+					ParentRef:      makeRandomParentRef(),
+					ControllerName: gatewayData.gatewayClass.Spec.ControllerName,
+				},
+				{
+					ParentRef:      matchedRef,
+					ControllerName: gatewayData.gatewayClass.Spec.ControllerName,
+				},
+			}
+			setupClientGet(t, deps.K8sClient, client.ObjectKeyFromObject(&route), route)
+
+			condition := pausedCondition{
+				status:  metav1.ConditionTrue,
+				reason:  ReasonPaused,
+				message: faker.New().Lorem().Sentence(5),
+			}
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockResourcesModel.EXPECT().setCondition(t.Context(), setConditionParams{
+				resource:      &route,
+				conditions:    &route.Status.Parents[1].Conditions,
+				conditionType: ConditionPaused,
+				status:        condition.status,
+				reason:        condition.reason,
+				message:       condition.message,
+			}).Return(nil)
+
+			err := model.setPaused(t.Context(), setPausedParams{
+				httpRoute:    route,
+				gatewayClass: gatewayData.gatewayClass,
+				matchedRef:   matchedRef,
+				condition:    condition,
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("ignores routes without matching parent status", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+
+			route := makeRandomHTTPRoute()
+			setupClientGet(t, deps.K8sClient, client.ObjectKeyFromObject(&route), route)
+
+			err := model.setPaused(t.Context(), setPausedParams{
+				httpRoute:    route,
+				gatewayClass: makeRandomAcceptedGatewayDetails().gatewayClass,
+				matchedRef:   makeRandomParentRef(),
+			})
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("setRejected", func(t *testing.T) {
+		makeRouteDetails := func() resolvedRouteDetails {
+			gatewayData := makeRandomAcceptedGatewayDetails()
+			matchedRef := makeRandomParentRef()
+			route := makeRandomHTTPRoute()
+			route.Status.Parents = []gatewayv1.RouteParentStatus{
+				{
+					ParentRef:      makeRandomParentRef(),
+					ControllerName: gatewayData.gatewayClass.Spec.ControllerName,
+				},
+				{
+					ParentRef:      matchedRef,
+					ControllerName: gatewayData.gatewayClass.Spec.ControllerName,
+				},
+			}
+			return resolvedRouteDetails{
+				gatewayDetails: *gatewayData,
+				httpRoute:      route,
+				matchedRef:     matchedRef,
+			}
+		}
+
+		t.Run("success", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			routeDetails := makeRouteDetails()
+			statusErr := httpRouteStatusError{
+				conditionType: gatewayv1.RouteConditionAccepted,
+				reason:        routeReasonRoutingPolicyCapacityExceeded,
+				message:       fake.Lorem().Sentence(8),
+			}
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockResourcesModel.EXPECT().setCondition(t.Context(), mock.MatchedBy(func(params setConditionParams) bool {
+				route, _ := params.resource.(*gatewayv1.HTTPRoute)
+				return route.Name == routeDetails.httpRoute.Name &&
+					params.conditions == &route.Status.Parents[1].Conditions &&
+					params.conditionType == string(gatewayv1.RouteConditionAccepted) &&
+					params.status == metav1.ConditionFalse &&
+					params.reason == string(routeReasonRoutingPolicyCapacityExceeded) &&
+					params.message == statusErr.message
+			})).Return(nil).Once()
+
+			err := model.setRejected(t.Context(), routeDetails, statusErr)
+			require.NoError(t, err)
+		})
+
+		t.Run("fails pending programming", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			routeDetails := makeRouteDetails()
+			routeDetails.httpRoute.Status.Parents[1].Conditions = []metav1.Condition{
+				{Type: RouteConditionProgrammed, Status: metav1.ConditionUnknown, Reason: RouteReasonPending},
+			}
+			statusErr := httpRouteStatusError{
+				conditionType: gatewayv1.RouteConditionResolvedRefs,
+				reason:        gatewayv1.RouteReasonUnsupportedValue,
+				message:       fake.Lorem().Sentence(8),
+			}
+
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockResourcesModel.EXPECT().setCondition(t.Context(), mock.MatchedBy(func(params setConditionParams) bool {
+				condition := meta.FindStatusCondition(*params.conditions, RouteConditionProgrammed)
+				return assert.NotNil(t, condition) &&
+					assert.Equal(t, metav1.ConditionFalse, condition.Status) &&
+					assert.Equal(t, string(gatewayv1.RouteReasonUnsupportedValue), condition.Reason) &&
+					assert.Equal(t, statusErr.message, condition.Message)
+			})).Return(nil).Once()
+
+			err := model.setRejected(t.Context(), routeDetails, statusErr)
+			require.NoError(t, err)
+		})
+
+		t.Run("parent status not found", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
+			routeDetails := makeRouteDetails()
+			routeDetails.matchedRef = makeRandomParentRef()
 
-			route := makeRandomHTTPRoute()
-			gatewayData := makeRandomAcceptedGatewayDetails()
-			matchedRef := makeRandomParentRef()
-			wrongParentRef := makeRandomParentRef()
+			err := model.setRejected(t.Context(), routeDetails, httpRouteStatusError{})
+			require.Error(t, err)
+		})
 
-			// Add a status with the correct controller, but wrong parentRef
-			route.Status.Parents = []gatewayv1.RouteParentStatus{
-				{
-					ParentRef:      wrongParentRef,
-					ControllerName: gatewayData.gatewayClass.Spec.ControllerName,
-				},
-			}
+		t.Run("status update error", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			wantErr := errors.New(fake.Lorem().Sentence(10))
 
-			details := setProgrammedParams{
-				httpRoute:    route,
-				gatewayClass: gatewayData.gatewayClass,
-				gateway:      gatewayData.gateway,
-				matchedRef:   matchedRef, // The ref we are looking for
-			}
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockResourcesModel.EXPECT().setCondition(t.Context(), mock.Anything).Return(wantErr).Once()
 
-			err := model.setProgrammed(t.Context(), details)
-			require.Error(t, err)
-			assert.Contains(t, err.Error(), "parent status not found for controller")
+			err := model.setRejected(t.Context(), makeRouteDetails(), httpRouteStatusError{})
+			require.ErrorIs(t, err, wantErr)
 		})
+	})
 
-		t.Run("update fails", func(t *testing.T) {
-			fake := faker.New()
+	t.Run("setProgramming", func(t *testing.T) {
+		t.Run("sets programmed condition to pending", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
 
 			route := makeRandomHTTPRoute()
 			gatewayData := makeRandomAcceptedGatewayDetails()
 			matchedRef := makeRandomParentRef()
-
-			// Add a matching parent status entry
 			route.Status.Parents = []gatewayv1.RouteParentStatus{
 				{
 					ParentRef:      matchedRef,
@@ -2209,19 +3488,35 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 				},
 			}
 
-			details := setProgrammedParams{
-				httpRoute:    route,
+			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
+			mockResourcesModel.EXPECT().setCondition(t.Context(), setConditionParams{
+				resource:      &route,
+				conditions:    &route.Status.Parents[0].Conditions,
+				conditionType: RouteConditionProgrammed,
+				status:        metav1.ConditionUnknown,
+				reason:        RouteReasonPending,
+				message:       fmt.Sprintf("Programming route generation %d", route.Generation),
+			}).Return(nil).Once()
+
+			err := model.setProgramming(t.Context(), setRouteProgrammingParams{
+				httpRoute:    &route,
 				gatewayClass: gatewayData.gatewayClass,
-				gateway:      gatewayData.gateway,
 				matchedRef:   matchedRef,
-			}
+			})
+			require.NoError(t, err)
+		})
 
-			updateErr := errors.New(fake.Lorem().Sentence(10))
-			mockResourcesModel, _ := deps.ResourcesModel.(*MockresourcesModel)
-			mockResourcesModel.EXPECT().setCondition(t.Context(), mock.Anything).Return(updateErr)
+		t.Run("parent status not found", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			route := makeRandomHTTPRoute()
 
-			err := model.setProgrammed(t.Context(), details)
-			require.ErrorIs(t, err, updateErr)
+			err := model.setProgramming(t.Context(), setRouteProgrammingParams{
+				httpRoute:    &route,
+				gatewayClass: makeRandomAcceptedGatewayDetails().gatewayClass,
+				matchedRef:   makeRandomParentRef(),
+			})
+			require.Error(t, err)
 		})
 	})
 
@@ -2230,6 +3525,8 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
 
 			wantBackendRefs := []gatewayv1.HTTPBackendRef{
 				makeRandomBackendRef(),
@@ -2263,9 +3560,11 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			}
 
 			listeners := makeFewRandomListeners()
+			gateway := newRandomGateway()
+			gateway.Spec.Listeners = listeners
 
 			params := deprovisionRouteParams{
-				gateway:          *newRandomGateway(),
+				gateway:          *gateway,
 				config:           config,
 				httpRoute:        httpRoute,
 				matchedListeners: listeners,
@@ -2283,7 +3582,14 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 				}).Return(nil).Once()
 			}
 
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
 			for _, backendRef := range wantBackendRefs {
+				programmingState.EXPECT().backendSetReferences(t.Context(), backendSetReferencesParams{
+					gateway:        *gateway,
+					routeKind:      "HTTPRoute",
+					route:          &params.httpRoute,
+					backendSetName: ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef),
+				}).Return(nil, nil).Once()
 				ociLBModel.EXPECT().deprovisionBackendSet(t.Context(), deprovisionBackendSetParams{
 					loadBalancerID: config.Spec.LoadBalancerID,
 					routeNamespace: httpRoute.Namespace,
@@ -2293,6 +3599,7 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 
 			// Expect client update for finalizer removal
 			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			setupClientGet(t, mockK8sClient, client.ObjectKeyFromObject(&httpRoute), httpRoute)
 			var updatedRoute *gatewayv1.HTTPRoute
 			mockK8sClient.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
 				var ok bool
@@ -2303,13 +3610,110 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 				return ok && assert.Equal(t, httpRoute.Name, updatedRoute.Name)
 			})).Return(nil)
 
+			programmingState.EXPECT().
+				forgetProgrammedRoute(t.Context(), mock.MatchedBy(func(params routeProgrammingStateParams) bool {
+					return params.routeKind == "HTTPRoute" && params.route.GetName() == httpRoute.Name
+				})).
+				Return(nil).
+				Once()
+
+			err := model.deprovisionRoute(t.Context(), params)
+			require.NoError(t, err)
+		})
+
+		t.Run("keeps backend sets referenced by other routes", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
+
+			sharedRef := makeRandomBackendRef()
+			ownRef := makeRandomBackendRef()
+			config := makeRandomGatewayConfig()
+			httpRoute := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(
+				makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(sharedRef, ownRef)),
+				makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(sharedRef)),
+			))
+			previousRule := "rule-" + faker.New().Lorem().Word()
+			httpRoute.Annotations = map[string]string{
+				HTTPRouteProgrammedPolicyRulesAnnotation: previousRule,
+			}
+			listener := makeRandomListener()
+			gateway := newRandomGateway()
+			gateway.Spec.Listeners = []gatewayv1.Listener{listener}
+			params := deprovisionRouteParams{
+				gateway:          *gateway,
+				config:           config,
+				httpRoute:        httpRoute,
+				matchedListeners: gateway.Spec.Listeners,
+			}
+
+			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
+			ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), mock.Anything).Return(nil).Once()
+
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().backendSetReferences(t.Context(), backendSetReferencesParams{
+				gateway:        *gateway,
+				routeKind:      "HTTPRoute",
+				route:          &params.httpRoute,
+				backendSetName: ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, sharedRef),
+			}).Return([]string{"HTTPRoute other/route"}, nil).Once()
+			programmingState.EXPECT().backendSetReferences(t.Context(), backendSetReferencesParams{
+				gateway:        *gateway,
+				routeKind:      "HTTPRoute",
+				route:          &params.httpRoute,
+				backendSetName: ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, ownRef),
+			}).Return(nil, nil).Once()
+			ociLBModel.EXPECT().deprovisionBackendSet(t.Context(), deprovisionBackendSetParams{
+				loadBalancerID: config.Spec.LoadBalancerID,
+				routeNamespace: httpRoute.Namespace,
+				backendRef:     ownRef.BackendRef,
+			}).Return(nil).Once()
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			setupClientGet(t, mockK8sClient, client.ObjectKeyFromObject(&httpRoute), httpRoute)
+			mockK8sClient.EXPECT().Update(t.Context(), mock.Anything).Return(nil).Once()
+			programmingState.EXPECT().forgetProgrammedRoute(t.Context(), mock.Anything).Return(nil).Once()
+
 			err := model.deprovisionRoute(t.Context(), params)
 			require.NoError(t, err)
 		})
 
+		t.Run("fails when backend set references can not be resolved", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
+
+			httpRoute := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(
+				makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(makeRandomBackendRef())),
+			))
+			httpRoute.Annotations = map[string]string{
+				HTTPRouteProgrammedPolicyRulesAnnotation: "rule-" + faker.New().Lorem().Word(),
+			}
+			gateway := newRandomGateway()
+			gateway.Spec.Listeners = []gatewayv1.Listener{makeRandomListener()}
+			wantErr := errors.New(faker.New().Lorem().Sentence(5))
+
+			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
+			ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), mock.Anything).Return(nil).Once()
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().backendSetReferences(t.Context(), mock.Anything).Return(nil, wantErr).Once()
+
+			err := model.deprovisionRoute(t.Context(), deprovisionRouteParams{
+				gateway:          *gateway,
+				config:           makeRandomGatewayConfig(),
+				httpRoute:        httpRoute,
+				matchedListeners: gateway.Spec.Listeners,
+			})
+			require.ErrorIs(t, err, wantErr)
+		})
+
 		t.Run("successfully deprovisions route with no previous rules annotation", func(t *testing.T) {
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
 
 			config := makeRandomGatewayConfig()
 			httpRoute := makeRandomHTTPRoute()
@@ -2334,6 +3738,8 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
 
 			config := makeRandomGatewayConfig()
 			httpRoute := makeRandomHTTPRoute()
@@ -2372,19 +3778,253 @@ func TestHTTPRouteModelImpl(t *testing.T) {
 			}).Return(nil).Once().NotBefore(currentCommit)
 
 			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			setupClientGet(t, mockK8sClient, client.ObjectKeyFromObject(&httpRoute), httpRoute)
 			mockK8sClient.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
 				updatedRoute, ok := obj.(*gatewayv1.HTTPRoute)
 				return ok && assert.NotContains(t, updatedRoute.Finalizers, HTTPRouteProgrammedFinalizer)
 			})).Return(nil)
 
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				forgetProgrammedRoute(t.Context(), mock.MatchedBy(func(params routeProgrammingStateParams) bool {
+					return params.routeKind == "HTTPRoute" && params.route.GetName() == httpRoute.Name
+				})).
+				Return(nil).
+				Once()
+
 			err := model.deprovisionRoute(t.Context(), params)
 			require.NoError(t, err)
 		})
 
+		t.Run("deprovisions legacy rules from all gateway listeners", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
+
+			config := makeRandomGatewayConfig()
+			previousListener := makeRandomListener()
+			currentListener := makeRandomListener()
+			tlsListener := makeRandomListener()
+			tlsListener.Protocol = gatewayv1.TLSProtocolType
+			gateway := newRandomGateway()
+			gateway.Spec.Listeners = []gatewayv1.Listener{previousListener, currentListener, tlsListener}
+
+			legacyRule := "legacy-rule-" + fake.Lorem().Word()
+			httpRoute := makeRandomHTTPRoute()
+			httpRoute.Finalizers = []string{HTTPRouteProgrammedFinalizer}
+			httpRoute.Annotations = map[string]string{
+				HTTPRouteProgrammedPolicyRulesAnnotation: legacyRule,
+			}
+
+			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
+			for _, listener := range []gatewayv1.Listener{previousListener, currentListener} {
+				ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+					loadBalancerID:  config.Spec.LoadBalancerID,
+					listenerName:    string(listener.Name),
+					policyRules:     []loadbalancer.RoutingRule{},
+					prevPolicyRules: []string{legacyRule},
+				}).Return(nil).Once()
+			}
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			setupClientGet(t, mockK8sClient, client.ObjectKeyFromObject(&httpRoute), httpRoute)
+			mockK8sClient.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				updatedRoute, ok := obj.(*gatewayv1.HTTPRoute)
+				return ok && assert.NotContains(t, updatedRoute.Finalizers, HTTPRouteProgrammedFinalizer)
+			})).Return(nil)
+
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				forgetProgrammedRoute(t.Context(), mock.Anything).
+				Return(nil).
+				Once()
+
+			err := model.deprovisionRoute(t.Context(), deprovisionRouteParams{
+				gateway:          *gateway,
+				config:           config,
+				httpRoute:        httpRoute,
+				matchedListeners: []gatewayv1.Listener{currentListener},
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("deprovisions rules of the given parent gateway only", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
+
+			config := makeRandomGatewayConfig()
+			gateway := newRandomGateway()
+			goneGateway := newRandomGateway()
+			listener := makeRandomListener()
+			gatewayRule := "gateway-rule-" + fake.Lorem().Word()
+			gatewayAnnotation := l7RouteParentPolicyRulesAnnotation(
+				HTTPRouteProgrammedPolicyRulesAnnotation,
+				client.ObjectKeyFromObject(gateway).String(),
+			)
+			goneGatewayAnnotation := l7RouteParentPolicyRulesAnnotation(
+				HTTPRouteProgrammedPolicyRulesAnnotation,
+				client.ObjectKeyFromObject(goneGateway).String(),
+			)
+			httpRoute := makeRandomHTTPRoute()
+			httpRoute.Spec.ParentRefs = []gatewayv1.ParentReference{
+				{Name: gatewayv1.ObjectName(gateway.Name), Namespace: new(gatewayv1.Namespace(gateway.Namespace))},
+				{Name: gatewayv1.ObjectName(goneGateway.Name), Namespace: new(gatewayv1.Namespace(goneGateway.Namespace))},
+			}
+			httpRoute.Finalizers = []string{HTTPRouteProgrammedFinalizer}
+			httpRoute.Annotations = map[string]string{
+				gatewayAnnotation:                        fmt.Sprintf("%s/%s", listener.Name, gatewayRule),
+				goneGatewayAnnotation:                    fmt.Sprintf("%s/other-%s", listener.Name, gatewayRule),
+				HTTPRouteProgrammedPolicyRulesAnnotation: "legacy-" + gatewayRule,
+			}
+
+			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
+			ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+				loadBalancerID:  config.Spec.LoadBalancerID,
+				listenerName:    string(listener.Name),
+				policyRules:     []loadbalancer.RoutingRule{},
+				prevPolicyRules: []string{gatewayRule},
+			}).Return(nil).Once()
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			setupClientGet(t, mockK8sClient, client.ObjectKeyFromObject(&httpRoute), httpRoute)
+			mockK8sClient.EXPECT().
+				Get(t.Context(), client.ObjectKeyFromObject(goneGateway), mock.Anything).
+				Return(apierrors.NewNotFound(schema.GroupResource{
+					Group:    gatewayv1.GroupName,
+					Resource: "Gateway",
+				}, goneGateway.Name)).
+				Once()
+			mockK8sClient.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				updatedRoute, ok := obj.(*gatewayv1.HTTPRoute)
+				return ok &&
+					assert.NotContains(t, updatedRoute.Finalizers, HTTPRouteProgrammedFinalizer) &&
+					assert.Empty(t, updatedRoute.Annotations)
+			})).Return(nil)
+
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				forgetProgrammedRoute(t.Context(), mock.MatchedBy(func(params routeProgrammingStateParams) bool {
+					return params.routeKind == "HTTPRoute" && params.route.GetName() == httpRoute.Name
+				})).
+				Return(nil).
+				Once()
+
+			err := model.deprovisionRoute(t.Context(), deprovisionRouteParams{
+				gateway:          *gateway,
+				config:           config,
+				httpRoute:        httpRoute,
+				matchedListeners: []gatewayv1.Listener{listener},
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("deprovisions rules recorded in programming state", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+
+			config := makeRandomGatewayConfig()
+			gateway := newRandomGateway()
+			listener := makeRandomListener()
+			recordedRule := "recorded-rule-" + fake.Lorem().Word()
+			httpRoute := makeRandomHTTPRoute()
+			httpRoute.Finalizers = []string{HTTPRouteProgrammedFinalizer}
+
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().
+				programmedRoutePolicyRules(t.Context(), routeProgrammingStateParams{
+					gateway:   *gateway,
+					routeKind: "HTTPRoute",
+					route:     &httpRoute,
+				}).
+				Return([]string{fmt.Sprintf("%s/%s", listener.Name, recordedRule)}, true, nil).
+				Once()
+
+			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
+			ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+				loadBalancerID:  config.Spec.LoadBalancerID,
+				listenerName:    string(listener.Name),
+				policyRules:     []loadbalancer.RoutingRule{},
+				prevPolicyRules: []string{recordedRule},
+			}).Return(nil).Once()
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			setupClientGet(t, mockK8sClient, client.ObjectKeyFromObject(&httpRoute), httpRoute)
+			updateCall := mockK8sClient.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				updatedRoute, ok := obj.(*gatewayv1.HTTPRoute)
+				return ok && assert.NotContains(t, updatedRoute.Finalizers, HTTPRouteProgrammedFinalizer)
+			})).Return(nil).Once()
+			programmingState.EXPECT().
+				forgetProgrammedRoute(t.Context(), mock.MatchedBy(func(params routeProgrammingStateParams) bool {
+					return params.gateway.Name == gateway.Name && params.route.GetName() == httpRoute.Name
+				})).
+				Return(nil).
+				Once().
+				NotBefore(updateCall)
+
+			err := model.deprovisionRoute(t.Context(), deprovisionRouteParams{
+				gateway:          *gateway,
+				config:           config,
+				httpRoute:        httpRoute,
+				matchedListeners: []gatewayv1.Listener{listener},
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("forgets programming state of route removed meanwhile", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
+
+			config := makeRandomGatewayConfig()
+			listener := makeRandomListener()
+			gateway := newRandomGateway()
+			gateway.Spec.Listeners = []gatewayv1.Listener{listener}
+			httpRoute := makeRandomHTTPRoute()
+			httpRoute.Annotations = map[string]string{
+				HTTPRouteProgrammedPolicyRulesAnnotation: "rule-" + faker.New().Lorem().Word(),
+			}
+
+			ociLBModel, _ := deps.OciLBModel.(*MockociLoadBalancerModel)
+			ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), mock.Anything).Return(nil).Once()
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				Get(t.Context(), client.ObjectKeyFromObject(&httpRoute), mock.Anything).
+				Return(apierrors.NewNotFound(schema.GroupResource{
+					Group:    gatewayv1.GroupName,
+					Resource: "HTTPRoute",
+				}, httpRoute.Name)).
+				Once()
+			programmingState, _ := deps.ProgrammingState.(*MockprogrammingStateModel)
+			programmingState.EXPECT().forgetProgrammedRoute(t.Context(), routeProgrammingStateParams{
+				gateway:   *gateway,
+				routeKind: "HTTPRoute",
+				route:     &httpRoute,
+			}).Return(nil).Once()
+
+			err := model.deprovisionRoute(t.Context(), deprovisionRouteParams{
+				gateway:          *gateway,
+				config:           config,
+				httpRoute:        httpRoute,
+				matchedListeners: []gatewayv1.Listener{listener},
+			})
+			require.NoError(t, err)
+		})
+
 		t.Run("fails when commitRoutingPolicy fails", func(t *testing.T) {
 			fake := faker.New()
 			deps := newMockDeps(t)
 			model := newHTTPRouteModel(deps)
+			expectNoRedirectRules(t, deps)
+			expectNoRecordedPolicyRules(t, deps)
 
 			config := makeRandomGatewayConfig()
 			httpRoute := makeRandomHTTPRoute()
@@ -2534,3 +4174,51 @@ func TestResolveL7BackendSSLConfig(t *testing.T) {
 		require.True(t, managed)
 	})
 }
+
+func TestHTTPRouteCompatibleListeners(t *testing.T) {
+	gateway := newRandomGateway()
+	httpListener := makeRandomListener(func(listener *gatewayv1.Listener) {
+		listener.Protocol = gatewayv1.HTTPProtocolType
+	})
+	httpsListener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+	tlsListener := makeRandomListener(func(listener *gatewayv1.Listener) {
+		listener.Protocol = gatewayv1.TLSProtocolType
+	})
+	noCertsListener := makeRandomListener(func(listener *gatewayv1.Listener) {
+		listener.Protocol = gatewayv1.HTTPSProtocolType
+	})
+
+	t.Run("keeps compatible listeners", func(t *testing.T) {
+		listeners := []gatewayv1.Listener{httpListener, httpsListener}
+
+		got, reason, message := httpRouteCompatibleListeners(*gateway, listeners)
+
+		assert.Equal(t, listeners, got)
+		assert.Empty(t, reason)
+		assert.Empty(t, message)
+	})
+
+	t.Run("drops incompatible listeners", func(t *testing.T) {
+		got, reason, message := httpRouteCompatibleListeners(*gateway, []gatewayv1.Listener{
+			tlsListener, httpListener, noCertsListener,
+		})
+
+		assert.Equal(t, []gatewayv1.Listener{httpListener}, got)
+		assert.Empty(t, reason)
+		assert.Empty(t, message)
+	})
+
+	t.Run("rejects if no listener is compatible", func(t *testing.T) {
+		got, reason, message := httpRouteCompatibleListeners(*gateway, []gatewayv1.Listener{
+			tlsListener, noCertsListener,
+		})
+
+		assert.Empty(t, got)
+		assert.Equal(t, routeReasonUnsupportedProtocol, reason)
+		assert.Equal(t, fmt.Sprintf(
+			"Gateway %s/%s has no listeners compatible with HTTPRoute: "+
+				"listener %s has TLS protocol, HTTPS listener %s has no certificateRefs",
+			gateway.Namespace, gateway.Name, tlsListener.Name, noCertsListener.Name,
+		), message)
+	})
+}
@@ -0,0 +1,259 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+const (
+	httpDefaultPort  = 80
+	httpsDefaultPort = 443
+)
+
+// isHTTPRouteRedirectRule returns true if the rule redirects requests instead of forwarding
+// them to backends. Such rules are programmed as REDIRECT rules of the listener rule set.
+func isHTTPRouteRedirectRule(rule gatewayv1.HTTPRouteRule) bool {
+	return len(rule.BackendRefs) == 0 && slices.ContainsFunc(rule.Filters, func(filter gatewayv1.HTTPRouteFilter) bool {
+		return filter.Type == gatewayv1.HTTPRouteFilterRequestRedirect && filter.RequestRedirect != nil
+	})
+}
+
+// httpRouteRedirectRuleSetItems returns REDIRECT rules of the redirect rules of the route.
+// OCI redirect rules can only be conditioned on the request path, so rules matching on
+// anything else are rejected with errUnsupportedMatch.
+func httpRouteRedirectRuleSetItems(route gatewayv1.HTTPRoute) ([]loadbalancer.Rule, error) {
+	var items []loadbalancer.Rule
+	seenConditions := make(map[string]struct{})
+	for ruleIndex, rule := range route.Spec.Rules {
+		if !isHTTPRouteRedirectRule(rule) {
+			continue
+		}
+		redirectURI, responseCode, err := mapHTTPRouteRedirectFilter(rule, ruleIndex)
+		if err != nil {
+			return nil, err
+		}
+		matches := rule.Matches
+		if len(matches) == 0 {
+			matches = []gatewayv1.HTTPRouteMatch{{}}
+		}
+		for _, match := range matches {
+			conditions, matchErr := mapHTTPRouteRedirectMatch(match, ruleIndex)
+			if matchErr != nil {
+				return nil, matchErr
+			}
+			for _, condition := range conditions {
+				// OCI allows a single redirect rule per path, earlier rules take precedence
+				conditionKey := string(condition.Operator) + " " + *condition.AttributeValue
+				if _, seen := seenConditions[conditionKey]; seen {
+					continue
+				}
+				seenConditions[conditionKey] = struct{}{}
+				items = append(items, loadbalancer.RedirectRule{
+					Conditions:   []loadbalancer.RuleCondition{condition},
+					ResponseCode: new(responseCode),
+					RedirectUri:  redirectURI,
+				})
+			}
+		}
+	}
+	return items, nil
+}
+
+func mapHTTPRouteRedirectFilter(
+	rule gatewayv1.HTTPRouteRule,
+	ruleIndex int,
+) (*loadbalancer.RedirectUri, int, error) {
+	var redirect *gatewayv1.HTTPRequestRedirectFilter
+	for _, filter := range rule.Filters {
+		if filter.Type != gatewayv1.HTTPRouteFilterRequestRedirect {
+			return nil, 0, fmt.Errorf("%w: %s filter of redirect rule %d", errUnsupportedFilter, filter.Type, ruleIndex)
+		}
+		if filter.RequestRedirect != nil {
+			redirect = filter.RequestRedirect
+		}
+	}
+
+	// Unset parts of the URI are explicitly taken from the request, so the rule set
+	// items are compared with the programmed ones as is.
+	redirectURI := &loadbalancer.RedirectUri{
+		Protocol: new("{protocol}"),
+		Host:     new("{host}"),
+		Path:     new("{path}"),
+		Query:    new("{query}"),
+	}
+	if redirect.Scheme != nil {
+		scheme := strings.ToLower(*redirect.Scheme)
+		redirectURI.Protocol = new(strings.ToUpper(scheme))
+		// Well known port of the scheme is used unless the port is given
+		switch scheme {
+		case "http":
+			redirectURI.Port = new(httpDefaultPort)
+		case "https":
+			redirectURI.Port = new(httpsDefaultPort)
+		default:
+			return nil, 0, fmt.Errorf("%w: redirect scheme '%s' of rule %d",
+				errUnsupportedFilter, *redirect.Scheme, ruleIndex)
+		}
+	}
+	if redirect.Hostname != nil {
+		redirectURI.Host = new(string(*redirect.Hostname))
+	}
+	if redirect.Port != nil {
+		redirectURI.Port = new(int(*redirect.Port))
+	}
+	if redirect.Path != nil {
+		if redirect.Path.Type != gatewayv1.FullPathHTTPPathModifier || redirect.Path.ReplaceFullPath == nil {
+			return nil, 0, fmt.Errorf("%w: %s path modifier of redirect rule %d",
+				errUnsupportedFilter, redirect.Path.Type, ruleIndex)
+		}
+		redirectURI.Path = new(*redirect.Path.ReplaceFullPath)
+	}
+
+	responseCode := http.StatusFound
+	if redirect.StatusCode != nil {
+		responseCode = *redirect.StatusCode
+	}
+	return redirectURI, responseCode, nil
+}
+
+// mapHTTPRouteRedirectMatch maps the match to path conditions of redirect rules. Path prefix
+// is matched on path segment boundaries, so it takes an exact and a prefix condition.
+func mapHTTPRouteRedirectMatch(
+	match gatewayv1.HTTPRouteMatch,
+	ruleIndex int,
+) ([]loadbalancer.PathMatchCondition, error) {
+	if len(match.Headers) > 0 || len(match.QueryParams) > 0 || match.Method != nil {
+		return nil, fmt.Errorf("%w: redirect rule %d can only match on path", errUnsupportedMatch, ruleIndex)
+	}
+	pathType := gatewayv1.PathMatchPathPrefix
+	pathValue := "/"
+	if match.Path != nil {
+		if match.Path.Type != nil {
+			pathType = *match.Path.Type
+		}
+		if match.Path.Value != nil {
+			pathValue = *match.Path.Value
+		}
+	}
+
+	switch pathType {
+	case gatewayv1.PathMatchExact:
+		return []loadbalancer.PathMatchCondition{{
+			AttributeValue: new(pathValue),
+			Operator:       loadbalancer.PathMatchConditionOperatorExactMatch,
+		}}, nil
+	case gatewayv1.PathMatchPathPrefix:
+		segmentsPrefix := strings.TrimRight(pathValue, "/")
+		if segmentsPrefix == "" {
+			return []loadbalancer.PathMatchCondition{{
+				AttributeValue: new("/"),
+				Operator:       loadbalancer.PathMatchConditionOperatorPrefixMatch,
+			}}, nil
+		}
+		return []loadbalancer.PathMatchCondition{
+			{
+				AttributeValue: new(segmentsPrefix),
+				Operator:       loadbalancer.PathMatchConditionOperatorExactMatch,
+			},
+			{
+				AttributeValue: new(segmentsPrefix + "/"),
+				Operator:       loadbalancer.PathMatchConditionOperatorPrefixMatch,
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s path matching of redirect rule %d", errUnsupportedMatch, pathType, ruleIndex)
+	}
+}
+
+// httpRouteRedirectListeners returns listeners to attach the redirect rule set of the route to.
+// Redirect rules apply to all requests of the listener, so listeners of the route hostnames
+// that serve other hostnames as well are rejected with errUnsupportedMatch.
+func httpRouteRedirectListeners(
+	route gatewayv1.HTTPRoute,
+	matchedListeners []gatewayv1.Listener,
+) ([]gatewayv1.Listener, error) {
+	if len(route.Spec.Hostnames) == 0 {
+		return matchedListeners, nil
+	}
+	var listeners []gatewayv1.Listener
+	for _, listener := range matchedListeners {
+		if len(l7RouteHostnamesForListener(route.Spec.Hostnames, listener)) == 0 {
+			continue
+		}
+		covered := listener.Hostname != nil &&
+			slices.ContainsFunc(route.Spec.Hostnames, func(hostname gatewayv1.Hostname) bool {
+				return redirectHostnameCovers(hostname, *listener.Hostname)
+			})
+		if !covered {
+			return nil, fmt.Errorf(
+				"%w: redirect rules can not be limited to route hostnames on listener %s",
+				errUnsupportedMatch,
+				listener.Name,
+			)
+		}
+		listeners = append(listeners, listener)
+	}
+	return listeners, nil
+}
+
+// redirectHostnameCovers returns true if all hostnames of the listener match the route hostname.
+func redirectHostnameCovers(routeHostname, listenerHostname gatewayv1.Hostname) bool {
+	routeValue := strings.ToLower(string(routeHostname))
+	listenerValue := strings.ToLower(string(listenerHostname))
+	if routeValue == listenerValue {
+		return true
+	}
+	if !strings.HasPrefix(routeValue, "*.") {
+		return false
+	}
+	return strings.HasSuffix(strings.TrimPrefix(listenerValue, "*."), "."+strings.TrimPrefix(routeValue, "*."))
+}
+
+// httpRouteRedirectRuleSetParams returns the redirect rule set of the route and listeners
+// to attach it to. Unsupported redirect rules are rejected before anything is programmed.
+func httpRouteRedirectRuleSetParams(
+	gateway gatewayv1.Gateway,
+	config types.GatewayConfig,
+	route gatewayv1.HTTPRoute,
+	matchedListeners []gatewayv1.Listener,
+) (reconcileRouteRedirectRuleSetParams, error) {
+	namePrefix := ociGatewayNamePrefix(&gateway, config)
+	params := reconcileRouteRedirectRuleSetParams{
+		loadBalancerID: config.Spec.LoadBalancerID,
+		ruleSetName:    ociHTTPRouteRedirectRuleSetName(namePrefix, &route),
+	}
+	items, err := httpRouteRedirectRuleSetItems(route)
+	if err != nil || len(items) == 0 {
+		return params, err
+	}
+	listeners, err := httpRouteRedirectListeners(route, matchedListeners)
+	if err != nil || len(listeners) == 0 {
+		return params, err
+	}
+	naming := ociResourceNamingFromConfig(config)
+	params.items = items
+	params.listenerNames = lo.Map(listeners, func(listener gatewayv1.Listener, _ int) string {
+		return naming.listenerName(namePrefix, listener.Name)
+	})
+	return params, nil
+}
+
+// ociHTTPRouteRedirectRuleSetName returns the name of the OCI rule set holding redirect rules of the route.
+func ociHTTPRouteRedirectRuleSetName(namePrefix string, route *gatewayv1.HTTPRoute) string {
+	return ociapi.ConstructOCIResourceName(
+		"rd_"+namePrefix+route.Namespace+"_"+route.Name,
+		ociapi.OCIResourceNameConfig{
+			MaxLength:           maxListenerPolicyNameLength,
+			InvalidCharsPattern: invalidCharsForPolicyNamePattern,
+		},
+	)
+}
@@ -0,0 +1,272 @@
+package app
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestHTTPRouteRedirectRuleSetItems(t *testing.T) {
+	redirectRule := func(
+		redirect gatewayv1.HTTPRequestRedirectFilter,
+		matches ...gatewayv1.HTTPRouteMatch,
+	) gatewayv1.HTTPRouteRule {
+		return gatewayv1.HTTPRouteRule{
+			Matches: matches,
+			Filters: []gatewayv1.HTTPRouteFilter{
+				{Type: gatewayv1.HTTPRouteFilterRequestRedirect, RequestRedirect: &redirect},
+			},
+		}
+	}
+	pathMatch := func(pathType gatewayv1.PathMatchType, value string) gatewayv1.HTTPRouteMatch {
+		return gatewayv1.HTTPRouteMatch{Path: &gatewayv1.HTTPPathMatch{Type: new(pathType), Value: new(value)}}
+	}
+	requestURI := func() *loadbalancer.RedirectUri {
+		return &loadbalancer.RedirectUri{
+			Protocol: new("{protocol}"),
+			Host:     new("{host}"),
+			Path:     new("{path}"),
+			Query:    new("{query}"),
+		}
+	}
+	pathCondition := func(
+		operator loadbalancer.PathMatchConditionOperatorEnum,
+		value string,
+	) []loadbalancer.RuleCondition {
+		return []loadbalancer.RuleCondition{
+			loadbalancer.PathMatchCondition{AttributeValue: new(value), Operator: operator},
+		}
+	}
+
+	t.Run("redirects all paths to https", func(t *testing.T) {
+		route := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(
+			makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(makeRandomBackendRef())),
+			redirectRule(gatewayv1.HTTPRequestRedirectFilter{
+				Scheme:     new("https"),
+				StatusCode: new(http.StatusMovedPermanently),
+			}),
+		))
+
+		items, err := httpRouteRedirectRuleSetItems(route)
+		require.NoError(t, err)
+
+		wantURI := requestURI()
+		wantURI.Protocol = new("HTTPS")
+		wantURI.Port = new(443)
+		assert.Equal(t, []loadbalancer.Rule{
+			loadbalancer.RedirectRule{
+				Conditions:   pathCondition(loadbalancer.PathMatchConditionOperatorPrefixMatch, "/"),
+				ResponseCode: new(http.StatusMovedPermanently),
+				RedirectUri:  wantURI,
+			},
+		}, items)
+	})
+
+	t.Run("maps hostname, port and full path of the redirect", func(t *testing.T) {
+		hostname := faker.New().Internet().Domain()
+		route := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(
+			redirectRule(gatewayv1.HTTPRequestRedirectFilter{
+				Scheme:   new("http"),
+				Hostname: new(gatewayv1.PreciseHostname(hostname)),
+				Port:     new(gatewayv1.PortNumber(8080)),
+				Path: &gatewayv1.HTTPPathModifier{
+					Type:            gatewayv1.FullPathHTTPPathModifier,
+					ReplaceFullPath: new("/new-docs"),
+				},
+			}, pathMatch(gatewayv1.PathMatchExact, "/docs")),
+		))
+
+		items, err := httpRouteRedirectRuleSetItems(route)
+		require.NoError(t, err)
+
+		assert.Equal(t, []loadbalancer.Rule{
+			loadbalancer.RedirectRule{
+				Conditions:   pathCondition(loadbalancer.PathMatchConditionOperatorExactMatch, "/docs"),
+				ResponseCode: new(http.StatusFound),
+				RedirectUri: &loadbalancer.RedirectUri{
+					Protocol: new("HTTP"),
+					Host:     new(hostname),
+					Port:     new(8080),
+					Path:     new("/new-docs"),
+					Query:    new("{query}"),
+				},
+			},
+		}, items)
+	})
+
+	t.Run("matches path prefix on segment boundaries", func(t *testing.T) {
+		route := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(
+			redirectRule(gatewayv1.HTTPRequestRedirectFilter{}, pathMatch(gatewayv1.PathMatchPathPrefix, "/docs/")),
+		))
+
+		items, err := httpRouteRedirectRuleSetItems(route)
+		require.NoError(t, err)
+
+		assert.Equal(t, []loadbalancer.Rule{
+			loadbalancer.RedirectRule{
+				Conditions:   pathCondition(loadbalancer.PathMatchConditionOperatorExactMatch, "/docs"),
+				ResponseCode: new(http.StatusFound),
+				RedirectUri:  requestURI(),
+			},
+			loadbalancer.RedirectRule{
+				Conditions:   pathCondition(loadbalancer.PathMatchConditionOperatorPrefixMatch, "/docs/"),
+				ResponseCode: new(http.StatusFound),
+				RedirectUri:  requestURI(),
+			},
+		}, items)
+	})
+
+	t.Run("keeps the earlier rule of the same path", func(t *testing.T) {
+		route := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(
+			redirectRule(gatewayv1.HTTPRequestRedirectFilter{
+				StatusCode: new(http.StatusMovedPermanently),
+			}, pathMatch(gatewayv1.PathMatchExact, "/docs")),
+			redirectRule(gatewayv1.HTTPRequestRedirectFilter{}, pathMatch(gatewayv1.PathMatchPathPrefix, "/docs")),
+		))
+
+		items, err := httpRouteRedirectRuleSetItems(route)
+		require.NoError(t, err)
+
+		assert.Equal(t, []loadbalancer.Rule{
+			loadbalancer.RedirectRule{
+				Conditions:   pathCondition(loadbalancer.PathMatchConditionOperatorExactMatch, "/docs"),
+				ResponseCode: new(http.StatusMovedPermanently),
+				RedirectUri:  requestURI(),
+			},
+			loadbalancer.RedirectRule{
+				Conditions:   pathCondition(loadbalancer.PathMatchConditionOperatorPrefixMatch, "/docs/"),
+				ResponseCode: new(http.StatusFound),
+				RedirectUri:  requestURI(),
+			},
+		}, items)
+	})
+
+	t.Run("is empty without redirect rules", func(t *testing.T) {
+		route := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(
+			makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(makeRandomBackendRef())),
+			makeRandomHTTPRouteRule(),
+		))
+
+		items, err := httpRouteRedirectRuleSetItems(route)
+		require.NoError(t, err)
+		assert.Empty(t, items)
+	})
+
+	t.Run("rejects header matches", func(t *testing.T) {
+		route := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(
+			redirectRule(gatewayv1.HTTPRequestRedirectFilter{}, gatewayv1.HTTPRouteMatch{
+				Headers: []gatewayv1.HTTPHeaderMatch{{Name: "X-Version", Value: "v1"}},
+			}),
+		))
+
+		_, err := httpRouteRedirectRuleSetItems(route)
+		require.ErrorIs(t, err, errUnsupportedMatch)
+	})
+
+	t.Run("rejects regex path matches", func(t *testing.T) {
+		route := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(
+			redirectRule(
+				gatewayv1.HTTPRequestRedirectFilter{},
+				pathMatch(gatewayv1.PathMatchRegularExpression, "^/docs"),
+			),
+		))
+
+		_, err := httpRouteRedirectRuleSetItems(route)
+		require.ErrorIs(t, err, errUnsupportedMatch)
+	})
+
+	t.Run("rejects prefix replacement", func(t *testing.T) {
+		route := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(
+			redirectRule(gatewayv1.HTTPRequestRedirectFilter{
+				Path: &gatewayv1.HTTPPathModifier{
+					Type:               gatewayv1.PrefixMatchHTTPPathModifier,
+					ReplacePrefixMatch: new("/v2"),
+				},
+			}, pathMatch(gatewayv1.PathMatchPathPrefix, "/v1")),
+		))
+
+		_, err := httpRouteRedirectRuleSetItems(route)
+		require.ErrorIs(t, err, errUnsupportedFilter)
+	})
+
+	t.Run("rejects other filters of redirect rules", func(t *testing.T) {
+		rule := redirectRule(gatewayv1.HTTPRequestRedirectFilter{Scheme: new("https")})
+		rule.Filters = append(rule.Filters, gatewayv1.HTTPRouteFilter{
+			Type: gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+		})
+		route := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(rule))
+
+		_, err := httpRouteRedirectRuleSetItems(route)
+		require.ErrorIs(t, err, errUnsupportedFilter)
+	})
+}
+
+func TestHTTPRouteRedirectListeners(t *testing.T) {
+	listenerWithHostname := func(hostname string) gatewayv1.Listener {
+		listener := makeRandomListener()
+		listener.Hostname = new(gatewayv1.Hostname(hostname))
+		return listener
+	}
+	routeWithHostnames := func(hostnames ...gatewayv1.Hostname) gatewayv1.HTTPRoute {
+		route := makeRandomHTTPRoute()
+		route.Spec.Hostnames = hostnames
+		return route
+	}
+
+	t.Run("returns matched listeners of route without hostnames", func(t *testing.T) {
+		listeners := []gatewayv1.Listener{makeRandomListener(), listenerWithHostname("example.com")}
+
+		got, err := httpRouteRedirectListeners(makeRandomHTTPRoute(), listeners)
+		require.NoError(t, err)
+		assert.Equal(t, listeners, got)
+	})
+
+	t.Run("returns listeners of route hostnames", func(t *testing.T) {
+		exactListener := listenerWithHostname("www.example.com")
+		wildcardListener := listenerWithHostname("*.api.example.com")
+		otherListener := listenerWithHostname("other.com")
+
+		got, err := httpRouteRedirectListeners(
+			routeWithHostnames("*.example.com"),
+			[]gatewayv1.Listener{exactListener, wildcardListener, otherListener},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []gatewayv1.Listener{exactListener, wildcardListener}, got)
+	})
+
+	t.Run("rejects listeners without hostname", func(t *testing.T) {
+		_, err := httpRouteRedirectListeners(
+			routeWithHostnames("www.example.com"),
+			[]gatewayv1.Listener{makeRandomListener()},
+		)
+		require.ErrorIs(t, err, errUnsupportedMatch)
+	})
+
+	t.Run("rejects listeners serving other hostnames", func(t *testing.T) {
+		_, err := httpRouteRedirectListeners(
+			routeWithHostnames("www.example.com"),
+			[]gatewayv1.Listener{listenerWithHostname("*.example.com")},
+		)
+		require.ErrorIs(t, err, errUnsupportedMatch)
+	})
+}
+
+func TestOCIHTTPRouteRedirectRuleSetName(t *testing.T) {
+	route := makeRandomHTTPRoute(
+		randomHTTPRouteWithNamespaceOpt("apps"),
+		randomHTTPRouteWithNameOpt("web.redirect"),
+	)
+
+	assert.Equal(t, "rd_gw_apps_web_redirect", ociHTTPRouteRedirectRuleSetName("gw_", &route))
+
+	route.Name = strings.Repeat("long", 20)
+	name := ociHTTPRouteRedirectRuleSetName("gw_", &route)
+	assert.LessOrEqual(t, len(name), maxListenerPolicyNameLength)
+	assert.True(t, strings.HasPrefix(name, "rd_gw_apps_"))
+}
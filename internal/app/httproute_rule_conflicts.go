@@ -0,0 +1,111 @@
+package app
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// httpRouteRuleConditions tracks routing conditions of the rules of the route.
+//
+// Rules with the same matches collapse to the same routing condition, e.g. when they only
+// differ by filters that are not mapped to the condition. OCI evaluates the routing policy
+// rules in order, so the later of such rules never matches.
+type httpRouteRuleConditions struct {
+	byCondition map[string]httpRouteRuleCondition
+	conflicts   []string
+}
+
+type httpRouteRuleCondition struct {
+	ruleIndex int
+	actions   []loadbalancer.Action
+}
+
+func newHTTPRouteRuleConditions() *httpRouteRuleConditions {
+	return &httpRouteRuleConditions{
+		byCondition: make(map[string]httpRouteRuleCondition),
+	}
+}
+
+// dedupe returns the routing rules of the route rule without the rules whose condition
+// is taken by an earlier route rule. Rules with the same actions are merged into the
+// earlier rule since they route the same way, others are recorded as conflicts.
+func (c *httpRouteRuleConditions) dedupe(
+	ruleIndex int,
+	rules []loadbalancer.RoutingRule,
+) []loadbalancer.RoutingRule {
+	result := make([]loadbalancer.RoutingRule, 0, len(rules))
+	conflictsWith := make(map[int]struct{})
+	for _, rule := range rules {
+		condition := lo.FromPtr(rule.Condition)
+		if condition == "" {
+			// Not a valid routing rule, left for OCI to reject
+			result = append(result, rule)
+			continue
+		}
+		existing, found := c.byCondition[condition]
+		if !found || existing.ruleIndex == ruleIndex {
+			c.byCondition[condition] = httpRouteRuleCondition{ruleIndex: ruleIndex, actions: rule.Actions}
+			result = append(result, rule)
+			continue
+		}
+		if !reflect.DeepEqual(existing.actions, rule.Actions) {
+			conflictsWith[existing.ruleIndex] = struct{}{}
+		}
+	}
+	for _, earlierIndex := range slices.Sorted(maps.Keys(conflictsWith)) {
+		c.conflicts = append(c.conflicts, fmt.Sprintf("rules %d and %d", earlierIndex, ruleIndex))
+	}
+	return result
+}
+
+// conflictsMessage returns the message of the PartiallyInvalid condition,
+// empty if there are no conflicting rules.
+func (c *httpRouteRuleConditions) conflictsMessage() string {
+	if len(c.conflicts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(
+		"%s have the same matches but route differently, only the first rule of each is programmed",
+		strings.Join(c.conflicts, ", "),
+	)
+}
+
+// httpRouteFilterOnlyRulesMessage returns the PartiallyInvalid condition message listing
+// rules without backendRefs, empty if there are none. Such rules are not programmed since
+// OCI routing policies can only forward requests to backend sets. Redirect rules are
+// programmed as listener rule set redirects and are not listed.
+func httpRouteFilterOnlyRulesMessage(route gatewayv1.HTTPRoute) string {
+	var ruleIndexes []string
+	for ruleIndex, rule := range route.Spec.Rules {
+		if len(rule.BackendRefs) == 0 && !isHTTPRouteRedirectRule(rule) {
+			ruleIndexes = append(ruleIndexes, strconv.Itoa(ruleIndex))
+		}
+	}
+	switch len(ruleIndexes) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf(
+			"rule %s has no backendRefs and is not programmed, OCI routing policies can only forward to backends",
+			ruleIndexes[0],
+		)
+	default:
+		return fmt.Sprintf(
+			"rules %s have no backendRefs and are not programmed, OCI routing policies can only forward to backends",
+			strings.Join(ruleIndexes, ", "),
+		)
+	}
+}
+
+// joinPartiallyInvalidMessages joins non empty messages of the PartiallyInvalid condition.
+func joinPartiallyInvalidMessages(messages ...string) string {
+	return strings.Join(lo.Compact(messages), "; ")
+}
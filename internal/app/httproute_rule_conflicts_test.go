@@ -0,0 +1,112 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestHTTPRouteRuleConditions(t *testing.T) {
+	makeRule := func(condition string, backendSetName string) loadbalancer.RoutingRule {
+		rule := makeRandomOCIRoutingRule()
+		rule.Condition = new(condition)
+		rule.Actions = []loadbalancer.Action{
+			loadbalancer.ForwardToBackendSet{BackendSetName: new(backendSetName)},
+		}
+		return rule
+	}
+
+	t.Run("keeps rules with different conditions", func(t *testing.T) {
+		fake := faker.New()
+		conditions := newHTTPRouteRuleConditions()
+		first := makeRule("http.request.url.path sw '/a'", fake.UUID().V4())
+		second := makeRule("http.request.url.path sw '/b'", fake.UUID().V4())
+
+		assert.Equal(t, []loadbalancer.RoutingRule{first}, conditions.dedupe(0, []loadbalancer.RoutingRule{first}))
+		assert.Equal(t, []loadbalancer.RoutingRule{second}, conditions.dedupe(1, []loadbalancer.RoutingRule{second}))
+		assert.Empty(t, conditions.conflictsMessage())
+	})
+
+	t.Run("merges rules with the same condition and actions", func(t *testing.T) {
+		backendSetName := faker.New().UUID().V4()
+		conditions := newHTTPRouteRuleConditions()
+		first := makeRule("http.request.url.path sw '/a'", backendSetName)
+		second := makeRule("http.request.url.path sw '/a'", backendSetName)
+
+		conditions.dedupe(0, []loadbalancer.RoutingRule{first})
+
+		assert.Empty(t, conditions.dedupe(1, []loadbalancer.RoutingRule{second}))
+		assert.Empty(t, conditions.conflictsMessage())
+	})
+
+	t.Run("reports rules with the same condition and different actions", func(t *testing.T) {
+		fake := faker.New()
+		conditions := newHTTPRouteRuleConditions()
+		conditions.dedupe(0, []loadbalancer.RoutingRule{makeRule("http.request.url.path sw '/a'", fake.UUID().V4())})
+		conditions.dedupe(1, []loadbalancer.RoutingRule{makeRule("http.request.url.path sw '/b'", fake.UUID().V4())})
+
+		kept := makeRule("http.request.url.path sw '/c'", fake.UUID().V4())
+		got := conditions.dedupe(2, []loadbalancer.RoutingRule{
+			makeRule("http.request.url.path sw '/b'", fake.UUID().V4()),
+			makeRule("http.request.url.path sw '/a'", fake.UUID().V4()),
+			kept,
+		})
+
+		assert.Equal(t, []loadbalancer.RoutingRule{kept}, got)
+		assert.Equal(t,
+			"rules 0 and 2, rules 1 and 2 have the same matches but route differently, "+
+				"only the first rule of each is programmed",
+			conditions.conflictsMessage(),
+		)
+	})
+}
+
+func TestHTTPRouteFilterOnlyRulesMessage(t *testing.T) {
+	withBackends := makeRandomHTTPRouteRule(randomHTTPRouteRuleWithRandomBackendRefsOpt(makeRandomBackendRef()))
+
+	t.Run("is empty if all rules have backendRefs", func(t *testing.T) {
+		route := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(withBackends))
+		assert.Empty(t, httpRouteFilterOnlyRulesMessage(route))
+	})
+
+	t.Run("reports a single rule without backendRefs", func(t *testing.T) {
+		route := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(withBackends, makeRandomHTTPRouteRule()))
+		assert.Equal(t,
+			"rule 1 has no backendRefs and is not programmed, OCI routing policies can only forward to backends",
+			httpRouteFilterOnlyRulesMessage(route),
+		)
+	})
+
+	t.Run("reports multiple rules without backendRefs", func(t *testing.T) {
+		route := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(
+			makeRandomHTTPRouteRule(), withBackends, makeRandomHTTPRouteRule(),
+		))
+		assert.Equal(t,
+			"rules 0, 2 have no backendRefs and are not programmed, OCI routing policies can only forward to backends",
+			httpRouteFilterOnlyRulesMessage(route),
+		)
+	})
+
+	t.Run("does not report redirect rules", func(t *testing.T) {
+		redirect := gatewayv1.HTTPRouteRule{
+			Filters: []gatewayv1.HTTPRouteFilter{{
+				Type:            gatewayv1.HTTPRouteFilterRequestRedirect,
+				RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{Scheme: new("https")},
+			}},
+		}
+		route := makeRandomHTTPRoute(randomHTTPRouteWithRulesOpt(redirect, withBackends, makeRandomHTTPRouteRule()))
+		assert.Equal(t,
+			"rule 2 has no backendRefs and is not programmed, OCI routing policies can only forward to backends",
+			httpRouteFilterOnlyRulesMessage(route),
+		)
+	})
+}
+
+func TestJoinPartiallyInvalidMessages(t *testing.T) {
+	assert.Empty(t, joinPartiallyInvalidMessages("", ""))
+	assert.Equal(t, "first", joinPartiallyInvalidMessages("", "first"))
+	assert.Equal(t, "first; second", joinPartiallyInvalidMessages("first", "", "second"))
+}
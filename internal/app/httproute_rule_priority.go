@@ -0,0 +1,87 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+var errInvalidRulePriority = errors.New("invalid rule priority")
+
+const maxRoutingRulePriority = 999
+
+// Routing rules programmed with explicit priority have names prefixed with
+// rp<priority>_ or rn<priority>_ for positive and negative priorities respectively.
+var routingRulePriorityNamePattern = regexp.MustCompile(`^r([pn])(\d{3})_`)
+
+// httpRouteRulePriority returns the priority of the route rules set with the
+// HTTPRouteRulePriorityAnnotation. Routes without the annotation have priority 0.
+func httpRouteRulePriority(route gatewayv1.HTTPRoute) (int, error) {
+	value, ok := route.Annotations[HTTPRouteRulePriorityAnnotation]
+	if !ok {
+		return 0, nil
+	}
+	priority, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || priority < -maxRoutingRulePriority || priority > maxRoutingRulePriority {
+		return 0, fmt.Errorf(
+			"%w: %s annotation must be an integer between %d and %d, got %q",
+			errInvalidRulePriority,
+			HTTPRouteRulePriorityAnnotation,
+			-maxRoutingRulePriority,
+			maxRoutingRulePriority,
+			value,
+		)
+	}
+	return priority, nil
+}
+
+// httpRouteRulePriorityOrDefault returns priority 0 for routes with invalid priority.
+// Such routes are rejected when their routing rules are made.
+func httpRouteRulePriorityOrDefault(route gatewayv1.HTTPRoute) int {
+	priority, err := httpRouteRulePriority(route)
+	if err != nil {
+		return 0
+	}
+	return priority
+}
+
+// httpRouteProgrammingRevision returns the programming revision of the route. The priority
+// annotation is not part of the route spec, so it's included to reprogram the route when changed.
+func httpRouteProgrammingRevision(route gatewayv1.HTTPRoute) string {
+	value, ok := route.Annotations[HTTPRouteRulePriorityAnnotation]
+	if !ok {
+		return HTTPRouteProgrammingRevisionValue
+	}
+	return HTTPRouteProgrammingRevisionValue + "-priority-" + strings.TrimSpace(value)
+}
+
+func routingRulePriorityNamePrefix(priority int) string {
+	sign := 'p'
+	if priority < 0 {
+		sign = 'n'
+		priority = -priority
+	}
+	return fmt.Sprintf("r%c%03d", sign, priority)
+}
+
+// routingRulePriorityOf returns the priority the routing rule was programmed with.
+func routingRulePriorityOf(rule loadbalancer.RoutingRule) int {
+	matches := routingRulePriorityNamePattern.FindStringSubmatch(lo.FromPtr(rule.Name))
+	if matches == nil {
+		return 0
+	}
+	priority, err := strconv.Atoi(matches[2])
+	if err != nil {
+		return 0
+	}
+	if matches[1] == "n" {
+		return -priority
+	}
+	return priority
+}
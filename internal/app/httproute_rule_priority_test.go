@@ -0,0 +1,87 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPRouteRulePriority(t *testing.T) {
+	t.Run("defaults to zero without annotation", func(t *testing.T) {
+		route := makeRandomHTTPRoute()
+
+		priority, err := httpRouteRulePriority(route)
+
+		require.NoError(t, err)
+		assert.Zero(t, priority)
+	})
+
+	t.Run("parses annotation value", func(t *testing.T) {
+		for value, want := range map[string]int{
+			"10":   10,
+			" -25": -25,
+			"999":  maxRoutingRulePriority,
+			"-999": -maxRoutingRulePriority,
+		} {
+			route := makeRandomHTTPRoute()
+			route.Annotations = map[string]string{HTTPRouteRulePriorityAnnotation: value}
+
+			priority, err := httpRouteRulePriority(route)
+
+			require.NoError(t, err)
+			assert.Equal(t, want, priority, value)
+		}
+	})
+
+	t.Run("fails for invalid annotation value", func(t *testing.T) {
+		for _, value := range []string{"", "high", "1.5", "1000", "-1000"} {
+			route := makeRandomHTTPRoute()
+			route.Annotations = map[string]string{HTTPRouteRulePriorityAnnotation: value}
+
+			_, err := httpRouteRulePriority(route)
+
+			require.ErrorIs(t, err, errInvalidRulePriority, value)
+			assert.Zero(t, httpRouteRulePriorityOrDefault(route))
+		}
+	})
+}
+
+func TestHTTPRouteProgrammingRevision(t *testing.T) {
+	t.Run("uses programming revision without priority", func(t *testing.T) {
+		assert.Equal(t, HTTPRouteProgrammingRevisionValue, httpRouteProgrammingRevision(makeRandomHTTPRoute()))
+	})
+
+	t.Run("includes priority annotation value", func(t *testing.T) {
+		route := makeRandomHTTPRoute()
+		route.Annotations = map[string]string{HTTPRouteRulePriorityAnnotation: "-5"}
+
+		assert.Equal(t, HTTPRouteProgrammingRevisionValue+"-priority--5", httpRouteProgrammingRevision(route))
+	})
+}
+
+func TestRoutingRulePriorityOf(t *testing.T) {
+	t.Run("zero for rules without explicit priority", func(t *testing.T) {
+		fake := faker.New()
+		assert.Zero(t, routingRulePriorityOf(loadbalancer.RoutingRule{
+			Name: new(ociListenerPolicyRuleNameFromParts(0, fake.Lorem().Word(), fake.Lorem().Word())),
+		}))
+		assert.Zero(t, routingRulePriorityOf(defaultCatchAllRoutingRule(fake.Lorem().Word())))
+		assert.Zero(t, routingRulePriorityOf(loadbalancer.RoutingRule{}))
+	})
+
+	t.Run("parses priority of prioritized rule names", func(t *testing.T) {
+		fake := faker.New()
+		longName := strings.Repeat(fake.Lorem().Word(), 10)
+		for _, priority := range []int{1, 42, maxRoutingRulePriority, -1, -42, -maxRoutingRulePriority} {
+			ruleName := ociPrioritizedListenerPolicyRuleName(priority, 3, "ns", longName)
+
+			assert.LessOrEqual(t, len(ruleName), maxListenerPolicyNameLength)
+			assert.True(t, isValidOCIRoutingPolicyName(ruleName), ruleName)
+			assert.Equal(t, priority, routingRulePriorityOf(loadbalancer.RoutingRule{Name: &ruleName}), ruleName)
+		}
+	})
+}
@@ -17,6 +17,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
 )
 
 type randomGatewayClassOpt func(*gatewayv1.GatewayClass)
@@ -357,6 +359,15 @@ func randomBackendRefWithNameOpt(name string) randomBackendRefOpt {
 	}
 }
 
+func randomBackendRefWithExternalBackendOpt() randomBackendRefOpt {
+	return func(ref *gatewayv1.HTTPBackendRef) {
+		ref.BackendObjectReference.Group = new(gatewayv1.Group(types.GroupName))
+		ref.BackendObjectReference.Kind = new(gatewayv1.Kind(ExternalBackendKind))
+		ref.BackendObjectReference.Namespace = nil
+		ref.BackendObjectReference.Port = nil
+	}
+}
+
 func randomBackendRefWithNamespaceOpt(namespace string) randomBackendRefOpt {
 	return func(ref *gatewayv1.HTTPBackendRef) {
 		ref.BackendObjectReference.Namespace = new(gatewayv1.Namespace(namespace))
@@ -484,6 +495,7 @@ func makeRandomEndpointSlice(
 	fake := faker.New()
 	svcName := fake.Lorem().Word() + "." + fake.Internet().Domain()
 	epSlice := discoveryv1.EndpointSlice{
+		AddressType: discoveryv1.AddressTypeIPv4,
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fake.Internet().Domain(),
 			Namespace: fake.Internet().Slug(),
@@ -578,9 +590,9 @@ func randomSecretWithNameOpt(name string) randomSecretOpt {
 
 func randomSecretWithTLSDataOpt() randomSecretOpt {
 	return func(secret *corev1.Secret) {
-		fake := faker.New()
-		secret.Data[corev1.TLSCertKey] = []byte(fake.UUID().V4())
-		secret.Data[corev1.TLSPrivateKeyKey] = []byte(fake.UUID().V4())
+		certificate := issueTestCertificate(faker.New().Internet().Domain(), nil)
+		secret.Data[corev1.TLSCertKey] = certificate.certPEM
+		secret.Data[corev1.TLSPrivateKeyKey] = certificate.keyPEM
 	}
 }
 
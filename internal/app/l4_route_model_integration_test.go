@@ -4,11 +4,13 @@ import (
 	"testing"
 
 	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apitypes "k8s.io/apimachinery/pkg/types"
@@ -168,6 +170,93 @@ func TestTCPRouteModelResolveAndProgram(t *testing.T) {
 	assert.Len(t, updated.Status.Parents, 1)
 }
 
+func TestTCPRouteModelSkipsFQDNEndpoints(t *testing.T) {
+	port := gatewayv1.PortNumber(1935)
+	listener := gatewayv1.Listener{
+		Name:     "rtmp",
+		Protocol: gatewayv1.TCPProtocolType,
+		Port:     port,
+	}
+	route := &gatewayv1.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "rtmp", Generation: 3},
+		Spec: gatewayv1.TCPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{
+					{Name: "edge", SectionName: lo.ToPtr(gatewayv1.SectionName("rtmp"))},
+				},
+			},
+			Rules: []gatewayv1.TCPRouteRule{{
+				BackendRefs: []gatewayv1.BackendRef{{
+					BackendObjectReference: gatewayv1.BackendObjectReference{Name: "backend", Port: &port},
+				}},
+			}},
+		},
+	}
+	fqdnSlice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "iot",
+			Name:      "backend-fqdn",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: "backend"},
+		},
+		AddressType: discoveryv1.AddressTypeFQDN,
+		Endpoints: []discoveryv1.Endpoint{
+			{Addresses: []string{"media.example.com"}},
+			{Addresses: []string{"media-backup.example.com"}},
+		},
+	}
+
+	objects := append(l4GatewayObjects(listener), route, fqdnSlice)
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(newL4TestScheme(t)).
+		WithRuntimeObjects(objects...).
+		WithStatusSubresource(&gatewayv1.TCPRoute{}).
+		Build()
+	nlbClient := &stubNetworkLoadBalancerClient{}
+	endpointMetrics, err := newBackendEndpointMetrics()
+	require.NoError(t, err)
+	model := newTCPRouteModel(tcpRouteModelDeps{
+		RootLogger: diag.RootTestLogger(),
+		K8sClient:  k8sClient,
+		NetworkLoadBalancerModel: stubNetworkLoadBalancerGatewayModel{
+			networkLoadBalancer: networkloadbalancer.NetworkLoadBalancer{
+				Id: new("nlb-id"),
+				BackendSets: map[string]networkloadbalancer.BackendSet{
+					"bs_rtmp": {Name: new("bs_rtmp")},
+				},
+			},
+		},
+		OciNetworkLoadBalancerAPI: nlbClient,
+		WorkRequestsWatcher:       &stubWorkRequestsWatcher{},
+		EndpointMetrics:           endpointMetrics,
+	})
+
+	resolved, err := model.resolveRequest(t.Context(), reconcile.Request{
+		NamespacedName: apitypes.NamespacedName{Namespace: "iot", Name: "rtmp"},
+	})
+	require.NoError(t, err)
+	require.Len(t, resolved, 1)
+
+	require.NoError(t, model.programRoute(t.Context(), resolved[0]))
+	require.Len(t, nlbClient.updateBackendSetRequests, 1)
+	backends := nlbClient.updateBackendSetRequests[0].UpdateBackendSetDetails.Backends
+	require.Len(t, backends, 1)
+	assert.Equal(t, "10.0.0.10", lo.FromPtr(backends[0].IpAddress))
+
+	require.NoError(t, model.setProgrammed(t.Context(), resolved[0]))
+	var updated gatewayv1.TCPRoute
+	require.NoError(t, k8sClient.Get(t.Context(), apitypes.NamespacedName{Namespace: "iot", Name: "rtmp"}, &updated))
+	require.Len(t, updated.Status.Parents, 1)
+	resolvedRefs := meta.FindStatusCondition(
+		updated.Status.Parents[0].Conditions,
+		string(gatewayv1.RouteConditionResolvedRefs),
+	)
+	require.NotNil(t, resolvedRefs)
+	assert.Equal(t, metav1.ConditionTrue, resolvedRefs.Status)
+	assert.Contains(t, resolvedRefs.Message, "2 FQDN endpoints skipped")
+	assert.InDelta(t, 2, testutil.ToFloat64(
+		endpointMetrics.routeSkippedEndpoints.WithLabelValues("TCPRoute", "iot", "rtmp", "FQDN")), 0)
+}
+
 func TestUDPRouteModelResolveAndProgram(t *testing.T) {
 	port := gatewayv1.PortNumber(5684)
 	listener := gatewayv1.Listener{
@@ -14,6 +14,7 @@ import (
 
 const gatewayAPIGroup = "gateway.networking.k8s.io"
 const serviceKind = "Service"
+const secretKind = "Secret"
 
 func l4RouteKindAllowed(listener gatewayv1.Listener, routeKind gatewayv1.Kind) bool {
 	if listener.AllowedRoutes == nil || len(listener.AllowedRoutes.Kinds) == 0 {
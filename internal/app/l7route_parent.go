@@ -0,0 +1,275 @@
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+// l7RouteParentKeyHashBytes is the number of sha256 bytes used to derive
+// per-parent annotation keys. Keys must fit the 63 characters name limit.
+const l7RouteParentKeyHashBytes = 8
+
+// l7RouteParentPolicyRulesAnnotation returns the annotation key that holds programmed
+// policy rules of the route for the given parent gateway ("namespace/name").
+func l7RouteParentPolicyRulesAnnotation(baseAnnotation string, gatewayKey string) string {
+	sum := sha256.Sum256([]byte(gatewayKey))
+	return baseAnnotation + "-" + hex.EncodeToString(sum[:l7RouteParentKeyHashBytes])
+}
+
+// l7RouteProgrammedPolicyRules returns programmed policy rules of the route for the given
+// parent gateway. Routes programmed before per-parent tracking only have the shared
+// annotation, which is used as a fallback.
+func l7RouteProgrammedPolicyRules(
+	annotations map[string]string,
+	baseAnnotation string,
+	gateway gatewayv1.Gateway,
+) (string, bool) {
+	parentAnnotation := l7RouteParentPolicyRulesAnnotation(
+		baseAnnotation,
+		client.ObjectKeyFromObject(&gateway).String(),
+	)
+	if value, ok := annotations[parentAnnotation]; ok {
+		return value, true
+	}
+	value, ok := annotations[baseAnnotation]
+	return value, ok
+}
+
+type l7RouteStateParams struct {
+	gateway               gatewayv1.Gateway
+	config                types.GatewayConfig
+	matchedListeners      []gatewayv1.Listener
+	route                 client.Object
+	routeKind             string
+	policyRulesAnnotation string
+}
+
+// resolveL7RouteProgrammedPolicyRules returns policy rules programmed for the route on the given
+// parent gateway. Rules of routes that were not programmed since the programming state was
+// introduced are read from the route annotations.
+func resolveL7RouteProgrammedPolicyRules(
+	ctx context.Context,
+	programmingState programmingStateModel,
+	params l7RouteStateParams,
+) ([]programmedHTTPRoutePolicyRule, bool, error) {
+	rules, found, err := programmingState.programmedRoutePolicyRules(ctx, routeProgrammingStateParams{
+		gateway:   params.gateway,
+		routeKind: params.routeKind,
+		route:     params.route,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if found {
+		return parseProgrammedPolicyRuleEntries(rules), true, nil
+	}
+
+	annotationValue, found := l7RouteProgrammedPolicyRules(
+		params.route.GetAnnotations(),
+		params.policyRulesAnnotation,
+		params.gateway,
+	)
+	if !found {
+		return nil, false, nil
+	}
+	return parseProgrammedHTTPRoutePolicyRules(annotationValue), true, nil
+}
+
+// removeRejectedL7RoutePolicyRules removes policy rules of the route that lost a listener
+// hostname conflict. The state is kept so rules are also removed when the route is deleted.
+func removeRejectedL7RoutePolicyRules(
+	ctx context.Context,
+	ociLoadBalancerModel ociLoadBalancerModel,
+	programmingState programmingStateModel,
+	params l7RouteStateParams,
+) error {
+	previousRules, found, err := resolveL7RouteProgrammedPolicyRules(ctx, programmingState, params)
+	if err != nil || !found {
+		return err
+	}
+
+	return removeL7RoutePolicyRules(
+		ctx,
+		ociLoadBalancerModel,
+		params.config.Spec.LoadBalancerID,
+		ociGatewayNamePrefix(&params.gateway, params.config),
+		ociResourceNamingFromConfig(params.config),
+		params.matchedListeners,
+		previousRules,
+	)
+}
+
+type deprovisionL7RouteBackendSetsParams struct {
+	gateway     gatewayv1.Gateway
+	config      types.GatewayConfig
+	route       client.Object
+	routeKind   string
+	backendRefs []gatewayv1.BackendRef
+}
+
+// deprovisionL7RouteBackendSets deletes backend sets of the route. Routes referencing the same
+// service port share the backend set, so it is kept while other routes recorded in the programming
+// state of the gateway still reference it and is deleted with the last referencing route.
+// Backend sets programmed before the naming scheme of the gateway was changed are deleted as well.
+func deprovisionL7RouteBackendSets(
+	ctx context.Context,
+	logger *slog.Logger,
+	ociLoadBalancerModel ociLoadBalancerModel,
+	programmingState programmingStateModel,
+	params deprovisionL7RouteBackendSetsParams,
+) error {
+	processedBackendSets := make(map[string]struct{})
+	namings := ociResourceNamingFromConfig(params.config).withLegacy()
+	for _, backendRef := range params.backendRefs {
+		for _, naming := range namings {
+			backendSetName := ociBackendSetNameFromBackendObjectRef(
+				naming,
+				params.route.GetNamespace(),
+				backendRef.BackendObjectReference,
+			)
+			if _, ok := processedBackendSets[backendSetName]; ok {
+				continue
+			}
+			processedBackendSets[backendSetName] = struct{}{}
+
+			references, err := programmingState.backendSetReferences(ctx, backendSetReferencesParams{
+				gateway:        params.gateway,
+				routeKind:      params.routeKind,
+				route:          params.route,
+				backendSetName: backendSetName,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to resolve references of backend set %s: %w", backendSetName, err)
+			}
+			if len(references) > 0 {
+				logger.InfoContext(ctx, "Backend set is referenced by other routes, skipping deprovisioning",
+					slog.String("backendSetName", backendSetName),
+					slog.String("route", params.route.GetNamespace()+"/"+params.route.GetName()),
+					slog.Any("references", references),
+				)
+				continue
+			}
+
+			err = ociLoadBalancerModel.deprovisionBackendSet(ctx, deprovisionBackendSetParams{
+				loadBalancerID: params.config.Spec.LoadBalancerID,
+				routeNamespace: params.route.GetNamespace(),
+				backendRef:     backendRef,
+				naming:         naming,
+			})
+			if err != nil {
+				return fmt.Errorf(
+					"failed to deprovision backend set for rule %s/%s: %w",
+					params.route.GetNamespace(),
+					params.route.GetName(),
+					err,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+type releaseL7RouteParentParams struct {
+	route                 client.Object
+	parentRefs            []gatewayv1.ParentReference
+	gateway               gatewayv1.Gateway
+	policyRulesAnnotation string
+	finalizer             string
+	routeKind             string
+}
+
+// releaseL7RouteParent drops programming state of the given parent gateway from the route.
+// The finalizer is removed only when no other existing parent gateway still has programmed
+// policy rules, so each parent can deprovision its own load balancer.
+func releaseL7RouteParent(
+	ctx context.Context,
+	k8sClient k8sClient,
+	programmingState programmingStateModel,
+	params releaseL7RouteParentParams,
+) error {
+	route := params.route
+	gatewayKey := client.ObjectKeyFromObject(&params.gateway).String()
+	annotations := maps.Clone(route.GetAnnotations())
+	delete(annotations, l7RouteParentPolicyRulesAnnotation(params.policyRulesAnnotation, gatewayKey))
+	delete(annotations, params.policyRulesAnnotation)
+
+	otherParentsProgrammed := false
+	for _, otherGatewayKey := range parentGatewayIndexKeys(route.GetNamespace(), params.parentRefs) {
+		if otherGatewayKey == gatewayKey {
+			continue
+		}
+		otherAnnotation := l7RouteParentPolicyRulesAnnotation(params.policyRulesAnnotation, otherGatewayKey)
+		_, programmed := annotations[otherAnnotation]
+		if !programmed {
+			otherNamespace, otherName, _ := strings.Cut(otherGatewayKey, "/")
+			_, recorded, err := programmingState.programmedRoutePolicyRules(ctx, routeProgrammingStateParams{
+				gateway: gatewayv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: otherNamespace, Name: otherName},
+				},
+				routeKind: params.routeKind,
+				route:     route,
+			})
+			if err != nil {
+				return err
+			}
+			programmed = recorded
+		}
+		if !programmed {
+			continue
+		}
+
+		active, err := l7RouteParentGatewayActive(ctx, k8sClient, otherGatewayKey)
+		if err != nil {
+			return err
+		}
+		if !active {
+			// Gateway is gone, so nothing will deprovision its rules anymore
+			delete(annotations, otherAnnotation)
+			continue
+		}
+		otherParentsProgrammed = true
+	}
+
+	route.SetAnnotations(annotations)
+	if !otherParentsProgrammed {
+		controllerutil.RemoveFinalizer(route, params.finalizer)
+	}
+
+	if err := k8sClient.Update(ctx, route); err != nil {
+		return fmt.Errorf("failed to update %s %s/%s after deprovisioning: %w",
+			params.routeKind, route.GetNamespace(), route.GetName(), err)
+	}
+
+	// The route is updated first, so a failed update can be retried with the state still in place
+	return programmingState.forgetProgrammedRoute(ctx, routeProgrammingStateParams{
+		gateway:   params.gateway,
+		routeKind: params.routeKind,
+		route:     route,
+	})
+}
+
+func l7RouteParentGatewayActive(ctx context.Context, k8sClient k8sClient, gatewayKey string) (bool, error) {
+	namespace, name, _ := strings.Cut(gatewayKey, "/")
+	var gateway gatewayv1.Gateway
+	if err := k8sClient.Get(ctx, apitypes.NamespacedName{Namespace: namespace, Name: name}, &gateway); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get parent gateway %s: %w", gatewayKey, err)
+	}
+	return gateway.DeletionTimestamp == nil, nil
+}
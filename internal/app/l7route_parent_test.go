@@ -0,0 +1,414 @@
+package app
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestL7RouteParent(t *testing.T) {
+	t.Run("l7RouteParentPolicyRulesAnnotation", func(t *testing.T) {
+		t.Run("derives stable valid key per gateway", func(t *testing.T) {
+			gatewayKey := client.ObjectKeyFromObject(newRandomGateway()).String()
+			otherGatewayKey := client.ObjectKeyFromObject(newRandomGateway()).String()
+
+			got := l7RouteParentPolicyRulesAnnotation(HTTPRouteProgrammedPolicyRulesAnnotation, gatewayKey)
+
+			assert.Equal(t, got, l7RouteParentPolicyRulesAnnotation(HTTPRouteProgrammedPolicyRulesAnnotation, gatewayKey))
+			assert.NotEqual(t, got, l7RouteParentPolicyRulesAnnotation(
+				HTTPRouteProgrammedPolicyRulesAnnotation,
+				otherGatewayKey,
+			))
+			assert.True(t, strings.HasPrefix(got, HTTPRouteProgrammedPolicyRulesAnnotation+"-"))
+			_, name, _ := strings.Cut(got, "/")
+			assert.LessOrEqual(t, len(name), 63)
+		})
+	})
+
+	t.Run("l7RouteProgrammedPolicyRules", func(t *testing.T) {
+		t.Run("reads parent scoped rules", func(t *testing.T) {
+			fake := faker.New()
+			gateway := *newRandomGateway()
+			wantRules := "listener/rule-" + fake.Lorem().Word()
+			annotations := map[string]string{
+				l7RouteParentPolicyRulesAnnotation(
+					GRPCRouteProgrammedPolicyRulesAnnotation,
+					client.ObjectKeyFromObject(&gateway).String(),
+				): wantRules,
+				GRPCRouteProgrammedPolicyRulesAnnotation: "listener/legacy-" + fake.Lorem().Word(),
+			}
+
+			got, ok := l7RouteProgrammedPolicyRules(annotations, GRPCRouteProgrammedPolicyRulesAnnotation, gateway)
+
+			require.True(t, ok)
+			assert.Equal(t, wantRules, got)
+		})
+
+		t.Run("falls back to shared annotation", func(t *testing.T) {
+			wantRules := "listener/legacy-" + faker.New().Lorem().Word()
+			annotations := map[string]string{GRPCRouteProgrammedPolicyRulesAnnotation: wantRules}
+
+			got, ok := l7RouteProgrammedPolicyRules(
+				annotations,
+				GRPCRouteProgrammedPolicyRulesAnnotation,
+				*newRandomGateway(),
+			)
+
+			require.True(t, ok)
+			assert.Equal(t, wantRules, got)
+		})
+
+		t.Run("reports missing rules", func(t *testing.T) {
+			_, ok := l7RouteProgrammedPolicyRules(nil, GRPCRouteProgrammedPolicyRulesAnnotation, *newRandomGateway())
+
+			assert.False(t, ok)
+		})
+	})
+
+	t.Run("resolveL7RouteProgrammedPolicyRules", func(t *testing.T) {
+		t.Run("reads rules recorded in programming state", func(t *testing.T) {
+			fake := faker.New()
+			gateway := *newRandomGateway()
+			route := makeRandomHTTPRoute()
+			route.Annotations = map[string]string{
+				HTTPRouteProgrammedPolicyRulesAnnotation: "legacy-" + fake.Lorem().Word(),
+			}
+			listenerName := "listener-" + fake.Lorem().Word()
+			ruleName := "rule-" + fake.Lorem().Word()
+			programmingState := NewMockprogrammingStateModel(t)
+			programmingState.EXPECT().
+				programmedRoutePolicyRules(t.Context(), routeProgrammingStateParams{
+					gateway:   gateway,
+					routeKind: "HTTPRoute",
+					route:     &route,
+				}).
+				Return([]string{listenerName + "/" + ruleName}, true, nil).
+				Once()
+
+			got, found, err := resolveL7RouteProgrammedPolicyRules(t.Context(), programmingState, l7RouteStateParams{
+				gateway:               gateway,
+				route:                 &route,
+				routeKind:             "HTTPRoute",
+				policyRulesAnnotation: HTTPRouteProgrammedPolicyRulesAnnotation,
+			})
+
+			require.NoError(t, err)
+			require.True(t, found)
+			assert.Equal(t, []programmedHTTPRoutePolicyRule{{listenerName: listenerName, ruleName: ruleName}}, got)
+		})
+
+		t.Run("falls back to route annotations", func(t *testing.T) {
+			gateway := *newRandomGateway()
+			ruleName := "rule-" + faker.New().Lorem().Word()
+			route := makeRandomHTTPRoute()
+			route.Annotations = map[string]string{HTTPRouteProgrammedPolicyRulesAnnotation: ruleName}
+			programmingState := NewMockprogrammingStateModel(t)
+			programmingState.EXPECT().
+				programmedRoutePolicyRules(t.Context(), mock.Anything).
+				Return(nil, false, nil).
+				Once()
+
+			got, found, err := resolveL7RouteProgrammedPolicyRules(t.Context(), programmingState, l7RouteStateParams{
+				gateway:               gateway,
+				route:                 &route,
+				routeKind:             "HTTPRoute",
+				policyRulesAnnotation: HTTPRouteProgrammedPolicyRulesAnnotation,
+			})
+
+			require.NoError(t, err)
+			require.True(t, found)
+			assert.Equal(t, []programmedHTTPRoutePolicyRule{{ruleName: ruleName}}, got)
+		})
+
+		t.Run("reports missing rules", func(t *testing.T) {
+			route := makeRandomHTTPRoute()
+			programmingState := NewMockprogrammingStateModel(t)
+			programmingState.EXPECT().
+				programmedRoutePolicyRules(t.Context(), mock.Anything).
+				Return(nil, false, nil).
+				Once()
+
+			_, found, err := resolveL7RouteProgrammedPolicyRules(t.Context(), programmingState, l7RouteStateParams{
+				gateway:               *newRandomGateway(),
+				route:                 &route,
+				routeKind:             "HTTPRoute",
+				policyRulesAnnotation: HTTPRouteProgrammedPolicyRulesAnnotation,
+			})
+
+			require.NoError(t, err)
+			assert.False(t, found)
+		})
+
+		t.Run("returns programming state errors", func(t *testing.T) {
+			route := makeRandomHTTPRoute()
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+			programmingState := NewMockprogrammingStateModel(t)
+			programmingState.EXPECT().
+				programmedRoutePolicyRules(t.Context(), mock.Anything).
+				Return(nil, false, wantErr).
+				Once()
+
+			_, _, err := resolveL7RouteProgrammedPolicyRules(t.Context(), programmingState, l7RouteStateParams{
+				gateway:               *newRandomGateway(),
+				route:                 &route,
+				routeKind:             "HTTPRoute",
+				policyRulesAnnotation: HTTPRouteProgrammedPolicyRulesAnnotation,
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("removeRejectedL7RoutePolicyRules", func(t *testing.T) {
+		t.Run("removes recorded rules from matched listeners", func(t *testing.T) {
+			route := makeRandomHTTPRoute()
+			config := makeRandomGatewayConfig()
+			listener := makeRandomListener()
+			ruleName := "rule-" + faker.New().Lorem().Word()
+			programmingState := NewMockprogrammingStateModel(t)
+			programmingState.EXPECT().
+				programmedRoutePolicyRules(t.Context(), mock.Anything).
+				Return([]string{ruleName}, true, nil).
+				Once()
+			ociLBModel := NewMockociLoadBalancerModel(t)
+			ociLBModel.EXPECT().commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+				loadBalancerID:  config.Spec.LoadBalancerID,
+				listenerName:    string(listener.Name),
+				policyRules:     []loadbalancer.RoutingRule{},
+				prevPolicyRules: []string{ruleName},
+			}).Return(nil).Once()
+
+			err := removeRejectedL7RoutePolicyRules(t.Context(), ociLBModel, programmingState, l7RouteStateParams{
+				gateway:               *newRandomGateway(),
+				config:                config,
+				matchedListeners:      []gatewayv1.Listener{listener},
+				route:                 &route,
+				routeKind:             "HTTPRoute",
+				policyRulesAnnotation: HTTPRouteProgrammedPolicyRulesAnnotation,
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("skips routes without programmed rules", func(t *testing.T) {
+			route := makeRandomHTTPRoute()
+			programmingState := NewMockprogrammingStateModel(t)
+			programmingState.EXPECT().
+				programmedRoutePolicyRules(t.Context(), mock.Anything).
+				Return(nil, false, nil).
+				Once()
+
+			err := removeRejectedL7RoutePolicyRules(
+				t.Context(),
+				NewMockociLoadBalancerModel(t),
+				programmingState,
+				l7RouteStateParams{
+					gateway:               *newRandomGateway(),
+					config:                makeRandomGatewayConfig(),
+					matchedListeners:      makeFewRandomListeners(),
+					route:                 &route,
+					routeKind:             "HTTPRoute",
+					policyRulesAnnotation: HTTPRouteProgrammedPolicyRulesAnnotation,
+				},
+			)
+
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("releaseL7RouteParent", func(t *testing.T) {
+		makeRoute := func(gateways ...*gatewayv1.Gateway) *gatewayv1.HTTPRoute {
+			route := makeRandomHTTPRoute()
+			route.Spec.ParentRefs = nil
+			route.Annotations = map[string]string{}
+			for _, gateway := range gateways {
+				route.Spec.ParentRefs = append(route.Spec.ParentRefs, gatewayv1.ParentReference{
+					Name:      gatewayv1.ObjectName(gateway.Name),
+					Namespace: new(gatewayv1.Namespace(gateway.Namespace)),
+				})
+				route.Annotations[l7RouteParentPolicyRulesAnnotation(
+					HTTPRouteProgrammedPolicyRulesAnnotation,
+					client.ObjectKeyFromObject(gateway).String(),
+				)] = "listener/rule-" + gateway.Name
+			}
+			controllerutil.AddFinalizer(&route, HTTPRouteProgrammedFinalizer)
+			return &route
+		}
+
+		t.Run("removes finalizer when other parent gateway is deleting", func(t *testing.T) {
+			gateway := newRandomGateway()
+			deletingGateway := newRandomGateway()
+			deletingGateway.DeletionTimestamp = new(metav1.Now())
+			deletingGateway.Finalizers = []string{"example.com/finalizer"}
+			route := makeRoute(gateway, deletingGateway)
+			k8sClient := NewMockk8sClient(t)
+			programmingState := NewMockprogrammingStateModel(t)
+
+			setupClientGet(t, k8sClient, client.ObjectKeyFromObject(deletingGateway), *deletingGateway)
+			updateCall := k8sClient.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				return !controllerutil.ContainsFinalizer(obj, HTTPRouteProgrammedFinalizer) &&
+					assert.Empty(t, obj.GetAnnotations())
+			})).Return(nil).Once()
+			programmingState.EXPECT().forgetProgrammedRoute(t.Context(), routeProgrammingStateParams{
+				gateway:   *gateway,
+				routeKind: "HTTPRoute",
+				route:     route,
+			}).Return(nil).Once().NotBefore(updateCall)
+
+			err := releaseL7RouteParent(t.Context(), k8sClient, programmingState, releaseL7RouteParentParams{
+				route:                 route,
+				parentRefs:            route.Spec.ParentRefs,
+				gateway:               *gateway,
+				policyRulesAnnotation: HTTPRouteProgrammedPolicyRulesAnnotation,
+				finalizer:             HTTPRouteProgrammedFinalizer,
+				routeKind:             "HTTPRoute",
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("returns other parent gateway lookup errors", func(t *testing.T) {
+			gateway := newRandomGateway()
+			otherGateway := newRandomGateway()
+			route := makeRoute(gateway, otherGateway)
+			k8sClient := NewMockk8sClient(t)
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+
+			k8sClient.EXPECT().
+				Get(t.Context(), client.ObjectKeyFromObject(otherGateway), mock.Anything).
+				Return(wantErr).
+				Once()
+
+			err := releaseL7RouteParent(
+				t.Context(),
+				k8sClient,
+				NewMockprogrammingStateModel(t),
+				releaseL7RouteParentParams{
+					route:                 route,
+					parentRefs:            route.Spec.ParentRefs,
+					gateway:               *gateway,
+					policyRulesAnnotation: HTTPRouteProgrammedPolicyRulesAnnotation,
+					finalizer:             HTTPRouteProgrammedFinalizer,
+					routeKind:             "HTTPRoute",
+				},
+			)
+
+			require.ErrorIs(t, err, wantErr)
+		})
+
+		t.Run("keeps finalizer while other parent gateway has recorded state", func(t *testing.T) {
+			gateway := newRandomGateway()
+			otherGateway := newRandomGateway()
+			route := makeRoute(gateway)
+			route.Spec.ParentRefs = append(route.Spec.ParentRefs, gatewayv1.ParentReference{
+				Name:      gatewayv1.ObjectName(otherGateway.Name),
+				Namespace: new(gatewayv1.Namespace(otherGateway.Namespace)),
+			})
+			k8sClient := NewMockk8sClient(t)
+			programmingState := NewMockprogrammingStateModel(t)
+
+			programmingState.EXPECT().
+				programmedRoutePolicyRules(t.Context(), mock.MatchedBy(func(params routeProgrammingStateParams) bool {
+					return params.gateway.Namespace == otherGateway.Namespace &&
+						params.gateway.Name == otherGateway.Name &&
+						params.routeKind == "HTTPRoute"
+				})).
+				Return([]string{"listener/rule-" + otherGateway.Name}, true, nil).
+				Once()
+			setupClientGet(t, k8sClient, client.ObjectKeyFromObject(otherGateway), *otherGateway)
+			k8sClient.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				return controllerutil.ContainsFinalizer(obj, HTTPRouteProgrammedFinalizer) &&
+					assert.Empty(t, obj.GetAnnotations())
+			})).Return(nil).Once()
+			programmingState.EXPECT().
+				forgetProgrammedRoute(t.Context(), mock.MatchedBy(func(params routeProgrammingStateParams) bool {
+					return params.gateway.Name == gateway.Name
+				})).
+				Return(nil).
+				Once()
+
+			err := releaseL7RouteParent(t.Context(), k8sClient, programmingState, releaseL7RouteParentParams{
+				route:                 route,
+				parentRefs:            route.Spec.ParentRefs,
+				gateway:               *gateway,
+				policyRulesAnnotation: HTTPRouteProgrammedPolicyRulesAnnotation,
+				finalizer:             HTTPRouteProgrammedFinalizer,
+				routeKind:             "HTTPRoute",
+			})
+
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("deprovisionL7RouteBackendSets", func(t *testing.T) {
+		t.Run("deletes backend sets of the previous naming scheme", func(t *testing.T) {
+			gateway := *newRandomGateway()
+			config := makeRandomGatewayConfig()
+			config.Spec.Naming = &types.OCIResourceNaming{Separator: "_"}
+			route := makeRandomHTTPRoute()
+			backendRef := makeRandomBackendRef().BackendRef
+			ociLoadBalancerModel := NewMockociLoadBalancerModel(t)
+			programmingState := NewMockprogrammingStateModel(t)
+
+			wantNames := []string{
+				ociBackendSetNameFromBackendObjectRef(
+					ociResourceNaming{separator: "_"}, route.Namespace, backendRef.BackendObjectReference,
+				),
+				ociBackendSetNameFromBackendObjectRef(
+					ociResourceNaming{}, route.Namespace, backendRef.BackendObjectReference,
+				),
+			}
+			for _, wantName := range wantNames {
+				programmingState.EXPECT().
+					backendSetReferences(t.Context(), backendSetReferencesParams{
+						gateway:        gateway,
+						routeKind:      "HTTPRoute",
+						route:          &route,
+						backendSetName: wantName,
+					}).
+					Return(nil, nil).
+					Once()
+			}
+			for _, naming := range []ociResourceNaming{{separator: "_"}, {}} {
+				ociLoadBalancerModel.EXPECT().
+					deprovisionBackendSet(t.Context(), deprovisionBackendSetParams{
+						loadBalancerID: config.Spec.LoadBalancerID,
+						routeNamespace: route.Namespace,
+						backendRef:     backendRef,
+						naming:         naming,
+					}).
+					Return(nil).
+					Once()
+			}
+
+			err := deprovisionL7RouteBackendSets(
+				t.Context(),
+				diag.RootTestLogger(),
+				ociLoadBalancerModel,
+				programmingState,
+				deprovisionL7RouteBackendSetsParams{
+					gateway:     gateway,
+					config:      config,
+					route:       &route,
+					routeKind:   "HTTPRoute",
+					backendRefs: []gatewayv1.BackendRef{backendRef},
+				},
+			)
+
+			require.NoError(t, err)
+		})
+	})
+}
@@ -0,0 +1,210 @@
+package app
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+type certificateHostnameMatch int
+
+var errListenerHostnameNotCovered = errors.New("listener hostname is not covered by certificates")
+
+const (
+	certificateHostnameNoMatch certificateHostnameMatch = iota
+	certificateHostnameWildcardMatch
+	certificateHostnameExactMatch
+)
+
+// selectListenerCertificate picks the certificate to bind to the listener. OCI listener
+// serves a single certificate, so if the listener has a hostname the certificate that matches
+// it most specifically is preferred. Falls back to the first certificate otherwise.
+func selectListenerCertificate(
+	listener *gatewayv1.Listener,
+	certificates []loadbalancer.Certificate,
+) loadbalancer.Certificate {
+	if len(certificates) == 0 {
+		return loadbalancer.Certificate{}
+	}
+	if listener == nil || listener.Hostname == nil || len(certificates) == 1 {
+		return certificates[0]
+	}
+
+	hostname := strings.ToLower(string(*listener.Hostname))
+	selected := certificates[0]
+	bestMatch, bestLength := certificateHostnameNoMatch, 0
+	for _, certificate := range certificates {
+		match, length := certificateHostnameMatchFor(hostname, lo.FromPtr(certificate.PublicCertificate))
+		if match > bestMatch || (match == bestMatch && match != certificateHostnameNoMatch && length > bestLength) {
+			selected, bestMatch, bestLength = certificate, match, length
+		}
+	}
+	return selected
+}
+
+// certificateHostnameMatchFor returns how the leaf certificate of the PEM chain matches the hostname.
+// The length of the matched certificate name is returned to rank wildcard matches by specificity.
+func certificateHostnameMatchFor(hostname string, publicCertificatePEM string) (certificateHostnameMatch, int) {
+	block, _ := pem.Decode([]byte(publicCertificatePEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return certificateHostnameNoMatch, 0
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return certificateHostnameNoMatch, 0
+	}
+
+	names := cert.DNSNames
+	if len(names) == 0 && cert.Subject.CommonName != "" {
+		names = []string{cert.Subject.CommonName}
+	}
+
+	bestMatch, bestLength := certificateHostnameNoMatch, 0
+	for _, name := range names {
+		name = strings.ToLower(name)
+		match := certificateHostnameNoMatch
+		switch {
+		case name == hostname:
+			match = certificateHostnameExactMatch
+		case wildcardCertificateNameMatches(name, hostname):
+			match = certificateHostnameWildcardMatch
+		}
+		if match == certificateHostnameNoMatch {
+			continue
+		}
+		if match > bestMatch || (match == bestMatch && len(name) > bestLength) {
+			bestMatch, bestLength = match, len(name)
+		}
+	}
+	return bestMatch, bestLength
+}
+
+// wildcardCertificateNameMatches checks if the wildcard certificate name (e.g. *.example.com)
+// covers the hostname. Wildcard only covers a single label, so foo.bar.example.com does not match.
+func wildcardCertificateNameMatches(name string, hostname string) bool {
+	suffix, isWildcard := strings.CutPrefix(name, "*.")
+	if !isWildcard {
+		return false
+	}
+	label, rest, found := strings.Cut(hostname, ".")
+	return found && label != "" && label != "*" && rest == suffix
+}
+
+// listenerCertificateChain is the certificate chain of the listener Secret split as expected
+// by OCI: the leaf certificate and the intermediate certificates that issued it.
+type listenerCertificateChain struct {
+	publicCertificate string
+
+	// PEM encoded intermediate certificates, empty if the leaf certificate comes alone
+	caCertificate string
+}
+
+// parseListenerCertificateChain splits the PEM bundle of the tls.crt Secret key into the leaf
+// and intermediate certificates. Certificates are expected in the leaf first order, so each one
+// must be issued by the next one.
+func parseListenerCertificateChain(chainPEM []byte) (listenerCertificateChain, error) {
+	var certificates []*x509.Certificate
+	var encoded []string
+	rest := chainPEM
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		position := len(certificates) + 1
+		if block.Type != "CERTIFICATE" {
+			return listenerCertificateChain{}, fmt.Errorf("unexpected %s PEM block at position %d", block.Type, position)
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return listenerCertificateChain{}, fmt.Errorf("failed to parse certificate %d: %w", position, err)
+		}
+		certificates = append(certificates, certificate)
+		encoded = append(encoded, string(pem.EncodeToMemory(&pem.Block{Type: block.Type, Bytes: block.Bytes})))
+	}
+
+	if len(certificates) == 0 {
+		return listenerCertificateChain{}, errors.New("no PEM encoded certificates found")
+	}
+	if len(bytes.TrimSpace(rest)) > 0 {
+		return listenerCertificateChain{}, fmt.Errorf(
+			"malformed PEM data after certificate %d", len(certificates),
+		)
+	}
+	for i := 1; i < len(certificates); i++ {
+		if err := certificates[i-1].CheckSignatureFrom(certificates[i]); err != nil {
+			return listenerCertificateChain{}, fmt.Errorf(
+				"certificate %d is not issued by certificate %d: %w", i, i+1, err,
+			)
+		}
+	}
+
+	return listenerCertificateChain{
+		publicCertificate: encoded[0],
+		caCertificate:     strings.Join(encoded[1:], ""),
+	}, nil
+}
+
+// validateListenerCertificate checks the certificate chain and the private key of the listener
+// Secret before they are programmed, so OCI does not reject them or serve unusable certificates.
+func validateListenerCertificate(secret corev1.Secret, now time.Time) error {
+	chain, err := parseListenerCertificateChain(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return fmt.Errorf("invalid %s certificate chain: %w", corev1.TLSCertKey, err)
+	}
+
+	keyPair, err := tls.X509KeyPair([]byte(chain.publicCertificate), secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return fmt.Errorf("invalid %s private key: %w", corev1.TLSPrivateKeyKey, err)
+	}
+	leaf, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return fmt.Errorf("failed to parse certificate: %w", err)
+	}
+	if now.Before(leaf.NotBefore) {
+		return fmt.Errorf("certificate is not valid before %s", leaf.NotBefore.UTC().Format(time.RFC3339))
+	}
+	if now.After(leaf.NotAfter) {
+		return fmt.Errorf("certificate has expired at %s", leaf.NotAfter.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// listenerCertificatesCoverHostname checks if any of the listener certificates is issued for the
+// listener hostname. Listeners without hostname accept any certificate.
+func listenerCertificatesCoverHostname(
+	listener gatewayv1.Listener,
+	certificates []loadbalancer.Certificate,
+) bool {
+	if listener.Hostname == nil {
+		return true
+	}
+	hostname := strings.ToLower(string(*listener.Hostname))
+	return slices.ContainsFunc(certificates, func(certificate loadbalancer.Certificate) bool {
+		match, _ := certificateHostnameMatchFor(hostname, lo.FromPtr(certificate.PublicCertificate))
+		return match != certificateHostnameNoMatch
+	})
+}
+
+// newInvalidCertificateRefError reports the invalid certificate in the listener ResolvedRefs condition.
+func newInvalidCertificateRefError(listenerName gatewayv1.SectionName, message string, cause error) *resourceStatusError {
+	return &resourceStatusError{
+		conditionType: string(gatewayv1.ListenerConditionResolvedRefs),
+		reason:        string(gatewayv1.ListenerReasonInvalidCertificateRef),
+		message:       fmt.Sprintf("Listener %s: %s", listenerName, message),
+		cause:         cause,
+		listenerName:  string(listenerName),
+	}
+}
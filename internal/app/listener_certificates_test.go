@@ -0,0 +1,320 @@
+package app
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+type testCertificate struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+// issueTestCertificate issues certificate signed by the issuer or self signed CA certificate if issuer is nil.
+// Options can adjust the certificate template, e.g. validity period or DNS names.
+func issueTestCertificate(
+	commonName string,
+	issuer *testCertificate,
+	opts ...func(*x509.Certificate),
+) testCertificate {
+	key := lo.Must(ecdsa.GenerateKey(elliptic.P256(), rand.Reader))
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	for _, opt := range opts {
+		opt(&template)
+	}
+	parent, parentKey := &template, key
+	if issuer != nil {
+		parent, parentKey = issuer.cert, issuer.key
+	}
+	der := lo.Must(x509.CreateCertificate(rand.Reader, &template, parent, &key.PublicKey, parentKey))
+	return testCertificate{
+		cert:    lo.Must(x509.ParseCertificate(der)),
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM: pem.EncodeToMemory(&pem.Block{
+			Type:  "EC PRIVATE KEY",
+			Bytes: lo.Must(x509.MarshalECPrivateKey(key)),
+		}),
+	}
+}
+
+func makeTestServerCertificate(t *testing.T, commonName string, dnsNames ...string) loadbalancer.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return loadbalancer.Certificate{
+		CertificateName:   new(faker.New().UUID().V4()),
+		PublicCertificate: new(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))),
+	}
+}
+
+func TestSelectListenerCertificate(t *testing.T) {
+	makeListener := func(hostname string) *gatewayv1.Listener {
+		listener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+		if hostname != "" {
+			listener.Hostname = new(gatewayv1.Hostname(hostname))
+		}
+		return &listener
+	}
+
+	t.Run("returns empty certificate without certificates", func(t *testing.T) {
+		assert.Equal(t, loadbalancer.Certificate{}, selectListenerCertificate(makeListener("api.example.com"), nil))
+	})
+
+	t.Run("returns first certificate without listener hostname", func(t *testing.T) {
+		first := makeTestServerCertificate(t, "", "*.example.com")
+		exact := makeTestServerCertificate(t, "", "api.example.com")
+		assert.Equal(t, first, selectListenerCertificate(makeListener(""), []loadbalancer.Certificate{first, exact}))
+	})
+
+	t.Run("prefers exact match over wildcard", func(t *testing.T) {
+		wildcard := makeTestServerCertificate(t, "", "*.example.com")
+		exact := makeTestServerCertificate(t, "", "www.example.com", "API.example.com")
+		assert.Equal(t, exact, selectListenerCertificate(
+			makeListener("api.example.com"),
+			[]loadbalancer.Certificate{wildcard, exact},
+		))
+	})
+
+	t.Run("prefers most specific wildcard", func(t *testing.T) {
+		parent := makeTestServerCertificate(t, "", "*.example.com")
+		specific := makeTestServerCertificate(t, "", "*.eu.example.com")
+		assert.Equal(t, specific, selectListenerCertificate(
+			makeListener("api.eu.example.com"),
+			[]loadbalancer.Certificate{parent, specific},
+		))
+	})
+
+	t.Run("matches common name when certificate has no DNS names", func(t *testing.T) {
+		other := makeTestServerCertificate(t, "", "other.example.com")
+		legacy := makeTestServerCertificate(t, "api.example.com")
+		assert.Equal(t, legacy, selectListenerCertificate(
+			makeListener("api.example.com"),
+			[]loadbalancer.Certificate{other, legacy},
+		))
+	})
+
+	t.Run("matches wildcard listener hostname with wildcard certificate", func(t *testing.T) {
+		other := makeTestServerCertificate(t, "", "api.example.com")
+		wildcard := makeTestServerCertificate(t, "", "*.example.com")
+		assert.Equal(t, wildcard, selectListenerCertificate(
+			makeListener("*.example.com"),
+			[]loadbalancer.Certificate{other, wildcard},
+		))
+	})
+
+	t.Run("falls back to first certificate when nothing matches", func(t *testing.T) {
+		first := makeTestServerCertificate(t, "", "*.example.org")
+		invalid := loadbalancer.Certificate{
+			CertificateName:   new(faker.New().UUID().V4()),
+			PublicCertificate: new(faker.New().Lorem().Sentence(3)),
+		}
+		deepWildcard := makeTestServerCertificate(t, "", "*.example.com")
+		assert.Equal(t, first, selectListenerCertificate(
+			makeListener("api.eu.example.com"),
+			[]loadbalancer.Certificate{first, invalid, deepWildcard},
+		))
+	})
+}
+
+func TestWildcardCertificateNameMatches(t *testing.T) {
+	assert.True(t, wildcardCertificateNameMatches("*.example.com", "api.example.com"))
+	assert.False(t, wildcardCertificateNameMatches("*.example.com", "example.com"))
+	assert.False(t, wildcardCertificateNameMatches("*.example.com", "api.eu.example.com"))
+	assert.False(t, wildcardCertificateNameMatches("*.example.com", "*.example.com"))
+	assert.False(t, wildcardCertificateNameMatches("api.example.com", "api.example.com"))
+}
+
+func TestParseListenerCertificateChain(t *testing.T) {
+	t.Run("returns single certificate without CA certificate", func(t *testing.T) {
+		leaf := issueTestCertificate("api.example.com", nil)
+
+		chain, err := parseListenerCertificateChain(leaf.certPEM)
+		require.NoError(t, err)
+		assert.Equal(t, string(leaf.certPEM), chain.publicCertificate)
+		assert.Empty(t, chain.caCertificate)
+	})
+
+	t.Run("splits leaf and intermediate certificates", func(t *testing.T) {
+		root := issueTestCertificate("root", nil)
+		intermediate := issueTestCertificate("intermediate", &root)
+		leaf := issueTestCertificate("api.example.com", &intermediate)
+		bundle := string(leaf.certPEM) + "\n" + string(intermediate.certPEM) + string(root.certPEM)
+
+		chain, err := parseListenerCertificateChain([]byte(bundle))
+		require.NoError(t, err)
+		assert.Equal(t, string(leaf.certPEM), chain.publicCertificate)
+		assert.Equal(t, string(intermediate.certPEM)+string(root.certPEM), chain.caCertificate)
+	})
+
+	t.Run("fails without certificates", func(t *testing.T) {
+		_, err := parseListenerCertificateChain([]byte(faker.New().Lorem().Sentence(5)))
+		require.ErrorContains(t, err, "no PEM encoded certificates found")
+	})
+
+	t.Run("fails on non certificate block", func(t *testing.T) {
+		leaf := issueTestCertificate("api.example.com", nil)
+
+		_, err := parseListenerCertificateChain(append(leaf.certPEM, leaf.keyPEM...))
+		require.ErrorContains(t, err, "unexpected EC PRIVATE KEY PEM block at position 2")
+	})
+
+	t.Run("fails on invalid certificate data", func(t *testing.T) {
+		invalid := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte(faker.New().UUID().V4())})
+
+		_, err := parseListenerCertificateChain(invalid)
+		require.ErrorContains(t, err, "failed to parse certificate 1")
+	})
+
+	t.Run("fails on trailing malformed data", func(t *testing.T) {
+		leaf := issueTestCertificate("api.example.com", nil)
+		truncated := issueTestCertificate("intermediate", nil).certPEM[:40]
+
+		_, err := parseListenerCertificateChain(append(leaf.certPEM, truncated...))
+		require.ErrorContains(t, err, "malformed PEM data after certificate 1")
+	})
+
+	t.Run("fails on certificates in wrong order", func(t *testing.T) {
+		intermediate := issueTestCertificate("intermediate", nil)
+		leaf := issueTestCertificate("api.example.com", &intermediate)
+
+		_, err := parseListenerCertificateChain(append(intermediate.certPEM, leaf.certPEM...))
+		require.ErrorContains(t, err, "certificate 1 is not issued by certificate 2")
+	})
+}
+
+func TestValidateListenerCertificate(t *testing.T) {
+	makeSecret := func(certPEM []byte, keyPEM []byte) corev1.Secret {
+		return makeRandomSecret(func(secret *corev1.Secret) {
+			secret.Data[corev1.TLSCertKey] = certPEM
+			secret.Data[corev1.TLSPrivateKeyKey] = keyPEM
+		})
+	}
+
+	t.Run("accepts valid certificate chain and key", func(t *testing.T) {
+		intermediate := issueTestCertificate("intermediate", nil)
+		leaf := issueTestCertificate("api.example.com", &intermediate)
+
+		err := validateListenerCertificate(
+			makeSecret(append(leaf.certPEM, intermediate.certPEM...), leaf.keyPEM),
+			time.Now(),
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("fails on malformed certificate", func(t *testing.T) {
+		leaf := issueTestCertificate("api.example.com", nil)
+
+		err := validateListenerCertificate(
+			makeSecret([]byte(faker.New().Lorem().Sentence(5)), leaf.keyPEM),
+			time.Now(),
+		)
+		require.ErrorContains(t, err, "invalid tls.crt certificate chain")
+	})
+
+	t.Run("fails when key does not match certificate", func(t *testing.T) {
+		leaf := issueTestCertificate("api.example.com", nil)
+		other := issueTestCertificate("api.example.com", nil)
+
+		err := validateListenerCertificate(makeSecret(leaf.certPEM, other.keyPEM), time.Now())
+		require.ErrorContains(t, err, "invalid tls.key private key")
+	})
+
+	t.Run("fails on malformed key", func(t *testing.T) {
+		leaf := issueTestCertificate("api.example.com", nil)
+
+		err := validateListenerCertificate(
+			makeSecret(leaf.certPEM, []byte(faker.New().Lorem().Sentence(5))),
+			time.Now(),
+		)
+		require.ErrorContains(t, err, "invalid tls.key private key")
+	})
+
+	t.Run("fails on expired certificate", func(t *testing.T) {
+		leaf := issueTestCertificate("api.example.com", nil, func(cert *x509.Certificate) {
+			cert.NotBefore = time.Now().Add(-2 * time.Hour)
+			cert.NotAfter = time.Now().Add(-time.Hour)
+		})
+
+		err := validateListenerCertificate(makeSecret(leaf.certPEM, leaf.keyPEM), time.Now())
+		require.ErrorContains(t, err, "certificate has expired at")
+	})
+
+	t.Run("fails on not yet valid certificate", func(t *testing.T) {
+		leaf := issueTestCertificate("api.example.com", nil, func(cert *x509.Certificate) {
+			cert.NotBefore = time.Now().Add(time.Hour)
+			cert.NotAfter = time.Now().Add(2 * time.Hour)
+		})
+
+		err := validateListenerCertificate(makeSecret(leaf.certPEM, leaf.keyPEM), time.Now())
+		require.ErrorContains(t, err, "certificate is not valid before")
+	})
+}
+
+func TestListenerCertificatesCoverHostname(t *testing.T) {
+	makeListener := func(hostname string) gatewayv1.Listener {
+		listener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+		if hostname != "" {
+			listener.Hostname = new(gatewayv1.Hostname(hostname))
+		}
+		return listener
+	}
+
+	t.Run("accepts any certificate without listener hostname", func(t *testing.T) {
+		certificate := makeTestServerCertificate(t, "", "api.example.com")
+		assert.True(t, listenerCertificatesCoverHostname(makeListener(""), []loadbalancer.Certificate{certificate}))
+	})
+
+	t.Run("accepts if any certificate covers hostname", func(t *testing.T) {
+		other := makeTestServerCertificate(t, "", "other.example.com")
+		wildcard := makeTestServerCertificate(t, "", "*.example.com")
+		assert.True(t, listenerCertificatesCoverHostname(
+			makeListener("API.example.com"),
+			[]loadbalancer.Certificate{other, wildcard},
+		))
+	})
+
+	t.Run("rejects if no certificate covers hostname", func(t *testing.T) {
+		other := makeTestServerCertificate(t, "", "other.example.com")
+		nested := makeTestServerCertificate(t, "", "*.example.com")
+		assert.False(t, listenerCertificatesCoverHostname(
+			makeListener("api.eu.example.com"),
+			[]loadbalancer.Certificate{other, nested},
+		))
+	})
+}
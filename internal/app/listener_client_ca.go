@@ -0,0 +1,270 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	"go.uber.org/dig"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	listenerClientCABundleNamePrefix = "oke-lca-"
+	listenerClientCAManagedByValue   = "listener-client-ca"
+	listenerClientCAGatewayTag       = "oke-gateway-api-gateway"
+	defaultListenerClientVerifyDepth = 1
+)
+
+type reconcileListenersClientCAParams struct {
+	gateway       *gatewayv1.Gateway
+	compartmentID string
+
+	// Free-form tags of the GatewayConfig added to the created CA bundles
+	freeformTags map[string]string
+
+	// PEM encoded client CA certificates by listener name
+	clientCAs map[string]string
+}
+
+type removeUnusedListenersClientCAParams struct {
+	gateway       *gatewayv1.Gateway
+	compartmentID string
+
+	// CA bundles attached to the listeners. Other CA bundles of the gateway are removed.
+	caBundleIDs []string
+}
+
+// listenerClientCAModel manages OCI Certificates Service CA bundles used to verify
+// client certificates on the gateway listeners.
+type listenerClientCAModel interface {
+	// reconcileListenersClientCA creates or updates CA bundles with client CAs of the listeners.
+	// Returns CA bundle OCIDs by listener name.
+	reconcileListenersClientCA(
+		ctx context.Context,
+		params reconcileListenersClientCAParams,
+	) (map[string]string, error)
+
+	// removeUnusedListenersClientCA removes CA bundles of the gateway that are no longer used.
+	// It should be called after the CA bundles are detached from listeners.
+	removeUnusedListenersClientCA(
+		ctx context.Context,
+		params removeUnusedListenersClientCAParams,
+	) error
+}
+
+type listenerClientCAModelImpl struct {
+	logger      *slog.Logger
+	certsClient ociCertificatesManagementClient
+}
+
+func (m *listenerClientCAModelImpl) reconcileListenersClientCA(
+	ctx context.Context,
+	params reconcileListenersClientCAParams,
+) (map[string]string, error) {
+	if len(params.clientCAs) == 0 {
+		return map[string]string{}, nil
+	}
+
+	listResp, err := m.certsClient.ListCaBundles(ctx, certificatesmanagement.ListCaBundlesRequest{
+		CompartmentId: &params.compartmentID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list OCI CA bundles: %w", err)
+	}
+	bundlesByName := make(map[string]certificatesmanagement.CaBundleSummary, len(listResp.Items))
+	for _, bundle := range listResp.Items {
+		if bundle.LifecycleState == certificatesmanagement.CaBundleLifecycleStateDeleted {
+			continue
+		}
+		bundlesByName[lo.FromPtr(bundle.Name)] = bundle
+	}
+
+	listenerNames := lo.Keys(params.clientCAs)
+	slices.Sort(listenerNames)
+
+	result := make(map[string]string, len(listenerNames))
+	for _, listenerName := range listenerNames {
+		caPEM := params.clientCAs[listenerName]
+		name := listenerClientCABundleName(params.gateway, listenerName)
+		tags := mergeFreeformTags(params.freeformTags, listenerClientCABundleTags(params.gateway, sha256Hex(caPEM)))
+
+		existing, exists := bundlesByName[name]
+		if !exists {
+			var bundleID string
+			if bundleID, err = m.createClientCABundle(ctx, params.compartmentID, name, caPEM, tags); err != nil {
+				return nil, err
+			}
+			result[listenerName] = bundleID
+			continue
+		}
+
+		if !isOwnedListenerClientCABundle(existing.FreeformTags, params.gateway) {
+			return nil, fmt.Errorf("OCI CA bundle %s already exists and is not owned by Gateway %s/%s",
+				name, params.gateway.Namespace, params.gateway.Name)
+		}
+		if existing.LifecycleState != "" && existing.LifecycleState != certificatesmanagement.CaBundleLifecycleStateActive {
+			return nil, fmt.Errorf("OCI CA bundle %s is %s and is not ready for client verification",
+				name, existing.LifecycleState)
+		}
+		if existing.FreeformTags[backendTLSCAHashTag] != tags[backendTLSCAHashTag] {
+			m.logger.InfoContext(ctx, "Updating OCI CA bundle for listener client verification",
+				slog.String("listenerName", listenerName),
+				slog.String("caBundleName", name),
+			)
+			if _, err = m.certsClient.UpdateCaBundle(ctx, certificatesmanagement.UpdateCaBundleRequest{
+				CaBundleId: existing.Id,
+				UpdateCaBundleDetails: certificatesmanagement.UpdateCaBundleDetails{
+					CaBundlePem:  &caPEM,
+					FreeformTags: tags,
+				},
+			}); err != nil {
+				return nil, fmt.Errorf("failed to update OCI CA bundle %s: %w", name, err)
+			}
+		}
+		result[listenerName] = lo.FromPtr(existing.Id)
+	}
+
+	return result, nil
+}
+
+func (m *listenerClientCAModelImpl) createClientCABundle(
+	ctx context.Context,
+	compartmentID string,
+	name string,
+	caPEM string,
+	tags map[string]string,
+) (string, error) {
+	m.logger.InfoContext(ctx, "Creating OCI CA bundle for listener client verification",
+		slog.String("caBundleName", name),
+		slog.String("compartmentId", compartmentID),
+	)
+	createResp, err := m.certsClient.CreateCaBundle(ctx, certificatesmanagement.CreateCaBundleRequest{
+		CreateCaBundleDetails: certificatesmanagement.CreateCaBundleDetails{
+			Name:          &name,
+			CompartmentId: &compartmentID,
+			CaBundlePem:   &caPEM,
+			FreeformTags:  tags,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create OCI CA bundle %s: %w", name, err)
+	}
+	return lo.FromPtr(createResp.CaBundle.Id), nil
+}
+
+func (m *listenerClientCAModelImpl) removeUnusedListenersClientCA(
+	ctx context.Context,
+	params removeUnusedListenersClientCAParams,
+) error {
+	listResp, err := m.certsClient.ListCaBundles(ctx, certificatesmanagement.ListCaBundlesRequest{
+		CompartmentId: &params.compartmentID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list OCI CA bundles: %w", err)
+	}
+
+	for _, bundle := range listResp.Items {
+		if !isOwnedListenerClientCABundle(bundle.FreeformTags, params.gateway) ||
+			slices.Contains(params.caBundleIDs, lo.FromPtr(bundle.Id)) ||
+			bundle.LifecycleState == certificatesmanagement.CaBundleLifecycleStateDeleted ||
+			bundle.LifecycleState == certificatesmanagement.CaBundleLifecycleStateDeleting {
+			continue
+		}
+
+		m.logger.InfoContext(ctx, "Deleting unused OCI CA bundle for listener client verification",
+			slog.String("caBundleName", lo.FromPtr(bundle.Name)),
+		)
+		if _, err = m.certsClient.DeleteCaBundle(ctx, certificatesmanagement.DeleteCaBundleRequest{
+			CaBundleId: bundle.Id,
+		}); err != nil {
+			if isBackendTLSCABundleAlreadyDeleted(err) {
+				continue
+			}
+			return fmt.Errorf("failed to delete OCI CA bundle %s: %w", lo.FromPtr(bundle.Name), err)
+		}
+	}
+	return nil
+}
+
+func listenerClientCABundleName(gateway *gatewayv1.Gateway, listenerName string) string {
+	hashInput := gateway.Namespace + "/" + gateway.Name + "/" + listenerName
+	return listenerClientCABundleNamePrefix + sha256Hex(hashInput)[:24]
+}
+
+func listenerClientCABundleTags(gateway *gatewayv1.Gateway, caHash string) map[string]string {
+	return map[string]string{
+		backendTLSManagedByTag:     listenerClientCAManagedByValue,
+		listenerClientCAGatewayTag: gateway.Namespace + "/" + gateway.Name,
+		backendTLSCAHashTag:        caHash,
+	}
+}
+
+func isOwnedListenerClientCABundle(tags map[string]string, gateway *gatewayv1.Gateway) bool {
+	return tags[backendTLSManagedByTag] == listenerClientCAManagedByValue &&
+		tags[listenerClientCAGatewayTag] == gateway.Namespace+"/"+gateway.Name
+}
+
+// listenerClientVerifyDepth returns the client certificate chain verification depth
+// configured with the listener TLS options.
+func listenerClientVerifyDepth(tlsConfig *gatewayv1.ListenerTLSConfig) (int, error) {
+	value := strings.TrimSpace(string(tlsConfig.Options[ListenerTLSOptionClientVerifyDepth]))
+	if value == "" {
+		return defaultListenerClientVerifyDepth, nil
+	}
+	depth, err := strconv.Atoi(value)
+	if err != nil || depth < 1 {
+		return 0, fmt.Errorf("invalid verify depth %q, must be a positive integer", value)
+	}
+	return depth, nil
+}
+
+// applyListenerClientVerification makes the listener require client certificates
+// issued by the CA bundle. No-op if the listener has no client CA.
+func applyListenerClientVerification(
+	sslConfig *loadbalancer.SslConfigurationDetails,
+	tlsConfig *gatewayv1.ListenerTLSConfig,
+	caBundleID string,
+) {
+	if sslConfig == nil || tlsConfig == nil || caBundleID == "" {
+		return
+	}
+	depth, err := listenerClientVerifyDepth(tlsConfig)
+	if err != nil {
+		depth = defaultListenerClientVerifyDepth
+	}
+	sslConfig.TrustedCertificateAuthorityIds = []string{caBundleID}
+	sslConfig.VerifyPeerCertificate = new(true)
+	sslConfig.VerifyDepth = new(depth)
+}
+
+// listenerClientCAAnnotationValue returns the value of the GatewayListenerClientCAAnnotation.
+// It holds the hash of the client CA of each listener so CA changes trigger reprogramming.
+func listenerClientCAAnnotationValue(clientCAs map[string]string) string {
+	values := make([]string, 0, len(clientCAs))
+	for listenerName, caPEM := range clientCAs {
+		values = append(values, listenerName+"="+sha256Hex(caPEM)[:16])
+	}
+	slices.Sort(values)
+	return strings.Join(values, ",")
+}
+
+type listenerClientCAModelDeps struct {
+	dig.In
+
+	RootLogger                *slog.Logger
+	OciCertificatesMgmtClient ociCertificatesManagementClient
+}
+
+func newListenerClientCAModel(deps listenerClientCAModelDeps) *listenerClientCAModelImpl {
+	return &listenerClientCAModelImpl{
+		logger:      deps.RootLogger.WithGroup("listener-client-ca-model"),
+		certsClient: deps.OciCertificatesMgmtClient,
+	}
+}
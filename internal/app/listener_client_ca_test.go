@@ -0,0 +1,293 @@
+package app
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+)
+
+func TestListenerClientCAModel(t *testing.T) {
+	makeModel := func(certsClient *stubCertificatesManagementClient) *listenerClientCAModelImpl {
+		return newListenerClientCAModel(listenerClientCAModelDeps{
+			RootLogger:                diag.RootTestLogger(),
+			OciCertificatesMgmtClient: certsClient,
+		})
+	}
+
+	t.Run("reconcileListenersClientCA", func(t *testing.T) {
+		t.Run("does nothing without client CAs", func(t *testing.T) {
+			certsClient := newStubCertificatesManagementClient()
+			certsClient.listErr = errors.New(faker.New().Lorem().Sentence(3))
+			model := makeModel(certsClient)
+
+			ids, err := model.reconcileListenersClientCA(t.Context(), reconcileListenersClientCAParams{
+				gateway: newRandomGateway(),
+			})
+			require.NoError(t, err)
+			assert.Empty(t, ids)
+		})
+
+		t.Run("creates missing CA bundles", func(t *testing.T) {
+			gateway := newRandomGateway()
+			compartmentID := faker.New().UUID().V4()
+			caPEM := testCAPEM(t)
+			certsClient := newStubCertificatesManagementClient()
+			model := makeModel(certsClient)
+
+			ids, err := model.reconcileListenersClientCA(t.Context(), reconcileListenersClientCAParams{
+				gateway:       gateway,
+				compartmentID: compartmentID,
+				clientCAs:     map[string]string{"https-a": caPEM, "https-b": caPEM},
+			})
+			require.NoError(t, err)
+			require.Len(t, certsClient.createCalls, 2)
+			assert.Len(t, ids, 2)
+
+			firstCall := certsClient.createCalls[0].CreateCaBundleDetails
+			assert.Equal(t, listenerClientCABundleName(gateway, "https-a"), lo.FromPtr(firstCall.Name))
+			assert.Equal(t, compartmentID, lo.FromPtr(firstCall.CompartmentId))
+			assert.Equal(t, caPEM, lo.FromPtr(firstCall.CaBundlePem))
+			assert.Equal(t, listenerClientCABundleTags(gateway, sha256Hex(caPEM)), firstCall.FreeformTags)
+
+			wantIDA := lo.FromPtr(certsClient.bundles[listenerClientCABundleName(gateway, "https-a")].Id)
+			wantIDB := lo.FromPtr(certsClient.bundles[listenerClientCABundleName(gateway, "https-b")].Id)
+			assert.Equal(t, map[string]string{"https-a": wantIDA, "https-b": wantIDB}, ids)
+		})
+
+		t.Run("reuses owned CA bundle when hash matches", func(t *testing.T) {
+			gateway := newRandomGateway()
+			caPEM := testCAPEM(t)
+			name := listenerClientCABundleName(gateway, "https")
+			bundleID := faker.New().UUID().V4()
+			certsClient := newStubCertificatesManagementClient()
+			certsClient.bundles[name] = certificatesmanagement.CaBundleSummary{
+				Id:             &bundleID,
+				Name:           &name,
+				LifecycleState: certificatesmanagement.CaBundleLifecycleStateActive,
+				FreeformTags:   listenerClientCABundleTags(gateway, sha256Hex(caPEM)),
+			}
+			model := makeModel(certsClient)
+
+			ids, err := model.reconcileListenersClientCA(t.Context(), reconcileListenersClientCAParams{
+				gateway:   gateway,
+				clientCAs: map[string]string{"https": caPEM},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"https": bundleID}, ids)
+			assert.Empty(t, certsClient.createCalls)
+			assert.Empty(t, certsClient.updateCalls)
+		})
+
+		t.Run("updates owned CA bundle when PEM changes", func(t *testing.T) {
+			gateway := newRandomGateway()
+			caPEM := testCAPEM(t)
+			name := listenerClientCABundleName(gateway, "https")
+			bundleID := faker.New().UUID().V4()
+			certsClient := newStubCertificatesManagementClient()
+			certsClient.bundles[name] = certificatesmanagement.CaBundleSummary{
+				Id:             &bundleID,
+				Name:           &name,
+				LifecycleState: certificatesmanagement.CaBundleLifecycleStateActive,
+				FreeformTags:   listenerClientCABundleTags(gateway, sha256Hex(testCAPEM(t))),
+			}
+			model := makeModel(certsClient)
+
+			ids, err := model.reconcileListenersClientCA(t.Context(), reconcileListenersClientCAParams{
+				gateway:   gateway,
+				clientCAs: map[string]string{"https": caPEM},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"https": bundleID}, ids)
+			require.Len(t, certsClient.updateCalls, 1)
+			assert.Equal(t, bundleID, lo.FromPtr(certsClient.updateCalls[0].CaBundleId))
+			assert.Equal(t, caPEM, lo.FromPtr(certsClient.updateCalls[0].UpdateCaBundleDetails.CaBundlePem))
+		})
+
+		t.Run("rejects CA bundle not owned by the gateway", func(t *testing.T) {
+			gateway := newRandomGateway()
+			name := listenerClientCABundleName(gateway, "https")
+			certsClient := newStubCertificatesManagementClient()
+			certsClient.bundles[name] = certificatesmanagement.CaBundleSummary{
+				Id:             new(faker.New().UUID().V4()),
+				Name:           &name,
+				LifecycleState: certificatesmanagement.CaBundleLifecycleStateActive,
+			}
+			model := makeModel(certsClient)
+
+			_, err := model.reconcileListenersClientCA(t.Context(), reconcileListenersClientCAParams{
+				gateway:   gateway,
+				clientCAs: map[string]string{"https": testCAPEM(t)},
+			})
+			require.ErrorContains(t, err, "is not owned by Gateway")
+			assert.Empty(t, certsClient.updateCalls)
+		})
+
+		t.Run("rejects CA bundle that is not active", func(t *testing.T) {
+			gateway := newRandomGateway()
+			caPEM := testCAPEM(t)
+			name := listenerClientCABundleName(gateway, "https")
+			certsClient := newStubCertificatesManagementClient()
+			certsClient.bundles[name] = certificatesmanagement.CaBundleSummary{
+				Id:             new(faker.New().UUID().V4()),
+				Name:           &name,
+				LifecycleState: certificatesmanagement.CaBundleLifecycleStateCreating,
+				FreeformTags:   listenerClientCABundleTags(gateway, sha256Hex(caPEM)),
+			}
+			model := makeModel(certsClient)
+
+			_, err := model.reconcileListenersClientCA(t.Context(), reconcileListenersClientCAParams{
+				gateway:   gateway,
+				clientCAs: map[string]string{"https": caPEM},
+			})
+			require.ErrorContains(t, err, "is not ready for client verification")
+		})
+
+		t.Run("returns list error", func(t *testing.T) {
+			wantErr := errors.New(faker.New().Lorem().Sentence(3))
+			certsClient := newStubCertificatesManagementClient()
+			certsClient.listErr = wantErr
+			model := makeModel(certsClient)
+
+			_, err := model.reconcileListenersClientCA(t.Context(), reconcileListenersClientCAParams{
+				gateway:   newRandomGateway(),
+				clientCAs: map[string]string{"https": testCAPEM(t)},
+			})
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("removeUnusedListenersClientCA", func(t *testing.T) {
+		addBundle := func(
+			certsClient *stubCertificatesManagementClient,
+			name string,
+			tags map[string]string,
+		) string {
+			bundleID := faker.New().UUID().V4()
+			certsClient.bundles[name] = certificatesmanagement.CaBundleSummary{
+				Id:             &bundleID,
+				Name:           &name,
+				LifecycleState: certificatesmanagement.CaBundleLifecycleStateActive,
+				FreeformTags:   tags,
+			}
+			return bundleID
+		}
+
+		t.Run("deletes owned CA bundles that are not used", func(t *testing.T) {
+			gateway := newRandomGateway()
+			certsClient := newStubCertificatesManagementClient()
+			usedID := addBundle(certsClient, "used", listenerClientCABundleTags(gateway, "h1"))
+			unusedID := addBundle(certsClient, "unused", listenerClientCABundleTags(gateway, "h2"))
+			addBundle(certsClient, "other-gateway", listenerClientCABundleTags(newRandomGateway(), "h3"))
+			addBundle(certsClient, "foreign", map[string]string{})
+			model := makeModel(certsClient)
+
+			err := model.removeUnusedListenersClientCA(t.Context(), removeUnusedListenersClientCAParams{
+				gateway:     gateway,
+				caBundleIDs: []string{usedID},
+			})
+			require.NoError(t, err)
+			require.Len(t, certsClient.deleteCalls, 1)
+			assert.Equal(t, unusedID, lo.FromPtr(certsClient.deleteCalls[0].CaBundleId))
+		})
+
+		t.Run("ignores already deleted CA bundles", func(t *testing.T) {
+			gateway := newRandomGateway()
+			certsClient := newStubCertificatesManagementClient()
+			addBundle(certsClient, "unused", listenerClientCABundleTags(gateway, "h1"))
+			certsClient.deleteErr = ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(http.StatusNotFound))
+			model := makeModel(certsClient)
+
+			err := model.removeUnusedListenersClientCA(t.Context(), removeUnusedListenersClientCAParams{
+				gateway: gateway,
+			})
+			require.NoError(t, err)
+			assert.Len(t, certsClient.deleteCalls, 1)
+		})
+
+		t.Run("returns delete error", func(t *testing.T) {
+			gateway := newRandomGateway()
+			wantErr := errors.New(faker.New().Lorem().Sentence(3))
+			certsClient := newStubCertificatesManagementClient()
+			addBundle(certsClient, "unused", listenerClientCABundleTags(gateway, "h1"))
+			certsClient.deleteErr = wantErr
+			model := makeModel(certsClient)
+
+			err := model.removeUnusedListenersClientCA(t.Context(), removeUnusedListenersClientCAParams{
+				gateway: gateway,
+			})
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+}
+
+func TestListenerClientVerifyDepth(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{value: "", want: defaultListenerClientVerifyDepth},
+		{value: "3", want: 3},
+		{value: " 2 ", want: 2},
+		{value: "0", wantErr: true},
+		{value: "-1", wantErr: true},
+		{value: "deep", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			depth, err := listenerClientVerifyDepth(&gatewayv1.ListenerTLSConfig{
+				Options: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+					ListenerTLSOptionClientVerifyDepth: gatewayv1.AnnotationValue(tt.value),
+				},
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, depth)
+		})
+	}
+}
+
+func TestApplyListenerClientVerification(t *testing.T) {
+	t.Run("sets trusted CA and verification", func(t *testing.T) {
+		sslConfig := &loadbalancer.SslConfigurationDetails{}
+		bundleID := faker.New().UUID().V4()
+		applyListenerClientVerification(sslConfig, &gatewayv1.ListenerTLSConfig{
+			Options: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+				ListenerTLSOptionClientVerifyDepth: "2",
+			},
+		}, bundleID)
+		assert.Equal(t, []string{bundleID}, sslConfig.TrustedCertificateAuthorityIds)
+		assert.True(t, lo.FromPtr(sslConfig.VerifyPeerCertificate))
+		assert.Equal(t, 2, lo.FromPtr(sslConfig.VerifyDepth))
+	})
+
+	t.Run("does nothing without CA bundle", func(t *testing.T) {
+		sslConfig := &loadbalancer.SslConfigurationDetails{}
+		applyListenerClientVerification(sslConfig, &gatewayv1.ListenerTLSConfig{}, "")
+		assert.Equal(t, &loadbalancer.SslConfigurationDetails{}, sslConfig)
+	})
+}
+
+func TestListenerClientCAAnnotationValue(t *testing.T) {
+	assert.Empty(t, listenerClientCAAnnotationValue(nil))
+
+	caPEM := testCAPEM(t)
+	assert.Equal(t,
+		"https-a="+sha256Hex(caPEM)[:16]+",https-b="+sha256Hex(caPEM)[:16],
+		listenerClientCAAnnotationValue(map[string]string{"https-b": caPEM, "https-a": caPEM}),
+	)
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// listenerInUseRequeueInterval is how often a gateway with removed listeners still
+// in use is rechecked. Route changes do not trigger gateway reconciliation, so the
+// listeners would not be removed after the routes are detached otherwise.
+const listenerInUseRequeueInterval = time.Minute
+
+// errListenerInUse is the cause of the status error reported when listeners removed
+// from the gateway spec still have routing policy rules of existing routes.
+var errListenerInUse = errors.New("removed listeners still have rules of existing routes")
+
+// listenerInUseRequeue makes sure the removed listeners are rechecked soon.
+func listenerInUseRequeue(result reconcile.Result) reconcile.Result {
+	if result.RequeueAfter == 0 || result.RequeueAfter > listenerInUseRequeueInterval {
+		result.RequeueAfter = listenerInUseRequeueInterval
+	}
+	return result
+}
+
+// listenerRouteRuleOwners returns existing routes owning the rules of the listener routing
+// policy. The catch-all rule is programmed by the gateway and does not block the removal.
+func listenerRouteRuleOwners(
+	params removeMissingListenersParams,
+	listenerName string,
+	listener loadbalancer.Listener,
+) []string {
+	policy, ok := params.knownRoutingPolicies[lo.FromPtr(listener.RoutingPolicyName)]
+	if !ok {
+		return nil
+	}
+
+	sectionName := params.naming.listenerSectionName(params.namePrefix, listenerName)
+	var owners []string
+	for _, rule := range policy.Rules {
+		ruleName := lo.FromPtr(rule.Name)
+		if ruleName == defaultCatchAllRuleName {
+			continue
+		}
+		owners = append(owners, params.routeRuleOwners[string(sectionName)+"/"+ruleName]...)
+	}
+	slices.Sort(owners)
+	return slices.Compact(owners)
+}
+
+// newListenerInUseError reports listeners kept since they still route traffic of existing routes.
+// Blockers are listener names mapped to the routes owning their rules.
+func newListenerInUseError(blockers map[string][]string) *resourceStatusError {
+	listenerNames := lo.Keys(blockers)
+	slices.Sort(listenerNames)
+	details := make([]string, len(listenerNames))
+	for i, listenerName := range listenerNames {
+		details[i] = fmt.Sprintf("%s (%s)", listenerName, strings.Join(blockers[listenerName], ", "))
+	}
+
+	return &resourceStatusError{
+		conditionType: string(gatewayv1.GatewayConditionProgrammed),
+		reason:        GatewayReasonListenerInUse,
+		message: fmt.Sprintf(
+			"Removed listeners still have routing rules of existing routes: %s. "+
+				"Detach the routes from the listeners to complete the removal",
+			strings.Join(details, "; "),
+		),
+		cause: errListenerInUse,
+	}
+}
@@ -0,0 +1,142 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+// OCI applies 8KB header size limit if not configured explicitly. Rule set items
+// are always populated with explicit values so they compare equal to the programmed ones.
+const defaultHTTPLargeHeaderSizeInKB = 8
+
+// listenerPolicyRuleSetItems maps the OkeListenerPolicy to the items of the OCI listener rule set.
+// Returns nil if there is no policy or the policy has no rules.
+func listenerPolicyRuleSetItems(policy *types.OkeListenerPolicy) []loadbalancer.Rule {
+	if policy == nil {
+		return nil
+	}
+
+	var items []loadbalancer.Rule
+	if header := policy.Spec.HTTPHeader; header != nil {
+		sizeInKB := int(header.MaxSizeInKB)
+		if sizeInKB == 0 {
+			sizeInKB = defaultHTTPLargeHeaderSizeInKB
+		}
+		allowInvalidCharacters := header.AllowInvalidCharacters != nil && *header.AllowInvalidCharacters
+		items = append(items, loadbalancer.HttpHeaderRule{
+			HttpLargeHeaderSizeInKB:     new(sizeInKB),
+			AreInvalidCharactersAllowed: new(allowInvalidCharacters),
+		})
+	}
+
+	if allowedMethods := policy.Spec.AllowedMethods; allowedMethods != nil {
+		statusCode := int(allowedMethods.StatusCode)
+		if statusCode == 0 {
+			statusCode = http.StatusMethodNotAllowed
+		}
+		methods := make([]string, len(allowedMethods.Methods))
+		for i, method := range allowedMethods.Methods {
+			methods[i] = strings.ToUpper(strings.TrimSpace(method))
+		}
+		items = append(items, loadbalancer.ControlAccessUsingHttpMethodsRule{
+			AllowedMethods: methods,
+			StatusCode:     new(statusCode),
+		})
+	}
+
+	if maxConnections := policy.Spec.MaxConnections; maxConnections != nil {
+		var ipMaxConnections []loadbalancer.IpMaxConnections
+		for _, perIP := range maxConnections.PerIP {
+			ipMaxConnections = append(ipMaxConnections, loadbalancer.IpMaxConnections{
+				IpAddresses:    perIP.IPAddresses,
+				MaxConnections: new(int(perIP.MaxConnections)),
+			})
+		}
+		items = append(items, loadbalancer.IpBasedMaxConnectionsRule{
+			DefaultMaxConnections: new(int(maxConnections.Default)),
+			IpMaxConnections:      ipMaxConnections,
+		})
+	}
+
+	return items
+}
+
+// ruleSetItemsEqual compares rule set items regardless of their order, the order of the
+// methods and addresses in the rules and of nil and empty lists, which OCI does not preserve.
+func ruleSetItemsEqual(left, right []loadbalancer.Rule) bool {
+	return slices.Equal(ruleSetItemKeys(left), ruleSetItemKeys(right))
+}
+
+func ruleSetItemKeys(items []loadbalancer.Rule) []string {
+	keys := make([]string, len(items))
+	for i, item := range items {
+		encoded, err := json.Marshal(normalizeRuleSetItem(item))
+		if err != nil {
+			encoded = fmt.Appendf(nil, "%#v", item)
+		}
+		keys[i] = string(encoded)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func normalizeRuleSetItem(item loadbalancer.Rule) loadbalancer.Rule {
+	switch rule := item.(type) {
+	case loadbalancer.ControlAccessUsingHttpMethodsRule:
+		rule.AllowedMethods = sortedOrNil(rule.AllowedMethods)
+		return rule
+	case loadbalancer.IpBasedMaxConnectionsRule:
+		var ipMaxConnections []loadbalancer.IpMaxConnections
+		for _, perIP := range rule.IpMaxConnections {
+			perIP.IpAddresses = sortedOrNil(perIP.IpAddresses)
+			ipMaxConnections = append(ipMaxConnections, perIP)
+		}
+		slices.SortFunc(ipMaxConnections, func(a, b loadbalancer.IpMaxConnections) int {
+			return strings.Compare(strings.Join(a.IpAddresses, ","), strings.Join(b.IpAddresses, ","))
+		})
+		rule.IpMaxConnections = ipMaxConnections
+		return rule
+	case loadbalancer.AllowRule:
+		if len(rule.Conditions) == 0 {
+			rule.Conditions = nil
+		}
+		return rule
+	default:
+		return item
+	}
+}
+
+func sortedOrNil(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	return slices.Sorted(slices.Values(values))
+}
+
+// ociListenerRuleSetName returns the name of the OCI rule set holding listener policy rules of the gateway.
+// Rule set names follow the same naming rules as routing policy names.
+func ociListenerRuleSetName(gateway *gatewayv1.Gateway, namePrefix string) string {
+	return ociapi.ConstructOCIResourceName("rs_"+namePrefix+gateway.Name, ociapi.OCIResourceNameConfig{
+		MaxLength:           maxListenerPolicyNameLength,
+		InvalidCharsPattern: invalidCharsForPolicyNamePattern,
+	})
+}
+
+// listenerPolicyAnnotationValue returns the value of the GatewayListenerPolicyAnnotation.
+// Policy changes increment the generation and trigger reprogramming of the gateway.
+func listenerPolicyAnnotationValue(policy *types.OkeListenerPolicy) string {
+	if policy == nil {
+		return ""
+	}
+	return policy.Name + "/" + strconv.FormatInt(policy.Generation, 10)
+}
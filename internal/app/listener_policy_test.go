@@ -0,0 +1,155 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestListenerPolicyRuleSetItems(t *testing.T) {
+	t.Run("nil without policy or rules", func(t *testing.T) {
+		assert.Nil(t, listenerPolicyRuleSetItems(nil))
+		assert.Nil(t, listenerPolicyRuleSetItems(&types.OkeListenerPolicy{}))
+	})
+
+	t.Run("maps all policy rules", func(t *testing.T) {
+		policy := &types.OkeListenerPolicy{
+			Spec: types.OkeListenerPolicySpec{
+				HTTPHeader: &types.OkeListenerPolicyHTTPHeader{
+					MaxSizeInKB:            32,
+					AllowInvalidCharacters: new(true),
+				},
+				AllowedMethods: &types.OkeListenerPolicyAllowedMethods{
+					Methods:    []string{"get", " POST "},
+					StatusCode: http.StatusForbidden,
+				},
+				MaxConnections: &types.OkeListenerPolicyMaxConnections{
+					Default: 100,
+					PerIP: []types.OkeListenerPolicyIPMaxConnections{
+						{IPAddresses: []string{"10.0.0.0/8"}, MaxConnections: 1000},
+					},
+				},
+			},
+		}
+
+		assert.Equal(t, []loadbalancer.Rule{
+			loadbalancer.HttpHeaderRule{
+				HttpLargeHeaderSizeInKB:     new(32),
+				AreInvalidCharactersAllowed: new(true),
+			},
+			loadbalancer.ControlAccessUsingHttpMethodsRule{
+				AllowedMethods: []string{"GET", "POST"},
+				StatusCode:     new(http.StatusForbidden),
+			},
+			loadbalancer.IpBasedMaxConnectionsRule{
+				DefaultMaxConnections: new(100),
+				IpMaxConnections: []loadbalancer.IpMaxConnections{
+					{IpAddresses: []string{"10.0.0.0/8"}, MaxConnections: new(1000)},
+				},
+			},
+		}, listenerPolicyRuleSetItems(policy))
+	})
+
+	t.Run("applies defaults", func(t *testing.T) {
+		policy := &types.OkeListenerPolicy{
+			Spec: types.OkeListenerPolicySpec{
+				HTTPHeader:     &types.OkeListenerPolicyHTTPHeader{},
+				AllowedMethods: &types.OkeListenerPolicyAllowedMethods{Methods: []string{"GET"}},
+				MaxConnections: &types.OkeListenerPolicyMaxConnections{Default: 10},
+			},
+		}
+
+		assert.Equal(t, []loadbalancer.Rule{
+			loadbalancer.HttpHeaderRule{
+				HttpLargeHeaderSizeInKB:     new(defaultHTTPLargeHeaderSizeInKB),
+				AreInvalidCharactersAllowed: new(false),
+			},
+			loadbalancer.ControlAccessUsingHttpMethodsRule{
+				AllowedMethods: []string{"GET"},
+				StatusCode:     new(http.StatusMethodNotAllowed),
+			},
+			loadbalancer.IpBasedMaxConnectionsRule{
+				DefaultMaxConnections: new(10),
+			},
+		}, listenerPolicyRuleSetItems(policy))
+	})
+}
+
+func TestOCIListenerRuleSetName(t *testing.T) {
+	t.Run("derived from gateway name", func(t *testing.T) {
+		gateway := newRandomGateway()
+		gateway.Name = "edge-gw"
+
+		assert.Equal(t, "rs_edge_gw", ociListenerRuleSetName(gateway, ""))
+		assert.Equal(t, "rs_gw_0a1b2c3d_edge_gw", ociListenerRuleSetName(gateway, "gw_0a1b2c3d_"))
+	})
+
+	t.Run("valid OCI name for long gateway names", func(t *testing.T) {
+		fake := faker.New()
+		gateway := newRandomGateway()
+		gateway.Name = fake.Lorem().Sentence(20)
+
+		name := ociListenerRuleSetName(gateway, "gw_0a1b2c3d_")
+
+		assert.True(t, isValidOCIRoutingPolicyName(name), name)
+		assert.NotEqual(t, name, ociListenerRuleSetName(gateway, ""))
+	})
+}
+
+func TestListenerPolicyAnnotationValue(t *testing.T) {
+	assert.Empty(t, listenerPolicyAnnotationValue(nil))
+	assert.Equal(t, "limits/3", listenerPolicyAnnotationValue(&types.OkeListenerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "limits", Generation: 3},
+	}))
+}
+
+func TestRuleSetItemsEqual(t *testing.T) {
+	t.Run("ignores order of items, methods and addresses", func(t *testing.T) {
+		left := []loadbalancer.Rule{
+			loadbalancer.ControlAccessUsingHttpMethodsRule{AllowedMethods: []string{"GET", "POST"}},
+			loadbalancer.IpBasedMaxConnectionsRule{
+				DefaultMaxConnections: new(100),
+				IpMaxConnections: []loadbalancer.IpMaxConnections{
+					{IpAddresses: []string{"10.0.0.2", "10.0.0.1"}, MaxConnections: new(10)},
+					{IpAddresses: []string{"10.0.1.1"}, MaxConnections: new(20)},
+				},
+			},
+		}
+		right := []loadbalancer.Rule{
+			loadbalancer.IpBasedMaxConnectionsRule{
+				DefaultMaxConnections: new(100),
+				IpMaxConnections: []loadbalancer.IpMaxConnections{
+					{IpAddresses: []string{"10.0.1.1"}, MaxConnections: new(20)},
+					{IpAddresses: []string{"10.0.0.1", "10.0.0.2"}, MaxConnections: new(10)},
+				},
+			},
+			loadbalancer.ControlAccessUsingHttpMethodsRule{AllowedMethods: []string{"POST", "GET"}},
+		}
+
+		assert.True(t, ruleSetItemsEqual(left, right))
+	})
+
+	t.Run("treats nil and empty lists as equal", func(t *testing.T) {
+		assert.True(t, ruleSetItemsEqual(
+			[]loadbalancer.Rule{loadbalancer.IpBasedMaxConnectionsRule{DefaultMaxConnections: new(5)}},
+			[]loadbalancer.Rule{loadbalancer.IpBasedMaxConnectionsRule{
+				DefaultMaxConnections: new(5),
+				IpMaxConnections:      []loadbalancer.IpMaxConnections{},
+			}},
+		))
+		assert.True(t, ruleSetItemsEqual(nil, []loadbalancer.Rule{}))
+	})
+
+	t.Run("detects changed items", func(t *testing.T) {
+		assert.False(t, ruleSetItemsEqual(
+			[]loadbalancer.Rule{loadbalancer.ControlAccessUsingHttpMethodsRule{AllowedMethods: []string{"GET"}}},
+			[]loadbalancer.Rule{loadbalancer.ControlAccessUsingHttpMethodsRule{AllowedMethods: []string{"POST"}}},
+		))
+	})
+}
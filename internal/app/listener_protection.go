@@ -0,0 +1,43 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// errListenerProtected is the cause of the status error reported when listeners removed
+// from the gateway spec are protected from deletion with the ProtectedListenersAnnotation.
+var errListenerProtected = errors.New("removed listeners are protected from deletion")
+
+// protectedGatewayListeners returns listener names of the ProtectedListenersAnnotation of the gateway.
+func protectedGatewayListeners(gateway gatewayv1.Gateway) []string {
+	value := gateway.Annotations[ProtectedListenersAnnotation]
+	if value == "" {
+		return nil
+	}
+	return lo.Compact(lo.Map(strings.Split(value, ","), func(name string, _ int) string {
+		return strings.TrimSpace(name)
+	}))
+}
+
+// newListenerProtectedError reports removed listeners kept since they are protected from deletion.
+// The operator either restores the listeners or removes them from the annotation to delete them.
+func newListenerProtectedError(listenerNames []string) *resourceStatusError {
+	listenerNames = slices.Sorted(slices.Values(listenerNames))
+	return &resourceStatusError{
+		conditionType: string(gatewayv1.GatewayConditionProgrammed),
+		reason:        GatewayReasonListenerProtected,
+		message: fmt.Sprintf(
+			"Removed listeners are protected from deletion: %s. "+
+				"Restore them in the Gateway spec, or remove them from the %s annotation to complete the removal",
+			strings.Join(listenerNames, ", "),
+			ProtectedListenersAnnotation,
+		),
+		cause: errListenerProtected,
+	}
+}
@@ -0,0 +1,30 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestProtectedGatewayListeners(t *testing.T) {
+	t.Run("returns nil without annotation", func(t *testing.T) {
+		assert.Nil(t, protectedGatewayListeners(*newRandomGateway()))
+	})
+
+	t.Run("parses listener names", func(t *testing.T) {
+		gateway := newRandomGateway()
+		gateway.Annotations = map[string]string{ProtectedListenersAnnotation: " http, ,https "}
+
+		assert.Equal(t, []string{"http", "https"}, protectedGatewayListeners(*gateway))
+	})
+}
+
+func TestNewListenerProtectedError(t *testing.T) {
+	err := newListenerProtectedError([]string{"https", "http"})
+
+	assert.ErrorIs(t, err, errListenerProtected)
+	assert.Equal(t, string(gatewayv1.GatewayConditionProgrammed), err.conditionType)
+	assert.Equal(t, GatewayReasonListenerProtected, err.reason)
+	assert.Contains(t, err.message, "protected from deletion: http, https.")
+}
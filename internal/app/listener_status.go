@@ -0,0 +1,118 @@
+package app
+
+import (
+	"slices"
+
+	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// listenerSupportedKinds returns route kinds reported in the listener status.
+func listenerSupportedKinds(listener gatewayv1.Listener) []gatewayv1.RouteGroupKind {
+	if listener.AllowedRoutes != nil && len(listener.AllowedRoutes.Kinds) > 0 {
+		return slices.Clone(listener.AllowedRoutes.Kinds)
+	}
+
+	var kinds []gatewayv1.Kind
+	switch listener.Protocol {
+	case gatewayv1.HTTPProtocolType, gatewayv1.HTTPSProtocolType:
+		kinds = []gatewayv1.Kind{"HTTPRoute", "GRPCRoute"}
+	case gatewayv1.TLSProtocolType:
+		kinds = []gatewayv1.Kind{"TLSRoute"}
+	case gatewayv1.TCPProtocolType:
+		kinds = []gatewayv1.Kind{"TCPRoute"}
+	case gatewayv1.UDPProtocolType:
+		kinds = []gatewayv1.Kind{"UDPRoute"}
+	}
+
+	supportedKinds := make([]gatewayv1.RouteGroupKind, len(kinds))
+	for i, kind := range kinds {
+		supportedKinds[i] = gatewayv1.RouteGroupKind{Group: new(gatewayv1.Group(gatewayAPIGroup)), Kind: kind}
+	}
+	return supportedKinds
+}
+
+// setListenerStatusCondition sets the condition of the listener in the gateway status.
+// The listener status is added if it was not reported yet.
+func setListenerStatusCondition(
+	gateway *gatewayv1.Gateway,
+	listenerName gatewayv1.SectionName,
+	condition metav1.Condition,
+) {
+	index := slices.IndexFunc(gateway.Status.Listeners, func(status gatewayv1.ListenerStatus) bool {
+		return status.Name == listenerName
+	})
+	if index < 0 {
+		status := gatewayv1.ListenerStatus{
+			Name:           listenerName,
+			SupportedKinds: []gatewayv1.RouteGroupKind{},
+			Conditions:     []metav1.Condition{},
+		}
+		if listener, found := lo.Find(gateway.Spec.Listeners, func(listener gatewayv1.Listener) bool {
+			return listener.Name == listenerName
+		}); found {
+			status.SupportedKinds = listenerSupportedKinds(listener)
+		}
+		gateway.Status.Listeners = append(gateway.Status.Listeners, status)
+		index = len(gateway.Status.Listeners) - 1
+	}
+	meta.SetStatusCondition(&gateway.Status.Listeners[index].Conditions, condition)
+}
+
+// resolveListenerStatusRefs marks references of the reported listeners as resolved and
+// the listeners as programmed once the gateway is programmed. Statuses of listeners
+// removed from the spec are dropped.
+func resolveListenerStatusRefs(gateway *gatewayv1.Gateway) {
+	gateway.Status.Listeners = slices.DeleteFunc(gateway.Status.Listeners, func(status gatewayv1.ListenerStatus) bool {
+		return !slices.ContainsFunc(gateway.Spec.Listeners, func(listener gatewayv1.Listener) bool {
+			return listener.Name == status.Name
+		})
+	})
+	for i := range gateway.Status.Listeners {
+		meta.SetStatusCondition(&gateway.Status.Listeners[i].Conditions, metav1.Condition{
+			Type:               string(gatewayv1.ListenerConditionResolvedRefs),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gatewayv1.ListenerReasonResolvedRefs),
+			Message:            "All references resolved",
+			ObservedGeneration: gateway.Generation,
+			LastTransitionTime: metav1.Now(),
+		})
+		meta.SetStatusCondition(&gateway.Status.Listeners[i].Conditions, metav1.Condition{
+			Type:               string(gatewayv1.ListenerConditionProgrammed),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gatewayv1.ListenerReasonProgrammed),
+			Message:            "Listener programmed",
+			ObservedGeneration: gateway.Generation,
+			LastTransitionTime: metav1.Now(),
+		})
+	}
+}
+
+// setListenersProgramming reports all listeners of the spec as pending programming.
+func setListenersProgramming(gateway *gatewayv1.Gateway) {
+	for _, listener := range gateway.Spec.Listeners {
+		setListenerStatusCondition(gateway, listener.Name, metav1.Condition{
+			Type:               string(gatewayv1.ListenerConditionProgrammed),
+			Status:             metav1.ConditionUnknown,
+			Reason:             string(gatewayv1.ListenerReasonPending),
+			Message:            "Listener is being programmed",
+			ObservedGeneration: gateway.Generation,
+			LastTransitionTime: metav1.Now(),
+		})
+	}
+}
+
+// failPendingListeners sets the Programmed condition of the listeners pending programming to False.
+func failPendingListeners(gateway *gatewayv1.Gateway, reason gatewayv1.ListenerConditionReason, message string) {
+	for i := range gateway.Status.Listeners {
+		failPendingProgramming(
+			&gateway.Status.Listeners[i].Conditions,
+			string(gatewayv1.ListenerConditionProgrammed),
+			gateway.Generation,
+			string(reason),
+			message,
+		)
+	}
+}
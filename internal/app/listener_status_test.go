@@ -0,0 +1,148 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestListenerStatus(t *testing.T) {
+	invalidRefCondition := metav1.Condition{
+		Type:   string(gatewayv1.ListenerConditionResolvedRefs),
+		Status: metav1.ConditionFalse,
+		Reason: string(gatewayv1.ListenerReasonInvalidCertificateRef),
+	}
+
+	t.Run("listenerSupportedKinds", func(t *testing.T) {
+		t.Run("returns allowed route kinds", func(t *testing.T) {
+			listener := makeRandomListener(randomListenerWithHTTPProtocolOpt())
+			listener.AllowedRoutes = &gatewayv1.AllowedRoutes{
+				Kinds: []gatewayv1.RouteGroupKind{{Kind: "HTTPRoute"}},
+			}
+			assert.Equal(t, listener.AllowedRoutes.Kinds, listenerSupportedKinds(listener))
+		})
+
+		t.Run("returns protocol route kinds", func(t *testing.T) {
+			listener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+			assert.Equal(t, []gatewayv1.RouteGroupKind{
+				{Group: new(gatewayv1.Group(gatewayAPIGroup)), Kind: "HTTPRoute"},
+				{Group: new(gatewayv1.Group(gatewayAPIGroup)), Kind: "GRPCRoute"},
+			}, listenerSupportedKinds(listener))
+		})
+	})
+
+	t.Run("setListenerStatusCondition", func(t *testing.T) {
+		t.Run("adds listener status", func(t *testing.T) {
+			listener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+			gateway := newRandomGateway(randomGatewayWithListenersOpt(listener))
+
+			setListenerStatusCondition(gateway, listener.Name, invalidRefCondition)
+
+			require.Len(t, gateway.Status.Listeners, 1)
+			assert.Equal(t, listener.Name, gateway.Status.Listeners[0].Name)
+			assert.Equal(t, listenerSupportedKinds(listener), gateway.Status.Listeners[0].SupportedKinds)
+			assert.True(t, meta.IsStatusConditionFalse(
+				gateway.Status.Listeners[0].Conditions,
+				string(gatewayv1.ListenerConditionResolvedRefs),
+			))
+		})
+
+		t.Run("updates existing listener status", func(t *testing.T) {
+			listener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+			gateway := newRandomGateway(randomGatewayWithListenersOpt(listener))
+			gateway.Status.Listeners = []gatewayv1.ListenerStatus{{
+				Name:           listener.Name,
+				AttachedRoutes: 3,
+				Conditions: []metav1.Condition{{
+					Type:   string(gatewayv1.ListenerConditionResolvedRefs),
+					Status: metav1.ConditionTrue,
+					Reason: string(gatewayv1.ListenerReasonResolvedRefs),
+				}},
+			}}
+
+			setListenerStatusCondition(gateway, listener.Name, invalidRefCondition)
+
+			require.Len(t, gateway.Status.Listeners, 1)
+			assert.Equal(t, int32(3), gateway.Status.Listeners[0].AttachedRoutes)
+			assert.True(t, meta.IsStatusConditionFalse(
+				gateway.Status.Listeners[0].Conditions,
+				string(gatewayv1.ListenerConditionResolvedRefs),
+			))
+		})
+	})
+
+	t.Run("resolveListenerStatusRefs", func(t *testing.T) {
+		listener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+		removedListener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+		gateway := newRandomGateway(randomGatewayWithListenersOpt(listener))
+		setListenerStatusCondition(gateway, listener.Name, invalidRefCondition)
+		setListenerStatusCondition(gateway, removedListener.Name, invalidRefCondition)
+
+		resolveListenerStatusRefs(gateway)
+
+		require.Len(t, gateway.Status.Listeners, 1)
+		assert.Equal(t, listener.Name, gateway.Status.Listeners[0].Name)
+		resolvedRefs := meta.FindStatusCondition(
+			gateway.Status.Listeners[0].Conditions,
+			string(gatewayv1.ListenerConditionResolvedRefs),
+		)
+		require.NotNil(t, resolvedRefs)
+		assert.Equal(t, metav1.ConditionTrue, resolvedRefs.Status)
+		assert.Equal(t, string(gatewayv1.ListenerReasonResolvedRefs), resolvedRefs.Reason)
+		assert.Equal(t, gateway.Generation, resolvedRefs.ObservedGeneration)
+		assert.True(t, meta.IsStatusConditionTrue(
+			gateway.Status.Listeners[0].Conditions,
+			string(gatewayv1.ListenerConditionProgrammed),
+		))
+	})
+
+	t.Run("setListenersProgramming", func(t *testing.T) {
+		listeners := []gatewayv1.Listener{
+			makeRandomListener(randomListenerWithHTTPProtocolOpt()),
+			makeRandomListener(randomListenerWithHTTPSParamsOpt()),
+		}
+		gateway := newRandomGateway(randomGatewayWithListenersOpt(listeners...))
+
+		setListenersProgramming(gateway)
+
+		require.Len(t, gateway.Status.Listeners, len(listeners))
+		for i, listener := range listeners {
+			assert.Equal(t, listener.Name, gateway.Status.Listeners[i].Name)
+			assert.True(t, isProgrammingPending(
+				gateway.Status.Listeners[i].Conditions,
+				string(gatewayv1.ListenerConditionProgrammed),
+			))
+		}
+	})
+
+	t.Run("failPendingListeners", func(t *testing.T) {
+		pendingListener := makeRandomListener(randomListenerWithHTTPProtocolOpt())
+		programmedListener := makeRandomListener(randomListenerWithHTTPProtocolOpt())
+		gateway := newRandomGateway(randomGatewayWithListenersOpt(pendingListener, programmedListener))
+		setListenersProgramming(gateway)
+		setListenerStatusCondition(gateway, programmedListener.Name, metav1.Condition{
+			Type:   string(gatewayv1.ListenerConditionProgrammed),
+			Status: metav1.ConditionTrue,
+			Reason: string(gatewayv1.ListenerReasonProgrammed),
+		})
+
+		failPendingListeners(gateway, gatewayv1.ListenerReasonInvalid, "programming failed")
+
+		failed := meta.FindStatusCondition(
+			gateway.Status.Listeners[0].Conditions,
+			string(gatewayv1.ListenerConditionProgrammed),
+		)
+		require.NotNil(t, failed)
+		assert.Equal(t, metav1.ConditionFalse, failed.Status)
+		assert.Equal(t, string(gatewayv1.ListenerReasonInvalid), failed.Reason)
+		assert.Equal(t, "programming failed", failed.Message)
+		assert.True(t, meta.IsStatusConditionTrue(
+			gateway.Status.Listeners[1].Conditions,
+			string(gatewayv1.ListenerConditionProgrammed),
+		))
+	})
+}
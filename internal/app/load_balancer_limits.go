@@ -0,0 +1,120 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+)
+
+// OCI load balancer limits. Exceeding them makes OCI reject the request with
+// a generic 400 error, so they are checked before creating the resources.
+// See https://docs.oracle.com/en-us/iaas/Content/General/Concepts/servicelimits.htm#lb_limits
+const (
+	defaultLoadBalancerMaxListeners      = 16
+	defaultLoadBalancerMaxBackendSets    = 16
+	defaultLoadBalancerMaxBackendsPerSet = 512
+
+	// The legacy micro shape allows far fewer backends than other shapes.
+	microLoadBalancerShape             = "10Mbps-Micro"
+	microLoadBalancerMaxBackendsPerSet = 16
+)
+
+type loadBalancerLimits struct {
+	maxListeners      int
+	maxBackendSets    int
+	maxBackendsPerSet int
+}
+
+// loadBalancerShapeLimits returns the limits of the load balancer of the given shape.
+// Unknown and flexible shapes get the default limits.
+func loadBalancerShapeLimits(shapeName string) loadBalancerLimits {
+	limits := loadBalancerLimits{
+		maxListeners:      defaultLoadBalancerMaxListeners,
+		maxBackendSets:    defaultLoadBalancerMaxBackendSets,
+		maxBackendsPerSet: defaultLoadBalancerMaxBackendsPerSet,
+	}
+	if shapeName == microLoadBalancerShape {
+		limits.maxBackendsPerSet = microLoadBalancerMaxBackendsPerSet
+	}
+	return limits
+}
+
+// loadBalancerLimitError indicates that creating the resource would exceed
+// the limits of the load balancer shape.
+type loadBalancerLimitError struct {
+	loadBalancerID string
+	shapeName      string
+	resource       string
+	limit          int
+}
+
+func (e loadBalancerLimitError) Error() string {
+	return fmt.Sprintf(
+		"quota exceeded: load balancer %s (shape %q) allows at most %d %s, "+
+			"resize the load balancer or reduce the number of %s",
+		e.loadBalancerID, e.shapeName, e.limit, e.resource, e.resource,
+	)
+}
+
+// checkLoadBalancerLimit returns loadBalancerLimitError if the desired count
+// of the resources exceeds the limit.
+func checkLoadBalancerLimit(
+	loadBalancerID string,
+	shapeName string,
+	resource string,
+	desired int,
+	limit int,
+) error {
+	if desired <= limit {
+		return nil
+	}
+	return loadBalancerLimitError{
+		loadBalancerID: loadBalancerID,
+		shapeName:      shapeName,
+		resource:       resource,
+		limit:          limit,
+	}
+}
+
+// checkBackendSetsLimit verifies that one more backend set fits into the load balancer.
+// The load balancer is fetched since route backend sets are created without its details.
+func (m *ociLoadBalancerModelImpl) checkBackendSetsLimit(ctx context.Context, loadBalancerID string) error {
+	res, err := m.ociClient.GetLoadBalancer(ctx, loadbalancer.GetLoadBalancerRequest{
+		LoadBalancerId: new(loadBalancerID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get load balancer %s: %w", loadBalancerID, err)
+	}
+	shapeName := lo.FromPtr(res.LoadBalancer.ShapeName)
+	return checkLoadBalancerLimit(
+		loadBalancerID,
+		shapeName,
+		"backend sets",
+		len(res.LoadBalancer.BackendSets)+1,
+		loadBalancerShapeLimits(shapeName).maxBackendSets,
+	)
+}
+
+// checkBackendsLimit verifies that the desired backends fit into the backend set.
+// The load balancer shape is only fetched if the count exceeds the lowest limit.
+func (m *httpBackendModelImpl) checkBackendsLimit(ctx context.Context, loadBalancerID string, desired int) error {
+	if desired <= microLoadBalancerMaxBackendsPerSet {
+		return nil
+	}
+	res, err := m.ociClient.GetLoadBalancer(ctx, loadbalancer.GetLoadBalancerRequest{
+		LoadBalancerId: new(loadBalancerID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get load balancer %s: %w", loadBalancerID, err)
+	}
+	shapeName := lo.FromPtr(res.LoadBalancer.ShapeName)
+	return checkLoadBalancerLimit(
+		loadBalancerID,
+		shapeName,
+		"backends per backend set",
+		desired,
+		loadBalancerShapeLimits(shapeName).maxBackendsPerSet,
+	)
+}
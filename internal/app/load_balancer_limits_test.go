@@ -0,0 +1,178 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadBalancerLimits(t *testing.T) {
+	makeBackendSets := func(count int) map[string]loadbalancer.BackendSet {
+		backendSets := make(map[string]loadbalancer.BackendSet, count)
+		for i := range count {
+			backendSets[fmt.Sprintf("backend-set-%d", i)] = makeRandomOCIBackendSet()
+		}
+		return backendSets
+	}
+
+	t.Run("loadBalancerShapeLimits", func(t *testing.T) {
+		t.Run("returns default limits", func(t *testing.T) {
+			assert.Equal(t, loadBalancerLimits{
+				maxListeners:      defaultLoadBalancerMaxListeners,
+				maxBackendSets:    defaultLoadBalancerMaxBackendSets,
+				maxBackendsPerSet: defaultLoadBalancerMaxBackendsPerSet,
+			}, loadBalancerShapeLimits("flexible"))
+		})
+
+		t.Run("limits backends of micro shape", func(t *testing.T) {
+			assert.Equal(t, microLoadBalancerMaxBackendsPerSet,
+				loadBalancerShapeLimits(microLoadBalancerShape).maxBackendsPerSet)
+		})
+	})
+
+	t.Run("checkLoadBalancerLimit", func(t *testing.T) {
+		t.Run("allows resources within the limit", func(t *testing.T) {
+			require.NoError(t, checkLoadBalancerLimit("lb", "flexible", "listeners", 16, 16))
+		})
+
+		t.Run("returns friendly error when limit is exceeded", func(t *testing.T) {
+			loadBalancerID := faker.New().UUID().V4()
+
+			err := checkLoadBalancerLimit(loadBalancerID, "flexible", "listeners", 17, 16)
+
+			var limitErr loadBalancerLimitError
+			require.ErrorAs(t, err, &limitErr)
+			assert.Equal(t, loadBalancerLimitError{
+				loadBalancerID: loadBalancerID,
+				shapeName:      "flexible",
+				resource:       "listeners",
+				limit:          16,
+			}, limitErr)
+			assert.Equal(t,
+				"quota exceeded: load balancer "+loadBalancerID+` (shape "flexible") allows at most 16 listeners, `+
+					"resize the load balancer or reduce the number of listeners",
+				err.Error(),
+			)
+		})
+	})
+
+	t.Run("ociLoadBalancerModel", func(t *testing.T) {
+		makeModel := func(t *testing.T) (*ociLoadBalancerModelImpl, *MockociLoadBalancerClient) {
+			ociClient := NewMockociLoadBalancerClient(t)
+			return newOciLoadBalancerModel(ociLoadBalancerModelDeps{
+				RootLogger:          diag.RootTestLogger(),
+				OciClient:           ociClient,
+				K8sClient:           NewMockk8sClient(t),
+				WorkRequestsWatcher: NewMockworkRequestsWatcher(t),
+				RoutingRulesMapper:  NewMockociLoadBalancerRoutingRulesMapper(t),
+			}), ociClient
+		}
+
+		t.Run("does not create listener above the limit", func(t *testing.T) {
+			model, _ := makeModel(t)
+			knownListeners := make(map[string]loadbalancer.Listener, defaultLoadBalancerMaxListeners)
+			for i := range defaultLoadBalancerMaxListeners {
+				knownListeners[fmt.Sprintf("listener-%d", i)] = makeRandomOCIListener()
+			}
+			listener := makeRandomListener(randomListenerWithHTTPProtocolOpt())
+
+			err := model.createHTTPListener(t.Context(), reconcileHTTPListenerParams{
+				loadBalancerID: faker.New().UUID().V4(),
+				knownListeners: knownListeners,
+				listenerSpec:   &listener,
+			}, string(listener.Name), nil)
+
+			var limitErr loadBalancerLimitError
+			require.ErrorAs(t, err, &limitErr)
+			assert.Equal(t, "listeners", limitErr.resource)
+		})
+
+		t.Run("does not create default backend set above the limit", func(t *testing.T) {
+			model, _ := makeModel(t)
+
+			_, err := model.reconcileDefaultBackendSet(t.Context(), reconcileDefaultBackendParams{
+				loadBalancerID:   faker.New().UUID().V4(),
+				knownBackendSets: makeBackendSets(defaultLoadBalancerMaxBackendSets),
+				gateway:          newRandomGateway(),
+			})
+
+			var limitErr loadBalancerLimitError
+			require.ErrorAs(t, err, &limitErr)
+			assert.Equal(t, "backend sets", limitErr.resource)
+		})
+
+		t.Run("checks backend sets limit of the load balancer shape", func(t *testing.T) {
+			model, ociClient := makeModel(t)
+			loadBalancerID := faker.New().UUID().V4()
+			ociClient.EXPECT().GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+				LoadBalancerId: &loadBalancerID,
+			}).Return(loadbalancer.GetLoadBalancerResponse{
+				LoadBalancer: loadbalancer.LoadBalancer{
+					ShapeName:   new("flexible"),
+					BackendSets: makeBackendSets(defaultLoadBalancerMaxBackendSets - 1),
+				},
+			}, nil).Once()
+			ociClient.EXPECT().GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+				LoadBalancerId: &loadBalancerID,
+			}).Return(loadbalancer.GetLoadBalancerResponse{
+				LoadBalancer: loadbalancer.LoadBalancer{
+					ShapeName:   new("flexible"),
+					BackendSets: makeBackendSets(defaultLoadBalancerMaxBackendSets),
+				},
+			}, nil).Once()
+
+			require.NoError(t, model.checkBackendSetsLimit(t.Context(), loadBalancerID))
+			err := model.checkBackendSetsLimit(t.Context(), loadBalancerID)
+
+			var limitErr loadBalancerLimitError
+			require.ErrorAs(t, err, &limitErr)
+			assert.Equal(t, "flexible", limitErr.shapeName)
+		})
+	})
+
+	t.Run("httpBackendModel", func(t *testing.T) {
+		makeModel := func(t *testing.T) (*httpBackendModelImpl, *MockociLoadBalancerClient) {
+			ociClient := NewMockociLoadBalancerClient(t)
+			return newHTTPBackendModel(httpBackendModelDeps{
+				RootLogger:            diag.RootTestLogger(),
+				K8sClient:             NewMockk8sClient(t),
+				OciLoadBalancerClient: ociClient,
+				WorkRequestsWatcher:   NewMockworkRequestsWatcher(t),
+			}), ociClient
+		}
+
+		t.Run("skips shape lookup for backends within the lowest limit", func(t *testing.T) {
+			model, _ := makeModel(t)
+
+			require.NoError(t, model.checkBackendsLimit(
+				t.Context(), faker.New().UUID().V4(), microLoadBalancerMaxBackendsPerSet,
+			))
+		})
+
+		t.Run("checks backends limit of the load balancer shape", func(t *testing.T) {
+			model, ociClient := makeModel(t)
+			loadBalancerID := faker.New().UUID().V4()
+			ociClient.EXPECT().GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+				LoadBalancerId: &loadBalancerID,
+			}).Return(loadbalancer.GetLoadBalancerResponse{
+				LoadBalancer: loadbalancer.LoadBalancer{ShapeName: new(microLoadBalancerShape)},
+			}, nil).Once()
+
+			err := model.checkBackendsLimit(t.Context(), loadBalancerID, microLoadBalancerMaxBackendsPerSet+1)
+
+			var limitErr loadBalancerLimitError
+			require.ErrorAs(t, err, &limitErr)
+			assert.Equal(t, loadBalancerLimitError{
+				loadBalancerID: loadBalancerID,
+				shapeName:      microLoadBalancerShape,
+				resource:       "backends per backend set",
+				limit:          microLoadBalancerMaxBackendsPerSet,
+			}, limitErr)
+		})
+	})
+}
@@ -0,0 +1,202 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
+	"github.com/samber/lo"
+	"go.uber.org/dig"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+)
+
+const (
+	// loadBalancerMetricsNamespace is the OCI Monitoring namespace of the load balancer metrics.
+	loadBalancerMetricsNamespace = "oci_lbaas"
+
+	// loadBalancerMetricsWindow is how far back the latest datapoint of the metric is looked up.
+	loadBalancerMetricsWindow = 5 * time.Minute
+
+	// loadBalancerMetricsResolutionSeconds is the aggregation interval of the datapoints.
+	loadBalancerMetricsResolutionSeconds = 60
+)
+
+// loadBalancerUtilizationMonitor exports active connections and bandwidth of the gateway load
+// balancers read from OCI Monitoring, so capacity alerts can use the controller metrics.
+// Load balancers are polled on reconciliation of the gateway, at most once per interval.
+// A nil value is valid and reports nothing.
+type loadBalancerUtilizationMonitor struct {
+	logger             *slog.Logger
+	monitoringClient   ociMonitoringClient
+	loadBalancerClient ociLoadBalancerClient
+	metrics            *loadBalancerUtilizationMetrics
+	interval           time.Duration
+	now                func() time.Time
+
+	mu       sync.Mutex
+	polledAt map[apitypes.NamespacedName]time.Time
+}
+
+// pollInterval returns the interval the gateways should be reconciled at to keep the metrics
+// up to date, zero if the monitor is disabled.
+func (m *loadBalancerUtilizationMonitor) pollInterval() time.Duration {
+	if m == nil {
+		return 0
+	}
+	return m.interval
+}
+
+// checkGatewayUtilization records utilization of the gateway load balancer unless it was
+// polled within the interval. Failures are logged, they do not fail the reconciliation.
+func (m *loadBalancerUtilizationMonitor) checkGatewayUtilization(ctx context.Context, data *resolvedGatewayDetails) {
+	if m == nil || m.interval <= 0 {
+		return
+	}
+	gateway := &data.gateway
+	key := apitypes.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name}
+	now := m.now()
+
+	m.mu.Lock()
+	polledAt, polled := m.polledAt[key]
+	if polled && now.Sub(polledAt) < m.interval {
+		m.mu.Unlock()
+		return
+	}
+	m.polledAt[key] = now
+	m.mu.Unlock()
+
+	loadBalancerID := data.config.Spec.LoadBalancerID
+	if err := m.pollLoadBalancer(ctx, gateway, loadBalancerID, now); err != nil {
+		m.logger.WarnContext(ctx, "Failed to read load balancer utilization",
+			slog.String("gateway", key.String()),
+			slog.String("loadBalancerId", loadBalancerID),
+			diag.ErrAttr(err),
+		)
+	}
+}
+
+func (m *loadBalancerUtilizationMonitor) pollLoadBalancer(
+	ctx context.Context,
+	gateway *gatewayv1.Gateway,
+	loadBalancerID string,
+	now time.Time,
+) error {
+	response, err := m.loadBalancerClient.GetLoadBalancer(ctx, loadbalancer.GetLoadBalancerRequest{
+		LoadBalancerId: &loadBalancerID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get OCI Load Balancer %s: %w", loadBalancerID, err)
+	}
+	loadBalancer := response.LoadBalancer
+	if loadBalancer.ShapeDetails != nil && loadBalancer.ShapeDetails.MaximumBandwidthInMbps != nil {
+		m.metrics.setMaxBandwidth(gateway.Namespace, gateway.Name, loadBalancerID,
+			float64(*loadBalancer.ShapeDetails.MaximumBandwidthInMbps),
+		)
+	}
+
+	compartmentID := lo.FromPtr(loadBalancer.CompartmentId)
+	connections, found, err := m.latestValue(ctx, compartmentID, loadBalancerID, "ActiveConnections", "mean", now)
+	if err != nil {
+		return err
+	}
+	if found {
+		m.metrics.setActiveConnections(gateway.Namespace, gateway.Name, loadBalancerID, connections)
+	}
+
+	for direction, metric := range map[string]string{"received": "BytesReceived", "sent": "BytesSent"} {
+		var bytes float64
+		bytes, found, err = m.latestValue(ctx, compartmentID, loadBalancerID, metric, "sum", now)
+		if err != nil {
+			return err
+		}
+		if found {
+			m.metrics.setBandwidth(gateway.Namespace, gateway.Name, loadBalancerID, direction,
+				bytes/loadBalancerMetricsResolutionSeconds,
+			)
+		}
+	}
+	return nil
+}
+
+// latestValue returns the latest datapoint of the load balancer metric within the window.
+// It returns false if OCI has no datapoints, e.g. for a newly created load balancer.
+func (m *loadBalancerUtilizationMonitor) latestValue(
+	ctx context.Context,
+	compartmentID, loadBalancerID, metric, statistic string,
+	now time.Time,
+) (float64, bool, error) {
+	query := fmt.Sprintf(`%s[%ds]{resourceId = "%s"}.%s()`,
+		metric, loadBalancerMetricsResolutionSeconds, loadBalancerID, statistic,
+	)
+	response, err := m.monitoringClient.SummarizeMetricsData(ctx, monitoring.SummarizeMetricsDataRequest{
+		CompartmentId: &compartmentID,
+		SummarizeMetricsDataDetails: monitoring.SummarizeMetricsDataDetails{
+			Namespace: new(loadBalancerMetricsNamespace),
+			Query:     &query,
+			StartTime: &common.SDKTime{Time: now.Add(-loadBalancerMetricsWindow)},
+			EndTime:   &common.SDKTime{Time: now},
+		},
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to summarize %s metric of OCI Load Balancer %s: %w",
+			metric, loadBalancerID, err)
+	}
+
+	var (
+		latest    float64
+		latestAt  time.Time
+		hasLatest bool
+	)
+	for _, item := range response.Items {
+		for _, datapoint := range item.AggregatedDatapoints {
+			if datapoint.Timestamp == nil || datapoint.Value == nil {
+				continue
+			}
+			if !hasLatest || datapoint.Timestamp.After(latestAt) {
+				latest, latestAt, hasLatest = *datapoint.Value, datapoint.Timestamp.Time, true
+			}
+		}
+	}
+	return latest, hasLatest, nil
+}
+
+// forgetGateway removes utilization metrics of the gateway that is deleted or no longer managed.
+func (m *loadBalancerUtilizationMonitor) forgetGateway(name apitypes.NamespacedName) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	delete(m.polledAt, name)
+	m.mu.Unlock()
+	m.metrics.deleteGateway(name.Namespace, name.Name)
+}
+
+type loadBalancerUtilizationMonitorDeps struct {
+	dig.In
+
+	RootLogger         *slog.Logger
+	MonitoringClient   ociMonitoringClient
+	LoadBalancerClient ociLoadBalancerClient
+	Metrics            *loadBalancerUtilizationMetrics `optional:"true"`
+	Interval           time.Duration                   `name:"config.reconcile.load-balancer-metrics-interval"`
+}
+
+func newLoadBalancerUtilizationMonitor(deps loadBalancerUtilizationMonitorDeps) *loadBalancerUtilizationMonitor {
+	return &loadBalancerUtilizationMonitor{
+		logger:             deps.RootLogger.WithGroup("load-balancer-utilization-monitor"),
+		monitoringClient:   deps.MonitoringClient,
+		loadBalancerClient: deps.LoadBalancerClient,
+		metrics:            deps.Metrics,
+		interval:           deps.Interval,
+		now:                time.Now,
+		polledAt:           make(map[apitypes.NamespacedName]time.Time),
+	}
+}
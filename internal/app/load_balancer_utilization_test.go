@@ -0,0 +1,187 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apitypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+)
+
+func TestLoadBalancerUtilizationMonitor(t *testing.T) {
+	now := time.Now()
+
+	type testDeps struct {
+		monitoringClient   *MockociMonitoringClient
+		loadBalancerClient *MockociLoadBalancerClient
+		metrics            *loadBalancerUtilizationMetrics
+	}
+
+	newMonitor := func(t *testing.T, interval time.Duration) (*loadBalancerUtilizationMonitor, testDeps) {
+		metrics, err := newLoadBalancerUtilizationMetrics()
+		require.NoError(t, err)
+		deps := testDeps{
+			monitoringClient:   NewMockociMonitoringClient(t),
+			loadBalancerClient: NewMockociLoadBalancerClient(t),
+			metrics:            metrics,
+		}
+		monitor := newLoadBalancerUtilizationMonitor(loadBalancerUtilizationMonitorDeps{
+			RootLogger:         diag.RootTestLogger(),
+			MonitoringClient:   deps.monitoringClient,
+			LoadBalancerClient: deps.loadBalancerClient,
+			Metrics:            metrics,
+			Interval:           interval,
+		})
+		monitor.now = func() time.Time { return now }
+		return monitor, deps
+	}
+
+	makeData := func() *resolvedGatewayDetails {
+		return &resolvedGatewayDetails{
+			gateway: *newRandomGateway(),
+			config:  makeRandomGatewayConfig(),
+		}
+	}
+
+	expectLoadBalancer := func(t *testing.T, deps testDeps, data *resolvedGatewayDetails, compartmentID string) {
+		deps.loadBalancerClient.EXPECT().
+			GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+				LoadBalancerId: &data.config.Spec.LoadBalancerID,
+			}).
+			Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadbalancer.LoadBalancer{
+				Id:            &data.config.Spec.LoadBalancerID,
+				CompartmentId: &compartmentID,
+				ShapeDetails: &loadbalancer.ShapeDetails{
+					MinimumBandwidthInMbps: new(10),
+					MaximumBandwidthInMbps: new(100),
+				},
+			}}, nil).
+			Once()
+	}
+
+	expectMetric := func(
+		t *testing.T,
+		deps testDeps,
+		data *resolvedGatewayDetails,
+		compartmentID, metric string,
+		datapoints ...monitoring.AggregatedDatapoint,
+	) {
+		deps.monitoringClient.EXPECT().
+			SummarizeMetricsData(t.Context(), mock.MatchedBy(func(req monitoring.SummarizeMetricsDataRequest) bool {
+				return strings.HasPrefix(*req.SummarizeMetricsDataDetails.Query, metric+"[") &&
+					strings.Contains(*req.SummarizeMetricsDataDetails.Query, data.config.Spec.LoadBalancerID)
+			})).
+			RunAndReturn(func(
+				_ context.Context, req monitoring.SummarizeMetricsDataRequest,
+			) (monitoring.SummarizeMetricsDataResponse, error) {
+				assert.Equal(t, compartmentID, *req.CompartmentId)
+				assert.Equal(t, loadBalancerMetricsNamespace, *req.SummarizeMetricsDataDetails.Namespace)
+				assert.Equal(t, now, req.SummarizeMetricsDataDetails.EndTime.Time)
+				return monitoring.SummarizeMetricsDataResponse{
+					Items: []monitoring.MetricData{{AggregatedDatapoints: datapoints}},
+				}, nil
+			}).
+			Once()
+	}
+
+	datapoint := func(age time.Duration, value float64) monitoring.AggregatedDatapoint {
+		return monitoring.AggregatedDatapoint{
+			Timestamp: &common.SDKTime{Time: now.Add(-age)},
+			Value:     new(value),
+		}
+	}
+
+	t.Run("records latest datapoints", func(t *testing.T) {
+		monitor, deps := newMonitor(t, time.Minute)
+		data := makeData()
+		compartmentID := faker.New().UUID().V4()
+		expectLoadBalancer(t, deps, data, compartmentID)
+		expectMetric(t, deps, data, compartmentID, "ActiveConnections",
+			datapoint(2*time.Minute, 10), datapoint(time.Minute, 25))
+		expectMetric(t, deps, data, compartmentID, "BytesReceived", datapoint(time.Minute, 6000))
+		expectMetric(t, deps, data, compartmentID, "BytesSent", datapoint(time.Minute, 120))
+
+		monitor.checkGatewayUtilization(t.Context(), data)
+
+		gateway := data.gateway
+		loadBalancerID := data.config.Spec.LoadBalancerID
+		assert.InDelta(t, 25, testutil.ToFloat64(
+			deps.metrics.activeConnections.WithLabelValues(gateway.Namespace, gateway.Name, loadBalancerID)), 0)
+		assert.InDelta(t, 100, testutil.ToFloat64(
+			deps.metrics.bandwidth.WithLabelValues(gateway.Namespace, gateway.Name, loadBalancerID, "received")), 0)
+		assert.InDelta(t, 2, testutil.ToFloat64(
+			deps.metrics.bandwidth.WithLabelValues(gateway.Namespace, gateway.Name, loadBalancerID, "sent")), 0)
+		assert.InDelta(t, 100, testutil.ToFloat64(
+			deps.metrics.maxBandwidth.WithLabelValues(gateway.Namespace, gateway.Name, loadBalancerID)), 0)
+	})
+
+	t.Run("polls at most once per interval", func(t *testing.T) {
+		monitor, deps := newMonitor(t, time.Minute)
+		data := makeData()
+		compartmentID := faker.New().UUID().V4()
+		expectLoadBalancer(t, deps, data, compartmentID)
+		for _, metric := range []string{"ActiveConnections", "BytesReceived", "BytesSent"} {
+			expectMetric(t, deps, data, compartmentID, metric)
+		}
+
+		monitor.checkGatewayUtilization(t.Context(), data)
+		now = now.Add(30 * time.Second)
+		monitor.checkGatewayUtilization(t.Context(), data)
+	})
+
+	t.Run("logs failures", func(t *testing.T) {
+		monitor, deps := newMonitor(t, time.Minute)
+		data := makeData()
+		deps.loadBalancerClient.EXPECT().
+			GetLoadBalancer(t.Context(), mock.Anything).
+			Return(loadbalancer.GetLoadBalancerResponse{}, errors.New(faker.New().Lorem().Sentence(3)))
+
+		assert.NotPanics(t, func() {
+			monitor.checkGatewayUtilization(t.Context(), data)
+		})
+	})
+
+	t.Run("forgets gateway", func(t *testing.T) {
+		monitor, deps := newMonitor(t, time.Minute)
+		data := makeData()
+		name := apitypes.NamespacedName{Namespace: data.gateway.Namespace, Name: data.gateway.Name}
+		loadBalancerID := data.config.Spec.LoadBalancerID
+		monitor.polledAt[name] = now
+		deps.metrics.setActiveConnections(name.Namespace, name.Name, loadBalancerID, 5)
+
+		monitor.forgetGateway(name)
+
+		assert.Empty(t, monitor.polledAt)
+		assert.InDelta(t, 0, testutil.ToFloat64(
+			deps.metrics.activeConnections.WithLabelValues(name.Namespace, name.Name, loadBalancerID)), 0)
+	})
+
+	t.Run("is disabled without interval", func(t *testing.T) {
+		monitor, _ := newMonitor(t, 0)
+
+		monitor.checkGatewayUtilization(t.Context(), makeData())
+
+		assert.Zero(t, monitor.pollInterval())
+	})
+
+	t.Run("nil monitor is noop", func(t *testing.T) {
+		var monitor *loadBalancerUtilizationMonitor
+		assert.NotPanics(t, func() {
+			monitor.checkGatewayUtilization(t.Context(), makeData())
+			monitor.forgetGateway(apitypes.NamespacedName{})
+		})
+		assert.Zero(t, monitor.pollInterval())
+	})
+}
@@ -0,0 +1,403 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsNamespace = "oke_gateway"
+
+// routingPolicyMetrics exposes routing policy state via the controller-runtime metrics endpoint.
+// A nil value is valid and records nothing.
+type routingPolicyMetrics struct {
+	remainingRules *prometheus.GaugeVec
+}
+
+func (m *routingPolicyMetrics) setRemainingRules(loadBalancerID, listenerName string, remaining int) {
+	if m == nil {
+		return
+	}
+	m.remainingRules.WithLabelValues(loadBalancerID, listenerName).Set(float64(remaining))
+}
+
+func registerGaugeVec(gauge *prometheus.GaugeVec) (*prometheus.GaugeVec, error) {
+	return registerCollector(gauge)
+}
+
+func registerCollector[T prometheus.Collector](collector T) (T, error) {
+	if err := metrics.Registry.Register(collector); err != nil {
+		var alreadyRegistered prometheus.AlreadyRegisteredError
+		if !errors.As(err, &alreadyRegistered) {
+			return collector, err
+		}
+		existing, ok := alreadyRegistered.ExistingCollector.(T)
+		if !ok {
+			return collector, fmt.Errorf("unexpected metrics collector: %T", alreadyRegistered.ExistingCollector)
+		}
+		return existing, nil
+	}
+	return collector, nil
+}
+
+func newRoutingPolicyMetrics() (*routingPolicyMetrics, error) {
+	remainingRules, err := registerGaugeVec(prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "routing_policy",
+		Name:      "remaining_rules",
+		Help:      "Number of rules that can still be added to the listener routing policy.",
+	}, []string{"load_balancer_id", "listener"}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register routing policy metrics: %w", err)
+	}
+
+	return &routingPolicyMetrics{remainingRules: remainingRules}, nil
+}
+
+// backendHealthMetrics exposes OCI backend health of the routes.
+// A nil value is valid and records nothing.
+type backendHealthMetrics struct {
+	routeBackends *prometheus.GaugeVec
+}
+
+func (m *backendHealthMetrics) setRouteBackends(namespace, route, gateway string, health backendsHealthSummary) {
+	if m == nil {
+		return
+	}
+	m.routeBackends.WithLabelValues(namespace, route, gateway, "ok").Set(float64(health.ok()))
+	m.routeBackends.WithLabelValues(namespace, route, gateway, "warning").Set(float64(health.warning))
+	m.routeBackends.WithLabelValues(namespace, route, gateway, "critical").Set(float64(health.critical))
+	m.routeBackends.WithLabelValues(namespace, route, gateway, "unknown").Set(float64(health.unknown))
+}
+
+func (m *backendHealthMetrics) deleteRouteBackends(namespace, route string) {
+	if m == nil {
+		return
+	}
+	m.routeBackends.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "route": route})
+}
+
+func newBackendHealthMetrics() (*backendHealthMetrics, error) {
+	routeBackends, err := registerGaugeVec(prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "route",
+		Name:      "backends",
+		Help:      "Number of route backends by OCI health state.",
+	}, []string{"namespace", "route", "gateway", "state"}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register backend health metrics: %w", err)
+	}
+
+	return &backendHealthMetrics{routeBackends: routeBackends}, nil
+}
+
+// certificateMetrics exposes expiry of the certificates served by the gateway listeners.
+// A nil value is valid and records nothing.
+type certificateMetrics struct {
+	expiryDays *prometheus.GaugeVec
+}
+
+func (m *certificateMetrics) setExpiryDays(namespace, gateway, certificate string, days float64) {
+	if m == nil {
+		return
+	}
+	m.expiryDays.WithLabelValues(namespace, gateway, certificate).Set(days)
+}
+
+func (m *certificateMetrics) deleteGatewayCertificates(namespace, gateway string) {
+	if m == nil {
+		return
+	}
+	m.expiryDays.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "gateway": gateway})
+}
+
+func newCertificateMetrics() (*certificateMetrics, error) {
+	expiryDays, err := registerGaugeVec(prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "certificate",
+		Name:      "expiry_days",
+		Help:      "Days until the OCI certificate programmed for the gateway listeners expires.",
+	}, []string{"namespace", "gateway", "certificate"}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register certificate metrics: %w", err)
+	}
+
+	return &certificateMetrics{expiryDays: expiryDays}, nil
+}
+
+// backendEndpointMetrics exposes endpoints that can not be registered in the OCI backend sets.
+// A nil value is valid and records nothing.
+type backendEndpointMetrics struct {
+	skippedEndpoints      *prometheus.GaugeVec
+	routeSkippedEndpoints *prometheus.GaugeVec
+}
+
+func (m *backendEndpointMetrics) setSkippedEndpoints(
+	loadBalancerID, backendSetName string,
+	skipped map[discoveryv1.AddressType]int,
+) {
+	if m == nil {
+		return
+	}
+	for _, addressType := range []discoveryv1.AddressType{
+		discoveryv1.AddressTypeIPv4,
+		discoveryv1.AddressTypeIPv6,
+		discoveryv1.AddressTypeFQDN,
+	} {
+		m.skippedEndpoints.WithLabelValues(loadBalancerID, backendSetName, string(addressType)).
+			Set(float64(skipped[addressType]))
+	}
+}
+
+// setRouteSkippedFQDNEndpoints records FQDN endpoints of the route backends that are not
+// registered in the network load balancer backend sets.
+func (m *backendEndpointMetrics) setRouteSkippedFQDNEndpoints(routeKind, namespace, route string, skipped int) {
+	if m == nil {
+		return
+	}
+	m.routeSkippedEndpoints.WithLabelValues(routeKind, namespace, route, string(discoveryv1.AddressTypeFQDN)).
+		Set(float64(skipped))
+}
+
+func (m *backendEndpointMetrics) deleteRouteSkippedEndpoints(routeKind, namespace, route string) {
+	if m == nil {
+		return
+	}
+	m.routeSkippedEndpoints.DeletePartialMatch(prometheus.Labels{
+		"route_kind": routeKind,
+		"namespace":  namespace,
+		"route":      route,
+	})
+}
+
+func newBackendEndpointMetrics() (*backendEndpointMetrics, error) {
+	skippedEndpoints, err := registerGaugeVec(prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "backend_set",
+		Name:      "skipped_endpoints",
+		Help:      "Number of endpoints not registered in the backend set since their address type is not enabled.",
+	}, []string{"load_balancer_id", "backend_set", "address_type"}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register backend endpoint metrics: %w", err)
+	}
+
+	routeSkippedEndpoints, err := registerGaugeVec(prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "route",
+		Name:      "skipped_endpoints",
+		Help:      "Number of L4 route endpoints not registered since their address type is not supported.",
+	}, []string{"route_kind", "namespace", "route", "address_type"}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register route endpoint metrics: %w", err)
+	}
+
+	return &backendEndpointMetrics{
+		skippedEndpoints:      skippedEndpoints,
+		routeSkippedEndpoints: routeSkippedEndpoints,
+	}, nil
+}
+
+// ociReadinessMetrics exposes reachability of the load balancers of active GatewayConfigs.
+// A nil value is valid and records nothing.
+type ociReadinessMetrics struct {
+	reachable *prometheus.GaugeVec
+}
+
+func (m *ociReadinessMetrics) setReachable(namespace, gatewayConfig, loadBalancerID string, reachable bool) {
+	if m == nil {
+		return
+	}
+	value := 0.0
+	if reachable {
+		value = 1
+	}
+	m.reachable.WithLabelValues(namespace, gatewayConfig, loadBalancerID).Set(value)
+}
+
+func (m *ociReadinessMetrics) reset() {
+	if m == nil {
+		return
+	}
+	m.reachable.Reset()
+}
+
+func newOCIReadinessMetrics() (*ociReadinessMetrics, error) {
+	reachable, err := registerGaugeVec(prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "oci",
+		Name:      "load_balancer_reachable",
+		Help:      "Whether the load balancer of the active GatewayConfig was reachable by the last readiness probe.",
+	}, []string{"namespace", "gateway_config", "load_balancer_id"}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register OCI readiness metrics: %w", err)
+	}
+
+	return &ociReadinessMetrics{reachable: reachable}, nil
+}
+
+// Buckets of the route programming lag, from 1 second up to about half an hour.
+const (
+	routeProgrammingLagStartSeconds = 1
+	routeProgrammingLagFactor       = 2
+	routeProgrammingLagBuckets      = 12
+)
+
+// routeProgrammingMetrics exposes how long it takes to program changes of the routes,
+// from the first change observed by the controller until the route is reconciled
+// successfully, including retries.
+// A nil value is valid and records nothing.
+type routeProgrammingMetrics struct {
+	lag *prometheus.HistogramVec
+	now func() time.Time
+
+	mu           sync.Mutex
+	pendingSince map[apitypes.NamespacedName]time.Time
+}
+
+// observeChange starts measuring the lag of the route, unless a previous change is still pending.
+func (m *routeProgrammingMetrics) observeChange(route apitypes.NamespacedName) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, pending := m.pendingSince[route]; !pending {
+		m.pendingSince[route] = m.now()
+	}
+}
+
+// observeProgrammed records the lag of the pending change of the route.
+func (m *routeProgrammingMetrics) observeProgrammed(route apitypes.NamespacedName) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	since, pending := m.pendingSince[route]
+	delete(m.pendingSince, route)
+	m.mu.Unlock()
+	if pending {
+		m.lag.WithLabelValues(route.Namespace, route.Name).Observe(m.now().Sub(since).Seconds())
+	}
+}
+
+// forget drops the pending change and the lag of the route, e.g. when the route is deleted.
+func (m *routeProgrammingMetrics) forget(route apitypes.NamespacedName) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	delete(m.pendingSince, route)
+	m.mu.Unlock()
+	m.lag.DeletePartialMatch(prometheus.Labels{"namespace": route.Namespace, "route": route.Name})
+}
+
+func newRouteProgrammingMetrics() (*routeProgrammingMetrics, error) {
+	lag, err := registerCollector(prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "route",
+		Name:      "programming_lag_seconds",
+		Help:      "Time from a change of the HTTPRoute or its backend EndpointSlices until the route is programmed.",
+		Buckets: prometheus.ExponentialBuckets(
+			routeProgrammingLagStartSeconds,
+			routeProgrammingLagFactor,
+			routeProgrammingLagBuckets,
+		),
+	}, []string{"namespace", "route"}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register route programming metrics: %w", err)
+	}
+
+	return &routeProgrammingMetrics{
+		lag:          lag,
+		now:          time.Now,
+		pendingSince: make(map[apitypes.NamespacedName]time.Time),
+	}, nil
+}
+
+// loadBalancerUtilizationMetrics exposes utilization of the gateway load balancers read from OCI Monitoring.
+// A nil value is valid and records nothing.
+type loadBalancerUtilizationMetrics struct {
+	activeConnections *prometheus.GaugeVec
+	bandwidth         *prometheus.GaugeVec
+	maxBandwidth      *prometheus.GaugeVec
+}
+
+func (m *loadBalancerUtilizationMetrics) setActiveConnections(
+	namespace, gateway, loadBalancerID string,
+	connections float64,
+) {
+	if m == nil {
+		return
+	}
+	m.activeConnections.WithLabelValues(namespace, gateway, loadBalancerID).Set(connections)
+}
+
+func (m *loadBalancerUtilizationMetrics) setBandwidth(
+	namespace, gateway, loadBalancerID, direction string,
+	bytesPerSecond float64,
+) {
+	if m == nil {
+		return
+	}
+	m.bandwidth.WithLabelValues(namespace, gateway, loadBalancerID, direction).Set(bytesPerSecond)
+}
+
+func (m *loadBalancerUtilizationMetrics) setMaxBandwidth(namespace, gateway, loadBalancerID string, mbps float64) {
+	if m == nil {
+		return
+	}
+	m.maxBandwidth.WithLabelValues(namespace, gateway, loadBalancerID).Set(mbps)
+}
+
+func (m *loadBalancerUtilizationMetrics) deleteGateway(namespace, gateway string) {
+	if m == nil {
+		return
+	}
+	labels := prometheus.Labels{"namespace": namespace, "gateway": gateway}
+	m.activeConnections.DeletePartialMatch(labels)
+	m.bandwidth.DeletePartialMatch(labels)
+	m.maxBandwidth.DeletePartialMatch(labels)
+}
+
+func newLoadBalancerUtilizationMetrics() (*loadBalancerUtilizationMetrics, error) {
+	labels := []string{"namespace", "gateway", "load_balancer_id"}
+	activeConnections, err := registerGaugeVec(prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "load_balancer",
+		Name:      "active_connections",
+		Help:      "Average number of active connections of the gateway load balancer reported by OCI Monitoring.",
+	}, labels))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register load balancer utilization metrics: %w", err)
+	}
+	bandwidth, err := registerGaugeVec(prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "load_balancer",
+		Name:      "bandwidth_bytes_per_second",
+		Help:      "Bytes per second received or sent by the gateway load balancer reported by OCI Monitoring.",
+	}, []string{"namespace", "gateway", "load_balancer_id", "direction"}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register load balancer utilization metrics: %w", err)
+	}
+	maxBandwidth, err := registerGaugeVec(prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "load_balancer",
+		Name:      "max_bandwidth_mbps",
+		Help:      "Maximum bandwidth in Mbps of the flexible shape of the gateway load balancer.",
+	}, labels))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register load balancer utilization metrics: %w", err)
+	}
+
+	return &loadBalancerUtilizationMetrics{
+		activeConnections: activeConnections,
+		bandwidth:         bandwidth,
+		maxBandwidth:      maxBandwidth,
+	}, nil
+}
@@ -0,0 +1,278 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+)
+
+func TestRoutingPolicyMetrics(t *testing.T) {
+	t.Run("reuses already registered collector", func(t *testing.T) {
+		first, err := newRoutingPolicyMetrics()
+		require.NoError(t, err)
+		second, err := newRoutingPolicyMetrics()
+		require.NoError(t, err)
+
+		assert.Same(t, first.remainingRules, second.remainingRules)
+	})
+
+	t.Run("setRemainingRules", func(t *testing.T) {
+		fake := faker.New()
+		metrics, err := newRoutingPolicyMetrics()
+		require.NoError(t, err)
+		loadBalancerID := fake.UUID().V4()
+		listenerName := fake.Lorem().Word()
+		remaining := fake.IntBetween(0, maxRoutingPolicyRules)
+
+		metrics.setRemainingRules(loadBalancerID, listenerName, remaining)
+
+		assert.InDelta(t,
+			float64(remaining),
+			testutil.ToFloat64(metrics.remainingRules.WithLabelValues(loadBalancerID, listenerName)),
+			0,
+		)
+	})
+
+	t.Run("nil metrics are noop", func(t *testing.T) {
+		var metrics *routingPolicyMetrics
+		assert.NotPanics(t, func() {
+			metrics.setRemainingRules(faker.New().UUID().V4(), faker.New().Lorem().Word(), 1)
+		})
+	})
+}
+
+func TestBackendHealthMetrics(t *testing.T) {
+	t.Run("setRouteBackends and deleteRouteBackends", func(t *testing.T) {
+		fake := faker.New()
+		metrics, err := newBackendHealthMetrics()
+		require.NoError(t, err)
+		namespace := fake.Internet().Slug()
+		route := fake.Internet().Slug()
+		gateway := fake.Internet().Slug()
+		health := backendsHealthSummary{total: 6, warning: 1, critical: 2, unknown: 1}
+
+		metrics.setRouteBackends(namespace, route, gateway, health)
+
+		assert.InDelta(t, 2, testutil.ToFloat64(metrics.routeBackends.WithLabelValues(namespace, route, gateway, "ok")), 0)
+		assert.InDelta(t, 2,
+			testutil.ToFloat64(metrics.routeBackends.WithLabelValues(namespace, route, gateway, "critical")), 0)
+
+		metrics.deleteRouteBackends(namespace, route)
+
+		assert.InDelta(t, 0,
+			testutil.ToFloat64(metrics.routeBackends.WithLabelValues(namespace, route, gateway, "critical")), 0)
+	})
+
+	t.Run("nil metrics are noop", func(t *testing.T) {
+		var metrics *backendHealthMetrics
+		assert.NotPanics(t, func() {
+			metrics.setRouteBackends(faker.New().Lorem().Word(), faker.New().Lorem().Word(), "", backendsHealthSummary{})
+			metrics.deleteRouteBackends(faker.New().Lorem().Word(), faker.New().Lorem().Word())
+		})
+	})
+}
+
+func TestCertificateMetrics(t *testing.T) {
+	t.Run("setExpiryDays and deleteGatewayCertificates", func(t *testing.T) {
+		fake := faker.New()
+		metrics, err := newCertificateMetrics()
+		require.NoError(t, err)
+		namespace := fake.Internet().Slug()
+		gateway := fake.Internet().Slug()
+		certificate := fake.Internet().Slug()
+
+		metrics.setExpiryDays(namespace, gateway, certificate, 12.5)
+
+		assert.InDelta(t, 12.5,
+			testutil.ToFloat64(metrics.expiryDays.WithLabelValues(namespace, gateway, certificate)), 0)
+
+		metrics.deleteGatewayCertificates(namespace, gateway)
+
+		assert.InDelta(t, 0,
+			testutil.ToFloat64(metrics.expiryDays.WithLabelValues(namespace, gateway, certificate)), 0)
+	})
+
+	t.Run("nil metrics are noop", func(t *testing.T) {
+		var metrics *certificateMetrics
+		assert.NotPanics(t, func() {
+			metrics.setExpiryDays(faker.New().Lorem().Word(), faker.New().Lorem().Word(), faker.New().Lorem().Word(), 1)
+			metrics.deleteGatewayCertificates(faker.New().Lorem().Word(), faker.New().Lorem().Word())
+		})
+	})
+}
+
+func TestBackendEndpointMetrics(t *testing.T) {
+	t.Run("setSkippedEndpoints", func(t *testing.T) {
+		fake := faker.New()
+		metrics, err := newBackendEndpointMetrics()
+		require.NoError(t, err)
+		loadBalancerID := fake.UUID().V4()
+		backendSetName := fake.Internet().Slug()
+
+		metrics.setSkippedEndpoints(loadBalancerID, backendSetName, map[discoveryv1.AddressType]int{
+			discoveryv1.AddressTypeIPv6: 3,
+		})
+
+		assert.InDelta(t, 3, testutil.ToFloat64(
+			metrics.skippedEndpoints.WithLabelValues(loadBalancerID, backendSetName, "IPv6")), 0)
+		assert.InDelta(t, 0, testutil.ToFloat64(
+			metrics.skippedEndpoints.WithLabelValues(loadBalancerID, backendSetName, "FQDN")), 0)
+
+		metrics.setSkippedEndpoints(loadBalancerID, backendSetName, nil)
+
+		assert.InDelta(t, 0, testutil.ToFloat64(
+			metrics.skippedEndpoints.WithLabelValues(loadBalancerID, backendSetName, "IPv6")), 0)
+	})
+
+	t.Run("setRouteSkippedFQDNEndpoints", func(t *testing.T) {
+		fake := faker.New()
+		metrics, err := newBackendEndpointMetrics()
+		require.NoError(t, err)
+		namespace := fake.Internet().Slug()
+		route := fake.Internet().Slug()
+
+		metrics.setRouteSkippedFQDNEndpoints("TCPRoute", namespace, route, 2)
+
+		assert.InDelta(t, 2, testutil.ToFloat64(
+			metrics.routeSkippedEndpoints.WithLabelValues("TCPRoute", namespace, route, "FQDN")), 0)
+
+		metrics.setRouteSkippedFQDNEndpoints("TCPRoute", namespace, route, 0)
+
+		assert.InDelta(t, 0, testutil.ToFloat64(
+			metrics.routeSkippedEndpoints.WithLabelValues("TCPRoute", namespace, route, "FQDN")), 0)
+	})
+
+	t.Run("deleteRouteSkippedEndpoints", func(t *testing.T) {
+		fake := faker.New()
+		metrics, err := newBackendEndpointMetrics()
+		require.NoError(t, err)
+		metrics.routeSkippedEndpoints.Reset()
+		namespace := fake.Internet().Slug()
+		route := fake.Internet().Slug()
+		metrics.setRouteSkippedFQDNEndpoints("TCPRoute", namespace, route, 2)
+		metrics.setRouteSkippedFQDNEndpoints("UDPRoute", namespace, route, 3)
+
+		metrics.deleteRouteSkippedEndpoints("TCPRoute", namespace, route)
+
+		assert.Equal(t, 1, testutil.CollectAndCount(metrics.routeSkippedEndpoints))
+		assert.InDelta(t, 3, testutil.ToFloat64(
+			metrics.routeSkippedEndpoints.WithLabelValues("UDPRoute", namespace, route, "FQDN")), 0)
+	})
+
+	t.Run("nil metrics are noop", func(t *testing.T) {
+		var metrics *backendEndpointMetrics
+		assert.NotPanics(t, func() {
+			metrics.setSkippedEndpoints(faker.New().UUID().V4(), faker.New().Lorem().Word(), nil)
+			metrics.setRouteSkippedFQDNEndpoints("UDPRoute", faker.New().Lorem().Word(), faker.New().Lorem().Word(), 1)
+			metrics.deleteRouteSkippedEndpoints("UDPRoute", faker.New().Lorem().Word(), faker.New().Lorem().Word())
+		})
+	})
+}
+
+func TestRouteProgrammingMetrics(t *testing.T) {
+	makeRoute := func() apitypes.NamespacedName {
+		fake := faker.New()
+		return apitypes.NamespacedName{Namespace: fake.Internet().Slug(), Name: fake.Internet().Slug()}
+	}
+
+	t.Run("records lag from the first pending change", func(t *testing.T) {
+		metrics, err := newRouteProgrammingMetrics()
+		require.NoError(t, err)
+		metrics.lag.Reset()
+		now := time.Now()
+		metrics.now = func() time.Time { return now }
+		route := makeRoute()
+
+		metrics.observeChange(route)
+		now = now.Add(time.Minute)
+		metrics.observeChange(route)
+
+		assert.Equal(t, now.Add(-time.Minute), metrics.pendingSince[route])
+
+		metrics.observeProgrammed(route)
+
+		assert.Empty(t, metrics.pendingSince)
+		assert.Equal(t, 1, testutil.CollectAndCount(metrics.lag, "oke_gateway_route_programming_lag_seconds"))
+	})
+
+	t.Run("skips routes without pending changes", func(t *testing.T) {
+		metrics, err := newRouteProgrammingMetrics()
+		require.NoError(t, err)
+		metrics.lag.Reset()
+
+		metrics.observeProgrammed(makeRoute())
+
+		assert.Equal(t, 0, testutil.CollectAndCount(metrics.lag, "oke_gateway_route_programming_lag_seconds"))
+	})
+
+	t.Run("forgets deleted routes", func(t *testing.T) {
+		metrics, err := newRouteProgrammingMetrics()
+		require.NoError(t, err)
+		metrics.lag.Reset()
+		route := makeRoute()
+		metrics.observeChange(route)
+		metrics.observeProgrammed(route)
+		metrics.observeChange(route)
+
+		metrics.forget(route)
+
+		assert.Empty(t, metrics.pendingSince)
+		assert.Equal(t, 0, testutil.CollectAndCount(metrics.lag, "oke_gateway_route_programming_lag_seconds"))
+	})
+
+	t.Run("nil metrics are noop", func(t *testing.T) {
+		var metrics *routeProgrammingMetrics
+		assert.NotPanics(t, func() {
+			metrics.observeChange(makeRoute())
+			metrics.observeProgrammed(makeRoute())
+			metrics.forget(makeRoute())
+		})
+	})
+}
+
+func TestLoadBalancerUtilizationMetrics(t *testing.T) {
+	t.Run("set and deleteGateway", func(t *testing.T) {
+		fake := faker.New()
+		metrics, err := newLoadBalancerUtilizationMetrics()
+		require.NoError(t, err)
+		namespace := fake.Internet().Slug()
+		gateway := fake.Internet().Slug()
+		loadBalancerID := fake.UUID().V4()
+
+		metrics.setActiveConnections(namespace, gateway, loadBalancerID, 42)
+		metrics.setBandwidth(namespace, gateway, loadBalancerID, "sent", 1024)
+		metrics.setMaxBandwidth(namespace, gateway, loadBalancerID, 100)
+
+		assert.InDelta(t, 42,
+			testutil.ToFloat64(metrics.activeConnections.WithLabelValues(namespace, gateway, loadBalancerID)), 0)
+		assert.InDelta(t, 1024,
+			testutil.ToFloat64(metrics.bandwidth.WithLabelValues(namespace, gateway, loadBalancerID, "sent")), 0)
+		assert.InDelta(t, 100,
+			testutil.ToFloat64(metrics.maxBandwidth.WithLabelValues(namespace, gateway, loadBalancerID)), 0)
+
+		metrics.deleteGateway(namespace, gateway)
+
+		assert.InDelta(t, 0,
+			testutil.ToFloat64(metrics.activeConnections.WithLabelValues(namespace, gateway, loadBalancerID)), 0)
+		assert.InDelta(t, 0,
+			testutil.ToFloat64(metrics.bandwidth.WithLabelValues(namespace, gateway, loadBalancerID, "sent")), 0)
+		assert.InDelta(t, 0,
+			testutil.ToFloat64(metrics.maxBandwidth.WithLabelValues(namespace, gateway, loadBalancerID)), 0)
+	})
+
+	t.Run("nil metrics are noop", func(t *testing.T) {
+		var metrics *loadBalancerUtilizationMetrics
+		assert.NotPanics(t, func() {
+			metrics.setActiveConnections(faker.New().Lorem().Word(), faker.New().Lorem().Word(), "", 1)
+			metrics.setBandwidth(faker.New().Lorem().Word(), faker.New().Lorem().Word(), "", "received", 1)
+			metrics.setMaxBandwidth(faker.New().Lorem().Word(), faker.New().Lorem().Word(), "", 1)
+			metrics.deleteGateway(faker.New().Lorem().Word(), faker.New().Lorem().Word())
+		})
+	})
+}
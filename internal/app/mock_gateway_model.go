@@ -24,6 +24,53 @@ func (_m *MockgatewayModel) EXPECT() *MockgatewayModel_Expecter {
 	return &MockgatewayModel_Expecter{mock: &_m.Mock}
 }
 
+// deprovisionGateway provides a mock function with given fields: ctx, data
+func (_m *MockgatewayModel) deprovisionGateway(ctx context.Context, data *resolvedGatewayDetails) error {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for deprovisionGateway")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *resolvedGatewayDetails) error); ok {
+		r0 = rf(ctx, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockgatewayModel_deprovisionGateway_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'deprovisionGateway'
+type MockgatewayModel_deprovisionGateway_Call struct {
+	*mock.Call
+}
+
+// deprovisionGateway is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *resolvedGatewayDetails
+func (_e *MockgatewayModel_Expecter) deprovisionGateway(ctx interface{}, data interface{}) *MockgatewayModel_deprovisionGateway_Call {
+	return &MockgatewayModel_deprovisionGateway_Call{Call: _e.mock.On("deprovisionGateway", ctx, data)}
+}
+
+func (_c *MockgatewayModel_deprovisionGateway_Call) Run(run func(ctx context.Context, data *resolvedGatewayDetails)) *MockgatewayModel_deprovisionGateway_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*resolvedGatewayDetails))
+	})
+	return _c
+}
+
+func (_c *MockgatewayModel_deprovisionGateway_Call) Return(_a0 error) *MockgatewayModel_deprovisionGateway_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockgatewayModel_deprovisionGateway_Call) RunAndReturn(run func(context.Context, *resolvedGatewayDetails) error) *MockgatewayModel_deprovisionGateway_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // isProgrammed provides a mock function with given fields: ctx, data
 func (_m *MockgatewayModel) isProgrammed(ctx context.Context, data *resolvedGatewayDetails) bool {
 	ret := _m.Called(ctx, data)
@@ -80,63 +80,73 @@ func (_c *MockhttpBackendModel_identifyBackendsToUpdate_Call) RunAndReturn(run f
 	return _c
 }
 
-// syncRouteBackendRefEndpoints provides a mock function with given fields: ctx, params
-func (_m *MockhttpBackendModel) syncRouteBackendRefEndpoints(ctx context.Context, params syncRouteBackendRefEndpointsParams) error {
+// routeBackendsHealth provides a mock function with given fields: ctx, params
+func (_m *MockhttpBackendModel) routeBackendsHealth(ctx context.Context, params routeBackendsHealthParams) (backendsHealthSummary, error) {
 	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for syncRouteBackendRefEndpoints")
+		panic("no return value specified for routeBackendsHealth")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, syncRouteBackendRefEndpointsParams) error); ok {
+	var r0 backendsHealthSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, routeBackendsHealthParams) (backendsHealthSummary, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, routeBackendsHealthParams) backendsHealthSummary); ok {
 		r0 = rf(ctx, params)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(backendsHealthSummary)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, routeBackendsHealthParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockhttpBackendModel_syncRouteBackendRefEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'syncRouteBackendRefEndpoints'
-type MockhttpBackendModel_syncRouteBackendRefEndpoints_Call struct {
+// MockhttpBackendModel_routeBackendsHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'routeBackendsHealth'
+type MockhttpBackendModel_routeBackendsHealth_Call struct {
 	*mock.Call
 }
 
-// syncRouteBackendRefEndpoints is a helper method to define mock.On call
+// routeBackendsHealth is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params syncRouteBackendRefEndpointsParams
-func (_e *MockhttpBackendModel_Expecter) syncRouteBackendRefEndpoints(ctx interface{}, params interface{}) *MockhttpBackendModel_syncRouteBackendRefEndpoints_Call {
-	return &MockhttpBackendModel_syncRouteBackendRefEndpoints_Call{Call: _e.mock.On("syncRouteBackendRefEndpoints", ctx, params)}
+//   - params routeBackendsHealthParams
+func (_e *MockhttpBackendModel_Expecter) routeBackendsHealth(ctx interface{}, params interface{}) *MockhttpBackendModel_routeBackendsHealth_Call {
+	return &MockhttpBackendModel_routeBackendsHealth_Call{Call: _e.mock.On("routeBackendsHealth", ctx, params)}
 }
 
-func (_c *MockhttpBackendModel_syncRouteBackendRefEndpoints_Call) Run(run func(ctx context.Context, params syncRouteBackendRefEndpointsParams)) *MockhttpBackendModel_syncRouteBackendRefEndpoints_Call {
+func (_c *MockhttpBackendModel_routeBackendsHealth_Call) Run(run func(ctx context.Context, params routeBackendsHealthParams)) *MockhttpBackendModel_routeBackendsHealth_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(syncRouteBackendRefEndpointsParams))
+		run(args[0].(context.Context), args[1].(routeBackendsHealthParams))
 	})
 	return _c
 }
 
-func (_c *MockhttpBackendModel_syncRouteBackendRefEndpoints_Call) Return(_a0 error) *MockhttpBackendModel_syncRouteBackendRefEndpoints_Call {
-	_c.Call.Return(_a0)
+func (_c *MockhttpBackendModel_routeBackendsHealth_Call) Return(_a0 backendsHealthSummary, _a1 error) *MockhttpBackendModel_routeBackendsHealth_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockhttpBackendModel_syncRouteBackendRefEndpoints_Call) RunAndReturn(run func(context.Context, syncRouteBackendRefEndpointsParams) error) *MockhttpBackendModel_syncRouteBackendRefEndpoints_Call {
+func (_c *MockhttpBackendModel_routeBackendsHealth_Call) RunAndReturn(run func(context.Context, routeBackendsHealthParams) (backendsHealthSummary, error)) *MockhttpBackendModel_routeBackendsHealth_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// syncRouteEndpoints provides a mock function with given fields: ctx, params
-func (_m *MockhttpBackendModel) syncRouteEndpoints(ctx context.Context, params syncRouteEndpointsParams) error {
+// syncDefaultBackendEndpoints provides a mock function with given fields: ctx, params
+func (_m *MockhttpBackendModel) syncDefaultBackendEndpoints(ctx context.Context, params syncDefaultBackendEndpointsParams) error {
 	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for syncRouteEndpoints")
+		panic("no return value specified for syncDefaultBackendEndpoints")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, syncRouteEndpointsParams) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, syncDefaultBackendEndpointsParams) error); ok {
 		r0 = rf(ctx, params)
 	} else {
 		r0 = ret.Error(0)
@@ -145,31 +155,31 @@ func (_m *MockhttpBackendModel) syncRouteEndpoints(ctx context.Context, params s
 	return r0
 }
 
-// MockhttpBackendModel_syncRouteEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'syncRouteEndpoints'
-type MockhttpBackendModel_syncRouteEndpoints_Call struct {
+// MockhttpBackendModel_syncDefaultBackendEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'syncDefaultBackendEndpoints'
+type MockhttpBackendModel_syncDefaultBackendEndpoints_Call struct {
 	*mock.Call
 }
 
-// syncRouteEndpoints is a helper method to define mock.On call
+// syncDefaultBackendEndpoints is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params syncRouteEndpointsParams
-func (_e *MockhttpBackendModel_Expecter) syncRouteEndpoints(ctx interface{}, params interface{}) *MockhttpBackendModel_syncRouteEndpoints_Call {
-	return &MockhttpBackendModel_syncRouteEndpoints_Call{Call: _e.mock.On("syncRouteEndpoints", ctx, params)}
+//   - params syncDefaultBackendEndpointsParams
+func (_e *MockhttpBackendModel_Expecter) syncDefaultBackendEndpoints(ctx interface{}, params interface{}) *MockhttpBackendModel_syncDefaultBackendEndpoints_Call {
+	return &MockhttpBackendModel_syncDefaultBackendEndpoints_Call{Call: _e.mock.On("syncDefaultBackendEndpoints", ctx, params)}
 }
 
-func (_c *MockhttpBackendModel_syncRouteEndpoints_Call) Run(run func(ctx context.Context, params syncRouteEndpointsParams)) *MockhttpBackendModel_syncRouteEndpoints_Call {
+func (_c *MockhttpBackendModel_syncDefaultBackendEndpoints_Call) Run(run func(ctx context.Context, params syncDefaultBackendEndpointsParams)) *MockhttpBackendModel_syncDefaultBackendEndpoints_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(syncRouteEndpointsParams))
+		run(args[0].(context.Context), args[1].(syncDefaultBackendEndpointsParams))
 	})
 	return _c
 }
 
-func (_c *MockhttpBackendModel_syncRouteEndpoints_Call) Return(_a0 error) *MockhttpBackendModel_syncRouteEndpoints_Call {
+func (_c *MockhttpBackendModel_syncDefaultBackendEndpoints_Call) Return(_a0 error) *MockhttpBackendModel_syncDefaultBackendEndpoints_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockhttpBackendModel_syncRouteEndpoints_Call) RunAndReturn(run func(context.Context, syncRouteEndpointsParams) error) *MockhttpBackendModel_syncRouteEndpoints_Call {
+func (_c *MockhttpBackendModel_syncDefaultBackendEndpoints_Call) RunAndReturn(run func(context.Context, syncDefaultBackendEndpointsParams) error) *MockhttpBackendModel_syncDefaultBackendEndpoints_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -221,6 +231,100 @@ func (_c *MockhttpBackendModel_syncGRPCRouteEndpoints_Call) RunAndReturn(run fun
 	return _c
 }
 
+// syncRouteBackendRefEndpoints provides a mock function with given fields: ctx, params
+func (_m *MockhttpBackendModel) syncRouteBackendRefEndpoints(ctx context.Context, params syncRouteBackendRefEndpointsParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for syncRouteBackendRefEndpoints")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, syncRouteBackendRefEndpointsParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockhttpBackendModel_syncRouteBackendRefEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'syncRouteBackendRefEndpoints'
+type MockhttpBackendModel_syncRouteBackendRefEndpoints_Call struct {
+	*mock.Call
+}
+
+// syncRouteBackendRefEndpoints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params syncRouteBackendRefEndpointsParams
+func (_e *MockhttpBackendModel_Expecter) syncRouteBackendRefEndpoints(ctx interface{}, params interface{}) *MockhttpBackendModel_syncRouteBackendRefEndpoints_Call {
+	return &MockhttpBackendModel_syncRouteBackendRefEndpoints_Call{Call: _e.mock.On("syncRouteBackendRefEndpoints", ctx, params)}
+}
+
+func (_c *MockhttpBackendModel_syncRouteBackendRefEndpoints_Call) Run(run func(ctx context.Context, params syncRouteBackendRefEndpointsParams)) *MockhttpBackendModel_syncRouteBackendRefEndpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(syncRouteBackendRefEndpointsParams))
+	})
+	return _c
+}
+
+func (_c *MockhttpBackendModel_syncRouteBackendRefEndpoints_Call) Return(_a0 error) *MockhttpBackendModel_syncRouteBackendRefEndpoints_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockhttpBackendModel_syncRouteBackendRefEndpoints_Call) RunAndReturn(run func(context.Context, syncRouteBackendRefEndpointsParams) error) *MockhttpBackendModel_syncRouteBackendRefEndpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// syncRouteEndpoints provides a mock function with given fields: ctx, params
+func (_m *MockhttpBackendModel) syncRouteEndpoints(ctx context.Context, params syncRouteEndpointsParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for syncRouteEndpoints")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, syncRouteEndpointsParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockhttpBackendModel_syncRouteEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'syncRouteEndpoints'
+type MockhttpBackendModel_syncRouteEndpoints_Call struct {
+	*mock.Call
+}
+
+// syncRouteEndpoints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params syncRouteEndpointsParams
+func (_e *MockhttpBackendModel_Expecter) syncRouteEndpoints(ctx interface{}, params interface{}) *MockhttpBackendModel_syncRouteEndpoints_Call {
+	return &MockhttpBackendModel_syncRouteEndpoints_Call{Call: _e.mock.On("syncRouteEndpoints", ctx, params)}
+}
+
+func (_c *MockhttpBackendModel_syncRouteEndpoints_Call) Run(run func(ctx context.Context, params syncRouteEndpointsParams)) *MockhttpBackendModel_syncRouteEndpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(syncRouteEndpointsParams))
+	})
+	return _c
+}
+
+func (_c *MockhttpBackendModel_syncRouteEndpoints_Call) Return(_a0 error) *MockhttpBackendModel_syncRouteEndpoints_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockhttpBackendModel_syncRouteEndpoints_Call) RunAndReturn(run func(context.Context, syncRouteEndpointsParams) error) *MockhttpBackendModel_syncRouteEndpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockhttpBackendModel creates a new instance of MockhttpBackendModel. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockhttpBackendModel(t interface {
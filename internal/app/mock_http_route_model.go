@@ -379,6 +379,100 @@ func (_c *MockhttpRouteModel_resolveRequest_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// setBackendsHealth provides a mock function with given fields: ctx, params
+func (_m *MockhttpRouteModel) setBackendsHealth(ctx context.Context, params setBackendsHealthParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for setBackendsHealth")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, setBackendsHealthParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockhttpRouteModel_setBackendsHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'setBackendsHealth'
+type MockhttpRouteModel_setBackendsHealth_Call struct {
+	*mock.Call
+}
+
+// setBackendsHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params setBackendsHealthParams
+func (_e *MockhttpRouteModel_Expecter) setBackendsHealth(ctx interface{}, params interface{}) *MockhttpRouteModel_setBackendsHealth_Call {
+	return &MockhttpRouteModel_setBackendsHealth_Call{Call: _e.mock.On("setBackendsHealth", ctx, params)}
+}
+
+func (_c *MockhttpRouteModel_setBackendsHealth_Call) Run(run func(ctx context.Context, params setBackendsHealthParams)) *MockhttpRouteModel_setBackendsHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(setBackendsHealthParams))
+	})
+	return _c
+}
+
+func (_c *MockhttpRouteModel_setBackendsHealth_Call) Return(_a0 error) *MockhttpRouteModel_setBackendsHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockhttpRouteModel_setBackendsHealth_Call) RunAndReturn(run func(context.Context, setBackendsHealthParams) error) *MockhttpRouteModel_setBackendsHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// setPaused provides a mock function with given fields: ctx, params
+func (_m *MockhttpRouteModel) setPaused(ctx context.Context, params setPausedParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for setPaused")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, setPausedParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockhttpRouteModel_setPaused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'setPaused'
+type MockhttpRouteModel_setPaused_Call struct {
+	*mock.Call
+}
+
+// setPaused is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params setPausedParams
+func (_e *MockhttpRouteModel_Expecter) setPaused(ctx interface{}, params interface{}) *MockhttpRouteModel_setPaused_Call {
+	return &MockhttpRouteModel_setPaused_Call{Call: _e.mock.On("setPaused", ctx, params)}
+}
+
+func (_c *MockhttpRouteModel_setPaused_Call) Run(run func(ctx context.Context, params setPausedParams)) *MockhttpRouteModel_setPaused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(setPausedParams))
+	})
+	return _c
+}
+
+func (_c *MockhttpRouteModel_setPaused_Call) Return(_a0 error) *MockhttpRouteModel_setPaused_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockhttpRouteModel_setPaused_Call) RunAndReturn(run func(context.Context, setPausedParams) error) *MockhttpRouteModel_setPaused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // setProgrammed provides a mock function with given fields: ctx, params
 func (_m *MockhttpRouteModel) setProgrammed(ctx context.Context, params setProgrammedParams) error {
 	ret := _m.Called(ctx, params)
@@ -426,6 +520,101 @@ func (_c *MockhttpRouteModel_setProgrammed_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// setProgramming provides a mock function with given fields: ctx, params
+func (_m *MockhttpRouteModel) setProgramming(ctx context.Context, params setRouteProgrammingParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for setProgramming")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, setRouteProgrammingParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockhttpRouteModel_setProgramming_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'setProgramming'
+type MockhttpRouteModel_setProgramming_Call struct {
+	*mock.Call
+}
+
+// setProgramming is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params setRouteProgrammingParams
+func (_e *MockhttpRouteModel_Expecter) setProgramming(ctx interface{}, params interface{}) *MockhttpRouteModel_setProgramming_Call {
+	return &MockhttpRouteModel_setProgramming_Call{Call: _e.mock.On("setProgramming", ctx, params)}
+}
+
+func (_c *MockhttpRouteModel_setProgramming_Call) Run(run func(ctx context.Context, params setRouteProgrammingParams)) *MockhttpRouteModel_setProgramming_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(setRouteProgrammingParams))
+	})
+	return _c
+}
+
+func (_c *MockhttpRouteModel_setProgramming_Call) Return(_a0 error) *MockhttpRouteModel_setProgramming_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockhttpRouteModel_setProgramming_Call) RunAndReturn(run func(context.Context, setRouteProgrammingParams) error) *MockhttpRouteModel_setProgramming_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// setRejected provides a mock function with given fields: ctx, routeDetails, statusErr
+func (_m *MockhttpRouteModel) setRejected(ctx context.Context, routeDetails resolvedRouteDetails, statusErr httpRouteStatusError) error {
+	ret := _m.Called(ctx, routeDetails, statusErr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for setRejected")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, resolvedRouteDetails, httpRouteStatusError) error); ok {
+		r0 = rf(ctx, routeDetails, statusErr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockhttpRouteModel_setRejected_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'setRejected'
+type MockhttpRouteModel_setRejected_Call struct {
+	*mock.Call
+}
+
+// setRejected is a helper method to define mock.On call
+//   - ctx context.Context
+//   - routeDetails resolvedRouteDetails
+//   - statusErr httpRouteStatusError
+func (_e *MockhttpRouteModel_Expecter) setRejected(ctx interface{}, routeDetails interface{}, statusErr interface{}) *MockhttpRouteModel_setRejected_Call {
+	return &MockhttpRouteModel_setRejected_Call{Call: _e.mock.On("setRejected", ctx, routeDetails, statusErr)}
+}
+
+func (_c *MockhttpRouteModel_setRejected_Call) Run(run func(ctx context.Context, routeDetails resolvedRouteDetails, statusErr httpRouteStatusError)) *MockhttpRouteModel_setRejected_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resolvedRouteDetails), args[2].(httpRouteStatusError))
+	})
+	return _c
+}
+
+func (_c *MockhttpRouteModel_setRejected_Call) Return(_a0 error) *MockhttpRouteModel_setRejected_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockhttpRouteModel_setRejected_Call) RunAndReturn(run func(context.Context, resolvedRouteDetails, httpRouteStatusError) error) *MockhttpRouteModel_setRejected_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockhttpRouteModel creates a new instance of MockhttpRouteModel. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockhttpRouteModel(t interface {
@@ -27,6 +27,68 @@ func (_m *Mockk8sClient) EXPECT() *Mockk8sClient_Expecter {
 	return &Mockk8sClient_Expecter{mock: &_m.Mock}
 }
 
+// Create provides a mock function with given fields: ctx, obj, opts
+func (_m *Mockk8sClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, obj)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, client.Object, ...client.CreateOption) error); ok {
+		r0 = rf(ctx, obj, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Mockk8sClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type Mockk8sClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - obj client.Object
+//   - opts ...client.CreateOption
+func (_e *Mockk8sClient_Expecter) Create(ctx interface{}, obj interface{}, opts ...interface{}) *Mockk8sClient_Create_Call {
+	return &Mockk8sClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, obj}, opts...)...)}
+}
+
+func (_c *Mockk8sClient_Create_Call) Run(run func(ctx context.Context, obj client.Object, opts ...client.CreateOption)) *Mockk8sClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]client.CreateOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(client.CreateOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(client.Object), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Mockk8sClient_Create_Call) Return(_a0 error) *Mockk8sClient_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Mockk8sClient_Create_Call) RunAndReturn(run func(context.Context, client.Object, ...client.CreateOption) error) *Mockk8sClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: ctx, key, obj, opts
 func (_m *Mockk8sClient) Get(ctx context.Context, key types.NamespacedName, obj client.Object, opts ...client.GetOption) error {
 	_va := make([]interface{}, len(opts))
@@ -152,6 +214,69 @@ func (_c *Mockk8sClient_List_Call) RunAndReturn(run func(context.Context, client
 	return _c
 }
 
+// Patch provides a mock function with given fields: ctx, obj, patch, opts
+func (_m *Mockk8sClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, obj, patch)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, client.Object, client.Patch, ...client.PatchOption) error); ok {
+		r0 = rf(ctx, obj, patch, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Mockk8sClient_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type Mockk8sClient_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - obj client.Object
+//   - patch client.Patch
+//   - opts ...client.PatchOption
+func (_e *Mockk8sClient_Expecter) Patch(ctx interface{}, obj interface{}, patch interface{}, opts ...interface{}) *Mockk8sClient_Patch_Call {
+	return &Mockk8sClient_Patch_Call{Call: _e.mock.On("Patch",
+		append([]interface{}{ctx, obj, patch}, opts...)...)}
+}
+
+func (_c *Mockk8sClient_Patch_Call) Run(run func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption)) *Mockk8sClient_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]client.PatchOption, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(client.PatchOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(client.Object), args[2].(client.Patch), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Mockk8sClient_Patch_Call) Return(_a0 error) *Mockk8sClient_Patch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Mockk8sClient_Patch_Call) RunAndReturn(run func(context.Context, client.Object, client.Patch, ...client.PatchOption) error) *Mockk8sClient_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Status provides a mock function with no fields
 func (_m *Mockk8sClient) Status() client.SubResourceWriter {
 	ret := _m.Called()
@@ -366,6 +366,63 @@ func (_c *MockociLoadBalancerClient_CreateRoutingPolicy_Call) RunAndReturn(run f
 	return _c
 }
 
+// CreateRuleSet provides a mock function with given fields: ctx, request
+func (_m *MockociLoadBalancerClient) CreateRuleSet(ctx context.Context, request loadbalancer.CreateRuleSetRequest) (loadbalancer.CreateRuleSetResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRuleSet")
+	}
+
+	var r0 loadbalancer.CreateRuleSetResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, loadbalancer.CreateRuleSetRequest) (loadbalancer.CreateRuleSetResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, loadbalancer.CreateRuleSetRequest) loadbalancer.CreateRuleSetResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Get(0).(loadbalancer.CreateRuleSetResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, loadbalancer.CreateRuleSetRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockociLoadBalancerClient_CreateRuleSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRuleSet'
+type MockociLoadBalancerClient_CreateRuleSet_Call struct {
+	*mock.Call
+}
+
+// CreateRuleSet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request loadbalancer.CreateRuleSetRequest
+func (_e *MockociLoadBalancerClient_Expecter) CreateRuleSet(ctx interface{}, request interface{}) *MockociLoadBalancerClient_CreateRuleSet_Call {
+	return &MockociLoadBalancerClient_CreateRuleSet_Call{Call: _e.mock.On("CreateRuleSet", ctx, request)}
+}
+
+func (_c *MockociLoadBalancerClient_CreateRuleSet_Call) Run(run func(ctx context.Context, request loadbalancer.CreateRuleSetRequest)) *MockociLoadBalancerClient_CreateRuleSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(loadbalancer.CreateRuleSetRequest))
+	})
+	return _c
+}
+
+func (_c *MockociLoadBalancerClient_CreateRuleSet_Call) Return(response loadbalancer.CreateRuleSetResponse, err error) *MockociLoadBalancerClient_CreateRuleSet_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockociLoadBalancerClient_CreateRuleSet_Call) RunAndReturn(run func(context.Context, loadbalancer.CreateRuleSetRequest) (loadbalancer.CreateRuleSetResponse, error)) *MockociLoadBalancerClient_CreateRuleSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteBackendSet provides a mock function with given fields: ctx, request
 func (_m *MockociLoadBalancerClient) DeleteBackendSet(ctx context.Context, request loadbalancer.DeleteBackendSetRequest) (loadbalancer.DeleteBackendSetResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -594,6 +651,120 @@ func (_c *MockociLoadBalancerClient_DeleteRoutingPolicy_Call) RunAndReturn(run f
 	return _c
 }
 
+// DeleteRuleSet provides a mock function with given fields: ctx, request
+func (_m *MockociLoadBalancerClient) DeleteRuleSet(ctx context.Context, request loadbalancer.DeleteRuleSetRequest) (loadbalancer.DeleteRuleSetResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRuleSet")
+	}
+
+	var r0 loadbalancer.DeleteRuleSetResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, loadbalancer.DeleteRuleSetRequest) (loadbalancer.DeleteRuleSetResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, loadbalancer.DeleteRuleSetRequest) loadbalancer.DeleteRuleSetResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Get(0).(loadbalancer.DeleteRuleSetResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, loadbalancer.DeleteRuleSetRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockociLoadBalancerClient_DeleteRuleSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRuleSet'
+type MockociLoadBalancerClient_DeleteRuleSet_Call struct {
+	*mock.Call
+}
+
+// DeleteRuleSet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request loadbalancer.DeleteRuleSetRequest
+func (_e *MockociLoadBalancerClient_Expecter) DeleteRuleSet(ctx interface{}, request interface{}) *MockociLoadBalancerClient_DeleteRuleSet_Call {
+	return &MockociLoadBalancerClient_DeleteRuleSet_Call{Call: _e.mock.On("DeleteRuleSet", ctx, request)}
+}
+
+func (_c *MockociLoadBalancerClient_DeleteRuleSet_Call) Run(run func(ctx context.Context, request loadbalancer.DeleteRuleSetRequest)) *MockociLoadBalancerClient_DeleteRuleSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(loadbalancer.DeleteRuleSetRequest))
+	})
+	return _c
+}
+
+func (_c *MockociLoadBalancerClient_DeleteRuleSet_Call) Return(response loadbalancer.DeleteRuleSetResponse, err error) *MockociLoadBalancerClient_DeleteRuleSet_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockociLoadBalancerClient_DeleteRuleSet_Call) RunAndReturn(run func(context.Context, loadbalancer.DeleteRuleSetRequest) (loadbalancer.DeleteRuleSetResponse, error)) *MockociLoadBalancerClient_DeleteRuleSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBackendHealth provides a mock function with given fields: ctx, request
+func (_m *MockociLoadBalancerClient) GetBackendHealth(ctx context.Context, request loadbalancer.GetBackendHealthRequest) (loadbalancer.GetBackendHealthResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBackendHealth")
+	}
+
+	var r0 loadbalancer.GetBackendHealthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, loadbalancer.GetBackendHealthRequest) (loadbalancer.GetBackendHealthResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, loadbalancer.GetBackendHealthRequest) loadbalancer.GetBackendHealthResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Get(0).(loadbalancer.GetBackendHealthResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, loadbalancer.GetBackendHealthRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockociLoadBalancerClient_GetBackendHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBackendHealth'
+type MockociLoadBalancerClient_GetBackendHealth_Call struct {
+	*mock.Call
+}
+
+// GetBackendHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request loadbalancer.GetBackendHealthRequest
+func (_e *MockociLoadBalancerClient_Expecter) GetBackendHealth(ctx interface{}, request interface{}) *MockociLoadBalancerClient_GetBackendHealth_Call {
+	return &MockociLoadBalancerClient_GetBackendHealth_Call{Call: _e.mock.On("GetBackendHealth", ctx, request)}
+}
+
+func (_c *MockociLoadBalancerClient_GetBackendHealth_Call) Run(run func(ctx context.Context, request loadbalancer.GetBackendHealthRequest)) *MockociLoadBalancerClient_GetBackendHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(loadbalancer.GetBackendHealthRequest))
+	})
+	return _c
+}
+
+func (_c *MockociLoadBalancerClient_GetBackendHealth_Call) Return(response loadbalancer.GetBackendHealthResponse, err error) *MockociLoadBalancerClient_GetBackendHealth_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockociLoadBalancerClient_GetBackendHealth_Call) RunAndReturn(run func(context.Context, loadbalancer.GetBackendHealthRequest) (loadbalancer.GetBackendHealthResponse, error)) *MockociLoadBalancerClient_GetBackendHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBackendSet provides a mock function with given fields: ctx, request
 func (_m *MockociLoadBalancerClient) GetBackendSet(ctx context.Context, request loadbalancer.GetBackendSetRequest) (loadbalancer.GetBackendSetResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -651,6 +822,63 @@ func (_c *MockociLoadBalancerClient_GetBackendSet_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// GetBackendSetHealth provides a mock function with given fields: ctx, request
+func (_m *MockociLoadBalancerClient) GetBackendSetHealth(ctx context.Context, request loadbalancer.GetBackendSetHealthRequest) (loadbalancer.GetBackendSetHealthResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBackendSetHealth")
+	}
+
+	var r0 loadbalancer.GetBackendSetHealthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, loadbalancer.GetBackendSetHealthRequest) (loadbalancer.GetBackendSetHealthResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, loadbalancer.GetBackendSetHealthRequest) loadbalancer.GetBackendSetHealthResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Get(0).(loadbalancer.GetBackendSetHealthResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, loadbalancer.GetBackendSetHealthRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockociLoadBalancerClient_GetBackendSetHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBackendSetHealth'
+type MockociLoadBalancerClient_GetBackendSetHealth_Call struct {
+	*mock.Call
+}
+
+// GetBackendSetHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request loadbalancer.GetBackendSetHealthRequest
+func (_e *MockociLoadBalancerClient_Expecter) GetBackendSetHealth(ctx interface{}, request interface{}) *MockociLoadBalancerClient_GetBackendSetHealth_Call {
+	return &MockociLoadBalancerClient_GetBackendSetHealth_Call{Call: _e.mock.On("GetBackendSetHealth", ctx, request)}
+}
+
+func (_c *MockociLoadBalancerClient_GetBackendSetHealth_Call) Run(run func(ctx context.Context, request loadbalancer.GetBackendSetHealthRequest)) *MockociLoadBalancerClient_GetBackendSetHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(loadbalancer.GetBackendSetHealthRequest))
+	})
+	return _c
+}
+
+func (_c *MockociLoadBalancerClient_GetBackendSetHealth_Call) Return(response loadbalancer.GetBackendSetHealthResponse, err error) *MockociLoadBalancerClient_GetBackendSetHealth_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockociLoadBalancerClient_GetBackendSetHealth_Call) RunAndReturn(run func(context.Context, loadbalancer.GetBackendSetHealthRequest) (loadbalancer.GetBackendSetHealthResponse, error)) *MockociLoadBalancerClient_GetBackendSetHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetHostname provides a mock function with given fields: ctx, request
 func (_m *MockociLoadBalancerClient) GetHostname(ctx context.Context, request loadbalancer.GetHostnameRequest) (loadbalancer.GetHostnameResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -165,26 +165,28 @@ func (_c *MockociLoadBalancerModel_ensureHTTP2ListenerProtocol_Call) RunAndRetur
 	return _c
 }
 
-// makeRoutingRule provides a mock function with given fields: ctx, params
-func (_m *MockociLoadBalancerModel) makeRoutingRule(ctx context.Context, params makeRoutingRuleParams) (loadbalancer.RoutingRule, error) {
+// makeGRPCRoutingRules provides a mock function with given fields: ctx, params
+func (_m *MockociLoadBalancerModel) makeGRPCRoutingRules(ctx context.Context, params makeGRPCRoutingRuleParams) ([]loadbalancer.RoutingRule, error) {
 	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for makeRoutingRule")
+		panic("no return value specified for makeGRPCRoutingRules")
 	}
 
-	var r0 loadbalancer.RoutingRule
+	var r0 []loadbalancer.RoutingRule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, makeRoutingRuleParams) (loadbalancer.RoutingRule, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, makeGRPCRoutingRuleParams) ([]loadbalancer.RoutingRule, error)); ok {
 		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, makeRoutingRuleParams) loadbalancer.RoutingRule); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, makeGRPCRoutingRuleParams) []loadbalancer.RoutingRule); ok {
 		r0 = rf(ctx, params)
 	} else {
-		r0 = ret.Get(0).(loadbalancer.RoutingRule)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]loadbalancer.RoutingRule)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, makeRoutingRuleParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, makeGRPCRoutingRuleParams) error); ok {
 		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
@@ -193,55 +195,57 @@ func (_m *MockociLoadBalancerModel) makeRoutingRule(ctx context.Context, params
 	return r0, r1
 }
 
-// MockociLoadBalancerModel_makeRoutingRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'makeRoutingRule'
-type MockociLoadBalancerModel_makeRoutingRule_Call struct {
+// MockociLoadBalancerModel_makeGRPCRoutingRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'makeGRPCRoutingRules'
+type MockociLoadBalancerModel_makeGRPCRoutingRules_Call struct {
 	*mock.Call
 }
 
-// makeRoutingRule is a helper method to define mock.On call
+// makeGRPCRoutingRules is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params makeRoutingRuleParams
-func (_e *MockociLoadBalancerModel_Expecter) makeRoutingRule(ctx interface{}, params interface{}) *MockociLoadBalancerModel_makeRoutingRule_Call {
-	return &MockociLoadBalancerModel_makeRoutingRule_Call{Call: _e.mock.On("makeRoutingRule", ctx, params)}
+//   - params makeGRPCRoutingRuleParams
+func (_e *MockociLoadBalancerModel_Expecter) makeGRPCRoutingRules(ctx interface{}, params interface{}) *MockociLoadBalancerModel_makeGRPCRoutingRules_Call {
+	return &MockociLoadBalancerModel_makeGRPCRoutingRules_Call{Call: _e.mock.On("makeGRPCRoutingRules", ctx, params)}
 }
 
-func (_c *MockociLoadBalancerModel_makeRoutingRule_Call) Run(run func(ctx context.Context, params makeRoutingRuleParams)) *MockociLoadBalancerModel_makeRoutingRule_Call {
+func (_c *MockociLoadBalancerModel_makeGRPCRoutingRules_Call) Run(run func(ctx context.Context, params makeGRPCRoutingRuleParams)) *MockociLoadBalancerModel_makeGRPCRoutingRules_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(makeRoutingRuleParams))
+		run(args[0].(context.Context), args[1].(makeGRPCRoutingRuleParams))
 	})
 	return _c
 }
 
-func (_c *MockociLoadBalancerModel_makeRoutingRule_Call) Return(_a0 loadbalancer.RoutingRule, _a1 error) *MockociLoadBalancerModel_makeRoutingRule_Call {
+func (_c *MockociLoadBalancerModel_makeGRPCRoutingRules_Call) Return(_a0 []loadbalancer.RoutingRule, _a1 error) *MockociLoadBalancerModel_makeGRPCRoutingRules_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockociLoadBalancerModel_makeRoutingRule_Call) RunAndReturn(run func(context.Context, makeRoutingRuleParams) (loadbalancer.RoutingRule, error)) *MockociLoadBalancerModel_makeRoutingRule_Call {
+func (_c *MockociLoadBalancerModel_makeGRPCRoutingRules_Call) RunAndReturn(run func(context.Context, makeGRPCRoutingRuleParams) ([]loadbalancer.RoutingRule, error)) *MockociLoadBalancerModel_makeGRPCRoutingRules_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// makeGRPCRoutingRule provides a mock function with given fields: ctx, params
-func (_m *MockociLoadBalancerModel) makeGRPCRoutingRule(ctx context.Context, params makeGRPCRoutingRuleParams) (loadbalancer.RoutingRule, error) {
+// makeRoutingRules provides a mock function with given fields: ctx, params
+func (_m *MockociLoadBalancerModel) makeRoutingRules(ctx context.Context, params makeRoutingRuleParams) ([]loadbalancer.RoutingRule, error) {
 	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for makeGRPCRoutingRule")
+		panic("no return value specified for makeRoutingRules")
 	}
 
-	var r0 loadbalancer.RoutingRule
+	var r0 []loadbalancer.RoutingRule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, makeGRPCRoutingRuleParams) (loadbalancer.RoutingRule, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, makeRoutingRuleParams) ([]loadbalancer.RoutingRule, error)); ok {
 		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, makeGRPCRoutingRuleParams) loadbalancer.RoutingRule); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, makeRoutingRuleParams) []loadbalancer.RoutingRule); ok {
 		r0 = rf(ctx, params)
 	} else {
-		r0 = ret.Get(0).(loadbalancer.RoutingRule)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]loadbalancer.RoutingRule)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, makeGRPCRoutingRuleParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, makeRoutingRuleParams) error); ok {
 		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
@@ -250,31 +254,31 @@ func (_m *MockociLoadBalancerModel) makeGRPCRoutingRule(ctx context.Context, par
 	return r0, r1
 }
 
-// MockociLoadBalancerModel_makeGRPCRoutingRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'makeGRPCRoutingRule'
-type MockociLoadBalancerModel_makeGRPCRoutingRule_Call struct {
+// MockociLoadBalancerModel_makeRoutingRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'makeRoutingRules'
+type MockociLoadBalancerModel_makeRoutingRules_Call struct {
 	*mock.Call
 }
 
-// makeGRPCRoutingRule is a helper method to define mock.On call
+// makeRoutingRules is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params makeGRPCRoutingRuleParams
-func (_e *MockociLoadBalancerModel_Expecter) makeGRPCRoutingRule(ctx interface{}, params interface{}) *MockociLoadBalancerModel_makeGRPCRoutingRule_Call {
-	return &MockociLoadBalancerModel_makeGRPCRoutingRule_Call{Call: _e.mock.On("makeGRPCRoutingRule", ctx, params)}
+//   - params makeRoutingRuleParams
+func (_e *MockociLoadBalancerModel_Expecter) makeRoutingRules(ctx interface{}, params interface{}) *MockociLoadBalancerModel_makeRoutingRules_Call {
+	return &MockociLoadBalancerModel_makeRoutingRules_Call{Call: _e.mock.On("makeRoutingRules", ctx, params)}
 }
 
-func (_c *MockociLoadBalancerModel_makeGRPCRoutingRule_Call) Run(run func(ctx context.Context, params makeGRPCRoutingRuleParams)) *MockociLoadBalancerModel_makeGRPCRoutingRule_Call {
+func (_c *MockociLoadBalancerModel_makeRoutingRules_Call) Run(run func(ctx context.Context, params makeRoutingRuleParams)) *MockociLoadBalancerModel_makeRoutingRules_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(makeGRPCRoutingRuleParams))
+		run(args[0].(context.Context), args[1].(makeRoutingRuleParams))
 	})
 	return _c
 }
 
-func (_c *MockociLoadBalancerModel_makeGRPCRoutingRule_Call) Return(_a0 loadbalancer.RoutingRule, _a1 error) *MockociLoadBalancerModel_makeGRPCRoutingRule_Call {
+func (_c *MockociLoadBalancerModel_makeRoutingRules_Call) Return(_a0 []loadbalancer.RoutingRule, _a1 error) *MockociLoadBalancerModel_makeRoutingRules_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockociLoadBalancerModel_makeGRPCRoutingRule_Call) RunAndReturn(run func(context.Context, makeGRPCRoutingRuleParams) (loadbalancer.RoutingRule, error)) *MockociLoadBalancerModel_makeGRPCRoutingRule_Call {
+func (_c *MockociLoadBalancerModel_makeRoutingRules_Call) RunAndReturn(run func(context.Context, makeRoutingRuleParams) ([]loadbalancer.RoutingRule, error)) *MockociLoadBalancerModel_makeRoutingRules_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -430,6 +434,53 @@ func (_c *MockociLoadBalancerModel_reconcileHTTPListener_Call) RunAndReturn(run
 	return _c
 }
 
+// reconcileListenerRuleSet provides a mock function with given fields: ctx, params
+func (_m *MockociLoadBalancerModel) reconcileListenerRuleSet(ctx context.Context, params reconcileListenerRuleSetParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for reconcileListenerRuleSet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, reconcileListenerRuleSetParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockociLoadBalancerModel_reconcileListenerRuleSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'reconcileListenerRuleSet'
+type MockociLoadBalancerModel_reconcileListenerRuleSet_Call struct {
+	*mock.Call
+}
+
+// reconcileListenerRuleSet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params reconcileListenerRuleSetParams
+func (_e *MockociLoadBalancerModel_Expecter) reconcileListenerRuleSet(ctx interface{}, params interface{}) *MockociLoadBalancerModel_reconcileListenerRuleSet_Call {
+	return &MockociLoadBalancerModel_reconcileListenerRuleSet_Call{Call: _e.mock.On("reconcileListenerRuleSet", ctx, params)}
+}
+
+func (_c *MockociLoadBalancerModel_reconcileListenerRuleSet_Call) Run(run func(ctx context.Context, params reconcileListenerRuleSetParams)) *MockociLoadBalancerModel_reconcileListenerRuleSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(reconcileListenerRuleSetParams))
+	})
+	return _c
+}
+
+func (_c *MockociLoadBalancerModel_reconcileListenerRuleSet_Call) Return(_a0 error) *MockociLoadBalancerModel_reconcileListenerRuleSet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockociLoadBalancerModel_reconcileListenerRuleSet_Call) RunAndReturn(run func(context.Context, reconcileListenerRuleSetParams) error) *MockociLoadBalancerModel_reconcileListenerRuleSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // reconcileListenersCertificates provides a mock function with given fields: ctx, params
 func (_m *MockociLoadBalancerModel) reconcileListenersCertificates(ctx context.Context, params reconcileListenersCertificatesParams) (reconcileListenersCertificatesResult, error) {
 	ret := _m.Called(ctx, params)
@@ -487,6 +538,53 @@ func (_c *MockociLoadBalancerModel_reconcileListenersCertificates_Call) RunAndRe
 	return _c
 }
 
+// reconcileRouteRedirectRuleSet provides a mock function with given fields: ctx, params
+func (_m *MockociLoadBalancerModel) reconcileRouteRedirectRuleSet(ctx context.Context, params reconcileRouteRedirectRuleSetParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for reconcileRouteRedirectRuleSet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, reconcileRouteRedirectRuleSetParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockociLoadBalancerModel_reconcileRouteRedirectRuleSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'reconcileRouteRedirectRuleSet'
+type MockociLoadBalancerModel_reconcileRouteRedirectRuleSet_Call struct {
+	*mock.Call
+}
+
+// reconcileRouteRedirectRuleSet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params reconcileRouteRedirectRuleSetParams
+func (_e *MockociLoadBalancerModel_Expecter) reconcileRouteRedirectRuleSet(ctx interface{}, params interface{}) *MockociLoadBalancerModel_reconcileRouteRedirectRuleSet_Call {
+	return &MockociLoadBalancerModel_reconcileRouteRedirectRuleSet_Call{Call: _e.mock.On("reconcileRouteRedirectRuleSet", ctx, params)}
+}
+
+func (_c *MockociLoadBalancerModel_reconcileRouteRedirectRuleSet_Call) Run(run func(ctx context.Context, params reconcileRouteRedirectRuleSetParams)) *MockociLoadBalancerModel_reconcileRouteRedirectRuleSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(reconcileRouteRedirectRuleSetParams))
+	})
+	return _c
+}
+
+func (_c *MockociLoadBalancerModel_reconcileRouteRedirectRuleSet_Call) Return(_a0 error) *MockociLoadBalancerModel_reconcileRouteRedirectRuleSet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockociLoadBalancerModel_reconcileRouteRedirectRuleSet_Call) RunAndReturn(run func(context.Context, reconcileRouteRedirectRuleSetParams) error) *MockociLoadBalancerModel_reconcileRouteRedirectRuleSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // removeMissingListeners provides a mock function with given fields: ctx, params
 func (_m *MockociLoadBalancerModel) removeMissingListeners(ctx context.Context, params removeMissingListenersParams) error {
 	ret := _m.Called(ctx, params)
@@ -581,6 +679,53 @@ func (_c *MockociLoadBalancerModel_removeUnusedCertificates_Call) RunAndReturn(r
 	return _c
 }
 
+// removeUnusedListenerRuleSet provides a mock function with given fields: ctx, params
+func (_m *MockociLoadBalancerModel) removeUnusedListenerRuleSet(ctx context.Context, params reconcileListenerRuleSetParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for removeUnusedListenerRuleSet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, reconcileListenerRuleSetParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockociLoadBalancerModel_removeUnusedListenerRuleSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'removeUnusedListenerRuleSet'
+type MockociLoadBalancerModel_removeUnusedListenerRuleSet_Call struct {
+	*mock.Call
+}
+
+// removeUnusedListenerRuleSet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params reconcileListenerRuleSetParams
+func (_e *MockociLoadBalancerModel_Expecter) removeUnusedListenerRuleSet(ctx interface{}, params interface{}) *MockociLoadBalancerModel_removeUnusedListenerRuleSet_Call {
+	return &MockociLoadBalancerModel_removeUnusedListenerRuleSet_Call{Call: _e.mock.On("removeUnusedListenerRuleSet", ctx, params)}
+}
+
+func (_c *MockociLoadBalancerModel_removeUnusedListenerRuleSet_Call) Run(run func(ctx context.Context, params reconcileListenerRuleSetParams)) *MockociLoadBalancerModel_removeUnusedListenerRuleSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(reconcileListenerRuleSetParams))
+	})
+	return _c
+}
+
+func (_c *MockociLoadBalancerModel_removeUnusedListenerRuleSet_Call) Return(_a0 error) *MockociLoadBalancerModel_removeUnusedListenerRuleSet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockociLoadBalancerModel_removeUnusedListenerRuleSet_Call) RunAndReturn(run func(context.Context, reconcileListenerRuleSetParams) error) *MockociLoadBalancerModel_removeUnusedListenerRuleSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockociLoadBalancerModel creates a new instance of MockociLoadBalancerModel. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockociLoadBalancerModel(t interface {
@@ -84,6 +84,7 @@ func (r *NetworkLoadBalancerGatewayController) Reconcile(
 	if !relevant {
 		return reconcile.Result{}, nil
 	}
+	ctx = ociRegionContext(ctx, data.config)
 
 	if data.gateway.DeletionTimestamp != nil {
 		if !lo.Contains(data.gateway.Finalizers, NetworkLoadBalancerGatewayProgrammedFinalizer) {
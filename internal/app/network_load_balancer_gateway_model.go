@@ -271,6 +271,17 @@ func (m *networkLoadBalancerGatewayModelImpl) resolveReconcileRequest(
 		return false, fmt.Errorf("failed to get GatewayConfig %s: %w", configName, err)
 	}
 
+	if receiver.gateway.DeletionTimestamp == nil {
+		if err := validateGatewaySupportedFeatures(receiver.gateway, NetworkLoadBalancerControllerClassName); err != nil {
+			return false, err
+		}
+		if err := validateGatewayConfigLoadBalancerType(
+			receiver.config, NetworkLoadBalancerControllerClassName,
+		); err != nil {
+			return false, err
+		}
+	}
+
 	return true, nil
 }
 
@@ -615,6 +626,11 @@ func (m *networkLoadBalancerGatewayModelImpl) programGateway(
 	if busyErr := networkLoadBalancerBusyErrorFromState(nlb); busyErr != nil {
 		return busyErr
 	}
+	if err = checkGatewaySpecAddressesUsable(
+		data.gateway, *nlb.Id, gatewayStatusAddressesFromNetworkLoadBalancer(nlb),
+	); err != nil {
+		return err
+	}
 	annotations := data.gateway.GetAnnotations()
 	if annotations == nil {
 		annotations = map[string]string{}
@@ -0,0 +1,482 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/dns"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	"go.uber.org/dig"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+const (
+	ociDNSRecordTypeA    = "A"
+	ociDNSRecordTypeAAAA = "AAAA"
+	ociDNSRecordTypeTXT  = "TXT"
+	defaultDNSRecordTTL  = 300
+)
+
+// Records of the hostname are owned by the gateway named in the TXT record of the owner domain.
+// The owner domain is the hostname prefixed with dnsOwnerRecordPrefix, so TXT records of the
+// hostname itself are not touched.
+const (
+	dnsOwnerRecordPrefix   = "_oke-gateway-api."
+	dnsOwnerRecordHeritage = "heritage=oke-gateway-api"
+)
+
+// gatewayDNSRecords identifies DNS records managed by the controller for the gateway.
+type gatewayDNSRecords struct {
+	zoneID    string
+	hostnames []string
+}
+
+type reconcileListenersDNSRecordsParams struct {
+	gateway *gatewayv1.Gateway
+
+	// dns is the DNS config of the gateway, nil if records of the gateway are no longer managed
+	dns *types.GatewayConfigDNS
+
+	// hostnameAddresses holds IP addresses of the load balancer serving each listener hostname
+	hostnameAddresses map[string][]string
+
+	// programmedRecords are the records managed for the gateway by the previous programming
+	programmedRecords gatewayDNSRecords
+}
+
+type ociDNSModel interface {
+	// reconcileListenersDNSRecords makes sure the DNS zone has A and AAAA records of the listener
+	// hostnames pointing to the load balancer addresses. Records previously managed for hostnames
+	// the gateway no longer serves are removed. Hostnames outside of the zone are skipped.
+	reconcileListenersDNSRecords(ctx context.Context, params reconcileListenersDNSRecordsParams) error
+}
+
+type ociDNSModelImpl struct {
+	logger    *slog.Logger
+	ociClient ociDNSClient
+}
+
+func (m *ociDNSModelImpl) reconcileListenersDNSRecords(
+	ctx context.Context,
+	params reconcileListenersDNSRecordsParams,
+) error {
+	var zoneID, zoneName string
+	desiredHostnames := make(map[string]struct{}, len(params.hostnameAddresses))
+	if params.dns != nil {
+		zoneID = params.dns.ZoneID
+		var found bool
+		var err error
+		if zoneName, found, err = m.getZoneName(ctx, zoneID); err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("DNS zone %s not found", zoneID)
+		}
+
+		ttl := int(lo.CoalesceOrEmpty(params.dns.TTL, defaultDNSRecordTTL))
+		hostnames := lo.Keys(params.hostnameAddresses)
+		slices.Sort(hostnames)
+		for _, hostname := range hostnames {
+			if !dnsHostnameInZone(hostname, zoneName) {
+				m.logger.WarnContext(ctx, "Skipping DNS records of hostname outside of the zone",
+					slog.String("gateway", params.gateway.Namespace+"/"+params.gateway.Name),
+					slog.String("hostname", hostname),
+					slog.String("zone", zoneName),
+				)
+				continue
+			}
+			desiredHostnames[hostname] = struct{}{}
+			if err = m.reconcileHostnameRecords(ctx, reconcileHostnameRecordsParams{
+				gateway:    params.gateway,
+				zoneID:     zoneID,
+				hostname:   hostname,
+				addresses:  params.hostnameAddresses[hostname],
+				ttl:        ttl,
+				programmed: params.programmedRecords.has(zoneID, hostname),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return m.removeStaleRecords(ctx, params.gateway, params.programmedRecords, zoneID, zoneName, desiredHostnames)
+}
+
+// removeStaleRecords removes records of previously managed hostnames that are not desired
+// in the current zone. All records are stale if the zone has changed.
+func (m *ociDNSModelImpl) removeStaleRecords(
+	ctx context.Context,
+	gateway *gatewayv1.Gateway,
+	programmedRecords gatewayDNSRecords,
+	zoneID, zoneName string,
+	desiredHostnames map[string]struct{},
+) error {
+	staleHostnames := lo.Filter(programmedRecords.hostnames, func(hostname string, _ int) bool {
+		_, desired := desiredHostnames[hostname]
+		return programmedRecords.zoneID != zoneID || !desired
+	})
+	if len(staleHostnames) == 0 {
+		return nil
+	}
+
+	if programmedRecords.zoneID != zoneID {
+		var found bool
+		var err error
+		if zoneName, found, err = m.getZoneName(ctx, programmedRecords.zoneID); err != nil {
+			return err
+		}
+		if !found {
+			// Records are gone together with the zone
+			return nil
+		}
+	}
+
+	for _, hostname := range staleHostnames {
+		if !dnsHostnameInZone(hostname, zoneName) {
+			continue
+		}
+		if err := m.reconcileHostnameRecords(ctx, reconcileHostnameRecordsParams{
+			gateway:    gateway,
+			zoneID:     programmedRecords.zoneID,
+			hostname:   hostname,
+			programmed: true,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *ociDNSModelImpl) getZoneName(ctx context.Context, zoneID string) (string, bool, error) {
+	response, err := m.ociClient.GetZone(ctx, dns.GetZoneRequest{ZoneNameOrId: &zoneID})
+	if err != nil {
+		if serviceErr, ok := common.IsServiceError(err); ok &&
+			serviceErr.GetHTTPStatusCode() == http.StatusNotFound {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("failed to get DNS zone %s: %w", zoneID, err)
+	}
+	return strings.TrimSuffix(lo.FromPtr(response.Name), "."), true, nil
+}
+
+type reconcileHostnameRecordsParams struct {
+	gateway  *gatewayv1.Gateway
+	zoneID   string
+	hostname string
+
+	// addresses are empty if records of the hostname are removed
+	addresses []string
+	ttl       int
+
+	// programmed is set if the previous programming managed records of the hostname for the gateway.
+	// Such records are adopted if not marked, they were created before owner records were introduced.
+	programmed bool
+}
+
+// reconcileHostnameRecords makes A and AAAA records of the hostname match the addresses.
+// Record sets without addresses of their type are deleted. Records owned by others or created
+// outside of the controller are not touched.
+func (m *ociDNSModelImpl) reconcileHostnameRecords(
+	ctx context.Context,
+	params reconcileHostnameRecordsParams,
+) error {
+	zoneID, hostname := params.zoneID, params.hostname
+	existingRecords, err := m.getDomainRecords(ctx, zoneID, hostname)
+	if err != nil {
+		return err
+	}
+	ownerDomain := dnsOwnerRecordDomain(hostname)
+	ownerRecords, err := m.getDomainRecords(ctx, zoneID, ownerDomain)
+	if err != nil {
+		return err
+	}
+
+	owner := dnsOwnerRecordValue(params.gateway)
+	currentOwner := dnsRecordsOwner(ownerRecords)
+	ownedByOthers := currentOwner != "" && currentOwner != owner
+	unmarked := currentOwner == "" && !params.programmed && lo.SomeBy(existingRecords, isDNSAddressRecord)
+	if ownedByOthers || unmarked {
+		if len(params.addresses) == 0 {
+			m.logger.InfoContext(ctx, "Keeping DNS records not owned by the gateway",
+				slog.String("zoneId", zoneID),
+				slog.String("hostname", hostname),
+				slog.String("owner", currentOwner),
+			)
+			return nil
+		}
+		return &resourceStatusError{
+			conditionType: string(gatewayv1.GatewayConditionProgrammed),
+			reason:        string(gatewayv1.GatewayReasonInvalid),
+			message: fmt.Sprintf(
+				"DNS records of %s are not owned by the Gateway, remove them or set the %s %s record to %s",
+				hostname, ownerDomain, ociDNSRecordTypeTXT, strconv.Quote(owner),
+			),
+		}
+	}
+
+	if currentOwner == "" && len(params.addresses) > 0 {
+		// Records are marked first, so they are known to be owned even if the update fails half way
+		if err = m.updateRecords(ctx, zoneID, ownerDomain, ociDNSRecordTypeTXT,
+			[]string{strconv.Quote(owner)}, params.ttl); err != nil {
+			return err
+		}
+	}
+
+	desiredRdata := dnsRecordsRdataByType(params.addresses)
+	for _, rtype := range []string{ociDNSRecordTypeA, ociDNSRecordTypeAAAA} {
+		existing := lo.Filter(existingRecords, func(record dns.Record, _ int) bool {
+			return lo.FromPtr(record.Rtype) == rtype
+		})
+		desired := desiredRdata[rtype]
+		switch {
+		case len(desired) == 0 && len(existing) == 0:
+			continue
+		case len(desired) == 0:
+			if err = m.deleteRecords(ctx, zoneID, hostname, rtype); err != nil {
+				return err
+			}
+		case !dnsRecordsUpToDate(existing, desired, params.ttl):
+			if err = m.updateRecords(ctx, zoneID, hostname, rtype, desired, params.ttl); err != nil {
+				return err
+			}
+		}
+	}
+
+	if currentOwner != "" && len(params.addresses) == 0 {
+		return m.deleteRecords(ctx, zoneID, ownerDomain, ociDNSRecordTypeTXT)
+	}
+	return nil
+}
+
+func (m *ociDNSModelImpl) getDomainRecords(
+	ctx context.Context,
+	zoneID string,
+	hostname string,
+) ([]dns.Record, error) {
+	var result []dns.Record
+	var page *string
+	for {
+		response, err := m.ociClient.GetDomainRecords(ctx, dns.GetDomainRecordsRequest{
+			ZoneNameOrId: &zoneID,
+			Domain:       &hostname,
+			Page:         page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get DNS records of %s in zone %s: %w", hostname, zoneID, err)
+		}
+		result = append(result, response.Items...)
+
+		if response.OpcNextPage == nil {
+			return result, nil
+		}
+		page = response.OpcNextPage
+	}
+}
+
+func (m *ociDNSModelImpl) updateRecords(
+	ctx context.Context,
+	zoneID string,
+	hostname string,
+	rtype string,
+	rdata []string,
+	ttl int,
+) error {
+	m.logger.InfoContext(ctx, "Updating DNS records",
+		slog.String("zoneId", zoneID),
+		slog.String("hostname", hostname),
+		slog.String("rtype", rtype),
+		slog.Any("rdata", rdata),
+	)
+
+	if _, err := m.ociClient.UpdateRRSet(ctx, dns.UpdateRRSetRequest{
+		ZoneNameOrId: &zoneID,
+		Domain:       &hostname,
+		Rtype:        &rtype,
+		UpdateRrSetDetails: dns.UpdateRrSetDetails{
+			Items: lo.Map(rdata, func(value string, _ int) dns.RecordDetails {
+				return dns.RecordDetails{
+					Domain: &hostname,
+					Rtype:  &rtype,
+					Rdata:  new(value),
+					Ttl:    &ttl,
+				}
+			}),
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to update %s records of %s in zone %s: %w", rtype, hostname, zoneID, err)
+	}
+	return nil
+}
+
+func (m *ociDNSModelImpl) deleteRecords(ctx context.Context, zoneID, hostname, rtype string) error {
+	m.logger.InfoContext(ctx, "Deleting stale DNS records",
+		slog.String("zoneId", zoneID),
+		slog.String("hostname", hostname),
+		slog.String("rtype", rtype),
+	)
+
+	if _, err := m.ociClient.DeleteRRSet(ctx, dns.DeleteRRSetRequest{
+		ZoneNameOrId: &zoneID,
+		Domain:       &hostname,
+		Rtype:        &rtype,
+	}); err != nil {
+		return fmt.Errorf("failed to delete %s records of %s in zone %s: %w", rtype, hostname, zoneID, err)
+	}
+	return nil
+}
+
+// dnsRecordsRdataByType groups the addresses by the record type, A for IPv4 and AAAA for IPv6.
+func dnsRecordsRdataByType(addresses []string) map[string][]string {
+	result := make(map[string][]string)
+	for _, address := range addresses {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			continue
+		}
+		rtype := ociDNSRecordTypeAAAA
+		if ip.To4() != nil {
+			rtype = ociDNSRecordTypeA
+		}
+		result[rtype] = append(result[rtype], ip.String())
+	}
+	for rtype, rdata := range result {
+		slices.Sort(rdata)
+		result[rtype] = slices.Compact(rdata)
+	}
+	return result
+}
+
+func dnsRecordsUpToDate(existing []dns.Record, desiredRdata []string, ttl int) bool {
+	existingRdata := make([]string, 0, len(existing))
+	for _, record := range existing {
+		if lo.FromPtr(record.Ttl) != ttl {
+			return false
+		}
+		rdata := lo.FromPtr(record.Rdata)
+		if ip := net.ParseIP(rdata); ip != nil {
+			rdata = ip.String()
+		}
+		existingRdata = append(existingRdata, rdata)
+	}
+	slices.Sort(existingRdata)
+	return slices.Equal(existingRdata, desiredRdata)
+}
+
+func isDNSAddressRecord(record dns.Record) bool {
+	rtype := lo.FromPtr(record.Rtype)
+	return rtype == ociDNSRecordTypeA || rtype == ociDNSRecordTypeAAAA
+}
+
+// dnsOwnerRecordDomain returns the domain of the TXT record holding the owner of the hostname records.
+// The wildcard label is replaced, it is only allowed as the leftmost label.
+func dnsOwnerRecordDomain(hostname string) string {
+	return dnsOwnerRecordPrefix + strings.Replace(hostname, "*", "_wildcard", 1)
+}
+
+// dnsOwnerRecordValue returns the owner of the hostname records managed for the gateway.
+func dnsOwnerRecordValue(gateway *gatewayv1.Gateway) string {
+	return fmt.Sprintf("%s,gateway=%s/%s", dnsOwnerRecordHeritage, gateway.Namespace, gateway.Name)
+}
+
+// dnsRecordsOwner returns the owner held by the owner domain records, empty if records are not owned.
+func dnsRecordsOwner(ownerRecords []dns.Record) string {
+	for _, record := range ownerRecords {
+		value := strings.Trim(lo.FromPtr(record.Rdata), `"`)
+		if lo.FromPtr(record.Rtype) == ociDNSRecordTypeTXT && strings.HasPrefix(value, dnsOwnerRecordHeritage+",") {
+			return value
+		}
+	}
+	return ""
+}
+
+func dnsHostnameInZone(hostname, zoneName string) bool {
+	hostname = strings.ToLower(hostname)
+	zoneName = strings.ToLower(zoneName)
+	return hostname == zoneName || strings.HasSuffix(hostname, "."+zoneName)
+}
+
+// listenerHostnameAddresses returns IP addresses of the load balancer serving each listener hostname.
+// Hostnames of internal listeners point to the internal load balancer.
+func listenerHostnameAddresses(data *resolvedGatewayDetails) map[string][]string {
+	result := make(map[string][]string)
+	for _, listener := range data.gateway.Spec.Listeners {
+		if listener.Hostname == nil || *listener.Hostname == "" {
+			continue
+		}
+		loadBalancer := data.loadBalancer
+		if gatewayListenerInternal(data.config, listener.Name) && data.internalLoadBalancer != nil {
+			loadBalancer = data.internalLoadBalancer
+		}
+		hostname := strings.ToLower(string(*listener.Hostname))
+		result[hostname] = append(result[hostname], loadBalancerIPAddresses(loadBalancer)...)
+	}
+	return result
+}
+
+func loadBalancerIPAddresses(loadBalancer *loadbalancer.LoadBalancer) []string {
+	if loadBalancer == nil {
+		return nil
+	}
+	addresses := make([]string, 0, len(loadBalancer.IpAddresses))
+	for _, ipAddress := range loadBalancer.IpAddresses {
+		if address := lo.FromPtr(ipAddress.IpAddress); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// gatewayDNSRecordsAnnotationValue returns the value of the GatewayDNSRecordsAnnotation.
+func gatewayDNSRecordsAnnotationValue(gateway gatewayv1.Gateway, config types.GatewayConfig) string {
+	if config.Spec.DNS == nil {
+		return ""
+	}
+	hostnames := make([]string, 0, len(gateway.Spec.Listeners))
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.Hostname != nil && *listener.Hostname != "" {
+			hostnames = append(hostnames, strings.ToLower(string(*listener.Hostname)))
+		}
+	}
+	slices.Sort(hostnames)
+	return config.Spec.DNS.ZoneID + "=" + strings.Join(slices.Compact(hostnames), ",")
+}
+
+func (r gatewayDNSRecords) has(zoneID, hostname string) bool {
+	return r.zoneID == zoneID && slices.Contains(r.hostnames, hostname)
+}
+
+func parseGatewayDNSRecordsAnnotation(value string) gatewayDNSRecords {
+	zoneID, hostnames, found := strings.Cut(value, "=")
+	if !found || zoneID == "" {
+		return gatewayDNSRecords{}
+	}
+	result := gatewayDNSRecords{zoneID: zoneID}
+	if hostnames != "" {
+		result.hostnames = strings.Split(hostnames, ",")
+	}
+	return result
+}
+
+type ociDNSModelDeps struct {
+	dig.In
+
+	RootLogger *slog.Logger
+	OciClient  ociDNSClient
+}
+
+func newOciDNSModel(deps ociDNSModelDeps) *ociDNSModelImpl {
+	return &ociDNSModelImpl{
+		logger:    deps.RootLogger.WithGroup("oci-dns-model"),
+		ociClient: deps.OciClient,
+	}
+}
@@ -0,0 +1,480 @@
+package app
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/dns"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestOciDNSModelImpl(t *testing.T) {
+	newMockDeps := func(t *testing.T) ociDNSModelDeps {
+		return ociDNSModelDeps{
+			RootLogger: diag.RootTestLogger(),
+			OciClient:  NewMockociDNSClient(t),
+		}
+	}
+
+	makeRecord := func(hostname, rtype, rdata string, ttl int) dns.Record {
+		return dns.Record{
+			Domain: &hostname,
+			Rtype:  &rtype,
+			Rdata:  &rdata,
+			Ttl:    &ttl,
+		}
+	}
+
+	expectZone := func(t *testing.T, ociClient *MockociDNSClient, zoneID, zoneName string) {
+		ociClient.EXPECT().
+			GetZone(t.Context(), dns.GetZoneRequest{ZoneNameOrId: &zoneID}).
+			Return(dns.GetZoneResponse{Zone: dns.Zone{Name: &zoneName}}, nil).
+			Once()
+	}
+
+	expectDomainRecords := func(
+		t *testing.T,
+		ociClient *MockociDNSClient,
+		zoneID, hostname string,
+		records ...dns.Record,
+	) {
+		ociClient.EXPECT().
+			GetDomainRecords(t.Context(), dns.GetDomainRecordsRequest{
+				ZoneNameOrId: &zoneID,
+				Domain:       &hostname,
+			}).
+			Return(dns.GetDomainRecordsResponse{
+				RecordCollection: dns.RecordCollection{Items: records},
+			}, nil).
+			Once()
+	}
+
+	makeOwnerRecord := func(hostname string, gateway *gatewayv1.Gateway) dns.Record {
+		return makeRecord(
+			dnsOwnerRecordDomain(hostname),
+			ociDNSRecordTypeTXT,
+			`"`+dnsOwnerRecordValue(gateway)+`"`,
+			defaultDNSRecordTTL,
+		)
+	}
+
+	t.Run("reconcileListenersDNSRecords", func(t *testing.T) {
+		t.Run("updates records of hostnames in the zone", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciDNSModel(deps)
+			zoneID := faker.New().UUID().V4()
+			gateway := newRandomGateway()
+			const ttl = 60
+
+			ociClient, _ := deps.OciClient.(*MockociDNSClient)
+			expectZone(t, ociClient, zoneID, "example.com")
+			expectDomainRecords(t, ociClient, zoneID, "app.example.com",
+				makeRecord("app.example.com", ociDNSRecordTypeA, "10.0.0.1", ttl),
+				makeRecord("app.example.com", ociDNSRecordTypeAAAA, "2001:db8::1", ttl),
+				makeRecord("app.example.com", ociDNSRecordTypeTXT, "owner", ttl),
+			)
+			expectDomainRecords(t, ociClient, zoneID, dnsOwnerRecordDomain("app.example.com"),
+				makeOwnerRecord("app.example.com", gateway),
+			)
+			ociClient.EXPECT().
+				UpdateRRSet(t.Context(), dns.UpdateRRSetRequest{
+					ZoneNameOrId: &zoneID,
+					Domain:       new("app.example.com"),
+					Rtype:        new(ociDNSRecordTypeA),
+					UpdateRrSetDetails: dns.UpdateRrSetDetails{
+						Items: []dns.RecordDetails{
+							{
+								Domain: new("app.example.com"),
+								Rtype:  new(ociDNSRecordTypeA),
+								Rdata:  new("10.0.0.2"),
+								Ttl:    new(ttl),
+							},
+						},
+					},
+				}).
+				Return(dns.UpdateRRSetResponse{}, nil).
+				Once()
+			ociClient.EXPECT().
+				DeleteRRSet(t.Context(), dns.DeleteRRSetRequest{
+					ZoneNameOrId: &zoneID,
+					Domain:       new("app.example.com"),
+					Rtype:        new(ociDNSRecordTypeAAAA),
+				}).
+				Return(dns.DeleteRRSetResponse{}, nil).
+				Once()
+
+			err := model.reconcileListenersDNSRecords(t.Context(), reconcileListenersDNSRecordsParams{
+				gateway: gateway,
+				dns:     &types.GatewayConfigDNS{ZoneID: zoneID, TTL: ttl},
+				hostnameAddresses: map[string][]string{
+					"app.example.com":   {"10.0.0.2"},
+					"app.other-zone.io": {"10.0.0.2"},
+				},
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("keeps up to date records", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciDNSModel(deps)
+			zoneID := faker.New().UUID().V4()
+			gateway := newRandomGateway()
+
+			ociClient, _ := deps.OciClient.(*MockociDNSClient)
+			expectZone(t, ociClient, zoneID, "example.com.")
+			expectDomainRecords(t, ociClient, zoneID, "example.com",
+				makeRecord("example.com", ociDNSRecordTypeA, "10.0.0.1", defaultDNSRecordTTL),
+				makeRecord("example.com", ociDNSRecordTypeAAAA, "2001:0db8::0001", defaultDNSRecordTTL),
+			)
+			expectDomainRecords(t, ociClient, zoneID, dnsOwnerRecordDomain("example.com"),
+				makeOwnerRecord("example.com", gateway),
+			)
+
+			err := model.reconcileListenersDNSRecords(t.Context(), reconcileListenersDNSRecordsParams{
+				gateway: gateway,
+				dns:     &types.GatewayConfigDNS{ZoneID: zoneID},
+				hostnameAddresses: map[string][]string{
+					"example.com": {"2001:db8::1", "10.0.0.1"},
+				},
+				programmedRecords: gatewayDNSRecords{zoneID: zoneID, hostnames: []string{"example.com"}},
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("removes records of hostnames no longer served", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciDNSModel(deps)
+			zoneID := faker.New().UUID().V4()
+			gateway := newRandomGateway()
+
+			ociClient, _ := deps.OciClient.(*MockociDNSClient)
+			expectZone(t, ociClient, zoneID, "example.com")
+			expectDomainRecords(t, ociClient, zoneID, "old.example.com",
+				makeRecord("old.example.com", ociDNSRecordTypeA, "10.0.0.1", defaultDNSRecordTTL),
+			)
+			expectDomainRecords(t, ociClient, zoneID, dnsOwnerRecordDomain("old.example.com"),
+				makeOwnerRecord("old.example.com", gateway),
+			)
+			deleteRecordsCall := ociClient.EXPECT().
+				DeleteRRSet(t.Context(), dns.DeleteRRSetRequest{
+					ZoneNameOrId: &zoneID,
+					Domain:       new("old.example.com"),
+					Rtype:        new(ociDNSRecordTypeA),
+				}).
+				Return(dns.DeleteRRSetResponse{}, nil).
+				Once()
+			ociClient.EXPECT().
+				DeleteRRSet(t.Context(), dns.DeleteRRSetRequest{
+					ZoneNameOrId: &zoneID,
+					Domain:       new(dnsOwnerRecordDomain("old.example.com")),
+					Rtype:        new(ociDNSRecordTypeTXT),
+				}).
+				Return(dns.DeleteRRSetResponse{}, nil).
+				Once().
+				NotBefore(deleteRecordsCall)
+
+			err := model.reconcileListenersDNSRecords(t.Context(), reconcileListenersDNSRecordsParams{
+				gateway:           gateway,
+				dns:               &types.GatewayConfigDNS{ZoneID: zoneID},
+				programmedRecords: gatewayDNSRecords{zoneID: zoneID, hostnames: []string{"old.example.com"}},
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("marks new records with the owner", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciDNSModel(deps)
+			zoneID := faker.New().UUID().V4()
+			gateway := newRandomGateway()
+			ownerDomain := dnsOwnerRecordDomain("*.example.com")
+
+			ociClient, _ := deps.OciClient.(*MockociDNSClient)
+			expectZone(t, ociClient, zoneID, "example.com")
+			expectDomainRecords(t, ociClient, zoneID, "*.example.com")
+			expectDomainRecords(t, ociClient, zoneID, ownerDomain)
+			markCall := ociClient.EXPECT().
+				UpdateRRSet(t.Context(), dns.UpdateRRSetRequest{
+					ZoneNameOrId: &zoneID,
+					Domain:       &ownerDomain,
+					Rtype:        new(ociDNSRecordTypeTXT),
+					UpdateRrSetDetails: dns.UpdateRrSetDetails{
+						Items: []dns.RecordDetails{
+							{
+								Domain: &ownerDomain,
+								Rtype:  new(ociDNSRecordTypeTXT),
+								Rdata:  new(`"` + dnsOwnerRecordValue(gateway) + `"`),
+								Ttl:    new(defaultDNSRecordTTL),
+							},
+						},
+					},
+				}).
+				Return(dns.UpdateRRSetResponse{}, nil).
+				Once()
+			ociClient.EXPECT().
+				UpdateRRSet(t.Context(), mock.MatchedBy(func(req dns.UpdateRRSetRequest) bool {
+					return *req.Domain == "*.example.com" && *req.Rtype == ociDNSRecordTypeA
+				})).
+				Return(dns.UpdateRRSetResponse{}, nil).
+				Once().
+				NotBefore(markCall)
+
+			err := model.reconcileListenersDNSRecords(t.Context(), reconcileListenersDNSRecordsParams{
+				gateway:           gateway,
+				dns:               &types.GatewayConfigDNS{ZoneID: zoneID},
+				hostnameAddresses: map[string][]string{"*.example.com": {"10.0.0.1"}},
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, "_oke-gateway-api._wildcard.example.com", ownerDomain)
+		})
+
+		t.Run("rejects records not owned by the gateway", func(t *testing.T) {
+			gateway := newRandomGateway()
+			otherGateway := newRandomGateway()
+			tests := []struct {
+				name         string
+				ownerRecords []dns.Record
+			}{
+				{name: "not marked"},
+				{name: "owned by other gateway", ownerRecords: []dns.Record{
+					makeOwnerRecord("app.example.com", otherGateway),
+				}},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					deps := newMockDeps(t)
+					model := newOciDNSModel(deps)
+					zoneID := faker.New().UUID().V4()
+
+					ociClient, _ := deps.OciClient.(*MockociDNSClient)
+					expectZone(t, ociClient, zoneID, "example.com")
+					expectDomainRecords(t, ociClient, zoneID, "app.example.com",
+						makeRecord("app.example.com", ociDNSRecordTypeA, "10.0.0.1", defaultDNSRecordTTL),
+					)
+					expectDomainRecords(t, ociClient, zoneID, dnsOwnerRecordDomain("app.example.com"),
+						tt.ownerRecords...,
+					)
+
+					err := model.reconcileListenersDNSRecords(t.Context(), reconcileListenersDNSRecordsParams{
+						gateway:           gateway,
+						dns:               &types.GatewayConfigDNS{ZoneID: zoneID},
+						hostnameAddresses: map[string][]string{"app.example.com": {"10.0.0.2"}},
+					})
+
+					var statusErr *resourceStatusError
+					require.ErrorAs(t, err, &statusErr)
+					assert.Equal(t, string(gatewayv1.GatewayReasonInvalid), statusErr.reason)
+					assert.Contains(t, statusErr.message, "app.example.com")
+				})
+			}
+		})
+
+		t.Run("keeps records owned by other gateway on removal", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciDNSModel(deps)
+			zoneID := faker.New().UUID().V4()
+
+			ociClient, _ := deps.OciClient.(*MockociDNSClient)
+			expectZone(t, ociClient, zoneID, "example.com")
+			expectDomainRecords(t, ociClient, zoneID, "old.example.com",
+				makeRecord("old.example.com", ociDNSRecordTypeA, "10.0.0.1", defaultDNSRecordTTL),
+			)
+			expectDomainRecords(t, ociClient, zoneID, dnsOwnerRecordDomain("old.example.com"),
+				makeOwnerRecord("old.example.com", newRandomGateway()),
+			)
+
+			err := model.reconcileListenersDNSRecords(t.Context(), reconcileListenersDNSRecordsParams{
+				gateway:           newRandomGateway(),
+				dns:               &types.GatewayConfigDNS{ZoneID: zoneID},
+				programmedRecords: gatewayDNSRecords{zoneID: zoneID, hostnames: []string{"old.example.com"}},
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("removes records of the previous zone", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciDNSModel(deps)
+			previousZoneID := faker.New().UUID().V4()
+
+			ociClient, _ := deps.OciClient.(*MockociDNSClient)
+			expectZone(t, ociClient, previousZoneID, "example.com")
+			expectDomainRecords(t, ociClient, previousZoneID, "app.example.com",
+				makeRecord("app.example.com", ociDNSRecordTypeAAAA, "2001:db8::1", defaultDNSRecordTTL),
+			)
+			// Records programmed before owner records were introduced are not marked
+			expectDomainRecords(t, ociClient, previousZoneID, dnsOwnerRecordDomain("app.example.com"))
+			ociClient.EXPECT().
+				DeleteRRSet(t.Context(), dns.DeleteRRSetRequest{
+					ZoneNameOrId: &previousZoneID,
+					Domain:       new("app.example.com"),
+					Rtype:        new(ociDNSRecordTypeAAAA),
+				}).
+				Return(dns.DeleteRRSetResponse{}, nil).
+				Once()
+
+			err := model.reconcileListenersDNSRecords(t.Context(), reconcileListenersDNSRecordsParams{
+				gateway: newRandomGateway(),
+				programmedRecords: gatewayDNSRecords{
+					zoneID:    previousZoneID,
+					hostnames: []string{"app.example.com", "app.other-zone.io"},
+				},
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("skips removal of records of deleted zone", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciDNSModel(deps)
+			previousZoneID := faker.New().UUID().V4()
+
+			ociClient, _ := deps.OciClient.(*MockociDNSClient)
+			ociClient.EXPECT().
+				GetZone(t.Context(), dns.GetZoneRequest{ZoneNameOrId: &previousZoneID}).
+				Return(dns.GetZoneResponse{}, ociapi.NewRandomServiceError(
+					ociapi.RandomServiceErrorWithStatusCode(http.StatusNotFound),
+				)).
+				Once()
+
+			err := model.reconcileListenersDNSRecords(t.Context(), reconcileListenersDNSRecordsParams{
+				gateway: newRandomGateway(),
+				programmedRecords: gatewayDNSRecords{
+					zoneID:    previousZoneID,
+					hostnames: []string{"app.example.com"},
+				},
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("fails when zone is not found", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciDNSModel(deps)
+			zoneID := faker.New().UUID().V4()
+
+			ociClient, _ := deps.OciClient.(*MockociDNSClient)
+			ociClient.EXPECT().
+				GetZone(t.Context(), dns.GetZoneRequest{ZoneNameOrId: &zoneID}).
+				Return(dns.GetZoneResponse{}, ociapi.NewRandomServiceError(
+					ociapi.RandomServiceErrorWithStatusCode(http.StatusNotFound),
+				)).
+				Once()
+
+			err := model.reconcileListenersDNSRecords(t.Context(), reconcileListenersDNSRecordsParams{
+				gateway: newRandomGateway(),
+				dns:     &types.GatewayConfigDNS{ZoneID: zoneID},
+			})
+
+			require.ErrorContains(t, err, "DNS zone "+zoneID+" not found")
+		})
+
+		t.Run("fails when records update fails", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciDNSModel(deps)
+			zoneID := faker.New().UUID().V4()
+			wantErr := errors.New(faker.New().Lorem().Sentence(5))
+
+			ociClient, _ := deps.OciClient.(*MockociDNSClient)
+			expectZone(t, ociClient, zoneID, "example.com")
+			expectDomainRecords(t, ociClient, zoneID, "app.example.com")
+			expectDomainRecords(t, ociClient, zoneID, dnsOwnerRecordDomain("app.example.com"))
+			ociClient.EXPECT().
+				UpdateRRSet(t.Context(), mock.Anything).
+				Return(dns.UpdateRRSetResponse{}, wantErr).
+				Once()
+
+			err := model.reconcileListenersDNSRecords(t.Context(), reconcileListenersDNSRecordsParams{
+				gateway:           newRandomGateway(),
+				dns:               &types.GatewayConfigDNS{ZoneID: zoneID},
+				hostnameAddresses: map[string][]string{"app.example.com": {"10.0.0.1"}},
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("listenerHostnameAddresses", func(t *testing.T) {
+		t.Run("uses addresses of the load balancer serving the listener", func(t *testing.T) {
+			gateway := newRandomGateway()
+			gateway.Spec.Listeners = []gatewayv1.Listener{
+				makeRandomListener(func(l *gatewayv1.Listener) {
+					l.Name = "public"
+					l.Hostname = new(gatewayv1.Hostname("App.example.com"))
+				}),
+				makeRandomListener(func(l *gatewayv1.Listener) {
+					l.Name = "internal"
+					l.Hostname = new(gatewayv1.Hostname("internal.example.com"))
+				}),
+				makeRandomListener(func(l *gatewayv1.Listener) {
+					l.Name = "any"
+					l.Hostname = nil
+				}),
+			}
+			config := makeRandomGatewayConfig()
+			config.Spec.InternalLoadBalancerID = faker.New().UUID().V4()
+			config.Spec.Listeners = []types.GatewayConfigListener{{Name: "internal", Internal: true}}
+
+			got := listenerHostnameAddresses(&resolvedGatewayDetails{
+				gateway: *gateway,
+				config:  config,
+				loadBalancer: &loadbalancer.LoadBalancer{
+					IpAddresses: []loadbalancer.IpAddress{{IpAddress: new("203.0.113.10")}},
+				},
+				internalLoadBalancer: &loadbalancer.LoadBalancer{
+					IpAddresses: []loadbalancer.IpAddress{{IpAddress: new("10.0.0.10")}},
+				},
+			})
+
+			assert.Equal(t, map[string][]string{
+				"app.example.com":      {"203.0.113.10"},
+				"internal.example.com": {"10.0.0.10"},
+			}, got)
+		})
+	})
+
+	t.Run("gatewayDNSRecordsAnnotationValue", func(t *testing.T) {
+		t.Run("is empty without DNS config", func(t *testing.T) {
+			assert.Empty(t, gatewayDNSRecordsAnnotationValue(*newRandomGateway(), makeRandomGatewayConfig()))
+		})
+
+		t.Run("round trips zone and sorted hostnames", func(t *testing.T) {
+			gateway := newRandomGateway()
+			withHostname := func(hostname gatewayv1.Hostname) func(*gatewayv1.Listener) {
+				return func(l *gatewayv1.Listener) { l.Hostname = &hostname }
+			}
+			gateway.Spec.Listeners = []gatewayv1.Listener{
+				makeRandomListener(withHostname("b.example.com")),
+				makeRandomListener(withHostname("a.example.com")),
+				makeRandomListener(withHostname("b.example.com")),
+			}
+			config := makeRandomGatewayConfig()
+			config.Spec.DNS = &types.GatewayConfigDNS{ZoneID: faker.New().UUID().V4()}
+
+			value := gatewayDNSRecordsAnnotationValue(*gateway, config)
+
+			assert.Equal(t, config.Spec.DNS.ZoneID+"=a.example.com,b.example.com", value)
+			assert.Equal(t, gatewayDNSRecords{
+				zoneID:    config.Spec.DNS.ZoneID,
+				hostnames: []string{"a.example.com", "b.example.com"},
+			}, parseGatewayDNSRecordsAnnotation(value))
+		})
+
+		t.Run("parses empty value", func(t *testing.T) {
+			assert.Equal(t, gatewayDNSRecords{}, parseGatewayDNSRecordsAnnotation(""))
+		})
+	})
+}
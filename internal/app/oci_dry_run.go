@@ -0,0 +1,417 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/dns"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/logging"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"go.uber.org/dig"
+
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+)
+
+// dryRunWorkRequestID is returned by dry-run clients instead of actual work request IDs.
+// Dry-run work request watchers treat it as immediately succeeded.
+const dryRunWorkRequestID = "dry-run"
+
+// dryRunOperation logs the intended OCI change and returns a synthetic response.
+func dryRunOperation[TResponse any](
+	ctx context.Context,
+	logger *slog.Logger,
+	operation string,
+	request any,
+	response TResponse,
+) (TResponse, error) {
+	logger.InfoContext(ctx, "DRY RUN: skipping OCI change",
+		slog.String("operation", operation),
+		slog.Any("request", request),
+	)
+	return response, nil
+}
+
+// dryRunOciLoadBalancerClient passes read operations to the underlying client
+// and only logs mutating operations.
+type dryRunOciLoadBalancerClient struct {
+	ociLoadBalancerClient
+
+	logger *slog.Logger
+}
+
+func (c dryRunOciLoadBalancerClient) CreateBackendSet(
+	ctx context.Context, request loadbalancer.CreateBackendSetRequest,
+) (loadbalancer.CreateBackendSetResponse, error) {
+	return dryRunOperation(ctx, c.logger, "CreateBackendSet", request,
+		loadbalancer.CreateBackendSetResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) DeleteBackendSet(
+	ctx context.Context, request loadbalancer.DeleteBackendSetRequest,
+) (loadbalancer.DeleteBackendSetResponse, error) {
+	return dryRunOperation(ctx, c.logger, "DeleteBackendSet", request,
+		loadbalancer.DeleteBackendSetResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) UpdateBackendSet(
+	ctx context.Context, request loadbalancer.UpdateBackendSetRequest,
+) (loadbalancer.UpdateBackendSetResponse, error) {
+	return dryRunOperation(ctx, c.logger, "UpdateBackendSet", request,
+		loadbalancer.UpdateBackendSetResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) CreateBackend(
+	ctx context.Context, request loadbalancer.CreateBackendRequest,
+) (loadbalancer.CreateBackendResponse, error) {
+	return dryRunOperation(ctx, c.logger, "CreateBackend", request,
+		loadbalancer.CreateBackendResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) CreateListener(
+	ctx context.Context, request loadbalancer.CreateListenerRequest,
+) (loadbalancer.CreateListenerResponse, error) {
+	return dryRunOperation(ctx, c.logger, "CreateListener", request,
+		loadbalancer.CreateListenerResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) UpdateListener(
+	ctx context.Context, request loadbalancer.UpdateListenerRequest,
+) (loadbalancer.UpdateListenerResponse, error) {
+	return dryRunOperation(ctx, c.logger, "UpdateListener", request,
+		loadbalancer.UpdateListenerResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) DeleteListener(
+	ctx context.Context, request loadbalancer.DeleteListenerRequest,
+) (loadbalancer.DeleteListenerResponse, error) {
+	return dryRunOperation(ctx, c.logger, "DeleteListener", request,
+		loadbalancer.DeleteListenerResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) CreateHostname(
+	ctx context.Context, request loadbalancer.CreateHostnameRequest,
+) (loadbalancer.CreateHostnameResponse, error) {
+	return dryRunOperation(ctx, c.logger, "CreateHostname", request,
+		loadbalancer.CreateHostnameResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) CreateRuleSet(
+	ctx context.Context, request loadbalancer.CreateRuleSetRequest,
+) (loadbalancer.CreateRuleSetResponse, error) {
+	return dryRunOperation(ctx, c.logger, "CreateRuleSet", request,
+		loadbalancer.CreateRuleSetResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) UpdateRuleSet(
+	ctx context.Context, request loadbalancer.UpdateRuleSetRequest,
+) (loadbalancer.UpdateRuleSetResponse, error) {
+	return dryRunOperation(ctx, c.logger, "UpdateRuleSet", request,
+		loadbalancer.UpdateRuleSetResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) DeleteRuleSet(
+	ctx context.Context, request loadbalancer.DeleteRuleSetRequest,
+) (loadbalancer.DeleteRuleSetResponse, error) {
+	return dryRunOperation(ctx, c.logger, "DeleteRuleSet", request,
+		loadbalancer.DeleteRuleSetResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) CreateRoutingPolicy(
+	ctx context.Context, request loadbalancer.CreateRoutingPolicyRequest,
+) (loadbalancer.CreateRoutingPolicyResponse, error) {
+	return dryRunOperation(ctx, c.logger, "CreateRoutingPolicy", request,
+		loadbalancer.CreateRoutingPolicyResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) UpdateRoutingPolicy(
+	ctx context.Context, request loadbalancer.UpdateRoutingPolicyRequest,
+) (loadbalancer.UpdateRoutingPolicyResponse, error) {
+	return dryRunOperation(ctx, c.logger, "UpdateRoutingPolicy", request,
+		loadbalancer.UpdateRoutingPolicyResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) DeleteRoutingPolicy(
+	ctx context.Context, request loadbalancer.DeleteRoutingPolicyRequest,
+) (loadbalancer.DeleteRoutingPolicyResponse, error) {
+	return dryRunOperation(ctx, c.logger, "DeleteRoutingPolicy", request,
+		loadbalancer.DeleteRoutingPolicyResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) CreateCertificate(
+	ctx context.Context, request loadbalancer.CreateCertificateRequest,
+) (loadbalancer.CreateCertificateResponse, error) {
+	return dryRunOperation(ctx, c.logger, "CreateCertificate", request,
+		loadbalancer.CreateCertificateResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoadBalancerClient) DeleteCertificate(
+	ctx context.Context, request loadbalancer.DeleteCertificateRequest,
+) (loadbalancer.DeleteCertificateResponse, error) {
+	return dryRunOperation(ctx, c.logger, "DeleteCertificate", request,
+		loadbalancer.DeleteCertificateResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+// dryRunOciNetworkLoadBalancerClient passes read operations to the underlying client
+// and only logs mutating operations.
+type dryRunOciNetworkLoadBalancerClient struct {
+	ociNetworkLoadBalancerClient
+
+	logger *slog.Logger
+}
+
+func (c dryRunOciNetworkLoadBalancerClient) CreateListener(
+	ctx context.Context, request networkloadbalancer.CreateListenerRequest,
+) (networkloadbalancer.CreateListenerResponse, error) {
+	return dryRunOperation(ctx, c.logger, "CreateListener", request,
+		networkloadbalancer.CreateListenerResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciNetworkLoadBalancerClient) UpdateListener(
+	ctx context.Context, request networkloadbalancer.UpdateListenerRequest,
+) (networkloadbalancer.UpdateListenerResponse, error) {
+	return dryRunOperation(ctx, c.logger, "UpdateListener", request,
+		networkloadbalancer.UpdateListenerResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciNetworkLoadBalancerClient) DeleteListener(
+	ctx context.Context, request networkloadbalancer.DeleteListenerRequest,
+) (networkloadbalancer.DeleteListenerResponse, error) {
+	return dryRunOperation(ctx, c.logger, "DeleteListener", request,
+		networkloadbalancer.DeleteListenerResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciNetworkLoadBalancerClient) CreateBackendSet(
+	ctx context.Context, request networkloadbalancer.CreateBackendSetRequest,
+) (networkloadbalancer.CreateBackendSetResponse, error) {
+	return dryRunOperation(ctx, c.logger, "CreateBackendSet", request,
+		networkloadbalancer.CreateBackendSetResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciNetworkLoadBalancerClient) UpdateBackendSet(
+	ctx context.Context, request networkloadbalancer.UpdateBackendSetRequest,
+) (networkloadbalancer.UpdateBackendSetResponse, error) {
+	return dryRunOperation(ctx, c.logger, "UpdateBackendSet", request,
+		networkloadbalancer.UpdateBackendSetResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciNetworkLoadBalancerClient) DeleteBackendSet(
+	ctx context.Context, request networkloadbalancer.DeleteBackendSetRequest,
+) (networkloadbalancer.DeleteBackendSetResponse, error) {
+	return dryRunOperation(ctx, c.logger, "DeleteBackendSet", request,
+		networkloadbalancer.DeleteBackendSetResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciNetworkLoadBalancerClient) CreateBackend(
+	ctx context.Context, request networkloadbalancer.CreateBackendRequest,
+) (networkloadbalancer.CreateBackendResponse, error) {
+	return dryRunOperation(ctx, c.logger, "CreateBackend", request,
+		networkloadbalancer.CreateBackendResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciNetworkLoadBalancerClient) UpdateBackend(
+	ctx context.Context, request networkloadbalancer.UpdateBackendRequest,
+) (networkloadbalancer.UpdateBackendResponse, error) {
+	return dryRunOperation(ctx, c.logger, "UpdateBackend", request,
+		networkloadbalancer.UpdateBackendResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciNetworkLoadBalancerClient) DeleteBackend(
+	ctx context.Context, request networkloadbalancer.DeleteBackendRequest,
+) (networkloadbalancer.DeleteBackendResponse, error) {
+	return dryRunOperation(ctx, c.logger, "DeleteBackend", request,
+		networkloadbalancer.DeleteBackendResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+// dryRunOciCertificatesManagementClient passes read operations to the underlying client
+// and only logs mutating operations.
+type dryRunOciCertificatesManagementClient struct {
+	ociCertificatesManagementClient
+
+	logger *slog.Logger
+}
+
+func (c dryRunOciCertificatesManagementClient) CreateCaBundle(
+	ctx context.Context, request certificatesmanagement.CreateCaBundleRequest,
+) (certificatesmanagement.CreateCaBundleResponse, error) {
+	return dryRunOperation(ctx, c.logger, "CreateCaBundle", request,
+		certificatesmanagement.CreateCaBundleResponse{
+			CaBundle: certificatesmanagement.CaBundle{
+				Id:             new(dryRunWorkRequestID),
+				Name:           request.CreateCaBundleDetails.Name,
+				LifecycleState: certificatesmanagement.CaBundleLifecycleStateActive,
+			},
+		})
+}
+
+func (c dryRunOciCertificatesManagementClient) UpdateCaBundle(
+	ctx context.Context, request certificatesmanagement.UpdateCaBundleRequest,
+) (certificatesmanagement.UpdateCaBundleResponse, error) {
+	return dryRunOperation(ctx, c.logger, "UpdateCaBundle", request,
+		certificatesmanagement.UpdateCaBundleResponse{})
+}
+
+func (c dryRunOciCertificatesManagementClient) DeleteCaBundle(
+	ctx context.Context, request certificatesmanagement.DeleteCaBundleRequest,
+) (certificatesmanagement.DeleteCaBundleResponse, error) {
+	return dryRunOperation(ctx, c.logger, "DeleteCaBundle", request,
+		certificatesmanagement.DeleteCaBundleResponse{})
+}
+
+// dryRunOciLoggingManagementClient passes read operations to the underlying client
+// and only logs mutating operations.
+type dryRunOciLoggingManagementClient struct {
+	ociLoggingManagementClient
+
+	logger *slog.Logger
+}
+
+func (c dryRunOciLoggingManagementClient) CreateLog(
+	ctx context.Context, request logging.CreateLogRequest,
+) (logging.CreateLogResponse, error) {
+	return dryRunOperation(ctx, c.logger, "CreateLog", request,
+		logging.CreateLogResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+func (c dryRunOciLoggingManagementClient) UpdateLog(
+	ctx context.Context, request logging.UpdateLogRequest,
+) (logging.UpdateLogResponse, error) {
+	return dryRunOperation(ctx, c.logger, "UpdateLog", request,
+		logging.UpdateLogResponse{OpcWorkRequestId: new(dryRunWorkRequestID)})
+}
+
+// dryRunOciVirtualNetworkClient passes read operations to the underlying client
+// and only logs mutating operations.
+type dryRunOciVirtualNetworkClient struct {
+	ociVirtualNetworkClient
+
+	logger *slog.Logger
+}
+
+func (c dryRunOciVirtualNetworkClient) AddNetworkSecurityGroupSecurityRules(
+	ctx context.Context, request core.AddNetworkSecurityGroupSecurityRulesRequest,
+) (core.AddNetworkSecurityGroupSecurityRulesResponse, error) {
+	return dryRunOperation(ctx, c.logger, "AddNetworkSecurityGroupSecurityRules", request,
+		core.AddNetworkSecurityGroupSecurityRulesResponse{})
+}
+
+func (c dryRunOciVirtualNetworkClient) RemoveNetworkSecurityGroupSecurityRules(
+	ctx context.Context, request core.RemoveNetworkSecurityGroupSecurityRulesRequest,
+) (core.RemoveNetworkSecurityGroupSecurityRulesResponse, error) {
+	return dryRunOperation(ctx, c.logger, "RemoveNetworkSecurityGroupSecurityRules", request,
+		core.RemoveNetworkSecurityGroupSecurityRulesResponse{})
+}
+
+// dryRunOciDNSClient passes read operations to the underlying client
+// and only logs mutating operations.
+type dryRunOciDNSClient struct {
+	ociDNSClient
+
+	logger *slog.Logger
+}
+
+func (c dryRunOciDNSClient) UpdateRRSet(
+	ctx context.Context, request dns.UpdateRRSetRequest,
+) (dns.UpdateRRSetResponse, error) {
+	return dryRunOperation(ctx, c.logger, "UpdateRRSet", request, dns.UpdateRRSetResponse{})
+}
+
+func (c dryRunOciDNSClient) DeleteRRSet(
+	ctx context.Context, request dns.DeleteRRSetRequest,
+) (dns.DeleteRRSetResponse, error) {
+	return dryRunOperation(ctx, c.logger, "DeleteRRSet", request, dns.DeleteRRSetResponse{})
+}
+
+// dryRunWorkRequestsWatcher completes dry-run work requests immediately and
+// delegates everything else to the underlying watcher.
+type dryRunWorkRequestsWatcher struct {
+	workRequestsWatcher
+}
+
+func (w dryRunWorkRequestsWatcher) WaitFor(ctx context.Context, workRequestID string) error {
+	if workRequestID == dryRunWorkRequestID {
+		return nil
+	}
+	return w.workRequestsWatcher.WaitFor(ctx, workRequestID)
+}
+
+// ociDryRunDeps is used by the OCI ports adapters to decide if the
+// dry-run decorators should be applied.
+type ociDryRunDeps struct {
+	dig.In
+
+	RootLogger *slog.Logger
+
+	// This can be set via APP_OCIAPI_DRYRUN env variable
+	DryRun bool `name:"config.ociapi.dryRun"`
+}
+
+func (deps ociDryRunDeps) logger() *slog.Logger {
+	return deps.RootLogger.WithGroup("oci-dry-run")
+}
+
+func newOciLoadBalancerClientPort(c *ociapi.RegionalLoadBalancerClient, deps ociDryRunDeps) ociLoadBalancerClient {
+	if !deps.DryRun {
+		return c
+	}
+	return dryRunOciLoadBalancerClient{ociLoadBalancerClient: c, logger: deps.logger()}
+}
+
+func newOciNetworkLoadBalancerClientPort(
+	c *ociapi.RegionalNetworkLoadBalancerClient,
+	deps ociDryRunDeps,
+) ociNetworkLoadBalancerClient {
+	if !deps.DryRun {
+		return c
+	}
+	return dryRunOciNetworkLoadBalancerClient{ociNetworkLoadBalancerClient: c, logger: deps.logger()}
+}
+
+func newOciCertificatesManagementClientPort(
+	c *ociapi.RegionalCertificatesManagementClient,
+	deps ociDryRunDeps,
+) ociCertificatesManagementClient {
+	if !deps.DryRun {
+		return c
+	}
+	return dryRunOciCertificatesManagementClient{ociCertificatesManagementClient: c, logger: deps.logger()}
+}
+
+func newOciLoggingManagementClientPort(
+	c *ociapi.RegionalLoggingManagementClient,
+	deps ociDryRunDeps,
+) ociLoggingManagementClient {
+	if !deps.DryRun {
+		return c
+	}
+	return dryRunOciLoggingManagementClient{ociLoggingManagementClient: c, logger: deps.logger()}
+}
+
+func newOciVirtualNetworkClientPort(
+	c *ociapi.RegionalVirtualNetworkClient,
+	deps ociDryRunDeps,
+) ociVirtualNetworkClient {
+	if !deps.DryRun {
+		return c
+	}
+	return dryRunOciVirtualNetworkClient{ociVirtualNetworkClient: c, logger: deps.logger()}
+}
+
+func newOciDNSClientPort(
+	c *ociapi.RegionalDNSClient,
+	deps ociDryRunDeps,
+) ociDNSClient {
+	if !deps.DryRun {
+		return c
+	}
+	return dryRunOciDNSClient{ociDNSClient: c, logger: deps.logger()}
+}
+
+func newWorkRequestsWatcherPort(w workRequestsWatcher, deps ociDryRunDeps) workRequestsWatcher {
+	if !deps.DryRun {
+		return w
+	}
+	return dryRunWorkRequestsWatcher{workRequestsWatcher: w}
+}
@@ -0,0 +1,135 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/dns"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+)
+
+func TestOciDryRun(t *testing.T) {
+	t.Run("dryRunOciLoadBalancerClient", func(t *testing.T) {
+		t.Run("skips mutating operations", func(t *testing.T) {
+			ociClient := NewMockociLoadBalancerClient(t)
+			client := dryRunOciLoadBalancerClient{ociLoadBalancerClient: ociClient, logger: diag.RootTestLogger()}
+
+			updateRes, err := client.UpdateRoutingPolicy(t.Context(), loadbalancer.UpdateRoutingPolicyRequest{
+				LoadBalancerId: new(faker.New().UUID().V4()),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, dryRunWorkRequestID, *updateRes.OpcWorkRequestId)
+
+			deleteRes, err := client.DeleteBackendSet(t.Context(), loadbalancer.DeleteBackendSetRequest{})
+			require.NoError(t, err)
+			assert.Equal(t, dryRunWorkRequestID, *deleteRes.OpcWorkRequestId)
+		})
+
+		t.Run("passes read operations through", func(t *testing.T) {
+			ociClient := NewMockociLoadBalancerClient(t)
+			client := dryRunOciLoadBalancerClient{ociLoadBalancerClient: ociClient, logger: diag.RootTestLogger()}
+			req := loadbalancer.GetLoadBalancerRequest{LoadBalancerId: new(faker.New().UUID().V4())}
+			wantRes := loadbalancer.GetLoadBalancerResponse{
+				LoadBalancer: makeRandomOCILoadBalancer(),
+			}
+
+			ociClient.EXPECT().GetLoadBalancer(t.Context(), req).Return(wantRes, nil).Once()
+
+			gotRes, err := client.GetLoadBalancer(t.Context(), req)
+			require.NoError(t, err)
+			assert.Equal(t, wantRes, gotRes)
+		})
+	})
+
+	t.Run("dryRunOciLoggingManagementClient skips mutating operations", func(t *testing.T) {
+		client := dryRunOciLoggingManagementClient{
+			ociLoggingManagementClient: NewMockociLoggingManagementClient(t),
+			logger:                     diag.RootTestLogger(),
+		}
+
+		_, err := client.CreateLog(t.Context(), logging.CreateLogRequest{})
+		require.NoError(t, err)
+		_, err = client.UpdateLog(t.Context(), logging.UpdateLogRequest{})
+		require.NoError(t, err)
+	})
+
+	t.Run("dryRunOciVirtualNetworkClient skips mutating operations", func(t *testing.T) {
+		client := dryRunOciVirtualNetworkClient{
+			ociVirtualNetworkClient: NewMockociVirtualNetworkClient(t),
+			logger:                  diag.RootTestLogger(),
+		}
+
+		_, err := client.AddNetworkSecurityGroupSecurityRules(t.Context(),
+			core.AddNetworkSecurityGroupSecurityRulesRequest{})
+		require.NoError(t, err)
+		_, err = client.RemoveNetworkSecurityGroupSecurityRules(t.Context(),
+			core.RemoveNetworkSecurityGroupSecurityRulesRequest{})
+		require.NoError(t, err)
+	})
+
+	t.Run("dryRunOciDNSClient skips mutating operations", func(t *testing.T) {
+		client := dryRunOciDNSClient{
+			ociDNSClient: NewMockociDNSClient(t),
+			logger:       diag.RootTestLogger(),
+		}
+
+		_, err := client.UpdateRRSet(t.Context(), dns.UpdateRRSetRequest{})
+		require.NoError(t, err)
+		_, err = client.DeleteRRSet(t.Context(), dns.DeleteRRSetRequest{})
+		require.NoError(t, err)
+	})
+
+	t.Run("dryRunWorkRequestsWatcher", func(t *testing.T) {
+		t.Run("completes dry-run work requests", func(t *testing.T) {
+			watcher := dryRunWorkRequestsWatcher{workRequestsWatcher: NewMockworkRequestsWatcher(t)}
+
+			require.NoError(t, watcher.WaitFor(t.Context(), dryRunWorkRequestID))
+		})
+
+		t.Run("delegates other work requests", func(t *testing.T) {
+			mockWatcher := NewMockworkRequestsWatcher(t)
+			watcher := dryRunWorkRequestsWatcher{workRequestsWatcher: mockWatcher}
+			workRequestID := faker.New().UUID().V4()
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+
+			mockWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(wantErr).Once()
+
+			require.ErrorIs(t, watcher.WaitFor(t.Context(), workRequestID), wantErr)
+		})
+	})
+
+	t.Run("newWorkRequestsWatcherPort", func(t *testing.T) {
+		mockWatcher := NewMockworkRequestsWatcher(t)
+
+		assert.Equal(t, mockWatcher, newWorkRequestsWatcherPort(mockWatcher, ociDryRunDeps{
+			RootLogger: diag.RootTestLogger(),
+		}))
+		assert.Equal(t,
+			dryRunWorkRequestsWatcher{workRequestsWatcher: mockWatcher},
+			newWorkRequestsWatcherPort(mockWatcher, ociDryRunDeps{
+				RootLogger: diag.RootTestLogger(),
+				DryRun:     true,
+			}),
+		)
+	})
+
+	t.Run("newOciLoadBalancerClientPort", func(t *testing.T) {
+		client := &ociapi.RegionalLoadBalancerClient{}
+
+		assert.IsType(t, &ociapi.RegionalLoadBalancerClient{}, newOciLoadBalancerClientPort(client, ociDryRunDeps{
+			RootLogger: diag.RootTestLogger(),
+		}))
+		assert.IsType(t, dryRunOciLoadBalancerClient{}, newOciLoadBalancerClientPort(client, ociDryRunDeps{
+			RootLogger: diag.RootTestLogger(),
+			DryRun:     true,
+		}))
+	})
+}
@@ -5,8 +5,12 @@ import (
 
 	"github.com/jaswdr/faker/v2"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+
+	"github.com/gemyago/oke-gateway-api/pkg/testsupport"
 )
 
+var _ ociLoadBalancerClient = (*testsupport.FakeLoadBalancerClient)(nil)
+
 type randomOCIBackendSetOpt func(*loadbalancer.BackendSet)
 
 func makeRandomOCIBackendSet(
@@ -17,31 +17,72 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	"github.com/samber/lo"
 	"go.uber.org/dig"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/gemyago/oke-gateway-api/internal/diag"
 	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
 )
 
 const defaultBackendSetPort = 80
+const defaultBackendSetDrainPollInterval = 10 * time.Second
 const defaultCatchAllRuleName = "default_catch_all"
 const maxBackendSetNameLength = 32
 const maxListenerPolicyNameLength = 32
 const listenerPolicyNameHashLength = 16
+const ociGatewayNamePrefixHashLength = 8
 const ociListenerProtocolHTTP = "HTTP"
 const ociListenerProtocolHTTP2 = "HTTP2"
 
+// OCI routing policy limits. Exceeding them makes the policy update fail
+// with a generic error, so they are validated before committing the rules.
+const maxRoutingPolicyRules = 100
+const maxRoutingRuleConditionLength = 4096
+
+// routingPolicyCapacityError indicates that the routing rules of the route
+// do not fit into the OCI routing policy limits.
+type routingPolicyCapacityError struct {
+	message string
+}
+
+func (e routingPolicyCapacityError) Error() string {
+	return e.message
+}
+
 type reconcileDefaultBackendParams struct {
 	loadBalancerID   string
 	knownBackendSets map[string]loadbalancer.BackendSet
 	gateway          *gatewayv1.Gateway
+
+	// Shape of the load balancer to check the backend sets limit against.
+	shapeName string
+
+	// Prefix of the default backend set name, see ociGatewayNamePrefix.
+	namePrefix string
+	naming     ociResourceNaming
+
+	// Service receiving unmatched traffic, nil if the default backend set is kept empty.
+	defaultBackend *types.GatewayConfigDefaultBackend
+
+	// Configuration of the default backend set, nil if not configured.
+	defaultBackendSet *types.GatewayConfigDefaultBackendSet
+
+	// The default backend Service to derive the health check from, nil if not found.
+	service *corev1.Service
+
+	// nodePortBackends is set when nodes are registered as backends with the Service nodePort.
+	nodePortBackends bool
 }
 
 type reconcileBackendSetParams struct {
@@ -51,12 +92,21 @@ type reconcileBackendSetParams struct {
 	backendRef      gatewayv1.BackendRef
 	sslConfig       *loadbalancer.SslConfigurationDetails
 	manageSSLConfig bool
+	naming          ociResourceNaming
+
+	// nodePortBackends is set when nodes are registered as backends with the Service nodePort.
+	nodePortBackends bool
+
+	// externalBackend is set when the backendRef points to the OkeExternalBackend,
+	// the service is not populated in this case.
+	externalBackend *types.OkeExternalBackend
 }
 
 type deprovisionBackendSetParams struct {
 	loadBalancerID string
 	routeNamespace string
 	backendRef     gatewayv1.BackendRef
+	naming         ociResourceNaming
 }
 
 type reconcileHTTPListenerParams struct {
@@ -67,6 +117,35 @@ type reconcileHTTPListenerParams struct {
 	listenerCertificateID string
 	defaultBackendSetName string
 	listenerSpec          *gatewayv1.Listener
+
+	// Prefix of the OCI listener name, empty unless the load balancer is shared.
+	namePrefix string
+
+	// Naming scheme of the OCI listener name.
+	naming ociResourceNaming
+
+	// Rule sets attached to the listener: the listener policy and access rule sets.
+	ruleSetNames []string
+
+	// Rule sets the controller manages for the listener, attached or not. Other rule sets
+	// attached to the listener outside of the controller are kept.
+	managedRuleSetNames []string
+
+	// OCID of the CA bundle to verify client certificates, empty if client verification is disabled.
+	clientCABundleID string
+
+	// Shape of the load balancer to check the listeners limit against.
+	shapeName string
+}
+
+type reconcileListenerRuleSetParams struct {
+	loadBalancerID string
+	knownRuleSets  map[string]loadbalancer.RuleSet
+	ruleSetName    string
+
+	// Rules of the listener policy. The rule set is not programmed and
+	// gets removed if there are no rules.
+	items []loadbalancer.Rule
 }
 
 type reconcileListenersCertificatesParams struct {
@@ -89,11 +168,13 @@ type reconcileListenersCertificatesResult struct {
 type makeRoutingRuleParams struct {
 	httpRoute          gatewayv1.HTTPRoute
 	httpRouteRuleIndex int
+	naming             ociResourceNaming
 }
 
 type makeGRPCRoutingRuleParams struct {
 	grpcRoute          gatewayv1.GRPCRoute
 	grpcRouteRuleIndex int
+	naming             ociResourceNaming
 }
 
 type makeBackendRoutingRuleParams[T any] struct {
@@ -103,8 +184,16 @@ type makeBackendRoutingRuleParams[T any] struct {
 	routeRuleIndex      int
 	backendRefs         []T
 	backendSetName      func(T) string
-	mapCondition        func() (string, error)
 	conditionErrContext string
+
+	// Number of matches of the route rule
+	matchCount int
+
+	// mapCondition maps matches of the route rule in the [from, to) range to the condition
+	mapCondition func(from, to int) (string, error)
+
+	// partRuleName returns the name of the routing rule holding a part of the matches
+	partRuleName func(part int) string
 }
 
 type commitRoutingPolicyParams struct {
@@ -119,9 +208,28 @@ type commitRoutingPolicyParams struct {
 }
 
 type removeMissingListenersParams struct {
-	loadBalancerID   string
-	knownListeners   map[string]loadbalancer.Listener
-	gatewayListeners []gatewayv1.Listener
+	loadBalancerID       string
+	knownListeners       map[string]loadbalancer.Listener
+	knownRoutingPolicies map[string]loadbalancer.RoutingPolicy
+	gatewayListeners     []gatewayv1.Listener
+
+	// Existing routes owning policy rules of the listeners missing in the gateway spec,
+	// keyed by rule in the listener/rule format. Listeners with such rules are not removed.
+	routeRuleOwners map[string][]string
+
+	// When set, only listeners with this prefix are owned by the gateway
+	// and considered for removal.
+	namePrefix string
+
+	// Naming scheme of the OCI listener names.
+	naming ociResourceNaming
+
+	// OCI listeners configured outside of the controller that are not removed
+	adoptedListeners []string
+
+	// Gateway listener names protected from deletion. Such listeners are kept
+	// and reported with errListenerProtected.
+	protectedListeners []string
 }
 
 type removeUnusedCertificatesParams struct {
@@ -152,6 +260,26 @@ type ociLoadBalancerModel interface {
 		params ensureHTTP2ListenerProtocolParams,
 	) error
 
+	// reconcileListenerRuleSet creates or updates the rule set with listener policy rules.
+	reconcileListenerRuleSet(
+		ctx context.Context,
+		params reconcileListenerRuleSetParams,
+	) error
+
+	// removeUnusedListenerRuleSet removes the rule set if there are no listener policy rules.
+	// It should be called after the rule set is detached from listeners.
+	removeUnusedListenerRuleSet(
+		ctx context.Context,
+		params reconcileListenerRuleSetParams,
+	) error
+
+	// reconcileRouteRedirectRuleSet programs the rule set with redirect rules of the route
+	// and attaches it to the given listeners only.
+	reconcileRouteRedirectRuleSet(
+		ctx context.Context,
+		params reconcileRouteRedirectRuleSetParams,
+	) error
+
 	reconcileBackendSet(
 		ctx context.Context,
 		params reconcileBackendSetParams,
@@ -162,16 +290,17 @@ type ociLoadBalancerModel interface {
 		params deprovisionBackendSetParams,
 	) error
 
-	// makeRoutingRule appends a new routing rule to the routing policy.
-	makeRoutingRule(
+	// makeRoutingRules makes routing rules of the route rule. Matches of the rule are split
+	// across multiple routing rules if the condition exceeds the OCI limit.
+	makeRoutingRules(
 		ctx context.Context,
 		params makeRoutingRuleParams,
-	) (loadbalancer.RoutingRule, error)
+	) ([]loadbalancer.RoutingRule, error)
 
-	makeGRPCRoutingRule(
+	makeGRPCRoutingRules(
 		ctx context.Context,
 		params makeGRPCRoutingRuleParams,
-	) (loadbalancer.RoutingRule, error)
+	) ([]loadbalancer.RoutingRule, error)
 
 	commitRoutingPolicy(
 		ctx context.Context,
@@ -179,6 +308,8 @@ type ociLoadBalancerModel interface {
 	) error
 
 	// removeMissingListeners removes listeners from the load balancer that are not present in the gateway spec.
+	// Listeners with routing policy rules of existing routes are kept and reported with errListenerInUse,
+	// protected listeners are kept and reported with errListenerProtected.
 	removeMissingListeners(ctx context.Context, params removeMissingListenersParams) error
 
 	removeUnusedCertificates(
@@ -194,6 +325,13 @@ type ociLoadBalancerModelImpl struct {
 	workRequestsWatcher workRequestsWatcher
 	routingRulesMapper  ociLoadBalancerRoutingRulesMapper
 	routingPolicyLocks  routingPolicyLocks
+	metrics             *routingPolicyMetrics
+	fingerprints        *endpointsFingerprints
+
+	// drainTimeout is how long backends are kept draining before the backend
+	// set is deleted. Zero disables draining.
+	drainTimeout      time.Duration
+	drainPollInterval time.Duration
 }
 
 type ensureHTTP2ListenerProtocolParams struct {
@@ -201,6 +339,18 @@ type ensureHTTP2ListenerProtocolParams struct {
 	listenerName   string
 }
 
+type reconcileRouteRedirectRuleSetParams struct {
+	loadBalancerID string
+	ruleSetName    string
+
+	// Redirect rules of the route. The rule set is detached from all listeners
+	// and removed if there are no rules.
+	items []loadbalancer.Rule
+
+	// Names of the OCI listeners the rule set is attached to.
+	listenerNames []string
+}
+
 func loadBalancerHealthCheckerMatches(
 	current *loadbalancer.HealthChecker,
 	desired loadbalancer.HealthCheckerDetails,
@@ -208,8 +358,19 @@ func loadBalancerHealthCheckerMatches(
 	if current == nil {
 		return false
 	}
+	// Optional settings are only compared when explicitly desired, otherwise
+	// OCI defaults are kept as is.
+	optionalMatches := func(current *int, desired *int) bool {
+		return desired == nil || lo.FromPtr(current) == *desired
+	}
 	return lo.FromPtr(current.Protocol) == lo.FromPtr(desired.Protocol) &&
-		lo.FromPtr(current.Port) == lo.FromPtr(desired.Port)
+		lo.FromPtr(current.Port) == lo.FromPtr(desired.Port) &&
+		(desired.UrlPath == nil || lo.FromPtr(current.UrlPath) == *desired.UrlPath) &&
+		(desired.ResponseBodyRegex == nil || lo.FromPtr(current.ResponseBodyRegex) == *desired.ResponseBodyRegex) &&
+		optionalMatches(current.ReturnCode, desired.ReturnCode) &&
+		optionalMatches(current.IntervalInMillis, desired.IntervalInMillis) &&
+		optionalMatches(current.TimeoutInMillis, desired.TimeoutInMillis) &&
+		optionalMatches(current.Retries, desired.Retries)
 }
 
 func loadBalancerBackendSetMatches(
@@ -269,6 +430,13 @@ func loadBalancerListenerSSLConfigurationsEqual(
 	if len(desired.Protocols) > 0 && !stringSlicesEqual(current.Protocols, desired.Protocols) {
 		return false
 	}
+	if lo.FromPtr(current.VerifyPeerCertificate) != lo.FromPtr(desired.VerifyPeerCertificate) ||
+		!stringSlicesEqual(current.TrustedCertificateAuthorityIds, desired.TrustedCertificateAuthorityIds) {
+		return false
+	}
+	if lo.FromPtr(desired.VerifyPeerCertificate) && lo.FromPtr(current.VerifyDepth) != lo.FromPtr(desired.VerifyDepth) {
+		return false
+	}
 	return true
 }
 
@@ -280,6 +448,52 @@ func stringSlicesEqual(left []string, right []string) bool {
 	return strings.Join(left, "\x00") == strings.Join(right, "\x00")
 }
 
+// backendRefHealthCheckerPort returns the port probed by the health checker of the backendRef
+// backend set. Backends are registered with the target port of the referenced service port,
+// so the target port is probed when it is numeric. Named target ports are resolved from the
+// EndpointSlices of the service, the backendRef port is probed if it can not be resolved.
+// Node backends are registered with the nodePort, which is probed in this case.
+func (m *ociLoadBalancerModelImpl) backendRefHealthCheckerPort(
+	ctx context.Context,
+	service corev1.Service,
+	backendRef gatewayv1.BackendRef,
+	nodePortBackends bool,
+) (int, error) {
+	servicePort, err := l4ServicePortForBackendRef(service, backendRef)
+	if err == nil && nodePortBackends && servicePort.NodePort != 0 {
+		return int(servicePort.NodePort), nil
+	}
+	if err == nil && servicePort.TargetPort.Type == intstr.String {
+		return m.namedTargetPortHealthCheckerPort(ctx, service, *servicePort, backendRef)
+	}
+	if err == nil && servicePort.TargetPort.IntValue() != 0 {
+		return servicePort.TargetPort.IntValue(), nil
+	}
+	return int(lo.FromPtr(backendRef.BackendObjectReference.Port)), nil
+}
+
+func (m *ociLoadBalancerModelImpl) namedTargetPortHealthCheckerPort(
+	ctx context.Context,
+	service corev1.Service,
+	servicePort corev1.ServicePort,
+	backendRef gatewayv1.BackendRef,
+) (int, error) {
+	var endpointSlices discoveryv1.EndpointSliceList
+	if err := m.k8sClient.List(ctx, &endpointSlices,
+		client.MatchingLabels{discoveryv1.LabelServiceName: service.Name},
+		client.InNamespace(service.Namespace),
+	); err != nil {
+		return 0, fmt.Errorf("failed to list endpoint slices for service %s: %w",
+			client.ObjectKeyFromObject(&service), err)
+	}
+	for _, slice := range endpointSlices.Items {
+		if port, ok := l4EndpointPortForServicePort(servicePort, slice); ok {
+			return port, nil
+		}
+	}
+	return int(lo.FromPtr(backendRef.BackendObjectReference.Port)), nil
+}
+
 func loadBalancerBackendSetHealthChecker(port int) loadbalancer.HealthCheckerDetails {
 	return loadbalancer.HealthCheckerDetails{
 		Protocol: new("TCP"),
@@ -376,13 +590,49 @@ func healthCheckerFromDetails(details loadbalancer.HealthCheckerDetails) *loadba
 	}
 }
 
+// defaultBackendSetHealthChecker derives the default backend set health check from the
+// default backend Service the same way as for route backend sets, so the backends
+// registered for the default backend are probed on the port they serve. The health
+// check configured in the GatewayConfig takes precedence over the Service.
+func (m *ociLoadBalancerModelImpl) defaultBackendSetHealthChecker(
+	ctx context.Context,
+	params reconcileDefaultBackendParams,
+) (loadbalancer.HealthCheckerDetails, error) {
+	var configuredHealthCheck *types.OkeExternalBackendHealthCheck
+	if params.defaultBackendSet != nil {
+		configuredHealthCheck = params.defaultBackendSet.HealthCheck
+	}
+	if params.defaultBackend == nil {
+		return backendSetHealthChecker(lo.FromPtr(configuredHealthCheck), defaultBackendSetPort), nil
+	}
+	if params.service == nil {
+		return backendSetHealthChecker(lo.FromPtr(configuredHealthCheck), int(params.defaultBackend.Port)), nil
+	}
+	healthCheckerPort, err := m.backendRefHealthCheckerPort(ctx, *params.service, gatewayv1.BackendRef{
+		BackendObjectReference: gatewayv1.BackendObjectReference{
+			Name: gatewayv1.ObjectName(params.defaultBackend.ServiceName),
+			Port: new(params.defaultBackend.Port),
+		},
+	}, params.nodePortBackends)
+	if err != nil {
+		return loadbalancer.HealthCheckerDetails{}, err
+	}
+	if configuredHealthCheck != nil {
+		return backendSetHealthChecker(*configuredHealthCheck, healthCheckerPort), nil
+	}
+	return backendSetHealthChecker(m.serviceHealthCheck(ctx, *params.service), healthCheckerPort), nil
+}
+
 func (m *ociLoadBalancerModelImpl) reconcileDefaultBackendSet(
 	ctx context.Context,
 	params reconcileDefaultBackendParams,
 ) (loadbalancer.BackendSet, error) {
-	defaultBackendSetName := params.gateway.Name + "-default"
+	defaultBackendSetName := ociDefaultBackendSetName(params.naming, params.gateway, params.namePrefix)
 	desiredPolicy := "ROUND_ROBIN"
-	desiredHealthChecker := loadBalancerBackendSetHealthChecker(defaultBackendSetPort)
+	desiredHealthChecker, err := m.defaultBackendSetHealthChecker(ctx, params)
+	if err != nil {
+		return loadbalancer.BackendSet{}, fmt.Errorf("failed to derive default backend set health check: %w", err)
+	}
 	if existingBackendSet, ok := params.knownBackendSets[defaultBackendSetName]; ok {
 		existingSSLConfig := sslConfigurationDetailsFromBackendSet(existingBackendSet.SslConfiguration)
 		if !loadBalancerBackendSetMatches(existingBackendSet, desiredPolicy, desiredHealthChecker, existingSSLConfig) {
@@ -407,10 +657,22 @@ func (m *ociLoadBalancerModelImpl) reconcileDefaultBackendSet(
 		return existingBackendSet, nil
 	}
 
+	if err := checkLoadBalancerLimit(
+		params.loadBalancerID,
+		params.shapeName,
+		"backend sets",
+		len(params.knownBackendSets)+1,
+		loadBalancerShapeLimits(params.shapeName).maxBackendSets,
+	); err != nil {
+		return loadbalancer.BackendSet{},
+			fmt.Errorf("failed to create default backend set %s: %w", defaultBackendSetName, err)
+	}
+
 	m.logger.InfoContext(ctx, "Default backend set not found, creating",
 		slog.String("loadBalancerId", params.loadBalancerID),
 		slog.String("name", defaultBackendSetName),
 	)
+	m.fingerprints.forget(endpointsFingerprintKey(params.loadBalancerID, defaultBackendSetName))
 	createRes, err := m.ociClient.CreateBackendSet(ctx, loadbalancer.CreateBackendSetRequest{
 		LoadBalancerId: &params.loadBalancerID,
 		CreateBackendSetDetails: loadbalancer.CreateBackendSetDetails{
@@ -477,9 +739,17 @@ func (m *ociLoadBalancerModelImpl) reconcileListenersCertificates(
 		if reconcileErr != nil {
 			return reconcileListenersCertificatesResult{}, reconcileErr
 		}
-		if len(certs) > 0 {
-			listenerCertificates[string(listenerSpec.Name)] = certs
+		if len(certs) == 0 {
+			continue
+		}
+		if !listenerCertificatesCoverHostname(listenerSpec, certs) {
+			return reconcileListenersCertificatesResult{}, newInvalidCertificateRefError(
+				listenerSpec.Name,
+				fmt.Sprintf("no certificate covers the listener hostname %s", lo.FromPtr(listenerSpec.Hostname)),
+				errListenerHostnameNotCovered,
+			)
 		}
+		listenerCertificates[string(listenerSpec.Name)] = certs
 	}
 
 	return reconcileListenersCertificatesResult{
@@ -532,6 +802,13 @@ func (m *ociLoadBalancerModelImpl) reconcileListenerSecretCertificate(
 	if err != nil {
 		return loadbalancer.Certificate{}, err
 	}
+	if err = validateListenerCertificate(secret, time.Now()); err != nil {
+		return loadbalancer.Certificate{}, newInvalidCertificateRefError(
+			params.listenerSpec.Name,
+			fmt.Sprintf("secret %s/%s has invalid certificate: %s", secret.Namespace, secret.Name, err),
+			err,
+		)
+	}
 
 	certName := ociCertificateNameFromSecret(secret)
 	if cert, ok := params.resultingCertificates[certName]; ok {
@@ -586,11 +863,27 @@ func (m *ociLoadBalancerModelImpl) createListenerCertificate(
 		slog.String("secretVersion", secret.ResourceVersion),
 	)
 
+	chain, err := parseListenerCertificateChain(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return loadbalancer.Certificate{}, &resourceStatusError{
+			conditionType: string(gatewayv1.GatewayConditionAccepted),
+			reason:        string(gatewayv1.GatewayReasonInvalidParameters),
+			message: fmt.Sprintf(
+				"secret %s/%s has invalid %s certificate chain: %s",
+				secret.Namespace, secret.Name, corev1.TLSCertKey, err,
+			),
+			cause: err,
+		}
+	}
+
 	certCreateDetails := loadbalancer.CreateCertificateDetails{
 		CertificateName:   &certName,
-		PublicCertificate: new(string(secret.Data[corev1.TLSCertKey])),
+		PublicCertificate: &chain.publicCertificate,
 		PrivateKey:        new(string(secret.Data[corev1.TLSPrivateKeyKey])),
 	}
+	if chain.caCertificate != "" {
+		certCreateDetails.CaCertificate = &chain.caCertificate
+	}
 	createRes, createErr := m.ociClient.CreateCertificate(ctx, loadbalancer.CreateCertificateRequest{
 		LoadBalancerId:           &loadBalancerID,
 		CreateCertificateDetails: certCreateDetails,
@@ -605,13 +898,14 @@ func (m *ociLoadBalancerModelImpl) createListenerCertificate(
 		)
 	}
 
-	if err := m.workRequestsWatcher.WaitFor(ctx, *createRes.OpcWorkRequestId); err != nil {
+	if err = m.workRequestsWatcher.WaitFor(ctx, *createRes.OpcWorkRequestId); err != nil {
 		return loadbalancer.Certificate{}, fmt.Errorf("failed to wait for certificate %s: %w", certName, err)
 	}
 
 	return loadbalancer.Certificate{
 		CertificateName:   &certName,
 		PublicCertificate: certCreateDetails.PublicCertificate,
+		CaCertificate:     certCreateDetails.CaCertificate,
 	}, nil
 }
 
@@ -658,48 +952,59 @@ func defaultCatchAllRuleMatches(rule loadbalancer.RoutingRule, defaultBackendSet
 		routingRuleForwardsToBackendSet(rule, defaultBackendSetName)
 }
 
+// routingPolicyDefaultRuleDrifted reports whether the catch-all rule of the policy is missing,
+// was modified or is not the last rule. Rules are evaluated in order, so the catch-all
+// rule moved above the route rules would shadow them.
 func routingPolicyDefaultRuleDrifted(policy loadbalancer.RoutingPolicy, defaultBackendSetName string) bool {
-	for _, rule := range policy.Rules {
+	if len(policy.Rules) == 0 {
+		return true
+	}
+	for index, rule := range policy.Rules {
 		if lo.FromPtr(rule.Name) == defaultCatchAllRuleName {
-			return !defaultCatchAllRuleMatches(rule, defaultBackendSetName)
+			return index != len(policy.Rules)-1 || !defaultCatchAllRuleMatches(rule, defaultBackendSetName)
 		}
 	}
 	return true
 }
 
+// desiredRoutingPolicyRulesWithDefault returns the rules of the policy with
+// the restored catch-all rule placed last.
 func desiredRoutingPolicyRulesWithDefault(
 	policy loadbalancer.RoutingPolicy,
 	defaultBackendSetName string,
 ) []loadbalancer.RoutingRule {
 	rules := make([]loadbalancer.RoutingRule, 0, len(policy.Rules)+1)
-	defaultRuleFound := false
 	for _, rule := range policy.Rules {
 		if lo.FromPtr(rule.Name) == defaultCatchAllRuleName {
-			defaultRuleFound = true
-			rules = append(rules, defaultCatchAllRoutingRule(defaultBackendSetName))
 			continue
 		}
 		rules = append(rules, rule)
 	}
-	if !defaultRuleFound {
-		rules = append(rules, defaultCatchAllRoutingRule(defaultBackendSetName))
-	}
-	return rules
+	return append(rules, defaultCatchAllRoutingRule(defaultBackendSetName))
 }
 
 func (m *ociLoadBalancerModelImpl) reconcileListenerRoutingPolicy(
 	ctx context.Context,
 	params reconcileHTTPListenerParams,
 ) error {
-	listenerName := string(params.listenerSpec.Name)
+	listenerName := params.naming.listenerName(params.namePrefix, params.listenerSpec.Name)
 	routingPolicyName := listenerPolicyName(listenerName)
 	policy, ok := params.knownRoutingPolicies[routingPolicyName]
 
 	if !ok {
-		return m.createListenerRoutingPolicy(ctx, params, routingPolicyName, listenerName)
+		rules := []loadbalancer.RoutingRule{defaultCatchAllRoutingRule(params.defaultBackendSetName)}
+		if superseded, found := supersededHTTPListener(params, listenerName); found {
+			// Rules of the routes are moved to the policy of the listener replacing the superseded one
+			if supersededPolicy, policyFound := params.knownRoutingPolicies[lo.FromPtr(
+				superseded.RoutingPolicyName,
+			)]; policyFound {
+				rules = desiredRoutingPolicyRulesWithDefault(supersededPolicy, params.defaultBackendSetName)
+			}
+		}
+		return m.createListenerRoutingPolicy(ctx, params, routingPolicyName, listenerName, rules)
 	}
 	if routingPolicyDefaultRuleDrifted(policy, params.defaultBackendSetName) {
-		return m.updateListenerRoutingPolicyDefaultRule(ctx, params, routingPolicyName, policy)
+		return m.updateListenerRoutingPolicyDefaultRule(ctx, params, routingPolicyName)
 	}
 
 	m.logger.DebugContext(ctx, "Routing policy already exists, skipping creation",
@@ -716,6 +1021,7 @@ func (m *ociLoadBalancerModelImpl) createListenerRoutingPolicy(
 	params reconcileHTTPListenerParams,
 	routingPolicyName string,
 	listenerName string,
+	rules []loadbalancer.RoutingRule,
 ) error {
 	m.logger.InfoContext(ctx, "Creating routing policy for listener",
 		slog.String("loadBalancerId", params.loadBalancerID),
@@ -730,7 +1036,7 @@ func (m *ociLoadBalancerModelImpl) createListenerRoutingPolicy(
 			ConditionLanguageVersion: loadbalancer.CreateRoutingPolicyDetailsConditionLanguageVersionV1,
 			// We're creating routing policy to have it available when reconciling routes.
 			// It's not possible to create an empty routing policy, so we're adding a default rule.
-			Rules: []loadbalancer.RoutingRule{defaultCatchAllRoutingRule(params.defaultBackendSetName)},
+			Rules: rules,
 		},
 	})
 	if err != nil {
@@ -747,12 +1053,41 @@ func (m *ociLoadBalancerModelImpl) createListenerRoutingPolicy(
 	return nil
 }
 
+// updateListenerRoutingPolicyDefaultRule restores the catch-all rule of the listener routing policy.
+// The update is serialized with the route rules commits, so the rules committed by routes
+// reconciled in parallel are preserved.
 func (m *ociLoadBalancerModelImpl) updateListenerRoutingPolicyDefaultRule(
 	ctx context.Context,
 	params reconcileHTTPListenerParams,
 	routingPolicyName string,
-	policy loadbalancer.RoutingPolicy,
 ) error {
+	return m.withRoutingPolicyUpdate(ctx, params.loadBalancerID, routingPolicyName, func() error {
+		return m.updateListenerRoutingPolicyDefaultRuleLocked(ctx, params, routingPolicyName)
+	})
+}
+
+func (m *ociLoadBalancerModelImpl) updateListenerRoutingPolicyDefaultRuleLocked(
+	ctx context.Context,
+	params reconcileHTTPListenerParams,
+	routingPolicyName string,
+) error {
+	// Known policies may be stale by now, rules are merged with the latest policy state
+	policyResponse, err := m.ociClient.GetRoutingPolicy(ctx, loadbalancer.GetRoutingPolicyRequest{
+		RoutingPolicyName: &routingPolicyName,
+		LoadBalancerId:    &params.loadBalancerID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get routing policy %s: %w", routingPolicyName, err)
+	}
+	policy := policyResponse.RoutingPolicy
+	if !routingPolicyDefaultRuleDrifted(policy, params.defaultBackendSetName) {
+		m.logger.DebugContext(ctx, "Routing policy default rule already up to date, skipping update",
+			slog.String("loadBalancerId", params.loadBalancerID),
+			slog.String("routingPolicyName", routingPolicyName),
+		)
+		return nil
+	}
+
 	m.logger.InfoContext(ctx, "Updating routing policy default rule",
 		slog.String("loadBalancerId", params.loadBalancerID),
 		slog.String("routingPolicyName", routingPolicyName),
@@ -762,6 +1097,7 @@ func (m *ociLoadBalancerModelImpl) updateListenerRoutingPolicyDefaultRule(
 	updateRoutingPolicyRes, err := m.ociClient.UpdateRoutingPolicy(ctx, loadbalancer.UpdateRoutingPolicyRequest{
 		LoadBalancerId:    &params.loadBalancerID,
 		RoutingPolicyName: &routingPolicyName,
+		IfMatch:           policyResponse.ETag,
 		UpdateRoutingPolicyDetails: loadbalancer.UpdateRoutingPolicyDetails{
 			ConditionLanguageVersion: loadbalancer.UpdateRoutingPolicyDetailsConditionLanguageVersionEnum(
 				policy.ConditionLanguageVersion,
@@ -770,6 +1106,9 @@ func (m *ociLoadBalancerModelImpl) updateListenerRoutingPolicyDefaultRule(
 		},
 	})
 	if err != nil {
+		if isPreconditionFailedError(err) {
+			return fmt.Errorf("failed to update routing policy %s: %w: %w", routingPolicyName, errRoutingPolicyModified, err)
+		}
 		return fmt.Errorf("failed to update routing policy %s: %w", routingPolicyName, err)
 	}
 	if updateRoutingPolicyRes.OpcWorkRequestId == nil {
@@ -832,7 +1171,7 @@ func (m *ociLoadBalancerModelImpl) reconcileHTTPListener(
 	ctx context.Context,
 	params reconcileHTTPListenerParams,
 ) error {
-	listenerName := string(params.listenerSpec.Name)
+	listenerName := params.naming.listenerName(params.namePrefix, params.listenerSpec.Name)
 
 	if err := m.reconcileListenerRoutingPolicy(ctx, params); err != nil {
 		return fmt.Errorf("failed to reconcile listener routing policy: %w", err)
@@ -851,26 +1190,78 @@ func (m *ociLoadBalancerModelImpl) reconcileHTTPListener(
 				reason:        string(gatewayv1.GatewayReasonInvalidParameters),
 				message: fmt.Sprintf(
 					"listener %s requires certificateRefs or %s TLS option",
-					listenerName,
+					params.listenerSpec.Name,
 					ListenerTLSOptionOCICertificateOCID,
 				),
 			}
 		}
-		cert := params.listenerCertificates[0]
+		cert := selectListenerCertificate(params.listenerSpec, params.listenerCertificates)
 
 		sslConfig = &loadbalancer.SslConfigurationDetails{
 			CertificateName: cert.CertificateName,
 		}
 		applyListenerTLSOptions(sslConfig, params.listenerSpec.TLS)
 	}
+	applyListenerClientVerification(sslConfig, params.listenerSpec.TLS, params.clientCABundleID)
 
 	if existingListener, ok := params.knownListeners[listenerName]; ok {
 		return m.reconcileExistingHTTPListener(ctx, params, listenerName, existingListener, sslConfig)
 	}
+	if superseded, ok := supersededHTTPListener(params, listenerName); ok {
+		return m.replaceSupersededHTTPListener(ctx, params, listenerName, superseded, sslConfig)
+	}
 
 	return m.createHTTPListener(ctx, params, listenerName, sslConfig)
 }
 
+// supersededHTTPListener returns the listener programmed for the gateway listener with
+// another naming scheme, if the listener named after the current scheme does not exist yet.
+func supersededHTTPListener(
+	params reconcileHTTPListenerParams,
+	listenerName string,
+) (loadbalancer.Listener, bool) {
+	if _, ok := params.knownListeners[listenerName]; ok {
+		return loadbalancer.Listener{}, false
+	}
+	for name, listener := range params.knownListeners {
+		if params.naming.supersedesListener(params.namePrefix, *params.listenerSpec, name, listener) {
+			return listener, true
+		}
+	}
+	return loadbalancer.Listener{}, false
+}
+
+// replaceSupersededHTTPListener replaces the listener programmed with another naming scheme.
+// The listeners can not share the port, so the superseded listener is deleted first. Its routing
+// policy is deleted last, the rules of the routes are already copied to the policy of the new listener.
+func (m *ociLoadBalancerModelImpl) replaceSupersededHTTPListener(
+	ctx context.Context,
+	params reconcileHTTPListenerParams,
+	listenerName string,
+	superseded loadbalancer.Listener,
+	sslConfig *loadbalancer.SslConfigurationDetails,
+) error {
+	m.logger.InfoContext(ctx, "Replacing listener programmed with another naming scheme",
+		slog.String("loadBalancerId", params.loadBalancerID),
+		slog.String("listenerName", listenerName),
+		slog.String("supersededListenerName", lo.FromPtr(superseded.Name)),
+	)
+
+	if err := m.deleteMissingListener(ctx, params.loadBalancerID, superseded); err != nil {
+		return err
+	}
+
+	params.knownListeners = lo.OmitByKeys(params.knownListeners, []string{lo.FromPtr(superseded.Name)})
+	if err := m.createHTTPListener(ctx, params, listenerName, sslConfig); err != nil {
+		return err
+	}
+
+	if lo.FromPtr(superseded.RoutingPolicyName) == listenerPolicyName(listenerName) {
+		return nil
+	}
+	return m.deleteMissingRoutingPolicy(ctx, params.loadBalancerID, superseded)
+}
+
 func (m *ociLoadBalancerModelImpl) reconcileExistingHTTPListener(
 	ctx context.Context,
 	params reconcileHTTPListenerParams,
@@ -884,6 +1275,8 @@ func (m *ociLoadBalancerModelImpl) reconcileExistingHTTPListener(
 		listenerSpec:          params.listenerSpec,
 		defaultBackendSetName: params.defaultBackendSetName,
 		sslConfig:             sslConfig,
+		ruleSetNames:          params.ruleSetNames,
+		managedRuleSetNames:   params.managedRuleSetNames,
 	})
 	if !hasChanges {
 		m.logger.DebugContext(ctx, "Listener already up to date, skipping update",
@@ -922,6 +1315,16 @@ func (m *ociLoadBalancerModelImpl) createHTTPListener(
 	listenerName string,
 	sslConfig *loadbalancer.SslConfigurationDetails,
 ) error {
+	if err := checkLoadBalancerLimit(
+		params.loadBalancerID,
+		params.shapeName,
+		"listeners",
+		len(params.knownListeners)+1,
+		loadBalancerShapeLimits(params.shapeName).maxListeners,
+	); err != nil {
+		return fmt.Errorf("failed to create listener %s: %w", listenerName, err)
+	}
+
 	m.logger.InfoContext(ctx, "Listener not found, creating",
 		slog.String("loadBalancerId", params.loadBalancerID),
 		slog.String("name", listenerName),
@@ -933,9 +1336,10 @@ func (m *ociLoadBalancerModelImpl) createHTTPListener(
 			Name:                  new(listenerName),
 			DefaultBackendSetName: new(params.defaultBackendSetName),
 			Port:                  new(int(params.listenerSpec.Port)),
-			Protocol:              new(ociListenerProtocolHTTP),
+			Protocol:              new(expectedHTTPListenerProtocol(loadbalancer.Listener{}, params.listenerSpec)),
 			RoutingPolicyName:     new(listenerPolicyName(listenerName)),
 			SslConfiguration:      sslConfig,
+			RuleSetNames:          params.ruleSetNames,
 		},
 	})
 	if err != nil {
@@ -1009,22 +1413,202 @@ func (m *ociLoadBalancerModelImpl) ensureHTTP2ListenerProtocol(
 	return nil
 }
 
-func (m *ociLoadBalancerModelImpl) reconcileBackendSet(
+func (m *ociLoadBalancerModelImpl) reconcileListenerRuleSet(
 	ctx context.Context,
-	params reconcileBackendSetParams,
+	params reconcileListenerRuleSetParams,
 ) error {
-	backendSetName := ociBackendSetNameFromBackendObjectRef(params.routeNS, params.backendRef.BackendObjectReference)
-	healthCheckerPort := int(lo.FromPtr(params.backendRef.BackendObjectReference.Port))
-	if healthCheckerPort == 0 && len(params.service.Spec.Ports) > 0 {
-		healthCheckerPort = params.service.Spec.Ports[0].TargetPort.IntValue()
+	if len(params.items) == 0 {
+		return nil
+	}
+
+	existingRuleSet, exists := params.knownRuleSets[params.ruleSetName]
+	if exists && ruleSetItemsEqual(existingRuleSet.Items, params.items) {
+		m.logger.DebugContext(ctx, "Rule set already up to date, skipping update",
+			slog.String("loadBalancerId", params.loadBalancerID),
+			slog.String("ruleSetName", params.ruleSetName),
+		)
+		return nil
+	}
+
+	var workRequestID *string
+	if exists {
+		m.logger.InfoContext(ctx, "Updating listener rule set",
+			slog.String("loadBalancerId", params.loadBalancerID),
+			slog.String("ruleSetName", params.ruleSetName),
+		)
+		updateRes, err := m.ociClient.UpdateRuleSet(ctx, loadbalancer.UpdateRuleSetRequest{
+			LoadBalancerId: new(params.loadBalancerID),
+			RuleSetName:    new(params.ruleSetName),
+			UpdateRuleSetDetails: loadbalancer.UpdateRuleSetDetails{
+				Items: params.items,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update rule set %s: %w", params.ruleSetName, err)
+		}
+		workRequestID = updateRes.OpcWorkRequestId
+	} else {
+		m.logger.InfoContext(ctx, "Rule set not found, creating",
+			slog.String("loadBalancerId", params.loadBalancerID),
+			slog.String("ruleSetName", params.ruleSetName),
+		)
+		createRes, err := m.ociClient.CreateRuleSet(ctx, loadbalancer.CreateRuleSetRequest{
+			LoadBalancerId: new(params.loadBalancerID),
+			CreateRuleSetDetails: loadbalancer.CreateRuleSetDetails{
+				Name:  new(params.ruleSetName),
+				Items: params.items,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create rule set %s: %w", params.ruleSetName, err)
+		}
+		workRequestID = createRes.OpcWorkRequestId
+	}
+
+	if workRequestID == nil {
+		return fmt.Errorf("failed to program rule set %s: missing work request id", params.ruleSetName)
 	}
-	if healthCheckerPort == 0 {
-		// Not the best option. Potentially have to be refactored to use
-		// port from the backend ref. Some research is needed.
-		healthCheckerPort = int(params.service.Spec.Ports[0].Port)
+	if err := m.workRequestsWatcher.WaitFor(ctx, *workRequestID); err != nil {
+		return fmt.Errorf("failed to wait for rule set %s: %w", params.ruleSetName, err)
 	}
+
+	return nil
+}
+
+func (m *ociLoadBalancerModelImpl) removeUnusedListenerRuleSet(
+	ctx context.Context,
+	params reconcileListenerRuleSetParams,
+) error {
+	if len(params.items) > 0 {
+		return nil
+	}
+	if _, exists := params.knownRuleSets[params.ruleSetName]; !exists {
+		return nil
+	}
+
+	m.logger.InfoContext(ctx, "Removing unused listener rule set",
+		slog.String("loadBalancerId", params.loadBalancerID),
+		slog.String("ruleSetName", params.ruleSetName),
+	)
+	deleteRes, err := m.ociClient.DeleteRuleSet(ctx, loadbalancer.DeleteRuleSetRequest{
+		LoadBalancerId: new(params.loadBalancerID),
+		RuleSetName:    new(params.ruleSetName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete rule set %s: %w", params.ruleSetName, err)
+	}
+	if deleteRes.OpcWorkRequestId == nil {
+		return fmt.Errorf("failed to delete rule set %s: missing work request id", params.ruleSetName)
+	}
+	if err = m.workRequestsWatcher.WaitFor(ctx, *deleteRes.OpcWorkRequestId); err != nil {
+		return fmt.Errorf("failed to wait for rule set %s deletion: %w", params.ruleSetName, err)
+	}
+
+	return nil
+}
+
+func (m *ociLoadBalancerModelImpl) reconcileRouteRedirectRuleSet(
+	ctx context.Context,
+	params reconcileRouteRedirectRuleSetParams,
+) error {
+	getRes, err := m.ociClient.GetLoadBalancer(ctx, loadbalancer.GetLoadBalancerRequest{
+		LoadBalancerId: new(params.loadBalancerID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get load balancer %s: %w", params.loadBalancerID, err)
+	}
+
+	ruleSetParams := reconcileListenerRuleSetParams{
+		loadBalancerID: params.loadBalancerID,
+		knownRuleSets:  getRes.LoadBalancer.RuleSets,
+		ruleSetName:    params.ruleSetName,
+		items:          params.items,
+	}
+	if err = m.reconcileListenerRuleSet(ctx, ruleSetParams); err != nil {
+		return err
+	}
+
+	for _, listenerName := range slices.Sorted(maps.Keys(getRes.LoadBalancer.Listeners)) {
+		listener := getRes.LoadBalancer.Listeners[listenerName]
+		attached := slices.Contains(listener.RuleSetNames, params.ruleSetName)
+		desired := len(params.items) > 0 && slices.Contains(params.listenerNames, listenerName)
+		if attached == desired {
+			continue
+		}
+
+		// Redirects go first, so the order is kept when the gateway reprograms the listener
+		ruleSetNames := listenerRuleSetNames(listener.RuleSetNames, []string{params.ruleSetName}, nil)
+		if desired {
+			ruleSetNames = append([]string{params.ruleSetName}, ruleSetNames...)
+		}
+		m.logger.InfoContext(ctx, "Updating listener redirect rule set",
+			slog.String("loadBalancerId", params.loadBalancerID),
+			slog.String("listenerName", listenerName),
+			slog.String("ruleSetName", params.ruleSetName),
+			slog.Bool("attached", desired),
+		)
+		updateRes, updateErr := m.ociClient.UpdateListener(ctx, loadbalancer.UpdateListenerRequest{
+			LoadBalancerId: new(params.loadBalancerID),
+			ListenerName:   new(listenerName),
+			UpdateListenerDetails: loadbalancer.UpdateListenerDetails{
+				DefaultBackendSetName:   listener.DefaultBackendSetName,
+				Port:                    listener.Port,
+				Protocol:                listener.Protocol,
+				HostnameNames:           listener.HostnameNames,
+				PathRouteSetName:        listener.PathRouteSetName,
+				RoutingPolicyName:       listener.RoutingPolicyName,
+				SslConfiguration:        sslConfigurationDetailsFromBackendSet(listener.SslConfiguration),
+				ConnectionConfiguration: listener.ConnectionConfiguration,
+				RuleSetNames:            ruleSetNames,
+			},
+		})
+		if updateErr != nil {
+			return fmt.Errorf("failed to update rule sets of listener %s: %w", listenerName, updateErr)
+		}
+		if updateRes.OpcWorkRequestId == nil {
+			return fmt.Errorf("failed to update rule sets of listener %s: missing work request id", listenerName)
+		}
+		if err = m.workRequestsWatcher.WaitFor(ctx, *updateRes.OpcWorkRequestId); err != nil {
+			return fmt.Errorf("failed to wait for listener %s rule sets update: %w", listenerName, err)
+		}
+	}
+
+	return m.removeUnusedListenerRuleSet(ctx, ruleSetParams)
+}
+
+func (m *ociLoadBalancerModelImpl) reconcileBackendSet(
+	ctx context.Context,
+	params reconcileBackendSetParams,
+) error {
+	backendSetName := ociBackendSetNameFromBackendObjectRef(
+		params.naming,
+		params.routeNS,
+		params.backendRef.BackendObjectReference,
+	)
 	desiredPolicy := "ROUND_ROBIN"
-	desiredHealthChecker := loadBalancerBackendSetHealthChecker(healthCheckerPort)
+	var desiredHealthChecker loadbalancer.HealthCheckerDetails
+	if params.externalBackend != nil {
+		desiredHealthChecker = externalBackendHealthChecker(*params.externalBackend)
+	} else {
+		healthCheckerPort, err := m.backendRefHealthCheckerPort(
+			ctx, params.service, params.backendRef, params.nodePortBackends)
+		if err != nil {
+			return fmt.Errorf("failed to derive backend set %s health check port: %w", backendSetName, err)
+		}
+		if healthCheckerPort == 0 && len(params.service.Spec.Ports) > 0 {
+			healthCheckerPort = params.service.Spec.Ports[0].TargetPort.IntValue()
+		}
+		if healthCheckerPort == 0 {
+			if len(params.service.Spec.Ports) == 0 {
+				return fmt.Errorf("service %s has no ports to derive backend set %s health check port",
+					params.service.Name, backendSetName)
+			}
+			// Not the best option. Potentially have to be refactored to use
+			// port from the backend ref. Some research is needed.
+			healthCheckerPort = int(params.service.Spec.Ports[0].Port)
+		}
+		desiredHealthChecker = backendSetHealthChecker(m.serviceHealthCheck(ctx, params.service), healthCheckerPort)
+	}
 
 	getResponse, err := m.ociClient.GetBackendSet(ctx, loadbalancer.GetBackendSetRequest{
 		BackendSetName: &backendSetName,
@@ -1045,11 +1629,16 @@ func (m *ociLoadBalancerModelImpl) reconcileBackendSet(
 		)
 	}
 
+	if err = m.checkBackendSetsLimit(ctx, params.loadBalancerID); err != nil {
+		return fmt.Errorf("failed to create backend set %s: %w", backendSetName, err)
+	}
+
 	m.logger.InfoContext(ctx, "Backend set not found, creating",
 		slog.String("loadBalancerId", params.loadBalancerID),
 		slog.String("backendSetName", backendSetName),
-		slog.Int("healthCheckerPort", healthCheckerPort),
+		slog.Int("healthCheckerPort", lo.FromPtr(desiredHealthChecker.Port)),
 	)
+	m.fingerprints.forget(endpointsFingerprintKey(params.loadBalancerID, backendSetName))
 
 	createRes, err := m.ociClient.CreateBackendSet(ctx, loadbalancer.CreateBackendSetRequest{
 		LoadBalancerId: &params.loadBalancerID,
@@ -1083,6 +1672,7 @@ func (m *ociLoadBalancerModelImpl) deprovisionBackendSet(
 	params deprovisionBackendSetParams,
 ) error {
 	backendSetName := ociBackendSetNameFromBackendObjectRef(
+		params.naming,
 		params.routeNamespace,
 		params.backendRef.BackendObjectReference,
 	)
@@ -1091,6 +1681,13 @@ func (m *ociLoadBalancerModelImpl) deprovisionBackendSet(
 		slog.String("loadBalancerId", params.loadBalancerID),
 		slog.String("backendSetName", backendSetName),
 	)
+	m.fingerprints.forget(endpointsFingerprintKey(params.loadBalancerID, backendSetName))
+
+	if m.drainTimeout > 0 {
+		if err := m.drainBackendSet(ctx, params.loadBalancerID, backendSetName); err != nil {
+			return err
+		}
+	}
 
 	deleteRes, err := m.ociClient.DeleteBackendSet(ctx, loadbalancer.DeleteBackendSetRequest{
 		LoadBalancerId: &params.loadBalancerID,
@@ -1135,50 +1732,218 @@ func (m *ociLoadBalancerModelImpl) deprovisionBackendSet(
 	return nil
 }
 
-func (m *ociLoadBalancerModelImpl) makeRoutingRule(
+func loadBalancerReferencesBackendSet(lb loadbalancer.LoadBalancer, backendSetName string) bool {
+	for _, listener := range lb.Listeners {
+		if lo.FromPtr(listener.DefaultBackendSetName) == backendSetName {
+			return true
+		}
+	}
+	for _, policy := range lb.RoutingPolicies {
+		for _, rule := range policy.Rules {
+			if routingRuleForwardsToBackendSet(rule, backendSetName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// backendSetHealthDrained reports whether none of the backends can still be
+// serving requests, in which case there is nothing left to wait for.
+func backendSetHealthDrained(health loadbalancer.BackendSetHealth) bool {
+	total := lo.FromPtr(health.TotalBackendCount)
+	return total == 0 || len(health.CriticalStateBackendNames) >= total
+}
+
+// drainBackendSet marks all backends of the backend set as draining and waits
+// for the drain timeout so that in-flight requests can complete before the
+// backend set is deleted. The wait ends early once OCI reports every backend
+// as critical. Backend sets that are still referenced by a listener or a
+// routing policy are left untouched, since other routes are still using them.
+func (m *ociLoadBalancerModelImpl) drainBackendSet(
+	ctx context.Context,
+	loadBalancerID string,
+	backendSetName string,
+) error {
+	lbRes, err := m.ociClient.GetLoadBalancer(ctx, loadbalancer.GetLoadBalancerRequest{
+		LoadBalancerId: &loadBalancerID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get load balancer %s: %w", loadBalancerID, err)
+	}
+	if loadBalancerReferencesBackendSet(lbRes.LoadBalancer, backendSetName) {
+		m.logger.InfoContext(ctx, "Backend set is still referenced, skipping drain",
+			slog.String("loadBalancerId", loadBalancerID),
+			slog.String("backendSetName", backendSetName),
+		)
+		return nil
+	}
+
+	getRes, err := m.ociClient.GetBackendSet(ctx, loadbalancer.GetBackendSetRequest{
+		LoadBalancerId: &loadBalancerID,
+		BackendSetName: &backendSetName,
+	})
+	found, lookupErr := backendSetLookupFound(err)
+	if lookupErr != nil {
+		return fmt.Errorf("failed to get backend set %s: %w", backendSetName, lookupErr)
+	}
+	if !found || len(getRes.BackendSet.Backends) == 0 {
+		return nil
+	}
+
+	if lo.SomeBy(getRes.BackendSet.Backends, func(backend loadbalancer.Backend) bool {
+		return !lo.FromPtr(backend.Drain)
+	}) {
+		drainedBackends := lo.Map(getRes.BackendSet.Backends, ociBackendToDetails)
+		for i := range drainedBackends {
+			drainedBackends[i].Drain = new(true)
+		}
+		m.logger.InfoContext(ctx, "Draining backend set",
+			slog.String("loadBalancerId", loadBalancerID),
+			slog.String("backendSetName", backendSetName),
+			slog.Int("backends", len(drainedBackends)),
+			slog.Duration("drainTimeout", m.drainTimeout),
+		)
+		updateRes, updateErr := m.ociClient.UpdateBackendSet(ctx, loadbalancer.UpdateBackendSetRequest{
+			LoadBalancerId:          &loadBalancerID,
+			BackendSetName:          &backendSetName,
+			UpdateBackendSetDetails: makeUpdateOciBackendSetDetails(getRes.BackendSet, drainedBackends),
+		})
+		if updateErr != nil {
+			return fmt.Errorf("failed to drain backend set %s: %w", backendSetName, updateErr)
+		}
+		if updateRes.OpcWorkRequestId == nil {
+			return fmt.Errorf("failed to drain backend set %s: missing work request id", backendSetName)
+		}
+		if err = m.workRequestsWatcher.WaitFor(ctx, *updateRes.OpcWorkRequestId); err != nil {
+			return fmt.Errorf("failed to wait for backend set %s drain: %w", backendSetName, err)
+		}
+	}
+
+	return m.waitForBackendSetDrained(ctx, loadBalancerID, backendSetName)
+}
+
+func (m *ociLoadBalancerModelImpl) waitForBackendSetDrained(
+	ctx context.Context,
+	loadBalancerID string,
+	backendSetName string,
+) error {
+	deadline := time.NewTimer(m.drainTimeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(m.drainPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to wait for backend set %s drain: %w", backendSetName, ctx.Err())
+		case <-deadline.C:
+			m.logger.InfoContext(ctx, "Backend set drain timeout elapsed",
+				slog.String("loadBalancerId", loadBalancerID),
+				slog.String("backendSetName", backendSetName),
+			)
+			return nil
+		case <-ticker.C:
+		}
+
+		healthRes, err := m.ociClient.GetBackendSetHealth(ctx, loadbalancer.GetBackendSetHealthRequest{
+			LoadBalancerId: &loadBalancerID,
+			BackendSetName: &backendSetName,
+		})
+		if err != nil {
+			// Health is only used to finish early, the drain timeout still applies.
+			m.logger.WarnContext(ctx, "Failed to get backend set health while draining",
+				slog.String("loadBalancerId", loadBalancerID),
+				slog.String("backendSetName", backendSetName),
+				diag.ErrAttr(err),
+			)
+			continue
+		}
+		if backendSetHealthDrained(healthRes.BackendSetHealth) {
+			m.logger.InfoContext(ctx, "Backend set has no healthy backends left, finishing drain",
+				slog.String("loadBalancerId", loadBalancerID),
+				slog.String("backendSetName", backendSetName),
+			)
+			return nil
+		}
+	}
+}
+
+func (m *ociLoadBalancerModelImpl) makeRoutingRules(
 	ctx context.Context,
 	params makeRoutingRuleParams,
-) (loadbalancer.RoutingRule, error) {
+) ([]loadbalancer.RoutingRule, error) {
 	rule := params.httpRoute.Spec.Rules[params.httpRouteRuleIndex]
-	return makeBackendRoutingRule(ctx, makeBackendRoutingRuleParams[gatewayv1.HTTPBackendRef]{
+	if _, err := httpRouteRulePriority(params.httpRoute); err != nil {
+		return nil, err
+	}
+	rules, err := makeBackendRoutingRules(ctx, makeBackendRoutingRuleParams[gatewayv1.HTTPBackendRef]{
 		ruleName:       ociListerPolicyRuleName(params.httpRoute, params.httpRouteRuleIndex),
 		routeKind:      "httpRoute",
 		routeName:      fmt.Sprintf("%s/%s", params.httpRoute.Namespace, params.httpRoute.Name),
 		routeRuleIndex: params.httpRouteRuleIndex,
 		backendRefs:    rule.BackendRefs,
 		backendSetName: func(backendRef gatewayv1.HTTPBackendRef) string {
-			return ociBackendSetNameFromBackendRef(params.httpRoute, backendRef)
+			return ociBackendSetNameFromBackendRef(params.naming, params.httpRoute, backendRef)
 		},
-		mapCondition: func() (string, error) {
-			return m.routingRulesMapper.mapHTTPRouteHostnamesAndMatchesToCondition(
+		matchCount: len(rule.Matches),
+		mapCondition: func(from, to int) (string, error) {
+			condition, err := m.routingRulesMapper.mapHTTPRouteHostnamesAndMatchesToCondition(
 				params.httpRoute.Spec.Hostnames,
-				rule.Matches,
+				rule.Matches[from:to],
 			)
+			if err != nil {
+				return "", err
+			}
+			filterConditions, err := mapHTTPRouteExtensionRefFilters(ctx, params.httpRoute, params.httpRouteRuleIndex)
+			if err != nil {
+				return "", err
+			}
+			return allRoutingConditions(append([]string{condition}, filterConditions...)...), nil
+		},
+		partRuleName: func(part int) string {
+			return ociListerPolicyRulePartName(params.httpRoute, params.httpRouteRuleIndex, part)
 		},
 		conditionErrContext: "failed to map http route matches to condition",
 	}, m.buildForwardRoutingRule)
+	if err != nil || len(rule.BackendRefs) > 0 {
+		return rules, err
+	}
+
+	// Filter-only rule. OCI routing policies can only forward to backend sets, so the rule is
+	// not programmed instead of forwarding to nothing. RequestRedirect rules are programmed
+	// as listener rule set redirects, see httpRouteRedirectRuleSetItems.
+	m.logger.DebugContext(ctx, "Skipping http route rule without backendRefs",
+		slog.String("httpRoute", fmt.Sprintf("%s/%s", params.httpRoute.Namespace, params.httpRoute.Name)),
+		slog.Int("httpRouteRuleIndex", params.httpRouteRuleIndex),
+	)
+	return nil, nil
 }
 
-func (m *ociLoadBalancerModelImpl) makeGRPCRoutingRule(
+func (m *ociLoadBalancerModelImpl) makeGRPCRoutingRules(
 	ctx context.Context,
 	params makeGRPCRoutingRuleParams,
-) (loadbalancer.RoutingRule, error) {
+) ([]loadbalancer.RoutingRule, error) {
 	rule := params.grpcRoute.Spec.Rules[params.grpcRouteRuleIndex]
-	return makeBackendRoutingRule(ctx, makeBackendRoutingRuleParams[gatewayv1.GRPCBackendRef]{
+	return makeBackendRoutingRules(ctx, makeBackendRoutingRuleParams[gatewayv1.GRPCBackendRef]{
 		ruleName:       ociGRPCListenerPolicyRuleName(params.grpcRoute, params.grpcRouteRuleIndex),
 		routeKind:      "grpcRoute",
 		routeName:      fmt.Sprintf("%s/%s", params.grpcRoute.Namespace, params.grpcRoute.Name),
 		routeRuleIndex: params.grpcRouteRuleIndex,
 		backendRefs:    rule.BackendRefs,
 		backendSetName: func(backendRef gatewayv1.GRPCBackendRef) string {
-			return ociBackendSetNameFromGRPCBackendRef(params.grpcRoute, backendRef)
+			return ociBackendSetNameFromGRPCBackendRef(params.naming, params.grpcRoute, backendRef)
 		},
-		mapCondition: func() (string, error) {
+		matchCount: len(rule.Matches),
+		mapCondition: func(from, to int) (string, error) {
 			return m.routingRulesMapper.mapGRPCRouteHostnamesAndMatchesToCondition(
 				params.grpcRoute.Spec.Hostnames,
-				rule.Matches,
+				rule.Matches[from:to],
 			)
 		},
+		partRuleName: func(part int) string {
+			return ociGRPCListenerPolicyRulePartName(params.grpcRoute, params.grpcRouteRuleIndex, part)
+		},
 		conditionErrContext: "failed to map grpc route matches to condition",
 	}, m.buildForwardRoutingRule)
 }
@@ -1192,28 +1957,87 @@ type buildForwardRoutingRuleParams struct {
 	targetBackends []string
 }
 
-func makeBackendRoutingRule[T any](
+func makeBackendRoutingRules[T any](
 	ctx context.Context,
 	params makeBackendRoutingRuleParams[T],
 	buildForwardRoutingRule func(context.Context, buildForwardRoutingRuleParams) loadbalancer.RoutingRule,
-) (loadbalancer.RoutingRule, error) {
+) ([]loadbalancer.RoutingRule, error) {
 	targetBackends := lo.Map(params.backendRefs, func(backendRef T, _ int) string {
 		return params.backendSetName(backendRef)
 	})
 
-	condition, err := params.mapCondition()
+	conditions, err := backendRoutingRuleConditions(params)
 	if err != nil {
-		return loadbalancer.RoutingRule{}, fmt.Errorf("%s: %w", params.conditionErrContext, err)
+		return nil, err
 	}
 
-	return buildForwardRoutingRule(ctx, buildForwardRoutingRuleParams{
-		routeKind:      params.routeKind,
-		routeName:      params.routeName,
-		routeRuleIndex: params.routeRuleIndex,
-		ruleName:       params.ruleName,
-		condition:      condition,
-		targetBackends: targetBackends,
-	}), nil
+	rules := make([]loadbalancer.RoutingRule, len(conditions))
+	for part, condition := range conditions {
+		ruleName := params.ruleName
+		if part > 0 {
+			ruleName = params.partRuleName(part)
+		}
+		rules[part] = buildForwardRoutingRule(ctx, buildForwardRoutingRuleParams{
+			routeKind:      params.routeKind,
+			routeName:      params.routeName,
+			routeRuleIndex: params.routeRuleIndex,
+			ruleName:       ruleName,
+			condition:      condition,
+			targetBackends: targetBackends,
+		})
+	}
+	return rules, nil
+}
+
+// backendRoutingRuleConditions maps matches of the route rule to conditions of routing rules.
+// Usually it's a single condition. If it exceeds the OCI limit, consecutive matches are grouped
+// into conditions that fit the limit, each group is programmed as a separate routing rule.
+func backendRoutingRuleConditions[T any](params makeBackendRoutingRuleParams[T]) ([]string, error) {
+	condition, err := params.mapCondition(0, params.matchCount)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", params.conditionErrContext, err)
+	}
+	if len(condition) <= maxRoutingRuleConditionLength {
+		return []string{condition}, nil
+	}
+	if params.matchCount <= 1 {
+		return nil, routingPolicyCapacityError{
+			message: fmt.Sprintf(
+				"routing rule %s condition is %d characters long, the limit is %d",
+				params.ruleName, len(condition), maxRoutingRuleConditionLength,
+			),
+		}
+	}
+
+	var conditions []string
+	for from := 0; from < params.matchCount; {
+		groupCondition, groupErr := params.mapCondition(from, from+1)
+		if groupErr != nil {
+			return nil, fmt.Errorf("%s: %w", params.conditionErrContext, groupErr)
+		}
+		if len(groupCondition) > maxRoutingRuleConditionLength {
+			return nil, routingPolicyCapacityError{
+				message: fmt.Sprintf(
+					"routing rule %s match %d condition is %d characters long, the limit is %d",
+					params.ruleName, from, len(groupCondition), maxRoutingRuleConditionLength,
+				),
+			}
+		}
+		to := from + 1
+		for ; to < params.matchCount; to++ {
+			nextCondition, nextErr := params.mapCondition(from, to+1)
+			if nextErr != nil {
+				return nil, fmt.Errorf("%s: %w", params.conditionErrContext, nextErr)
+			}
+			if len(nextCondition) > maxRoutingRuleConditionLength {
+				break
+			}
+			groupCondition = nextCondition
+		}
+		conditions = append(conditions, groupCondition)
+		from = to
+	}
+	return conditions, nil
 }
 
 func (m *ociLoadBalancerModelImpl) buildForwardRoutingRule(
@@ -1276,48 +2100,91 @@ func (m *ociLoadBalancerModelImpl) deleteMissingRoutingPolicy(
 	loadBalancerID string,
 	listener loadbalancer.Listener,
 ) error {
-	if listener.RoutingPolicyName != nil {
-		m.logger.DebugContext(ctx, "Deleting routing policy",
-			slog.String("routingPolicyName", *listener.RoutingPolicyName),
-			slog.String("loadBalancerId", loadBalancerID),
-		)
-		var deletePolicyRes loadbalancer.DeleteRoutingPolicyResponse
-		deletePolicyRes, err := m.ociClient.DeleteRoutingPolicy(ctx, loadbalancer.DeleteRoutingPolicyRequest{
-			LoadBalancerId:    &loadBalancerID,
-			RoutingPolicyName: listener.RoutingPolicyName,
-		})
-		if err != nil {
-			return fmt.Errorf("failed to delete routing policy %s: %w", *listener.RoutingPolicyName, err)
-		}
-		if deletePolicyRes.OpcWorkRequestId == nil {
-			return fmt.Errorf(
-				"failed to delete routing policy %s: missing work request id",
-				*listener.RoutingPolicyName,
-			)
-		}
-
-		if err = m.workRequestsWatcher.WaitFor(ctx, *deletePolicyRes.OpcWorkRequestId); err != nil {
-			return fmt.Errorf("failed to wait for routing policy %s deletion: %w", *listener.RoutingPolicyName, err)
-		}
+	if listener.RoutingPolicyName == nil {
+		return nil
 	}
 
-	return nil
+	// Routes committing rules to the policy in parallel must not interleave with the deletion
+	return m.routingPolicyLocks.withLock(
+		routingPolicyLockKey(loadBalancerID, *listener.RoutingPolicyName),
+		func() error {
+			m.logger.DebugContext(ctx, "Deleting routing policy",
+				slog.String("routingPolicyName", *listener.RoutingPolicyName),
+				slog.String("loadBalancerId", loadBalancerID),
+			)
+			var deletePolicyRes loadbalancer.DeleteRoutingPolicyResponse
+			deletePolicyRes, err := m.ociClient.DeleteRoutingPolicy(ctx, loadbalancer.DeleteRoutingPolicyRequest{
+				LoadBalancerId:    &loadBalancerID,
+				RoutingPolicyName: listener.RoutingPolicyName,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to delete routing policy %s: %w", *listener.RoutingPolicyName, err)
+			}
+			if deletePolicyRes.OpcWorkRequestId == nil {
+				return fmt.Errorf(
+					"failed to delete routing policy %s: missing work request id",
+					*listener.RoutingPolicyName,
+				)
+			}
+
+			if err = m.workRequestsWatcher.WaitFor(ctx, *deletePolicyRes.OpcWorkRequestId); err != nil {
+				return fmt.Errorf("failed to wait for routing policy %s deletion: %w", *listener.RoutingPolicyName, err)
+			}
+			return nil
+		},
+	)
 }
 
 func (m *ociLoadBalancerModelImpl) removeMissingListeners(
 	ctx context.Context,
 	params removeMissingListenersParams,
 ) error {
-	// TODO: Investigate desired behavior when attempting to delete listeners
-	// that have rules associated with them.
-
 	gatewayListenerNames := lo.SliceToMap(params.gatewayListeners, func(l gatewayv1.Listener) (string, struct{}) {
-		return string(l.Name), struct{}{}
+		return params.naming.listenerName(params.namePrefix, l.Name), struct{}{}
 	})
 
 	var errs []error
+	blockers := map[string][]string{}
+	var protected []string
 	for listenerName, listener := range params.knownListeners {
+		if !strings.HasPrefix(listenerName, params.namePrefix) {
+			// Listener belongs to other gateway sharing the load balancer
+			continue
+		}
+		if slices.Contains(params.adoptedListeners, listenerName) {
+			m.logger.DebugContext(ctx, "Keeping adopted listener not found in gateway spec",
+				slog.String("listenerName", listenerName),
+				slog.String("loadBalancerId", params.loadBalancerID),
+			)
+			continue
+		}
+		if slices.ContainsFunc(params.gatewayListeners, func(l gatewayv1.Listener) bool {
+			return params.naming.supersedesListener(params.namePrefix, l, listenerName, listener)
+		}) {
+			// Replaced by the listener named after the naming scheme when the gateway listener is programmed
+			continue
+		}
 		if _, existsInGateway := gatewayListenerNames[listenerName]; !existsInGateway {
+			sectionName := string(params.naming.listenerSectionName(params.namePrefix, listenerName))
+			if slices.Contains(params.protectedListeners, sectionName) {
+				m.logger.WarnContext(ctx, "Listener is protected from deletion, skipping removal",
+					slog.String("listenerName", listenerName),
+					slog.String("loadBalancerId", params.loadBalancerID),
+				)
+				protected = append(protected, sectionName)
+				continue
+			}
+			if owners := listenerRouteRuleOwners(params, listenerName, listener); len(owners) > 0 {
+				// Deleting the listener would drop the traffic of the routes
+				m.logger.WarnContext(ctx, "Listener still has rules of existing routes, skipping removal",
+					slog.String("listenerName", listenerName),
+					slog.String("loadBalancerId", params.loadBalancerID),
+					slog.Any("routes", owners),
+				)
+				blockers[sectionName] = owners
+				continue
+			}
+
 			if err := m.deleteMissingListener(ctx, params.loadBalancerID, listener); err != nil {
 				m.logger.WarnContext(ctx, "Failed to delete listener, will try with others",
 					diag.ErrAttr(err),
@@ -1342,6 +2209,13 @@ func (m *ociLoadBalancerModelImpl) removeMissingListeners(
 		}
 	}
 
+	if len(blockers) > 0 {
+		errs = append(errs, newListenerInUseError(blockers))
+	}
+	if len(protected) > 0 {
+		errs = append(errs, newListenerProtectedError(protected))
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -1354,11 +2228,21 @@ func routingRuleLess(ruleI, ruleJ loadbalancer.RoutingRule) bool {
 	if ruleNameJ == defaultCatchAllRuleName {
 		return true
 	}
+	priorityI := routingRulePriorityOf(ruleI)
+	priorityJ := routingRulePriorityOf(ruleJ)
+	if priorityI != priorityJ {
+		return priorityI > priorityJ
+	}
 	grpcRuleI := routingRuleMatchesNativeGRPC(ruleI)
 	grpcRuleJ := routingRuleMatchesNativeGRPC(ruleJ)
 	if grpcRuleI != grpcRuleJ {
 		return grpcRuleI
 	}
+	precedenceI := routingConditionPrecedenceOf(lo.FromPtr(ruleI.Condition))
+	precedenceJ := routingConditionPrecedenceOf(lo.FromPtr(ruleJ.Condition))
+	if result := precedenceI.compare(precedenceJ); result != 0 {
+		return result < 0
+	}
 	return ruleNameI < ruleNameJ
 }
 
@@ -1369,6 +2253,63 @@ func routingRuleMatchesNativeGRPC(rule loadbalancer.RoutingRule) bool {
 		strings.Contains(condition, "sw (i 'application/grpc;')")
 }
 
+// routingRulePackKey identifies rules that can be combined into a single rule.
+type routingRulePackKey struct {
+	priority        int
+	nativeGRPC      bool
+	precedence      routingConditionPrecedence
+	backendSetNames string
+}
+
+func routingRulePackKeyOf(rule loadbalancer.RoutingRule) (routingRulePackKey, bool) {
+	backendSetNames := make([]string, 0, len(rule.Actions))
+	for _, action := range rule.Actions {
+		forward, ok := action.(loadbalancer.ForwardToBackendSet)
+		if !ok {
+			return routingRulePackKey{}, false
+		}
+		backendSetNames = append(backendSetNames, lo.FromPtr(forward.BackendSetName))
+	}
+	return routingRulePackKey{
+		priority:        routingRulePriorityOf(rule),
+		nativeGRPC:      routingRuleMatchesNativeGRPC(rule),
+		precedence:      routingConditionPrecedenceOf(lo.FromPtr(rule.Condition)),
+		backendSetNames: strings.Join(backendSetNames, ","),
+	}, true
+}
+
+// packRoutingRules combines rules forwarding to the same backend sets into a single rule
+// matching any of their conditions, so the route takes fewer rules of the routing policy.
+// OCI listeners reference a single routing policy, so rules can't be spread over multiple
+// policies. Only rules of the same priority and precedence are combined, the combined rule
+// is sorted as the rules it replaces and is named after the first of them.
+func packRoutingRules(rules []loadbalancer.RoutingRule) []loadbalancer.RoutingRule {
+	sorted := sortedRoutingRules(rules)
+	packed := make([]loadbalancer.RoutingRule, 0, len(sorted))
+	packIndexByKey := make(map[routingRulePackKey]int, len(sorted))
+	for _, rule := range sorted {
+		key, ok := routingRulePackKeyOf(rule)
+		if !ok {
+			packed = append(packed, rule)
+			continue
+		}
+		if index, found := packIndexByKey[key]; found {
+			alternatives := append(
+				splitAnyRoutingCondition(lo.FromPtr(packed[index].Condition)),
+				splitAnyRoutingCondition(lo.FromPtr(rule.Condition))...,
+			)
+			condition := fmt.Sprintf("any(%s)", strings.Join(alternatives, ", "))
+			if len(condition) <= maxRoutingRuleConditionLength {
+				packed[index].Condition = new(condition)
+				continue
+			}
+		}
+		packIndexByKey[key] = len(packed)
+		packed = append(packed, rule)
+	}
+	return packed
+}
+
 func sortRoutingRules(rules []loadbalancer.RoutingRule) {
 	sort.Slice(rules, func(i, j int) bool {
 		return routingRuleLess(rules[i], rules[j])
@@ -1436,10 +2377,38 @@ func (m *ociLoadBalancerModelImpl) commitRoutingPolicy(
 	params commitRoutingPolicyParams,
 ) error {
 	policyName := listenerPolicyName(params.listenerName)
+	return m.withRoutingPolicyUpdate(ctx, params.loadBalancerID, policyName, func() error {
+		return m.commitRoutingPolicyLocked(ctx, params, policyName)
+	})
+}
+
+// withRoutingPolicyUpdate serializes read-merge-write updates of the routing policy.
+// All updates of the listener routing policy made by this replica must go through it,
+// otherwise rules committed by routes reconciled in parallel can be dropped.
+func (m *ociLoadBalancerModelImpl) withRoutingPolicyUpdate(
+	ctx context.Context,
+	loadBalancerID string,
+	policyName string,
+	update func() error,
+) error {
 	return m.routingPolicyLocks.withLock(
-		routingPolicyLockKey(params.loadBalancerID, policyName),
+		routingPolicyLockKey(loadBalancerID, policyName),
 		func() error {
-			return m.commitRoutingPolicyLocked(ctx, params, policyName)
+			// The lock only covers this replica. The policy is updated with If-Match,
+			// so changes made by other replicas in between are merged on retry.
+			var err error
+			for attempt := 1; attempt <= maxRoutingPolicyUpdateAttempts; attempt++ {
+				err = update()
+				if !errors.Is(err, errRoutingPolicyModified) {
+					return err
+				}
+				m.logger.InfoContext(ctx, "Routing policy was modified concurrently, retrying",
+					slog.String("loadBalancerId", loadBalancerID),
+					slog.String("policyName", policyName),
+					slog.Int("attempt", attempt),
+				)
+			}
+			return err
 		},
 	)
 }
@@ -1454,37 +2423,64 @@ func (m *ociLoadBalancerModelImpl) commitRoutingPolicyLocked(
 		LoadBalancerId:    &params.loadBalancerID,
 	})
 	if err != nil {
+		serviceErr, ok := common.IsServiceError(err)
+		if ok && serviceErr.GetHTTPStatusCode() == http.StatusNotFound && len(params.policyRules) == 0 {
+			// Previous rules were committed to a listener that was removed since
+			m.logger.InfoContext(ctx, "Routing policy not found, assuming previous rules already removed",
+				slog.String("loadBalancerId", params.loadBalancerID),
+				slog.String("policyName", policyName),
+				slog.Any("prevPolicyRules", params.prevPolicyRules),
+			)
+			return nil
+		}
 		return fmt.Errorf("failed to get routing policy %s: %w", policyName, err)
 	}
 
-	currentRulesByName := lo.SliceToMap(
-		policyResponse.RoutingPolicy.Rules,
-		func(rule loadbalancer.RoutingRule) (string, loadbalancer.RoutingRule) {
-			return lo.FromPtr(rule.Name), rule
-		},
-	)
-
-	policyRulesNames := make(map[string]struct{})
+	routeRuleNames := make(map[string]struct{}, len(params.policyRules)+len(params.prevPolicyRules))
 	for _, newRule := range params.policyRules {
-		ruleName := lo.FromPtr(newRule.Name)
-		currentRulesByName[ruleName] = newRule
-		policyRulesNames[ruleName] = struct{}{}
+		routeRuleNames[lo.FromPtr(newRule.Name)] = struct{}{}
 	}
 
 	for _, prevRuleName := range params.prevPolicyRules {
-		if _, ok := policyRulesNames[prevRuleName]; !ok {
+		if _, ok := routeRuleNames[prevRuleName]; !ok {
 			m.logger.InfoContext(ctx, "Deleting previous policy rule",
 				slog.String("ruleName", prevRuleName),
 				slog.String("loadBalancerId", params.loadBalancerID),
 				slog.String("policyName", policyName),
 			)
-			delete(currentRulesByName, prevRuleName)
+			routeRuleNames[prevRuleName] = struct{}{}
 		}
 	}
 
-	mergedRules := lo.Values(currentRulesByName)
+	// Rules of other routes are kept as is, rules of the route are replaced
+	otherRules := lo.Reject(policyResponse.RoutingPolicy.Rules, func(rule loadbalancer.RoutingRule, _ int) bool {
+		_, ok := routeRuleNames[lo.FromPtr(rule.Name)]
+		return ok
+	})
+	mergedRules := append(slices.Clone(otherRules), params.policyRules...)
+	if len(mergedRules) > maxRoutingPolicyRules {
+		packedRules := packRoutingRules(params.policyRules)
+		m.logger.InfoContext(ctx, "Routing policy rules limit reached, packing route rules",
+			slog.String("loadBalancerId", params.loadBalancerID),
+			slog.String("policyName", policyName),
+			slog.Int("routeRules", len(params.policyRules)),
+			slog.Int("packedRules", len(packedRules)),
+		)
+		mergedRules = append(otherRules, packedRules...)
+	}
 	sortRoutingRules(mergedRules)
 
+	remainingRules := maxRoutingPolicyRules - len(mergedRules)
+	if remainingRules < 0 {
+		return routingPolicyCapacityError{
+			message: fmt.Sprintf(
+				"routing policy of listener %s would have %d rules, the limit is %d",
+				params.listenerName, len(mergedRules), maxRoutingPolicyRules,
+			),
+		}
+	}
+	m.metrics.setRemainingRules(params.loadBalancerID, params.listenerName, remainingRules)
+
 	if routingRulesEqual(policyResponse.RoutingPolicy.Rules, mergedRules) {
 		m.logger.DebugContext(ctx, "Routing policy already up to date, skipping update",
 			slog.String("loadBalancerId", params.loadBalancerID),
@@ -1496,6 +2492,7 @@ func (m *ociLoadBalancerModelImpl) commitRoutingPolicyLocked(
 	updateRes, err := m.ociClient.UpdateRoutingPolicy(ctx, loadbalancer.UpdateRoutingPolicyRequest{
 		LoadBalancerId:    &params.loadBalancerID,
 		RoutingPolicyName: &policyName,
+		IfMatch:           policyResponse.ETag,
 		UpdateRoutingPolicyDetails: loadbalancer.UpdateRoutingPolicyDetails{
 			ConditionLanguageVersion: loadbalancer.UpdateRoutingPolicyDetailsConditionLanguageVersionEnum(
 				policyResponse.RoutingPolicy.ConditionLanguageVersion,
@@ -1504,6 +2501,9 @@ func (m *ociLoadBalancerModelImpl) commitRoutingPolicyLocked(
 		},
 	})
 	if err != nil {
+		if isPreconditionFailedError(err) {
+			return fmt.Errorf("failed to update routing policy %s: %w: %w", policyName, errRoutingPolicyModified, err)
+		}
 		m.logger.WarnContext(ctx, "Failed to update routing policy",
 			diag.ErrAttr(err),
 			slog.String("loadBalancerId", params.loadBalancerID),
@@ -1530,6 +2530,16 @@ func (m *ociLoadBalancerModelImpl) commitRoutingPolicyLocked(
 	return nil
 }
 
+// errRoutingPolicyModified indicates the routing policy ETag did not match on update.
+var errRoutingPolicyModified = errors.New("routing policy was modified concurrently")
+
+func isPreconditionFailedError(err error) bool {
+	serviceErr, ok := common.IsServiceError(err)
+	return ok && serviceErr.GetHTTPStatusCode() == http.StatusPreconditionFailed
+}
+
+const maxRoutingPolicyUpdateAttempts = 3
+
 func routingPolicyLockKey(loadBalancerID, policyName string) string {
 	return loadBalancerID + "/" + policyName
 }
@@ -1655,35 +2665,60 @@ func isValidOCIRoutingPolicyName(policyName string) bool {
 // ociListerPolicyRuleName returns the name of the routing rule for the listener policy.
 // It's expected that the rule name is unique within the listener policy for every route.
 // Names should also be sortable, so we're using a 4 digit index.
+// Names of rules with explicit priority are prefixed with the priority.
 func ociListerPolicyRuleName(route gatewayv1.HTTPRoute, ruleIndex int) string {
+	return ociListerPolicyRulePartName(route, ruleIndex, 0)
+}
+
+// ociListerPolicyRulePartName returns the name of the routing rule holding a part of the route
+// rule matches. The first part is named as the rule itself.
+func ociListerPolicyRulePartName(route gatewayv1.HTTPRoute, ruleIndex int, part int) string {
 	rule := route.Spec.Rules[ruleIndex]
 	nameParts := []string{route.Namespace, route.Name}
 
 	if rule.Name != nil {
 		nameParts = append(nameParts, string(*rule.Name))
 	}
+	if part > 0 {
+		nameParts = append(nameParts, "part"+strconv.Itoa(part))
+	}
 
-	return ociListenerPolicyRuleNameFromParts(ruleIndex, nameParts...)
+	return ociPrioritizedListenerPolicyRuleName(httpRouteRulePriorityOrDefault(route), ruleIndex, nameParts...)
 }
 
 func ociGRPCListenerPolicyRuleName(route gatewayv1.GRPCRoute, ruleIndex int) string {
+	return ociGRPCListenerPolicyRulePartName(route, ruleIndex, 0)
+}
+
+func ociGRPCListenerPolicyRulePartName(route gatewayv1.GRPCRoute, ruleIndex int, part int) string {
 	rule := route.Spec.Rules[ruleIndex]
 	nameParts := []string{"grpc", route.Namespace, route.Name}
 
 	if rule.Name != nil {
 		nameParts = append(nameParts, string(*rule.Name))
 	}
+	if part > 0 {
+		nameParts = append(nameParts, "part"+strconv.Itoa(part))
+	}
 
 	return ociListenerPolicyRuleNameFromParts(ruleIndex, nameParts...)
 }
 
 func ociListenerPolicyRuleNameFromParts(ruleIndex int, nameParts ...string) string {
+	return ociPrioritizedListenerPolicyRuleName(0, ruleIndex, nameParts...)
+}
+
+func ociPrioritizedListenerPolicyRuleName(priority int, ruleIndex int, nameParts ...string) string {
 	resultingName := fmt.Sprintf(
 		"p%04d_%08x_%s",
 		ruleIndex,
 		crc32.ChecksumIEEE([]byte(ociListenerPolicyRuleIdentity(ruleIndex, nameParts...))),
 		strings.Join(nameParts, "_"),
 	)
+	if priority != 0 {
+		// Truncated names keep at least 12 leading characters, so the priority can be parsed back
+		resultingName = routingRulePriorityNamePrefix(priority) + "_" + resultingName
+	}
 
 	return ociapi.ConstructOCIResourceName(resultingName, ociapi.OCIResourceNameConfig{
 		MaxLength:           maxListenerPolicyNameLength,
@@ -1702,40 +2737,75 @@ func ociListenerPolicyRuleIdentity(ruleIndex int, nameParts ...string) string {
 
 // ociBackendSetName returns the name of the backend set for the route.
 // It's expected that the backend set name is unique within the load balancer for every route.
+// The port is part of the name, so backendRefs to the same service on different ports
+// get separate backend sets.
 // Sorting is not required, but keeping padding for consistency and readability.
-func ociBackendSetNameFromBackendRef(httpRoute gatewayv1.HTTPRoute, backendRef gatewayv1.HTTPBackendRef) string {
-	return ociBackendSetNameFromBackendObjectRef(httpRoute.Namespace, backendRef.BackendObjectReference)
+func ociBackendSetNameFromBackendRef(
+	naming ociResourceNaming,
+	httpRoute gatewayv1.HTTPRoute,
+	backendRef gatewayv1.HTTPBackendRef,
+) string {
+	return ociBackendSetNameFromBackendObjectRef(naming, httpRoute.Namespace, backendRef.BackendObjectReference)
 }
 
-func ociBackendSetNameFromGRPCBackendRef(grpcRoute gatewayv1.GRPCRoute, backendRef gatewayv1.GRPCBackendRef) string {
-	return ociBackendSetNameFromBackendObjectRef(grpcRoute.Namespace, backendRef.BackendObjectReference)
+func ociBackendSetNameFromGRPCBackendRef(
+	naming ociResourceNaming,
+	grpcRoute gatewayv1.GRPCRoute,
+	backendRef gatewayv1.GRPCBackendRef,
+) string {
+	return ociBackendSetNameFromBackendObjectRef(naming, grpcRoute.Namespace, backendRef.BackendObjectReference)
 }
 
 func ociBackendSetNameFromBackendObjectRef(
+	naming ociResourceNaming,
 	defaultNamespace string,
 	backendRef gatewayv1.BackendObjectReference,
 ) string {
-	refName := string(backendRef.Name)
 	refNamespace := string(lo.FromPtr(backendRef.Namespace))
 	if refNamespace == "" {
 		refNamespace = defaultNamespace
 	}
 
-	originalName := fmt.Sprintf("%s-%s", refNamespace, refName)
+	nameParts := []string{refNamespace, string(backendRef.Name)}
 	if backendRef.Port != nil {
-		originalName = fmt.Sprintf("%s-%d", originalName, *backendRef.Port)
+		nameParts = append(nameParts, strconv.Itoa(int(*backendRef.Port)))
 	}
 
-	return ociapi.ConstructOCIResourceName(originalName, ociapi.OCIResourceNameConfig{
-		MaxLength: maxBackendSetNameLength,
-	})
+	return naming.backendSetName(nameParts...)
 }
 
-func ociBackendSetNameFromService(service corev1.Service) string {
-	originalName := service.Namespace + "-" + service.Name
-	return ociapi.ConstructOCIResourceName(originalName, ociapi.OCIResourceNameConfig{
-		MaxLength: maxBackendSetNameLength,
-	})
+// ociDefaultBackendSetName returns the name of the backend set receiving traffic
+// not matched by any route of the gateway.
+func ociDefaultBackendSetName(naming ociResourceNaming, gateway *gatewayv1.Gateway, namePrefix string) string {
+	if namePrefix == "" && naming.isDefault() {
+		return gateway.Name + "-default"
+	}
+	return ociapi.ConstructOCIResourceName(namePrefix+naming.joinNameParts(gateway.Name, "default"),
+		ociapi.OCIResourceNameConfig{
+			MaxLength: maxBackendSetNameLength,
+		})
+}
+
+// ociGatewayNamePrefix returns the prefix of OCI listener, routing policy and default
+// backend set names programmed for the gateway. It starts with the configured name prefix.
+// Gateways sharing a load balancer get a prefix derived from the gateway namespace and name,
+// so the resources of one gateway can be told apart from the resources of another one.
+// Empty for not shared load balancers without the configured name prefix.
+func ociGatewayNamePrefix(gateway *gatewayv1.Gateway, config types.GatewayConfig) string {
+	namePrefix := ""
+	if config.Spec.NamePrefix != "" {
+		namePrefix = config.Spec.NamePrefix + "_"
+	}
+	if !config.Spec.SharedLoadBalancer {
+		return namePrefix
+	}
+	sum := sha256.Sum256([]byte(gateway.Namespace + "/" + gateway.Name))
+	return namePrefix + "gw_" + hex.EncodeToString(sum[:])[:ociGatewayNamePrefixHashLength] + "_"
+}
+
+// ociGatewayListenerName returns the name of the OCI listener programmed for the gateway listener.
+func ociGatewayListenerName(namePrefix string, listenerName gatewayv1.SectionName) string {
+	return namePrefix + string(listenerName)
 }
 
 type makeOciListenerUpdateDetailsParams struct {
@@ -1744,12 +2814,14 @@ type makeOciListenerUpdateDetailsParams struct {
 	listenerSpec          *gatewayv1.Listener
 	defaultBackendSetName string
 	sslConfig             *loadbalancer.SslConfigurationDetails
+	ruleSetNames          []string
+	managedRuleSetNames   []string
 }
 
 func makeOciListenerUpdateDetails(
 	params makeOciListenerUpdateDetailsParams,
 ) (loadbalancer.UpdateListenerDetails, bool) {
-	expectedProtocol := expectedHTTPListenerProtocol(params.existingListenerData)
+	expectedProtocol := expectedHTTPListenerProtocol(params.existingListenerData, params.listenerSpec)
 	hasChanges := params.existingListenerData.Protocol == nil ||
 		*params.existingListenerData.Protocol != expectedProtocol
 
@@ -1773,6 +2845,15 @@ func makeOciListenerUpdateDetails(
 		hasChanges = true
 	}
 
+	ruleSetNames := listenerRuleSetNames(
+		params.existingListenerData.RuleSetNames,
+		params.managedRuleSetNames,
+		params.ruleSetNames,
+	)
+	if !stringSlicesEqual(params.existingListenerData.RuleSetNames, ruleSetNames) {
+		hasChanges = true
+	}
+
 	if !hasChanges {
 		return loadbalancer.UpdateListenerDetails{}, false
 	}
@@ -1783,11 +2864,28 @@ func makeOciListenerUpdateDetails(
 		DefaultBackendSetName: new(params.defaultBackendSetName),
 		RoutingPolicyName:     new(expectedPolicyName),
 		SslConfiguration:      params.sslConfig,
+		RuleSetNames:          ruleSetNames,
 	}, true
 }
 
-func expectedHTTPListenerProtocol(existingListener loadbalancer.Listener) string {
-	if lo.FromPtr(existingListener.Protocol) == ociListenerProtocolHTTP2 {
+// listenerRuleSetNames returns the rule sets of the existing listener with the rule sets managed
+// by the controller replaced by the desired ones. Rule sets attached outside of the controller
+// are kept.
+func listenerRuleSetNames(existing, managed, desired []string) []string {
+	var names []string
+	for _, name := range existing {
+		if !slices.Contains(managed, name) {
+			names = append(names, name)
+		}
+	}
+	return append(names, desired...)
+}
+
+// expectedHTTPListenerProtocol returns HTTP2 if it is set by the listener protocol option or the
+// listener was switched to HTTP2 for GRPCRoutes, HTTP otherwise.
+func expectedHTTPListenerProtocol(existingListener loadbalancer.Listener, listenerSpec *gatewayv1.Listener) string {
+	if lo.FromPtr(existingListener.Protocol) == ociListenerProtocolHTTP2 ||
+		listenerSpec != nil && listenerProtocolOption(listenerSpec.TLS) == ociListenerProtocolHTTP2 {
 		return ociListenerProtocolHTTP2
 	}
 	return ociListenerProtocolHTTP
@@ -1800,7 +2898,7 @@ func applyListenerTLSOptions(
 	if sslConfig == nil || tlsConfig == nil {
 		return
 	}
-	if protocols := splitCSVOption(tlsConfig.Options[ListenerTLSOptionProtocols]); len(protocols) > 0 {
+	if protocols := listenerTLSProtocols(tlsConfig); len(protocols) > 0 {
 		sslConfig.Protocols = protocols
 	}
 	cipherSuite := strings.TrimSpace(string(tlsConfig.Options[ListenerTLSOptionCipherSuiteName]))
@@ -1809,6 +2907,27 @@ func applyListenerTLSOptions(
 	}
 }
 
+// ociListenerTLSProtocols returns SSL protocols supported by OCI listeners ordered from the oldest.
+func ociListenerTLSProtocols() []string {
+	return []string{"TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3"}
+}
+
+// listenerTLSProtocols returns SSL protocols configured with listener TLS options.
+// Explicit protocols take precedence, otherwise protocols are derived from the minimum TLS version.
+// Returns nil if neither is set or the minimum version is not supported.
+func listenerTLSProtocols(tlsConfig *gatewayv1.ListenerTLSConfig) []string {
+	if protocols := splitCSVOption(tlsConfig.Options[ListenerTLSOptionProtocols]); len(protocols) > 0 {
+		return protocols
+	}
+	supported := ociListenerTLSProtocols()
+	minVersion := strings.TrimSpace(string(tlsConfig.Options[ListenerTLSOptionMinTLSVersion]))
+	minVersionIndex := slices.Index(supported, minVersion)
+	if minVersionIndex < 0 {
+		return nil
+	}
+	return supported[minVersionIndex:]
+}
+
 type ociLoadBalancerModelDeps struct {
 	dig.In
 
@@ -1817,6 +2936,12 @@ type ociLoadBalancerModelDeps struct {
 	OciClient           ociLoadBalancerClient
 	WorkRequestsWatcher workRequestsWatcher
 	RoutingRulesMapper  ociLoadBalancerRoutingRulesMapper
+	Metrics             *routingPolicyMetrics `optional:"true"`
+
+	// Fingerprints of endpoints programmed to backend sets, dropped when backend sets are recreated.
+	EndpointsFingerprints *endpointsFingerprints `optional:"true"`
+
+	DrainTimeout time.Duration `name:"config.reconcile.drain-timeout"`
 }
 
 func newOciLoadBalancerModel(deps ociLoadBalancerModelDeps) *ociLoadBalancerModelImpl {
@@ -1826,6 +2951,10 @@ func newOciLoadBalancerModel(deps ociLoadBalancerModelDeps) *ociLoadBalancerMode
 		k8sClient:           deps.K8sClient,
 		workRequestsWatcher: deps.WorkRequestsWatcher,
 		routingRulesMapper:  deps.RoutingRulesMapper,
+		metrics:             deps.Metrics,
+		fingerprints:        deps.EndpointsFingerprints,
+		drainTimeout:        deps.DrainTimeout,
+		drainPollInterval:   defaultBackendSetDrainPollInterval,
 	}
 }
 
@@ -2,13 +2,16 @@ package app
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"hash/crc32"
 	"maps"
 	"math/rand/v2"
+	"net/http"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -16,18 +19,23 @@ import (
 
 	"github.com/jaswdr/faker/v2"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/gemyago/oke-gateway-api/internal/diag"
 	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	configtypes "github.com/gemyago/oke-gateway-api/internal/types"
+	"github.com/gemyago/oke-gateway-api/pkg/httpfilter"
 )
 
 func TestOciLoadBalancerModelImpl(t *testing.T) {
@@ -66,6 +74,15 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 				Protocol: &protocol,
 				Port:     &port,
 			}))
+			assert.False(t, loadBalancerHealthCheckerMatches(&loadbalancer.HealthChecker{
+				Protocol:          new("HTTP"),
+				Port:              &port,
+				ResponseBodyRegex: new("^ok$"),
+			}, loadbalancer.HealthCheckerDetails{
+				Protocol:          new("HTTP"),
+				Port:              &port,
+				ResponseBodyRegex: new("^healthy$"),
+			}))
 			assert.False(t, loadBalancerBackendSetMatches(
 				loadbalancer.BackendSet{
 					Policy: new("IP_HASH"),
@@ -145,6 +162,30 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			}, defaultBackendSetName))
 		})
 
+		t.Run("detects routing default rule condition and position drift", func(t *testing.T) {
+			fake := faker.New()
+			defaultBackendSetName := "default-" + fake.Lorem().Word()
+			routeRule := makeRandomOCIRoutingRule()
+			modifiedRule := defaultCatchAllRoutingRule(defaultBackendSetName)
+			modifiedRule.Condition = new("any(http.request.url.path sw '/api')")
+
+			assert.True(t, routingPolicyDefaultRuleDrifted(loadbalancer.RoutingPolicy{
+				Rules: []loadbalancer.RoutingRule{routeRule, modifiedRule},
+			}, defaultBackendSetName))
+			assert.True(t, routingPolicyDefaultRuleDrifted(loadbalancer.RoutingPolicy{
+				Rules: []loadbalancer.RoutingRule{defaultCatchAllRoutingRule(defaultBackendSetName), routeRule},
+			}, defaultBackendSetName))
+			assert.False(t, routingPolicyDefaultRuleDrifted(loadbalancer.RoutingPolicy{
+				Rules: []loadbalancer.RoutingRule{routeRule, defaultCatchAllRoutingRule(defaultBackendSetName)},
+			}, defaultBackendSetName))
+			assert.Equal(t,
+				[]loadbalancer.RoutingRule{routeRule, defaultCatchAllRoutingRule(defaultBackendSetName)},
+				desiredRoutingPolicyRulesWithDefault(loadbalancer.RoutingPolicy{
+					Rules: []loadbalancer.RoutingRule{modifiedRule, routeRule},
+				}, defaultBackendSetName),
+			)
+		})
+
 		t.Run("includes grpc rule name when present", func(t *testing.T) {
 			fake := faker.New()
 			ruleName := gatewayv1.SectionName("grpc-" + fake.Lorem().Word())
@@ -328,6 +369,245 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			assert.Equal(t, defaultBackendSetPort, lo.FromPtr(actualBackendSet.HealthChecker.Port))
 		})
 
+		t.Run("uses default backend port for health checks", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			gw := newRandomGateway()
+			wantBsName := ociDefaultBackendSetName(ociResourceNaming{}, gw, "")
+			defaultBackend := &configtypes.GatewayConfigDefaultBackend{
+				ServiceName: fake.Internet().Slug(),
+				Port:        rand.Int32N(1000) + 8000,
+			}
+			existingBackendSet := makeRandomOCIBackendSet(
+				randomOCIBackendSetWithNameOpt(wantBsName),
+				func(bs *loadbalancer.BackendSet) {
+					bs.Policy = new("ROUND_ROBIN")
+					bs.HealthChecker = &loadbalancer.HealthChecker{
+						Protocol: new("TCP"),
+						Port:     new(defaultBackendSetPort),
+					}
+				},
+			)
+
+			params := reconcileDefaultBackendParams{
+				loadBalancerID: fake.UUID().V4(),
+				knownBackendSets: map[string]loadbalancer.BackendSet{
+					wantBsName: existingBackendSet,
+				},
+				gateway:        gw,
+				defaultBackend: defaultBackend,
+			}
+
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			workRequestID := fake.UUID().V4()
+
+			ociLoadBalancerClient.EXPECT().UpdateBackendSet(
+				t.Context(),
+				mock.MatchedBy(func(req loadbalancer.UpdateBackendSetRequest) bool {
+					return assert.Equal(t, wantBsName, *req.BackendSetName) &&
+						assert.Equal(t, int(defaultBackend.Port), *req.HealthChecker.Port)
+				}),
+			).Return(loadbalancer.UpdateBackendSetResponse{
+				OpcWorkRequestId: &workRequestID,
+			}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
+
+			actualBackendSet, err := model.reconcileDefaultBackendSet(t.Context(), params)
+
+			require.NoError(t, err)
+			require.NotNil(t, actualBackendSet.HealthChecker)
+			assert.Equal(t, int(defaultBackend.Port), lo.FromPtr(actualBackendSet.HealthChecker.Port))
+		})
+
+		t.Run("derives health check from default backend service", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			gw := newRandomGateway()
+			wantBsName := ociDefaultBackendSetName(ociResourceNaming{}, gw, "")
+			defaultBackend := &configtypes.GatewayConfigDefaultBackend{
+				ServiceName: fake.Internet().Slug(),
+				Port:        rand.Int32N(1000) + 8000,
+			}
+			targetPort := int(rand.Int32N(1000) + 9000)
+			service := makeRandomService(func(s *corev1.Service) {
+				s.Name = defaultBackend.ServiceName
+				s.Annotations = map[string]string{
+					BackendHealthCheckProtocolAnnotation: "HTTP",
+					BackendHealthCheckPathAnnotation:     "/healthz",
+				}
+				s.Spec.Ports = []corev1.ServicePort{
+					{Port: defaultBackend.Port, TargetPort: intstr.FromInt(targetPort)},
+				}
+			})
+			existingBackendSet := makeRandomOCIBackendSet(
+				randomOCIBackendSetWithNameOpt(wantBsName),
+				func(bs *loadbalancer.BackendSet) {
+					bs.Policy = new("ROUND_ROBIN")
+					bs.HealthChecker = &loadbalancer.HealthChecker{
+						Protocol: new("TCP"),
+						Port:     new(int(defaultBackend.Port)),
+					}
+				},
+			)
+
+			params := reconcileDefaultBackendParams{
+				loadBalancerID: fake.UUID().V4(),
+				knownBackendSets: map[string]loadbalancer.BackendSet{
+					wantBsName: existingBackendSet,
+				},
+				gateway:        gw,
+				defaultBackend: defaultBackend,
+				service:        &service,
+			}
+
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			workRequestID := fake.UUID().V4()
+			wantHealthChecker := loadbalancer.HealthCheckerDetails{
+				Protocol:   new("HTTP"),
+				Port:       new(targetPort),
+				UrlPath:    new("/healthz"),
+				ReturnCode: new(200),
+			}
+
+			ociLoadBalancerClient.EXPECT().UpdateBackendSet(
+				t.Context(),
+				mock.MatchedBy(func(req loadbalancer.UpdateBackendSetRequest) bool {
+					return assert.Equal(t, wantBsName, *req.BackendSetName) &&
+						assert.Equal(t, wantHealthChecker, *req.HealthChecker)
+				}),
+			).Return(loadbalancer.UpdateBackendSetResponse{
+				OpcWorkRequestId: &workRequestID,
+			}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
+
+			actualBackendSet, err := model.reconcileDefaultBackendSet(t.Context(), params)
+
+			require.NoError(t, err)
+			assert.Equal(t, healthCheckerFromDetails(wantHealthChecker), actualBackendSet.HealthChecker)
+		})
+
+		t.Run("uses node port of default backend service for health checks", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			defaultBackend := &configtypes.GatewayConfigDefaultBackend{
+				ServiceName: faker.New().Internet().Slug(),
+				Port:        80,
+			}
+			service := makeRandomService(func(s *corev1.Service) {
+				s.Spec.Ports = []corev1.ServicePort{
+					{Port: 80, TargetPort: intstr.FromInt(8080), NodePort: 30080},
+				}
+			})
+
+			healthChecker, err := model.defaultBackendSetHealthChecker(t.Context(), reconcileDefaultBackendParams{
+				defaultBackend:   defaultBackend,
+				service:          &service,
+				nodePortBackends: true,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, loadbalancer.HealthCheckerDetails{
+				Protocol: new("TCP"),
+				Port:     new(30080),
+			}, healthChecker)
+		})
+
+		t.Run("resolves named target port of default backend service for health checks", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			defaultBackend := &configtypes.GatewayConfigDefaultBackend{
+				ServiceName: faker.New().Internet().Slug(),
+				Port:        80,
+			}
+			service := makeRandomService(func(s *corev1.Service) {
+				s.Name = defaultBackend.ServiceName
+				s.Spec.Ports = []corev1.ServicePort{
+					{Name: "http", Port: 80, TargetPort: intstr.FromString("web")},
+				}
+			})
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().List(
+				t.Context(),
+				mock.Anything,
+				client.MatchingLabels{discoveryv1.LabelServiceName: service.Name},
+				client.InNamespace(service.Namespace),
+			).RunAndReturn(func(_ context.Context, ol client.ObjectList, _ ...client.ListOption) error {
+				epSliceList, ok := ol.(*discoveryv1.EndpointSliceList)
+				require.True(t, ok, "expected an EndpointSliceList")
+				epSliceList.Items = []discoveryv1.EndpointSlice{
+					{Ports: []discoveryv1.EndpointPort{{Name: new("http"), Port: new(int32(8080))}}},
+				}
+				return nil
+			}).Once()
+
+			healthChecker, err := model.defaultBackendSetHealthChecker(t.Context(), reconcileDefaultBackendParams{
+				defaultBackend: defaultBackend,
+				service:        &service,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, loadbalancer.HealthCheckerDetails{
+				Protocol: new("TCP"),
+				Port:     new(8080),
+			}, healthChecker)
+		})
+
+		t.Run("uses configured health check of empty default backend set", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+
+			healthChecker, err := model.defaultBackendSetHealthChecker(t.Context(), reconcileDefaultBackendParams{
+				defaultBackendSet: &configtypes.GatewayConfigDefaultBackendSet{
+					HealthCheck: &configtypes.OkeExternalBackendHealthCheck{
+						Protocol: "HTTP",
+						Port:     8080,
+						URLPath:  "/healthz",
+					},
+				},
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, loadbalancer.HealthCheckerDetails{
+				Protocol:   new("HTTP"),
+				Port:       new(8080),
+				UrlPath:    new("/healthz"),
+				ReturnCode: new(200),
+			}, healthChecker)
+		})
+
+		t.Run("configured health check takes precedence over default backend service", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			defaultBackend := &configtypes.GatewayConfigDefaultBackend{
+				ServiceName: faker.New().Internet().Slug(),
+				Port:        80,
+			}
+			service := makeRandomService(func(s *corev1.Service) {
+				s.Annotations = map[string]string{BackendHealthCheckProtocolAnnotation: "HTTP"}
+				s.Spec.Ports = []corev1.ServicePort{
+					{Port: 80, TargetPort: intstr.FromInt(8080)},
+				}
+			})
+
+			healthChecker, err := model.defaultBackendSetHealthChecker(t.Context(), reconcileDefaultBackendParams{
+				defaultBackend: defaultBackend,
+				defaultBackendSet: &configtypes.GatewayConfigDefaultBackendSet{
+					HealthCheck: &configtypes.OkeExternalBackendHealthCheck{Protocol: "TCP"},
+				},
+				service: &service,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, loadbalancer.HealthCheckerDetails{
+				Protocol: new("TCP"),
+				Port:     new(8080),
+			}, healthChecker)
+		})
+
 		t.Run("when backend set does not exist", func(t *testing.T) {
 			fake := faker.New()
 			deps := makeMockDeps(t)
@@ -543,7 +823,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			for _, listener := range listeners {
 				if listener.TLS != nil {
 					for _, ref := range listener.TLS.CertificateRefs {
-						secret := makeRandomSecret()
+						secret := makeRandomSecret(randomSecretWithTLSDataOpt())
 						setupClientGet(t, k8sClient, types.NamespacedName{
 							Namespace: string(lo.FromPtr(ref.Namespace)),
 							Name:      string(ref.Name),
@@ -608,7 +888,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			for _, listener := range listeners {
 				if listener.TLS != nil {
 					for _, ref := range listener.TLS.CertificateRefs {
-						secret := makeRandomSecret()
+						secret := makeRandomSecret(randomSecretWithTLSDataOpt())
 						setupClientGet(t, k8sClient, types.NamespacedName{
 							Namespace: gateway.Namespace,
 							Name:      string(ref.Name),
@@ -687,7 +967,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			for _, listener := range existingCertsListeners {
 				if listener.TLS != nil {
 					for _, ref := range listener.TLS.CertificateRefs {
-						secret := makeRandomSecret()
+						secret := makeRandomSecret(randomSecretWithTLSDataOpt())
 						setupClientGet(t, k8sClient, types.NamespacedName{
 							Namespace: string(lo.FromPtr(ref.Namespace)),
 							Name:      string(ref.Name),
@@ -866,6 +1146,162 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			require.Error(t, err)
 			assert.ErrorIs(t, err, wantErr)
 		})
+
+		t.Run("uploads intermediate certificates as CA certificate", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			listener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+			listener.TLS.CertificateRefs = listener.TLS.CertificateRefs[:1]
+			gateway := newRandomGateway(randomGatewayWithListenersOpt(listener))
+			ref := listener.TLS.CertificateRefs[0]
+			root := issueTestCertificate("root", nil)
+			intermediate := issueTestCertificate("intermediate", &root)
+			leaf := issueTestCertificate("api.example.com", &intermediate)
+			secret := makeRandomSecret(func(secret *corev1.Secret) {
+				secret.Data[corev1.TLSCertKey] = append(append(leaf.certPEM, intermediate.certPEM...), root.certPEM...)
+				secret.Data[corev1.TLSPrivateKeyKey] = leaf.keyPEM
+			})
+			certName := ociCertificateNameFromSecret(secret)
+			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			loadBalancerID := faker.New().UUID().V4()
+			workRequestID := faker.New().UUID().V4()
+
+			setupClientGet(t, k8sClient, types.NamespacedName{
+				Namespace: string(lo.FromPtr(ref.Namespace)),
+				Name:      string(ref.Name),
+			}, secret).Once()
+
+			wantCaCertificate := string(intermediate.certPEM) + string(root.certPEM)
+			ociLoadBalancerClient.EXPECT().CreateCertificate(t.Context(), loadbalancer.CreateCertificateRequest{
+				LoadBalancerId: &loadBalancerID,
+				CreateCertificateDetails: loadbalancer.CreateCertificateDetails{
+					CertificateName:   &certName,
+					PublicCertificate: new(string(leaf.certPEM)),
+					CaCertificate:     &wantCaCertificate,
+					PrivateKey:        new(string(leaf.keyPEM)),
+				},
+			}).Return(loadbalancer.CreateCertificateResponse{
+				OpcWorkRequestId: &workRequestID,
+			}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
+
+			gotResult, err := model.reconcileListenersCertificates(t.Context(), reconcileListenersCertificatesParams{
+				loadBalancerID:    loadBalancerID,
+				gateway:           gateway,
+				knownCertificates: map[string]loadbalancer.Certificate{},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, loadbalancer.Certificate{
+				CertificateName:   &certName,
+				PublicCertificate: new(string(leaf.certPEM)),
+				CaCertificate:     &wantCaCertificate,
+			}, gotResult.reconciledCertificates[certName])
+		})
+
+		t.Run("fails with status error when certificate chain is malformed", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			listener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+			gateway := newRandomGateway(randomGatewayWithListenersOpt(listener))
+			ref := listener.TLS.CertificateRefs[0]
+			secret := makeRandomSecret(randomSecretWithTLSDataOpt(), func(secret *corev1.Secret) {
+				secret.Data[corev1.TLSCertKey] = append(
+					secret.Data[corev1.TLSCertKey],
+					[]byte("-----BEGIN CERTIFICATE-----\n"+faker.New().UUID().V4())...,
+				)
+			})
+			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+
+			setupClientGet(t, k8sClient, types.NamespacedName{
+				Namespace: string(lo.FromPtr(ref.Namespace)),
+				Name:      string(ref.Name),
+			}, secret).Once()
+
+			_, err := model.reconcileListenersCertificates(t.Context(), reconcileListenersCertificatesParams{
+				loadBalancerID: faker.New().UUID().V4(),
+				gateway:        gateway,
+			})
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.ListenerConditionResolvedRefs), statusErr.conditionType)
+			assert.Equal(t, string(gatewayv1.ListenerReasonInvalidCertificateRef), statusErr.reason)
+			assert.Equal(t, string(listener.Name), statusErr.listenerName)
+			assert.Contains(t, statusErr.message, secret.Namespace+"/"+secret.Name)
+			assert.Contains(t, statusErr.message, "malformed PEM data after certificate 1")
+		})
+
+		t.Run("fails with status error when private key does not match certificate", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			listener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+			gateway := newRandomGateway(randomGatewayWithListenersOpt(listener))
+			ref := listener.TLS.CertificateRefs[0]
+			otherCertificate := issueTestCertificate(faker.New().Internet().Domain(), nil)
+			secret := makeRandomSecret(randomSecretWithTLSDataOpt(), func(secret *corev1.Secret) {
+				secret.Data[corev1.TLSPrivateKeyKey] = otherCertificate.keyPEM
+			})
+			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+
+			setupClientGet(t, k8sClient, types.NamespacedName{
+				Namespace: string(lo.FromPtr(ref.Namespace)),
+				Name:      string(ref.Name),
+			}, secret).Once()
+
+			_, err := model.reconcileListenersCertificates(t.Context(), reconcileListenersCertificatesParams{
+				loadBalancerID: faker.New().UUID().V4(),
+				gateway:        gateway,
+			})
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.ListenerConditionResolvedRefs), statusErr.conditionType)
+			assert.Equal(t, string(gatewayv1.ListenerReasonInvalidCertificateRef), statusErr.reason)
+			assert.Equal(t, string(listener.Name), statusErr.listenerName)
+			assert.Contains(t, statusErr.message, "invalid tls.key private key")
+		})
+
+		t.Run("fails with status error when certificates do not cover listener hostname", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			listener := makeRandomListener(randomListenerWithHTTPSParamsOpt())
+			listener.Hostname = new(gatewayv1.Hostname("api.example.com"))
+			listener.TLS.CertificateRefs = listener.TLS.CertificateRefs[:1]
+			gateway := newRandomGateway(randomGatewayWithListenersOpt(listener))
+			ref := listener.TLS.CertificateRefs[0]
+			certificate := issueTestCertificate("", nil, func(cert *x509.Certificate) {
+				cert.DNSNames = []string{"other.example.com"}
+			})
+			secret := makeRandomSecret(func(secret *corev1.Secret) {
+				secret.Data[corev1.TLSCertKey] = certificate.certPEM
+				secret.Data[corev1.TLSPrivateKeyKey] = certificate.keyPEM
+			})
+			certName := ociCertificateNameFromSecret(secret)
+			k8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+
+			setupClientGet(t, k8sClient, types.NamespacedName{
+				Namespace: string(lo.FromPtr(ref.Namespace)),
+				Name:      string(ref.Name),
+			}, secret).Once()
+
+			_, err := model.reconcileListenersCertificates(t.Context(), reconcileListenersCertificatesParams{
+				loadBalancerID: faker.New().UUID().V4(),
+				gateway:        gateway,
+				knownCertificates: map[string]loadbalancer.Certificate{
+					certName: {
+						CertificateName:   &certName,
+						PublicCertificate: new(string(certificate.certPEM)),
+					},
+				},
+			})
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			require.ErrorIs(t, err, errListenerHostnameNotCovered)
+			assert.Equal(t, string(gatewayv1.ListenerConditionResolvedRefs), statusErr.conditionType)
+			assert.Equal(t, string(gatewayv1.ListenerReasonInvalidCertificateRef), statusErr.reason)
+			assert.Equal(t, string(listener.Name), statusErr.listenerName)
+			assert.Contains(t, statusErr.message, "api.example.com")
+		})
 	})
 
 	t.Run("reconcileHTTPListener", func(t *testing.T) {
@@ -923,22 +1359,78 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			require.NoError(t, err)
 		})
 
-		t.Run("fails when existing listener update fails", func(t *testing.T) {
+		t.Run("when prefixed listener of shared load balancer exists", func(t *testing.T) {
+			fake := faker.New()
 			deps := makeMockDeps(t)
 			model := newOciLoadBalancerModel(deps)
 			gwListener := makeRandomListener(
 				randomListenerWithHTTPProtocolOpt(),
 			)
+			namePrefix := "gw_" + fake.Lorem().Word() + "_"
+			listenerName := namePrefix + string(gwListener.Name)
 			lbListener := makeRandomOCIListener(
 				func(l *loadbalancer.Listener) {
-					l.Name = new(string(gwListener.Name))
+					l.Name = new(listenerName)
 				},
 			)
-			routingPolicyName := listenerPolicyName(string(gwListener.Name))
-			wantErr := errors.New(faker.New().Lorem().Sentence(10))
-			defaultBackendSetName := faker.New().UUID().V4()
 
-			params := reconcileHTTPListenerParams{
+			routingPolicyName := listenerPolicyName(listenerName)
+			defaultBackendSetName := fake.UUID().V4()
+
+			params := reconcileHTTPListenerParams{
+				loadBalancerID: fake.UUID().V4(),
+				knownRoutingPolicies: map[string]loadbalancer.RoutingPolicy{
+					routingPolicyName: makeMatchingRoutingPolicy(routingPolicyName, defaultBackendSetName),
+				},
+				knownListeners: map[string]loadbalancer.Listener{
+					listenerName:            lbListener,
+					string(gwListener.Name): makeRandomOCIListener(),
+				},
+				defaultBackendSetName: defaultBackendSetName,
+				listenerSpec:          &gwListener,
+				namePrefix:            namePrefix,
+			}
+
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			workRequestID := fake.UUID().V4()
+
+			ociLoadBalancerClient.EXPECT().UpdateListener(t.Context(), loadbalancer.UpdateListenerRequest{
+				LoadBalancerId: &params.loadBalancerID,
+				ListenerName:   new(listenerName),
+				UpdateListenerDetails: loadbalancer.UpdateListenerDetails{
+					Port:                  new(int(gwListener.Port)),
+					Protocol:              new(string(gwListener.Protocol)),
+					DefaultBackendSetName: new(params.defaultBackendSetName),
+					RoutingPolicyName:     new(routingPolicyName),
+				},
+			}).Return(loadbalancer.UpdateListenerResponse{
+				OpcWorkRequestId: &workRequestID,
+			}, nil)
+
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil)
+
+			err := model.reconcileHTTPListener(t.Context(), params)
+			require.NoError(t, err)
+		})
+
+		t.Run("fails when existing listener update fails", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			gwListener := makeRandomListener(
+				randomListenerWithHTTPProtocolOpt(),
+			)
+			lbListener := makeRandomOCIListener(
+				func(l *loadbalancer.Listener) {
+					l.Name = new(string(gwListener.Name))
+				},
+			)
+			routingPolicyName := listenerPolicyName(string(gwListener.Name))
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+			defaultBackendSetName := faker.New().UUID().V4()
+
+			params := reconcileHTTPListenerParams{
 				loadBalancerID: faker.New().UUID().V4(),
 				knownRoutingPolicies: map[string]loadbalancer.RoutingPolicy{
 					routingPolicyName: makeMatchingRoutingPolicy(routingPolicyName, defaultBackendSetName),
@@ -1165,6 +1657,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 				},
 				defaultBackendSetName: fake.UUID().V4(),
 				listenerSpec:          &gwListener,
+				ruleSetNames:          []string{"rs_" + fake.Lorem().Word()},
 			}
 
 			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
@@ -1209,6 +1702,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 					Protocol:              new(string(gwListener.Protocol)),
 					DefaultBackendSetName: new(params.defaultBackendSetName),
 					RoutingPolicyName:     new(routingPolicyName),
+					RuleSetNames:          params.ruleSetNames,
 				},
 			}).Return(loadbalancer.CreateListenerResponse{
 				OpcWorkRequestId: &listenerWorkRequestID,
@@ -1220,6 +1714,110 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			require.NoError(t, err)
 		})
 
+		t.Run("when listener named after another naming scheme exists", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			gwListener := makeRandomListener(
+				randomListenerWithHTTPProtocolOpt(),
+			)
+			naming := ociResourceNaming{separator: "_", hashSuffixLength: 8}
+			listenerName := naming.listenerName("", gwListener.Name)
+			routingPolicyName := listenerPolicyName(listenerName)
+
+			supersededPolicyName := listenerPolicyName(string(gwListener.Name))
+			routeRule := loadbalancer.RoutingRule{
+				Name:      new("r_" + fake.Lorem().Word()),
+				Condition: new("any(http.request.url.path sw '/" + fake.Lorem().Word() + "')"),
+				Actions: []loadbalancer.Action{
+					loadbalancer.ForwardToBackendSet{BackendSetName: new(fake.UUID().V4())},
+				},
+			}
+			superseded := makeRandomOCIListener(func(l *loadbalancer.Listener) {
+				l.Name = new(string(gwListener.Name))
+				l.Port = new(int(gwListener.Port))
+				l.RoutingPolicyName = new(supersededPolicyName)
+			})
+
+			params := reconcileHTTPListenerParams{
+				loadBalancerID: fake.UUID().V4(),
+				knownListeners: map[string]loadbalancer.Listener{
+					*superseded.Name: superseded,
+				},
+				knownRoutingPolicies: map[string]loadbalancer.RoutingPolicy{
+					supersededPolicyName: {
+						Name: new(supersededPolicyName),
+						Rules: []loadbalancer.RoutingRule{
+							routeRule,
+							defaultCatchAllRoutingRule(fake.UUID().V4()),
+						},
+					},
+				},
+				defaultBackendSetName: fake.UUID().V4(),
+				listenerSpec:          &gwListener,
+				naming:                naming,
+			}
+
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+
+			// Rules of the routes are moved to the policy of the new listener
+			routingPolicyWorkRequestID := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().CreateRoutingPolicy(t.Context(), loadbalancer.CreateRoutingPolicyRequest{
+				LoadBalancerId: &params.loadBalancerID,
+				CreateRoutingPolicyDetails: loadbalancer.CreateRoutingPolicyDetails{
+					Name:                     &routingPolicyName,
+					ConditionLanguageVersion: loadbalancer.CreateRoutingPolicyDetailsConditionLanguageVersionV1,
+					Rules: []loadbalancer.RoutingRule{
+						routeRule,
+						defaultCatchAllRoutingRule(params.defaultBackendSetName),
+					},
+				},
+			}).Return(loadbalancer.CreateRoutingPolicyResponse{
+				OpcWorkRequestId: &routingPolicyWorkRequestID,
+			}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), routingPolicyWorkRequestID).Return(nil).Once()
+
+			deleteListenerWorkRequestID := fake.UUID().V4()
+			deleteListenerCall := ociLoadBalancerClient.EXPECT().
+				DeleteListener(t.Context(), loadbalancer.DeleteListenerRequest{
+					LoadBalancerId: &params.loadBalancerID,
+					ListenerName:   superseded.Name,
+				}).
+				Return(loadbalancer.DeleteListenerResponse{OpcWorkRequestId: &deleteListenerWorkRequestID}, nil).
+				Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), deleteListenerWorkRequestID).Return(nil).Once()
+
+			listenerWorkRequestID := fake.UUID().V4()
+			createListenerCall := ociLoadBalancerClient.EXPECT().
+				CreateListener(t.Context(), loadbalancer.CreateListenerRequest{
+					LoadBalancerId: &params.loadBalancerID,
+					CreateListenerDetails: loadbalancer.CreateListenerDetails{
+						Name:                  new(listenerName),
+						Port:                  new(int(gwListener.Port)),
+						Protocol:              new(string(gwListener.Protocol)),
+						DefaultBackendSetName: new(params.defaultBackendSetName),
+						RoutingPolicyName:     new(routingPolicyName),
+					},
+				}).
+				Return(loadbalancer.CreateListenerResponse{OpcWorkRequestId: &listenerWorkRequestID}, nil).
+				Once().
+				NotBefore(deleteListenerCall)
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), listenerWorkRequestID).Return(nil).Once()
+
+			deletePolicyWorkRequestID := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().DeleteRoutingPolicy(t.Context(), loadbalancer.DeleteRoutingPolicyRequest{
+				LoadBalancerId:    &params.loadBalancerID,
+				RoutingPolicyName: new(supersededPolicyName),
+			}).Return(loadbalancer.DeleteRoutingPolicyResponse{
+				OpcWorkRequestId: &deletePolicyWorkRequestID,
+			}, nil).Once().NotBefore(createListenerCall)
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), deletePolicyWorkRequestID).Return(nil).Once()
+
+			err := model.reconcileHTTPListener(t.Context(), params)
+			require.NoError(t, err)
+		})
+
 		t.Run("when https listener does not exist", func(t *testing.T) {
 			fake := faker.New()
 			deps := makeMockDeps(t)
@@ -1310,6 +1908,54 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			require.NoError(t, err)
 		})
 
+		t.Run("when https listener with hostname does not exist", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			gwListener := makeRandomListener(
+				randomListenerWithHTTPSParamsOpt(),
+				func(listener *gatewayv1.Listener) {
+					listener.Hostname = new(gatewayv1.Hostname("api.example.com"))
+				},
+			)
+
+			matchingCert := makeTestServerCertificate(t, "", "api.example.com")
+			params := reconcileHTTPListenerParams{
+				loadBalancerID: fake.UUID().V4(),
+				listenerCertificates: []loadbalancer.Certificate{
+					makeTestServerCertificate(t, "", "*.example.com"),
+					matchingCert,
+				},
+				defaultBackendSetName: fake.UUID().V4(),
+				listenerSpec:          &gwListener,
+			}
+
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+
+			routingPolicyWorkRequestID := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().CreateRoutingPolicy(t.Context(), mock.Anything).
+				Return(loadbalancer.CreateRoutingPolicyResponse{
+					OpcWorkRequestId: &routingPolicyWorkRequestID,
+				}, nil)
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), routingPolicyWorkRequestID).Return(nil)
+
+			listenerWorkRequestID := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().
+				CreateListener(t.Context(), mock.MatchedBy(func(req loadbalancer.CreateListenerRequest) bool {
+					sslConfig := req.CreateListenerDetails.SslConfiguration
+					return sslConfig != nil &&
+						lo.FromPtr(sslConfig.CertificateName) == lo.FromPtr(matchingCert.CertificateName)
+				})).
+				Return(loadbalancer.CreateListenerResponse{
+					OpcWorkRequestId: &listenerWorkRequestID,
+				}, nil)
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), listenerWorkRequestID).Return(nil)
+
+			err := model.reconcileHTTPListener(t.Context(), params)
+			require.NoError(t, err)
+		})
+
 		t.Run("fails when https listener has no certificate source", func(t *testing.T) {
 			deps := makeMockDeps(t)
 			model := newOciLoadBalancerModel(deps)
@@ -1493,12 +2139,21 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
 			policyWorkRequestID := fake.UUID().V4()
 			listenerWorkRequestID := fake.UUID().V4()
+			etag := fake.UUID().V4()
 
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), loadbalancer.GetRoutingPolicyRequest{
+				RoutingPolicyName: &routingPolicyName,
+				LoadBalancerId:    &params.loadBalancerID,
+			}).Return(loadbalancer.GetRoutingPolicyResponse{
+				RoutingPolicy: existingPolicy,
+				ETag:          &etag,
+			}, nil).Once()
 			ociLoadBalancerClient.EXPECT().UpdateRoutingPolicy(
 				t.Context(),
 				mock.MatchedBy(func(req loadbalancer.UpdateRoutingPolicyRequest) bool {
 					return assert.Equal(t, params.loadBalancerID, *req.LoadBalancerId) &&
 						assert.Equal(t, routingPolicyName, *req.RoutingPolicyName) &&
+						assert.Equal(t, etag, *req.IfMatch) &&
 						assert.ElementsMatch(
 							t,
 							[]loadbalancer.RoutingRule{
@@ -1569,6 +2224,8 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			policyWorkRequestID := fake.UUID().V4()
 			listenerWorkRequestID := fake.UUID().V4()
 
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), mock.Anything).
+				Return(loadbalancer.GetRoutingPolicyResponse{RoutingPolicy: existingPolicy}, nil).Once()
 			ociLoadBalancerClient.EXPECT().UpdateRoutingPolicy(
 				t.Context(),
 				mock.MatchedBy(func(req loadbalancer.UpdateRoutingPolicyRequest) bool {
@@ -1629,6 +2286,8 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			}
 
 			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), mock.Anything).
+				Return(loadbalancer.GetRoutingPolicyResponse{RoutingPolicy: existingPolicy}, nil)
 			ociLoadBalancerClient.EXPECT().
 				UpdateRoutingPolicy(t.Context(), mock.Anything).
 				Return(loadbalancer.UpdateRoutingPolicyResponse{}, nil)
@@ -1639,6 +2298,120 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			ociLoadBalancerClient.AssertNotCalled(t, "CreateListener")
 		})
 
+		t.Run("merges routing policy default rule with latest policy rules", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			gwListener := makeRandomListener(
+				randomListenerWithHTTPProtocolOpt(),
+			)
+
+			routingPolicyName := listenerPolicyName(string(gwListener.Name))
+			defaultBackendSetName := fake.UUID().V4()
+			staleRule := defaultCatchAllRoutingRule("wrong-" + fake.UUID().V4())
+			routeRule := loadbalancer.RoutingRule{
+				Name:      new("r" + fake.Lorem().Word()),
+				Condition: new("any(http.request.url.path sw '/api')"),
+				Actions: []loadbalancer.Action{
+					loadbalancer.ForwardToBackendSet{BackendSetName: new("api-" + fake.UUID().V4())},
+				},
+			}
+			knownPolicy := loadbalancer.RoutingPolicy{
+				Name:                     new(routingPolicyName),
+				ConditionLanguageVersion: loadbalancer.RoutingPolicyConditionLanguageVersionV1,
+				Rules:                    []loadbalancer.RoutingRule{staleRule},
+			}
+			latestPolicy := knownPolicy
+			latestPolicy.Rules = []loadbalancer.RoutingRule{routeRule, staleRule}
+
+			params := reconcileHTTPListenerParams{
+				loadBalancerID: fake.UUID().V4(),
+				knownRoutingPolicies: map[string]loadbalancer.RoutingPolicy{
+					routingPolicyName: knownPolicy,
+				},
+				defaultBackendSetName: defaultBackendSetName,
+				listenerSpec:          &gwListener,
+			}
+
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			policyWorkRequestID := fake.UUID().V4()
+			staleEtag := fake.UUID().V4()
+			latestEtag := fake.UUID().V4()
+
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), mock.Anything).
+				Return(loadbalancer.GetRoutingPolicyResponse{RoutingPolicy: knownPolicy, ETag: &staleEtag}, nil).Once()
+			ociLoadBalancerClient.EXPECT().UpdateRoutingPolicy(
+				t.Context(),
+				mock.MatchedBy(func(req loadbalancer.UpdateRoutingPolicyRequest) bool {
+					return *req.IfMatch == staleEtag
+				}),
+			).Return(
+				loadbalancer.UpdateRoutingPolicyResponse{},
+				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(http.StatusPreconditionFailed)),
+			).Once()
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), mock.Anything).
+				Return(loadbalancer.GetRoutingPolicyResponse{RoutingPolicy: latestPolicy, ETag: &latestEtag}, nil).Once()
+			ociLoadBalancerClient.EXPECT().UpdateRoutingPolicy(
+				t.Context(),
+				mock.MatchedBy(func(req loadbalancer.UpdateRoutingPolicyRequest) bool {
+					return *req.IfMatch == latestEtag &&
+						assert.ElementsMatch(
+							t,
+							[]loadbalancer.RoutingRule{
+								routeRule,
+								defaultCatchAllRoutingRule(defaultBackendSetName),
+							},
+							req.UpdateRoutingPolicyDetails.Rules,
+						)
+				}),
+			).Return(loadbalancer.UpdateRoutingPolicyResponse{
+				OpcWorkRequestId: &policyWorkRequestID,
+			}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), policyWorkRequestID).Return(nil).Once()
+
+			err := model.reconcileListenerRoutingPolicy(t.Context(), params)
+
+			require.NoError(t, err)
+		})
+
+		t.Run("skips routing policy default rule update when already restored", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			gwListener := makeRandomListener(
+				randomListenerWithHTTPProtocolOpt(),
+			)
+
+			routingPolicyName := listenerPolicyName(string(gwListener.Name))
+			defaultBackendSetName := fake.UUID().V4()
+			knownPolicy := loadbalancer.RoutingPolicy{
+				Name:                     new(routingPolicyName),
+				ConditionLanguageVersion: loadbalancer.RoutingPolicyConditionLanguageVersionV1,
+				Rules:                    []loadbalancer.RoutingRule{defaultCatchAllRoutingRule(fake.UUID().V4())},
+			}
+			latestPolicy := knownPolicy
+			latestPolicy.Rules = []loadbalancer.RoutingRule{defaultCatchAllRoutingRule(defaultBackendSetName)}
+
+			params := reconcileHTTPListenerParams{
+				loadBalancerID: fake.UUID().V4(),
+				knownRoutingPolicies: map[string]loadbalancer.RoutingPolicy{
+					routingPolicyName: knownPolicy,
+				},
+				defaultBackendSetName: defaultBackendSetName,
+				listenerSpec:          &gwListener,
+			}
+
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), mock.Anything).
+				Return(loadbalancer.GetRoutingPolicyResponse{RoutingPolicy: latestPolicy}, nil).Once()
+
+			err := model.reconcileListenerRoutingPolicy(t.Context(), params)
+
+			require.NoError(t, err)
+			ociLoadBalancerClient.AssertNotCalled(t, "UpdateRoutingPolicy")
+		})
+
 		t.Run("when create routing policy fails", func(t *testing.T) {
 			fake := faker.New()
 			deps := makeMockDeps(t)
@@ -1807,13 +2580,32 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 				},
 			}
 		}
+		expectBackendSetsWithinLimit := func(t *testing.T, ociClient *MockociLoadBalancerClient) {
+			ociClient.EXPECT().GetLoadBalancer(t.Context(), mock.Anything).Return(
+				loadbalancer.GetLoadBalancerResponse{LoadBalancer: makeRandomOCILoadBalancer()}, nil,
+			)
+		}
 		backendSetNameFromParams := func(params reconcileBackendSetParams) string {
 			return ociBackendSetNameFromBackendObjectRef(
+				params.naming,
 				params.routeNS,
 				params.backendRef.BackendObjectReference,
 			)
 		}
 
+		t.Run("fails when health check port can not be derived", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			service := makeRandomService()
+			params := makeParams(service, faker.New().UUID().V4())
+			params.backendRef.Port = nil
+			params.service.Spec.Ports = nil
+
+			err := model.reconcileBackendSet(t.Context(), params)
+
+			require.ErrorContains(t, err, "has no ports")
+		})
+
 		t.Run("create new backend set", func(t *testing.T) {
 			fake := faker.New()
 			deps := makeMockDeps(t)
@@ -1837,13 +2629,14 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(404)),
 			).Once()
 
+			expectBackendSetsWithinLimit(t, ociLoadBalancerClient)
 			ociLoadBalancerClient.EXPECT().CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
 				LoadBalancerId: &params.loadBalancerID,
 				CreateBackendSetDetails: loadbalancer.CreateBackendSetDetails{
 					Name: &wantBsName,
 					HealthChecker: &loadbalancer.HealthCheckerDetails{
 						Protocol: new("TCP"),
-						Port:     new(int(lo.FromPtr(params.backendRef.Port))),
+						Port:     new(service.Spec.Ports[0].TargetPort.IntValue()),
 					},
 					Policy: new("ROUND_ROBIN"),
 				},
@@ -1856,23 +2649,18 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			err := model.reconcileBackendSet(t.Context(), params)
 			require.NoError(t, err)
 		})
-		t.Run("create new backend set with no target port", func(t *testing.T) {
+		t.Run("create new backend set probing node port of node backends", func(t *testing.T) {
 			fake := faker.New()
 			deps := makeMockDeps(t)
 			model := newOciLoadBalancerModel(deps)
-			service := makeRandomService(
-				func(s *corev1.Service) {
-					s.Spec.Ports[0].TargetPort = intstr.FromInt(0)
-				},
-			)
-
+			nodePort := int32(fake.IntBetween(30000, 32767))
+			service := makeRandomService(func(s *corev1.Service) {
+				s.Spec.Ports[0].NodePort = nodePort
+			})
 			params := makeParams(service, fake.UUID().V4())
-			params.backendRef.Port = nil
-
+			params.nodePortBackends = true
 			wantBsName := backendSetNameFromParams(params)
-
 			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
-
 			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
 			workRequestID := fake.UUID().V4()
 
@@ -1883,7 +2671,360 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 				loadbalancer.GetBackendSetResponse{},
 				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(404)),
 			).Once()
-
+			expectBackendSetsWithinLimit(t, ociLoadBalancerClient)
+			ociLoadBalancerClient.EXPECT().CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
+				LoadBalancerId: &params.loadBalancerID,
+				CreateBackendSetDetails: loadbalancer.CreateBackendSetDetails{
+					Name: &wantBsName,
+					HealthChecker: &loadbalancer.HealthCheckerDetails{
+						Protocol: new("TCP"),
+						Port:     new(int(nodePort)),
+					},
+					Policy: new("ROUND_ROBIN"),
+				},
+			}).Return(loadbalancer.CreateBackendSetResponse{
+				OpcWorkRequestId: &workRequestID,
+			}, nil)
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil)
+
+			err := model.reconcileBackendSet(t.Context(), params)
+			require.NoError(t, err)
+		})
+		t.Run("create new backend set probing health check port of the service", func(t *testing.T) {
+			for _, tc := range []struct {
+				name       string
+				annotation func(fake faker.Faker) (string, bool)
+			}{
+				{
+					name: "annotated port",
+					annotation: func(fake faker.Faker) (string, bool) {
+						return strconv.Itoa(fake.IntBetween(1, 65535)), true
+					},
+				},
+				{
+					name:       "invalid port",
+					annotation: func(faker.Faker) (string, bool) { return "70000", false },
+				},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					fake := faker.New()
+					deps := makeMockDeps(t)
+					model := newOciLoadBalancerModel(deps)
+					annotation, valid := tc.annotation(fake)
+					service := makeRandomService(func(s *corev1.Service) {
+						s.Annotations = map[string]string{BackendHealthCheckPortAnnotation: annotation}
+					})
+					wantPort := service.Spec.Ports[0].TargetPort.IntValue()
+					if valid {
+						wantPort, _ = strconv.Atoi(annotation)
+					}
+					params := makeParams(service, fake.UUID().V4())
+					wantBsName := backendSetNameFromParams(params)
+					ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+					workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+					workRequestID := fake.UUID().V4()
+
+					ociLoadBalancerClient.EXPECT().GetBackendSet(t.Context(), loadbalancer.GetBackendSetRequest{
+						BackendSetName: &wantBsName,
+						LoadBalancerId: &params.loadBalancerID,
+					}).Return(
+						loadbalancer.GetBackendSetResponse{},
+						ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(404)),
+					).Once()
+					expectBackendSetsWithinLimit(t, ociLoadBalancerClient)
+					ociLoadBalancerClient.EXPECT().CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
+						LoadBalancerId: &params.loadBalancerID,
+						CreateBackendSetDetails: loadbalancer.CreateBackendSetDetails{
+							Name: &wantBsName,
+							HealthChecker: &loadbalancer.HealthCheckerDetails{
+								Protocol: new("TCP"),
+								Port:     new(wantPort),
+							},
+							Policy: new("ROUND_ROBIN"),
+						},
+					}).Return(loadbalancer.CreateBackendSetResponse{
+						OpcWorkRequestId: &workRequestID,
+					}, nil)
+					workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil)
+
+					err := model.reconcileBackendSet(t.Context(), params)
+					require.NoError(t, err)
+				})
+			}
+		})
+		t.Run("create separate backend sets for service ports", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			service := makeRandomService(func(s *corev1.Service) {
+				s.Spec.Ports = []corev1.ServicePort{
+					{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080)},
+					{Name: "admin", Port: 81, TargetPort: intstr.FromInt(9090)},
+				}
+			})
+			httpParams := makeParams(service, fake.UUID().V4())
+			adminParams := makeParams(service, httpParams.loadBalancerID)
+			adminParams.backendRef.Port = &service.Spec.Ports[1].Port
+
+			httpBsName := backendSetNameFromParams(httpParams)
+			adminBsName := backendSetNameFromParams(adminParams)
+			require.NotEqual(t, httpBsName, adminBsName)
+
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			for bsName, wantPort := range map[string]int{httpBsName: 8080, adminBsName: 9090} {
+				workRequestID := fake.UUID().V4()
+				ociLoadBalancerClient.EXPECT().GetBackendSet(t.Context(), loadbalancer.GetBackendSetRequest{
+					BackendSetName: new(bsName),
+					LoadBalancerId: &httpParams.loadBalancerID,
+				}).Return(
+					loadbalancer.GetBackendSetResponse{},
+					ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(404)),
+				).Once()
+				expectBackendSetsWithinLimit(t, ociLoadBalancerClient)
+				ociLoadBalancerClient.EXPECT().CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
+					LoadBalancerId: &httpParams.loadBalancerID,
+					CreateBackendSetDetails: loadbalancer.CreateBackendSetDetails{
+						Name: new(bsName),
+						HealthChecker: &loadbalancer.HealthCheckerDetails{
+							Protocol: new("TCP"),
+							Port:     new(wantPort),
+						},
+						Policy: new("ROUND_ROBIN"),
+					},
+				}).Return(loadbalancer.CreateBackendSetResponse{
+					OpcWorkRequestId: &workRequestID,
+				}, nil).Once()
+				workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
+			}
+
+			require.NoError(t, model.reconcileBackendSet(t.Context(), httpParams))
+			require.NoError(t, model.reconcileBackendSet(t.Context(), adminParams))
+		})
+
+		t.Run("create new backend set for external backend", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			backendRef := makeRandomBackendRef(randomBackendRefWithExternalBackendOpt()).BackendRef
+			routeNS := fake.Internet().Domain()
+			externalBackend := makeRandomExternalBackend(routeNS, string(backendRef.Name))
+			externalBackend.Spec.HealthCheck = &configtypes.OkeExternalBackendHealthCheck{
+				Protocol: "HTTP",
+				URLPath:  "/" + fake.Lorem().Word(),
+			}
+			params := reconcileBackendSetParams{
+				loadBalancerID:  fake.UUID().V4(),
+				routeNS:         routeNS,
+				backendRef:      backendRef,
+				externalBackend: &externalBackend,
+			}
+			wantBsName := backendSetNameFromParams(params)
+
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			workRequestID := fake.UUID().V4()
+
+			ociLoadBalancerClient.EXPECT().GetBackendSet(t.Context(), loadbalancer.GetBackendSetRequest{
+				BackendSetName: &wantBsName,
+				LoadBalancerId: &params.loadBalancerID,
+			}).Return(
+				loadbalancer.GetBackendSetResponse{},
+				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(404)),
+			).Once()
+
+			expectBackendSetsWithinLimit(t, ociLoadBalancerClient)
+			ociLoadBalancerClient.EXPECT().CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
+				LoadBalancerId: &params.loadBalancerID,
+				CreateBackendSetDetails: loadbalancer.CreateBackendSetDetails{
+					Name: &wantBsName,
+					HealthChecker: &loadbalancer.HealthCheckerDetails{
+						Protocol:   new("HTTP"),
+						Port:       new(int(externalBackend.Spec.Endpoints[0].Port)),
+						UrlPath:    new(externalBackend.Spec.HealthCheck.URLPath),
+						ReturnCode: new(200),
+					},
+					Policy: new("ROUND_ROBIN"),
+				},
+			}).Return(loadbalancer.CreateBackendSetResponse{
+				OpcWorkRequestId: &workRequestID,
+			}, nil)
+
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil)
+
+			err := model.reconcileBackendSet(t.Context(), params)
+			require.NoError(t, err)
+		})
+
+		t.Run("updates external backend set when health check path changes", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			backendRef := makeRandomBackendRef(randomBackendRefWithExternalBackendOpt()).BackendRef
+			routeNS := fake.Internet().Domain()
+			externalBackend := makeRandomExternalBackend(routeNS, string(backendRef.Name))
+			externalBackend.Spec.HealthCheck = &configtypes.OkeExternalBackendHealthCheck{
+				Protocol: "HTTP",
+				URLPath:  "/" + fake.Lorem().Word(),
+			}
+			params := reconcileBackendSetParams{
+				loadBalancerID:  fake.UUID().V4(),
+				routeNS:         routeNS,
+				backendRef:      backendRef,
+				externalBackend: &externalBackend,
+			}
+			wantBsName := backendSetNameFromParams(params)
+
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			workRequestID := fake.UUID().V4()
+
+			ociLoadBalancerClient.EXPECT().GetBackendSet(t.Context(), loadbalancer.GetBackendSetRequest{
+				BackendSetName: &wantBsName,
+				LoadBalancerId: &params.loadBalancerID,
+			}).Return(loadbalancer.GetBackendSetResponse{
+				BackendSet: loadbalancer.BackendSet{
+					Name:   &wantBsName,
+					Policy: new("ROUND_ROBIN"),
+					HealthChecker: &loadbalancer.HealthChecker{
+						Protocol:   new("HTTP"),
+						Port:       new(int(externalBackend.Spec.Endpoints[0].Port)),
+						UrlPath:    new("/" + fake.Lorem().Word() + "-previous"),
+						ReturnCode: new(200),
+					},
+				},
+			}, nil).Once()
+
+			ociLoadBalancerClient.EXPECT().UpdateBackendSet(
+				t.Context(),
+				mock.MatchedBy(func(req loadbalancer.UpdateBackendSetRequest) bool {
+					return lo.FromPtr(req.BackendSetName) == wantBsName &&
+						lo.FromPtr(req.HealthChecker.UrlPath) == externalBackend.Spec.HealthCheck.URLPath
+				}),
+			).Return(loadbalancer.UpdateBackendSetResponse{
+				OpcWorkRequestId: &workRequestID,
+			}, nil)
+
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil)
+
+			err := model.reconcileBackendSet(t.Context(), params)
+			require.NoError(t, err)
+		})
+
+		t.Run("create new backend set probing resolved named target port", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			targetPort := rand.Int32N(1000) + 9000
+			service := makeRandomService(
+				func(s *corev1.Service) {
+					s.Spec.Ports[0].Name = "http"
+					s.Spec.Ports[0].TargetPort = intstr.FromString("web")
+				},
+			)
+
+			params := makeParams(service, fake.UUID().V4())
+
+			wantBsName := backendSetNameFromParams(params)
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().List(
+				t.Context(),
+				mock.Anything,
+				client.MatchingLabels{discoveryv1.LabelServiceName: service.Name},
+				client.InNamespace(service.Namespace),
+			).RunAndReturn(func(_ context.Context, ol client.ObjectList, _ ...client.ListOption) error {
+				epSliceList, ok := ol.(*discoveryv1.EndpointSliceList)
+				require.True(t, ok, "expected an EndpointSliceList")
+				epSliceList.Items = []discoveryv1.EndpointSlice{
+					{Ports: []discoveryv1.EndpointPort{{Name: new("http"), Port: new(targetPort)}}},
+				}
+				return nil
+			}).Once()
+
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			workRequestID := fake.UUID().V4()
+
+			ociLoadBalancerClient.EXPECT().GetBackendSet(t.Context(), loadbalancer.GetBackendSetRequest{
+				BackendSetName: &wantBsName,
+				LoadBalancerId: &params.loadBalancerID,
+			}).Return(
+				loadbalancer.GetBackendSetResponse{},
+				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(404)),
+			).Once()
+
+			expectBackendSetsWithinLimit(t, ociLoadBalancerClient)
+			ociLoadBalancerClient.EXPECT().CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
+				LoadBalancerId: &params.loadBalancerID,
+				CreateBackendSetDetails: loadbalancer.CreateBackendSetDetails{
+					Name: &wantBsName,
+					HealthChecker: &loadbalancer.HealthCheckerDetails{
+						Protocol: new("TCP"),
+						Port:     new(int(targetPort)),
+					},
+					Policy: new("ROUND_ROBIN"),
+				},
+			}).Return(loadbalancer.CreateBackendSetResponse{
+				OpcWorkRequestId: &workRequestID,
+			}, nil)
+
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil)
+
+			err := model.reconcileBackendSet(t.Context(), params)
+			require.NoError(t, err)
+		})
+
+		t.Run("fails when endpoint slices of named target port can not be listed", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			service := makeRandomService(
+				func(s *corev1.Service) {
+					s.Spec.Ports[0].TargetPort = intstr.FromString("web")
+				},
+			)
+			params := makeParams(service, faker.New().UUID().V4())
+
+			wantErr := errors.New(faker.New().Lorem().Sentence(5))
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().List(t.Context(), mock.Anything, mock.Anything, mock.Anything).
+				Return(wantErr).Once()
+
+			err := model.reconcileBackendSet(t.Context(), params)
+
+			require.ErrorIs(t, err, wantErr)
+		})
+
+		t.Run("create new backend set with no target port", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			service := makeRandomService(
+				func(s *corev1.Service) {
+					s.Spec.Ports[0].TargetPort = intstr.FromInt(0)
+				},
+			)
+
+			params := makeParams(service, fake.UUID().V4())
+			params.backendRef.Port = nil
+
+			wantBsName := backendSetNameFromParams(params)
+
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			workRequestID := fake.UUID().V4()
+
+			ociLoadBalancerClient.EXPECT().GetBackendSet(t.Context(), loadbalancer.GetBackendSetRequest{
+				BackendSetName: &wantBsName,
+				LoadBalancerId: &params.loadBalancerID,
+			}).Return(
+				loadbalancer.GetBackendSetResponse{},
+				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(404)),
+			).Once()
+
+			expectBackendSetsWithinLimit(t, ociLoadBalancerClient)
 			ociLoadBalancerClient.EXPECT().CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
 				LoadBalancerId: &params.loadBalancerID,
 				CreateBackendSetDetails: loadbalancer.CreateBackendSetDetails{
@@ -1917,7 +3058,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 				bs.Policy = new("ROUND_ROBIN")
 				bs.HealthChecker = &loadbalancer.HealthChecker{
 					Protocol: new("TCP"),
-					Port:     new(int(lo.FromPtr(params.backendRef.Port))),
+					Port:     new(service.Spec.Ports[0].TargetPort.IntValue()),
 				}
 			})
 
@@ -1962,7 +3103,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 						assert.Equal(t, wantBsName, *req.BackendSetName) &&
 						assert.Equal(t, "ROUND_ROBIN", *req.Policy) &&
 						assert.Equal(t, "TCP", *req.HealthChecker.Protocol) &&
-						assert.Equal(t, int(lo.FromPtr(params.backendRef.Port)), *req.HealthChecker.Port)
+						assert.Equal(t, service.Spec.Ports[0].TargetPort.IntValue(), *req.HealthChecker.Port)
 				}),
 			).Return(loadbalancer.UpdateBackendSetResponse{
 				OpcWorkRequestId: &workRequestID,
@@ -1992,7 +3133,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 				bs.Policy = new("ROUND_ROBIN")
 				bs.HealthChecker = &loadbalancer.HealthChecker{
 					Protocol: new("TCP"),
-					Port:     new(int(lo.FromPtr(params.backendRef.Port))),
+					Port:     new(service.Spec.Ports[0].TargetPort.IntValue()),
 				}
 				bs.SslConfiguration = nil
 			})
@@ -2011,7 +3152,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 				t.Context(),
 				mock.MatchedBy(func(req loadbalancer.UpdateBackendSetRequest) bool {
 					return assert.Equal(t, "TCP", lo.FromPtr(req.HealthChecker.Protocol)) &&
-						assert.Equal(t, int(lo.FromPtr(params.backendRef.Port)), lo.FromPtr(req.HealthChecker.Port)) &&
+						assert.Equal(t, service.Spec.Ports[0].TargetPort.IntValue(), lo.FromPtr(req.HealthChecker.Port)) &&
 						assert.Equal(t, verifyDepth, lo.FromPtr(req.SslConfiguration.VerifyDepth))
 				}),
 			).Return(loadbalancer.UpdateBackendSetResponse{
@@ -2080,13 +3221,14 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(404)),
 			).Once()
 
+			expectBackendSetsWithinLimit(t, ociLoadBalancerClient)
 			ociLoadBalancerClient.EXPECT().CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
 				LoadBalancerId: &params.loadBalancerID,
 				CreateBackendSetDetails: loadbalancer.CreateBackendSetDetails{
 					Name: &wantBsName,
 					HealthChecker: &loadbalancer.HealthCheckerDetails{
 						Protocol: new("TCP"),
-						Port:     new(int(lo.FromPtr(params.backendRef.Port))),
+						Port:     new(service.Spec.Ports[0].TargetPort.IntValue()),
 					},
 					Policy: new("ROUND_ROBIN"),
 				},
@@ -2113,13 +3255,14 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(404)),
 			).Once()
 
+			expectBackendSetsWithinLimit(t, ociLoadBalancerClient)
 			ociLoadBalancerClient.EXPECT().CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
 				LoadBalancerId: &params.loadBalancerID,
 				CreateBackendSetDetails: loadbalancer.CreateBackendSetDetails{
 					Name: &wantBsName,
 					HealthChecker: &loadbalancer.HealthCheckerDetails{
 						Protocol: new("TCP"),
-						Port:     new(int(lo.FromPtr(params.backendRef.Port))),
+						Port:     new(service.Spec.Ports[0].TargetPort.IntValue()),
 					},
 					Policy: new("ROUND_ROBIN"),
 				},
@@ -2149,13 +3292,14 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(404)),
 			).Once()
 
+			expectBackendSetsWithinLimit(t, ociLoadBalancerClient)
 			ociLoadBalancerClient.EXPECT().CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
 				LoadBalancerId: &params.loadBalancerID,
 				CreateBackendSetDetails: loadbalancer.CreateBackendSetDetails{
 					Name: &wantBsName,
 					HealthChecker: &loadbalancer.HealthCheckerDetails{
 						Protocol: new("TCP"),
-						Port:     new(int(lo.FromPtr(params.backendRef.Port))),
+						Port:     new(service.Spec.Ports[0].TargetPort.IntValue()),
 					},
 					Policy: new("ROUND_ROBIN"),
 				},
@@ -2253,71 +3397,191 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			require.NoError(t, err)
 		})
 
-		t.Run("some listeners to remove with routing policy", func(t *testing.T) {
+		t.Run("only removes listeners owned by the gateway on shared load balancer", func(t *testing.T) {
 			fake := faker.New()
 			deps := makeMockDeps(t)
 			model := newOciLoadBalancerModel(deps)
 			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
 			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
 
-			gwListener1 := makeRandomListener()
-			gwListener2 := makeRandomListener()
-			lbListener1 := makeRandomOCIListener(func(l *loadbalancer.Listener) {
-				l.Name = new(string(gwListener1.Name))
+			namePrefix := "gw_" + fake.Lorem().Word() + "_"
+			gwListener := makeRandomListener()
+			lbListener := makeRandomOCIListener(func(l *loadbalancer.Listener) {
+				l.Name = new(namePrefix + string(gwListener.Name))
 			})
-			lbListener2 := makeRandomOCIListener(func(l *loadbalancer.Listener) {
-				l.Name = new(string(gwListener2.Name))
+			otherGatewayListener := makeRandomOCIListener(func(l *loadbalancer.Listener) {
+				l.Name = new("gw_other_" + string(gwListener.Name))
+			})
+			unprefixedListener := makeRandomOCIListener(func(l *loadbalancer.Listener) {
+				l.Name = new(string(gwListener.Name))
+			})
+			lbListenerToRemove := makeRandomOCIListener(func(l *loadbalancer.Listener) {
+				l.Name = new(namePrefix + fake.Internet().Slug())
 			})
-			lbListenerToRemove1 := makeRandomOCIListener(
-				func(l *loadbalancer.Listener) {
-					l.RoutingPolicyName = new("policy1" + fake.Internet().Domain())
-				},
-			)
-			lbListenerToRemove2 := makeRandomOCIListener(
-				func(l *loadbalancer.Listener) {
-					l.RoutingPolicyName = new("policy2" + fake.Internet().Domain())
-				},
-			)
 
 			params := removeMissingListenersParams{
 				loadBalancerID: fake.UUID().V4(),
 				knownListeners: map[string]loadbalancer.Listener{
-					*lbListener1.Name:         lbListener1,
-					*lbListener2.Name:         lbListener2,
-					*lbListenerToRemove1.Name: lbListenerToRemove1,
-					*lbListenerToRemove2.Name: lbListenerToRemove2,
-				},
-				gatewayListeners: []gatewayv1.Listener{
-					gwListener1,
-					gwListener2,
+					*lbListener.Name:           lbListener,
+					*otherGatewayListener.Name: otherGatewayListener,
+					*unprefixedListener.Name:   unprefixedListener,
+					*lbListenerToRemove.Name:   lbListenerToRemove,
 				},
+				gatewayListeners: []gatewayv1.Listener{gwListener},
+				namePrefix:       namePrefix,
 			}
 
-			deletePolicyRequestID1 := fake.UUID().V4()
-			ociLoadBalancerClient.EXPECT().DeleteRoutingPolicy(t.Context(), loadbalancer.DeleteRoutingPolicyRequest{
-				LoadBalancerId:    &params.loadBalancerID,
-				RoutingPolicyName: lbListenerToRemove1.RoutingPolicyName,
-			}).Return(loadbalancer.DeleteRoutingPolicyResponse{OpcWorkRequestId: &deletePolicyRequestID1}, nil).Once()
-
-			workRequestsWatcher.EXPECT().WaitFor(t.Context(), deletePolicyRequestID1).Return(nil).Once()
-
-			deletePolicyRequestID2 := fake.UUID().V4()
-			ociLoadBalancerClient.EXPECT().DeleteRoutingPolicy(t.Context(), loadbalancer.DeleteRoutingPolicyRequest{
-				LoadBalancerId:    &params.loadBalancerID,
-				RoutingPolicyName: lbListenerToRemove2.RoutingPolicyName,
-			}).Return(loadbalancer.DeleteRoutingPolicyResponse{OpcWorkRequestId: &deletePolicyRequestID2}, nil).Once()
-
-			workRequestsWatcher.EXPECT().WaitFor(t.Context(), deletePolicyRequestID2).Return(nil).Once()
-
-			// Expect deletion for both missing listeners
-			deleteListenerRequestID := fake.UUID().V4()
+			workRequestID := fake.UUID().V4()
 			ociLoadBalancerClient.EXPECT().DeleteListener(t.Context(), loadbalancer.DeleteListenerRequest{
 				LoadBalancerId: &params.loadBalancerID,
-				ListenerName:   lbListenerToRemove1.Name,
-			}).Return(loadbalancer.DeleteListenerResponse{OpcWorkRequestId: &deleteListenerRequestID}, nil).Once()
-			workRequestsWatcher.EXPECT().WaitFor(t.Context(), deleteListenerRequestID).Return(nil).Once()
+				ListenerName:   lbListenerToRemove.Name,
+			}).Return(loadbalancer.DeleteListenerResponse{OpcWorkRequestId: &workRequestID}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
 
-			workRequestID2 := fake.UUID().V4()
+			err := model.removeMissingListeners(t.Context(), params)
+			require.NoError(t, err)
+		})
+
+		t.Run("keeps adopted listeners", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+
+			gwListener := makeRandomListener()
+			lbListener := makeRandomOCIListener(func(l *loadbalancer.Listener) {
+				l.Name = new(string(gwListener.Name))
+			})
+			adoptedListener := makeRandomOCIListener()
+			lbListenerToRemove := makeRandomOCIListener()
+
+			params := removeMissingListenersParams{
+				loadBalancerID: fake.UUID().V4(),
+				knownListeners: map[string]loadbalancer.Listener{
+					*lbListener.Name:         lbListener,
+					*adoptedListener.Name:    adoptedListener,
+					*lbListenerToRemove.Name: lbListenerToRemove,
+				},
+				gatewayListeners: []gatewayv1.Listener{gwListener},
+				adoptedListeners: []string{*adoptedListener.Name},
+			}
+
+			workRequestID := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().DeleteListener(t.Context(), loadbalancer.DeleteListenerRequest{
+				LoadBalancerId: &params.loadBalancerID,
+				ListenerName:   lbListenerToRemove.Name,
+			}).Return(loadbalancer.DeleteListenerResponse{OpcWorkRequestId: &workRequestID}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
+
+			err := model.removeMissingListeners(t.Context(), params)
+			require.NoError(t, err)
+		})
+
+		t.Run("keeps listeners superseded by the naming scheme", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+
+			naming := ociResourceNaming{hashSuffixLength: 8}
+			gwListener := makeRandomListener()
+			supersededListener := makeRandomOCIListener(func(l *loadbalancer.Listener) {
+				l.Name = new(string(gwListener.Name))
+				l.Port = new(int(gwListener.Port))
+			})
+			lbListenerToRemove := makeRandomOCIListener(func(l *loadbalancer.Listener) {
+				l.Name = new(naming.listenerName("", gatewayv1.SectionName(fake.Lorem().Word()+"-removed")))
+			})
+
+			params := removeMissingListenersParams{
+				loadBalancerID: fake.UUID().V4(),
+				knownListeners: map[string]loadbalancer.Listener{
+					*supersededListener.Name: supersededListener,
+					*lbListenerToRemove.Name: lbListenerToRemove,
+				},
+				gatewayListeners: []gatewayv1.Listener{gwListener},
+				naming:           naming,
+			}
+
+			// Superseded listener is replaced when the gateway listener is programmed
+			workRequestID := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().DeleteListener(t.Context(), loadbalancer.DeleteListenerRequest{
+				LoadBalancerId: &params.loadBalancerID,
+				ListenerName:   lbListenerToRemove.Name,
+			}).Return(loadbalancer.DeleteListenerResponse{OpcWorkRequestId: &workRequestID}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
+
+			err := model.removeMissingListeners(t.Context(), params)
+			require.NoError(t, err)
+		})
+
+		t.Run("some listeners to remove with routing policy", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+
+			gwListener1 := makeRandomListener()
+			gwListener2 := makeRandomListener()
+			lbListener1 := makeRandomOCIListener(func(l *loadbalancer.Listener) {
+				l.Name = new(string(gwListener1.Name))
+			})
+			lbListener2 := makeRandomOCIListener(func(l *loadbalancer.Listener) {
+				l.Name = new(string(gwListener2.Name))
+			})
+			lbListenerToRemove1 := makeRandomOCIListener(
+				func(l *loadbalancer.Listener) {
+					l.RoutingPolicyName = new("policy1" + fake.Internet().Domain())
+				},
+			)
+			lbListenerToRemove2 := makeRandomOCIListener(
+				func(l *loadbalancer.Listener) {
+					l.RoutingPolicyName = new("policy2" + fake.Internet().Domain())
+				},
+			)
+
+			params := removeMissingListenersParams{
+				loadBalancerID: fake.UUID().V4(),
+				knownListeners: map[string]loadbalancer.Listener{
+					*lbListener1.Name:         lbListener1,
+					*lbListener2.Name:         lbListener2,
+					*lbListenerToRemove1.Name: lbListenerToRemove1,
+					*lbListenerToRemove2.Name: lbListenerToRemove2,
+				},
+				gatewayListeners: []gatewayv1.Listener{
+					gwListener1,
+					gwListener2,
+				},
+			}
+
+			deletePolicyRequestID1 := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().DeleteRoutingPolicy(t.Context(), loadbalancer.DeleteRoutingPolicyRequest{
+				LoadBalancerId:    &params.loadBalancerID,
+				RoutingPolicyName: lbListenerToRemove1.RoutingPolicyName,
+			}).Return(loadbalancer.DeleteRoutingPolicyResponse{OpcWorkRequestId: &deletePolicyRequestID1}, nil).Once()
+
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), deletePolicyRequestID1).Return(nil).Once()
+
+			deletePolicyRequestID2 := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().DeleteRoutingPolicy(t.Context(), loadbalancer.DeleteRoutingPolicyRequest{
+				LoadBalancerId:    &params.loadBalancerID,
+				RoutingPolicyName: lbListenerToRemove2.RoutingPolicyName,
+			}).Return(loadbalancer.DeleteRoutingPolicyResponse{OpcWorkRequestId: &deletePolicyRequestID2}, nil).Once()
+
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), deletePolicyRequestID2).Return(nil).Once()
+
+			// Expect deletion for both missing listeners
+			deleteListenerRequestID := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().DeleteListener(t.Context(), loadbalancer.DeleteListenerRequest{
+				LoadBalancerId: &params.loadBalancerID,
+				ListenerName:   lbListenerToRemove1.Name,
+			}).Return(loadbalancer.DeleteListenerResponse{OpcWorkRequestId: &deleteListenerRequestID}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), deleteListenerRequestID).Return(nil).Once()
+
+			workRequestID2 := fake.UUID().V4()
 			ociLoadBalancerClient.EXPECT().DeleteListener(t.Context(), loadbalancer.DeleteListenerRequest{
 				LoadBalancerId: &params.loadBalancerID,
 				ListenerName:   lbListenerToRemove2.Name,
@@ -2328,6 +3592,107 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			require.NoError(t, err)
 		})
 
+		t.Run("keeps listeners with rules of existing routes", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+
+			inUsePolicy := makeRandomOCIRoutingPolicy()
+			inUsePolicy.Rules = []loadbalancer.RoutingRule{
+				{Name: new("live-rule")},
+				{Name: new(defaultCatchAllRuleName)},
+			}
+			catchAllPolicy := makeRandomOCIRoutingPolicy()
+			catchAllPolicy.Rules = []loadbalancer.RoutingRule{
+				{Name: new(defaultCatchAllRuleName)},
+			}
+			inUseListener := makeRandomOCIListener(func(l *loadbalancer.Listener) {
+				l.RoutingPolicyName = inUsePolicy.Name
+			})
+			catchAllListener := makeRandomOCIListener(func(l *loadbalancer.Listener) {
+				l.RoutingPolicyName = catchAllPolicy.Name
+			})
+			routeOwner := "HTTPRoute " + fake.Lorem().Word() + "/" + fake.Lorem().Word()
+
+			params := removeMissingListenersParams{
+				loadBalancerID: fake.UUID().V4(),
+				knownListeners: map[string]loadbalancer.Listener{
+					*inUseListener.Name:    inUseListener,
+					*catchAllListener.Name: catchAllListener,
+				},
+				knownRoutingPolicies: map[string]loadbalancer.RoutingPolicy{
+					*inUsePolicy.Name:    inUsePolicy,
+					*catchAllPolicy.Name: catchAllPolicy,
+				},
+				gatewayListeners: []gatewayv1.Listener{},
+				routeRuleOwners: map[string][]string{
+					*inUseListener.Name + "/live-rule":                     {routeOwner},
+					*catchAllListener.Name + "/" + defaultCatchAllRuleName: {routeOwner},
+				},
+			}
+
+			deleteListenerRequestID := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().DeleteListener(t.Context(), loadbalancer.DeleteListenerRequest{
+				LoadBalancerId: &params.loadBalancerID,
+				ListenerName:   catchAllListener.Name,
+			}).Return(loadbalancer.DeleteListenerResponse{OpcWorkRequestId: &deleteListenerRequestID}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), deleteListenerRequestID).Return(nil).Once()
+			deletePolicyRequestID := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().DeleteRoutingPolicy(t.Context(), loadbalancer.DeleteRoutingPolicyRequest{
+				LoadBalancerId:    &params.loadBalancerID,
+				RoutingPolicyName: catchAllPolicy.Name,
+			}).Return(loadbalancer.DeleteRoutingPolicyResponse{OpcWorkRequestId: &deletePolicyRequestID}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), deletePolicyRequestID).Return(nil).Once()
+
+			err := model.removeMissingListeners(t.Context(), params)
+
+			require.ErrorIs(t, err, errListenerInUse)
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.GatewayConditionProgrammed), statusErr.conditionType)
+			assert.Equal(t, GatewayReasonListenerInUse, statusErr.reason)
+			assert.Contains(t, statusErr.message, *inUseListener.Name)
+			assert.Contains(t, statusErr.message, routeOwner)
+		})
+
+		t.Run("keeps protected listeners", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+
+			namePrefix := "gw_" + fake.Lorem().Word() + "_"
+			gwListener := makeRandomListener()
+			lbListener := makeRandomOCIListener(func(l *loadbalancer.Listener) {
+				l.Name = new(namePrefix + string(gwListener.Name))
+			})
+			protectedName := "listener-" + fake.UUID().V4()
+			protectedListener := makeRandomOCIListener(func(l *loadbalancer.Listener) {
+				l.Name = new(namePrefix + protectedName)
+			})
+
+			params := removeMissingListenersParams{
+				loadBalancerID: fake.UUID().V4(),
+				knownListeners: map[string]loadbalancer.Listener{
+					*lbListener.Name:        lbListener,
+					*protectedListener.Name: protectedListener,
+				},
+				gatewayListeners:   []gatewayv1.Listener{gwListener},
+				namePrefix:         namePrefix,
+				protectedListeners: []string{protectedName},
+			}
+
+			err := model.removeMissingListeners(t.Context(), params)
+
+			require.ErrorIs(t, err, errListenerProtected)
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.GatewayConditionProgrammed), statusErr.conditionType)
+			assert.Equal(t, GatewayReasonListenerProtected, statusErr.reason)
+			assert.Contains(t, statusErr.message, protectedName)
+		})
+
 		t.Run("fail when delete listener fails", func(t *testing.T) {
 			fake := faker.New()
 			deps := makeMockDeps(t)
@@ -2568,7 +3933,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 		})
 	})
 
-	t.Run("makeRoutingRule", func(t *testing.T) {
+	t.Run("makeRoutingRules", func(t *testing.T) {
 		t.Run("successfully create a routing rule", func(t *testing.T) {
 			fake := faker.New()
 			deps := makeMockDeps(t)
@@ -2602,7 +3967,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 
 			expectedRuleName := ociListerPolicyRuleName(httpRoute, ruleIndex)
 			expectedBackendSets := lo.Map(refs, func(ref gatewayv1.HTTPBackendRef, _ int) string {
-				return ociBackendSetNameFromBackendRef(httpRoute, ref)
+				return ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, ref)
 			})
 
 			expectedRule := loadbalancer.RoutingRule{
@@ -2615,52 +3980,59 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 				}),
 			}
 
-			actualRule, err := model.makeRoutingRule(t.Context(), params)
+			actualRules, err := model.makeRoutingRules(t.Context(), params)
 			require.NoError(t, err)
-			assert.Equal(t, expectedRule, actualRule)
+			assert.Equal(t, []loadbalancer.RoutingRule{expectedRule}, actualRules)
 		})
 
-		t.Run("includes route hostname in routing rule condition", func(t *testing.T) {
+		t.Run("combines ExtensionRef filter conditions", func(t *testing.T) {
 			fake := faker.New()
 			deps := makeMockDeps(t)
-			deps.RoutingRulesMapper = newOciLoadBalancerRoutingRulesMapper()
 			model := newOciLoadBalancerModel(deps)
+			routingRulesMapper, _ := deps.RoutingRulesMapper.(*MockociLoadBalancerRoutingRulesMapper)
 
-			hostname := gatewayv1.Hostname("auth-" + fake.Internet().Domain())
-			pathValue := "/"
-			backendRef := makeRandomBackendRef()
+			extensionGroup := gatewayv1.Group(fake.Internet().Domain())
+			extensionRef := gatewayv1.LocalObjectReference{
+				Group: extensionGroup,
+				Kind:  "Canary",
+				Name:  gatewayv1.ObjectName(fake.Lorem().Word()),
+			}
 			httpRoute := makeRandomHTTPRoute(
-				randomHTTPRouteWithRulesOpt(
-					gatewayv1.HTTPRouteRule{
-						Matches: []gatewayv1.HTTPRouteMatch{
-							{
-								Path: &gatewayv1.HTTPPathMatch{
-									Type:  lo.ToPtr(gatewayv1.PathMatchPathPrefix),
-									Value: &pathValue,
-								},
-							},
-						},
-						BackendRefs: []gatewayv1.HTTPBackendRef{backendRef},
-					},
-				),
+				randomHTTPRouteWithRulesOpt(makeRandomHTTPRouteRule(
+					randomHTTPRouteRuleWithRandomBackendRefsOpt(makeRandomBackendRef()),
+				)),
 			)
-			httpRoute.Spec.Hostnames = []gatewayv1.Hostname{hostname}
+			httpRoute.Spec.Rules[0].Filters = []gatewayv1.HTTPRouteFilter{
+				{Type: gatewayv1.HTTPRouteFilterExtensionRef, ExtensionRef: &extensionRef},
+			}
+			httpfilter.Register(extensionGroup, "Canary", httpfilter.ExtensionFunc(
+				func(_ context.Context, req httpfilter.Request) (string, error) {
+					assert.Equal(t, extensionRef, req.Ref)
+					assert.Equal(t, 0, req.RuleIndex)
+					assert.Equal(t, httpRoute.Name, req.Route.Name)
+					return "http.request.headers[(i 'x-canary')] eq 'true'", nil
+				},
+			))
+
+			routingRulesMapper.EXPECT().mapHTTPRouteHostnamesAndMatchesToCondition(
+				httpRoute.Spec.Hostnames,
+				httpRoute.Spec.Rules[0].Matches,
+			).Return("http.request.url.path sw '/api'", nil).Once()
 
-			actualRule, err := model.makeRoutingRule(t.Context(), makeRoutingRuleParams{
+			actualRules, err := model.makeRoutingRules(t.Context(), makeRoutingRuleParams{
 				httpRoute:          httpRoute,
 				httpRouteRuleIndex: 0,
 			})
 
 			require.NoError(t, err)
-			condition := lo.FromPtr(actualRule.Condition)
-			assert.Contains(t, condition, "all(")
-			assert.Contains(t, condition, "http.request.headers[(i 'host')]")
-			assert.Contains(t, condition, fmt.Sprintf("eq (i '%s')", hostname))
-			assert.Contains(t, condition, fmt.Sprintf("http.request.url.path sw '%s'", pathValue))
+			require.Len(t, actualRules, 1)
+			assert.Equal(t,
+				"all(http.request.url.path sw '/api', http.request.headers[(i 'x-canary')] eq 'true')",
+				lo.FromPtr(actualRules[0].Condition),
+			)
 		})
 
-		t.Run("fail when mapping matches to condition fails", func(t *testing.T) {
-			fake := faker.New()
+		t.Run("skips filter-only rules", func(t *testing.T) {
 			deps := makeMockDeps(t)
 			model := newOciLoadBalancerModel(deps)
 			routingRulesMapper, _ := deps.RoutingRulesMapper.(*MockociLoadBalancerRoutingRulesMapper)
@@ -2668,46 +4040,280 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			httpRoute := makeRandomHTTPRoute(
 				randomHTTPRouteWithRulesOpt(makeRandomHTTPRouteRule()),
 			)
-			ruleIndex := 0
-
-			params := makeRoutingRuleParams{
-				httpRoute:          httpRoute,
-				httpRouteRuleIndex: ruleIndex,
+			httpRoute.Spec.Rules[0].Filters = []gatewayv1.HTTPRouteFilter{
+				{
+					Type: gatewayv1.HTTPRouteFilterRequestRedirect,
+					RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{
+						Scheme:     new("https"),
+						StatusCode: new(http.StatusMovedPermanently),
+					},
+				},
 			}
-
-			expectedErr := errors.New(fake.Lorem().Sentence(10))
 			routingRulesMapper.EXPECT().mapHTTPRouteHostnamesAndMatchesToCondition(
 				httpRoute.Spec.Hostnames,
-				httpRoute.Spec.Rules[ruleIndex].Matches,
-			).Return("", expectedErr).Once()
+				httpRoute.Spec.Rules[0].Matches,
+			).Return("http.request.url.path sw '/'", nil).Once()
 
-			_, err := model.makeRoutingRule(t.Context(), params)
-			require.Error(t, err)
-			require.ErrorIs(t, err, expectedErr)
+			actualRules, err := model.makeRoutingRules(t.Context(), makeRoutingRuleParams{
+				httpRoute:          httpRoute,
+				httpRouteRuleIndex: 0,
+			})
+
+			require.NoError(t, err)
+			assert.Empty(t, actualRules)
 		})
-	})
 
-	t.Run("makeGRPCRoutingRule", func(t *testing.T) {
-		t.Run("uses a route-kind-specific rule name", func(t *testing.T) {
-			namespace := "default"
-			name := "shared-route-name"
-			ruleIndex := 0
+		t.Run("fails for unknown ExtensionRef filter", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			routingRulesMapper, _ := deps.RoutingRulesMapper.(*MockociLoadBalancerRoutingRulesMapper)
 
-			httpRoute := gatewayv1.HTTPRoute{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: namespace,
-					Name:      name,
-				},
-				Spec: gatewayv1.HTTPRouteSpec{
-					Rules: []gatewayv1.HTTPRouteRule{{}},
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(makeRandomHTTPRouteRule()),
+			)
+			httpRoute.Spec.Rules[0].Filters = []gatewayv1.HTTPRouteFilter{
+				{
+					Type: gatewayv1.HTTPRouteFilterExtensionRef,
+					ExtensionRef: &gatewayv1.LocalObjectReference{
+						Group: gatewayv1.Group(fake.Internet().Domain()),
+						Kind:  "Unknown",
+						Name:  gatewayv1.ObjectName(fake.Lorem().Word()),
+					},
 				},
 			}
-			grpcRoute := gatewayv1.GRPCRoute{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: namespace,
-					Name:      name,
-				},
-				Spec: gatewayv1.GRPCRouteSpec{
+			routingRulesMapper.EXPECT().mapHTTPRouteHostnamesAndMatchesToCondition(
+				httpRoute.Spec.Hostnames,
+				httpRoute.Spec.Rules[0].Matches,
+			).Return(fake.Lorem().Sentence(3), nil).Once()
+
+			_, err := model.makeRoutingRules(t.Context(), makeRoutingRuleParams{
+				httpRoute:          httpRoute,
+				httpRouteRuleIndex: 0,
+			})
+
+			require.ErrorIs(t, err, errUnsupportedFilter)
+		})
+
+		t.Run("fails for invalid rule priority", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(makeRandomHTTPRouteRule()),
+			)
+			httpRoute.Annotations = map[string]string{HTTPRouteRulePriorityAnnotation: "first"}
+
+			_, err := model.makeRoutingRules(t.Context(), makeRoutingRuleParams{
+				httpRoute:          httpRoute,
+				httpRouteRuleIndex: 0,
+			})
+
+			require.ErrorIs(t, err, errInvalidRulePriority)
+		})
+
+		t.Run("fails when condition exceeds the limit", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			routingRulesMapper, _ := deps.RoutingRulesMapper.(*MockociLoadBalancerRoutingRulesMapper)
+
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(makeRandomHTTPRouteRule()),
+			)
+
+			routingRulesMapper.EXPECT().mapHTTPRouteHostnamesAndMatchesToCondition(
+				httpRoute.Spec.Hostnames,
+				httpRoute.Spec.Rules[0].Matches,
+			).Return(strings.Repeat("a", maxRoutingRuleConditionLength+1), nil).Once()
+
+			_, err := model.makeRoutingRules(t.Context(), makeRoutingRuleParams{
+				httpRoute:          httpRoute,
+				httpRouteRuleIndex: 0,
+			})
+
+			var capacityErr routingPolicyCapacityError
+			require.ErrorAs(t, err, &capacityErr)
+			assert.Contains(t, capacityErr.Error(), ociListerPolicyRuleName(httpRoute, 0))
+		})
+
+		t.Run("splits matches across routing rules when condition exceeds the limit", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			routingRulesMapper, _ := deps.RoutingRulesMapper.(*MockociLoadBalancerRoutingRulesMapper)
+
+			matches := lo.Map([]string{"a", "b", "c"}, func(letter string, _ int) gatewayv1.HTTPRouteMatch {
+				return gatewayv1.HTTPRouteMatch{Path: &gatewayv1.HTTPPathMatch{
+					Type:  lo.ToPtr(gatewayv1.PathMatchPathPrefix),
+					Value: new("/" + strings.Repeat(letter, maxRoutingRuleConditionLength/3)),
+				}}
+			})
+			matchesCondition := func(matches []gatewayv1.HTTPRouteMatch) string {
+				conditions := lo.Map(matches, func(match gatewayv1.HTTPRouteMatch, _ int) string {
+					return fmt.Sprintf("http.request.url.path sw '%s'", *match.Path.Value)
+				})
+				return fmt.Sprintf("any(%s)", strings.Join(conditions, ", "))
+			}
+			backendRef := makeRandomBackendRef()
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(gatewayv1.HTTPRouteRule{
+					Matches:     matches,
+					BackendRefs: []gatewayv1.HTTPBackendRef{backendRef},
+				}),
+			)
+
+			routingRulesMapper.EXPECT().mapHTTPRouteHostnamesAndMatchesToCondition(
+				httpRoute.Spec.Hostnames,
+				mock.Anything,
+			).RunAndReturn(func(_ []gatewayv1.Hostname, matches []gatewayv1.HTTPRouteMatch) (string, error) {
+				return matchesCondition(matches), nil
+			})
+
+			actualRules, err := model.makeRoutingRules(t.Context(), makeRoutingRuleParams{
+				httpRoute:          httpRoute,
+				httpRouteRuleIndex: 0,
+			})
+
+			require.NoError(t, err)
+			actions := []loadbalancer.Action{loadbalancer.ForwardToBackendSet{
+				BackendSetName: new(ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)),
+			}}
+			assert.Equal(t, []loadbalancer.RoutingRule{
+				{
+					Name:      new(ociListerPolicyRuleName(httpRoute, 0)),
+					Condition: new(matchesCondition(matches[:2])),
+					Actions:   actions,
+				},
+				{
+					Name:      new(ociListerPolicyRulePartName(httpRoute, 0, 1)),
+					Condition: new(matchesCondition(matches[2:])),
+					Actions:   actions,
+				},
+			}, actualRules)
+		})
+
+		t.Run("fails when a single match exceeds the condition limit", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			routingRulesMapper, _ := deps.RoutingRulesMapper.(*MockociLoadBalancerRoutingRulesMapper)
+
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(gatewayv1.HTTPRouteRule{
+					Matches: []gatewayv1.HTTPRouteMatch{
+						{Path: &gatewayv1.HTTPPathMatch{Value: new("/short")}},
+						{Path: &gatewayv1.HTTPPathMatch{Value: new("/long")}},
+					},
+				}),
+			)
+
+			routingRulesMapper.EXPECT().mapHTTPRouteHostnamesAndMatchesToCondition(
+				httpRoute.Spec.Hostnames,
+				mock.Anything,
+			).RunAndReturn(func(_ []gatewayv1.Hostname, matches []gatewayv1.HTTPRouteMatch) (string, error) {
+				if *matches[len(matches)-1].Path.Value == "/long" {
+					return strings.Repeat("a", maxRoutingRuleConditionLength+1), nil
+				}
+				return "http.request.url.path sw '/short'", nil
+			})
+
+			_, err := model.makeRoutingRules(t.Context(), makeRoutingRuleParams{
+				httpRoute:          httpRoute,
+				httpRouteRuleIndex: 0,
+			})
+
+			var capacityErr routingPolicyCapacityError
+			require.ErrorAs(t, err, &capacityErr)
+			assert.Contains(t, capacityErr.Error(), ociListerPolicyRuleName(httpRoute, 0)+" match 1 ")
+		})
+
+		t.Run("includes route hostname in routing rule condition", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			deps.RoutingRulesMapper = newOciLoadBalancerRoutingRulesMapper()
+			model := newOciLoadBalancerModel(deps)
+
+			hostname := gatewayv1.Hostname("auth-" + fake.Internet().Domain())
+			pathValue := "/" + fake.Lorem().Word()
+			backendRef := makeRandomBackendRef()
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(
+					gatewayv1.HTTPRouteRule{
+						Matches: []gatewayv1.HTTPRouteMatch{
+							{
+								Path: &gatewayv1.HTTPPathMatch{
+									Type:  lo.ToPtr(gatewayv1.PathMatchPathPrefix),
+									Value: &pathValue,
+								},
+							},
+						},
+						BackendRefs: []gatewayv1.HTTPBackendRef{backendRef},
+					},
+				),
+			)
+			httpRoute.Spec.Hostnames = []gatewayv1.Hostname{hostname}
+
+			actualRules, err := model.makeRoutingRules(t.Context(), makeRoutingRuleParams{
+				httpRoute:          httpRoute,
+				httpRouteRuleIndex: 0,
+			})
+
+			require.NoError(t, err)
+			require.Len(t, actualRules, 1)
+			condition := lo.FromPtr(actualRules[0].Condition)
+			assert.Contains(t, condition, "all(")
+			assert.Contains(t, condition, "http.request.headers[(i 'host')]")
+			assert.Contains(t, condition, fmt.Sprintf("eq (i '%s')", hostname))
+			assert.Contains(t, condition, fmt.Sprintf("http.request.url.path sw '%s/'", pathValue))
+		})
+
+		t.Run("fail when mapping matches to condition fails", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			routingRulesMapper, _ := deps.RoutingRulesMapper.(*MockociLoadBalancerRoutingRulesMapper)
+
+			httpRoute := makeRandomHTTPRoute(
+				randomHTTPRouteWithRulesOpt(makeRandomHTTPRouteRule()),
+			)
+			ruleIndex := 0
+
+			params := makeRoutingRuleParams{
+				httpRoute:          httpRoute,
+				httpRouteRuleIndex: ruleIndex,
+			}
+
+			expectedErr := errors.New(fake.Lorem().Sentence(10))
+			routingRulesMapper.EXPECT().mapHTTPRouteHostnamesAndMatchesToCondition(
+				httpRoute.Spec.Hostnames,
+				httpRoute.Spec.Rules[ruleIndex].Matches,
+			).Return("", expectedErr).Once()
+
+			_, err := model.makeRoutingRules(t.Context(), params)
+			require.Error(t, err)
+			require.ErrorIs(t, err, expectedErr)
+		})
+	})
+
+	t.Run("makeGRPCRoutingRules", func(t *testing.T) {
+		t.Run("uses a route-kind-specific rule name", func(t *testing.T) {
+			namespace := "default"
+			name := "shared-route-name"
+			ruleIndex := 0
+
+			httpRoute := gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: gatewayv1.HTTPRouteSpec{
+					Rules: []gatewayv1.HTTPRouteRule{{}},
+				},
+			}
+			grpcRoute := gatewayv1.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: gatewayv1.GRPCRouteSpec{
 					Rules: []gatewayv1.GRPCRouteRule{{}},
 				},
 			}
@@ -2767,7 +4373,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 
 			expectedRuleName := ociGRPCListenerPolicyRuleName(grpcRoute, ruleIndex)
 			expectedBackendSets := lo.Map(refs, func(ref gatewayv1.GRPCBackendRef, _ int) string {
-				return ociBackendSetNameFromGRPCBackendRef(grpcRoute, ref)
+				return ociBackendSetNameFromGRPCBackendRef(ociResourceNaming{}, grpcRoute, ref)
 			})
 			expectedRule := loadbalancer.RoutingRule{
 				Name:      new(expectedRuleName),
@@ -2779,13 +4385,13 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 				}),
 			}
 
-			actualRule, err := model.makeGRPCRoutingRule(t.Context(), makeGRPCRoutingRuleParams{
+			actualRules, err := model.makeGRPCRoutingRules(t.Context(), makeGRPCRoutingRuleParams{
 				grpcRoute:          grpcRoute,
 				grpcRouteRuleIndex: ruleIndex,
 			})
 
 			require.NoError(t, err)
-			assert.Equal(t, expectedRule, actualRule)
+			assert.Equal(t, []loadbalancer.RoutingRule{expectedRule}, actualRules)
 		})
 
 		t.Run("fails when grpc match mapping fails", func(t *testing.T) {
@@ -2808,7 +4414,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 				grpcRoute.Spec.Rules[0].Matches,
 			).Return("", wantErr).Once()
 
-			_, err := model.makeGRPCRoutingRule(t.Context(), makeGRPCRoutingRuleParams{
+			_, err := model.makeGRPCRoutingRules(t.Context(), makeGRPCRoutingRuleParams{
 				grpcRoute:          grpcRoute,
 				grpcRouteRuleIndex: 0,
 			})
@@ -2817,8 +4423,261 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 		})
 	})
 
-	t.Run("commitRoutingPolicy", func(t *testing.T) {
-		t.Run("successfully merge and update routing policy", func(t *testing.T) {
+	t.Run("commitRoutingPolicy", func(t *testing.T) {
+		t.Run("successfully merge and update routing policy", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+
+			loadBalancerID := fake.UUID().V4()
+			listenerName := fake.UUID().V4()
+			policyName := listenerPolicyName(listenerName)
+
+			existingRulePrefixes := []string{
+				"routes-1",
+				"routes-2",
+				"routes-3",
+				"routes-4",
+			}
+
+			existingRules := lo.Map(existingRulePrefixes, func(prefix string, i int) loadbalancer.RoutingRule {
+				return loadbalancer.RoutingRule{
+					Name:      new(fmt.Sprintf("%s%04d", prefix, i)),
+					Condition: new(fake.Lorem().Sentence(10)),
+				}
+			})
+
+			existingRules = append(existingRules, loadbalancer.RoutingRule{
+				Name:      new(string(defaultCatchAllRuleName)),
+				Condition: new(fake.Lorem().Sentence(10)),
+			})
+
+			newRulesPrefixes := []string{
+				"new-routes-1",
+				"new-routes-2",
+				"new-routes-3",
+			}
+			newRules := lo.Map(newRulesPrefixes, func(prefix string, i int) loadbalancer.RoutingRule {
+				return loadbalancer.RoutingRule{
+					Name:      new(fmt.Sprintf("%s%04d", prefix, i)),
+					Condition: new(fake.Lorem().Sentence(10)),
+				}
+			})
+
+			replacedRuleIndex := rand.IntN(len(existingRulePrefixes))
+			replacedRule := loadbalancer.RoutingRule{
+				Name:      new(fmt.Sprintf("%s%04d", existingRulePrefixes[replacedRuleIndex], replacedRuleIndex)),
+				Condition: new(fake.Lorem().Sentence(10)),
+			}
+
+			rulesToCommit := make([]loadbalancer.RoutingRule, 0, len(existingRules)+len(newRules))
+			rulesToCommit = append(rulesToCommit, existingRules...)
+			rulesToCommit[replacedRuleIndex] = replacedRule
+			rulesToCommit = append(rulesToCommit, newRules...)
+
+			wantMergedRules := make([]loadbalancer.RoutingRule, 0, len(rulesToCommit))
+			wantMergedRules = append(wantMergedRules, rulesToCommit...)
+
+			// Sort the expected rules
+			sort.Slice(wantMergedRules, func(i, j int) bool {
+				ruleI := lo.FromPtr(wantMergedRules[i].Name)
+				ruleJ := lo.FromPtr(wantMergedRules[j].Name)
+				if ruleI == defaultCatchAllRuleName {
+					return false
+				}
+				if ruleJ == defaultCatchAllRuleName {
+					return true
+				}
+				return ruleI < ruleJ
+			})
+
+			params := commitRoutingPolicyParams{
+				loadBalancerID: loadBalancerID,
+				listenerName:   listenerName,
+				policyRules:    rulesToCommit,
+			}
+
+			existingPolicy := loadbalancer.RoutingPolicy{
+				Name:                     new(policyName),
+				Rules:                    existingRules,
+				ConditionLanguageVersion: loadbalancer.RoutingPolicyConditionLanguageVersionV1,
+			}
+
+			// Expect to get the current routing policy
+			etag := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), loadbalancer.GetRoutingPolicyRequest{
+				RoutingPolicyName: new(policyName),
+				LoadBalancerId:    &loadBalancerID,
+			}).Return(loadbalancer.GetRoutingPolicyResponse{
+				RoutingPolicy: existingPolicy,
+				ETag:          &etag,
+			}, nil)
+
+			// Expect to update the policy with merged rules
+			workRequestID := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().UpdateRoutingPolicy(t.Context(), loadbalancer.UpdateRoutingPolicyRequest{
+				LoadBalancerId:    &loadBalancerID,
+				RoutingPolicyName: new(policyName),
+				IfMatch:           &etag,
+				UpdateRoutingPolicyDetails: loadbalancer.UpdateRoutingPolicyDetails{
+					ConditionLanguageVersion: loadbalancer.UpdateRoutingPolicyDetailsConditionLanguageVersionEnum(
+						existingPolicy.ConditionLanguageVersion,
+					),
+					Rules: wantMergedRules,
+				},
+			}).Return(loadbalancer.UpdateRoutingPolicyResponse{
+				OpcWorkRequestId: &workRequestID,
+			}, nil)
+
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil)
+
+			err := model.commitRoutingPolicy(t.Context(), params)
+			require.NoError(t, err)
+		})
+
+		t.Run("retries when routing policy was modified concurrently", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+
+			loadBalancerID := fake.UUID().V4()
+			listenerName := fake.UUID().V4()
+			policyName := listenerPolicyName(listenerName)
+			newRule := loadbalancer.RoutingRule{
+				Name:      new(fake.UUID().V4()),
+				Condition: new(fake.Lorem().Sentence(10)),
+			}
+			concurrentRule := loadbalancer.RoutingRule{
+				Name:      new(fake.UUID().V4()),
+				Condition: new(fake.Lorem().Sentence(10)),
+			}
+
+			staleEtag := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), mock.Anything).Return(
+				loadbalancer.GetRoutingPolicyResponse{
+					RoutingPolicy: loadbalancer.RoutingPolicy{Name: new(policyName)},
+					ETag:          &staleEtag,
+				}, nil,
+			).Once()
+			ociLoadBalancerClient.EXPECT().UpdateRoutingPolicy(
+				t.Context(),
+				mock.MatchedBy(func(req loadbalancer.UpdateRoutingPolicyRequest) bool {
+					return lo.FromPtr(req.IfMatch) == staleEtag
+				}),
+			).Return(
+				loadbalancer.UpdateRoutingPolicyResponse{},
+				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(http.StatusPreconditionFailed)),
+			).Once()
+
+			freshEtag := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), mock.Anything).Return(
+				loadbalancer.GetRoutingPolicyResponse{
+					RoutingPolicy: loadbalancer.RoutingPolicy{
+						Name:  new(policyName),
+						Rules: []loadbalancer.RoutingRule{concurrentRule},
+					},
+					ETag: &freshEtag,
+				}, nil,
+			).Once()
+			workRequestID := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().UpdateRoutingPolicy(
+				t.Context(),
+				mock.MatchedBy(func(req loadbalancer.UpdateRoutingPolicyRequest) bool {
+					return lo.FromPtr(req.IfMatch) == freshEtag &&
+						assert.ElementsMatch(t, []loadbalancer.RoutingRule{concurrentRule, newRule}, req.Rules)
+				}),
+			).Return(loadbalancer.UpdateRoutingPolicyResponse{
+				OpcWorkRequestId: &workRequestID,
+			}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil)
+
+			err := model.commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+				loadBalancerID: loadBalancerID,
+				listenerName:   listenerName,
+				policyRules:    []loadbalancer.RoutingRule{newRule},
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("fails when routing policy keeps being modified concurrently", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+
+			listenerName := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), mock.Anything).Return(
+				loadbalancer.GetRoutingPolicyResponse{
+					RoutingPolicy: loadbalancer.RoutingPolicy{Name: new(listenerPolicyName(listenerName))},
+					ETag:          new(fake.UUID().V4()),
+				}, nil,
+			).Times(maxRoutingPolicyUpdateAttempts)
+			ociLoadBalancerClient.EXPECT().UpdateRoutingPolicy(t.Context(), mock.Anything).Return(
+				loadbalancer.UpdateRoutingPolicyResponse{},
+				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(http.StatusPreconditionFailed)),
+			).Times(maxRoutingPolicyUpdateAttempts)
+
+			err := model.commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+				loadBalancerID: fake.UUID().V4(),
+				listenerName:   listenerName,
+				policyRules: []loadbalancer.RoutingRule{{
+					Name:      new(fake.UUID().V4()),
+					Condition: new(fake.Lorem().Sentence(10)),
+				}},
+			})
+			require.ErrorIs(t, err, errRoutingPolicyModified)
+		})
+
+		t.Run("fail when merged rules exceed the limit", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+
+			loadBalancerID := fake.UUID().V4()
+			listenerName := fake.UUID().V4()
+			policyName := listenerPolicyName(listenerName)
+
+			existingRules := make([]loadbalancer.RoutingRule, maxRoutingPolicyRules)
+			for i := range existingRules {
+				existingRules[i] = loadbalancer.RoutingRule{
+					Name:      new(fmt.Sprintf("routes-%04d", i)),
+					Condition: new(fake.Lorem().Sentence(10)),
+				}
+			}
+
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), loadbalancer.GetRoutingPolicyRequest{
+				RoutingPolicyName: new(policyName),
+				LoadBalancerId:    &loadBalancerID,
+			}).Return(loadbalancer.GetRoutingPolicyResponse{
+				RoutingPolicy: loadbalancer.RoutingPolicy{
+					Name:                     new(policyName),
+					Rules:                    existingRules,
+					ConditionLanguageVersion: loadbalancer.RoutingPolicyConditionLanguageVersionV1,
+				},
+			}, nil).Once()
+
+			err := model.commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+				loadBalancerID: loadBalancerID,
+				listenerName:   listenerName,
+				policyRules: []loadbalancer.RoutingRule{
+					{
+						Name:      new("new-routes-0000"),
+						Condition: new(fake.Lorem().Sentence(10)),
+					},
+				},
+			})
+
+			var capacityErr routingPolicyCapacityError
+			require.ErrorAs(t, err, &capacityErr)
+			assert.Contains(t, capacityErr.Error(), listenerName)
+		})
+
+		t.Run("pack route rules when merged rules exceed the limit", func(t *testing.T) {
 			fake := faker.New()
 			deps := makeMockDeps(t)
 			model := newOciLoadBalancerModel(deps)
@@ -2829,103 +4688,100 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			listenerName := fake.UUID().V4()
 			policyName := listenerPolicyName(listenerName)
 
-			existingRulePrefixes := []string{
-				"routes-1",
-				"routes-2",
-				"routes-3",
-				"routes-4",
-			}
-
-			existingRules := lo.Map(existingRulePrefixes, func(prefix string, i int) loadbalancer.RoutingRule {
-				return loadbalancer.RoutingRule{
-					Name:      new(fmt.Sprintf("%s%04d", prefix, i)),
-					Condition: new(fake.Lorem().Sentence(10)),
+			existingRules := make([]loadbalancer.RoutingRule, maxRoutingPolicyRules-1)
+			for i := range existingRules {
+				existingRules[i] = loadbalancer.RoutingRule{
+					Name:      new(fmt.Sprintf("routes-%04d", i)),
+					Condition: new(fmt.Sprintf("http.request.url.path sw '/routes-%04d'", i)),
 				}
-			})
-
-			existingRules = append(existingRules, loadbalancer.RoutingRule{
-				Name:      new(string(defaultCatchAllRuleName)),
-				Condition: new(fake.Lorem().Sentence(10)),
-			})
-
-			newRulesPrefixes := []string{
-				"new-routes-1",
-				"new-routes-2",
-				"new-routes-3",
 			}
-			newRules := lo.Map(newRulesPrefixes, func(prefix string, i int) loadbalancer.RoutingRule {
+			backendSetName := fake.Internet().Slug()
+			routeRules := lo.Map([]string{"/aa", "/bb"}, func(path string, i int) loadbalancer.RoutingRule {
 				return loadbalancer.RoutingRule{
-					Name:      new(fmt.Sprintf("%s%04d", prefix, i)),
-					Condition: new(fake.Lorem().Sentence(10)),
+					Name:      new(fmt.Sprintf("new-routes-%04d", i)),
+					Condition: new(fmt.Sprintf("any(http.request.url.path sw '%s')", path)),
+					Actions: []loadbalancer.Action{
+						loadbalancer.ForwardToBackendSet{BackendSetName: new(backendSetName)},
+					},
 				}
 			})
 
-			replacedRuleIndex := rand.IntN(len(existingRulePrefixes))
-			replacedRule := loadbalancer.RoutingRule{
-				Name:      new(fmt.Sprintf("%s%04d", existingRulePrefixes[replacedRuleIndex], replacedRuleIndex)),
-				Condition: new(fake.Lorem().Sentence(10)),
-			}
-
-			rulesToCommit := make([]loadbalancer.RoutingRule, 0, len(existingRules)+len(newRules))
-			rulesToCommit = append(rulesToCommit, existingRules...)
-			rulesToCommit[replacedRuleIndex] = replacedRule
-			rulesToCommit = append(rulesToCommit, newRules...)
-
-			wantMergedRules := make([]loadbalancer.RoutingRule, 0, len(rulesToCommit))
-			wantMergedRules = append(wantMergedRules, rulesToCommit...)
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), mock.Anything).
+				Return(loadbalancer.GetRoutingPolicyResponse{
+					RoutingPolicy: loadbalancer.RoutingPolicy{
+						Name:                     new(policyName),
+						Rules:                    existingRules,
+						ConditionLanguageVersion: loadbalancer.RoutingPolicyConditionLanguageVersionV1,
+					},
+				}, nil).Once()
 
-			// Sort the expected rules
-			sort.Slice(wantMergedRules, func(i, j int) bool {
-				ruleI := lo.FromPtr(wantMergedRules[i].Name)
-				ruleJ := lo.FromPtr(wantMergedRules[j].Name)
-				if ruleI == defaultCatchAllRuleName {
-					return false
-				}
-				if ruleJ == defaultCatchAllRuleName {
+			workRequestID := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().UpdateRoutingPolicy(t.Context(), mock.MatchedBy(
+				func(req loadbalancer.UpdateRoutingPolicyRequest) bool {
+					rules := req.UpdateRoutingPolicyDetails.Rules
+					assert.Len(t, rules, maxRoutingPolicyRules)
+					packedRule, found := lo.Find(rules, func(rule loadbalancer.RoutingRule) bool {
+						return lo.FromPtr(rule.Name) == "new-routes-0000"
+					})
+					assert.True(t, found)
+					assert.Equal(t,
+						"any(http.request.url.path sw '/aa', http.request.url.path sw '/bb')",
+						lo.FromPtr(packedRule.Condition),
+					)
+					assert.Equal(t, routeRules[0].Actions, packedRule.Actions)
 					return true
-				}
-				return ruleI < ruleJ
-			})
+				},
+			)).Return(loadbalancer.UpdateRoutingPolicyResponse{
+				OpcWorkRequestId: &workRequestID,
+			}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
 
-			params := commitRoutingPolicyParams{
+			err := model.commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
 				loadBalancerID: loadBalancerID,
 				listenerName:   listenerName,
-				policyRules:    rulesToCommit,
-			}
-
-			existingPolicy := loadbalancer.RoutingPolicy{
-				Name:                     new(policyName),
-				Rules:                    existingRules,
-				ConditionLanguageVersion: loadbalancer.RoutingPolicyConditionLanguageVersionV1,
-			}
+				policyRules:    routeRules,
+			})
+			require.NoError(t, err)
+		})
 
-			// Expect to get the current routing policy
-			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), loadbalancer.GetRoutingPolicyRequest{
-				RoutingPolicyName: new(policyName),
-				LoadBalancerId:    &loadBalancerID,
-			}).Return(loadbalancer.GetRoutingPolicyResponse{
-				RoutingPolicy: existingPolicy,
-			}, nil)
+		t.Run("report remaining rules capacity", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			metrics, err := newRoutingPolicyMetrics()
+			require.NoError(t, err)
+			deps.Metrics = metrics
+			model := newOciLoadBalancerModel(deps)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
 
-			// Expect to update the policy with merged rules
-			workRequestID := fake.UUID().V4()
-			ociLoadBalancerClient.EXPECT().UpdateRoutingPolicy(t.Context(), loadbalancer.UpdateRoutingPolicyRequest{
-				LoadBalancerId:    &loadBalancerID,
-				RoutingPolicyName: new(policyName),
-				UpdateRoutingPolicyDetails: loadbalancer.UpdateRoutingPolicyDetails{
-					ConditionLanguageVersion: loadbalancer.UpdateRoutingPolicyDetailsConditionLanguageVersionEnum(
-						existingPolicy.ConditionLanguageVersion,
-					),
-					Rules: wantMergedRules,
-				},
-			}).Return(loadbalancer.UpdateRoutingPolicyResponse{
-				OpcWorkRequestId: &workRequestID,
-			}, nil)
+			loadBalancerID := fake.UUID().V4()
+			listenerName := fake.UUID().V4()
+			policyName := listenerPolicyName(listenerName)
+			existingRule := loadbalancer.RoutingRule{
+				Name:      new(string(defaultCatchAllRuleName)),
+				Condition: new(fake.Lorem().Sentence(10)),
+			}
 
-			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil)
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), mock.Anything).
+				Return(loadbalancer.GetRoutingPolicyResponse{
+					RoutingPolicy: loadbalancer.RoutingPolicy{
+						Name:                     new(policyName),
+						Rules:                    []loadbalancer.RoutingRule{existingRule},
+						ConditionLanguageVersion: loadbalancer.RoutingPolicyConditionLanguageVersionV1,
+					},
+				}, nil).Once()
 
-			err := model.commitRoutingPolicy(t.Context(), params)
+			err = model.commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+				loadBalancerID: loadBalancerID,
+				listenerName:   listenerName,
+				policyRules:    []loadbalancer.RoutingRule{existingRule},
+			})
 			require.NoError(t, err)
+
+			assert.InDelta(t,
+				float64(maxRoutingPolicyRules-1),
+				testutil.ToFloat64(metrics.remainingRules.WithLabelValues(loadBalancerID, listenerName)),
+				0,
+			)
 		})
 
 		t.Run("delete previously programmed rules that are not in the new policy", func(t *testing.T) {
@@ -3108,6 +4964,131 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			require.NoError(t, err)
 		})
 
+		t.Run("orders rules by route match precedence", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+
+			loadBalancerID := fake.UUID().V4()
+			listenerName := fake.UUID().V4()
+			policyName := listenerPolicyName(listenerName)
+			rootPrefixRule := loadbalancer.RoutingRule{
+				Name:      new("a-root"),
+				Condition: new("http.request.url.path sw '/'"),
+			}
+			apiPrefixRule := loadbalancer.RoutingRule{
+				Name:      new("b-api"),
+				Condition: new("http.request.url.path sw '/api/v1'"),
+			}
+			apiHeaderRule := loadbalancer.RoutingRule{
+				Name: new("c-api-header"),
+				Condition: new("all(http.request.url.path sw '/api/v1', " +
+					"http.request.headers[(i 'x-version')] eq (i 'v2'))"),
+			}
+			exactRule := loadbalancer.RoutingRule{
+				Name:      new("d-exact"),
+				Condition: new("http.request.url.path eq '/api/v1/users'"),
+			}
+			defaultRule := defaultCatchAllRoutingRule("default-backend")
+
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), loadbalancer.GetRoutingPolicyRequest{
+				RoutingPolicyName: new(policyName),
+				LoadBalancerId:    &loadBalancerID,
+			}).Return(loadbalancer.GetRoutingPolicyResponse{
+				RoutingPolicy: loadbalancer.RoutingPolicy{
+					Name:                     new(policyName),
+					Rules:                    []loadbalancer.RoutingRule{rootPrefixRule, defaultRule},
+					ConditionLanguageVersion: loadbalancer.RoutingPolicyConditionLanguageVersionV1,
+				},
+			}, nil)
+
+			workRequestID := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().UpdateRoutingPolicy(t.Context(), mock.MatchedBy(
+				func(req loadbalancer.UpdateRoutingPolicyRequest) bool {
+					assert.Equal(
+						t,
+						[]loadbalancer.RoutingRule{exactRule, apiHeaderRule, apiPrefixRule, rootPrefixRule, defaultRule},
+						req.UpdateRoutingPolicyDetails.Rules,
+					)
+					return true
+				},
+			)).Return(loadbalancer.UpdateRoutingPolicyResponse{
+				OpcWorkRequestId: &workRequestID,
+			}, nil)
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil)
+
+			err := model.commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+				loadBalancerID: loadBalancerID,
+				listenerName:   listenerName,
+				policyRules:    []loadbalancer.RoutingRule{apiPrefixRule, exactRule, apiHeaderRule},
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("orders rules by explicit priority before route match precedence", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+
+			loadBalancerID := fake.UUID().V4()
+			listenerName := fake.UUID().V4()
+			policyName := listenerPolicyName(listenerName)
+			catchAllRouteRule := loadbalancer.RoutingRule{
+				Name:      new(ociPrioritizedListenerPolicyRuleName(-10, 0, "ns", "catch-all")),
+				Condition: new("http.request.url.path eq '/api/v1/users'"),
+			}
+			rootPrefixRule := loadbalancer.RoutingRule{
+				Name:      new(ociListenerPolicyRuleNameFromParts(0, "ns", "root")),
+				Condition: new("http.request.url.path sw '/'"),
+			}
+			exactRule := loadbalancer.RoutingRule{
+				Name:      new(ociListenerPolicyRuleNameFromParts(0, "ns", "exact")),
+				Condition: new("http.request.url.path eq '/api/v1/users'"),
+			}
+			priorityRule := loadbalancer.RoutingRule{
+				Name:      new(ociPrioritizedListenerPolicyRuleName(5, 0, "ns", "priority")),
+				Condition: new("http.request.url.path sw '/'"),
+			}
+			defaultRule := defaultCatchAllRoutingRule("default-backend")
+
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), loadbalancer.GetRoutingPolicyRequest{
+				RoutingPolicyName: new(policyName),
+				LoadBalancerId:    &loadBalancerID,
+			}).Return(loadbalancer.GetRoutingPolicyResponse{
+				RoutingPolicy: loadbalancer.RoutingPolicy{
+					Name:                     new(policyName),
+					Rules:                    []loadbalancer.RoutingRule{rootPrefixRule, exactRule, defaultRule},
+					ConditionLanguageVersion: loadbalancer.RoutingPolicyConditionLanguageVersionV1,
+				},
+			}, nil)
+
+			workRequestID := fake.UUID().V4()
+			ociLoadBalancerClient.EXPECT().UpdateRoutingPolicy(t.Context(), mock.MatchedBy(
+				func(req loadbalancer.UpdateRoutingPolicyRequest) bool {
+					assert.Equal(
+						t,
+						[]loadbalancer.RoutingRule{priorityRule, exactRule, rootPrefixRule, catchAllRouteRule, defaultRule},
+						req.UpdateRoutingPolicyDetails.Rules,
+					)
+					return true
+				},
+			)).Return(loadbalancer.UpdateRoutingPolicyResponse{
+				OpcWorkRequestId: &workRequestID,
+			}, nil)
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil)
+
+			err := model.commitRoutingPolicy(t.Context(), commitRoutingPolicyParams{
+				loadBalancerID: loadBalancerID,
+				listenerName:   listenerName,
+				policyRules:    []loadbalancer.RoutingRule{catchAllRouteRule, priorityRule},
+			})
+			require.NoError(t, err)
+		})
+
 		t.Run("serializes concurrent commits for the same routing policy", func(t *testing.T) {
 			fake := faker.New()
 			deps := makeMockDeps(t)
@@ -3310,29 +5291,72 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			model := newOciLoadBalancerModel(deps)
 			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
 
-			loadBalancerID := fake.UUID().V4()
-			listenerName := fake.UUID().V4()
-			policyName := listenerPolicyName(listenerName)
-
-			newRules := []loadbalancer.RoutingRule{
-				makeRandomOCIRoutingRule(),
-			}
-
+			loadBalancerID := fake.UUID().V4()
+			listenerName := fake.UUID().V4()
+			policyName := listenerPolicyName(listenerName)
+
+			newRules := []loadbalancer.RoutingRule{
+				makeRandomOCIRoutingRule(),
+			}
+
+			params := commitRoutingPolicyParams{
+				loadBalancerID: loadBalancerID,
+				listenerName:   listenerName,
+				policyRules:    newRules,
+			}
+
+			wantErr := errors.New(fake.Lorem().Sentence(10))
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), loadbalancer.GetRoutingPolicyRequest{
+				RoutingPolicyName: new(policyName),
+				LoadBalancerId:    &loadBalancerID,
+			}).Return(loadbalancer.GetRoutingPolicyResponse{}, wantErr)
+
+			err := model.commitRoutingPolicy(t.Context(), params)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, wantErr)
+		})
+
+		t.Run("skips removing previous rules of missing routing policy", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+
+			params := commitRoutingPolicyParams{
+				loadBalancerID:  fake.UUID().V4(),
+				listenerName:    fake.UUID().V4(),
+				policyRules:     []loadbalancer.RoutingRule{},
+				prevPolicyRules: []string{fake.Lorem().Word()},
+			}
+
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), mock.Anything).Return(
+				loadbalancer.GetRoutingPolicyResponse{},
+				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(http.StatusNotFound)),
+			).Once()
+
+			err := model.commitRoutingPolicy(t.Context(), params)
+			require.NoError(t, err)
+		})
+
+		t.Run("fail when routing policy is missing for new rules", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			model := newOciLoadBalancerModel(deps)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+
 			params := commitRoutingPolicyParams{
-				loadBalancerID: loadBalancerID,
-				listenerName:   listenerName,
-				policyRules:    newRules,
+				loadBalancerID: fake.UUID().V4(),
+				listenerName:   fake.UUID().V4(),
+				policyRules:    []loadbalancer.RoutingRule{makeRandomOCIRoutingRule()},
 			}
 
-			wantErr := errors.New(fake.Lorem().Sentence(10))
-			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), loadbalancer.GetRoutingPolicyRequest{
-				RoutingPolicyName: new(policyName),
-				LoadBalancerId:    &loadBalancerID,
-			}).Return(loadbalancer.GetRoutingPolicyResponse{}, wantErr)
+			ociLoadBalancerClient.EXPECT().GetRoutingPolicy(t.Context(), mock.Anything).Return(
+				loadbalancer.GetRoutingPolicyResponse{},
+				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(http.StatusNotFound)),
+			).Once()
 
 			err := model.commitRoutingPolicy(t.Context(), params)
-			require.Error(t, err)
-			assert.ErrorIs(t, err, wantErr)
+			require.ErrorContains(t, err, "failed to get routing policy")
 		})
 
 		t.Run("fail when update routing policy fails", func(t *testing.T) {
@@ -3470,7 +5494,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			loadBalancerID := fake.UUID().V4()
 			httpRoute := makeRandomHTTPRoute()
 			backendRef := makeRandomBackendRef()
-			backendSetName := ociBackendSetNameFromBackendRef(httpRoute, backendRef)
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
 			workRequestID := fake.UUID().V4()
 
 			params := deprovisionBackendSetParams{
@@ -3501,7 +5525,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			loadBalancerID := fake.UUID().V4()
 			httpRoute := makeRandomHTTPRoute()
 			backendRef := makeRandomBackendRef()
-			backendSetName := ociBackendSetNameFromBackendRef(httpRoute, backendRef)
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
 			wantErr := errors.New(fake.Lorem().Sentence(10))
 
 			params := deprovisionBackendSetParams{
@@ -3530,7 +5554,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			loadBalancerID := fake.UUID().V4()
 			httpRoute := makeRandomHTTPRoute()
 			backendRef := makeRandomBackendRef()
-			backendSetName := ociBackendSetNameFromBackendRef(httpRoute, backendRef)
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
 			workRequestID := fake.UUID().V4()
 			wantErr := errors.New(fake.Lorem().Sentence(10))
 
@@ -3563,7 +5587,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			loadBalancerID := fake.UUID().V4()
 			httpRoute := makeRandomHTTPRoute()
 			backendRef := makeRandomBackendRef()
-			backendSetName := ociBackendSetNameFromBackendRef(httpRoute, backendRef)
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
 
 			params := deprovisionBackendSetParams{
 				loadBalancerID: loadBalancerID,
@@ -3590,7 +5614,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			loadBalancerID := fake.UUID().V4()
 			httpRoute := makeRandomHTTPRoute()
 			backendRef := makeRandomBackendRef()
-			backendSetName := ociBackendSetNameFromBackendRef(httpRoute, backendRef)
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
 
 			params := deprovisionBackendSetParams{
 				loadBalancerID: loadBalancerID,
@@ -3618,7 +5642,7 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			loadBalancerID := fake.UUID().V4()
 			httpRoute := makeRandomHTTPRoute()
 			backendRef := makeRandomBackendRef()
-			backendSetName := ociBackendSetNameFromBackendRef(httpRoute, backendRef)
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
 
 			params := deprovisionBackendSetParams{
 				loadBalancerID: loadBalancerID,
@@ -3640,6 +5664,164 @@ func TestOciLoadBalancerModelImpl(t *testing.T) {
 			err := model.deprovisionBackendSet(t.Context(), params)
 			require.NoError(t, err)
 		})
+
+		t.Run("drains backends before deleting the backend set", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			deps.DrainTimeout = time.Minute
+			model := newOciLoadBalancerModel(deps)
+			model.drainPollInterval = time.Millisecond
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+
+			loadBalancerID := fake.UUID().V4()
+			httpRoute := makeRandomHTTPRoute()
+			backendRef := makeRandomBackendRef()
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
+			drainWorkRequestID := fake.UUID().V4()
+			deleteWorkRequestID := fake.UUID().V4()
+
+			existingBackendSet := makeRandomOCIBackendSet(
+				randomOCIBackendSetWithNameOpt(backendSetName),
+				randomOCIBackendSetWithBackendsOpt(makeFewRandomOCIBackends()),
+			)
+			wantBackends := lo.Map(existingBackendSet.Backends, ociBackendToDetails)
+			for i := range wantBackends {
+				wantBackends[i].Drain = new(true)
+			}
+
+			ociLoadBalancerClient.EXPECT().GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+				LoadBalancerId: &loadBalancerID,
+			}).Return(loadbalancer.GetLoadBalancerResponse{
+				LoadBalancer: makeRandomOCILoadBalancer(),
+			}, nil).Once()
+			ociLoadBalancerClient.EXPECT().GetBackendSet(t.Context(), loadbalancer.GetBackendSetRequest{
+				LoadBalancerId: &loadBalancerID,
+				BackendSetName: &backendSetName,
+			}).Return(loadbalancer.GetBackendSetResponse{BackendSet: existingBackendSet}, nil).Once()
+			ociLoadBalancerClient.EXPECT().UpdateBackendSet(t.Context(), loadbalancer.UpdateBackendSetRequest{
+				LoadBalancerId:          &loadBalancerID,
+				BackendSetName:          &backendSetName,
+				UpdateBackendSetDetails: makeUpdateOciBackendSetDetails(existingBackendSet, wantBackends),
+			}).Return(loadbalancer.UpdateBackendSetResponse{OpcWorkRequestId: &drainWorkRequestID}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), drainWorkRequestID).Return(nil).Once()
+			criticalBackendNames := lo.Map(existingBackendSet.Backends, func(backend loadbalancer.Backend, _ int) string {
+				return lo.FromPtr(backend.Name)
+			})
+			ociLoadBalancerClient.EXPECT().GetBackendSetHealth(t.Context(), loadbalancer.GetBackendSetHealthRequest{
+				LoadBalancerId: &loadBalancerID,
+				BackendSetName: &backendSetName,
+			}).Return(loadbalancer.GetBackendSetHealthResponse{
+				BackendSetHealth: loadbalancer.BackendSetHealth{
+					Status:                    loadbalancer.BackendSetHealthStatusCritical,
+					TotalBackendCount:         new(len(existingBackendSet.Backends)),
+					CriticalStateBackendNames: criticalBackendNames,
+				},
+			}, nil).Once()
+			ociLoadBalancerClient.EXPECT().DeleteBackendSet(t.Context(), loadbalancer.DeleteBackendSetRequest{
+				LoadBalancerId: &loadBalancerID,
+				BackendSetName: &backendSetName,
+			}).Return(loadbalancer.DeleteBackendSetResponse{OpcWorkRequestId: &deleteWorkRequestID}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), deleteWorkRequestID).Return(nil).Once()
+
+			err := model.deprovisionBackendSet(t.Context(), deprovisionBackendSetParams{
+				loadBalancerID: loadBalancerID,
+				routeNamespace: httpRoute.Namespace,
+				backendRef:     backendRef.BackendRef,
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("deletes drained backend set once drain timeout elapses", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			deps.DrainTimeout = 5 * time.Millisecond
+			model := newOciLoadBalancerModel(deps)
+			model.drainPollInterval = time.Millisecond
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+
+			loadBalancerID := fake.UUID().V4()
+			httpRoute := makeRandomHTTPRoute()
+			backendRef := makeRandomBackendRef()
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
+			deleteWorkRequestID := fake.UUID().V4()
+
+			backends := makeFewRandomOCIBackends()
+			for i := range backends {
+				backends[i].Drain = new(true)
+			}
+			existingBackendSet := makeRandomOCIBackendSet(
+				randomOCIBackendSetWithNameOpt(backendSetName),
+				randomOCIBackendSetWithBackendsOpt(backends),
+			)
+
+			ociLoadBalancerClient.EXPECT().GetLoadBalancer(t.Context(), mock.Anything).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: makeRandomOCILoadBalancer()}, nil).Once()
+			ociLoadBalancerClient.EXPECT().GetBackendSet(t.Context(), mock.Anything).
+				Return(loadbalancer.GetBackendSetResponse{BackendSet: existingBackendSet}, nil).Once()
+			ociLoadBalancerClient.EXPECT().GetBackendSetHealth(t.Context(), mock.Anything).
+				Return(loadbalancer.GetBackendSetHealthResponse{
+					BackendSetHealth: loadbalancer.BackendSetHealth{
+						Status:            loadbalancer.BackendSetHealthStatusOk,
+						TotalBackendCount: new(len(backends)),
+					},
+				}, nil).Maybe()
+			ociLoadBalancerClient.EXPECT().DeleteBackendSet(t.Context(), loadbalancer.DeleteBackendSetRequest{
+				LoadBalancerId: &loadBalancerID,
+				BackendSetName: &backendSetName,
+			}).Return(loadbalancer.DeleteBackendSetResponse{OpcWorkRequestId: &deleteWorkRequestID}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), deleteWorkRequestID).Return(nil).Once()
+
+			err := model.deprovisionBackendSet(t.Context(), deprovisionBackendSetParams{
+				loadBalancerID: loadBalancerID,
+				routeNamespace: httpRoute.Namespace,
+				backendRef:     backendRef.BackendRef,
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("skips drain when backend set is still referenced", func(t *testing.T) {
+			fake := faker.New()
+			deps := makeMockDeps(t)
+			deps.DrainTimeout = time.Minute
+			model := newOciLoadBalancerModel(deps)
+			ociLoadBalancerClient, _ := deps.OciClient.(*MockociLoadBalancerClient)
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+
+			loadBalancerID := fake.UUID().V4()
+			httpRoute := makeRandomHTTPRoute()
+			backendRef := makeRandomBackendRef()
+			backendSetName := ociBackendSetNameFromBackendRef(ociResourceNaming{}, httpRoute, backendRef)
+			deleteWorkRequestID := fake.UUID().V4()
+
+			lb := makeRandomOCILoadBalancer()
+			lb.RoutingPolicies = map[string]loadbalancer.RoutingPolicy{
+				"shared": {
+					Name: new("shared"),
+					Rules: []loadbalancer.RoutingRule{{
+						Name:    new("rule"),
+						Actions: []loadbalancer.Action{loadbalancer.ForwardToBackendSet{BackendSetName: &backendSetName}},
+					}},
+				},
+			}
+
+			ociLoadBalancerClient.EXPECT().GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+				LoadBalancerId: &loadBalancerID,
+			}).Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: lb}, nil).Once()
+			ociLoadBalancerClient.EXPECT().DeleteBackendSet(t.Context(), loadbalancer.DeleteBackendSetRequest{
+				LoadBalancerId: &loadBalancerID,
+				BackendSetName: &backendSetName,
+			}).Return(loadbalancer.DeleteBackendSetResponse{OpcWorkRequestId: &deleteWorkRequestID}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), deleteWorkRequestID).Return(nil).Once()
+
+			err := model.deprovisionBackendSet(t.Context(), deprovisionBackendSetParams{
+				loadBalancerID: loadBalancerID,
+				routeNamespace: httpRoute.Namespace,
+				backendRef:     backendRef.BackendRef,
+			})
+			require.NoError(t, err)
+		})
 	})
 
 	t.Run("removeUnusedCertificates", func(t *testing.T) {
@@ -3989,7 +6171,7 @@ func TestOciLoadBalancerModelOCICertificateIDs(t *testing.T) {
 				Listeners: []gatewayv1.Listener{secretListener, ociListener},
 			},
 		}
-		secret := makeRandomSecret()
+		secret := makeRandomSecret(randomSecretWithTLSDataOpt())
 		secret.Namespace = gateway.Namespace
 		secret.Name = "secret-cert"
 		certName := ociCertificateNameFromSecret(secret)
@@ -4436,10 +6618,408 @@ func TestOciLoadBalancerModelOCICertificateIDs(t *testing.T) {
 			},
 		}).Return(loadbalancer.UpdateListenerResponse{}, wantErr)
 
-		err := model.reconcileHTTPListener(t.Context(), params)
+		err := model.reconcileHTTPListener(t.Context(), params)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, wantErr)
+	})
+}
+
+func TestOciLoadBalancerModelImpl_listenerRuleSet(t *testing.T) {
+	makeModel := func(t *testing.T) (*ociLoadBalancerModelImpl, *MockociLoadBalancerClient, *MockworkRequestsWatcher) {
+		ociLoadBalancerClient := NewMockociLoadBalancerClient(t)
+		workRequestsWatcher := NewMockworkRequestsWatcher(t)
+		model := newOciLoadBalancerModel(ociLoadBalancerModelDeps{
+			RootLogger:          diag.RootTestLogger(),
+			OciClient:           ociLoadBalancerClient,
+			K8sClient:           NewMockk8sClient(t),
+			WorkRequestsWatcher: workRequestsWatcher,
+			RoutingRulesMapper:  NewMockociLoadBalancerRoutingRulesMapper(t),
+		})
+		return model, ociLoadBalancerClient, workRequestsWatcher
+	}
+	makeParams := func() reconcileListenerRuleSetParams {
+		fake := faker.New()
+		return reconcileListenerRuleSetParams{
+			loadBalancerID: fake.UUID().V4(),
+			knownRuleSets:  map[string]loadbalancer.RuleSet{},
+			ruleSetName:    "rs_" + fake.Lorem().Word(),
+			items: []loadbalancer.Rule{
+				loadbalancer.HttpHeaderRule{
+					HttpLargeHeaderSizeInKB:     new(16),
+					AreInvalidCharactersAllowed: new(false),
+				},
+			},
+		}
+	}
+
+	t.Run("reconcileListenerRuleSet", func(t *testing.T) {
+		t.Run("skips without rules", func(t *testing.T) {
+			model, _, _ := makeModel(t)
+			params := makeParams()
+			params.items = nil
+
+			require.NoError(t, model.reconcileListenerRuleSet(t.Context(), params))
+		})
+
+		t.Run("creates missing rule set", func(t *testing.T) {
+			model, ociClient, workRequestsWatcher := makeModel(t)
+			params := makeParams()
+			workRequestID := faker.New().UUID().V4()
+
+			ociClient.EXPECT().CreateRuleSet(t.Context(), loadbalancer.CreateRuleSetRequest{
+				LoadBalancerId: new(params.loadBalancerID),
+				CreateRuleSetDetails: loadbalancer.CreateRuleSetDetails{
+					Name:  new(params.ruleSetName),
+					Items: params.items,
+				},
+			}).Return(loadbalancer.CreateRuleSetResponse{OpcWorkRequestId: new(workRequestID)}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
+
+			require.NoError(t, model.reconcileListenerRuleSet(t.Context(), params))
+		})
+
+		t.Run("updates changed rule set", func(t *testing.T) {
+			model, ociClient, workRequestsWatcher := makeModel(t)
+			params := makeParams()
+			params.knownRuleSets[params.ruleSetName] = loadbalancer.RuleSet{
+				Name: new(params.ruleSetName),
+				Items: []loadbalancer.Rule{
+					loadbalancer.ControlAccessUsingHttpMethodsRule{AllowedMethods: []string{"GET"}},
+				},
+			}
+			workRequestID := faker.New().UUID().V4()
+
+			ociClient.EXPECT().UpdateRuleSet(t.Context(), loadbalancer.UpdateRuleSetRequest{
+				LoadBalancerId:       new(params.loadBalancerID),
+				RuleSetName:          new(params.ruleSetName),
+				UpdateRuleSetDetails: loadbalancer.UpdateRuleSetDetails{Items: params.items},
+			}).Return(loadbalancer.UpdateRuleSetResponse{OpcWorkRequestId: new(workRequestID)}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
+
+			require.NoError(t, model.reconcileListenerRuleSet(t.Context(), params))
+		})
+
+		t.Run("skips up to date rule set", func(t *testing.T) {
+			model, _, _ := makeModel(t)
+			params := makeParams()
+			params.knownRuleSets[params.ruleSetName] = loadbalancer.RuleSet{
+				Name:  new(params.ruleSetName),
+				Items: params.items,
+			}
+
+			require.NoError(t, model.reconcileListenerRuleSet(t.Context(), params))
+		})
+
+		t.Run("skips rule set with reordered items", func(t *testing.T) {
+			model, _, _ := makeModel(t)
+			params := makeParams()
+			params.items = append(params.items, loadbalancer.ControlAccessUsingHttpMethodsRule{
+				AllowedMethods: []string{"GET", "POST"},
+				StatusCode:     new(http.StatusMethodNotAllowed),
+			})
+			params.knownRuleSets[params.ruleSetName] = loadbalancer.RuleSet{
+				Name: new(params.ruleSetName),
+				Items: []loadbalancer.Rule{
+					loadbalancer.ControlAccessUsingHttpMethodsRule{
+						AllowedMethods: []string{"POST", "GET"},
+						StatusCode:     new(http.StatusMethodNotAllowed),
+					},
+					params.items[0],
+				},
+			}
+
+			require.NoError(t, model.reconcileListenerRuleSet(t.Context(), params))
+		})
+
+		t.Run("returns create errors", func(t *testing.T) {
+			model, ociClient, _ := makeModel(t)
+			params := makeParams()
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+
+			ociClient.EXPECT().CreateRuleSet(t.Context(), mock.Anything).
+				Return(loadbalancer.CreateRuleSetResponse{}, wantErr).Once()
+
+			require.ErrorIs(t, model.reconcileListenerRuleSet(t.Context(), params), wantErr)
+		})
+
+		t.Run("returns missing work request id errors", func(t *testing.T) {
+			model, ociClient, _ := makeModel(t)
+			params := makeParams()
+
+			ociClient.EXPECT().CreateRuleSet(t.Context(), mock.Anything).
+				Return(loadbalancer.CreateRuleSetResponse{}, nil).Once()
+
+			err := model.reconcileListenerRuleSet(t.Context(), params)
+			require.ErrorContains(t, err, "missing work request id")
+		})
+	})
+
+	t.Run("removeUnusedListenerRuleSet", func(t *testing.T) {
+		t.Run("keeps rule set with rules", func(t *testing.T) {
+			model, _, _ := makeModel(t)
+			params := makeParams()
+			params.knownRuleSets[params.ruleSetName] = loadbalancer.RuleSet{Name: new(params.ruleSetName)}
+
+			require.NoError(t, model.removeUnusedListenerRuleSet(t.Context(), params))
+		})
+
+		t.Run("skips missing rule set", func(t *testing.T) {
+			model, _, _ := makeModel(t)
+			params := makeParams()
+			params.items = nil
+
+			require.NoError(t, model.removeUnusedListenerRuleSet(t.Context(), params))
+		})
+
+		t.Run("deletes rule set without rules", func(t *testing.T) {
+			model, ociClient, workRequestsWatcher := makeModel(t)
+			params := makeParams()
+			params.items = nil
+			params.knownRuleSets[params.ruleSetName] = loadbalancer.RuleSet{Name: new(params.ruleSetName)}
+			workRequestID := faker.New().UUID().V4()
+
+			ociClient.EXPECT().DeleteRuleSet(t.Context(), loadbalancer.DeleteRuleSetRequest{
+				LoadBalancerId: new(params.loadBalancerID),
+				RuleSetName:    new(params.ruleSetName),
+			}).Return(loadbalancer.DeleteRuleSetResponse{OpcWorkRequestId: new(workRequestID)}, nil).Once()
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
+
+			require.NoError(t, model.removeUnusedListenerRuleSet(t.Context(), params))
+		})
+
+		t.Run("returns delete errors", func(t *testing.T) {
+			model, ociClient, _ := makeModel(t)
+			params := makeParams()
+			params.items = nil
+			params.knownRuleSets[params.ruleSetName] = loadbalancer.RuleSet{Name: new(params.ruleSetName)}
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+
+			ociClient.EXPECT().DeleteRuleSet(t.Context(), mock.Anything).
+				Return(loadbalancer.DeleteRuleSetResponse{}, wantErr).Once()
+
+			require.ErrorIs(t, model.removeUnusedListenerRuleSet(t.Context(), params), wantErr)
+		})
+	})
+}
+
+func TestOciLoadBalancerModelImpl_reconcileRouteRedirectRuleSet(t *testing.T) {
+	makeModel := func(t *testing.T) (*ociLoadBalancerModelImpl, *MockociLoadBalancerClient, *MockworkRequestsWatcher) {
+		ociLoadBalancerClient := NewMockociLoadBalancerClient(t)
+		workRequestsWatcher := NewMockworkRequestsWatcher(t)
+		model := newOciLoadBalancerModel(ociLoadBalancerModelDeps{
+			RootLogger:          diag.RootTestLogger(),
+			OciClient:           ociLoadBalancerClient,
+			K8sClient:           NewMockk8sClient(t),
+			WorkRequestsWatcher: workRequestsWatcher,
+			RoutingRulesMapper:  NewMockociLoadBalancerRoutingRulesMapper(t),
+		})
+		return model, ociLoadBalancerClient, workRequestsWatcher
+	}
+	makeParams := func() reconcileRouteRedirectRuleSetParams {
+		fake := faker.New()
+		return reconcileRouteRedirectRuleSetParams{
+			loadBalancerID: fake.UUID().V4(),
+			ruleSetName:    "rd_" + fake.Lorem().Word(),
+			items: []loadbalancer.Rule{
+				loadbalancer.RedirectRule{
+					Conditions: []loadbalancer.RuleCondition{
+						loadbalancer.PathMatchCondition{
+							AttributeValue: new("/"),
+							Operator:       loadbalancer.PathMatchConditionOperatorPrefixMatch,
+						},
+					},
+					ResponseCode: new(http.StatusMovedPermanently),
+					RedirectUri:  &loadbalancer.RedirectUri{Protocol: new("HTTPS"), Port: new(443)},
+				},
+			},
+			listenerNames: []string{"http-" + fake.Lorem().Word()},
+		}
+	}
+	makeListener := func(name string, ruleSetNames ...string) loadbalancer.Listener {
+		return loadbalancer.Listener{
+			Name:                  new(name),
+			DefaultBackendSetName: new("default-" + faker.New().Lorem().Word()),
+			Port:                  new(80),
+			Protocol:              new(ociListenerProtocolHTTP),
+			RoutingPolicyName:     new(listenerPolicyName(name)),
+			RuleSetNames:          ruleSetNames,
+		}
+	}
+	expectLoadBalancer := func(
+		t *testing.T,
+		ociClient *MockociLoadBalancerClient,
+		params reconcileRouteRedirectRuleSetParams,
+		loadBalancer loadbalancer.LoadBalancer,
+	) {
+		ociClient.EXPECT().GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+			LoadBalancerId: new(params.loadBalancerID),
+		}).Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadBalancer}, nil).Once()
+	}
+
+	t.Run("creates rule set and attaches it to given listeners", func(t *testing.T) {
+		model, ociClient, workRequestsWatcher := makeModel(t)
+		params := makeParams()
+		listener := makeListener(params.listenerNames[0], "rs_gateway")
+		otherListener := makeListener("other-" + faker.New().Lorem().Word())
+		expectLoadBalancer(t, ociClient, params, loadbalancer.LoadBalancer{
+			Listeners: map[string]loadbalancer.Listener{
+				*listener.Name:      listener,
+				*otherListener.Name: otherListener,
+			},
+		})
+		createWorkRequestID := faker.New().UUID().V4()
+		updateWorkRequestID := faker.New().UUID().V4()
+
+		createCall := ociClient.EXPECT().CreateRuleSet(t.Context(), loadbalancer.CreateRuleSetRequest{
+			LoadBalancerId: new(params.loadBalancerID),
+			CreateRuleSetDetails: loadbalancer.CreateRuleSetDetails{
+				Name:  new(params.ruleSetName),
+				Items: params.items,
+			},
+		}).Return(loadbalancer.CreateRuleSetResponse{OpcWorkRequestId: new(createWorkRequestID)}, nil).Once()
+		workRequestsWatcher.EXPECT().WaitFor(t.Context(), createWorkRequestID).Return(nil).Once()
+		ociClient.EXPECT().UpdateListener(t.Context(), loadbalancer.UpdateListenerRequest{
+			LoadBalancerId: new(params.loadBalancerID),
+			ListenerName:   listener.Name,
+			UpdateListenerDetails: loadbalancer.UpdateListenerDetails{
+				DefaultBackendSetName: listener.DefaultBackendSetName,
+				Port:                  listener.Port,
+				Protocol:              listener.Protocol,
+				RoutingPolicyName:     listener.RoutingPolicyName,
+				RuleSetNames:          []string{params.ruleSetName, "rs_gateway"},
+			},
+		}).Return(loadbalancer.UpdateListenerResponse{OpcWorkRequestId: new(updateWorkRequestID)}, nil).
+			Once().
+			NotBefore(createCall)
+		workRequestsWatcher.EXPECT().WaitFor(t.Context(), updateWorkRequestID).Return(nil).Once()
+
+		require.NoError(t, model.reconcileRouteRedirectRuleSet(t.Context(), params))
+	})
+
+	t.Run("skips up to date rule set attached to given listeners", func(t *testing.T) {
+		model, ociClient, _ := makeModel(t)
+		params := makeParams()
+		listener := makeListener(params.listenerNames[0], params.ruleSetName)
+		expectLoadBalancer(t, ociClient, params, loadbalancer.LoadBalancer{
+			Listeners: map[string]loadbalancer.Listener{*listener.Name: listener},
+			RuleSets: map[string]loadbalancer.RuleSet{
+				params.ruleSetName: {Name: new(params.ruleSetName), Items: params.items},
+			},
+		})
+
+		require.NoError(t, model.reconcileRouteRedirectRuleSet(t.Context(), params))
+	})
+
+	t.Run("detaches rule set from listeners that are no longer given", func(t *testing.T) {
+		model, ociClient, workRequestsWatcher := makeModel(t)
+		params := makeParams()
+		staleListener := makeListener("stale-"+faker.New().Lorem().Word(), params.ruleSetName, "rs_gateway")
+		listener := makeListener(params.listenerNames[0], params.ruleSetName)
+		expectLoadBalancer(t, ociClient, params, loadbalancer.LoadBalancer{
+			Listeners: map[string]loadbalancer.Listener{
+				*listener.Name:      listener,
+				*staleListener.Name: staleListener,
+			},
+			RuleSets: map[string]loadbalancer.RuleSet{
+				params.ruleSetName: {Name: new(params.ruleSetName), Items: params.items},
+			},
+		})
+		workRequestID := faker.New().UUID().V4()
+
+		ociClient.EXPECT().UpdateListener(t.Context(), loadbalancer.UpdateListenerRequest{
+			LoadBalancerId: new(params.loadBalancerID),
+			ListenerName:   staleListener.Name,
+			UpdateListenerDetails: loadbalancer.UpdateListenerDetails{
+				DefaultBackendSetName: staleListener.DefaultBackendSetName,
+				Port:                  staleListener.Port,
+				Protocol:              staleListener.Protocol,
+				RoutingPolicyName:     staleListener.RoutingPolicyName,
+				RuleSetNames:          []string{"rs_gateway"},
+			},
+		}).Return(loadbalancer.UpdateListenerResponse{OpcWorkRequestId: new(workRequestID)}, nil).Once()
+		workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
+
+		require.NoError(t, model.reconcileRouteRedirectRuleSet(t.Context(), params))
+	})
+
+	t.Run("detaches and removes rule set without rules", func(t *testing.T) {
+		model, ociClient, workRequestsWatcher := makeModel(t)
+		params := makeParams()
+		params.items = nil
+		listener := makeListener(params.listenerNames[0], "rs_gateway", params.ruleSetName)
+		expectLoadBalancer(t, ociClient, params, loadbalancer.LoadBalancer{
+			Listeners: map[string]loadbalancer.Listener{*listener.Name: listener},
+			RuleSets: map[string]loadbalancer.RuleSet{
+				params.ruleSetName: {Name: new(params.ruleSetName)},
+			},
+		})
+		updateWorkRequestID := faker.New().UUID().V4()
+		deleteWorkRequestID := faker.New().UUID().V4()
+
+		updateCall := ociClient.EXPECT().UpdateListener(t.Context(), loadbalancer.UpdateListenerRequest{
+			LoadBalancerId: new(params.loadBalancerID),
+			ListenerName:   listener.Name,
+			UpdateListenerDetails: loadbalancer.UpdateListenerDetails{
+				DefaultBackendSetName: listener.DefaultBackendSetName,
+				Port:                  listener.Port,
+				Protocol:              listener.Protocol,
+				RoutingPolicyName:     listener.RoutingPolicyName,
+				RuleSetNames:          []string{"rs_gateway"},
+			},
+		}).Return(loadbalancer.UpdateListenerResponse{OpcWorkRequestId: new(updateWorkRequestID)}, nil).Once()
+		workRequestsWatcher.EXPECT().WaitFor(t.Context(), updateWorkRequestID).Return(nil).Once()
+		ociClient.EXPECT().DeleteRuleSet(t.Context(), loadbalancer.DeleteRuleSetRequest{
+			LoadBalancerId: new(params.loadBalancerID),
+			RuleSetName:    new(params.ruleSetName),
+		}).Return(loadbalancer.DeleteRuleSetResponse{OpcWorkRequestId: new(deleteWorkRequestID)}, nil).
+			Once().
+			NotBefore(updateCall)
+		workRequestsWatcher.EXPECT().WaitFor(t.Context(), deleteWorkRequestID).Return(nil).Once()
+
+		require.NoError(t, model.reconcileRouteRedirectRuleSet(t.Context(), params))
+	})
+
+	t.Run("skips missing rule set without rules", func(t *testing.T) {
+		model, ociClient, _ := makeModel(t)
+		params := makeParams()
+		params.items = nil
+		listener := makeListener(params.listenerNames[0], "rs_gateway")
+		expectLoadBalancer(t, ociClient, params, loadbalancer.LoadBalancer{
+			Listeners: map[string]loadbalancer.Listener{*listener.Name: listener},
+		})
+
+		require.NoError(t, model.reconcileRouteRedirectRuleSet(t.Context(), params))
+	})
+
+	t.Run("returns listener update errors", func(t *testing.T) {
+		model, ociClient, workRequestsWatcher := makeModel(t)
+		params := makeParams()
+		listener := makeListener(params.listenerNames[0])
+		expectLoadBalancer(t, ociClient, params, loadbalancer.LoadBalancer{
+			Listeners: map[string]loadbalancer.Listener{*listener.Name: listener},
+		})
+		workRequestID := faker.New().UUID().V4()
+		wantErr := errors.New(faker.New().Lorem().Sentence(10))
+
+		ociClient.EXPECT().CreateRuleSet(t.Context(), mock.Anything).
+			Return(loadbalancer.CreateRuleSetResponse{OpcWorkRequestId: new(workRequestID)}, nil).Once()
+		workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
+		ociClient.EXPECT().UpdateListener(t.Context(), mock.Anything).
+			Return(loadbalancer.UpdateListenerResponse{}, wantErr).Once()
 
-		require.Error(t, err)
-		assert.ErrorIs(t, err, wantErr)
+		require.ErrorIs(t, model.reconcileRouteRedirectRuleSet(t.Context(), params), wantErr)
+	})
+
+	t.Run("returns load balancer errors", func(t *testing.T) {
+		model, ociClient, _ := makeModel(t)
+		params := makeParams()
+		wantErr := errors.New(faker.New().Lorem().Sentence(10))
+
+		ociClient.EXPECT().GetLoadBalancer(t.Context(), mock.Anything).
+			Return(loadbalancer.GetLoadBalancerResponse{}, wantErr).Once()
+
+		require.ErrorIs(t, model.reconcileRouteRedirectRuleSet(t.Context(), params), wantErr)
 	})
 }
 
@@ -4838,6 +7418,32 @@ func Test_ociListerPolicyRuleName(t *testing.T) {
 				want:      makeExpectedName(index, unsanitizedNamespace, unsanitizedParentName, unsanitizedRuleName),
 			}
 		},
+		func() testCase {
+			priority := rand.IntN(maxRoutingRulePriority) + 1
+			route := makeRandomHTTPRoute(
+				randomHTTPRouteWithNamespaceOpt(fmt.Sprintf("ns_%d", rand.IntN(1000))),
+				randomHTTPRouteWithNameOpt(fmt.Sprintf("rt_%d", rand.IntN(1000))),
+				randomHTTPRouteWithRulesOpt(makeRandomHTTPRouteRule()),
+			)
+			route.Annotations = map[string]string{HTTPRouteRulePriorityAnnotation: "-" + strconv.Itoa(priority)}
+			unsanitizedInput := fmt.Sprintf(
+				"rn%03d_p%04d_%08x_%s_%s",
+				priority,
+				0,
+				crc32.ChecksumIEEE([]byte(ociListenerPolicyRuleIdentity(0, route.Namespace, route.Name))),
+				route.Namespace,
+				route.Name,
+			)
+			return testCase{
+				name:      "rule with explicit priority",
+				route:     route,
+				ruleIndex: 0,
+				want: ociapi.ConstructOCIResourceName(unsanitizedInput, ociapi.OCIResourceNameConfig{
+					MaxLength:           maxListenerPolicyNameLength,
+					InvalidCharsPattern: invalidCharsForPolicyNamePattern,
+				}),
+			}
+		},
 	}
 
 	for _, tc := range tests {
@@ -5086,70 +7692,80 @@ func Test_ociBackendSetNameFromBackendRef(t *testing.T) {
 	for _, tcFunc := range tests {
 		tc := tcFunc()
 		t.Run(tc.name, func(t *testing.T) {
-			got := ociBackendSetNameFromBackendRef(tc.httpRoute, tc.backendRef)
+			got := ociBackendSetNameFromBackendRef(ociResourceNaming{}, tc.httpRoute, tc.backendRef)
 			assert.Equal(t, tc.want, got)
 		})
 	}
 }
 
-func Test_ociBackendSetNameFromService(t *testing.T) {
-	type testCase struct {
-		name    string
-		service corev1.Service
-		want    string
-	}
+func Test_ociGatewayNamePrefix(t *testing.T) {
+	t.Run("empty when load balancer is not shared", func(t *testing.T) {
+		gateway := newRandomGateway()
+		config := makeRandomGatewayConfig()
+		config.Spec.SharedLoadBalancer = false
 
-	tests := []func() testCase{
-		func() testCase {
-			fake := faker.New()
-			svcNs := fake.Lorem().Word() + "-ns"
-			svcName := fake.Internet().Slug() + "-svc"
-			service := makeRandomService(
-				func(s *corev1.Service) {
-					s.Name = svcName
-					s.Namespace = svcNs
-				},
-			)
-			wantName := fmt.Sprintf("%s-%s", svcNs, svcName)
-			return testCase{
-				name:    "standard name",
-				service: service,
-				want: ociapi.ConstructOCIResourceName(wantName, ociapi.OCIResourceNameConfig{
-					MaxLength: maxBackendSetNameLength,
-				}),
-			}
-		},
-		func() testCase {
-			fake := faker.New()
-			longSvcNs := fake.Numerify("################################")
-			longSvcName := fake.Numerify("################################")
-			longSvcNs = longSvcNs[0:20]
-			longSvcName = longSvcName[0:20]
-			service := makeRandomService(
-				func(s *corev1.Service) {
-					s.Name = longSvcName
-					s.Namespace = longSvcNs
-				},
-			)
-			originalName := fmt.Sprintf("%s-%s", longSvcNs, longSvcName)
-			assert.Greater(t, len(originalName), maxBackendSetNameLength)
-			return testCase{
-				name:    "long name truncated",
-				service: service,
-				want: ociapi.ConstructOCIResourceName(originalName, ociapi.OCIResourceNameConfig{
-					MaxLength: maxBackendSetNameLength,
-				}),
-			}
-		},
-	}
+		assert.Empty(t, ociGatewayNamePrefix(gateway, config))
+	})
 
-	for _, tcFunc := range tests {
-		tc := tcFunc()
-		t.Run(tc.name, func(t *testing.T) {
-			got := ociBackendSetNameFromService(tc.service)
-			assert.Equal(t, tc.want, got)
+	t.Run("derived from gateway namespaced name when load balancer is shared", func(t *testing.T) {
+		gateway := newRandomGateway()
+		otherGateway := newRandomGateway(func(gw *gatewayv1.Gateway) {
+			gw.Namespace = gateway.Namespace
 		})
-	}
+		config := makeRandomGatewayConfig()
+		config.Spec.SharedLoadBalancer = true
+
+		got := ociGatewayNamePrefix(gateway, config)
+		assert.Regexp(t, `^gw_[0-9a-f]{8}_$`, got)
+		assert.Equal(t, got, ociGatewayNamePrefix(gateway.DeepCopy(), config))
+		assert.NotEqual(t, got, ociGatewayNamePrefix(otherGateway, config))
+		assert.True(t, isValidOCIRoutingPolicyName(listenerPolicyName(got+"http")))
+	})
+
+	t.Run("starts with configured name prefix", func(t *testing.T) {
+		gateway := newRandomGateway()
+		config := makeRandomGatewayConfig()
+		config.Spec.NamePrefix = "team1"
+
+		config.Spec.SharedLoadBalancer = false
+		assert.Equal(t, "team1_", ociGatewayNamePrefix(gateway, config))
+
+		config.Spec.SharedLoadBalancer = true
+		got := ociGatewayNamePrefix(gateway, config)
+		assert.Regexp(t, `^team1_gw_[0-9a-f]{8}_$`, got)
+		assert.True(t, isValidOCIRoutingPolicyName(listenerPolicyName(got+"http")))
+	})
+}
+
+func Test_ociDefaultBackendSetName(t *testing.T) {
+	t.Run("legacy name without prefix", func(t *testing.T) {
+		gateway := newRandomGateway()
+		assert.Equal(t, gateway.Name+"-default", ociDefaultBackendSetName(ociResourceNaming{}, gateway, ""))
+	})
+
+	t.Run("prefixed name limited to max backend set name length", func(t *testing.T) {
+		fake := faker.New()
+		gateway := newRandomGateway(func(gw *gatewayv1.Gateway) {
+			gw.Name = fake.Numerify("gateway-##########################")
+		})
+		namePrefix := "gw_" + fake.Numerify("########") + "_"
+
+		got := ociDefaultBackendSetName(ociResourceNaming{}, gateway, namePrefix)
+		assert.LessOrEqual(t, len(got), maxBackendSetNameLength)
+		assert.Equal(t, ociapi.ConstructOCIResourceName(
+			namePrefix+gateway.Name+"-default",
+			ociapi.OCIResourceNameConfig{MaxLength: maxBackendSetNameLength},
+		), got)
+	})
+
+	t.Run("follows configured naming scheme", func(t *testing.T) {
+		gateway := newRandomGateway(func(gw *gatewayv1.Gateway) {
+			gw.Name = "web"
+		})
+
+		got := ociDefaultBackendSetName(ociResourceNaming{separator: "_", hashSuffixLength: 6}, gateway, "edge_")
+		assert.Regexp(t, `^edge_web_default_[0-9a-f]{6}$`, got)
+	})
 }
 
 func Test_ociCertificateNameFromSecret(t *testing.T) {
@@ -5177,6 +7793,70 @@ func Test_makeOciListenerUpdateDetails(t *testing.T) {
 	}
 
 	tests := []func() testCase{
+		func() testCase {
+			fake := faker.New()
+			listenerName := fake.UUID().V4()
+			listenerSpec := makeRandomListener(
+				randomListenerWithHTTPProtocolOpt(),
+			)
+			defaultBackendSetName := fake.UUID().V4()
+			ruleSetName := "rs_" + fake.Lorem().Word()
+			staleRuleSetName := "ac_" + fake.UUID().V4()
+			externalRuleSetName := "external_" + fake.UUID().V4()
+
+			return testCase{
+				name: "rule set names changed",
+				params: makeOciListenerUpdateDetailsParams{
+					existingListenerData: loadbalancer.Listener{
+						Protocol:              new("HTTP"),
+						Port:                  new(int(listenerSpec.Port)),
+						DefaultBackendSetName: new(defaultBackendSetName),
+						RoutingPolicyName:     new(listenerPolicyName(listenerName)),
+						RuleSetNames:          []string{externalRuleSetName, staleRuleSetName},
+					},
+					listenerName:          listenerName,
+					listenerSpec:          &listenerSpec,
+					defaultBackendSetName: defaultBackendSetName,
+					ruleSetNames:          []string{ruleSetName},
+					managedRuleSetNames:   []string{ruleSetName, staleRuleSetName},
+				},
+				want: loadbalancer.UpdateListenerDetails{
+					Protocol:              new("HTTP"),
+					Port:                  new(int(listenerSpec.Port)),
+					DefaultBackendSetName: new(defaultBackendSetName),
+					RoutingPolicyName:     new(listenerPolicyName(listenerName)),
+					RuleSetNames:          []string{externalRuleSetName, ruleSetName},
+				},
+				wantOk: true,
+			}
+		},
+		func() testCase {
+			fake := faker.New()
+			listenerName := fake.UUID().V4()
+			listenerSpec := makeRandomListener(
+				randomListenerWithHTTPProtocolOpt(),
+			)
+			defaultBackendSetName := fake.UUID().V4()
+
+			return testCase{
+				name: "rule sets attached outside of the controller are kept",
+				params: makeOciListenerUpdateDetailsParams{
+					existingListenerData: loadbalancer.Listener{
+						Protocol:              new("HTTP"),
+						Port:                  new(int(listenerSpec.Port)),
+						DefaultBackendSetName: new(defaultBackendSetName),
+						RoutingPolicyName:     new(listenerPolicyName(listenerName)),
+						RuleSetNames:          []string{"external_" + fake.UUID().V4()},
+					},
+					listenerName:          listenerName,
+					listenerSpec:          &listenerSpec,
+					defaultBackendSetName: defaultBackendSetName,
+					managedRuleSetNames:   []string{"rs_" + fake.Lorem().Word()},
+				},
+				want:   loadbalancer.UpdateListenerDetails{},
+				wantOk: false,
+			}
+		},
 		func() testCase {
 			fake := faker.New()
 			listenerName := fake.UUID().V4()
@@ -5258,6 +7938,39 @@ func Test_makeOciListenerUpdateDetails(t *testing.T) {
 				wantOk: true,
 			}
 		},
+		func() testCase {
+			fake := faker.New()
+			listenerName := fake.UUID().V4()
+			listenerSpec := makeRandomListener(
+				randomListenerWithHTTPSParamsOpt(),
+			)
+			listenerSpec.TLS.Options = map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+				ListenerTLSOptionListenerProtocol: "http2",
+			}
+			defaultBackendSetName := fake.UUID().V4()
+
+			return testCase{
+				name: "switches listener protocol to HTTP2 set by listener option",
+				params: makeOciListenerUpdateDetailsParams{
+					existingListenerData: loadbalancer.Listener{
+						Protocol:              new(ociListenerProtocolHTTP),
+						Port:                  new(int(listenerSpec.Port)),
+						DefaultBackendSetName: new(defaultBackendSetName),
+						RoutingPolicyName:     new(listenerPolicyName(listenerName)),
+					},
+					listenerName:          listenerName,
+					listenerSpec:          &listenerSpec,
+					defaultBackendSetName: defaultBackendSetName,
+				},
+				want: loadbalancer.UpdateListenerDetails{
+					Protocol:              new(ociListenerProtocolHTTP2),
+					Port:                  new(int(listenerSpec.Port)),
+					DefaultBackendSetName: new(defaultBackendSetName),
+					RoutingPolicyName:     new(listenerPolicyName(listenerName)),
+				},
+				wantOk: true,
+			}
+		},
 		func() testCase {
 			fake := faker.New()
 			listenerName := fake.UUID().V4()
@@ -5689,6 +8402,92 @@ func Test_makeOciListenerUpdateDetails(t *testing.T) {
 				wantOk: false,
 			}
 		},
+		func() testCase {
+			fake := faker.New()
+			listenerName := fake.UUID().V4()
+			listenerSpec := makeRandomListener(
+				randomListenerWithHTTPSParamsOpt(),
+			)
+			defaultBackendSetName := fake.UUID().V4()
+			certName := fake.UUID().V4()
+			caBundleID := fake.UUID().V4()
+			verifyDepth := 1
+			verifyPeerCertificate := false
+			sslConfig := &loadbalancer.SslConfigurationDetails{
+				CertificateName:                &certName,
+				TrustedCertificateAuthorityIds: []string{caBundleID},
+				VerifyPeerCertificate:          new(true),
+				VerifyDepth:                    new(2),
+			}
+
+			return testCase{
+				name: "ssl config detects client verification changes",
+				params: makeOciListenerUpdateDetailsParams{
+					existingListenerData: loadbalancer.Listener{
+						Protocol:              new("HTTP"),
+						Port:                  new(int(listenerSpec.Port)),
+						DefaultBackendSetName: new(defaultBackendSetName),
+						RoutingPolicyName:     new(listenerPolicyName(listenerName)),
+						SslConfiguration: &loadbalancer.SslConfiguration{
+							CertificateName:       &certName,
+							VerifyDepth:           &verifyDepth,
+							VerifyPeerCertificate: &verifyPeerCertificate,
+						},
+					},
+					listenerName:          listenerName,
+					listenerSpec:          &listenerSpec,
+					defaultBackendSetName: defaultBackendSetName,
+					sslConfig:             sslConfig,
+				},
+				want: loadbalancer.UpdateListenerDetails{
+					Protocol:              new("HTTP"),
+					Port:                  new(int(listenerSpec.Port)),
+					DefaultBackendSetName: new(defaultBackendSetName),
+					RoutingPolicyName:     new(listenerPolicyName(listenerName)),
+					SslConfiguration:      sslConfig,
+				},
+				wantOk: true,
+			}
+		},
+		func() testCase {
+			fake := faker.New()
+			listenerName := fake.UUID().V4()
+			listenerSpec := makeRandomListener(
+				randomListenerWithHTTPSParamsOpt(),
+			)
+			defaultBackendSetName := fake.UUID().V4()
+			certName := fake.UUID().V4()
+			caBundleID := fake.UUID().V4()
+
+			return testCase{
+				name: "ssl config keeps matching client verification",
+				params: makeOciListenerUpdateDetailsParams{
+					existingListenerData: loadbalancer.Listener{
+						Protocol:              new("HTTP"),
+						Port:                  new(int(listenerSpec.Port)),
+						DefaultBackendSetName: new(defaultBackendSetName),
+						RoutingPolicyName:     new(listenerPolicyName(listenerName)),
+						SslConfiguration: &loadbalancer.SslConfiguration{
+							CertificateName:                &certName,
+							TrustedCertificateAuthorityIds: []string{caBundleID},
+							VerifyDepth:                    new(3),
+							VerifyPeerCertificate:          new(true),
+						},
+					},
+					listenerName:          listenerName,
+					listenerSpec:          &listenerSpec,
+					defaultBackendSetName: defaultBackendSetName,
+					sslConfig: &loadbalancer.SslConfigurationDetails{
+						CertificateName:                &certName,
+						TrustedCertificateAuthorityIds: []string{caBundleID},
+						VerifyPeerCertificate:          new(true),
+						VerifyDepth:                    new(3),
+					},
+				},
+				want:   loadbalancer.UpdateListenerDetails{},
+				wantOk: false,
+			}
+		},
 	}
 
 	for _, tc := range tests {
@@ -5740,3 +8539,98 @@ func Test_applyListenerTLSOptions(t *testing.T) {
 		assert.Equal(t, []string{"TLSv1.2", "TLSv1.3"}, sslConfig.Protocols)
 	})
 }
+
+func Test_listenerTLSProtocols(t *testing.T) {
+	makeTLSConfig := func(options map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue) *gatewayv1.ListenerTLSConfig {
+		return &gatewayv1.ListenerTLSConfig{Options: options}
+	}
+
+	t.Run("nil without options", func(t *testing.T) {
+		assert.Nil(t, listenerTLSProtocols(makeTLSConfig(nil)))
+	})
+
+	t.Run("derives protocols from minimum TLS version", func(t *testing.T) {
+		assert.Equal(t, []string{"TLSv1.2", "TLSv1.3"}, listenerTLSProtocols(makeTLSConfig(
+			map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{ListenerTLSOptionMinTLSVersion: " TLSv1.2 "},
+		)))
+		assert.Equal(t, []string{"TLSv1.3"}, listenerTLSProtocols(makeTLSConfig(
+			map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{ListenerTLSOptionMinTLSVersion: "TLSv1.3"},
+		)))
+	})
+
+	t.Run("prefers explicit protocols", func(t *testing.T) {
+		assert.Equal(t, []string{"TLSv1.3"}, listenerTLSProtocols(makeTLSConfig(
+			map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+				ListenerTLSOptionMinTLSVersion: "TLSv1.2",
+				ListenerTLSOptionProtocols:     "TLSv1.3",
+			},
+		)))
+	})
+
+	t.Run("nil for unsupported minimum TLS version", func(t *testing.T) {
+		assert.Nil(t, listenerTLSProtocols(makeTLSConfig(
+			map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{ListenerTLSOptionMinTLSVersion: "SSLv3"},
+		)))
+	})
+
+	t.Run("applied to listener SSL configuration", func(t *testing.T) {
+		sslConfig := &loadbalancer.SslConfigurationDetails{}
+		applyListenerTLSOptions(sslConfig, makeTLSConfig(map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+			ListenerTLSOptionMinTLSVersion:   "TLSv1.2",
+			ListenerTLSOptionCipherSuiteName: "oci-tls-12-13-ssl-cipher-suite-v3",
+		}))
+
+		assert.Equal(t, &loadbalancer.SslConfigurationDetails{
+			Protocols:       []string{"TLSv1.2", "TLSv1.3"},
+			CipherSuiteName: new("oci-tls-12-13-ssl-cipher-suite-v3"),
+		}, sslConfig)
+	})
+}
+
+func Test_packRoutingRules(t *testing.T) {
+	forwardRule := func(name, condition string, backendSetNames ...string) loadbalancer.RoutingRule {
+		return loadbalancer.RoutingRule{
+			Name:      new(name),
+			Condition: new(condition),
+			Actions: lo.Map(backendSetNames, func(backendSetName string, _ int) loadbalancer.Action {
+				return loadbalancer.ForwardToBackendSet{BackendSetName: new(backendSetName)}
+			}),
+		}
+	}
+
+	t.Run("combines rules forwarding to the same backend sets", func(t *testing.T) {
+		packed := packRoutingRules([]loadbalancer.RoutingRule{
+			forwardRule("p0001_route", "any(http.request.url.path sw '/bb', http.request.url.path sw '/cc')", "web"),
+			forwardRule("p0000_route", "http.request.url.path sw '/aa'", "web"),
+			forwardRule("p0002_route", "http.request.url.path sw '/dd'", "api"),
+		})
+
+		assert.Equal(t, []loadbalancer.RoutingRule{
+			forwardRule(
+				"p0000_route",
+				"any(http.request.url.path sw '/aa', http.request.url.path sw '/bb', http.request.url.path sw '/cc')",
+				"web",
+			),
+			forwardRule("p0002_route", "http.request.url.path sw '/dd'", "api"),
+		}, packed)
+	})
+
+	t.Run("keeps rules of different precedence", func(t *testing.T) {
+		rules := []loadbalancer.RoutingRule{
+			forwardRule("p0000_route", "http.request.url.path sw '/api/v1'", "web"),
+			forwardRule("p0001_route", "http.request.url.path sw '/'", "web"),
+		}
+
+		assert.Equal(t, rules, packRoutingRules(rules))
+	})
+
+	t.Run("keeps rules exceeding the condition limit", func(t *testing.T) {
+		longPath := strings.Repeat("a", maxRoutingRuleConditionLength/2)
+		rules := []loadbalancer.RoutingRule{
+			forwardRule("p0000_route", "http.request.url.path sw '/"+longPath+"'", "web"),
+			forwardRule("p0001_route", "http.request.url.path sw '/"+strings.ToUpper(longPath)+"'", "web"),
+		}
+
+		assert.Equal(t, rules, packRoutingRules(rules))
+	})
+}
@@ -1,9 +1,11 @@
 package app
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/samber/lo"
@@ -18,8 +20,29 @@ var startsWithExpression = regexp.MustCompile(`^\^([a-zA-Z0-9\-_\\\.]+?)(?:\.\*)
 // Allow alphanumeric characters, hyphens, underscores, and escaped dots.
 var endsWithExpression = regexp.MustCompile(`^([a-zA-Z0-9\-_\\\.]+?)\$$`)
 
+// Path regex literals allow alphanumeric characters, hyphens, underscores,
+// tildes, slashes and escaped dots.
+const pathRegexLiteral = `((?:[a-zA-Z0-9\-_~/]|\\\.)+?)`
+
+var exactPathExpression = regexp.MustCompile(`^\^` + pathRegexLiteral + `\$$`)
+var prefixPathExpression = regexp.MustCompile(`^\^` + pathRegexLiteral + `(?:\.\*)?$`)
+var suffixPathExpression = regexp.MustCompile(`^(?:\.\*)?` + pathRegexLiteral + `\$$`)
+
+var exactPathConditionPattern = regexp.MustCompile(`http\.request\.url\.path eq '([^']*)'`)
+var prefixPathConditionPattern = regexp.MustCompile(`http\.request\.url\.path sw '([^']*)'`)
+var segmentPrefixConditionPattern = regexp.MustCompile(
+	`any\(http\.request\.url\.path eq '([^']*)', http\.request\.url\.path sw '([^']*)'\)`,
+)
+var headerConditionPattern = regexp.MustCompile(`http\.request\.headers\[\(i '([^']*)'\)\]`)
+var exactHostnameConditionPattern = regexp.MustCompile(`http\.request\.headers\[\(i 'host'\)\] eq \(i '([^']*)'\)`)
+var wildcardHostnameConditionPattern = regexp.MustCompile(
+	`http\.request\.headers\[\(i 'host'\)\]\[0\] ew \(i '([^']*)'\)`,
+)
+
 const expectedMatchesLength = 2
 
+const catchAllPathCondition = `http.request.url.path sw '/'`
+
 // Returns the prefix and true if it matches, empty string and false otherwise.
 func parseRegexForStartsWith(pattern string) (string, bool) {
 	matches := startsWithExpression.FindStringSubmatch(pattern)
@@ -135,14 +158,48 @@ func mapPathMatchToCondition(pathMatch gatewayv1.HTTPPathMatch) (string, error)
 		return fmt.Sprintf(`http.request.url.path eq '%s'`, pathValue), nil
 	case gatewayv1.PathMatchPathPrefix:
 		// TODO: Handle escaping single quotes in pathValue if necessary
-		return fmt.Sprintf(`http.request.url.path sw '%s'`, pathValue), nil
+		return mapPathPrefixToCondition(pathValue), nil
 	case gatewayv1.PathMatchRegularExpression:
-		return "", fmt.Errorf("%w: regex path matching", errUnsupportedMatch)
+		return mapRegexPathToCondition(pathValue)
 	default:
 		return "", fmt.Errorf("%w: unknown path match type '%s'", errUnsupportedMatch, pathType)
 	}
 }
 
+// mapRegexPathToCondition maps the limited set of path regex patterns that can be
+// expressed with OCI conditions. OCI has no regex support, so anchored literals only
+// are accepted and everything else is rejected.
+func mapRegexPathToCondition(pattern string) (string, error) {
+	unescape := func(literal string) string {
+		return strings.ReplaceAll(literal, "\\.", ".")
+	}
+	if matches := exactPathExpression.FindStringSubmatch(pattern); len(matches) == expectedMatchesLength {
+		return fmt.Sprintf(`http.request.url.path eq '%s'`, unescape(matches[1])), nil
+	}
+	if matches := prefixPathExpression.FindStringSubmatch(pattern); len(matches) == expectedMatchesLength {
+		return fmt.Sprintf(`http.request.url.path sw '%s'`, unescape(matches[1])), nil
+	}
+	if matches := suffixPathExpression.FindStringSubmatch(pattern); len(matches) == expectedMatchesLength {
+		return fmt.Sprintf(`http.request.url.path ew '%s'`, unescape(matches[1])), nil
+	}
+	return "", fmt.Errorf("%w: regex path matching '%s'", errUnsupportedMatch, pattern)
+}
+
+// mapPathPrefixToCondition produces a condition that matches the prefix
+// on path segment boundaries only, so /foo matches /foo and /foo/bar but not /foobar.
+// Trailing slash of the prefix is ignored as required by the Gateway API.
+func mapPathPrefixToCondition(pathPrefix string) string {
+	segmentsPrefix := strings.TrimRight(pathPrefix, "/")
+	if segmentsPrefix == "" {
+		return catchAllPathCondition
+	}
+	return fmt.Sprintf(
+		`any(http.request.url.path eq '%s', http.request.url.path sw '%s/')`,
+		segmentsPrefix,
+		segmentsPrefix,
+	)
+}
+
 func mapHeaderMatchToCondition(headerMatch gatewayv1.HTTPHeaderMatch) (string, error) {
 	headerType := gatewayv1.HeaderMatchExact // Default type
 	if headerMatch.Type != nil {
@@ -227,10 +284,14 @@ func (r *ociLoadBalancerRoutingRulesMapperImpl) mapHTTPRouteHostnamesAndMatchesT
 	if err != nil {
 		return "", err
 	}
+	// Catch-all match makes other matches redundant, only hostnames are checked then
+	if slices.Contains(matchConditions, catchAllPathCondition) {
+		matchConditions = nil
+	}
 
 	conditions := make([]string, 0, len(hostnames)*max(1, len(matchConditions)))
 	for _, hostname := range hostnames {
-		hostCondition := fmt.Sprintf(`http.request.headers[(i 'host')] eq (i '%s')`, hostname)
+		hostCondition := hostnameCondition(hostname)
 		if len(matchConditions) == 0 {
 			conditions = append(conditions, hostCondition)
 			continue
@@ -258,7 +319,7 @@ func (r *ociLoadBalancerRoutingRulesMapperImpl) mapGRPCRouteHostnamesAndMatchesT
 
 	conditions := make([]string, 0, len(hostnames)*max(1, len(matchConditions))*len(grpcContentTypeConditions()))
 	for _, hostname := range hostnames {
-		hostCondition := fmt.Sprintf(`http.request.headers[(i 'host')] eq (i '%s')`, hostname)
+		hostCondition := hostnameCondition(hostname)
 		if len(matchConditions) == 0 {
 			for _, contentTypeCondition := range grpcContentTypeConditions() {
 				conditions = append(conditions, allRoutingConditions(hostCondition, contentTypeCondition))
@@ -278,6 +339,15 @@ func (r *ociLoadBalancerRoutingRulesMapperImpl) mapGRPCRouteHostnamesAndMatchesT
 	return fmt.Sprintf("any(%s)", strings.Join(conditions, ", ")), nil
 }
 
+// hostnameCondition matches the Host header against the route hostname. Wildcard hostnames
+// match subdomains only, *.example.com matches foo.example.com but not example.com.
+func hostnameCondition(hostname gatewayv1.Hostname) string {
+	if suffix, ok := strings.CutPrefix(string(hostname), "*"); ok {
+		return fmt.Sprintf(`http.request.headers[(i 'host')][0] ew (i '%s')`, suffix)
+	}
+	return fmt.Sprintf(`http.request.headers[(i 'host')] eq (i '%s')`, hostname)
+}
+
 func grpcContentTypeCondition() string {
 	return "any(" + strings.Join(grpcContentTypeConditions(), ", ") + ")"
 }
@@ -416,3 +486,128 @@ func mapGRPCHeaderMatchToCondition(headerMatch gatewayv1.GRPCHeaderMatch) (strin
 
 	return fmt.Sprintf(`http.request.headers[(i '%s')] eq (i '%s')`, headerMatch.Name, headerMatch.Value), nil
 }
+
+// routingConditionPrecedence describes how specific the routing condition is.
+// OCI evaluates routing policy rules in order and picks the first matching one,
+// so the rules must be ordered following the Gateway API precedence. Routes with
+// intersecting hostnames are ordered by the longest non-wildcard hostname and then
+// by the longest wildcard hostname, so foo.example.com goes before *.example.com
+// and both go before routes without hostnames. Matches are ordered by exact path,
+// longest path prefix and then the number of header matches.
+// Method and query param matches are not supported by OCI conditions.
+type routingConditionPrecedence struct {
+	exactHostnameLength    int
+	wildcardHostnameLength int
+	exactPath              bool
+	pathPrefixLength       int
+	headerMatches          int
+}
+
+// compare returns a negative number if p takes precedence over other,
+// a positive number if other takes precedence and zero otherwise.
+func (p routingConditionPrecedence) compare(other routingConditionPrecedence) int {
+	if result := cmp.Or(
+		cmp.Compare(other.exactHostnameLength, p.exactHostnameLength),
+		cmp.Compare(other.wildcardHostnameLength, p.wildcardHostnameLength),
+	); result != 0 {
+		return result
+	}
+	if p.exactPath != other.exactPath {
+		if p.exactPath {
+			return -1
+		}
+		return 1
+	}
+	return cmp.Or(
+		cmp.Compare(other.pathPrefixLength, p.pathPrefixLength),
+		cmp.Compare(other.headerMatches, p.headerMatches),
+	)
+}
+
+// routingConditionPrecedenceOf returns the precedence of the most specific
+// alternative of the condition.
+func routingConditionPrecedenceOf(condition string) routingConditionPrecedence {
+	// Segment aware prefix conditions contain the exact path alternative,
+	// so they are collapsed to the plain prefix before evaluating the precedence.
+	condition = segmentPrefixConditionPattern.ReplaceAllStringFunc(condition, func(prefixCondition string) string {
+		match := segmentPrefixConditionPattern.FindStringSubmatch(prefixCondition)
+		if match[1]+"/" != match[2] {
+			return prefixCondition
+		}
+		return fmt.Sprintf(`http.request.url.path sw '%s'`, match[2])
+	})
+
+	var result routingConditionPrecedence
+	for i, alternative := range splitAnyRoutingCondition(condition) {
+		precedence := routingConditionAlternativePrecedence(alternative)
+		if i == 0 || precedence.compare(result) < 0 {
+			result = precedence
+		}
+	}
+	return result
+}
+
+func routingConditionAlternativePrecedence(condition string) routingConditionPrecedence {
+	var precedence routingConditionPrecedence
+	precedence.exactPath = exactPathConditionPattern.MatchString(condition)
+	for _, match := range prefixPathConditionPattern.FindAllStringSubmatch(condition, -1) {
+		precedence.pathPrefixLength = max(precedence.pathPrefixLength, len(match[1]))
+	}
+	matchesHostname := false
+	for _, match := range headerConditionPattern.FindAllStringSubmatch(condition, -1) {
+		// Host and content-type conditions are produced from hostnames and
+		// gRPC routes and are not header matches of the route.
+		switch strings.ToLower(match[1]) {
+		case "host":
+			matchesHostname = true
+			continue
+		case "content-type":
+			continue
+		}
+		precedence.headerMatches++
+	}
+	// Hostname only conditions match any path, same as the catch-all path prefix
+	if matchesHostname && precedence == (routingConditionPrecedence{}) {
+		precedence.pathPrefixLength = len("/")
+	}
+	for _, match := range exactHostnameConditionPattern.FindAllStringSubmatch(condition, -1) {
+		precedence.exactHostnameLength = max(precedence.exactHostnameLength, len(match[1]))
+	}
+	for _, match := range wildcardHostnameConditionPattern.FindAllStringSubmatch(condition, -1) {
+		// Counted with the leading "*" of the wildcard hostname
+		precedence.wildcardHostnameLength = max(precedence.wildcardHostnameLength, len(match[1])+1)
+	}
+	return precedence
+}
+
+// splitAnyRoutingCondition splits the top level any(...) condition into alternatives.
+// Other conditions are returned as is.
+func splitAnyRoutingCondition(condition string) []string {
+	inner, ok := strings.CutPrefix(condition, "any(")
+	if !ok || !strings.HasSuffix(inner, ")") {
+		return []string{condition}
+	}
+	inner = inner[:len(inner)-1]
+
+	var alternatives []string
+	depth, start, quoted := 0, 0, false
+	for i, ch := range inner {
+		switch {
+		case ch == '\'':
+			quoted = !quoted
+		case quoted:
+		case ch == '(' || ch == '[':
+			depth++
+		case ch == ')' || ch == ']':
+			depth--
+			if depth < 0 {
+				// The any(...) group ends before the end of the condition
+				return []string{condition}
+			}
+		case ch == ',' && depth == 0:
+			alternatives = append(alternatives, strings.TrimSpace(inner[start:i]))
+			start = i + 1
+		}
+	}
+	return append(alternatives, strings.TrimSpace(inner[start:]))
+}
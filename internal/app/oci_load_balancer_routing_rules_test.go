@@ -37,6 +37,20 @@ func TestOciLoadBalancerRoutingRulesMapper(t *testing.T) {
 					want: fmt.Sprintf(`http.request.url.path eq '%s'`, pathValue),
 				}
 			},
+			func() testCase {
+				fake := faker.New()
+				pathValue := "/" + strings.Join(fake.Lorem().Words(4), "/") + "/"
+				return testCase{
+					name: "exact nested path match with trailing slash",
+					match: gatewayv1.HTTPRouteMatch{
+						Path: &gatewayv1.HTTPPathMatch{
+							Type:  lo.ToPtr(gatewayv1.PathMatchExact),
+							Value: new(pathValue),
+						},
+					},
+					want: fmt.Sprintf(`http.request.url.path eq '%s'`, pathValue),
+				}
+			},
 			func() testCase {
 				fake := faker.New()
 				pathPrefix := "/" + fake.Lorem().Word() + "/" + fake.Lorem().Word()
@@ -48,7 +62,39 @@ func TestOciLoadBalancerRoutingRulesMapper(t *testing.T) {
 							Value: new(pathPrefix),
 						},
 					},
-					want: fmt.Sprintf(`http.request.url.path sw '%s'`, pathPrefix),
+					want: fmt.Sprintf(
+						`any(http.request.url.path eq '%s', http.request.url.path sw '%s/')`,
+						pathPrefix, pathPrefix,
+					),
+				}
+			},
+			func() testCase {
+				fake := faker.New()
+				pathPrefix := "/" + fake.Lorem().Word() + "/" + fake.Lorem().Word()
+				return testCase{
+					name: "prefix path match with trailing slash",
+					match: gatewayv1.HTTPRouteMatch{
+						Path: &gatewayv1.HTTPPathMatch{
+							Type:  lo.ToPtr(gatewayv1.PathMatchPathPrefix),
+							Value: new(pathPrefix + "/"),
+						},
+					},
+					want: fmt.Sprintf(
+						`any(http.request.url.path eq '%s', http.request.url.path sw '%s/')`,
+						pathPrefix, pathPrefix,
+					),
+				}
+			},
+			func() testCase {
+				return testCase{
+					name: "root prefix path match",
+					match: gatewayv1.HTTPRouteMatch{
+						Path: &gatewayv1.HTTPPathMatch{
+							Type:  lo.ToPtr(gatewayv1.PathMatchPathPrefix),
+							Value: new("/"),
+						},
+					},
+					want: `http.request.url.path sw '/'`,
 				}
 			},
 			func() testCase {
@@ -151,7 +197,7 @@ func TestOciLoadBalancerRoutingRulesMapper(t *testing.T) {
 					},
 					want: fmt.Sprintf(
 						"all(%s, %s, %s)",
-						`http.request.url.path sw '/api/v1'`,
+						`any(http.request.url.path eq '/api/v1', http.request.url.path sw '/api/v1/')`,
 						fmt.Sprintf(`http.request.headers[(i 'Authorization')] eq (i '%s')`, authValue),
 						fmt.Sprintf(`http.request.headers[(i 'X-Request-ID')] eq (i '%s')`, requestID),
 					),
@@ -169,6 +215,54 @@ func TestOciLoadBalancerRoutingRulesMapper(t *testing.T) {
 					wantErrIs: errUnsupportedMatch,
 				}
 			},
+			func() testCase {
+				return testCase{
+					name: "regex path anchored on both ends",
+					match: gatewayv1.HTTPRouteMatch{
+						Path: &gatewayv1.HTTPPathMatch{
+							Type:  lo.ToPtr(gatewayv1.PathMatchRegularExpression),
+							Value: new(`^/api/v1/users$`),
+						},
+					},
+					want: `http.request.url.path eq '/api/v1/users'`,
+				}
+			},
+			func() testCase {
+				return testCase{
+					name: "regex path starts with",
+					match: gatewayv1.HTTPRouteMatch{
+						Path: &gatewayv1.HTTPPathMatch{
+							Type:  lo.ToPtr(gatewayv1.PathMatchRegularExpression),
+							Value: new(`^/static/.*`),
+						},
+					},
+					want: `http.request.url.path sw '/static/'`,
+				}
+			},
+			func() testCase {
+				return testCase{
+					name: "regex path ends with escaped dot",
+					match: gatewayv1.HTTPRouteMatch{
+						Path: &gatewayv1.HTTPPathMatch{
+							Type:  lo.ToPtr(gatewayv1.PathMatchRegularExpression),
+							Value: new(`.*\.png$`),
+						},
+					},
+					want: `http.request.url.path ew '.png'`,
+				}
+			},
+			func() testCase {
+				return testCase{
+					name: "unsupported regex path with unescaped dot",
+					match: gatewayv1.HTTPRouteMatch{
+						Path: &gatewayv1.HTTPPathMatch{
+							Type:  lo.ToPtr(gatewayv1.PathMatchRegularExpression),
+							Value: new("^/api.v1"),
+						},
+					},
+					wantErrText: "regex path matching '^/api.v1'",
+				}
+			},
 			func() testCase {
 				unknownPathType := gatewayv1.PathMatchType("Unknown")
 				return testCase{
@@ -418,7 +512,10 @@ func TestOciLoadBalancerRoutingRulesMapper(t *testing.T) {
 							Value: new(pathValue),
 						},
 					},
-					want: fmt.Sprintf(`http.request.url.path sw '%s'`, pathValue),
+					want: fmt.Sprintf(
+						`any(http.request.url.path eq '%s', http.request.url.path sw '%s/')`,
+						pathValue, pathValue,
+					),
 				}
 			},
 			func() testCase {
@@ -514,7 +611,8 @@ func TestOciLoadBalancerRoutingRulesMapper(t *testing.T) {
 						},
 					},
 					want: fmt.Sprintf(
-						`any(http.request.url.path eq '%s', http.request.url.path sw '%s')`,
+						`any(http.request.url.path eq '%[1]s', `+
+							`any(http.request.url.path eq '%[2]s', http.request.url.path sw '%[2]s/'))`,
 						pathValue1, pathValue2,
 					),
 				}
@@ -644,11 +742,12 @@ func TestOciLoadBalancerRoutingRulesMapper(t *testing.T) {
 			require.NoError(t, err)
 			want := fmt.Sprintf(
 				"any("+
-					"all(http.request.headers[(i 'host')] eq (i '%s'), http.request.url.path sw '%s'), "+
-					"all(http.request.headers[(i 'host')] eq (i '%s'), http.request.url.path sw '%s')"+
+					"all(http.request.headers[(i 'host')] eq (i '%[1]s'), "+
+					"any(http.request.url.path eq '%[3]s', http.request.url.path sw '%[3]s/')), "+
+					"all(http.request.headers[(i 'host')] eq (i '%[2]s'), "+
+					"any(http.request.url.path eq '%[3]s', http.request.url.path sw '%[3]s/'))"+
 					")",
 				host1,
-				pathValue,
 				host2,
 				pathValue,
 			)
@@ -681,6 +780,37 @@ func TestOciLoadBalancerRoutingRulesMapper(t *testing.T) {
 				actual,
 			)
 		})
+		t.Run("uses hostnames only for catch-all path match", func(t *testing.T) {
+			fake := faker.New()
+			host := gatewayv1.Hostname("auth-" + fake.Internet().Domain())
+			rootPath := "/"
+			pathValue := "/" + fake.Lorem().Word()
+
+			rs := newOciLoadBalancerRoutingRulesMapper()
+			actual, err := rs.mapHTTPRouteHostnamesAndMatchesToCondition(
+				[]gatewayv1.Hostname{host},
+				[]gatewayv1.HTTPRouteMatch{
+					{Path: &gatewayv1.HTTPPathMatch{Type: lo.ToPtr(gatewayv1.PathMatchExact), Value: &pathValue}},
+					{Path: &gatewayv1.HTTPPathMatch{Type: lo.ToPtr(gatewayv1.PathMatchPathPrefix), Value: &rootPath}},
+				},
+			)
+
+			require.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("any(http.request.headers[(i 'host')] eq (i '%s'))", host), actual)
+		})
+
+		t.Run("matches subdomains of wildcard hostnames", func(t *testing.T) {
+			domain := faker.New().Internet().Domain()
+
+			rs := newOciLoadBalancerRoutingRulesMapper()
+			actual, err := rs.mapHTTPRouteHostnamesAndMatchesToCondition(
+				[]gatewayv1.Hostname{gatewayv1.Hostname("*." + domain)},
+				nil,
+			)
+
+			require.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("any(http.request.headers[(i 'host')][0] ew (i '.%s'))", domain), actual)
+		})
 	})
 
 	t.Run("mapGRPCRouteHostnamesAndMatchesToCondition", func(t *testing.T) {
@@ -922,4 +1052,172 @@ func TestOciLoadBalancerRoutingRulesMapper(t *testing.T) {
 			require.ErrorIs(t, err, errUnsupportedMatch)
 		})
 	})
+
+	t.Run("routingConditionPrecedenceOf", func(t *testing.T) {
+		rs := newOciLoadBalancerRoutingRulesMapper()
+		mapCondition := func(t *testing.T, hostnames []gatewayv1.Hostname, matches ...gatewayv1.HTTPRouteMatch) string {
+			condition, err := rs.mapHTTPRouteHostnamesAndMatchesToCondition(hostnames, matches)
+			require.NoError(t, err)
+			return condition
+		}
+		pathMatch := func(pathType gatewayv1.PathMatchType, value string) gatewayv1.HTTPRouteMatch {
+			return gatewayv1.HTTPRouteMatch{
+				Path: &gatewayv1.HTTPPathMatch{Type: &pathType, Value: &value},
+			}
+		}
+		headerMatch := func(match gatewayv1.HTTPRouteMatch, count int) gatewayv1.HTTPRouteMatch {
+			for i := range count {
+				match.Headers = append(match.Headers, gatewayv1.HTTPHeaderMatch{
+					Type:  lo.ToPtr(gatewayv1.HeaderMatchExact),
+					Name:  gatewayv1.HTTPHeaderName(fmt.Sprintf("x-header-%d", i)),
+					Value: faker.New().Lorem().Word(),
+				})
+			}
+			return match
+		}
+
+		t.Run("exact path", func(t *testing.T) {
+			condition := mapCondition(t, nil, pathMatch(gatewayv1.PathMatchExact, "/api/v1/users"))
+			assert.Equal(t,
+				routingConditionPrecedence{exactPath: true},
+				routingConditionPrecedenceOf(condition),
+			)
+		})
+
+		t.Run("path prefix and headers", func(t *testing.T) {
+			hostname := faker.New().Internet().Domain()
+			condition := mapCondition(t,
+				[]gatewayv1.Hostname{gatewayv1.Hostname(hostname)},
+				headerMatch(pathMatch(gatewayv1.PathMatchPathPrefix, "/api/v1"), 2),
+			)
+			assert.Equal(t,
+				routingConditionPrecedence{
+					exactHostnameLength: len(hostname),
+					pathPrefixLength:    len("/api/v1/"),
+					headerMatches:       2,
+				},
+				routingConditionPrecedenceOf(condition),
+			)
+		})
+
+		t.Run("uses the most specific alternative", func(t *testing.T) {
+			condition := mapCondition(t, nil,
+				pathMatch(gatewayv1.PathMatchPathPrefix, "/api"),
+				pathMatch(gatewayv1.PathMatchPathPrefix, "/api/v1/users"),
+				headerMatch(pathMatch(gatewayv1.PathMatchPathPrefix, "/api/v1"), 3),
+			)
+			assert.Equal(t,
+				routingConditionPrecedence{pathPrefixLength: len("/api/v1/users/")},
+				routingConditionPrecedenceOf(condition),
+			)
+		})
+
+		t.Run("segment aware prefix is not an exact path", func(t *testing.T) {
+			condition := mapCondition(t, nil, pathMatch(gatewayv1.PathMatchPathPrefix, "/api/v1"))
+			assert.Equal(t,
+				routingConditionPrecedence{pathPrefixLength: len("/api/v1/")},
+				routingConditionPrecedenceOf(condition),
+			)
+		})
+
+		t.Run("exact path alongside the same prefix", func(t *testing.T) {
+			condition := mapCondition(t, nil,
+				pathMatch(gatewayv1.PathMatchExact, "/api/v1"),
+				pathMatch(gatewayv1.PathMatchPathPrefix, "/api/v1"),
+			)
+			assert.Equal(t,
+				routingConditionPrecedence{exactPath: true},
+				routingConditionPrecedenceOf(condition),
+			)
+		})
+
+		t.Run("hostname only condition as catch-all path prefix", func(t *testing.T) {
+			hostname := faker.New().Internet().Domain()
+			condition := mapCondition(t, []gatewayv1.Hostname{gatewayv1.Hostname(hostname)})
+			assert.Equal(t,
+				routingConditionPrecedence{exactHostnameLength: len(hostname), pathPrefixLength: len("/")},
+				routingConditionPrecedenceOf(condition),
+			)
+		})
+
+		t.Run("wildcard hostname", func(t *testing.T) {
+			condition := mapCondition(t,
+				[]gatewayv1.Hostname{"*.example.com"},
+				pathMatch(gatewayv1.PathMatchPathPrefix, "/api"),
+			)
+			assert.Equal(t,
+				routingConditionPrecedence{wildcardHostnameLength: len("*.example.com"), pathPrefixLength: len("/api/")},
+				routingConditionPrecedenceOf(condition),
+			)
+		})
+
+		t.Run("uses the most specific hostname", func(t *testing.T) {
+			condition := mapCondition(t,
+				[]gatewayv1.Hostname{"*.example.com", "foo.example.com"},
+				pathMatch(gatewayv1.PathMatchPathPrefix, "/api"),
+			)
+			assert.Equal(t,
+				routingConditionPrecedence{exactHostnameLength: len("foo.example.com"), pathPrefixLength: len("/api/")},
+				routingConditionPrecedenceOf(condition),
+			)
+		})
+
+		t.Run("specific hostname takes precedence over wildcard with longer path", func(t *testing.T) {
+			exact := mapCondition(t, []gatewayv1.Hostname{"foo.example.com"})
+			wildcard := mapCondition(t,
+				[]gatewayv1.Hostname{"*.example.com"},
+				pathMatch(gatewayv1.PathMatchExact, "/api/v1/users"),
+			)
+			assert.Negative(t, routingConditionPrecedenceOf(exact).compare(routingConditionPrecedenceOf(wildcard)))
+		})
+
+		t.Run("ignores grpc content type conditions", func(t *testing.T) {
+			condition, err := rs.mapGRPCRouteMatchesToCondition(nil)
+			require.NoError(t, err)
+			assert.Equal(t, routingConditionPrecedence{}, routingConditionPrecedenceOf(condition))
+		})
+
+		t.Run("compare follows match precedence", func(t *testing.T) {
+			ordered := []routingConditionPrecedence{
+				{exactHostnameLength: 20},
+				{exactHostnameLength: 10, exactPath: true},
+				{exactHostnameLength: 10, pathPrefixLength: 1},
+				{wildcardHostnameLength: 20},
+				{wildcardHostnameLength: 10, exactPath: true},
+				{exactPath: true, headerMatches: 1},
+				{exactPath: true},
+				{pathPrefixLength: 10},
+				{pathPrefixLength: 5, headerMatches: 2},
+				{pathPrefixLength: 5, headerMatches: 1},
+				{pathPrefixLength: 1},
+				{},
+			}
+			for i := range len(ordered) - 1 {
+				assert.Negative(t, ordered[i].compare(ordered[i+1]), "index %d", i)
+				assert.Positive(t, ordered[i+1].compare(ordered[i]), "index %d", i)
+			}
+			assert.Zero(t, ordered[0].compare(ordered[0]))
+		})
+	})
+
+	t.Run("splitAnyRoutingCondition", func(t *testing.T) {
+		t.Run("returns plain condition as is", func(t *testing.T) {
+			condition := `http.request.url.path eq '/a,b'`
+			assert.Equal(t, []string{condition}, splitAnyRoutingCondition(condition))
+		})
+
+		t.Run("splits top level alternatives", func(t *testing.T) {
+			first := `all(http.request.url.path sw '/a', http.request.headers[(i 'x')] eq (i 'y,z)'))`
+			second := `http.request.url.path eq '/b'`
+			assert.Equal(t,
+				[]string{first, second},
+				splitAnyRoutingCondition("any("+first+", "+second+")"),
+			)
+		})
+
+		t.Run("does not split when any group ends early", func(t *testing.T) {
+			condition := `any(http.request.url.path eq '/a') and any(http.request.url.path eq '/b')`
+			assert.Equal(t, []string{condition}, splitAnyRoutingCondition(condition))
+		})
+	})
 }
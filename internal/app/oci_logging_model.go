@@ -0,0 +1,274 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/logging"
+	"github.com/samber/lo"
+	"go.uber.org/dig"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+const (
+	ociLoadBalancerLogSourceService  = "loadbalancer"
+	ociLoadBalancerAccessLogCategory = "access"
+	ociLoadBalancerErrorLogCategory  = "error"
+)
+
+const maxLogNameLength = 255
+
+var invalidCharsForLogNamePattern = regexp.MustCompile(`[^a-zA-Z0-9_\-.]`)
+
+type reconcileLoadBalancerLogsParams struct {
+	loadBalancerID string
+	gateway        *gatewayv1.Gateway
+	logging        types.GatewayConfigLogging
+}
+
+type reconcileLoadBalancerLogParams struct {
+	loadBalancerID string
+	logGroupID     string
+	category       string
+	gateway        *gatewayv1.Gateway
+	logSpec        types.GatewayConfigLog
+}
+
+// loadBalancerLogState is a minimal view of the OCI log that is relevant for reconciliation.
+type loadBalancerLogState struct {
+	logID   string
+	enabled bool
+}
+
+type ociLoggingModel interface {
+	// reconcileLoadBalancerLogs makes sure the access and error logs of the load balancer
+	// match the logging settings. Logs that are not mentioned in the settings are left untouched.
+	reconcileLoadBalancerLogs(ctx context.Context, params reconcileLoadBalancerLogsParams) error
+}
+
+type ociLoggingModelImpl struct {
+	logger              *slog.Logger
+	ociClient           ociLoggingManagementClient
+	workRequestsWatcher workRequestsWatcher
+}
+
+func (m *ociLoggingModelImpl) reconcileLoadBalancerLogs(
+	ctx context.Context,
+	params reconcileLoadBalancerLogsParams,
+) error {
+	logs := []struct {
+		category string
+		spec     *types.GatewayConfigLog
+	}{
+		{category: ociLoadBalancerAccessLogCategory, spec: params.logging.AccessLog},
+		{category: ociLoadBalancerErrorLogCategory, spec: params.logging.ErrorLog},
+	}
+
+	for _, log := range logs {
+		if log.spec == nil {
+			continue
+		}
+		if err := m.reconcileLoadBalancerLog(ctx, reconcileLoadBalancerLogParams{
+			loadBalancerID: params.loadBalancerID,
+			logGroupID:     params.logging.LogGroupID,
+			category:       log.category,
+			gateway:        params.gateway,
+			logSpec:        *log.spec,
+		}); err != nil {
+			return fmt.Errorf("failed to reconcile %s log: %w", log.category, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *ociLoggingModelImpl) reconcileLoadBalancerLog(
+	ctx context.Context,
+	params reconcileLoadBalancerLogParams,
+) error {
+	existingLog, err := m.findLoadBalancerLog(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	if existingLog == nil {
+		if !params.logSpec.Enabled {
+			return nil
+		}
+		return m.createLoadBalancerLog(ctx, params)
+	}
+
+	if existingLog.enabled == params.logSpec.Enabled {
+		m.logger.DebugContext(ctx, "Load balancer log is up to date",
+			slog.String("logId", existingLog.logID),
+			slog.String("category", params.category),
+		)
+		return nil
+	}
+
+	m.logger.InfoContext(ctx, "Updating load balancer log",
+		slog.String("logId", existingLog.logID),
+		slog.String("category", params.category),
+		slog.Bool("enabled", params.logSpec.Enabled),
+	)
+
+	// Logging work requests are tracked by a separate OCI API. The log state
+	// is converged by subsequent reconciliations, so we are not waiting here.
+	_, err = m.ociClient.UpdateLog(ctx, logging.UpdateLogRequest{
+		LogGroupId: &params.logGroupID,
+		LogId:      &existingLog.logID,
+		UpdateLogDetails: logging.UpdateLogDetails{
+			IsEnabled: &params.logSpec.Enabled,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update log %s: %w", existingLog.logID, err)
+	}
+
+	return nil
+}
+
+func (m *ociLoggingModelImpl) findLoadBalancerLog(
+	ctx context.Context,
+	params reconcileLoadBalancerLogParams,
+) (*loadBalancerLogState, error) {
+	if params.logSpec.LogID != "" {
+		response, err := m.ociClient.GetLog(ctx, logging.GetLogRequest{
+			LogGroupId: &params.logGroupID,
+			LogId:      &params.logSpec.LogID,
+		})
+		if err != nil {
+			if serviceErr, ok := common.IsServiceError(err); ok &&
+				serviceErr.GetHTTPStatusCode() == http.StatusNotFound {
+				return nil, &resourceStatusError{
+					conditionType: string(gatewayv1.GatewayConditionProgrammed),
+					reason:        string(gatewayv1.GatewayReasonInvalid),
+					message: fmt.Sprintf("referenced OCI log %s not found in log group %s",
+						params.logSpec.LogID, params.logGroupID),
+				}
+			}
+			return nil, fmt.Errorf("failed to get log %s: %w", params.logSpec.LogID, err)
+		}
+		return &loadBalancerLogState{
+			logID:   params.logSpec.LogID,
+			enabled: lo.FromPtr(response.IsEnabled),
+		}, nil
+	}
+
+	var page *string
+	for {
+		response, err := m.ociClient.ListLogs(ctx, logging.ListLogsRequest{
+			LogGroupId:     &params.logGroupID,
+			LogType:        logging.ListLogsLogTypeService,
+			SourceService:  new(ociLoadBalancerLogSourceService),
+			SourceResource: &params.loadBalancerID,
+			Page:           page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list logs of log group %s: %w", params.logGroupID, err)
+		}
+
+		for _, item := range response.Items {
+			if item.LifecycleState == logging.LogLifecycleStateDeleting ||
+				ociLogSourceCategory(item.Configuration) != params.category {
+				continue
+			}
+			return &loadBalancerLogState{
+				logID:   lo.FromPtr(item.Id),
+				enabled: lo.FromPtr(item.IsEnabled),
+			}, nil
+		}
+
+		if response.OpcNextPage == nil {
+			return nil, nil
+		}
+		page = response.OpcNextPage
+	}
+}
+
+func ociLogSourceCategory(configuration *logging.Configuration) string {
+	if configuration == nil {
+		return ""
+	}
+	switch source := configuration.Source.(type) {
+	case logging.OciService:
+		return lo.FromPtr(source.Category)
+	case *logging.OciService:
+		return lo.FromPtr(source.Category)
+	}
+	return ""
+}
+
+func (m *ociLoggingModelImpl) createLoadBalancerLog(
+	ctx context.Context,
+	params reconcileLoadBalancerLogParams,
+) error {
+	logName := ociLoadBalancerLogName(params.gateway, params.category)
+	m.logger.InfoContext(ctx, "Creating load balancer log",
+		slog.String("logName", logName),
+		slog.String("logGroupId", params.logGroupID),
+		slog.String("category", params.category),
+	)
+
+	response, err := m.ociClient.CreateLog(ctx, logging.CreateLogRequest{
+		LogGroupId: &params.logGroupID,
+		CreateLogDetails: logging.CreateLogDetails{
+			DisplayName: &logName,
+			LogType:     logging.CreateLogDetailsLogTypeService,
+			IsEnabled:   new(true),
+			Configuration: &logging.Configuration{
+				Source: logging.OciService{
+					Service:  new(ociLoadBalancerLogSourceService),
+					Resource: &params.loadBalancerID,
+					Category: &params.category,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create log %s: %w", logName, err)
+	}
+
+	if response.OpcWorkRequestId == nil {
+		return fmt.Errorf("failed to create log %s: missing work request id", logName)
+	}
+
+	// The log is only listed once created, so the next reconcile would create it again
+	// if it did not wait for the work request.
+	if err = m.workRequestsWatcher.WaitFor(ctx, *response.OpcWorkRequestId); err != nil {
+		return fmt.Errorf("failed to wait for log %s to be created: %w", logName, err)
+	}
+
+	return nil
+}
+
+func ociLoadBalancerLogName(gateway *gatewayv1.Gateway, category string) string {
+	originalName := fmt.Sprintf("%s-%s-%s", gateway.Namespace, gateway.Name, category)
+	return ociapi.ConstructOCIResourceName(originalName, ociapi.OCIResourceNameConfig{
+		MaxLength:           maxLogNameLength,
+		InvalidCharsPattern: invalidCharsForLogNamePattern,
+	})
+}
+
+type ociLoggingModelDeps struct {
+	dig.In
+
+	RootLogger          *slog.Logger
+	OciClient           ociLoggingManagementClient
+	WorkRequestsWatcher workRequestsWatcher `name:"loggingWorkRequestsWatcher"`
+}
+
+func newOciLoggingModel(deps ociLoggingModelDeps) *ociLoggingModelImpl {
+	return &ociLoggingModelImpl{
+		logger:              deps.RootLogger.WithGroup("oci-logging-model"),
+		ociClient:           deps.OciClient,
+		workRequestsWatcher: deps.WorkRequestsWatcher,
+	}
+}
@@ -0,0 +1,342 @@
+package app
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestOciLoggingModelImpl(t *testing.T) {
+	newMockDeps := func(t *testing.T) ociLoggingModelDeps {
+		return ociLoggingModelDeps{
+			RootLogger:          diag.RootTestLogger(),
+			OciClient:           NewMockociLoggingManagementClient(t),
+			WorkRequestsWatcher: NewMockworkRequestsWatcher(t),
+		}
+	}
+
+	makeRandomLogSummary := func(category string, enabled bool) logging.LogSummary {
+		fake := faker.New()
+		return logging.LogSummary{
+			Id:             new(fake.UUID().V4()),
+			IsEnabled:      new(enabled),
+			LifecycleState: logging.LogLifecycleStateActive,
+			Configuration: &logging.Configuration{
+				Source: logging.OciService{
+					Service:  new(ociLoadBalancerLogSourceService),
+					Resource: new(fake.UUID().V4()),
+					Category: new(category),
+				},
+			},
+		}
+	}
+
+	t.Run("reconcileLoadBalancerLogs", func(t *testing.T) {
+		t.Run("skips logs that are not configured", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciLoggingModel(deps)
+
+			err := model.reconcileLoadBalancerLogs(t.Context(), reconcileLoadBalancerLogsParams{
+				loadBalancerID: faker.New().UUID().V4(),
+				gateway:        newRandomGateway(),
+				logging: types.GatewayConfigLogging{
+					LogGroupID: faker.New().UUID().V4(),
+				},
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("creates missing enabled log", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciLoggingModel(deps)
+			loadBalancerID := faker.New().UUID().V4()
+			logGroupID := faker.New().UUID().V4()
+			gateway := newRandomGateway()
+			nextPage := faker.New().UUID().V4()
+
+			ociClient, _ := deps.OciClient.(*MockociLoggingManagementClient)
+			ociClient.EXPECT().
+				ListLogs(t.Context(), logging.ListLogsRequest{
+					LogGroupId:     &logGroupID,
+					LogType:        logging.ListLogsLogTypeService,
+					SourceService:  new(ociLoadBalancerLogSourceService),
+					SourceResource: &loadBalancerID,
+				}).
+				Return(logging.ListLogsResponse{
+					Items: []logging.LogSummary{
+						makeRandomLogSummary(ociLoadBalancerErrorLogCategory, true),
+					},
+					OpcNextPage: &nextPage,
+				}, nil).
+				Once()
+			ociClient.EXPECT().
+				ListLogs(t.Context(), logging.ListLogsRequest{
+					LogGroupId:     &logGroupID,
+					LogType:        logging.ListLogsLogTypeService,
+					SourceService:  new(ociLoadBalancerLogSourceService),
+					SourceResource: &loadBalancerID,
+					Page:           &nextPage,
+				}).
+				Return(logging.ListLogsResponse{}, nil).
+				Once()
+
+			wantLogName := ociLoadBalancerLogName(gateway, ociLoadBalancerAccessLogCategory)
+			workRequestID := faker.New().UUID().V4()
+			ociClient.EXPECT().
+				CreateLog(t.Context(), logging.CreateLogRequest{
+					LogGroupId: &logGroupID,
+					CreateLogDetails: logging.CreateLogDetails{
+						DisplayName: &wantLogName,
+						LogType:     logging.CreateLogDetailsLogTypeService,
+						IsEnabled:   new(true),
+						Configuration: &logging.Configuration{
+							Source: logging.OciService{
+								Service:  new(ociLoadBalancerLogSourceService),
+								Resource: &loadBalancerID,
+								Category: new(ociLoadBalancerAccessLogCategory),
+							},
+						},
+					},
+				}).
+				Return(logging.CreateLogResponse{OpcWorkRequestId: &workRequestID}, nil).
+				Once()
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
+
+			err := model.reconcileLoadBalancerLogs(t.Context(), reconcileLoadBalancerLogsParams{
+				loadBalancerID: loadBalancerID,
+				gateway:        gateway,
+				logging: types.GatewayConfigLogging{
+					LogGroupID: logGroupID,
+					AccessLog:  &types.GatewayConfigLog{Enabled: true},
+				},
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("fails when log creation work request fails", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciLoggingModel(deps)
+			workRequestID := faker.New().UUID().V4()
+			wantErr := errors.New(faker.New().Lorem().Sentence(3))
+
+			ociClient, _ := deps.OciClient.(*MockociLoggingManagementClient)
+			ociClient.EXPECT().ListLogs(t.Context(), mock.Anything).Return(logging.ListLogsResponse{}, nil).Once()
+			ociClient.EXPECT().
+				CreateLog(t.Context(), mock.Anything).
+				Return(logging.CreateLogResponse{OpcWorkRequestId: &workRequestID}, nil).
+				Once()
+			workRequestsWatcher, _ := deps.WorkRequestsWatcher.(*MockworkRequestsWatcher)
+			workRequestsWatcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(wantErr).Once()
+
+			err := model.reconcileLoadBalancerLogs(t.Context(), reconcileLoadBalancerLogsParams{
+				loadBalancerID: faker.New().UUID().V4(),
+				gateway:        newRandomGateway(),
+				logging: types.GatewayConfigLogging{
+					LogGroupID: faker.New().UUID().V4(),
+					AccessLog:  &types.GatewayConfigLog{Enabled: true},
+				},
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+
+		t.Run("does not create missing disabled log", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciLoggingModel(deps)
+
+			ociClient, _ := deps.OciClient.(*MockociLoggingManagementClient)
+			ociClient.EXPECT().
+				ListLogs(t.Context(), mock.Anything).
+				Return(logging.ListLogsResponse{}, nil).
+				Once()
+
+			err := model.reconcileLoadBalancerLogs(t.Context(), reconcileLoadBalancerLogsParams{
+				loadBalancerID: faker.New().UUID().V4(),
+				gateway:        newRandomGateway(),
+				logging: types.GatewayConfigLogging{
+					LogGroupID: faker.New().UUID().V4(),
+					ErrorLog:   &types.GatewayConfigLog{Enabled: false},
+				},
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("updates existing log when enabled state differs", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciLoggingModel(deps)
+			logGroupID := faker.New().UUID().V4()
+			existingLog := makeRandomLogSummary(ociLoadBalancerErrorLogCategory, true)
+			deletingLog := makeRandomLogSummary(ociLoadBalancerErrorLogCategory, false)
+			deletingLog.LifecycleState = logging.LogLifecycleStateDeleting
+
+			ociClient, _ := deps.OciClient.(*MockociLoggingManagementClient)
+			ociClient.EXPECT().
+				ListLogs(t.Context(), mock.Anything).
+				Return(logging.ListLogsResponse{
+					Items: []logging.LogSummary{deletingLog, existingLog},
+				}, nil).
+				Once()
+			ociClient.EXPECT().
+				UpdateLog(t.Context(), logging.UpdateLogRequest{
+					LogGroupId: &logGroupID,
+					LogId:      existingLog.Id,
+					UpdateLogDetails: logging.UpdateLogDetails{
+						IsEnabled: new(false),
+					},
+				}).
+				Return(logging.UpdateLogResponse{}, nil).
+				Once()
+
+			err := model.reconcileLoadBalancerLogs(t.Context(), reconcileLoadBalancerLogsParams{
+				loadBalancerID: faker.New().UUID().V4(),
+				gateway:        newRandomGateway(),
+				logging: types.GatewayConfigLogging{
+					LogGroupID: logGroupID,
+					ErrorLog:   &types.GatewayConfigLog{Enabled: false},
+				},
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("keeps existing log that is up to date", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciLoggingModel(deps)
+
+			ociClient, _ := deps.OciClient.(*MockociLoggingManagementClient)
+			ociClient.EXPECT().
+				ListLogs(t.Context(), mock.Anything).
+				Return(logging.ListLogsResponse{
+					Items: []logging.LogSummary{
+						makeRandomLogSummary(ociLoadBalancerAccessLogCategory, true),
+					},
+				}, nil).
+				Once()
+
+			err := model.reconcileLoadBalancerLogs(t.Context(), reconcileLoadBalancerLogsParams{
+				loadBalancerID: faker.New().UUID().V4(),
+				gateway:        newRandomGateway(),
+				logging: types.GatewayConfigLogging{
+					LogGroupID: faker.New().UUID().V4(),
+					AccessLog:  &types.GatewayConfigLog{Enabled: true},
+				},
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("uses referenced log", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciLoggingModel(deps)
+			logGroupID := faker.New().UUID().V4()
+			logID := faker.New().UUID().V4()
+
+			ociClient, _ := deps.OciClient.(*MockociLoggingManagementClient)
+			ociClient.EXPECT().
+				GetLog(t.Context(), logging.GetLogRequest{
+					LogGroupId: &logGroupID,
+					LogId:      &logID,
+				}).
+				Return(logging.GetLogResponse{
+					Log: logging.Log{Id: &logID, IsEnabled: new(false)},
+				}, nil).
+				Once()
+			ociClient.EXPECT().
+				UpdateLog(t.Context(), logging.UpdateLogRequest{
+					LogGroupId: &logGroupID,
+					LogId:      &logID,
+					UpdateLogDetails: logging.UpdateLogDetails{
+						IsEnabled: new(true),
+					},
+				}).
+				Return(logging.UpdateLogResponse{}, nil).
+				Once()
+
+			err := model.reconcileLoadBalancerLogs(t.Context(), reconcileLoadBalancerLogsParams{
+				loadBalancerID: faker.New().UUID().V4(),
+				gateway:        newRandomGateway(),
+				logging: types.GatewayConfigLogging{
+					LogGroupID: logGroupID,
+					AccessLog:  &types.GatewayConfigLog{Enabled: true, LogID: logID},
+				},
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("referenced log not found", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciLoggingModel(deps)
+
+			ociClient, _ := deps.OciClient.(*MockociLoggingManagementClient)
+			ociClient.EXPECT().
+				GetLog(t.Context(), mock.Anything).
+				Return(logging.GetLogResponse{}, ociapi.NewRandomServiceError(
+					ociapi.RandomServiceErrorWithStatusCode(http.StatusNotFound),
+				)).
+				Once()
+
+			err := model.reconcileLoadBalancerLogs(t.Context(), reconcileLoadBalancerLogsParams{
+				loadBalancerID: faker.New().UUID().V4(),
+				gateway:        newRandomGateway(),
+				logging: types.GatewayConfigLogging{
+					LogGroupID: faker.New().UUID().V4(),
+					ErrorLog:   &types.GatewayConfigLog{Enabled: true, LogID: faker.New().UUID().V4()},
+				},
+			})
+
+			var statusErr *resourceStatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, string(gatewayv1.GatewayConditionProgrammed), statusErr.conditionType)
+			assert.Equal(t, string(gatewayv1.GatewayReasonInvalid), statusErr.reason)
+		})
+
+		t.Run("list logs failure", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciLoggingModel(deps)
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+
+			ociClient, _ := deps.OciClient.(*MockociLoggingManagementClient)
+			ociClient.EXPECT().
+				ListLogs(t.Context(), mock.Anything).
+				Return(logging.ListLogsResponse{}, wantErr).
+				Once()
+
+			err := model.reconcileLoadBalancerLogs(t.Context(), reconcileLoadBalancerLogsParams{
+				loadBalancerID: faker.New().UUID().V4(),
+				gateway:        newRandomGateway(),
+				logging: types.GatewayConfigLogging{
+					LogGroupID: faker.New().UUID().V4(),
+					AccessLog:  &types.GatewayConfigLog{Enabled: true},
+				},
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("ociLoadBalancerLogName", func(t *testing.T) {
+		gateway := newRandomGateway()
+		gateway.Namespace = "ns"
+		gateway.Name = "my:gateway"
+
+		got := ociLoadBalancerLogName(gateway, ociLoadBalancerAccessLogCategory)
+
+		assert.Equal(t, "ns-my_gateway-access", got)
+	})
+}
@@ -0,0 +1,275 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/samber/lo"
+	"go.uber.org/dig"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+const (
+	ociSecurityRuleProtocolTCP       = "6"
+	ociSecurityRuleDescriptionPrefix = "oke-gateway-api"
+	defaultSecurityRuleSourceCIDR    = "0.0.0.0/0"
+
+	// OCI accepts up to 25 rules in a single add/remove request.
+	maxSecurityRulesPerRequest = 25
+)
+
+type reconcileListenersSecurityRulesParams struct {
+	gateway              *gatewayv1.Gateway
+	networkSecurityGroup types.GatewayConfigNetworkSecurityGroup
+}
+
+type removeListenersSecurityRulesParams struct {
+	gateway                *gatewayv1.Gateway
+	networkSecurityGroupID string
+}
+
+type ociNetworkSecurityGroupModel interface {
+	// reconcileListenersSecurityRules makes sure the NSG has ingress rules for every gateway
+	// listener port and removes rules previously created for listeners that no longer exist.
+	// Only rules created by the controller for the given gateway are managed.
+	reconcileListenersSecurityRules(ctx context.Context, params reconcileListenersSecurityRulesParams) error
+
+	// removeListenersSecurityRules removes all rules created by the controller for the gateway
+	// from the NSG, e.g. once the gateway no longer uses it. Missing NSG is not an error.
+	removeListenersSecurityRules(ctx context.Context, params removeListenersSecurityRulesParams) error
+}
+
+type securityRuleKey struct {
+	source string
+	port   int
+}
+
+type ociNetworkSecurityGroupModelImpl struct {
+	logger    *slog.Logger
+	ociClient ociVirtualNetworkClient
+}
+
+func (m *ociNetworkSecurityGroupModelImpl) reconcileListenersSecurityRules(
+	ctx context.Context,
+	params reconcileListenersSecurityRulesParams,
+) error {
+	nsgID := params.networkSecurityGroup.ID
+	description := ociSecurityRuleDescription(params.gateway)
+
+	sources := params.networkSecurityGroup.SourceCIDRs
+	if len(sources) == 0 {
+		sources = []string{defaultSecurityRuleSourceCIDR}
+	}
+
+	desiredRules := make(map[securityRuleKey]struct{})
+	for _, listener := range params.gateway.Spec.Listeners {
+		for _, source := range sources {
+			desiredRules[securityRuleKey{source: source, port: int(listener.Port)}] = struct{}{}
+		}
+	}
+
+	existingRules, err := m.listManagedSecurityRules(ctx, nsgID, description)
+	if err != nil {
+		return err
+	}
+
+	staleRuleIDs := make([]string, 0, len(existingRules))
+	for _, rule := range existingRules {
+		key, ok := securityRuleKeyFromRule(rule)
+		if _, desired := desiredRules[key]; ok && desired {
+			delete(desiredRules, key)
+			continue
+		}
+		staleRuleIDs = append(staleRuleIDs, lo.FromPtr(rule.Id))
+	}
+
+	if err = m.addSecurityRules(ctx, nsgID, description, lo.Keys(desiredRules)); err != nil {
+		return err
+	}
+
+	return m.removeSecurityRules(ctx, nsgID, staleRuleIDs)
+}
+
+func (m *ociNetworkSecurityGroupModelImpl) removeListenersSecurityRules(
+	ctx context.Context,
+	params removeListenersSecurityRulesParams,
+) error {
+	nsgID := params.networkSecurityGroupID
+	existingRules, err := m.listManagedSecurityRules(ctx, nsgID, ociSecurityRuleDescription(params.gateway))
+	if err != nil {
+		var serviceErr common.ServiceError
+		if errors.As(err, &serviceErr) && serviceErr.GetHTTPStatusCode() == http.StatusNotFound {
+			m.logger.InfoContext(ctx, "NSG is already gone, skipping security rules removal",
+				slog.String("nsgId", nsgID),
+			)
+			return nil
+		}
+		return err
+	}
+
+	return m.removeSecurityRules(ctx, nsgID, lo.Map(existingRules, func(rule core.SecurityRule, _ int) string {
+		return lo.FromPtr(rule.Id)
+	}))
+}
+
+func (m *ociNetworkSecurityGroupModelImpl) listManagedSecurityRules(
+	ctx context.Context,
+	nsgID string,
+	description string,
+) ([]core.SecurityRule, error) {
+	var result []core.SecurityRule
+	var page *string
+	for {
+		response, err := m.ociClient.ListNetworkSecurityGroupSecurityRules(ctx,
+			core.ListNetworkSecurityGroupSecurityRulesRequest{
+				NetworkSecurityGroupId: &nsgID,
+				Direction:              core.ListNetworkSecurityGroupSecurityRulesDirectionIngress,
+				Page:                   page,
+			})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list security rules of NSG %s: %w", nsgID, err)
+		}
+
+		for _, rule := range response.Items {
+			if lo.FromPtr(rule.Description) == description {
+				result = append(result, rule)
+			}
+		}
+
+		if response.OpcNextPage == nil {
+			return result, nil
+		}
+		page = response.OpcNextPage
+	}
+}
+
+func (m *ociNetworkSecurityGroupModelImpl) addSecurityRules(
+	ctx context.Context,
+	nsgID string,
+	description string,
+	keys []securityRuleKey,
+) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	slices.SortFunc(keys, func(a, b securityRuleKey) int {
+		return cmp.Or(cmp.Compare(a.port, b.port), cmp.Compare(a.source, b.source))
+	})
+
+	for _, chunk := range lo.Chunk(keys, maxSecurityRulesPerRequest) {
+		rules := lo.Map(chunk, func(key securityRuleKey, _ int) core.AddSecurityRuleDetails {
+			return core.AddSecurityRuleDetails{
+				Direction:   core.AddSecurityRuleDetailsDirectionIngress,
+				Protocol:    new(ociSecurityRuleProtocolTCP),
+				Description: &description,
+				Source:      &key.source,
+				SourceType:  core.AddSecurityRuleDetailsSourceTypeCidrBlock,
+				IsStateless: new(false),
+				TcpOptions: &core.TcpOptions{
+					DestinationPortRange: &core.PortRange{
+						Min: &key.port,
+						Max: &key.port,
+					},
+				},
+			}
+		})
+
+		m.logger.InfoContext(ctx, "Adding NSG security rules",
+			slog.String("nsgId", nsgID),
+			slog.Int("rulesCount", len(rules)),
+		)
+
+		if _, err := m.ociClient.AddNetworkSecurityGroupSecurityRules(ctx,
+			core.AddNetworkSecurityGroupSecurityRulesRequest{
+				NetworkSecurityGroupId: &nsgID,
+				AddNetworkSecurityGroupSecurityRulesDetails: core.AddNetworkSecurityGroupSecurityRulesDetails{
+					SecurityRules: rules,
+				},
+			}); err != nil {
+			return fmt.Errorf("failed to add security rules to NSG %s: %w", nsgID, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *ociNetworkSecurityGroupModelImpl) removeSecurityRules(
+	ctx context.Context,
+	nsgID string,
+	ruleIDs []string,
+) error {
+	for _, chunk := range lo.Chunk(ruleIDs, maxSecurityRulesPerRequest) {
+		m.logger.InfoContext(ctx, "Removing stale NSG security rules",
+			slog.String("nsgId", nsgID),
+			slog.Any("ruleIds", chunk),
+		)
+
+		if _, err := m.ociClient.RemoveNetworkSecurityGroupSecurityRules(ctx,
+			core.RemoveNetworkSecurityGroupSecurityRulesRequest{
+				NetworkSecurityGroupId: &nsgID,
+				RemoveNetworkSecurityGroupSecurityRulesDetails: core.RemoveNetworkSecurityGroupSecurityRulesDetails{
+					SecurityRuleIds: chunk,
+				},
+			}); err != nil {
+			return fmt.Errorf("failed to remove security rules from NSG %s: %w", nsgID, err)
+		}
+	}
+
+	return nil
+}
+
+func securityRuleKeyFromRule(rule core.SecurityRule) (securityRuleKey, bool) {
+	if lo.FromPtr(rule.Protocol) != ociSecurityRuleProtocolTCP ||
+		rule.TcpOptions == nil ||
+		rule.TcpOptions.DestinationPortRange == nil {
+		return securityRuleKey{}, false
+	}
+
+	portRange := rule.TcpOptions.DestinationPortRange
+	if lo.FromPtr(portRange.Min) != lo.FromPtr(portRange.Max) {
+		return securityRuleKey{}, false
+	}
+
+	return securityRuleKey{
+		source: lo.FromPtr(rule.Source),
+		port:   lo.FromPtr(portRange.Min),
+	}, true
+}
+
+// ociSecurityRuleDescription is used to identify rules created by the controller for the gateway.
+func ociSecurityRuleDescription(gateway *gatewayv1.Gateway) string {
+	return fmt.Sprintf("%s:%s/%s", ociSecurityRuleDescriptionPrefix, gateway.Namespace, gateway.Name)
+}
+
+// gatewayNetworkSecurityGroupAnnotationValue returns the value of the GatewayNetworkSecurityGroupAnnotation,
+// so rules of the previous NSG can be removed once the NSG of the config changes.
+func gatewayNetworkSecurityGroupAnnotationValue(config types.GatewayConfig) string {
+	if config.Spec.NetworkSecurityGroup == nil {
+		return ""
+	}
+	return config.Spec.NetworkSecurityGroup.ID
+}
+
+type ociNetworkSecurityGroupModelDeps struct {
+	dig.In
+
+	RootLogger *slog.Logger
+	OciClient  ociVirtualNetworkClient
+}
+
+func newOciNetworkSecurityGroupModel(deps ociNetworkSecurityGroupModelDeps) *ociNetworkSecurityGroupModelImpl {
+	return &ociNetworkSecurityGroupModelImpl{
+		logger:    deps.RootLogger.WithGroup("oci-nsg-model"),
+		ociClient: deps.OciClient,
+	}
+}
@@ -0,0 +1,289 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestOciNetworkSecurityGroupModelImpl(t *testing.T) {
+	newMockDeps := func(t *testing.T) ociNetworkSecurityGroupModelDeps {
+		return ociNetworkSecurityGroupModelDeps{
+			RootLogger: diag.RootTestLogger(),
+			OciClient:  NewMockociVirtualNetworkClient(t),
+		}
+	}
+
+	makeSecurityRule := func(description, source string, port int) core.SecurityRule {
+		return core.SecurityRule{
+			Id:          new(faker.New().UUID().V4()),
+			Direction:   core.SecurityRuleDirectionIngress,
+			Protocol:    new(ociSecurityRuleProtocolTCP),
+			Description: &description,
+			Source:      &source,
+			TcpOptions: &core.TcpOptions{
+				DestinationPortRange: &core.PortRange{Min: &port, Max: &port},
+			},
+		}
+	}
+
+	t.Run("reconcileListenersSecurityRules", func(t *testing.T) {
+		t.Run("adds missing rules and removes stale ones", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciNetworkSecurityGroupModel(deps)
+
+			gateway := newRandomGateway()
+			gateway.Spec.Listeners = []gatewayv1.Listener{
+				makeRandomListener(func(l *gatewayv1.Listener) { l.Port = 80 }),
+				makeRandomListener(func(l *gatewayv1.Listener) { l.Port = 443 }),
+			}
+			nsgID := faker.New().UUID().V4()
+			description := ociSecurityRuleDescription(gateway)
+			nextPage := faker.New().UUID().V4()
+
+			keptRule := makeSecurityRule(description, defaultSecurityRuleSourceCIDR, 80)
+			staleRule := makeSecurityRule(description, defaultSecurityRuleSourceCIDR, 8080)
+			foreignRule := makeSecurityRule(faker.New().Lorem().Sentence(3), defaultSecurityRuleSourceCIDR, 22)
+
+			ociClient, _ := deps.OciClient.(*MockociVirtualNetworkClient)
+			ociClient.EXPECT().
+				ListNetworkSecurityGroupSecurityRules(t.Context(), core.ListNetworkSecurityGroupSecurityRulesRequest{
+					NetworkSecurityGroupId: &nsgID,
+					Direction:              core.ListNetworkSecurityGroupSecurityRulesDirectionIngress,
+				}).
+				Return(core.ListNetworkSecurityGroupSecurityRulesResponse{
+					Items:       []core.SecurityRule{keptRule, foreignRule},
+					OpcNextPage: &nextPage,
+				}, nil).
+				Once()
+			ociClient.EXPECT().
+				ListNetworkSecurityGroupSecurityRules(t.Context(), core.ListNetworkSecurityGroupSecurityRulesRequest{
+					NetworkSecurityGroupId: &nsgID,
+					Direction:              core.ListNetworkSecurityGroupSecurityRulesDirectionIngress,
+					Page:                   &nextPage,
+				}).
+				Return(core.ListNetworkSecurityGroupSecurityRulesResponse{
+					Items: []core.SecurityRule{staleRule},
+				}, nil).
+				Once()
+
+			ociClient.EXPECT().
+				AddNetworkSecurityGroupSecurityRules(t.Context(), core.AddNetworkSecurityGroupSecurityRulesRequest{
+					NetworkSecurityGroupId: &nsgID,
+					AddNetworkSecurityGroupSecurityRulesDetails: core.AddNetworkSecurityGroupSecurityRulesDetails{
+						SecurityRules: []core.AddSecurityRuleDetails{
+							{
+								Direction:   core.AddSecurityRuleDetailsDirectionIngress,
+								Protocol:    new(ociSecurityRuleProtocolTCP),
+								Description: &description,
+								Source:      new(defaultSecurityRuleSourceCIDR),
+								SourceType:  core.AddSecurityRuleDetailsSourceTypeCidrBlock,
+								IsStateless: new(false),
+								TcpOptions: &core.TcpOptions{
+									DestinationPortRange: &core.PortRange{Min: new(443), Max: new(443)},
+								},
+							},
+						},
+					},
+				}).
+				Return(core.AddNetworkSecurityGroupSecurityRulesResponse{}, nil).
+				Once()
+			ociClient.EXPECT().
+				RemoveNetworkSecurityGroupSecurityRules(t.Context(), core.RemoveNetworkSecurityGroupSecurityRulesRequest{
+					NetworkSecurityGroupId: &nsgID,
+					RemoveNetworkSecurityGroupSecurityRulesDetails: core.RemoveNetworkSecurityGroupSecurityRulesDetails{
+						SecurityRuleIds: []string{*staleRule.Id},
+					},
+				}).
+				Return(core.RemoveNetworkSecurityGroupSecurityRulesResponse{}, nil).
+				Once()
+
+			err := model.reconcileListenersSecurityRules(t.Context(), reconcileListenersSecurityRulesParams{
+				gateway:              gateway,
+				networkSecurityGroup: types.GatewayConfigNetworkSecurityGroup{ID: nsgID},
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("creates rule per source CIDR in chunks", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciNetworkSecurityGroupModel(deps)
+
+			gateway := newRandomGateway()
+			sources := make([]string, maxSecurityRulesPerRequest+1)
+			for i := range sources {
+				sources[i] = fmt.Sprintf("10.0.%d.0/24", i)
+			}
+
+			ociClient, _ := deps.OciClient.(*MockociVirtualNetworkClient)
+			ociClient.EXPECT().
+				ListNetworkSecurityGroupSecurityRules(t.Context(), mock.Anything).
+				Return(core.ListNetworkSecurityGroupSecurityRulesResponse{}, nil).
+				Once()
+
+			var addedRules []core.AddSecurityRuleDetails
+			ociClient.EXPECT().
+				AddNetworkSecurityGroupSecurityRules(t.Context(), mock.Anything).
+				RunAndReturn(func(
+					_ context.Context,
+					req core.AddNetworkSecurityGroupSecurityRulesRequest,
+				) (core.AddNetworkSecurityGroupSecurityRulesResponse, error) {
+					assert.LessOrEqual(t, len(req.AddNetworkSecurityGroupSecurityRulesDetails.SecurityRules),
+						maxSecurityRulesPerRequest)
+					addedRules = append(addedRules, req.AddNetworkSecurityGroupSecurityRulesDetails.SecurityRules...)
+					return core.AddNetworkSecurityGroupSecurityRulesResponse{}, nil
+				}).
+				Times(2)
+
+			err := model.reconcileListenersSecurityRules(t.Context(), reconcileListenersSecurityRulesParams{
+				gateway: gateway,
+				networkSecurityGroup: types.GatewayConfigNetworkSecurityGroup{
+					ID:          faker.New().UUID().V4(),
+					SourceCIDRs: sources,
+				},
+			})
+
+			require.NoError(t, err)
+			assert.Len(t, addedRules, len(sources))
+		})
+
+		t.Run("list rules failure", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciNetworkSecurityGroupModel(deps)
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+
+			ociClient, _ := deps.OciClient.(*MockociVirtualNetworkClient)
+			ociClient.EXPECT().
+				ListNetworkSecurityGroupSecurityRules(t.Context(), mock.Anything).
+				Return(core.ListNetworkSecurityGroupSecurityRulesResponse{}, wantErr).
+				Once()
+
+			err := model.reconcileListenersSecurityRules(t.Context(), reconcileListenersSecurityRulesParams{
+				gateway:              newRandomGateway(),
+				networkSecurityGroup: types.GatewayConfigNetworkSecurityGroup{ID: faker.New().UUID().V4()},
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+
+		t.Run("remove rules failure", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciNetworkSecurityGroupModel(deps)
+			gateway := newRandomGateway()
+			gateway.Spec.Listeners = nil
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+
+			ociClient, _ := deps.OciClient.(*MockociVirtualNetworkClient)
+			ociClient.EXPECT().
+				ListNetworkSecurityGroupSecurityRules(t.Context(), mock.Anything).
+				Return(core.ListNetworkSecurityGroupSecurityRulesResponse{
+					Items: []core.SecurityRule{
+						makeSecurityRule(ociSecurityRuleDescription(gateway), defaultSecurityRuleSourceCIDR, 80),
+					},
+				}, nil).
+				Once()
+			ociClient.EXPECT().
+				RemoveNetworkSecurityGroupSecurityRules(t.Context(), mock.Anything).
+				Return(core.RemoveNetworkSecurityGroupSecurityRulesResponse{}, wantErr).
+				Once()
+
+			err := model.reconcileListenersSecurityRules(t.Context(), reconcileListenersSecurityRulesParams{
+				gateway:              gateway,
+				networkSecurityGroup: types.GatewayConfigNetworkSecurityGroup{ID: faker.New().UUID().V4()},
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("removeListenersSecurityRules", func(t *testing.T) {
+		t.Run("removes rules of the gateway", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciNetworkSecurityGroupModel(deps)
+			gateway := newRandomGateway()
+			nsgID := faker.New().UUID().V4()
+			ownRule := makeSecurityRule(ociSecurityRuleDescription(gateway), defaultSecurityRuleSourceCIDR, 80)
+			foreignRule := makeSecurityRule(faker.New().Lorem().Sentence(3), defaultSecurityRuleSourceCIDR, 22)
+
+			ociClient, _ := deps.OciClient.(*MockociVirtualNetworkClient)
+			ociClient.EXPECT().
+				ListNetworkSecurityGroupSecurityRules(t.Context(), core.ListNetworkSecurityGroupSecurityRulesRequest{
+					NetworkSecurityGroupId: &nsgID,
+					Direction:              core.ListNetworkSecurityGroupSecurityRulesDirectionIngress,
+				}).
+				Return(core.ListNetworkSecurityGroupSecurityRulesResponse{
+					Items: []core.SecurityRule{ownRule, foreignRule},
+				}, nil).
+				Once()
+			ociClient.EXPECT().
+				RemoveNetworkSecurityGroupSecurityRules(t.Context(), core.RemoveNetworkSecurityGroupSecurityRulesRequest{
+					NetworkSecurityGroupId: &nsgID,
+					RemoveNetworkSecurityGroupSecurityRulesDetails: core.RemoveNetworkSecurityGroupSecurityRulesDetails{
+						SecurityRuleIds: []string{*ownRule.Id},
+					},
+				}).
+				Return(core.RemoveNetworkSecurityGroupSecurityRulesResponse{}, nil).
+				Once()
+
+			err := model.removeListenersSecurityRules(t.Context(), removeListenersSecurityRulesParams{
+				gateway:                gateway,
+				networkSecurityGroupID: nsgID,
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("ignores missing NSG", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciNetworkSecurityGroupModel(deps)
+
+			ociClient, _ := deps.OciClient.(*MockociVirtualNetworkClient)
+			ociClient.EXPECT().
+				ListNetworkSecurityGroupSecurityRules(t.Context(), mock.Anything).
+				Return(core.ListNetworkSecurityGroupSecurityRulesResponse{}, ociapi.NewRandomServiceError(
+					ociapi.RandomServiceErrorWithStatusCode(http.StatusNotFound),
+				)).
+				Once()
+
+			err := model.removeListenersSecurityRules(t.Context(), removeListenersSecurityRulesParams{
+				gateway:                newRandomGateway(),
+				networkSecurityGroupID: faker.New().UUID().V4(),
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("list rules failure", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newOciNetworkSecurityGroupModel(deps)
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+
+			ociClient, _ := deps.OciClient.(*MockociVirtualNetworkClient)
+			ociClient.EXPECT().
+				ListNetworkSecurityGroupSecurityRules(t.Context(), mock.Anything).
+				Return(core.ListNetworkSecurityGroupSecurityRulesResponse{}, wantErr).
+				Once()
+
+			err := model.removeListenersSecurityRules(t.Context(), removeListenersSecurityRulesParams{
+				gateway:                newRandomGateway(),
+				networkSecurityGroupID: faker.New().UUID().V4(),
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+}
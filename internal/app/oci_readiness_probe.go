@@ -0,0 +1,130 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"go.uber.org/dig"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+// OCIReadinessProbe verifies that the OCI API is usable by the controller: the
+// credentials are accepted and the load balancers of the GatewayConfigs in use are
+// reachable. The probe result is cached for the probe interval, so readiness requests
+// of the kubelet do not hit the OCI API on every call.
+type OCIReadinessProbe struct {
+	client             k8sClient
+	loadBalancerClient ociLoadBalancerClient
+	logger             *slog.Logger
+	metrics            *ociReadinessMetrics
+	interval           time.Duration
+	now                func() time.Time
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	lastErr   error
+}
+
+// OCIReadinessProbeDeps contains the dependencies for the OCIReadinessProbe.
+type OCIReadinessProbeDeps struct {
+	dig.In
+
+	RootLogger            *slog.Logger
+	K8sClient             k8sClient
+	OciLoadBalancerClient ociLoadBalancerClient
+	Metrics               *ociReadinessMetrics `optional:"true"`
+
+	// Interval to cache the probe result for. The probe is disabled if zero.
+	Interval time.Duration `name:"config.controller.ociReadinessInterval"`
+}
+
+// NewOCIReadinessProbe creates a new OCIReadinessProbe.
+func NewOCIReadinessProbe(deps OCIReadinessProbeDeps) *OCIReadinessProbe {
+	return &OCIReadinessProbe{
+		client:             deps.K8sClient,
+		loadBalancerClient: deps.OciLoadBalancerClient,
+		logger:             deps.RootLogger.WithGroup("oci-readiness-probe"),
+		metrics:            deps.Metrics,
+		interval:           deps.Interval,
+		now:                time.Now,
+	}
+}
+
+// Check implements the healthz.Checker of the readiness endpoint. It fails if the OCI
+// credentials are rejected or none of the load balancers of GatewayConfigs in use is
+// reachable. Individual unreachable load balancers are reported via logs and metrics
+// only, so a single misconfigured GatewayConfig does not take the controller out.
+func (p *OCIReadinessProbe) Check(req *http.Request) error {
+	if p.interval == 0 {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	if !p.checkedAt.IsZero() && now.Sub(p.checkedAt) < p.interval {
+		return p.lastErr
+	}
+	p.lastErr = p.probe(req.Context())
+	p.checkedAt = now
+	return p.lastErr
+}
+
+func (p *OCIReadinessProbe) probe(ctx context.Context) error {
+	var configs types.GatewayConfigList
+	if err := p.client.List(ctx, &configs); err != nil {
+		return fmt.Errorf("failed to list GatewayConfigs: %w", err)
+	}
+
+	p.metrics.reset()
+	var probed int
+	var degraded []string
+	var credentialsRejected bool
+	for _, config := range configs.Items {
+		if !meta.IsStatusConditionPresentAndEqual(config.Status.Conditions,
+			GatewayConfigConditionInUse, metav1.ConditionTrue) {
+			continue
+		}
+		probed++
+		_, err := p.loadBalancerClient.GetLoadBalancer(ociRegionContext(ctx, config),
+			loadbalancer.GetLoadBalancerRequest{LoadBalancerId: &config.Spec.LoadBalancerID},
+		)
+		p.metrics.setReachable(config.Namespace, config.Name, config.Spec.LoadBalancerID, err == nil)
+		if err == nil {
+			continue
+		}
+
+		p.logger.WarnContext(ctx, "Load balancer of the GatewayConfig is not reachable",
+			slog.String("gatewayConfig", config.Namespace+"/"+config.Name),
+			slog.String("loadBalancerId", config.Spec.LoadBalancerID),
+			diag.ErrAttr(err),
+		)
+		degraded = append(degraded, fmt.Sprintf("%s/%s: %s", config.Namespace, config.Name, err))
+		if serviceErr, ok := common.IsServiceError(err); ok &&
+			serviceErr.GetHTTPStatusCode() == http.StatusUnauthorized {
+			credentialsRejected = true
+		}
+	}
+
+	switch {
+	case credentialsRejected:
+		return fmt.Errorf("OCI API rejected the credentials: %s", strings.Join(degraded, "; "))
+	case probed > 0 && len(degraded) == probed:
+		return fmt.Errorf("load balancers of all GatewayConfigs in use are unreachable: %s",
+			strings.Join(degraded, "; "))
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,153 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestOCIReadinessProbe(t *testing.T) {
+	makeConfig := func(inUse bool) types.GatewayConfig {
+		fake := faker.New()
+		config := makeRandomGatewayConfig()
+		config.Namespace = fake.Internet().Slug()
+		config.Name = fake.Internet().Slug()
+		status := metav1.ConditionFalse
+		if inUse {
+			status = metav1.ConditionTrue
+		}
+		meta.SetStatusCondition(&config.Status.Conditions, metav1.Condition{
+			Type:   GatewayConfigConditionInUse,
+			Status: status,
+			Reason: fake.Lorem().Word(),
+		})
+		return config
+	}
+
+	newProbe := func(t *testing.T, configs ...types.GatewayConfig) (
+		*OCIReadinessProbe, *MockociLoadBalancerClient, *ociReadinessMetrics,
+	) {
+		metrics, err := newOCIReadinessMetrics()
+		require.NoError(t, err)
+		metrics.reset()
+		k8sClient := NewMockk8sClient(t)
+		k8sClient.EXPECT().List(mock.Anything, mock.AnythingOfType("*types.GatewayConfigList")).
+			RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+				list.(*types.GatewayConfigList).Items = configs
+				return nil
+			}).Maybe()
+		lbClient := NewMockociLoadBalancerClient(t)
+		probe := NewOCIReadinessProbe(OCIReadinessProbeDeps{
+			RootLogger:            diag.RootTestLogger(),
+			K8sClient:             k8sClient,
+			OciLoadBalancerClient: lbClient,
+			Metrics:               metrics,
+			Interval:              30 * time.Second,
+		})
+		return probe, lbClient, metrics
+	}
+
+	expectGetLoadBalancer := func(lbClient *MockociLoadBalancerClient, config types.GatewayConfig, err error) {
+		lbClient.EXPECT().GetLoadBalancer(mock.Anything, loadbalancer.GetLoadBalancerRequest{
+			LoadBalancerId: &config.Spec.LoadBalancerID,
+		}).Return(loadbalancer.GetLoadBalancerResponse{}, err).Once()
+	}
+
+	newRequest := func(t *testing.T) *http.Request {
+		return httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/readyz", nil)
+	}
+
+	t.Run("should be ready if load balancers of configs in use are reachable", func(t *testing.T) {
+		config := makeConfig(true)
+		probe, lbClient, metrics := newProbe(t, config, makeConfig(false))
+		expectGetLoadBalancer(lbClient, config, nil)
+
+		require.NoError(t, probe.Check(newRequest(t)))
+		assert.InDelta(t, 1, testutil.ToFloat64(metrics.reachable.WithLabelValues(
+			config.Namespace, config.Name, config.Spec.LoadBalancerID)), 0)
+	})
+
+	t.Run("should be ready if no config is in use", func(t *testing.T) {
+		probe, _, _ := newProbe(t, makeConfig(false))
+
+		require.NoError(t, probe.Check(newRequest(t)))
+	})
+
+	t.Run("should stay ready if only some load balancers are unreachable", func(t *testing.T) {
+		reachable := makeConfig(true)
+		unreachable := makeConfig(true)
+		probe, lbClient, metrics := newProbe(t, reachable, unreachable)
+		expectGetLoadBalancer(lbClient, reachable, nil)
+		expectGetLoadBalancer(lbClient, unreachable, ociapi.NewRandomServiceError(
+			ociapi.RandomServiceErrorWithStatusCode(http.StatusNotFound),
+		))
+
+		require.NoError(t, probe.Check(newRequest(t)))
+		assert.InDelta(t, 0, testutil.ToFloat64(metrics.reachable.WithLabelValues(
+			unreachable.Namespace, unreachable.Name, unreachable.Spec.LoadBalancerID)), 0)
+	})
+
+	t.Run("should not be ready if all load balancers are unreachable", func(t *testing.T) {
+		config := makeConfig(true)
+		probe, lbClient, _ := newProbe(t, config)
+		expectGetLoadBalancer(lbClient, config, errors.New(faker.New().Lorem().Sentence(3)))
+
+		err := probe.Check(newRequest(t))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), config.Namespace+"/"+config.Name)
+	})
+
+	t.Run("should not be ready if credentials are rejected", func(t *testing.T) {
+		rejected := makeConfig(true)
+		reachable := makeConfig(true)
+		probe, lbClient, _ := newProbe(t, rejected, reachable)
+		expectGetLoadBalancer(lbClient, rejected, ociapi.NewRandomServiceError(
+			ociapi.RandomServiceErrorWithStatusCode(http.StatusUnauthorized),
+		))
+		expectGetLoadBalancer(lbClient, reachable, nil)
+
+		err := probe.Check(newRequest(t))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "credentials")
+	})
+
+	t.Run("should cache the result for the interval", func(t *testing.T) {
+		config := makeConfig(true)
+		probe, lbClient, _ := newProbe(t, config)
+		now := time.Now()
+		probe.now = func() time.Time { return now }
+		wantErr := errors.New(faker.New().Lorem().Sentence(3))
+		expectGetLoadBalancer(lbClient, config, wantErr)
+
+		require.Error(t, probe.Check(newRequest(t)))
+		require.Error(t, probe.Check(newRequest(t)))
+
+		now = now.Add(probe.interval)
+		expectGetLoadBalancer(lbClient, config, nil)
+		require.NoError(t, probe.Check(newRequest(t)))
+	})
+
+	t.Run("should not probe if disabled", func(t *testing.T) {
+		probe, _, _ := newProbe(t, makeConfig(true))
+		probe.interval = 0
+
+		require.NoError(t, probe.Check(newRequest(t)))
+	})
+}
@@ -0,0 +1,17 @@
+package app
+
+import (
+	"context"
+
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+// ociRegionContext makes OCI requests made with the returned context go to the region
+// of the GatewayConfig. The controller region is used if the config does not set one.
+func ociRegionContext(ctx context.Context, config types.GatewayConfig) context.Context {
+	if config.Spec.Region == "" {
+		return ctx
+	}
+	return ociapi.WithRegion(ctx, config.Spec.Region)
+}
@@ -0,0 +1,27 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestOciRegionContext(t *testing.T) {
+	t.Run("keeps context when region is not set", func(t *testing.T) {
+		ctx := t.Context()
+
+		assert.Equal(t, ctx, ociRegionContext(ctx, types.GatewayConfig{}))
+	})
+
+	t.Run("sets region of the config", func(t *testing.T) {
+		config := types.GatewayConfig{Spec: types.GatewayConfigSpec{Region: faker.New().Lorem().Word()}}
+
+		ctx := ociRegionContext(t.Context(), config)
+
+		assert.Equal(t, config.Spec.Region, ociapi.RegionFromContext(ctx))
+	})
+}
@@ -0,0 +1,123 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+const defaultOCIResourceNameSeparator = "-"
+
+// supersededListenerNameSuffixPattern matches the hash suffix of the listener names
+// programmed with the naming schemes, see ociResourceNaming.listenerName.
+var supersededListenerNameSuffixPattern = regexp.MustCompile(`^[-_][0-9a-f]+$`)
+
+// ociResourceNaming is the naming scheme of OCI listeners and backend sets programmed for the gateway.
+// The zero value is the default scheme, it produces names of the previous releases,
+// so listeners and backend sets programmed before the scheme was introduced keep their names.
+type ociResourceNaming struct {
+	separator        string
+	hashSuffixLength int
+}
+
+func ociResourceNamingFromConfig(config types.GatewayConfig) ociResourceNaming {
+	if config.Spec.Naming == nil {
+		return ociResourceNaming{}
+	}
+	return ociResourceNaming{
+		separator:        config.Spec.Naming.Separator,
+		hashSuffixLength: config.Spec.Naming.HashSuffixLength,
+	}
+}
+
+func (n ociResourceNaming) isDefault() bool {
+	return n.nameSeparator() == defaultOCIResourceNameSeparator && n.hashSuffixLength <= 0
+}
+
+func (n ociResourceNaming) nameSeparator() string {
+	if n.separator == "" {
+		return defaultOCIResourceNameSeparator
+	}
+	return n.separator
+}
+
+// joinNameParts joins the name parts with the separator and appends the hash suffix if configured.
+func (n ociResourceNaming) joinNameParts(parts ...string) string {
+	name := strings.Join(parts, n.nameSeparator())
+	if n.hashSuffixLength <= 0 {
+		return name
+	}
+	var identity strings.Builder
+	for _, part := range parts {
+		identity.WriteString(strconv.Itoa(len(part)) + ":" + part + ";")
+	}
+	sum := sha256.Sum256([]byte(identity.String()))
+	hash := hex.EncodeToString(sum[:])
+	return name + n.nameSeparator() + hash[:min(n.hashSuffixLength, len(hash))]
+}
+
+// backendSetName returns the name of the backend set built from the name parts,
+// for example the namespace, name and port of the backend Service.
+func (n ociResourceNaming) backendSetName(parts ...string) string {
+	return ociapi.ConstructOCIResourceName(n.joinNameParts(parts...), ociapi.OCIResourceNameConfig{
+		MaxLength: maxBackendSetNameLength,
+	})
+}
+
+// withLegacy returns the scheme followed by the default one if they differ. Backend sets
+// programmed before the scheme was changed are named after the default scheme and are
+// cleaned up under both names.
+func (n ociResourceNaming) withLegacy() []ociResourceNaming {
+	if n.isDefault() {
+		return []ociResourceNaming{n}
+	}
+	return []ociResourceNaming{n, {}}
+}
+
+// listenerName returns the name of the OCI listener programmed for the gateway listener.
+// The routing policy of the listener is named after it, see listenerPolicyName.
+func (n ociResourceNaming) listenerName(namePrefix string, listenerName gatewayv1.SectionName) string {
+	if n.hashSuffixLength <= 0 {
+		return ociGatewayListenerName(namePrefix, listenerName)
+	}
+	return namePrefix + n.joinNameParts(string(listenerName))
+}
+
+// listenerSectionName returns the gateway listener name of the OCI listener programmed for the gateway.
+func (n ociResourceNaming) listenerSectionName(namePrefix, ociListenerName string) gatewayv1.SectionName {
+	name := strings.TrimPrefix(ociListenerName, namePrefix)
+	suffixLength := len(n.nameSeparator()) + min(n.hashSuffixLength, sha256.Size*2)
+	if n.hashSuffixLength > 0 && len(name) > suffixLength {
+		sectionName := gatewayv1.SectionName(name[:len(name)-suffixLength])
+		if n.listenerName(namePrefix, sectionName) == ociListenerName {
+			return sectionName
+		}
+	}
+	return gatewayv1.SectionName(name)
+}
+
+// supersedesListener reports whether the OCI listener was programmed for the gateway listener
+// with another naming scheme. OCI listeners can not be renamed and the superseded listener
+// holds the port, so it is replaced by the listener named after the scheme.
+func (n ociResourceNaming) supersedesListener(
+	namePrefix string,
+	gatewayListener gatewayv1.Listener,
+	ociListenerName string,
+	ociListener loadbalancer.Listener,
+) bool {
+	if ociListenerName == n.listenerName(namePrefix, gatewayListener.Name) ||
+		lo.FromPtr(ociListener.Port) != int(gatewayListener.Port) {
+		return false
+	}
+	suffix, ok := strings.CutPrefix(ociListenerName, ociGatewayListenerName(namePrefix, gatewayListener.Name))
+	return ok && (suffix == "" || supersededListenerNameSuffixPattern.MatchString(suffix))
+}
@@ -0,0 +1,137 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestOCIResourceNaming(t *testing.T) {
+	t.Run("backendSetName", func(t *testing.T) {
+		t.Run("joins parts with dash by default", func(t *testing.T) {
+			fake := faker.New()
+			namespace := fake.Lorem().Word() + "-ns"
+			name := fake.Internet().Slug() + "-svc"
+
+			got := ociResourceNaming{}.backendSetName(namespace, name)
+
+			assert.Equal(t, ociapi.ConstructOCIResourceName(
+				fmt.Sprintf("%s-%s", namespace, name),
+				ociapi.OCIResourceNameConfig{MaxLength: maxBackendSetNameLength},
+			), got)
+		})
+
+		t.Run("truncates long names", func(t *testing.T) {
+			fake := faker.New()
+			namespace := fake.Numerify("####################")
+			name := fake.Numerify("####################")
+
+			got := ociResourceNaming{}.backendSetName(namespace, name)
+
+			assert.Len(t, got, maxBackendSetNameLength)
+			assert.Equal(t, ociapi.ConstructOCIResourceName(
+				namespace+"-"+name,
+				ociapi.OCIResourceNameConfig{MaxLength: maxBackendSetNameLength},
+			), got)
+		})
+
+		t.Run("joins parts with configured separator", func(t *testing.T) {
+			got := ociResourceNaming{separator: "_"}.backendSetName("apps", "web", "8080")
+
+			assert.Equal(t, "apps_web_8080", got)
+		})
+
+		t.Run("appends hash suffix", func(t *testing.T) {
+			naming := ociResourceNaming{hashSuffixLength: 8}
+
+			got := naming.backendSetName("apps", "web-api", "80")
+			other := naming.backendSetName("apps-web", "api", "80")
+
+			assert.Regexp(t, `^apps-web-api-80-[0-9a-f]{8}$`, got)
+			assert.NotEqual(t, got, other)
+			assert.Equal(t, got, naming.backendSetName("apps", "web-api", "80"))
+		})
+	})
+
+	t.Run("ociResourceNamingFromConfig", func(t *testing.T) {
+		config := makeRandomGatewayConfig()
+		assert.Equal(t, ociResourceNaming{}, ociResourceNamingFromConfig(config))
+		assert.True(t, ociResourceNamingFromConfig(config).isDefault())
+
+		config.Spec.Naming = &types.OCIResourceNaming{Separator: "_", HashSuffixLength: 4}
+		assert.Equal(t,
+			ociResourceNaming{separator: "_", hashSuffixLength: 4},
+			ociResourceNamingFromConfig(config),
+		)
+		assert.False(t, ociResourceNamingFromConfig(config).isDefault())
+	})
+
+	t.Run("withLegacy", func(t *testing.T) {
+		assert.Equal(t, []ociResourceNaming{{}}, ociResourceNaming{}.withLegacy())
+		assert.Equal(t,
+			[]ociResourceNaming{{separator: "-"}},
+			ociResourceNaming{separator: "-"}.withLegacy(),
+		)
+		assert.Equal(t,
+			[]ociResourceNaming{{hashSuffixLength: 4}, {}},
+			ociResourceNaming{hashSuffixLength: 4}.withLegacy(),
+		)
+	})
+
+	t.Run("listenerName", func(t *testing.T) {
+		t.Run("keeps listener name without hash suffix", func(t *testing.T) {
+			listenerName := gatewayv1.SectionName(faker.New().Internet().Slug())
+
+			assert.Equal(t, "edge_"+string(listenerName), ociResourceNaming{}.listenerName("edge_", listenerName))
+			assert.Equal(t,
+				"edge_"+string(listenerName),
+				ociResourceNaming{separator: "_"}.listenerName("edge_", listenerName),
+			)
+		})
+
+		t.Run("appends hash suffix", func(t *testing.T) {
+			naming := ociResourceNaming{separator: "_", hashSuffixLength: 8}
+
+			got := naming.listenerName("edge_", "https")
+
+			assert.Regexp(t, `^edge_https_[0-9a-f]{8}$`, got)
+			assert.Regexp(t, `^edge_https_[0-9a-f]{8}_policy$`, listenerPolicyName(got))
+			assert.NotEqual(t, got, naming.listenerName("edge_", "http"))
+		})
+	})
+
+	t.Run("listenerSectionName", func(t *testing.T) {
+		naming := ociResourceNaming{hashSuffixLength: 8}
+		listenerName := gatewayv1.SectionName(faker.New().Internet().Slug())
+
+		assert.Equal(t, listenerName, naming.listenerSectionName("edge_", naming.listenerName("edge_", listenerName)))
+		assert.Equal(t, listenerName, naming.listenerSectionName("edge_", "edge_"+string(listenerName)))
+		assert.Equal(t, listenerName, ociResourceNaming{}.listenerSectionName("edge_", "edge_"+string(listenerName)))
+	})
+
+	t.Run("supersedesListener", func(t *testing.T) {
+		naming := ociResourceNaming{hashSuffixLength: 8}
+		gatewayListener := gatewayv1.Listener{Name: "https", Port: 443}
+		listenerAt := func(port int) loadbalancer.Listener {
+			return loadbalancer.Listener{Port: new(port)}
+		}
+
+		assert.True(t, naming.supersedesListener("edge_", gatewayListener, "edge_https", listenerAt(443)))
+		assert.True(t, ociResourceNaming{}.supersedesListener(
+			"edge_", gatewayListener, naming.listenerName("edge_", "https"), listenerAt(443),
+		))
+		assert.False(t, naming.supersedesListener(
+			"edge_", gatewayListener, naming.listenerName("edge_", "https"), listenerAt(443),
+		))
+		assert.False(t, naming.supersedesListener("edge_", gatewayListener, "edge_https", listenerAt(8443)))
+		assert.False(t, naming.supersedesListener("edge_", gatewayListener, "edge_https-admin", listenerAt(443)))
+		assert.False(t, naming.supersedesListener("edge_", gatewayListener, "other_https", listenerAt(443)))
+	})
+}
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// pausedGatewayRequeueInterval is how often routes of a paused gateway are rechecked.
+// Gateway changes do not trigger route reconciliation, so routes would not be programmed
+// after the gateway is resumed otherwise.
+const pausedGatewayRequeueInterval = time.Minute
+
+func isPaused(obj metav1.Object) bool {
+	return obj.GetAnnotations()[PausedAnnotation] == "true"
+}
+
+type pausedCondition struct {
+	status  metav1.ConditionStatus
+	reason  string
+	message string
+}
+
+// pausedConditionChange returns the Paused condition to report. The condition is only
+// reported once the resource has been paused. Returns false if the current condition
+// is up to date.
+func pausedConditionChange(
+	conditions []metav1.Condition,
+	generation int64,
+	paused bool,
+) (pausedCondition, bool) {
+	existing := meta.FindStatusCondition(conditions, ConditionPaused)
+	if paused {
+		if existing != nil && existing.Status == metav1.ConditionTrue && existing.ObservedGeneration == generation {
+			return pausedCondition{}, false
+		}
+		return pausedCondition{
+			status:  metav1.ConditionTrue,
+			reason:  ReasonPaused,
+			message: fmt.Sprintf("Programming is paused with %s annotation", PausedAnnotation),
+		}, true
+	}
+	if existing == nil || existing.Status == metav1.ConditionFalse {
+		return pausedCondition{}, false
+	}
+	return pausedCondition{
+		status:  metav1.ConditionFalse,
+		reason:  ReasonResumed,
+		message: "Programming is resumed",
+	}, true
+}
+
+// pausedGatewayRequeue makes sure routes of the paused gateway are programmed soon after it is resumed.
+func pausedGatewayRequeue(result reconcile.Result) reconcile.Result {
+	if result.RequeueAfter == 0 || result.RequeueAfter > pausedGatewayRequeueInterval {
+		result.RequeueAfter = pausedGatewayRequeueInterval
+	}
+	return result
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestPausedConditionChange(t *testing.T) {
+	t.Run("should report paused condition", func(t *testing.T) {
+		generation := rand.Int64()
+
+		condition, changed := pausedConditionChange(nil, generation, true)
+
+		assert.True(t, changed)
+		assert.Equal(t, metav1.ConditionTrue, condition.status)
+		assert.Equal(t, ReasonPaused, condition.reason)
+	})
+
+	t.Run("should report paused condition for new generation", func(t *testing.T) {
+		generation := rand.Int64()
+		conditions := []metav1.Condition{
+			{Type: ConditionPaused, Status: metav1.ConditionTrue, ObservedGeneration: generation - 1},
+		}
+
+		_, changed := pausedConditionChange(conditions, generation, true)
+
+		assert.True(t, changed)
+	})
+
+	t.Run("should not report up to date paused condition", func(t *testing.T) {
+		generation := rand.Int64()
+		conditions := []metav1.Condition{
+			{Type: ConditionPaused, Status: metav1.ConditionTrue, ObservedGeneration: generation},
+		}
+
+		_, changed := pausedConditionChange(conditions, generation, true)
+
+		assert.False(t, changed)
+	})
+
+	t.Run("should report resumed condition", func(t *testing.T) {
+		generation := rand.Int64()
+		conditions := []metav1.Condition{
+			{Type: ConditionPaused, Status: metav1.ConditionTrue, ObservedGeneration: generation},
+		}
+
+		condition, changed := pausedConditionChange(conditions, generation, false)
+
+		assert.True(t, changed)
+		assert.Equal(t, metav1.ConditionFalse, condition.status)
+		assert.Equal(t, ReasonResumed, condition.reason)
+	})
+
+	t.Run("should not report condition for never paused resource", func(t *testing.T) {
+		_, changed := pausedConditionChange(nil, rand.Int64(), false)
+
+		assert.False(t, changed)
+	})
+}
+
+func TestPausedGatewayRequeue(t *testing.T) {
+	assert.Equal(t,
+		reconcile.Result{RequeueAfter: pausedGatewayRequeueInterval},
+		pausedGatewayRequeue(reconcile.Result{}),
+	)
+	assert.Equal(t,
+		reconcile.Result{RequeueAfter: pausedGatewayRequeueInterval},
+		pausedGatewayRequeue(reconcile.Result{RequeueAfter: 2 * pausedGatewayRequeueInterval}),
+	)
+	assert.Equal(t,
+		reconcile.Result{RequeueAfter: pausedGatewayRequeueInterval / 2},
+		pausedGatewayRequeue(reconcile.Result{RequeueAfter: pausedGatewayRequeueInterval / 2}),
+	)
+}
@@ -0,0 +1,140 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const podReadinessGateRequeueInterval = 10 * time.Second
+
+// errPodReadinessPending is returned when some pods with the backend health readiness gate
+// are registered in the backend set but OCI does not report them as healthy yet.
+var errPodReadinessPending = errors.New("pod readiness gates are pending backend health")
+
+// gatedBackendPod is a pod registered as backend before it is ready, waiting for
+// the backend health readiness gate.
+type gatedBackendPod struct {
+	pod       client.ObjectKey
+	ipAddress string
+	port      int
+}
+
+func podHasBackendHealthReadinessGate(pod *corev1.Pod) bool {
+	return lo.ContainsBy(pod.Spec.ReadinessGates, func(gate corev1.PodReadinessGate) bool {
+		return gate.ConditionType == PodReadinessGateBackendHealthy
+	})
+}
+
+func podConditionTrue(pod *corev1.Pod, conditionType corev1.PodConditionType) bool {
+	return lo.ContainsBy(pod.Status.Conditions, func(condition corev1.PodCondition) bool {
+		return condition.Type == conditionType && condition.Status == corev1.ConditionTrue
+	})
+}
+
+// podAwaitsBackendHealth reports whether the not ready pod is waiting for the backend
+// health readiness gate to be set by the controller.
+func podAwaitsBackendHealth(pod *corev1.Pod) bool {
+	return pod != nil &&
+		pod.DeletionTimestamp == nil &&
+		podHasBackendHealthReadinessGate(pod) &&
+		!podConditionTrue(pod, PodReadinessGateBackendHealthy)
+}
+
+// podReadinessGateRequeue makes sure the pending readiness gates are rechecked soon.
+func podReadinessGateRequeue(result reconcile.Result) reconcile.Result {
+	if result.RequeueAfter == 0 || result.RequeueAfter > podReadinessGateRequeueInterval {
+		result.RequeueAfter = podReadinessGateRequeueInterval
+	}
+	return result
+}
+
+// updatePodReadinessGates sets the backend health readiness gate condition of the gated pods
+// reported healthy by OCI. It returns errPodReadinessPending if some pods are not healthy yet,
+// including the pods that are still starting.
+func (m *httpBackendModelImpl) updatePodReadinessGates(
+	ctx context.Context,
+	loadBalancerID string,
+	backendSetName string,
+	backends identifyBackendsToUpdateResult,
+) error {
+	pending := backends.startingGatedPods
+	for _, gatedPod := range backends.gatedPods {
+		backendName := net.JoinHostPort(gatedPod.ipAddress, strconv.Itoa(gatedPod.port))
+		healthRes, err := m.ociClient.GetBackendHealth(ctx, loadbalancer.GetBackendHealthRequest{
+			LoadBalancerId: &loadBalancerID,
+			BackendSetName: &backendSetName,
+			BackendName:    &backendName,
+		})
+		found, lookupErr := backendSetLookupFound(err)
+		if lookupErr != nil {
+			return fmt.Errorf("failed to get backend %s health: %w", backendName, lookupErr)
+		}
+		if !found || healthRes.BackendHealth.Status != loadbalancer.BackendHealthStatusOk {
+			pending++
+			continue
+		}
+
+		if err = m.setPodBackendHealthy(ctx, gatedPod.pod); err != nil {
+			return err
+		}
+		m.logger.InfoContext(ctx, "Pod backend is healthy, readiness gate passed",
+			slog.String("pod", gatedPod.pod.String()),
+			slog.String("backendSetName", backendSetName),
+			slog.String("backendName", backendName),
+		)
+	}
+
+	if pending > 0 {
+		return fmt.Errorf("%d pods in backend set %s: %w", pending, backendSetName, errPodReadinessPending)
+	}
+	return nil
+}
+
+func (m *httpBackendModelImpl) setPodBackendHealthy(ctx context.Context, podKey client.ObjectKey) error {
+	var pod corev1.Pod
+	if err := m.k8sClient.Get(ctx, podKey, &pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get pod %s: %w", podKey, err)
+	}
+	if podConditionTrue(&pod, PodReadinessGateBackendHealthy) {
+		return nil
+	}
+
+	original := pod.DeepCopy()
+	condition := corev1.PodCondition{
+		Type:               PodReadinessGateBackendHealthy,
+		Status:             corev1.ConditionTrue,
+		Reason:             "BackendHealthy",
+		Message:            "OCI load balancer reports the pod backend as healthy",
+		LastTransitionTime: metav1.Now(),
+	}
+	_, index, found := lo.FindIndexOf(pod.Status.Conditions, func(existing corev1.PodCondition) bool {
+		return existing.Type == PodReadinessGateBackendHealthy
+	})
+	if found {
+		pod.Status.Conditions[index] = condition
+	} else {
+		pod.Status.Conditions = append(pod.Status.Conditions, condition)
+	}
+
+	// Strategic merge keeps conditions owned by the kubelet untouched.
+	if err := m.k8sClient.Status().Patch(ctx, &pod, client.StrategicMergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to update pod %s readiness gate: %w", podKey, err)
+	}
+	return nil
+}
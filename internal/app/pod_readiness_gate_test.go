@@ -0,0 +1,313 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/k8sapi"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+)
+
+func TestPodReadinessGate(t *testing.T) {
+	newMockDeps := func(t *testing.T) httpBackendModelDeps {
+		return httpBackendModelDeps{
+			K8sClient:             NewMockk8sClient(t),
+			RootLogger:            diag.RootTestLogger(),
+			OciLoadBalancerClient: NewMockociLoadBalancerClient(t),
+			WorkRequestsWatcher:   NewMockworkRequestsWatcher(t),
+			self:                  NewMockhttpBackendModel(t),
+		}
+	}
+
+	makeGatedPod := func(conditions ...corev1.PodCondition) corev1.Pod {
+		fake := faker.New()
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fake.Internet().Slug(),
+				Namespace: fake.Internet().Slug(),
+			},
+			Spec: corev1.PodSpec{
+				ReadinessGates: []corev1.PodReadinessGate{
+					{ConditionType: PodReadinessGateBackendHealthy},
+				},
+			},
+			Status: corev1.PodStatus{Conditions: conditions},
+		}
+	}
+
+	makeGatedBackendPod := func(pod corev1.Pod) gatedBackendPod {
+		return gatedBackendPod{
+			pod:       client.ObjectKeyFromObject(&pod),
+			ipAddress: faker.New().Internet().Ipv4(),
+			port:      rand.IntN(65534) + 1,
+		}
+	}
+
+	expectGetPod := func(t *testing.T, deps httpBackendModelDeps, pod corev1.Pod) {
+		mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+		mockK8sClient.EXPECT().Get(t.Context(), client.ObjectKeyFromObject(&pod), mock.Anything).
+			RunAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+				target, ok := obj.(*corev1.Pod)
+				require.True(t, ok)
+				*target = pod
+				return nil
+			}).
+			Once()
+	}
+
+	containersReady := corev1.PodCondition{Type: corev1.ContainersReady, Status: corev1.ConditionTrue}
+
+	t.Run("podAwaitsBackendHealth", func(t *testing.T) {
+		t.Run("true for gated pod without the condition", func(t *testing.T) {
+			pod := makeGatedPod(containersReady)
+			assert.True(t, podAwaitsBackendHealth(&pod))
+		})
+
+		t.Run("false for pod without the gate", func(t *testing.T) {
+			pod := makeGatedPod(containersReady)
+			pod.Spec.ReadinessGates = nil
+			assert.False(t, podAwaitsBackendHealth(&pod))
+		})
+
+		t.Run("false when the gate has passed", func(t *testing.T) {
+			pod := makeGatedPod(containersReady, corev1.PodCondition{
+				Type:   PodReadinessGateBackendHealthy,
+				Status: corev1.ConditionTrue,
+			})
+			assert.False(t, podAwaitsBackendHealth(&pod))
+		})
+
+		t.Run("false for deleted pod", func(t *testing.T) {
+			pod := makeGatedPod(containersReady)
+			pod.DeletionTimestamp = new(metav1.Now())
+			assert.False(t, podAwaitsBackendHealth(&pod))
+			assert.False(t, podAwaitsBackendHealth(nil))
+		})
+	})
+
+	t.Run("podReadinessGateRequeue", func(t *testing.T) {
+		assert.Equal(t,
+			reconcile.Result{RequeueAfter: podReadinessGateRequeueInterval},
+			podReadinessGateRequeue(reconcile.Result{}),
+		)
+		assert.Equal(t,
+			reconcile.Result{RequeueAfter: podReadinessGateRequeueInterval},
+			podReadinessGateRequeue(reconcile.Result{RequeueAfter: time.Hour}),
+		)
+		assert.Equal(t,
+			reconcile.Result{RequeueAfter: time.Second},
+			podReadinessGateRequeue(reconcile.Result{RequeueAfter: time.Second}),
+		)
+	})
+
+	t.Run("identifyBackendsToUpdate", func(t *testing.T) {
+		makeSlice := func(pod corev1.Pod) discoveryv1.EndpointSlice {
+			endpoint := makeRandomEndpoint(randomEndpointWithConditionsOpt(new(false), new(false)))
+			endpoint.TargetRef = &corev1.ObjectReference{Kind: "Pod", Name: pod.Name}
+			return discoveryv1.EndpointSlice{
+				AddressType: discoveryv1.AddressTypeIPv4,
+				ObjectMeta:  metav1.ObjectMeta{Namespace: pod.Namespace},
+				Endpoints:   []discoveryv1.Endpoint{endpoint},
+			}
+		}
+
+		t.Run("registers not ready pod awaiting backend health", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+			refPort := rand.Int32N(65534) + 1
+			pod := makeGatedPod(containersReady)
+			slice := makeSlice(pod)
+			expectGetPod(t, deps, pod)
+
+			result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+				endpointPort:   refPort,
+				endpointSlices: []discoveryv1.EndpointSlice{slice},
+			})
+
+			require.NoError(t, err)
+			assert.True(t, result.updateRequired)
+			assert.Equal(t, []loadbalancer.BackendDetails{
+				{
+					IpAddress: &slice.Endpoints[0].Addresses[0],
+					Port:      new(int(refPort)),
+					Drain:     new(false),
+				},
+			}, result.updatedBackends)
+			assert.Equal(t, []gatedBackendPod{
+				{
+					pod:       client.ObjectKeyFromObject(&pod),
+					ipAddress: slice.Endpoints[0].Addresses[0],
+					port:      int(refPort),
+				},
+			}, result.gatedPods)
+		})
+
+		t.Run("counts gated pod with containers not ready as starting", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+			pod := makeGatedPod()
+			expectGetPod(t, deps, pod)
+
+			result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+				endpointPort:   rand.Int32N(65534) + 1,
+				endpointSlices: []discoveryv1.EndpointSlice{makeSlice(pod)},
+			})
+
+			require.NoError(t, err)
+			assert.Empty(t, result.updatedBackends)
+			assert.Empty(t, result.gatedPods)
+			assert.Equal(t, 1, result.startingGatedPods)
+		})
+
+		t.Run("skips not ready pod without the gate", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+			pod := makeGatedPod(containersReady)
+			pod.Spec.ReadinessGates = nil
+			expectGetPod(t, deps, pod)
+
+			result, err := model.identifyBackendsToUpdate(t.Context(), identifyBackendsToUpdateParams{
+				endpointPort:   rand.Int32N(65534) + 1,
+				endpointSlices: []discoveryv1.EndpointSlice{makeSlice(pod)},
+			})
+
+			require.NoError(t, err)
+			assert.Empty(t, result.updatedBackends)
+			assert.Empty(t, result.gatedPods)
+			assert.Zero(t, result.startingGatedPods)
+		})
+	})
+
+	t.Run("updatePodReadinessGates", func(t *testing.T) {
+		t.Run("sets condition of healthy pods", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+			loadBalancerID := faker.New().UUID().V4()
+			backendSetName := faker.New().Internet().Slug()
+			pod := makeGatedPod(containersReady)
+			gatedPod := makeGatedBackendPod(pod)
+
+			mockOciClient, _ := deps.OciLoadBalancerClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().GetBackendHealth(t.Context(), loadbalancer.GetBackendHealthRequest{
+				LoadBalancerId: &loadBalancerID,
+				BackendSetName: &backendSetName,
+				BackendName:    new(net.JoinHostPort(gatedPod.ipAddress, strconv.Itoa(gatedPod.port))),
+			}).Return(loadbalancer.GetBackendHealthResponse{
+				BackendHealth: loadbalancer.BackendHealth{Status: loadbalancer.BackendHealthStatusOk},
+			}, nil).Once()
+
+			expectGetPod(t, deps, pod)
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockStatusWriter := k8sapi.NewMockSubResourceWriter(t)
+			mockK8sClient.EXPECT().Status().Return(mockStatusWriter)
+			mockStatusWriter.EXPECT().Patch(t.Context(), mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+					patched, ok := obj.(*corev1.Pod)
+					require.True(t, ok)
+					assert.True(t, podConditionTrue(patched, PodReadinessGateBackendHealthy))
+					assert.True(t, podConditionTrue(patched, corev1.ContainersReady))
+					return nil
+				}).
+				Once()
+
+			err := model.updatePodReadinessGates(t.Context(), loadBalancerID, backendSetName,
+				identifyBackendsToUpdateResult{gatedPods: []gatedBackendPod{gatedPod}},
+			)
+			require.NoError(t, err)
+		})
+
+		t.Run("reports pending for unhealthy and unknown backends", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+			unhealthyPod := makeGatedBackendPod(makeGatedPod(containersReady))
+			unknownPod := makeGatedBackendPod(makeGatedPod(containersReady))
+
+			mockOciClient, _ := deps.OciLoadBalancerClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().GetBackendHealth(t.Context(), mock.MatchedBy(
+				func(req loadbalancer.GetBackendHealthRequest) bool {
+					return *req.BackendName == net.JoinHostPort(unhealthyPod.ipAddress, strconv.Itoa(unhealthyPod.port))
+				},
+			)).Return(loadbalancer.GetBackendHealthResponse{
+				BackendHealth: loadbalancer.BackendHealth{Status: loadbalancer.BackendHealthStatusCritical},
+			}, nil).Once()
+			mockOciClient.EXPECT().GetBackendHealth(t.Context(), mock.MatchedBy(
+				func(req loadbalancer.GetBackendHealthRequest) bool {
+					return *req.BackendName == net.JoinHostPort(unknownPod.ipAddress, strconv.Itoa(unknownPod.port))
+				},
+			)).Return(
+				loadbalancer.GetBackendHealthResponse{},
+				ociapi.NewRandomServiceError(ociapi.RandomServiceErrorWithStatusCode(404)),
+			).Once()
+
+			err := model.updatePodReadinessGates(t.Context(), faker.New().UUID().V4(), faker.New().Internet().Slug(),
+				identifyBackendsToUpdateResult{gatedPods: []gatedBackendPod{unhealthyPod, unknownPod}},
+			)
+			require.ErrorIs(t, err, errPodReadinessPending)
+		})
+
+		t.Run("reports pending for starting pods", func(t *testing.T) {
+			model := newHTTPBackendModel(newMockDeps(t))
+
+			err := model.updatePodReadinessGates(t.Context(), faker.New().UUID().V4(), faker.New().Internet().Slug(),
+				identifyBackendsToUpdateResult{startingGatedPods: 1},
+			)
+			require.ErrorIs(t, err, errPodReadinessPending)
+		})
+
+		t.Run("ignores deleted pods", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+			gatedPod := makeGatedBackendPod(makeGatedPod(containersReady))
+
+			mockOciClient, _ := deps.OciLoadBalancerClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().GetBackendHealth(t.Context(), mock.Anything).
+				Return(loadbalancer.GetBackendHealthResponse{
+					BackendHealth: loadbalancer.BackendHealth{Status: loadbalancer.BackendHealthStatusOk},
+				}, nil).Once()
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().Get(t.Context(), gatedPod.pod, mock.Anything).
+				Return(apierrors.NewNotFound(corev1.Resource("pods"), gatedPod.pod.Name)).
+				Once()
+
+			err := model.updatePodReadinessGates(t.Context(), faker.New().UUID().V4(), faker.New().Internet().Slug(),
+				identifyBackendsToUpdateResult{gatedPods: []gatedBackendPod{gatedPod}},
+			)
+			require.NoError(t, err)
+		})
+
+		t.Run("returns health lookup errors", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newHTTPBackendModel(deps)
+			wantErr := errors.New(faker.New().Lorem().Sentence(5))
+
+			mockOciClient, _ := deps.OciLoadBalancerClient.(*MockociLoadBalancerClient)
+			mockOciClient.EXPECT().GetBackendHealth(t.Context(), mock.Anything).
+				Return(loadbalancer.GetBackendHealthResponse{}, wantErr).Once()
+
+			err := model.updatePodReadinessGates(t.Context(), faker.New().UUID().V4(), faker.New().Internet().Slug(),
+				identifyBackendsToUpdateResult{
+					gatedPods: []gatedBackendPod{makeGatedBackendPod(makeGatedPod(containersReady))},
+				},
+			)
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+}
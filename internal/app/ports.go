@@ -4,7 +4,11 @@ import (
 	"context"
 
 	"github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/dns"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/logging"
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
 	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -13,8 +17,10 @@ import (
 type k8sClient interface {
 	Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error
 	List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error
+	Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error
 	Status() client.StatusWriter
 	Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error
+	Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error
 }
 
 // This file contains application required ports (e.g external dependencies)
@@ -32,6 +38,12 @@ type ociLoadBalancerClient interface {
 	GetBackendSet(ctx context.Context, request loadbalancer.GetBackendSetRequest) (
 		response loadbalancer.GetBackendSetResponse, err error)
 
+	GetBackendSetHealth(ctx context.Context, request loadbalancer.GetBackendSetHealthRequest) (
+		response loadbalancer.GetBackendSetHealthResponse, err error)
+
+	GetBackendHealth(ctx context.Context, request loadbalancer.GetBackendHealthRequest) (
+		response loadbalancer.GetBackendHealthResponse, err error)
+
 	CreateListener(ctx context.Context, request loadbalancer.CreateListenerRequest) (
 		response loadbalancer.CreateListenerResponse, err error)
 
@@ -53,9 +65,15 @@ type ociLoadBalancerClient interface {
 	DeleteListener(ctx context.Context, request loadbalancer.DeleteListenerRequest) (
 		response loadbalancer.DeleteListenerResponse, err error)
 
+	CreateRuleSet(ctx context.Context, request loadbalancer.CreateRuleSetRequest) (
+		response loadbalancer.CreateRuleSetResponse, err error)
+
 	UpdateRuleSet(ctx context.Context, request loadbalancer.UpdateRuleSetRequest) (
 		response loadbalancer.UpdateRuleSetResponse, err error)
 
+	DeleteRuleSet(ctx context.Context, request loadbalancer.DeleteRuleSetRequest) (
+		response loadbalancer.DeleteRuleSetResponse, err error)
+
 	GetRuleSet(ctx context.Context, request loadbalancer.GetRuleSetRequest) (
 		response loadbalancer.GetRuleSetResponse, err error)
 
@@ -105,6 +123,60 @@ type ociCertificatesManagementClient interface {
 		response certificatesmanagement.DeleteCaBundleResponse, err error)
 }
 
+// ociLoggingManagementClient defines the interface for OCI Logging service operations.
+type ociLoggingManagementClient interface {
+	ListLogs(ctx context.Context, request logging.ListLogsRequest) (
+		response logging.ListLogsResponse, err error)
+
+	GetLog(ctx context.Context, request logging.GetLogRequest) (
+		response logging.GetLogResponse, err error)
+
+	CreateLog(ctx context.Context, request logging.CreateLogRequest) (
+		response logging.CreateLogResponse, err error)
+
+	UpdateLog(ctx context.Context, request logging.UpdateLogRequest) (
+		response logging.UpdateLogResponse, err error)
+}
+
+// ociVirtualNetworkClient defines the interface for OCI Networking service operations.
+type ociVirtualNetworkClient interface {
+	ListNetworkSecurityGroupSecurityRules(
+		ctx context.Context,
+		request core.ListNetworkSecurityGroupSecurityRulesRequest,
+	) (response core.ListNetworkSecurityGroupSecurityRulesResponse, err error)
+
+	AddNetworkSecurityGroupSecurityRules(
+		ctx context.Context,
+		request core.AddNetworkSecurityGroupSecurityRulesRequest,
+	) (response core.AddNetworkSecurityGroupSecurityRulesResponse, err error)
+
+	RemoveNetworkSecurityGroupSecurityRules(
+		ctx context.Context,
+		request core.RemoveNetworkSecurityGroupSecurityRulesRequest,
+	) (response core.RemoveNetworkSecurityGroupSecurityRulesResponse, err error)
+}
+
+// ociDNSClient defines the interface for OCI DNS service operations.
+type ociDNSClient interface {
+	GetZone(ctx context.Context, request dns.GetZoneRequest) (
+		response dns.GetZoneResponse, err error)
+
+	GetDomainRecords(ctx context.Context, request dns.GetDomainRecordsRequest) (
+		response dns.GetDomainRecordsResponse, err error)
+
+	UpdateRRSet(ctx context.Context, request dns.UpdateRRSetRequest) (
+		response dns.UpdateRRSetResponse, err error)
+
+	DeleteRRSet(ctx context.Context, request dns.DeleteRRSetRequest) (
+		response dns.DeleteRRSetResponse, err error)
+}
+
+// ociMonitoringClient defines the interface for OCI Monitoring service operations.
+type ociMonitoringClient interface {
+	SummarizeMetricsData(ctx context.Context, request monitoring.SummarizeMetricsDataRequest) (
+		response monitoring.SummarizeMetricsDataResponse, err error)
+}
+
 // ociNetworkLoadBalancerClient defines the interface for OCI Network Load Balancer operations.
 type ociNetworkLoadBalancerClient interface {
 	GetNetworkLoadBalancer(ctx context.Context, request networkloadbalancer.GetNetworkLoadBalancerRequest) (
@@ -0,0 +1,303 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"go.uber.org/dig"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+type routeProgrammingStateParams struct {
+	gateway   gatewayv1.Gateway
+	routeKind string
+	route     client.Object
+}
+
+type recordProgrammedRouteParams struct {
+	gateway     gatewayv1.Gateway
+	routeKind   string
+	route       client.Object
+	policyRules []string
+	backendSets []string
+}
+
+type backendSetReferencesParams struct {
+	gateway        gatewayv1.Gateway
+	routeKind      string
+	route          client.Object
+	backendSetName string
+}
+
+type recordProgrammedCertificatesParams struct {
+	gateway      gatewayv1.Gateway
+	certificates []string
+}
+
+// programmingStateModel maintains OkeGatewayProgrammingState resources. The state
+// records load balancer resources programmed for a gateway, so they can be cleaned up
+// later even if the metadata of the gateway or routes was pruned.
+type programmingStateModel interface {
+	// programmedRoutePolicyRules returns policy rules recorded for the route on the gateway.
+	// The second result is false if nothing is recorded for the route.
+	programmedRoutePolicyRules(ctx context.Context, params routeProgrammingStateParams) ([]string, bool, error)
+
+	// recordProgrammedRoute records resources programmed for the route on the gateway.
+	recordProgrammedRoute(ctx context.Context, params recordProgrammedRouteParams) error
+
+	// forgetProgrammedRoute drops the route from the state of the gateway.
+	forgetProgrammedRoute(ctx context.Context, params routeProgrammingStateParams) error
+
+	// backendSetReferences returns other routes recorded on the gateway that reference the backend set.
+	// Backend sets are shared by routes referencing the same service port, so the backend set
+	// can only be deprovisioned when no other route references it.
+	backendSetReferences(ctx context.Context, params backendSetReferencesParams) ([]string, error)
+
+	// programmedRoutes returns all routes recorded on the gateway.
+	programmedRoutes(ctx context.Context, gateway gatewayv1.Gateway) ([]types.OkeGatewayProgrammedRoute, error)
+
+	// programmedCertificates returns certificates recorded for the gateway listeners.
+	programmedCertificates(ctx context.Context, gateway gatewayv1.Gateway) ([]string, error)
+
+	// recordProgrammedCertificates records certificates programmed for the gateway listeners.
+	recordProgrammedCertificates(ctx context.Context, params recordProgrammedCertificatesParams) error
+}
+
+type programmingStateModelImpl struct {
+	client k8sClient
+	logger *slog.Logger
+	dryRun bool
+}
+
+func programmedRouteMatches(params routeProgrammingStateParams) func(types.OkeGatewayProgrammedRoute) bool {
+	return func(route types.OkeGatewayProgrammedRoute) bool {
+		return route.Kind == params.routeKind &&
+			route.Namespace == params.route.GetNamespace() &&
+			route.Name == params.route.GetName()
+	}
+}
+
+func (m *programmingStateModelImpl) getState(
+	ctx context.Context,
+	gateway gatewayv1.Gateway,
+) (types.OkeGatewayProgrammingState, bool, error) {
+	var state types.OkeGatewayProgrammingState
+	if err := m.client.Get(ctx, client.ObjectKeyFromObject(&gateway), &state); err != nil {
+		if apierrors.IsNotFound(err) {
+			return state, false, nil
+		}
+		return state, false, fmt.Errorf("failed to get programming state of gateway %s/%s: %w",
+			gateway.Namespace, gateway.Name, err)
+	}
+	return state, true, nil
+}
+
+// updateState applies the mutation to the latest state of the gateway and stores it.
+// The state is shared by all routes of the gateway, so conflicting writes are retried.
+func (m *programmingStateModelImpl) updateState(
+	ctx context.Context,
+	gateway gatewayv1.Gateway,
+	mutate func(spec *types.OkeGatewayProgrammingStateSpec) bool,
+) error {
+	// Nothing is programmed in the dry run mode, so there is nothing to record
+	if m.dryRun {
+		m.logger.DebugContext(ctx, "DRY RUN: skipping gateway programming state update",
+			slog.String("gateway", gateway.Name),
+			slog.String("namespace", gateway.Namespace),
+		)
+		return nil
+	}
+	return retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}, func() error {
+		state, found, err := m.getState(ctx, gateway)
+		if err != nil {
+			return err
+		}
+		if !mutate(&state.Spec) {
+			return nil
+		}
+
+		if !found {
+			state.ObjectMeta = metav1.ObjectMeta{
+				Namespace:       gateway.Namespace,
+				Name:            gateway.Name,
+				OwnerReferences: programmingStateOwnerReferences(gateway),
+			}
+			m.logger.DebugContext(ctx, "Creating gateway programming state",
+				slog.String("gateway", gateway.Name),
+				slog.String("namespace", gateway.Namespace),
+			)
+			if err = m.client.Create(ctx, &state); err != nil {
+				return fmt.Errorf("failed to create programming state of gateway %s/%s: %w",
+					gateway.Namespace, gateway.Name, err)
+			}
+			return nil
+		}
+
+		if err = m.client.Update(ctx, &state); err != nil {
+			return fmt.Errorf("failed to update programming state of gateway %s/%s: %w",
+				gateway.Namespace, gateway.Name, err)
+		}
+		return nil
+	})
+}
+
+// programmingStateOwnerReferences makes the state garbage collected with the gateway.
+func programmingStateOwnerReferences(gateway gatewayv1.Gateway) []metav1.OwnerReference {
+	if gateway.UID == "" {
+		return nil
+	}
+	return []metav1.OwnerReference{{
+		APIVersion: gatewayv1.GroupVersion.String(),
+		Kind:       "Gateway",
+		Name:       gateway.Name,
+		UID:        gateway.UID,
+	}}
+}
+
+func (m *programmingStateModelImpl) programmedRoutePolicyRules(
+	ctx context.Context,
+	params routeProgrammingStateParams,
+) ([]string, bool, error) {
+	state, found, err := m.getState(ctx, params.gateway)
+	if err != nil || !found {
+		return nil, false, err
+	}
+
+	index := slices.IndexFunc(state.Spec.Routes, programmedRouteMatches(params))
+	if index < 0 {
+		return nil, false, nil
+	}
+	return state.Spec.Routes[index].PolicyRules, true, nil
+}
+
+func (m *programmingStateModelImpl) recordProgrammedRoute(
+	ctx context.Context,
+	params recordProgrammedRouteParams,
+) error {
+	wantRoute := types.OkeGatewayProgrammedRoute{
+		Kind:        params.routeKind,
+		Namespace:   params.route.GetNamespace(),
+		Name:        params.route.GetName(),
+		PolicyRules: params.policyRules,
+		BackendSets: params.backendSets,
+	}
+	matches := programmedRouteMatches(routeProgrammingStateParams{
+		gateway:   params.gateway,
+		routeKind: params.routeKind,
+		route:     params.route,
+	})
+
+	return m.updateState(ctx, params.gateway, func(spec *types.OkeGatewayProgrammingStateSpec) bool {
+		index := slices.IndexFunc(spec.Routes, matches)
+		if index < 0 {
+			spec.Routes = append(spec.Routes, wantRoute)
+			return true
+		}
+
+		existing := spec.Routes[index]
+		if slices.Equal(existing.PolicyRules, wantRoute.PolicyRules) &&
+			slices.Equal(existing.BackendSets, wantRoute.BackendSets) {
+			return false
+		}
+		spec.Routes[index] = wantRoute
+		return true
+	})
+}
+
+func (m *programmingStateModelImpl) forgetProgrammedRoute(
+	ctx context.Context,
+	params routeProgrammingStateParams,
+) error {
+	matches := programmedRouteMatches(params)
+	return m.updateState(ctx, params.gateway, func(spec *types.OkeGatewayProgrammingStateSpec) bool {
+		routesCount := len(spec.Routes)
+		spec.Routes = slices.DeleteFunc(spec.Routes, matches)
+		return len(spec.Routes) != routesCount
+	})
+}
+
+func (m *programmingStateModelImpl) programmedRoutes(
+	ctx context.Context,
+	gateway gatewayv1.Gateway,
+) ([]types.OkeGatewayProgrammedRoute, error) {
+	state, _, err := m.getState(ctx, gateway)
+	if err != nil {
+		return nil, err
+	}
+	return state.Spec.Routes, nil
+}
+
+func (m *programmingStateModelImpl) backendSetReferences(
+	ctx context.Context,
+	params backendSetReferencesParams,
+) ([]string, error) {
+	state, _, err := m.getState(ctx, params.gateway)
+	if err != nil {
+		return nil, err
+	}
+
+	isRoute := programmedRouteMatches(routeProgrammingStateParams{
+		gateway:   params.gateway,
+		routeKind: params.routeKind,
+		route:     params.route,
+	})
+	var references []string
+	for _, route := range state.Spec.Routes {
+		if isRoute(route) || !slices.Contains(route.BackendSets, params.backendSetName) {
+			continue
+		}
+		references = append(references, fmt.Sprintf("%s %s/%s", route.Kind, route.Namespace, route.Name))
+	}
+	return references, nil
+}
+
+func (m *programmingStateModelImpl) programmedCertificates(
+	ctx context.Context,
+	gateway gatewayv1.Gateway,
+) ([]string, error) {
+	state, _, err := m.getState(ctx, gateway)
+	if err != nil {
+		return nil, err
+	}
+	return state.Spec.Certificates, nil
+}
+
+func (m *programmingStateModelImpl) recordProgrammedCertificates(
+	ctx context.Context,
+	params recordProgrammedCertificatesParams,
+) error {
+	return m.updateState(ctx, params.gateway, func(spec *types.OkeGatewayProgrammingStateSpec) bool {
+		if slices.Equal(spec.Certificates, params.certificates) {
+			return false
+		}
+		spec.Certificates = params.certificates
+		return true
+	})
+}
+
+type programmingStateModelDeps struct {
+	dig.In
+
+	K8sClient  k8sClient
+	RootLogger *slog.Logger
+
+	DryRun bool `name:"config.ociapi.dryRun" optional:"true"`
+}
+
+func newProgrammingStateModel(deps programmingStateModelDeps) *programmingStateModelImpl {
+	return &programmingStateModelImpl{
+		client: deps.K8sClient,
+		logger: deps.RootLogger.WithGroup("programming-state-model"),
+		dryRun: deps.DryRun,
+	}
+}
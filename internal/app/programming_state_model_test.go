@@ -0,0 +1,486 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestProgrammingStateModelImpl(t *testing.T) {
+	newMockDeps := func(t *testing.T) programmingStateModelDeps {
+		return programmingStateModelDeps{
+			K8sClient:  NewMockk8sClient(t),
+			RootLogger: diag.RootTestLogger(),
+		}
+	}
+
+	stateNotFoundErr := func(gateway *gatewayv1.Gateway) error {
+		return apierrors.NewNotFound(schema.GroupResource{
+			Group:    types.GroupName,
+			Resource: "oke-gateway-programming-states",
+		}, gateway.Name)
+	}
+
+	makeState := func(
+		gateway *gatewayv1.Gateway,
+		routes ...types.OkeGatewayProgrammedRoute,
+	) types.OkeGatewayProgrammingState {
+		return types.OkeGatewayProgrammingState{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       gateway.Namespace,
+				Name:            gateway.Name,
+				ResourceVersion: faker.New().Numerify("####"),
+			},
+			Spec: types.OkeGatewayProgrammingStateSpec{Routes: routes},
+		}
+	}
+
+	makeProgrammedRoute := func(route gatewayv1.HTTPRoute) types.OkeGatewayProgrammedRoute {
+		fake := faker.New()
+		return types.OkeGatewayProgrammedRoute{
+			Kind:        "HTTPRoute",
+			Namespace:   route.Namespace,
+			Name:        route.Name,
+			PolicyRules: []string{"listener/rule-" + fake.Lorem().Word()},
+			BackendSets: []string{"backend-set-" + fake.Lorem().Word()},
+		}
+	}
+
+	t.Run("programmedRoutePolicyRules", func(t *testing.T) {
+		t.Run("returns recorded rules of the route", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			route := makeRandomHTTPRoute()
+			otherRoute := makeRandomHTTPRoute()
+			programmedRoute := makeProgrammedRoute(route)
+			state := makeState(gateway, makeProgrammedRoute(otherRoute), programmedRoute)
+
+			setupClientGet(t, deps.K8sClient, client.ObjectKeyFromObject(gateway), state)
+
+			got, found, err := model.programmedRoutePolicyRules(t.Context(), routeProgrammingStateParams{
+				gateway:   *gateway,
+				routeKind: "HTTPRoute",
+				route:     &route,
+			})
+
+			require.NoError(t, err)
+			require.True(t, found)
+			assert.Equal(t, programmedRoute.PolicyRules, got)
+		})
+
+		t.Run("does not match routes of other kind", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			route := makeRandomHTTPRoute()
+			state := makeState(gateway, makeProgrammedRoute(route))
+
+			setupClientGet(t, deps.K8sClient, client.ObjectKeyFromObject(gateway), state)
+
+			_, found, err := model.programmedRoutePolicyRules(t.Context(), routeProgrammingStateParams{
+				gateway:   *gateway,
+				routeKind: "GRPCRoute",
+				route:     &route,
+			})
+
+			require.NoError(t, err)
+			assert.False(t, found)
+		})
+
+		t.Run("reports missing state", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			route := makeRandomHTTPRoute()
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				Get(t.Context(), client.ObjectKeyFromObject(gateway), mock.Anything).
+				Return(stateNotFoundErr(gateway)).
+				Once()
+
+			_, found, err := model.programmedRoutePolicyRules(t.Context(), routeProgrammingStateParams{
+				gateway:   *gateway,
+				routeKind: "HTTPRoute",
+				route:     &route,
+			})
+
+			require.NoError(t, err)
+			assert.False(t, found)
+		})
+
+		t.Run("returns get errors", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			route := makeRandomHTTPRoute()
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				Get(t.Context(), client.ObjectKeyFromObject(gateway), mock.Anything).
+				Return(wantErr).
+				Once()
+
+			_, _, err := model.programmedRoutePolicyRules(t.Context(), routeProgrammingStateParams{
+				gateway:   *gateway,
+				routeKind: "HTTPRoute",
+				route:     &route,
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("recordProgrammedRoute", func(t *testing.T) {
+		t.Run("creates state owned by the gateway", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			gateway.UID = apitypes.UID(faker.New().UUID().V4())
+			route := makeRandomHTTPRoute()
+			programmedRoute := makeProgrammedRoute(route)
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				Get(t.Context(), client.ObjectKeyFromObject(gateway), mock.Anything).
+				Return(stateNotFoundErr(gateway)).
+				Once()
+			mockK8sClient.EXPECT().Create(t.Context(), &types.OkeGatewayProgrammingState{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: gateway.Namespace,
+					Name:      gateway.Name,
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: gatewayv1.GroupVersion.String(),
+						Kind:       "Gateway",
+						Name:       gateway.Name,
+						UID:        gateway.UID,
+					}},
+				},
+				Spec: types.OkeGatewayProgrammingStateSpec{
+					Routes: []types.OkeGatewayProgrammedRoute{programmedRoute},
+				},
+			}).Return(nil).Once()
+
+			err := model.recordProgrammedRoute(t.Context(), recordProgrammedRouteParams{
+				gateway:     *gateway,
+				routeKind:   "HTTPRoute",
+				route:       &route,
+				policyRules: programmedRoute.PolicyRules,
+				backendSets: programmedRoute.BackendSets,
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("replaces recorded route", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			route := makeRandomHTTPRoute()
+			otherRoute := makeProgrammedRoute(makeRandomHTTPRoute())
+			state := makeState(gateway, otherRoute, makeProgrammedRoute(route))
+			wantRoute := makeProgrammedRoute(route)
+
+			setupClientGet(t, deps.K8sClient, client.ObjectKeyFromObject(gateway), state)
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				updatedState, ok := obj.(*types.OkeGatewayProgrammingState)
+				return ok &&
+					assert.Equal(t, state.ResourceVersion, updatedState.ResourceVersion) &&
+					assert.Equal(t, []types.OkeGatewayProgrammedRoute{otherRoute, wantRoute}, updatedState.Spec.Routes)
+			})).Return(nil).Once()
+
+			err := model.recordProgrammedRoute(t.Context(), recordProgrammedRouteParams{
+				gateway:     *gateway,
+				routeKind:   "HTTPRoute",
+				route:       &route,
+				policyRules: wantRoute.PolicyRules,
+				backendSets: wantRoute.BackendSets,
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("skips update when nothing changed", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			route := makeRandomHTTPRoute()
+			programmedRoute := makeProgrammedRoute(route)
+
+			setupClientGet(t, deps.K8sClient, client.ObjectKeyFromObject(gateway), makeState(gateway, programmedRoute))
+
+			err := model.recordProgrammedRoute(t.Context(), recordProgrammedRouteParams{
+				gateway:     *gateway,
+				routeKind:   "HTTPRoute",
+				route:       &route,
+				policyRules: programmedRoute.PolicyRules,
+				backendSets: programmedRoute.BackendSets,
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("skips recording in dry run mode", func(t *testing.T) {
+			deps := newMockDeps(t)
+			deps.DryRun = true
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			route := makeRandomHTTPRoute()
+			programmedRoute := makeProgrammedRoute(route)
+
+			err := model.recordProgrammedRoute(t.Context(), recordProgrammedRouteParams{
+				gateway:     *gateway,
+				routeKind:   "HTTPRoute",
+				route:       &route,
+				policyRules: programmedRoute.PolicyRules,
+				backendSets: programmedRoute.BackendSets,
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("retries conflicting updates", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			route := makeRandomHTTPRoute()
+			programmedRoute := makeProgrammedRoute(route)
+			state := makeState(gateway)
+
+			setupClientGet(t, deps.K8sClient, client.ObjectKeyFromObject(gateway), state).Twice()
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				Update(t.Context(), mock.Anything).
+				Return(apierrors.NewConflict(schema.GroupResource{
+					Group:    types.GroupName,
+					Resource: "oke-gateway-programming-states",
+				}, gateway.Name, errors.New(faker.New().Lorem().Sentence(5)))).
+				Once()
+			mockK8sClient.EXPECT().Update(t.Context(), mock.Anything).Return(nil).Once()
+
+			err := model.recordProgrammedRoute(t.Context(), recordProgrammedRouteParams{
+				gateway:     *gateway,
+				routeKind:   "HTTPRoute",
+				route:       &route,
+				policyRules: programmedRoute.PolicyRules,
+				backendSets: programmedRoute.BackendSets,
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("returns update errors", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			route := makeRandomHTTPRoute()
+			wantErr := errors.New(faker.New().Lorem().Sentence(10))
+
+			setupClientGet(t, deps.K8sClient, client.ObjectKeyFromObject(gateway), makeState(gateway))
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().Update(t.Context(), mock.Anything).Return(wantErr).Once()
+
+			err := model.recordProgrammedRoute(t.Context(), recordProgrammedRouteParams{
+				gateway:   *gateway,
+				routeKind: "HTTPRoute",
+				route:     &route,
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("forgetProgrammedRoute", func(t *testing.T) {
+		t.Run("removes the route from the state", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			route := makeRandomHTTPRoute()
+			otherRoute := makeProgrammedRoute(makeRandomHTTPRoute())
+			state := makeState(gateway, makeProgrammedRoute(route), otherRoute)
+
+			setupClientGet(t, deps.K8sClient, client.ObjectKeyFromObject(gateway), state)
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				updatedState, ok := obj.(*types.OkeGatewayProgrammingState)
+				return ok && assert.Equal(t, []types.OkeGatewayProgrammedRoute{otherRoute}, updatedState.Spec.Routes)
+			})).Return(nil).Once()
+
+			err := model.forgetProgrammedRoute(t.Context(), routeProgrammingStateParams{
+				gateway:   *gateway,
+				routeKind: "HTTPRoute",
+				route:     &route,
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("does nothing when state is missing", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			route := makeRandomHTTPRoute()
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				Get(t.Context(), client.ObjectKeyFromObject(gateway), mock.Anything).
+				Return(stateNotFoundErr(gateway)).
+				Once()
+
+			err := model.forgetProgrammedRoute(t.Context(), routeProgrammingStateParams{
+				gateway:   *gateway,
+				routeKind: "HTTPRoute",
+				route:     &route,
+			})
+
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("backendSetReferences", func(t *testing.T) {
+		t.Run("returns other routes referencing the backend set", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			route := makeRandomHTTPRoute()
+			sharingRoute := makeRandomHTTPRoute()
+			otherRoute := makeRandomHTTPRoute()
+			programmedRoute := makeProgrammedRoute(route)
+			backendSetName := programmedRoute.BackendSets[0]
+			sharingProgrammedRoute := makeProgrammedRoute(sharingRoute)
+			sharingProgrammedRoute.BackendSets = append(sharingProgrammedRoute.BackendSets, backendSetName)
+			state := makeState(gateway, programmedRoute, sharingProgrammedRoute, makeProgrammedRoute(otherRoute))
+
+			setupClientGet(t, deps.K8sClient, client.ObjectKeyFromObject(gateway), state)
+
+			got, err := model.backendSetReferences(t.Context(), backendSetReferencesParams{
+				gateway:        *gateway,
+				routeKind:      "HTTPRoute",
+				route:          &route,
+				backendSetName: backendSetName,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, []string{"HTTPRoute " + sharingRoute.Namespace + "/" + sharingRoute.Name}, got)
+		})
+
+		t.Run("returns nothing when state is missing", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			route := makeRandomHTTPRoute()
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				Get(t.Context(), client.ObjectKeyFromObject(gateway), mock.Anything).
+				Return(stateNotFoundErr(gateway)).
+				Once()
+
+			got, err := model.backendSetReferences(t.Context(), backendSetReferencesParams{
+				gateway:        *gateway,
+				routeKind:      "HTTPRoute",
+				route:          &route,
+				backendSetName: "backend-set",
+			})
+
+			require.NoError(t, err)
+			assert.Empty(t, got)
+		})
+	})
+
+	t.Run("programmedCertificates", func(t *testing.T) {
+		t.Run("returns recorded certificates", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			state := makeState(gateway)
+			state.Spec.Certificates = []string{"cert-" + faker.New().Lorem().Word()}
+
+			setupClientGet(t, deps.K8sClient, client.ObjectKeyFromObject(gateway), state)
+
+			got, err := model.programmedCertificates(t.Context(), *gateway)
+
+			require.NoError(t, err)
+			assert.Equal(t, state.Spec.Certificates, got)
+		})
+
+		t.Run("returns nothing when state is missing", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				Get(t.Context(), client.ObjectKeyFromObject(gateway), mock.Anything).
+				Return(stateNotFoundErr(gateway)).
+				Once()
+
+			got, err := model.programmedCertificates(t.Context(), *gateway)
+
+			require.NoError(t, err)
+			assert.Empty(t, got)
+		})
+	})
+
+	t.Run("recordProgrammedCertificates", func(t *testing.T) {
+		t.Run("updates recorded certificates", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+			route := makeProgrammedRoute(makeRandomHTTPRoute())
+			state := makeState(gateway, route)
+			wantCertificates := []string{"cert-" + faker.New().Lorem().Word()}
+
+			setupClientGet(t, deps.K8sClient, client.ObjectKeyFromObject(gateway), state)
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().Update(t.Context(), mock.MatchedBy(func(obj client.Object) bool {
+				updatedState, ok := obj.(*types.OkeGatewayProgrammingState)
+				return ok &&
+					assert.Equal(t, wantCertificates, updatedState.Spec.Certificates) &&
+					assert.Equal(t, []types.OkeGatewayProgrammedRoute{route}, updatedState.Spec.Routes)
+			})).Return(nil).Once()
+
+			err := model.recordProgrammedCertificates(t.Context(), recordProgrammedCertificatesParams{
+				gateway:      *gateway,
+				certificates: wantCertificates,
+			})
+
+			require.NoError(t, err)
+		})
+
+		t.Run("does not create state without certificates", func(t *testing.T) {
+			deps := newMockDeps(t)
+			model := newProgrammingStateModel(deps)
+			gateway := newRandomGateway()
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				Get(t.Context(), client.ObjectKeyFromObject(gateway), mock.Anything).
+				Return(stateNotFoundErr(gateway)).
+				Once()
+
+			err := model.recordProgrammedCertificates(t.Context(), recordProgrammedCertificatesParams{
+				gateway:      *gateway,
+				certificates: []string{},
+			})
+
+			require.NoError(t, err)
+		})
+	})
+}
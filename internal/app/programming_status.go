@@ -0,0 +1,41 @@
+package app
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// isProgrammingPending returns true if the programmed condition is Unknown,
+// which means the changes of the resource are still being programmed.
+func isProgrammingPending(conditions []metav1.Condition, conditionType string) bool {
+	return meta.IsStatusConditionPresentAndEqual(conditions, conditionType, metav1.ConditionUnknown)
+}
+
+// programmingStarts returns true if the programmed condition has to be reset to Unknown
+// before programming the changes. Only resources programmed or rejected before are reset,
+// resources that were never programmed have no condition to flip.
+func programmingStarts(conditions []metav1.Condition, conditionType string) bool {
+	condition := meta.FindStatusCondition(conditions, conditionType)
+	return condition != nil && condition.Status != metav1.ConditionUnknown
+}
+
+// failPendingProgramming sets the pending programmed condition to False. The condition
+// is only updated in memory and persisted along with the condition reporting the failure.
+func failPendingProgramming(
+	conditions *[]metav1.Condition,
+	conditionType string,
+	generation int64,
+	reason string,
+	message string,
+) {
+	if !isProgrammingPending(*conditions, conditionType) {
+		return
+	}
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+}
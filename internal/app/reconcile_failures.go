@@ -0,0 +1,40 @@
+package app
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// reconcileFailures counts consecutive failed reconciliations per resource.
+// Controllers use it to report persisting failures in the resource status
+// while the request is retried with backoff.
+type reconcileFailures struct {
+	mu        sync.Mutex
+	threshold int
+	counts    map[types.NamespacedName]int
+}
+
+func newReconcileFailures(threshold int) *reconcileFailures {
+	return &reconcileFailures{
+		threshold: threshold,
+		counts:    map[types.NamespacedName]int{},
+	}
+}
+
+// recordFailure increments the failures count of the resource and reports
+// whether the threshold is reached. Non positive threshold disables reporting.
+func (f *reconcileFailures) recordFailure(key types.NamespacedName) (int, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.counts[key]++
+	count := f.counts[key]
+	return count, f.threshold > 0 && count >= f.threshold
+}
+
+// reset clears the failures count of the resource after a successful reconciliation.
+func (f *reconcileFailures) reset(key types.NamespacedName) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.counts, key)
+}
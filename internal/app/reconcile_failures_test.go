@@ -0,0 +1,57 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	apitypes "k8s.io/apimachinery/pkg/types"
+)
+
+func TestReconcileFailures(t *testing.T) {
+	fake := faker.New()
+	randomKey := func() apitypes.NamespacedName {
+		return apitypes.NamespacedName{
+			Namespace: "ns-" + fake.Internet().Slug(),
+			Name:      "gw-" + fake.Internet().Slug(),
+		}
+	}
+
+	t.Run("should report reached threshold", func(t *testing.T) {
+		failures := newReconcileFailures(2)
+		key := randomKey()
+
+		count, reached := failures.recordFailure(key)
+		assert.Equal(t, 1, count)
+		assert.False(t, reached)
+
+		count, reached = failures.recordFailure(key)
+		assert.Equal(t, 2, count)
+		assert.True(t, reached)
+
+		count, reached = failures.recordFailure(randomKey())
+		assert.Equal(t, 1, count)
+		assert.False(t, reached)
+	})
+
+	t.Run("should start over after reset", func(t *testing.T) {
+		failures := newReconcileFailures(2)
+		key := randomKey()
+		failures.recordFailure(key)
+		failures.reset(key)
+
+		count, reached := failures.recordFailure(key)
+		assert.Equal(t, 1, count)
+		assert.False(t, reached)
+	})
+
+	t.Run("should not report when threshold is disabled", func(t *testing.T) {
+		failures := newReconcileFailures(0)
+		key := randomKey()
+
+		for range 5 {
+			_, reached := failures.recordFailure(key)
+			assert.False(t, reached)
+		}
+	})
+}
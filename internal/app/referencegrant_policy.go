@@ -37,6 +37,34 @@ func referenceGrantAllowsServiceBackend(
 	return false, nil
 }
 
+// referenceGrantAllowsGatewaySecret checks if the Gateway may reference the certificate Secret.
+// Secrets in the Gateway namespace are always allowed.
+func referenceGrantAllowsGatewaySecret(
+	ctx context.Context,
+	k8sClient k8sClient,
+	gatewayNamespace string,
+	secretName apitypes.NamespacedName,
+) (bool, error) {
+	if secretName.Namespace == gatewayNamespace {
+		return true, nil
+	}
+
+	var grants gatewayv1beta1.ReferenceGrantList
+	if err := k8sClient.List(ctx, &grants, client.InNamespace(secretName.Namespace)); err != nil {
+		return false, fmt.Errorf("failed to list ReferenceGrants in namespace %s: %w", secretName.Namespace, err)
+	}
+
+	for _, grant := range grants.Items {
+		if !referenceGrantHasMatchingFrom(grant, "Gateway", gatewayNamespace) {
+			continue
+		}
+		if referenceGrantHasMatchingCoreTo(grant, secretKind, secretName.Name) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func referenceGrantHasMatchingFrom(
 	grant gatewayv1beta1.ReferenceGrant,
 	routeKind gatewayv1.Kind,
@@ -53,11 +81,15 @@ func referenceGrantHasMatchingFrom(
 }
 
 func referenceGrantHasMatchingServiceTo(grant gatewayv1beta1.ReferenceGrant, serviceName string) bool {
+	return referenceGrantHasMatchingCoreTo(grant, serviceKind, serviceName)
+}
+
+func referenceGrantHasMatchingCoreTo(grant gatewayv1beta1.ReferenceGrant, kind string, name string) bool {
 	for _, to := range grant.Spec.To {
-		if string(to.Group) != "" || string(to.Kind) != serviceKind {
+		if string(to.Group) != "" || string(to.Kind) != kind {
 			continue
 		}
-		if to.Name == nil || string(*to.Name) == serviceName {
+		if to.Name == nil || string(*to.Name) == name {
 			return true
 		}
 	}
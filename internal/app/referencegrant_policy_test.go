@@ -172,4 +172,66 @@ func TestReferenceGrantPolicy(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, allowed)
 	})
+
+	t.Run("referenceGrantAllowsGatewaySecret permits same namespace secret", func(t *testing.T) {
+		allowed, err := referenceGrantAllowsGatewaySecret(
+			t.Context(),
+			NewMockk8sClient(t),
+			"gateways",
+			types.NamespacedName{Namespace: "gateways", Name: "tls"},
+		)
+
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("referenceGrantAllowsGatewaySecret checks cross namespace secret grants", func(t *testing.T) {
+		setupGrants := func(t *testing.T, grants ...gatewayv1beta1.ReferenceGrant) k8sClient {
+			mockClient := NewMockk8sClient(t)
+			mockClient.EXPECT().
+				List(t.Context(), mock.AnythingOfType("*v1beta1.ReferenceGrantList"), client.InNamespace("certs")).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().Set(reflect.ValueOf(gatewayv1beta1.ReferenceGrantList{Items: grants}))
+					return nil
+				})
+			return mockClient
+		}
+		makeGrant := func(fromKind string, toKind string, toName string) gatewayv1beta1.ReferenceGrant {
+			to := gatewayv1beta1.ReferenceGrantTo{Group: gatewayv1.Group(""), Kind: gatewayv1.Kind(toKind)}
+			if toName != "" {
+				to.Name = lo.ToPtr(gatewayv1.ObjectName(toName))
+			}
+			return gatewayv1beta1.ReferenceGrant{
+				Spec: gatewayv1beta1.ReferenceGrantSpec{
+					From: []gatewayv1beta1.ReferenceGrantFrom{{
+						Group:     gatewayv1.Group(gatewayAPIGroup),
+						Kind:      gatewayv1.Kind(fromKind),
+						Namespace: gatewayv1.Namespace("gateways"),
+					}},
+					To: []gatewayv1beta1.ReferenceGrantTo{to},
+				},
+			}
+		}
+		secretName := types.NamespacedName{Namespace: "certs", Name: "tls"}
+
+		allowed, err := referenceGrantAllowsGatewaySecret(t.Context(), setupGrants(t,
+			makeGrant("HTTPRoute", secretKind, ""),
+			makeGrant("Gateway", serviceKind, ""),
+			makeGrant("Gateway", secretKind, "other"),
+		), "gateways", secretName)
+		require.NoError(t, err)
+		assert.False(t, allowed)
+
+		allowed, err = referenceGrantAllowsGatewaySecret(t.Context(), setupGrants(t,
+			makeGrant("Gateway", secretKind, "tls"),
+		), "gateways", secretName)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, err = referenceGrantAllowsGatewaySecret(t.Context(), setupGrants(t,
+			makeGrant("Gateway", secretKind, ""),
+		), "gateways", secretName)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	})
 }
@@ -1,9 +1,6 @@
 package app
 
 import (
-	"github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
-	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
-	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 	"go.uber.org/dig"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -14,17 +11,32 @@ import (
 func Register(container *dig.Container) error {
 	return di.ProvideAll(container,
 		func(c client.Client) k8sClient { return c },
-		func(c loadbalancer.LoadBalancerClient) ociLoadBalancerClient { return c },
-		func(c networkloadbalancer.NetworkLoadBalancerClient) ociNetworkLoadBalancerClient { return c },
-		func(c certificatesmanagement.CertificatesManagementClient) ociCertificatesManagementClient { return c },
-		func(w *ociapi.WorkRequestsWatcher) workRequestsWatcher { return w },
+		newOciLoadBalancerClientPort,
+		newOciNetworkLoadBalancerClientPort,
+		newOciCertificatesManagementClientPort,
+		newOciLoggingManagementClientPort,
+		newOciVirtualNetworkClientPort,
+		newOciDNSClientPort,
+		func(c *ociapi.RegionalMonitoringClient) ociMonitoringClient { return c },
+		func(w *ociapi.WorkRequestsWatcher, deps ociDryRunDeps) workRequestsWatcher {
+			return newWorkRequestsWatcherPort(w, deps)
+		},
+		di.ConstructorWithOpts{
+			Constructor: func(w *ociapi.NetworkLoadBalancerWorkRequestsWatcher, deps ociDryRunDeps) workRequestsWatcher {
+				return newWorkRequestsWatcherPort(w, deps)
+			},
+			Options: []dig.ProvideOption{dig.Name("networkLoadBalancerWorkRequestsWatcher")},
+		},
 		di.ConstructorWithOpts{
-			Constructor: func(w *ociapi.NetworkLoadBalancerWorkRequestsWatcher) workRequestsWatcher { return w },
-			Options:     []dig.ProvideOption{dig.Name("networkLoadBalancerWorkRequestsWatcher")},
+			Constructor: func(w *ociapi.LoggingWorkRequestsWatcher, deps ociDryRunDeps) workRequestsWatcher {
+				return newWorkRequestsWatcherPort(w, deps)
+			},
+			Options: []dig.ProvideOption{dig.Name("loggingWorkRequestsWatcher")},
 		},
 		NewGatewayClassController,
 		NewGatewayController,
 		NewNetworkLoadBalancerGatewayController,
+		NewGatewayConfigController,
 		NewHTTPRouteController,
 		NewGRPCRouteController,
 		NewTCPRouteController,
@@ -32,7 +44,12 @@ func Register(container *dig.Container) error {
 		NewTLSRouteController,
 		NewBackendTLSPolicyController,
 		newNetworkLoadBalancerOperationLocks,
+		newEndpointsFingerprints,
+		newGatewayCheckpoints,
+		newGatewayConfigValidation,
+		newRouteAdmissionWebhook,
 		di.ProvideFactoryAs[resourcesModel](newResourcesModel),
+		di.ProvideFactoryAs[programmingStateModel](newProgrammingStateModel),
 		di.ProvideFactoryAs[gatewayModel](newGatewayModel),
 		di.ProvideFactoryAs[networkLoadBalancerGatewayModel](newNetworkLoadBalancerGatewayModel),
 		di.ProvideFactoryAs[httpRouteModel](newHTTPRouteModel),
@@ -42,9 +59,27 @@ func Register(container *dig.Container) error {
 		di.ProvideFactoryAs[tlsRouteModel](newTLSRouteModel),
 		di.ProvideFactoryAs[ociLoadBalancerModel](newOciLoadBalancerModel),
 		di.ProvideFactoryAs[backendTLSPolicyModel](newBackendTLSPolicyModel),
+		di.ProvideFactoryAs[listenerClientCAModel](newListenerClientCAModel),
+		di.ProvideFactoryAs[ociLoggingModel](newOciLoggingModel),
+		di.ProvideFactoryAs[ociNetworkSecurityGroupModel](newOciNetworkSecurityGroupModel),
+		di.ProvideFactoryAs[ociDNSModel](newOciDNSModel),
+		newRoutingPolicyMetrics,
+		newBackendHealthMetrics,
+		newCertificateMetrics,
+		newBackendEndpointMetrics,
+		newRouteProgrammingMetrics,
+		newOCIReadinessMetrics,
+		newCertificateExpiryMonitor,
+		newLoadBalancerUtilizationMetrics,
+		newLoadBalancerUtilizationMonitor,
 		newOciLoadBalancerRoutingRulesMapper,
 		di.ProvideAs[*ociLoadBalancerRoutingRulesMapperImpl, ociLoadBalancerRoutingRulesMapper],
 		di.ProvideFactoryAs[httpBackendModel](newHTTPBackendModel),
 		NewWatchesModel,
+		NewStateDumpModel,
+		NewLoadBalancerCleanupModel,
+		NewLoadBalancerSnapshotModel,
+		NewOCIReadinessProbe,
+		NewWorkRequestHistoryRecorder,
 	)
 }
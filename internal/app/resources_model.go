@@ -42,6 +42,7 @@ type resourcesModel interface {
 type resourcesModelImpl struct {
 	client k8sClient
 	logger *slog.Logger
+	dryRun bool
 }
 
 func (m *resourcesModelImpl) setCondition(ctx context.Context, params setConditionParams) error {
@@ -78,7 +79,9 @@ func (m *resourcesModelImpl) setCondition(ctx context.Context, params setConditi
 		needsResourceUpdate = controllerutil.AddFinalizer(params.resource, params.finalizer)
 	}
 
-	if len(params.annotations) > 0 {
+	// Annotations record what was programmed, nothing is programmed in the dry run mode,
+	// so the resources are fully programmed once the dry run mode is disabled
+	if len(params.annotations) > 0 && !m.dryRun {
 		currentAnnotations := params.resource.GetAnnotations()
 		if currentAnnotations == nil {
 			currentAnnotations = make(map[string]string)
@@ -128,11 +131,14 @@ type resourcesModelDeps struct {
 
 	K8sClient  k8sClient
 	RootLogger *slog.Logger
+
+	DryRun bool `name:"config.ociapi.dryRun" optional:"true"`
 }
 
 func newResourcesModel(deps resourcesModelDeps) *resourcesModelImpl {
 	return &resourcesModelImpl{
 		client: deps.K8sClient,
 		logger: deps.RootLogger.WithGroup("resources-model"),
+		dryRun: deps.DryRun,
 	}
 }
@@ -222,6 +222,42 @@ func TestResourcesModelImpl_setCondition(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("DryRun_SkipsAnnotations", func(t *testing.T) {
+		fake := faker.New()
+		deps := newMockDeps(t)
+		deps.DryRun = true
+		model := newResourcesModel(deps)
+		mockClient, _ := deps.K8sClient.(*Mockk8sClient)
+		mockStatusWriter := k8sapi.NewMockSubResourceWriter(t)
+
+		gatewayClass := &gatewayv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       fake.Internet().Domain(),
+				Generation: rand.Int64(),
+			},
+			Spec: gatewayv1.GatewayClassSpec{
+				ControllerName: ControllerClassName,
+			},
+		}
+
+		params := setConditionParams{
+			resource:      gatewayClass,
+			conditions:    &gatewayClass.Status.Conditions,
+			conditionType: fake.Internet().Domain(),
+			status:        metav1.ConditionTrue,
+			reason:        fake.Lorem().Word(),
+			message:       fake.Lorem().Sentence(10),
+			annotations:   map[string]string{"key-" + fake.Lorem().Word(): fake.Lorem().Word()},
+		}
+
+		mockClient.EXPECT().Status().Return(mockStatusWriter).Once()
+		mockStatusWriter.EXPECT().Update(t.Context(), gatewayClass, mock.Anything).Return(nil).Once()
+
+		err := model.setCondition(t.Context(), params)
+		require.NoError(t, err)
+		assert.Empty(t, gatewayClass.GetAnnotations())
+	})
+
 	t.Run("HappyPath_AddsAnnotations_NoInitial", func(t *testing.T) {
 		fake := faker.New()
 		deps := newMockDeps(t)
@@ -0,0 +1,162 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"go.uber.org/dig"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// routeReasonAdmissionDenied is the reason of the Accepted condition of routes not allowed
+// by the route admission hook.
+const routeReasonAdmissionDenied gatewayv1.RouteConditionReason = "AdmissionDenied"
+
+// routeAdmissionWebhookMaxResponseBytes limits the size of the webhook response read by the controller.
+const routeAdmissionWebhookMaxResponseBytes = 1 << 20
+
+// routeAdmissionReview describes the route the gateway is about to accept.
+type routeAdmissionReview struct {
+	Kind      string                `json:"kind"`
+	Route     client.Object         `json:"route"`
+	Gateway   routeAdmissionGateway `json:"gateway"`
+	Listeners []string              `json:"listeners"`
+}
+
+type routeAdmissionGateway struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// routeAdmissionResponse is the decision of the route admission hook.
+type routeAdmissionResponse struct {
+	Allowed bool   `json:"allowed"`
+	Message string `json:"message,omitempty"`
+}
+
+// routeAdmissionHook is invoked before a route is accepted by a gateway, so platform teams can
+// enforce organization policies, e.g. block wildcard paths in production. Routes not allowed by
+// the hook get the Accepted condition set to False with the message of the hook. Errors of the
+// hook fail the reconcile, so the route is retried rather than accepted.
+type routeAdmissionHook interface {
+	admitRoute(ctx context.Context, review routeAdmissionReview) (routeAdmissionResponse, error)
+}
+
+// routeAdmissionHookFunc adapts a function to the routeAdmissionHook.
+type routeAdmissionHookFunc func(ctx context.Context, review routeAdmissionReview) (routeAdmissionResponse, error)
+
+func (f routeAdmissionHookFunc) admitRoute(
+	ctx context.Context,
+	review routeAdmissionReview,
+) (routeAdmissionResponse, error) {
+	return f(ctx, review)
+}
+
+// admitL7Route asks the hook whether the route may be attached to the listeners of the gateway.
+// It returns the rejection message, empty if the route is allowed or there is no hook.
+func admitL7Route(
+	ctx context.Context,
+	hook routeAdmissionHook,
+	kind string,
+	route client.Object,
+	gateway gatewayv1.Gateway,
+	matchedListeners []gatewayv1.Listener,
+) (string, error) {
+	if hook == nil {
+		return "", nil
+	}
+	listeners := make([]string, len(matchedListeners))
+	for i, listener := range matchedListeners {
+		listeners[i] = string(listener.Name)
+	}
+	response, err := hook.admitRoute(ctx, routeAdmissionReview{
+		Kind:      kind,
+		Route:     route,
+		Gateway:   routeAdmissionGateway{Namespace: gateway.Namespace, Name: gateway.Name},
+		Listeners: listeners,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to admit %s %s/%s: %w", kind, route.GetNamespace(), route.GetName(), err)
+	}
+	if response.Allowed {
+		return "", nil
+	}
+	if response.Message == "" {
+		return "Route is not allowed by the route admission hook", nil
+	}
+	return response.Message, nil
+}
+
+type routeAdmissionWebhookDeps struct {
+	dig.In
+
+	RootLogger *slog.Logger
+	URL        string        `name:"config.routeAdmission.webhookUrl"`
+	Timeout    time.Duration `name:"config.routeAdmission.timeout"`
+}
+
+// routeAdmissionWebhook is the route admission hook posting the review as JSON
+// to the configured URL and reading the decision from the response.
+type routeAdmissionWebhook struct {
+	logger     *slog.Logger
+	url        string
+	httpClient *http.Client
+}
+
+// newRouteAdmissionWebhook returns the route admission hook calling the configured webhook,
+// nil if the webhook is not configured.
+func newRouteAdmissionWebhook(deps routeAdmissionWebhookDeps) routeAdmissionHook {
+	if deps.URL == "" {
+		return nil
+	}
+	return &routeAdmissionWebhook{
+		logger:     deps.RootLogger.WithGroup("route-admission-webhook"),
+		url:        deps.URL,
+		httpClient: &http.Client{Timeout: deps.Timeout},
+	}
+}
+
+func (w *routeAdmissionWebhook) admitRoute(
+	ctx context.Context,
+	review routeAdmissionReview,
+) (routeAdmissionResponse, error) {
+	body, err := json.Marshal(review)
+	if err != nil {
+		return routeAdmissionResponse{}, fmt.Errorf("failed to marshal route admission review: %w", err)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return routeAdmissionResponse{}, fmt.Errorf("failed to create route admission request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := w.httpClient.Do(request)
+	if err != nil {
+		return routeAdmissionResponse{}, fmt.Errorf("route admission webhook request failed: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return routeAdmissionResponse{}, fmt.Errorf(
+			"route admission webhook responded with status %d", response.StatusCode,
+		)
+	}
+
+	var decision routeAdmissionResponse
+	if err = json.NewDecoder(io.LimitReader(response.Body, routeAdmissionWebhookMaxResponseBytes)).
+		Decode(&decision); err != nil {
+		return routeAdmissionResponse{}, fmt.Errorf("failed to decode route admission response: %w", err)
+	}
+	w.logger.DebugContext(ctx, "Route admission webhook decision",
+		slog.String("kind", review.Kind),
+		slog.String("route", review.Route.GetNamespace()+"/"+review.Route.GetName()),
+		slog.Bool("allowed", decision.Allowed),
+	)
+	return decision, nil
+}
@@ -0,0 +1,135 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+)
+
+func TestRouteAdmission(t *testing.T) {
+	t.Run("admitL7Route", func(t *testing.T) {
+		t.Run("allows routes without hook", func(t *testing.T) {
+			route := makeRandomHTTPRoute()
+
+			message, err := admitL7Route(t.Context(), nil, "HTTPRoute", &route, *newRandomGateway(), nil)
+
+			require.NoError(t, err)
+			assert.Empty(t, message)
+		})
+
+		t.Run("allows routes allowed by hook", func(t *testing.T) {
+			route := makeRandomHTTPRoute()
+			hook := routeAdmissionHookFunc(func(context.Context, routeAdmissionReview) (routeAdmissionResponse, error) {
+				return routeAdmissionResponse{Allowed: true, Message: faker.New().Lorem().Sentence(3)}, nil
+			})
+
+			message, err := admitL7Route(t.Context(), hook, "HTTPRoute", &route, *newRandomGateway(), nil)
+
+			require.NoError(t, err)
+			assert.Empty(t, message)
+		})
+
+		t.Run("uses default message for denied routes", func(t *testing.T) {
+			route := makeRandomHTTPRoute()
+			hook := routeAdmissionHookFunc(func(context.Context, routeAdmissionReview) (routeAdmissionResponse, error) {
+				return routeAdmissionResponse{Allowed: false}, nil
+			})
+
+			message, err := admitL7Route(t.Context(), hook, "HTTPRoute", &route, *newRandomGateway(), nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, "Route is not allowed by the route admission hook", message)
+		})
+
+		t.Run("wraps hook errors", func(t *testing.T) {
+			route := makeRandomHTTPRoute()
+			wantErr := errors.New(faker.New().Lorem().Sentence(3))
+			hook := routeAdmissionHookFunc(func(context.Context, routeAdmissionReview) (routeAdmissionResponse, error) {
+				return routeAdmissionResponse{}, wantErr
+			})
+
+			_, err := admitL7Route(t.Context(), hook, "HTTPRoute", &route, *newRandomGateway(), nil)
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("routeAdmissionWebhook", func(t *testing.T) {
+		newWebhook := func(t *testing.T, handler http.HandlerFunc) routeAdmissionHook {
+			server := httptest.NewServer(handler)
+			t.Cleanup(server.Close)
+			return newRouteAdmissionWebhook(routeAdmissionWebhookDeps{
+				RootLogger: diag.RootTestLogger(),
+				URL:        server.URL,
+				Timeout:    time.Second,
+			})
+		}
+
+		t.Run("returns nil hook when url is not configured", func(t *testing.T) {
+			hook := newRouteAdmissionWebhook(routeAdmissionWebhookDeps{RootLogger: diag.RootTestLogger()})
+
+			assert.Nil(t, hook)
+		})
+
+		t.Run("posts review and returns decision", func(t *testing.T) {
+			route := makeRandomHTTPRoute()
+			gateway := newRandomGateway()
+			wantResponse := routeAdmissionResponse{Allowed: false, Message: faker.New().Lorem().Sentence(5)}
+			var gotBody map[string]any
+			hook := newWebhook(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+				assert.NoError(t, json.NewEncoder(w).Encode(wantResponse))
+			})
+
+			got, err := hook.admitRoute(t.Context(), routeAdmissionReview{
+				Kind:      "HTTPRoute",
+				Route:     &route,
+				Gateway:   routeAdmissionGateway{Namespace: gateway.Namespace, Name: gateway.Name},
+				Listeners: []string{"http"},
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, wantResponse, got)
+			assert.Equal(t, "HTTPRoute", gotBody["kind"])
+			assert.Equal(t, map[string]any{"namespace": gateway.Namespace, "name": gateway.Name}, gotBody["gateway"])
+			assert.Equal(t, []any{"http"}, gotBody["listeners"])
+			gotRoute, _ := gotBody["route"].(map[string]any)
+			gotMetadata, _ := gotRoute["metadata"].(map[string]any)
+			assert.Equal(t, route.Name, gotMetadata["name"])
+		})
+
+		t.Run("fails on unexpected status", func(t *testing.T) {
+			route := makeRandomHTTPRoute()
+			hook := newWebhook(t, func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			})
+
+			_, err := hook.admitRoute(t.Context(), routeAdmissionReview{Kind: "HTTPRoute", Route: &route})
+
+			require.ErrorContains(t, err, "route admission webhook responded with status 500")
+		})
+
+		t.Run("fails on invalid response", func(t *testing.T) {
+			route := makeRandomHTTPRoute()
+			hook := newWebhook(t, func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("not json"))
+			})
+
+			_, err := hook.admitRoute(t.Context(), routeAdmissionReview{Kind: "HTTPRoute", Route: &route})
+
+			require.ErrorContains(t, err, "failed to decode route admission response")
+		})
+	})
+}
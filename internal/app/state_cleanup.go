@@ -0,0 +1,263 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	"go.uber.org/dig"
+	apitypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+const (
+	OrphanedRoutingPolicy = "RoutingPolicy"
+	OrphanedRuleSet       = "RuleSet"
+	OrphanedBackendSet    = "BackendSet"
+	OrphanedCertificate   = "Certificate"
+)
+
+// Patterns of OCI resource names produced by the controller. Resources with other names
+// were not created by the controller and are never reported as orphaned. Backend set names
+// are matched by backendSetNamePattern, they are only reported when carrying a controller marker.
+var (
+	orphanRoutingPolicyNamePattern = regexp.MustCompile(`(^p_[0-9a-f]+_.+|_policy)$`)
+	orphanRuleSetNamePattern       = regexp.MustCompile(`^(rs|ac|rd)_.+$`)
+	orphanCertificateNamePattern   = regexp.MustCompile(`^[a-z0-9].*-rev-[0-9]+$`)
+
+	// Superseded backend sets are named after the default naming scheme for known backends,
+	// so they are reported without the controller marker, see stateDumpSource.
+	orphanAnyNamePattern = regexp.MustCompile(`.*`)
+)
+
+// orphanSharedGatewayBackendSetNamePattern matches default backend sets of gateways sharing the load balancer.
+var orphanSharedGatewayBackendSetNamePattern = fmt.Sprintf(
+	`^([A-Za-z][A-Za-z0-9_]*_)?gw_[0-9a-f]{%d}_`, ociGatewayNamePrefixHashLength,
+)
+
+// OrphanedResource is an OCI resource named after the controller naming scheme
+// that is not owned by any K8s resource and not used by other OCI resources.
+type OrphanedResource struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+type LoadBalancerOrphans struct {
+	GatewayConfig  string             `json:"gatewayConfig"`
+	LoadBalancerID string             `json:"loadBalancerId"`
+	Resources      []OrphanedResource `json:"resources"`
+
+	// config is used to resolve the region of the load balancer when deleting orphans
+	config types.GatewayConfig
+}
+
+// LoadBalancerCleanupModel finds and deletes orphaned OCI resources. Those are usually
+// left behind when K8s resources were removed while the controller was not running,
+// for example after restoring the cluster from a backup.
+type LoadBalancerCleanupModel struct {
+	logger              *slog.Logger
+	stateDumpModel      *StateDumpModel
+	ociClient           ociLoadBalancerClient
+	workRequestsWatcher workRequestsWatcher
+}
+
+type LoadBalancerCleanupModelDeps struct {
+	dig.In
+
+	RootLogger          *slog.Logger
+	StateDumpModel      *StateDumpModel
+	OciClient           ociLoadBalancerClient
+	WorkRequestsWatcher workRequestsWatcher
+}
+
+func NewLoadBalancerCleanupModel(deps LoadBalancerCleanupModelDeps) *LoadBalancerCleanupModel {
+	return &LoadBalancerCleanupModel{
+		logger:              deps.RootLogger.WithGroup("lb-cleanup"),
+		stateDumpModel:      deps.StateDumpModel,
+		ociClient:           deps.OciClient,
+		workRequestsWatcher: deps.WorkRequestsWatcher,
+	}
+}
+
+// FindOrphans returns orphaned resources of the OCI Load Balancer of the GatewayConfig.
+// Listeners and routing rules are not reported, they are removed by the regular
+// programming of the gateway and routes.
+func (m *LoadBalancerCleanupModel) FindOrphans(
+	ctx context.Context,
+	configKey apitypes.NamespacedName,
+) (*LoadBalancerOrphans, error) {
+	source, err := m.stateDumpModel.loadSource(ctx, configKey)
+	if err != nil {
+		return nil, err
+	}
+	lb := source.loadBalancer
+	backendSetNamePattern := m.backendSetNamePattern(ctx, source)
+	isOrphan := func(name string, pattern *regexp.Regexp, used map[string]struct{}) bool {
+		_, isUsed := used[name]
+		return !isUsed && len(source.owners[name]) == 0 && pattern.MatchString(name)
+	}
+
+	usedPolicies := map[string]struct{}{}
+	usedRuleSets := map[string]struct{}{}
+	usedCertificates := map[string]struct{}{}
+	usedBackendSets := map[string]struct{}{}
+	for _, listener := range lb.Listeners {
+		usedPolicies[lo.FromPtr(listener.RoutingPolicyName)] = struct{}{}
+		usedBackendSets[lo.FromPtr(listener.DefaultBackendSetName)] = struct{}{}
+		for _, ruleSet := range listener.RuleSetNames {
+			usedRuleSets[ruleSet] = struct{}{}
+		}
+		if listener.SslConfiguration != nil {
+			usedCertificates[lo.FromPtr(listener.SslConfiguration.CertificateName)] = struct{}{}
+		}
+	}
+
+	var resources []OrphanedResource
+	for name, policy := range lb.RoutingPolicies {
+		if isOrphan(name, orphanRoutingPolicyNamePattern, usedPolicies) {
+			resources = append(resources, OrphanedResource{Kind: OrphanedRoutingPolicy, Name: name})
+			continue
+		}
+		// Backend sets used by orphaned policies become orphaned once policies are deleted
+		for _, rule := range policy.Rules {
+			for _, action := range rule.Actions {
+				if forward, ok := action.(loadbalancer.ForwardToBackendSet); ok {
+					usedBackendSets[lo.FromPtr(forward.BackendSetName)] = struct{}{}
+				}
+			}
+		}
+	}
+	for name := range lb.RuleSets {
+		if isOrphan(name, orphanRuleSetNamePattern, usedRuleSets) {
+			resources = append(resources, OrphanedResource{Kind: OrphanedRuleSet, Name: name})
+		}
+	}
+	for name, backendSet := range lb.BackendSets {
+		if backendSet.SslConfiguration != nil {
+			usedCertificates[lo.FromPtr(backendSet.SslConfiguration.CertificateName)] = struct{}{}
+		}
+		_, superseded := source.supersededBackendSets[name]
+		if isOrphan(name, backendSetNamePattern, usedBackendSets) ||
+			superseded && isOrphan(name, orphanAnyNamePattern, usedBackendSets) {
+			resources = append(resources, OrphanedResource{Kind: OrphanedBackendSet, Name: name})
+		}
+	}
+	for name := range lb.Certificates {
+		if isOrphan(name, orphanCertificateNamePattern, usedCertificates) {
+			resources = append(resources, OrphanedResource{Kind: OrphanedCertificate, Name: name})
+		}
+	}
+
+	// Sorted in the order resources can be deleted in
+	kindsOrder := []string{OrphanedRoutingPolicy, OrphanedRuleSet, OrphanedBackendSet, OrphanedCertificate}
+	slices.SortFunc(resources, func(a, b OrphanedResource) int {
+		return cmp.Or(
+			cmp.Compare(slices.Index(kindsOrder, a.Kind), slices.Index(kindsOrder, b.Kind)),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	return &LoadBalancerOrphans{
+		GatewayConfig:  configKey.String(),
+		LoadBalancerID: source.config.Spec.LoadBalancerID,
+		Resources:      resources,
+		config:         source.config,
+	}, nil
+}
+
+// backendSetNamePattern returns the pattern of backend set names carrying a controller marker:
+// the prefix of gateways sharing the load balancer, the configured name prefix or the hash
+// suffix of the configured naming scheme. Backend sets named after the default scheme can not
+// be told apart from the ones created manually, so they are only reported when superseded.
+func (m *LoadBalancerCleanupModel) backendSetNamePattern(
+	ctx context.Context,
+	source *stateDumpSource,
+) *regexp.Regexp {
+	configs := []types.GatewayConfig{source.config}
+	for i := range source.gateways {
+		configs = append(configs,
+			m.stateDumpModel.gatewayConfigWithClassDefaults(ctx, source.config, &source.gateways[i]),
+		)
+	}
+	alternatives := []string{orphanSharedGatewayBackendSetNamePattern}
+	for _, config := range configs {
+		if config.Spec.NamePrefix != "" {
+			alternatives = append(alternatives, "^"+regexp.QuoteMeta(config.Spec.NamePrefix+"_"))
+		}
+		naming := ociResourceNamingFromConfig(config)
+		if naming.hashSuffixLength > 0 {
+			alternatives = append(alternatives, fmt.Sprintf(`%s[0-9a-f]{%d}$`,
+				regexp.QuoteMeta(naming.nameSeparator()),
+				min(naming.hashSuffixLength, hex.EncodedLen(sha256.Size)),
+			))
+		}
+	}
+	return regexp.MustCompile(strings.Join(lo.Uniq(alternatives), "|"))
+}
+
+// DeleteOrphans deletes resources returned by FindOrphans one by one, waiting for
+// each deletion to complete. It stops on the first failure.
+func (m *LoadBalancerCleanupModel) DeleteOrphans(ctx context.Context, orphans *LoadBalancerOrphans) error {
+	ctx = ociRegionContext(ctx, orphans.config)
+	for _, resource := range orphans.Resources {
+		workRequestID, err := m.deleteOrphan(ctx, orphans.LoadBalancerID, resource)
+		if err != nil {
+			return fmt.Errorf("failed to delete %s %s: %w", resource.Kind, resource.Name, err)
+		}
+		if workRequestID == nil {
+			return fmt.Errorf("failed to delete %s %s: missing work request id", resource.Kind, resource.Name)
+		}
+		if err = m.workRequestsWatcher.WaitFor(ctx, *workRequestID); err != nil {
+			return fmt.Errorf("failed to wait for %s %s deletion: %w", resource.Kind, resource.Name, err)
+		}
+		m.logger.InfoContext(ctx, "Deleted orphaned OCI resource",
+			slog.String("loadBalancerId", orphans.LoadBalancerID),
+			slog.String("kind", resource.Kind),
+			slog.String("name", resource.Name),
+		)
+	}
+	return nil
+}
+
+func (m *LoadBalancerCleanupModel) deleteOrphan(
+	ctx context.Context,
+	loadBalancerID string,
+	resource OrphanedResource,
+) (*string, error) {
+	switch resource.Kind {
+	case OrphanedRoutingPolicy:
+		res, err := m.ociClient.DeleteRoutingPolicy(ctx, loadbalancer.DeleteRoutingPolicyRequest{
+			LoadBalancerId:    &loadBalancerID,
+			RoutingPolicyName: &resource.Name,
+		})
+		return res.OpcWorkRequestId, err
+	case OrphanedRuleSet:
+		res, err := m.ociClient.DeleteRuleSet(ctx, loadbalancer.DeleteRuleSetRequest{
+			LoadBalancerId: &loadBalancerID,
+			RuleSetName:    &resource.Name,
+		})
+		return res.OpcWorkRequestId, err
+	case OrphanedBackendSet:
+		res, err := m.ociClient.DeleteBackendSet(ctx, loadbalancer.DeleteBackendSetRequest{
+			LoadBalancerId: &loadBalancerID,
+			BackendSetName: &resource.Name,
+		})
+		return res.OpcWorkRequestId, err
+	case OrphanedCertificate:
+		res, err := m.ociClient.DeleteCertificate(ctx, loadbalancer.DeleteCertificateRequest{
+			LoadBalancerId:  &loadBalancerID,
+			CertificateName: &resource.Name,
+		})
+		return res.OpcWorkRequestId, err
+	default:
+		return nil, fmt.Errorf("unsupported resource kind %s", resource.Kind)
+	}
+}
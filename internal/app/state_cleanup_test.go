@@ -0,0 +1,353 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestLoadBalancerCleanupModel(t *testing.T) {
+	configKey := apitypes.NamespacedName{Namespace: "oke-gw", Name: "edge-config"}
+
+	newModel := func(
+		t *testing.T,
+		loadBalancerID string,
+		specOpts ...func(spec *types.GatewayConfigSpec),
+	) (*LoadBalancerCleanupModel, *MockociLoadBalancerClient, *MockworkRequestsWatcher) {
+		config := types.GatewayConfig{
+			ObjectMeta: metav1.ObjectMeta{Namespace: configKey.Namespace, Name: configKey.Name},
+			Spec:       types.GatewayConfigSpec{LoadBalancerID: loadBalancerID},
+		}
+		for _, opt := range specOpts {
+			opt(&config.Spec)
+		}
+		gateway := gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: configKey.Namespace, Name: "edge"},
+			Spec: gatewayv1.GatewaySpec{
+				Infrastructure: &gatewayv1.GatewayInfrastructure{
+					ParametersRef: &gatewayv1.LocalParametersReference{Name: configKey.Name},
+				},
+				Listeners: []gatewayv1.Listener{{Name: "http", Protocol: gatewayv1.HTTPProtocolType, Port: 80}},
+			},
+		}
+		k8sReader := fake.NewClientBuilder().
+			WithScheme(newL4TestScheme(t)).
+			WithObjects(&config, &gateway).
+			Build()
+		ociClient := NewMockociLoadBalancerClient(t)
+		watcher := NewMockworkRequestsWatcher(t)
+		model := NewLoadBalancerCleanupModel(LoadBalancerCleanupModelDeps{
+			RootLogger: diag.RootTestLogger(),
+			StateDumpModel: NewStateDumpModel(StateDumpModelDeps{
+				RootLogger: diag.RootTestLogger(),
+				K8sReader:  k8sReader,
+				OciClient:  ociClient,
+			}),
+			OciClient:           ociClient,
+			WorkRequestsWatcher: watcher,
+		})
+		return model, ociClient, watcher
+	}
+
+	t.Run("FindOrphans", func(t *testing.T) {
+		t.Run("returns unowned and unused resources matching naming scheme", func(t *testing.T) {
+			loadBalancerID := faker.New().UUID().V4()
+			model, ociClient, _ := newModel(t, loadBalancerID)
+			forwardTo := func(backendSet string) loadbalancer.RoutingRule {
+				return loadbalancer.RoutingRule{
+					Name: new("p0000_" + backendSet),
+					Actions: []loadbalancer.Action{
+						loadbalancer.ForwardToBackendSet{BackendSetName: new(backendSet)},
+					},
+				}
+			}
+			ociClient.EXPECT().
+				GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{LoadBalancerId: &loadBalancerID}).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadbalancer.LoadBalancer{
+					Listeners: map[string]loadbalancer.Listener{
+						"http": {
+							DefaultBackendSetName: new("edge-default"),
+							RoutingPolicyName:     new("http_policy"),
+						},
+						"manual": {
+							DefaultBackendSetName: new("manual-default"),
+							RoutingPolicyName:     new("stale_policy"),
+							RuleSetNames:          []string{"rs_stale"},
+							SslConfiguration: &loadbalancer.SslConfiguration{
+								CertificateName: new("apps-used-cert-rev-3"),
+							},
+						},
+					},
+					RoutingPolicies: map[string]loadbalancer.RoutingPolicy{
+						"http_policy":    {Rules: []loadbalancer.RoutingRule{forwardTo("apps-used-80")}},
+						"stale_policy":   {Rules: []loadbalancer.RoutingRule{forwardTo("apps-stale-policy-80")}},
+						"removed_policy": {Rules: []loadbalancer.RoutingRule{forwardTo("apps-removed-80")}},
+						"ManualPolicy":   {},
+					},
+					RuleSets: map[string]loadbalancer.RuleSet{
+						"rs_edge":      {},
+						"rs_stale":     {},
+						"ac_removed":   {},
+						"manual_rules": {},
+					},
+					BackendSets: map[string]loadbalancer.BackendSet{
+						"edge-default":                {},
+						"manual-default":              {},
+						"apps-used-80":                {},
+						"apps-stale-policy-80":        {},
+						"apps-removed-80":             {},
+						"apps-stale-80":               {},
+						"gw_0a1b2c3d_removed-default": {},
+						"edge_apps_renamed_80_0a1b":   {},
+						"apps-tls-443": {
+							SslConfiguration: &loadbalancer.SslConfiguration{
+								CertificateName: new("apps-backend-ca-rev-5"),
+							},
+						},
+						"Manual_Backends": {},
+					},
+					Certificates: map[string]loadbalancer.Certificate{
+						"apps-used-cert-rev-3":  {},
+						"apps-backend-ca-rev-5": {},
+						"apps-stale-cert-rev-1": {},
+						"manual-cert":           {},
+					},
+				}}, nil)
+
+			orphans, err := model.FindOrphans(t.Context(), configKey)
+			require.NoError(t, err)
+			assert.Equal(t, configKey.String(), orphans.GatewayConfig)
+			assert.Equal(t, loadBalancerID, orphans.LoadBalancerID)
+			assert.Equal(t, []OrphanedResource{
+				{Kind: OrphanedRoutingPolicy, Name: "removed_policy"},
+				{Kind: OrphanedRuleSet, Name: "ac_removed"},
+				{Kind: OrphanedBackendSet, Name: "gw_0a1b2c3d_removed-default"},
+				{Kind: OrphanedCertificate, Name: "apps-stale-cert-rev-1"},
+			}, orphans.Resources)
+		})
+
+		t.Run("returns backend sets with controller markers", func(t *testing.T) {
+			loadBalancerID := faker.New().UUID().V4()
+			model, ociClient, _ := newModel(t, loadBalancerID, func(spec *types.GatewayConfigSpec) {
+				spec.NamePrefix = "edge"
+				spec.Naming = &types.OCIResourceNaming{Separator: "_", HashSuffixLength: 4}
+			})
+			ociClient.EXPECT().
+				GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{LoadBalancerId: &loadBalancerID}).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadbalancer.LoadBalancer{
+					BackendSets: map[string]loadbalancer.BackendSet{
+						"apps-stale-80":                {},
+						"apps_stale_80":                {},
+						"apps_renamed_80_0a1b":         {},
+						"edge_removed_default":         {},
+						"other_gw_0a1b2c3d_removed_80": {},
+						"Manual_Backends":              {},
+					},
+				}}, nil)
+
+			orphans, err := model.FindOrphans(t.Context(), configKey)
+			require.NoError(t, err)
+			assert.Equal(t, []OrphanedResource{
+				{Kind: OrphanedBackendSet, Name: "apps_renamed_80_0a1b"},
+				{Kind: OrphanedBackendSet, Name: "edge_removed_default"},
+				{Kind: OrphanedBackendSet, Name: "other_gw_0a1b2c3d_removed_80"},
+			}, orphans.Resources)
+		})
+
+		t.Run("returns backend sets superseded by naming scheme", func(t *testing.T) {
+			loadBalancerID := faker.New().UUID().V4()
+			model, ociClient, _ := newModel(t, loadBalancerID, func(spec *types.GatewayConfigSpec) {
+				spec.Naming = &types.OCIResourceNaming{HashSuffixLength: 4}
+			})
+			ociClient.EXPECT().
+				GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{LoadBalancerId: &loadBalancerID}).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadbalancer.LoadBalancer{
+					BackendSets: map[string]loadbalancer.BackendSet{
+						"edge-default":   {},
+						"apps-manual-80": {},
+					},
+				}}, nil)
+
+			orphans, err := model.FindOrphans(t.Context(), configKey)
+			require.NoError(t, err)
+			assert.Equal(t, []OrphanedResource{
+				{Kind: OrphanedBackendSet, Name: "edge-default"},
+			}, orphans.Resources)
+		})
+
+		t.Run("keeps superseded backend sets still in use", func(t *testing.T) {
+			loadBalancerID := faker.New().UUID().V4()
+			model, ociClient, _ := newModel(t, loadBalancerID, func(spec *types.GatewayConfigSpec) {
+				spec.Naming = &types.OCIResourceNaming{HashSuffixLength: 4}
+			})
+			ociClient.EXPECT().
+				GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{LoadBalancerId: &loadBalancerID}).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadbalancer.LoadBalancer{
+					Listeners: map[string]loadbalancer.Listener{
+						"http": {Name: new("http"), DefaultBackendSetName: new("edge-default")},
+					},
+					BackendSets: map[string]loadbalancer.BackendSet{
+						"edge-default": {},
+					},
+				}}, nil)
+
+			orphans, err := model.FindOrphans(t.Context(), configKey)
+			require.NoError(t, err)
+			assert.Empty(t, orphans.Resources)
+		})
+
+		t.Run("fails when OCI Load Balancer can not be fetched", func(t *testing.T) {
+			model, ociClient, _ := newModel(t, faker.New().UUID().V4())
+			wantErr := errors.New(faker.New().Lorem().Sentence(3))
+			ociClient.EXPECT().
+				GetLoadBalancer(t.Context(), mock.Anything).
+				Return(loadbalancer.GetLoadBalancerResponse{}, wantErr)
+
+			_, err := model.FindOrphans(t.Context(), configKey)
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("DeleteOrphans", func(t *testing.T) {
+		t.Run("deletes resources in order", func(t *testing.T) {
+			loadBalancerID := faker.New().UUID().V4()
+			model, ociClient, watcher := newModel(t, loadBalancerID)
+			var deleted []string
+			expectWait := func(workRequestID string) {
+				watcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Once()
+			}
+			ociClient.EXPECT().
+				DeleteRoutingPolicy(t.Context(), loadbalancer.DeleteRoutingPolicyRequest{
+					LoadBalancerId:    &loadBalancerID,
+					RoutingPolicyName: new("removed_policy"),
+				}).
+				RunAndReturn(func(_ context.Context, _ loadbalancer.DeleteRoutingPolicyRequest) (
+					loadbalancer.DeleteRoutingPolicyResponse, error) {
+					deleted = append(deleted, "policy")
+					return loadbalancer.DeleteRoutingPolicyResponse{OpcWorkRequestId: new("wr-policy")}, nil
+				})
+			expectWait("wr-policy")
+			ociClient.EXPECT().
+				DeleteRuleSet(t.Context(), loadbalancer.DeleteRuleSetRequest{
+					LoadBalancerId: &loadBalancerID,
+					RuleSetName:    new("ac_removed"),
+				}).
+				RunAndReturn(func(_ context.Context, _ loadbalancer.DeleteRuleSetRequest) (
+					loadbalancer.DeleteRuleSetResponse, error) {
+					deleted = append(deleted, "rule set")
+					return loadbalancer.DeleteRuleSetResponse{OpcWorkRequestId: new("wr-rule-set")}, nil
+				})
+			expectWait("wr-rule-set")
+			ociClient.EXPECT().
+				DeleteBackendSet(t.Context(), loadbalancer.DeleteBackendSetRequest{
+					LoadBalancerId: &loadBalancerID,
+					BackendSetName: new("apps-removed-80"),
+				}).
+				RunAndReturn(func(_ context.Context, _ loadbalancer.DeleteBackendSetRequest) (
+					loadbalancer.DeleteBackendSetResponse, error) {
+					deleted = append(deleted, "backend set")
+					return loadbalancer.DeleteBackendSetResponse{OpcWorkRequestId: new("wr-backend-set")}, nil
+				})
+			expectWait("wr-backend-set")
+			ociClient.EXPECT().
+				DeleteCertificate(t.Context(), loadbalancer.DeleteCertificateRequest{
+					LoadBalancerId:  &loadBalancerID,
+					CertificateName: new("apps-stale-cert-rev-1"),
+				}).
+				RunAndReturn(func(_ context.Context, _ loadbalancer.DeleteCertificateRequest) (
+					loadbalancer.DeleteCertificateResponse, error) {
+					deleted = append(deleted, "certificate")
+					return loadbalancer.DeleteCertificateResponse{OpcWorkRequestId: new("wr-certificate")}, nil
+				})
+			expectWait("wr-certificate")
+
+			err := model.DeleteOrphans(t.Context(), &LoadBalancerOrphans{
+				LoadBalancerID: loadBalancerID,
+				Resources: []OrphanedResource{
+					{Kind: OrphanedRoutingPolicy, Name: "removed_policy"},
+					{Kind: OrphanedRuleSet, Name: "ac_removed"},
+					{Kind: OrphanedBackendSet, Name: "apps-removed-80"},
+					{Kind: OrphanedCertificate, Name: "apps-stale-cert-rev-1"},
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"policy", "rule set", "backend set", "certificate"}, deleted)
+		})
+
+		t.Run("uses the region of the GatewayConfig", func(t *testing.T) {
+			loadBalancerID := faker.New().UUID().V4()
+			region := "eu-frankfurt-1"
+			model, ociClient, watcher := newModel(t, loadBalancerID, func(spec *types.GatewayConfigSpec) {
+				spec.Region = region
+			})
+			inRegion := mock.MatchedBy(func(ctx context.Context) bool {
+				return ociapi.RegionFromContext(ctx) == region
+			})
+			ociClient.EXPECT().
+				GetLoadBalancer(inRegion, mock.Anything).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadbalancer.LoadBalancer{
+					RuleSets: map[string]loadbalancer.RuleSet{"ac_removed": {}},
+				}}, nil)
+			ociClient.EXPECT().
+				DeleteRuleSet(inRegion, loadbalancer.DeleteRuleSetRequest{
+					LoadBalancerId: &loadBalancerID,
+					RuleSetName:    new("ac_removed"),
+				}).
+				Return(loadbalancer.DeleteRuleSetResponse{OpcWorkRequestId: new("wr-rule-set")}, nil)
+			watcher.EXPECT().WaitFor(inRegion, "wr-rule-set").Return(nil)
+
+			orphans, err := model.FindOrphans(t.Context(), configKey)
+			require.NoError(t, err)
+			require.NoError(t, model.DeleteOrphans(t.Context(), orphans))
+		})
+
+		t.Run("stops on first failure", func(t *testing.T) {
+			loadBalancerID := faker.New().UUID().V4()
+			model, ociClient, _ := newModel(t, loadBalancerID)
+			wantErr := errors.New(faker.New().Lorem().Sentence(3))
+			ociClient.EXPECT().
+				DeleteBackendSet(t.Context(), mock.Anything).
+				Return(loadbalancer.DeleteBackendSetResponse{}, wantErr)
+
+			err := model.DeleteOrphans(t.Context(), &LoadBalancerOrphans{
+				LoadBalancerID: loadBalancerID,
+				Resources: []OrphanedResource{
+					{Kind: OrphanedBackendSet, Name: "apps-removed-80"},
+					{Kind: OrphanedCertificate, Name: "apps-stale-cert-rev-1"},
+				},
+			})
+			require.ErrorIs(t, err, wantErr)
+			require.ErrorContains(t, err, "failed to delete BackendSet apps-removed-80")
+		})
+
+		t.Run("fails when work request fails", func(t *testing.T) {
+			loadBalancerID := faker.New().UUID().V4()
+			model, ociClient, watcher := newModel(t, loadBalancerID)
+			wantErr := errors.New(faker.New().Lorem().Sentence(3))
+			ociClient.EXPECT().
+				DeleteCertificate(t.Context(), mock.Anything).
+				Return(loadbalancer.DeleteCertificateResponse{OpcWorkRequestId: new("wr-certificate")}, nil)
+			watcher.EXPECT().WaitFor(t.Context(), "wr-certificate").Return(wantErr)
+
+			err := model.DeleteOrphans(t.Context(), &LoadBalancerOrphans{
+				LoadBalancerID: loadBalancerID,
+				Resources:      []OrphanedResource{{Kind: OrphanedCertificate, Name: "apps-stale-cert-rev-1"}},
+			})
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+}
@@ -0,0 +1,442 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	"go.uber.org/dig"
+	corev1 "k8s.io/api/core/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+// StateDump is a view of the OCI Load Balancer programmed for the GatewayConfig.
+// Each OCI resource lists K8s resources it was programmed for. Resources without
+// owners are not known to be managed by the controller.
+type StateDump struct {
+	GatewayConfig   string                   `json:"gatewayConfig"`
+	LoadBalancerID  string                   `json:"loadBalancerId"`
+	Gateways        []string                 `json:"gateways"`
+	Listeners       []StateDumpListener      `json:"listeners"`
+	RoutingPolicies []StateDumpRoutingPolicy `json:"routingPolicies"`
+	BackendSets     []StateDumpBackendSet    `json:"backendSets"`
+	Certificates    []StateDumpCertificate   `json:"certificates"`
+}
+
+type StateDumpListener struct {
+	Name              string   `json:"name"`
+	Protocol          string   `json:"protocol"`
+	Port              int      `json:"port"`
+	DefaultBackendSet string   `json:"defaultBackendSet,omitempty"`
+	RoutingPolicy     string   `json:"routingPolicy,omitempty"`
+	Certificates      []string `json:"certificates,omitempty"`
+	RuleSets          []string `json:"ruleSets,omitempty"`
+	Owners            []string `json:"owners,omitempty"`
+}
+
+type StateDumpRoutingPolicy struct {
+	Name   string                 `json:"name"`
+	Rules  []StateDumpRoutingRule `json:"rules"`
+	Owners []string               `json:"owners,omitempty"`
+}
+
+type StateDumpRoutingRule struct {
+	Name        string   `json:"name"`
+	Condition   string   `json:"condition"`
+	BackendSets []string `json:"backendSets,omitempty"`
+	Owners      []string `json:"owners,omitempty"`
+}
+
+type StateDumpBackendSet struct {
+	Name     string             `json:"name"`
+	Policy   string             `json:"policy,omitempty"`
+	Health   string             `json:"health"`
+	Backends []StateDumpBackend `json:"backends,omitempty"`
+	Owners   []string           `json:"owners,omitempty"`
+}
+
+type StateDumpBackend struct {
+	Name    string `json:"name"`
+	Drain   bool   `json:"drain,omitempty"`
+	Offline bool   `json:"offline,omitempty"`
+	Backup  bool   `json:"backup,omitempty"`
+}
+
+type StateDumpCertificate struct {
+	Name   string   `json:"name"`
+	Owners []string `json:"owners,omitempty"`
+}
+
+// stateDumpOwners maps names of OCI resources to K8s resources they were programmed for.
+type stateDumpOwners map[string][]string
+
+func (o stateDumpOwners) add(name string, owner string) {
+	if !slices.Contains(o[name], owner) {
+		o[name] = append(o[name], owner)
+	}
+}
+
+// StateDumpModel builds the StateDump. It only reads K8s and OCI resources, so it is
+// safe to use against a load balancer managed by a running controller.
+type StateDumpModel struct {
+	logger    *slog.Logger
+	k8sReader client.Reader
+	ociClient ociLoadBalancerClient
+}
+
+type StateDumpModelDeps struct {
+	dig.In
+
+	RootLogger *slog.Logger
+	K8sReader  client.Reader
+	OciClient  ociLoadBalancerClient
+}
+
+func NewStateDumpModel(deps StateDumpModelDeps) *StateDumpModel {
+	return &StateDumpModel{
+		logger:    deps.RootLogger.WithGroup("state-dump"),
+		k8sReader: deps.K8sReader,
+		ociClient: deps.OciClient,
+	}
+}
+
+// stateDumpSource holds K8s and OCI resources the StateDump is built from.
+type stateDumpSource struct {
+	config       types.GatewayConfig
+	gateways     []gatewayv1.Gateway
+	owners       stateDumpOwners
+	loadBalancer loadbalancer.LoadBalancer
+
+	// Backend sets named after the default naming scheme for the gateway and route backends
+	// now named after another scheme. They are left behind when the naming scheme is changed.
+	supersededBackendSets map[string]struct{}
+}
+
+// Dump returns the state of the OCI Load Balancer of the GatewayConfig.
+func (m *StateDumpModel) Dump(ctx context.Context, configKey apitypes.NamespacedName) (*StateDump, error) {
+	source, err := m.loadSource(ctx, configKey)
+	if err != nil {
+		return nil, err
+	}
+
+	loadBalancerID := source.config.Spec.LoadBalancerID
+	dump := &StateDump{
+		GatewayConfig:  configKey.String(),
+		LoadBalancerID: loadBalancerID,
+		Gateways: lo.Map(source.gateways, func(gateway gatewayv1.Gateway, _ int) string {
+			return client.ObjectKeyFromObject(&gateway).String()
+		}),
+		Listeners:       dumpListeners(source.loadBalancer.Listeners, source.owners),
+		RoutingPolicies: dumpRoutingPolicies(source.loadBalancer.RoutingPolicies, source.owners),
+		Certificates:    dumpCertificates(source.loadBalancer.Certificates, source.owners),
+	}
+	dump.BackendSets = m.dumpBackendSets(
+		ociRegionContext(ctx, source.config),
+		loadBalancerID,
+		source.loadBalancer.BackendSets,
+		source.owners,
+	)
+	return dump, nil
+}
+
+func (m *StateDumpModel) loadSource(ctx context.Context, configKey apitypes.NamespacedName) (*stateDumpSource, error) {
+	var config types.GatewayConfig
+	if err := m.k8sReader.Get(ctx, configKey, &config); err != nil {
+		return nil, fmt.Errorf("failed to get GatewayConfig %s: %w", configKey, err)
+	}
+
+	var gatewayList gatewayv1.GatewayList
+	if err := m.k8sReader.List(ctx, &gatewayList, client.InNamespace(config.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list Gateways: %w", err)
+	}
+	gateways := lo.Filter(gatewayList.Items, func(gateway gatewayv1.Gateway, _ int) bool {
+		return gatewayReferencesConfig(&gateway, config.Name)
+	})
+
+	owners, supersededBackendSets, err := m.resolveOwners(ctx, config, gateways)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.ociClient.GetLoadBalancer(ociRegionContext(ctx, config), loadbalancer.GetLoadBalancerRequest{
+		LoadBalancerId: &config.Spec.LoadBalancerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OCI Load Balancer %s: %w", config.Spec.LoadBalancerID, err)
+	}
+
+	return &stateDumpSource{
+		config:                config,
+		gateways:              gateways,
+		owners:                owners,
+		loadBalancer:          response.LoadBalancer,
+		supersededBackendSets: supersededBackendSets,
+	}, nil
+}
+
+func (m *StateDumpModel) resolveOwners(
+	ctx context.Context,
+	config types.GatewayConfig,
+	gateways []gatewayv1.Gateway,
+) (stateDumpOwners, map[string]struct{}, error) {
+	owners := stateDumpOwners{}
+	superseded := map[string]struct{}{}
+	gatewayKeys := make(map[apitypes.NamespacedName]struct{}, len(gateways))
+	// Backend sets of routes are named after the naming scheme of the gateways they are attached to
+	namings := []ociResourceNaming{ociResourceNamingFromConfig(config)}
+	for _, gateway := range gateways {
+		gatewayKeys[client.ObjectKeyFromObject(&gateway)] = struct{}{}
+		namings = append(namings, m.addGatewayOwners(ctx, owners, superseded, config, &gateway))
+	}
+	namings = lo.Uniq(namings)
+
+	var httpRoutes gatewayv1.HTTPRouteList
+	if err := m.k8sReader.List(ctx, &httpRoutes); err != nil {
+		return nil, nil, fmt.Errorf("failed to list HTTPRoutes: %w", err)
+	}
+	for _, route := range httpRoutes.Items {
+		if !stateDumpRouteAttached(route.Namespace, route.Spec.ParentRefs, gatewayKeys) {
+			continue
+		}
+		owner := "HTTPRoute " + client.ObjectKeyFromObject(&route).String()
+		for ruleIndex, rule := range route.Spec.Rules {
+			owners.add(ociListerPolicyRuleName(route, ruleIndex), fmt.Sprintf("%s rule %d", owner, ruleIndex))
+			for _, backendRef := range rule.BackendRefs {
+				for _, naming := range namings {
+					owners.add(ociBackendSetNameFromBackendRef(naming, route, backendRef), owner)
+				}
+				superseded[ociBackendSetNameFromBackendRef(ociResourceNaming{}, route, backendRef)] = struct{}{}
+			}
+		}
+	}
+
+	var grpcRoutes gatewayv1.GRPCRouteList
+	if err := m.k8sReader.List(ctx, &grpcRoutes); err != nil {
+		return nil, nil, fmt.Errorf("failed to list GRPCRoutes: %w", err)
+	}
+	for _, route := range grpcRoutes.Items {
+		if !stateDumpRouteAttached(route.Namespace, route.Spec.ParentRefs, gatewayKeys) {
+			continue
+		}
+		owner := "GRPCRoute " + client.ObjectKeyFromObject(&route).String()
+		for ruleIndex, rule := range route.Spec.Rules {
+			owners.add(ociGRPCListenerPolicyRuleName(route, ruleIndex), fmt.Sprintf("%s rule %d", owner, ruleIndex))
+			for _, backendRef := range rule.BackendRefs {
+				for _, naming := range namings {
+					owners.add(ociBackendSetNameFromGRPCBackendRef(naming, route, backendRef), owner)
+				}
+				superseded[ociBackendSetNameFromGRPCBackendRef(ociResourceNaming{}, route, backendRef)] = struct{}{}
+			}
+		}
+	}
+
+	// Names still used by the scheme of some gateway are not superseded
+	for name := range superseded {
+		if len(owners[name]) > 0 {
+			delete(superseded, name)
+		}
+	}
+	return owners, superseded, nil
+}
+
+// addGatewayOwners adds resources programmed for the gateway to the owners and returns
+// the naming scheme of the gateway. The default backend set named after the default naming
+// scheme is added to the superseded backend sets.
+func (m *StateDumpModel) addGatewayOwners(
+	ctx context.Context,
+	owners stateDumpOwners,
+	superseded map[string]struct{},
+	config types.GatewayConfig,
+	gateway *gatewayv1.Gateway,
+) ociResourceNaming {
+	owner := "Gateway " + client.ObjectKeyFromObject(gateway).String()
+	gatewayConfig := m.gatewayConfigWithClassDefaults(ctx, config, gateway)
+	namePrefix := ociGatewayNamePrefix(gateway, gatewayConfig)
+	naming := ociResourceNamingFromConfig(gatewayConfig)
+	owners.add(ociDefaultBackendSetName(naming, gateway, namePrefix), owner)
+	superseded[ociDefaultBackendSetName(ociResourceNaming{}, gateway, namePrefix)] = struct{}{}
+	owners.add(ociListenerRuleSetName(gateway, namePrefix), owner)
+
+	for _, listener := range gateway.Spec.Listeners {
+		listenerOwner := fmt.Sprintf("%s listener %s", owner, listener.Name)
+		listenerName := naming.listenerName(namePrefix, listener.Name)
+		owners.add(listenerName, listenerOwner)
+		owners.add(listenerPolicyName(listenerName), listenerOwner)
+		owners.add(ociListenerAccessRuleSetName(namePrefix, listener.Name), listenerOwner)
+		if listener.TLS == nil {
+			continue
+		}
+		for _, ref := range listener.TLS.CertificateRefs {
+			secretKey := certificateRefNamespacedName(gateway.Namespace, ref)
+			var secret corev1.Secret
+			if err := m.k8sReader.Get(ctx, secretKey, &secret); err != nil {
+				// The certificate is then reported without owner, the dump is still useful
+				m.logger.WarnContext(ctx, "Failed to get listener certificate secret",
+					slog.String("secret", secretKey.String()),
+					diag.ErrAttr(err),
+				)
+				continue
+			}
+			owners.add(ociCertificateNameFromSecret(secret), "Secret "+secretKey.String())
+		}
+	}
+	return naming
+}
+
+// gatewayConfigWithClassDefaults applies defaults of the GatewayClassConfig of the gateway,
+// so names of the gateway resources are resolved the same way they are programmed.
+func (m *StateDumpModel) gatewayConfigWithClassDefaults(
+	ctx context.Context,
+	config types.GatewayConfig,
+	gateway *gatewayv1.Gateway,
+) types.GatewayConfig {
+	var gatewayClass gatewayv1.GatewayClass
+	classKey := apitypes.NamespacedName{Name: string(gateway.Spec.GatewayClassName)}
+	if err := m.k8sReader.Get(ctx, classKey, &gatewayClass); err != nil {
+		m.logger.WarnContext(ctx, "Failed to get GatewayClass of the gateway",
+			slog.String("gatewayClass", classKey.Name),
+			diag.ErrAttr(err),
+		)
+		return config
+	}
+
+	resolved := *config.DeepCopy()
+	if err := resolveGatewayClassConfig(ctx, m.k8sReader, &gatewayClass, &resolved); err != nil {
+		m.logger.WarnContext(ctx, "Failed to resolve GatewayClassConfig of the gateway",
+			slog.String("gatewayClass", classKey.Name),
+			diag.ErrAttr(err),
+		)
+		return config
+	}
+	return resolved
+}
+
+func stateDumpRouteAttached(
+	routeNamespace string,
+	parentRefs []gatewayv1.ParentReference,
+	gatewayKeys map[apitypes.NamespacedName]struct{},
+) bool {
+	return lo.ContainsBy(parentRefs, func(parentRef gatewayv1.ParentReference) bool {
+		if !parentRefTargetsGateway(parentRef) {
+			return false
+		}
+		namespace := routeNamespace
+		if parentRef.Namespace != nil {
+			namespace = string(*parentRef.Namespace)
+		}
+		_, ok := gatewayKeys[apitypes.NamespacedName{Namespace: namespace, Name: string(parentRef.Name)}]
+		return ok
+	})
+}
+
+func dumpListeners(listeners map[string]loadbalancer.Listener, owners stateDumpOwners) []StateDumpListener {
+	result := make([]StateDumpListener, 0, len(listeners))
+	for name, listener := range listeners {
+		item := StateDumpListener{
+			Name:              name,
+			Protocol:          lo.FromPtr(listener.Protocol),
+			Port:              lo.FromPtr(listener.Port),
+			DefaultBackendSet: lo.FromPtr(listener.DefaultBackendSetName),
+			RoutingPolicy:     lo.FromPtr(listener.RoutingPolicyName),
+			RuleSets:          listener.RuleSetNames,
+			Owners:            owners[name],
+		}
+		if listener.SslConfiguration != nil {
+			item.Certificates = listener.SslConfiguration.CertificateIds
+			if certificateName := lo.FromPtr(listener.SslConfiguration.CertificateName); certificateName != "" {
+				item.Certificates = append([]string{certificateName}, item.Certificates...)
+			}
+		}
+		result = append(result, item)
+	}
+	slices.SortFunc(result, func(a, b StateDumpListener) int { return cmp.Compare(a.Name, b.Name) })
+	return result
+}
+
+func dumpRoutingPolicies(
+	policies map[string]loadbalancer.RoutingPolicy,
+	owners stateDumpOwners,
+) []StateDumpRoutingPolicy {
+	result := make([]StateDumpRoutingPolicy, 0, len(policies))
+	for name, policy := range policies {
+		rules := make([]StateDumpRoutingRule, len(policy.Rules))
+		for i, rule := range policy.Rules {
+			ruleName := lo.FromPtr(rule.Name)
+			rules[i] = StateDumpRoutingRule{
+				Name:      ruleName,
+				Condition: lo.FromPtr(rule.Condition),
+				Owners:    owners[ruleName],
+			}
+			for _, action := range rule.Actions {
+				if forward, ok := action.(loadbalancer.ForwardToBackendSet); ok {
+					rules[i].BackendSets = append(rules[i].BackendSets, lo.FromPtr(forward.BackendSetName))
+				}
+			}
+		}
+		result = append(result, StateDumpRoutingPolicy{
+			Name:   name,
+			Rules:  rules,
+			Owners: owners[name],
+		})
+	}
+	slices.SortFunc(result, func(a, b StateDumpRoutingPolicy) int { return cmp.Compare(a.Name, b.Name) })
+	return result
+}
+
+func dumpCertificates(
+	certificates map[string]loadbalancer.Certificate,
+	owners stateDumpOwners,
+) []StateDumpCertificate {
+	result := make([]StateDumpCertificate, 0, len(certificates))
+	for name := range certificates {
+		result = append(result, StateDumpCertificate{Name: name, Owners: owners[name]})
+	}
+	slices.SortFunc(result, func(a, b StateDumpCertificate) int { return cmp.Compare(a.Name, b.Name) })
+	return result
+}
+
+func (m *StateDumpModel) dumpBackendSets(
+	ctx context.Context,
+	loadBalancerID string,
+	backendSets map[string]loadbalancer.BackendSet,
+	owners stateDumpOwners,
+) []StateDumpBackendSet {
+	result := make([]StateDumpBackendSet, 0, len(backendSets))
+	for name, backendSet := range backendSets {
+		item := StateDumpBackendSet{
+			Name:   name,
+			Policy: lo.FromPtr(backendSet.Policy),
+			Owners: owners[name],
+		}
+		for _, backend := range backendSet.Backends {
+			item.Backends = append(item.Backends, StateDumpBackend{
+				Name:    lo.FromPtr(backend.Name),
+				Drain:   lo.FromPtr(backend.Drain),
+				Offline: lo.FromPtr(backend.Offline),
+				Backup:  lo.FromPtr(backend.Backup),
+			})
+		}
+
+		health, err := m.ociClient.GetBackendSetHealth(ctx, loadbalancer.GetBackendSetHealthRequest{
+			LoadBalancerId: &loadBalancerID,
+			BackendSetName: &name,
+		})
+		if err != nil {
+			item.Health = "error: " + err.Error()
+		} else {
+			item.Health = string(health.BackendSetHealth.Status)
+		}
+		result = append(result, item)
+	}
+	slices.SortFunc(result, func(a, b StateDumpBackendSet) int { return cmp.Compare(a.Name, b.Name) })
+	return result
+}
@@ -0,0 +1,245 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestStateDumpModel(t *testing.T) {
+	configKey := apitypes.NamespacedName{Namespace: "oke-gw", Name: "edge-config"}
+	makeObjects := func(
+		loadBalancerID string,
+	) (types.GatewayConfig, gatewayv1.Gateway, gatewayv1.HTTPRoute, corev1.Secret) {
+		config := types.GatewayConfig{
+			ObjectMeta: metav1.ObjectMeta{Namespace: configKey.Namespace, Name: configKey.Name},
+			Spec:       types.GatewayConfigSpec{LoadBalancerID: loadBalancerID},
+		}
+		gateway := gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: configKey.Namespace, Name: "edge"},
+			Spec: gatewayv1.GatewaySpec{
+				Infrastructure: &gatewayv1.GatewayInfrastructure{
+					ParametersRef: &gatewayv1.LocalParametersReference{Name: configKey.Name},
+				},
+				Listeners: []gatewayv1.Listener{
+					{Name: "http", Protocol: gatewayv1.HTTPProtocolType, Port: 80},
+					{
+						Name:     "https",
+						Protocol: gatewayv1.HTTPSProtocolType,
+						Port:     443,
+						TLS: &gatewayv1.ListenerTLSConfig{
+							CertificateRefs: []gatewayv1.SecretObjectReference{{Name: "edge-cert"}},
+						},
+					},
+				},
+			},
+		}
+		route := gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "apps", Name: "web"},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{
+					ParentRefs: []gatewayv1.ParentReference{
+						{Name: "edge", Namespace: new(gatewayv1.Namespace(configKey.Namespace))},
+					},
+				},
+				Rules: []gatewayv1.HTTPRouteRule{
+					{
+						BackendRefs: []gatewayv1.HTTPBackendRef{
+							{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{
+								Name: "web",
+								Port: new(gatewayv1.PortNumber(80)),
+							}}},
+						},
+					},
+				},
+			},
+		}
+		secret := corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: configKey.Namespace, Name: "edge-cert", ResourceVersion: "12"},
+		}
+		return config, gateway, route, secret
+	}
+
+	t.Run("dumps OCI resources with owners", func(t *testing.T) {
+		loadBalancerID := faker.New().UUID().V4()
+		config, gateway, route, secret := makeObjects(loadBalancerID)
+		detachedRoute := route
+		detachedRoute.Name = "detached"
+		detachedRoute.Spec.ParentRefs = []gatewayv1.ParentReference{{Name: "other"}}
+		otherGateway := gateway
+		otherGateway.Name = "other"
+		otherGateway.Spec.Infrastructure = nil
+		k8sReader := fake.NewClientBuilder().
+			WithScheme(newL4TestScheme(t)).
+			WithObjects(&config, &gateway, &otherGateway, &route, &detachedRoute, &secret).
+			Build()
+		require.NoError(t, k8sReader.Get(t.Context(), apitypes.NamespacedName{
+			Namespace: secret.Namespace,
+			Name:      secret.Name,
+		}, &secret))
+
+		ruleName := ociListerPolicyRuleName(route, 0)
+		routeBackendSetName := ociBackendSetNameFromBackendRef(
+			ociResourceNaming{},
+			route,
+			route.Spec.Rules[0].BackendRefs[0],
+		)
+		certificateName := ociCertificateNameFromSecret(secret)
+		ociClient := NewMockociLoadBalancerClient(t)
+		ociClient.EXPECT().
+			GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{LoadBalancerId: &loadBalancerID}).
+			Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadbalancer.LoadBalancer{
+				Listeners: map[string]loadbalancer.Listener{
+					"https": {
+						Protocol:              new("HTTP"),
+						Port:                  new(443),
+						DefaultBackendSetName: new("edge-default"),
+						RoutingPolicyName:     new("https_policy"),
+						SslConfiguration:      &loadbalancer.SslConfiguration{CertificateName: &certificateName},
+					},
+					"manual": {Protocol: new("TCP"), Port: new(8080)},
+				},
+				RoutingPolicies: map[string]loadbalancer.RoutingPolicy{
+					"https_policy": {
+						Rules: []loadbalancer.RoutingRule{
+							{
+								Name:      &ruleName,
+								Condition: new("any(http.request.url.path sw '/')"),
+								Actions: []loadbalancer.Action{
+									loadbalancer.ForwardToBackendSet{BackendSetName: &routeBackendSetName},
+								},
+							},
+						},
+					},
+				},
+				BackendSets: map[string]loadbalancer.BackendSet{
+					"edge-default": {Policy: new("ROUND_ROBIN")},
+					routeBackendSetName: {
+						Policy: new("ROUND_ROBIN"),
+						Backends: []loadbalancer.Backend{
+							{Name: new("10.0.0.1:8080")},
+							{Name: new("10.0.0.2:8080"), Drain: new(true)},
+						},
+					},
+				},
+				Certificates: map[string]loadbalancer.Certificate{
+					certificateName: {CertificateName: &certificateName},
+					"manual-cert":   {},
+				},
+			}}, nil)
+		ociClient.EXPECT().
+			GetBackendSetHealth(t.Context(), mock.MatchedBy(func(req loadbalancer.GetBackendSetHealthRequest) bool {
+				return lo.FromPtr(req.BackendSetName) == "edge-default"
+			})).
+			Return(loadbalancer.GetBackendSetHealthResponse{BackendSetHealth: loadbalancer.BackendSetHealth{
+				Status: loadbalancer.BackendSetHealthStatusOk,
+			}}, nil)
+		ociClient.EXPECT().
+			GetBackendSetHealth(t.Context(), mock.MatchedBy(func(req loadbalancer.GetBackendSetHealthRequest) bool {
+				return lo.FromPtr(req.BackendSetName) == routeBackendSetName
+			})).
+			Return(loadbalancer.GetBackendSetHealthResponse{}, errors.New("health failed"))
+
+		model := NewStateDumpModel(StateDumpModelDeps{
+			RootLogger: diag.RootTestLogger(),
+			K8sReader:  k8sReader,
+			OciClient:  ociClient,
+		})
+
+		dump, err := model.Dump(t.Context(), configKey)
+		require.NoError(t, err)
+
+		routeOwner := "HTTPRoute apps/web"
+		assert.Equal(t, &StateDump{
+			GatewayConfig:  configKey.String(),
+			LoadBalancerID: loadBalancerID,
+			Gateways:       []string{"oke-gw/edge"},
+			Listeners: []StateDumpListener{
+				{
+					Name:              "https",
+					Protocol:          "HTTP",
+					Port:              443,
+					DefaultBackendSet: "edge-default",
+					RoutingPolicy:     "https_policy",
+					Certificates:      []string{certificateName},
+					Owners:            []string{"Gateway oke-gw/edge listener https"},
+				},
+				{Name: "manual", Protocol: "TCP", Port: 8080},
+			},
+			RoutingPolicies: []StateDumpRoutingPolicy{
+				{
+					Name: "https_policy",
+					Rules: []StateDumpRoutingRule{
+						{
+							Name:        ruleName,
+							Condition:   "any(http.request.url.path sw '/')",
+							BackendSets: []string{routeBackendSetName},
+							Owners:      []string{routeOwner + " rule 0"},
+						},
+					},
+					Owners: []string{"Gateway oke-gw/edge listener https"},
+				},
+			},
+			BackendSets: []StateDumpBackendSet{
+				{
+					Name:     routeBackendSetName,
+					Policy:   "ROUND_ROBIN",
+					Health:   "error: health failed",
+					Backends: []StateDumpBackend{{Name: "10.0.0.1:8080"}, {Name: "10.0.0.2:8080", Drain: true}},
+					Owners:   []string{routeOwner},
+				},
+				{
+					Name:   "edge-default",
+					Policy: "ROUND_ROBIN",
+					Health: string(loadbalancer.BackendSetHealthStatusOk),
+					Owners: []string{"Gateway oke-gw/edge"},
+				},
+			},
+			Certificates: []StateDumpCertificate{
+				{Name: "manual-cert"},
+				{Name: certificateName, Owners: []string{"Secret oke-gw/edge-cert"}},
+			},
+		}, dump)
+	})
+
+	t.Run("fails when GatewayConfig not found", func(t *testing.T) {
+		model := NewStateDumpModel(StateDumpModelDeps{
+			RootLogger: diag.RootTestLogger(),
+			K8sReader:  fake.NewClientBuilder().WithScheme(newL4TestScheme(t)).Build(),
+			OciClient:  NewMockociLoadBalancerClient(t),
+		})
+
+		_, err := model.Dump(t.Context(), configKey)
+		require.ErrorContains(t, err, "failed to get GatewayConfig "+configKey.String())
+	})
+
+	t.Run("fails when OCI Load Balancer can not be fetched", func(t *testing.T) {
+		loadBalancerID := faker.New().UUID().V4()
+		config, _, _, _ := makeObjects(loadBalancerID)
+		wantErr := errors.New(faker.New().Lorem().Sentence(3))
+		ociClient := NewMockociLoadBalancerClient(t)
+		ociClient.EXPECT().GetLoadBalancer(t.Context(), mock.Anything).Return(loadbalancer.GetLoadBalancerResponse{}, wantErr)
+		model := NewStateDumpModel(StateDumpModelDeps{
+			RootLogger: diag.RootTestLogger(),
+			K8sReader:  fake.NewClientBuilder().WithScheme(newL4TestScheme(t)).WithObjects(&config).Build(),
+			OciClient:  ociClient,
+		})
+
+		_, err := model.Dump(t.Context(), configKey)
+		require.ErrorIs(t, err, wantErr)
+	})
+}
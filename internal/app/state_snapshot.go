@@ -0,0 +1,447 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+	"go.uber.org/dig"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+// LoadBalancerSnapshotVersion is the version of the LoadBalancerSnapshot document format.
+const LoadBalancerSnapshotVersion = 1
+
+const (
+	SnapshotBackendSet    = "BackendSet"
+	SnapshotRuleSet       = "RuleSet"
+	SnapshotRoutingPolicy = "RoutingPolicy"
+	SnapshotListener      = "Listener"
+)
+
+const (
+	SnapshotRestoreCreated = "Created"
+	SnapshotRestoreExists  = "Exists"
+	SnapshotRestoreSkipped = "Skipped"
+)
+
+// LoadBalancerSnapshot is a portable document with the configuration of the OCI Load Balancer
+// programmed for the GatewayConfig. Resources are stored as OCI create details, so the document
+// can be restored on another load balancer, e.g. after a region failover.
+// Certificates are only listed by name, their private keys can not be read from OCI.
+type LoadBalancerSnapshot struct {
+	Version         int                                       `json:"version"`
+	GatewayConfig   string                                    `json:"gatewayConfig"`
+	LoadBalancerID  string                                    `json:"loadBalancerId"`
+	CapturedAt      time.Time                                 `json:"capturedAt"`
+	Certificates    []string                                  `json:"certificates,omitempty"`
+	BackendSets     []loadbalancer.CreateBackendSetDetails    `json:"backendSets,omitempty"`
+	RuleSets        []loadbalancer.CreateRuleSetDetails       `json:"ruleSets,omitempty"`
+	RoutingPolicies []loadbalancer.CreateRoutingPolicyDetails `json:"routingPolicies,omitempty"`
+	Listeners       []loadbalancer.CreateListenerDetails      `json:"listeners,omitempty"`
+}
+
+// RestoredResource is a resource of the snapshot and the outcome of its restore.
+type RestoredResource struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Result string `json:"result"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type LoadBalancerRestore struct {
+	LoadBalancerID string             `json:"loadBalancerId"`
+	Resources      []RestoredResource `json:"resources"`
+}
+
+type RestoreSnapshotParams struct {
+	// LoadBalancerID is the OCID of the load balancer the snapshot is restored on
+	LoadBalancerID string
+
+	// Region is the OCI region of the load balancer. Defaults to the region of the controller OCI config.
+	Region string
+
+	// SkipBackends restores backend sets without backends. Backends of the snapshot
+	// are addresses of the captured cluster, they are synced by the controller once
+	// Gateways are programmed on the restored load balancer.
+	SkipBackends bool
+}
+
+// LoadBalancerSnapshotModel captures the OCI Load Balancer configuration of the GatewayConfig
+// and restores it on a new load balancer.
+type LoadBalancerSnapshotModel struct {
+	logger              *slog.Logger
+	k8sReader           client.Reader
+	k8sClient           k8sClient
+	ociClient           ociLoadBalancerClient
+	workRequestsWatcher workRequestsWatcher
+	now                 func() time.Time
+}
+
+type LoadBalancerSnapshotModelDeps struct {
+	dig.In
+
+	RootLogger          *slog.Logger
+	K8sReader           client.Reader
+	K8sClient           k8sClient
+	OciClient           ociLoadBalancerClient
+	WorkRequestsWatcher workRequestsWatcher
+}
+
+func NewLoadBalancerSnapshotModel(deps LoadBalancerSnapshotModelDeps) *LoadBalancerSnapshotModel {
+	return &LoadBalancerSnapshotModel{
+		logger:              deps.RootLogger.WithGroup("lb-snapshot"),
+		k8sReader:           deps.K8sReader,
+		k8sClient:           deps.K8sClient,
+		ociClient:           deps.OciClient,
+		workRequestsWatcher: deps.WorkRequestsWatcher,
+		now:                 time.Now,
+	}
+}
+
+// Capture returns the snapshot of the OCI Load Balancer of the GatewayConfig.
+func (m *LoadBalancerSnapshotModel) Capture(
+	ctx context.Context,
+	configKey apitypes.NamespacedName,
+) (*LoadBalancerSnapshot, error) {
+	var config types.GatewayConfig
+	if err := m.k8sReader.Get(ctx, configKey, &config); err != nil {
+		return nil, fmt.Errorf("failed to get GatewayConfig %s: %w", configKey, err)
+	}
+	response, err := m.ociClient.GetLoadBalancer(ociRegionContext(ctx, config), loadbalancer.GetLoadBalancerRequest{
+		LoadBalancerId: &config.Spec.LoadBalancerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OCI Load Balancer %s: %w", config.Spec.LoadBalancerID, err)
+	}
+	lb := response.LoadBalancer
+
+	snapshot := &LoadBalancerSnapshot{
+		Version:        LoadBalancerSnapshotVersion,
+		GatewayConfig:  configKey.String(),
+		LoadBalancerID: config.Spec.LoadBalancerID,
+		CapturedAt:     m.now().UTC().Truncate(time.Second),
+		Certificates:   lo.Keys(lb.Certificates),
+	}
+	slices.Sort(snapshot.Certificates)
+	for name, backendSet := range lb.BackendSets {
+		snapshot.BackendSets = append(snapshot.BackendSets, snapshotBackendSet(name, backendSet))
+	}
+	slices.SortFunc(snapshot.BackendSets, func(a, b loadbalancer.CreateBackendSetDetails) int {
+		return cmp.Compare(lo.FromPtr(a.Name), lo.FromPtr(b.Name))
+	})
+	for name, ruleSet := range lb.RuleSets {
+		snapshot.RuleSets = append(snapshot.RuleSets, loadbalancer.CreateRuleSetDetails{
+			Name:  new(name),
+			Items: ruleSet.Items,
+		})
+	}
+	slices.SortFunc(snapshot.RuleSets, func(a, b loadbalancer.CreateRuleSetDetails) int {
+		return cmp.Compare(lo.FromPtr(a.Name), lo.FromPtr(b.Name))
+	})
+	for name, policy := range lb.RoutingPolicies {
+		snapshot.RoutingPolicies = append(snapshot.RoutingPolicies, loadbalancer.CreateRoutingPolicyDetails{
+			Name: new(name),
+			ConditionLanguageVersion: loadbalancer.CreateRoutingPolicyDetailsConditionLanguageVersionEnum(
+				policy.ConditionLanguageVersion,
+			),
+			Rules: policy.Rules,
+		})
+	}
+	slices.SortFunc(snapshot.RoutingPolicies, func(a, b loadbalancer.CreateRoutingPolicyDetails) int {
+		return cmp.Compare(lo.FromPtr(a.Name), lo.FromPtr(b.Name))
+	})
+	for name, listener := range lb.Listeners {
+		snapshot.Listeners = append(snapshot.Listeners, loadbalancer.CreateListenerDetails{
+			Name:                    new(name),
+			DefaultBackendSetName:   listener.DefaultBackendSetName,
+			Port:                    listener.Port,
+			Protocol:                listener.Protocol,
+			RoutingPolicyName:       listener.RoutingPolicyName,
+			SslConfiguration:        sslConfigurationDetailsFromBackendSet(listener.SslConfiguration),
+			ConnectionConfiguration: listener.ConnectionConfiguration,
+			RuleSetNames:            listener.RuleSetNames,
+		})
+	}
+	slices.SortFunc(snapshot.Listeners, func(a, b loadbalancer.CreateListenerDetails) int {
+		return cmp.Compare(lo.FromPtr(a.Name), lo.FromPtr(b.Name))
+	})
+	return snapshot, nil
+}
+
+func snapshotBackendSet(name string, backendSet loadbalancer.BackendSet) loadbalancer.CreateBackendSetDetails {
+	details := makeUpdateOciBackendSetDetails(backendSet, lo.Map(backendSet.Backends, ociBackendToDetails))
+	return loadbalancer.CreateBackendSetDetails{
+		Name:                                    new(name),
+		Policy:                                  details.Policy,
+		Backends:                                details.Backends,
+		HealthChecker:                           details.HealthChecker,
+		SslConfiguration:                        details.SslConfiguration,
+		SessionPersistenceConfiguration:         details.SessionPersistenceConfiguration,
+		LbCookieSessionPersistenceConfiguration: details.LbCookieSessionPersistenceConfiguration,
+	}
+}
+
+// Save stores the snapshot as the OkeLoadBalancerSnapshot named after the GatewayConfig.
+func (m *LoadBalancerSnapshotModel) Save(ctx context.Context, snapshot *LoadBalancerSnapshot) error {
+	configKey, err := parseSnapshotKey(snapshot.GatewayConfig)
+	if err != nil {
+		return err
+	}
+	document, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+	spec := types.OkeLoadBalancerSnapshotSpec{
+		GatewayConfigName: configKey.Name,
+		LoadBalancerID:    snapshot.LoadBalancerID,
+		CapturedAt:        metav1.NewTime(snapshot.CapturedAt),
+		Document:          runtime.RawExtension{Raw: document},
+	}
+
+	var existing types.OkeLoadBalancerSnapshot
+	err = m.k8sReader.Get(ctx, configKey, &existing)
+	if apierrors.IsNotFound(err) {
+		if err = m.k8sClient.Create(ctx, &types.OkeLoadBalancerSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Namespace: configKey.Namespace, Name: configKey.Name},
+			Spec:       spec,
+		}); err != nil {
+			return fmt.Errorf("failed to create OkeLoadBalancerSnapshot %s: %w", configKey, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get OkeLoadBalancerSnapshot %s: %w", configKey, err)
+	}
+	existing.Spec = spec
+	if err = m.k8sClient.Update(ctx, &existing); err != nil {
+		return fmt.Errorf("failed to update OkeLoadBalancerSnapshot %s: %w", configKey, err)
+	}
+	return nil
+}
+
+// Load returns the snapshot stored in the OkeLoadBalancerSnapshot.
+func (m *LoadBalancerSnapshotModel) Load(
+	ctx context.Context,
+	key apitypes.NamespacedName,
+) (*LoadBalancerSnapshot, error) {
+	var stored types.OkeLoadBalancerSnapshot
+	if err := m.k8sReader.Get(ctx, key, &stored); err != nil {
+		return nil, fmt.Errorf("failed to get OkeLoadBalancerSnapshot %s: %w", key, err)
+	}
+	return ParseLoadBalancerSnapshot(stored.Spec.Document.Raw)
+}
+
+// ParseLoadBalancerSnapshot reads the snapshot document.
+func ParseLoadBalancerSnapshot(data []byte) (*LoadBalancerSnapshot, error) {
+	var snapshot LoadBalancerSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot: %w", err)
+	}
+	if snapshot.Version != LoadBalancerSnapshotVersion {
+		return nil, fmt.Errorf(
+			"unsupported snapshot version %d, expected %d", snapshot.Version, LoadBalancerSnapshotVersion,
+		)
+	}
+	return &snapshot, nil
+}
+
+func parseSnapshotKey(value string) (apitypes.NamespacedName, error) {
+	namespace, name, found := strings.Cut(value, "/")
+	if !found || namespace == "" || name == "" {
+		return apitypes.NamespacedName{}, fmt.Errorf(
+			"snapshot GatewayConfig %q is not in the <namespace>/<name> format", value,
+		)
+	}
+	return apitypes.NamespacedName{Namespace: namespace, Name: name}, nil
+}
+
+// Restore creates resources of the snapshot missing on the load balancer, in the order
+// they depend on each other: backend sets, rule sets, routing policies and listeners.
+// Existing resources are left untouched. Resources referencing certificates or other
+// resources missing on the load balancer are skipped. Certificates are not restored,
+// the controller creates them from Secrets when programming Gateways. It stops on the
+// first failure and returns resources restored so far together with the error.
+func (m *LoadBalancerSnapshotModel) Restore(
+	ctx context.Context,
+	snapshot *LoadBalancerSnapshot,
+	params RestoreSnapshotParams,
+) (*LoadBalancerRestore, error) {
+	if params.Region != "" {
+		ctx = ociapi.WithRegion(ctx, params.Region)
+	}
+	response, err := m.ociClient.GetLoadBalancer(ctx, loadbalancer.GetLoadBalancerRequest{
+		LoadBalancerId: &params.LoadBalancerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OCI Load Balancer %s: %w", params.LoadBalancerID, err)
+	}
+	lb := response.LoadBalancer
+	restore := &LoadBalancerRestore{LoadBalancerID: params.LoadBalancerID}
+	available := snapshotRestoreAvailable{
+		SnapshotBackendSet:    snapshotResourceNames(lb.BackendSets),
+		SnapshotRuleSet:       snapshotResourceNames(lb.RuleSets),
+		SnapshotRoutingPolicy: snapshotResourceNames(lb.RoutingPolicies),
+		SnapshotListener:      snapshotResourceNames(lb.Listeners),
+	}
+	certificates := snapshotResourceNames(lb.Certificates)
+
+	restoreResource := func(kind, name, missing string, create func() (*string, error)) error {
+		resource := RestoredResource{Kind: kind, Name: name}
+		switch {
+		case available.has(kind, name):
+			resource.Result = SnapshotRestoreExists
+		case missing != "":
+			resource.Result = SnapshotRestoreSkipped
+			resource.Reason = missing
+		default:
+			if createErr := m.createResource(ctx, params.LoadBalancerID, resource, create); createErr != nil {
+				return createErr
+			}
+			resource.Result = SnapshotRestoreCreated
+			available[kind][name] = struct{}{}
+		}
+		restore.Resources = append(restore.Resources, resource)
+		return nil
+	}
+
+	for _, details := range snapshot.BackendSets {
+		if params.SkipBackends {
+			details.Backends = nil
+		}
+		missing := missingSnapshotCertificate(certificates, details.SslConfiguration)
+		if err = restoreResource(SnapshotBackendSet, lo.FromPtr(details.Name), missing, func() (*string, error) {
+			res, createErr := m.ociClient.CreateBackendSet(ctx, loadbalancer.CreateBackendSetRequest{
+				LoadBalancerId:          &params.LoadBalancerID,
+				CreateBackendSetDetails: details,
+			})
+			return res.OpcWorkRequestId, createErr
+		}); err != nil {
+			return restore, err
+		}
+	}
+	for _, details := range snapshot.RuleSets {
+		if err = restoreResource(SnapshotRuleSet, lo.FromPtr(details.Name), "", func() (*string, error) {
+			res, createErr := m.ociClient.CreateRuleSet(ctx, loadbalancer.CreateRuleSetRequest{
+				LoadBalancerId:       &params.LoadBalancerID,
+				CreateRuleSetDetails: details,
+			})
+			return res.OpcWorkRequestId, createErr
+		}); err != nil {
+			return restore, err
+		}
+	}
+	for _, details := range snapshot.RoutingPolicies {
+		var backendSets []string
+		for _, rule := range details.Rules {
+			for _, action := range rule.Actions {
+				if forward, ok := action.(loadbalancer.ForwardToBackendSet); ok {
+					backendSets = append(backendSets, lo.FromPtr(forward.BackendSetName))
+				}
+			}
+		}
+		missing := available.missing(SnapshotBackendSet, backendSets...)
+		if err = restoreResource(SnapshotRoutingPolicy, lo.FromPtr(details.Name), missing, func() (*string, error) {
+			res, createErr := m.ociClient.CreateRoutingPolicy(ctx, loadbalancer.CreateRoutingPolicyRequest{
+				LoadBalancerId:             &params.LoadBalancerID,
+				CreateRoutingPolicyDetails: details,
+			})
+			return res.OpcWorkRequestId, createErr
+		}); err != nil {
+			return restore, err
+		}
+	}
+	for _, details := range snapshot.Listeners {
+		missing := cmp.Or(
+			available.missing(SnapshotBackendSet, lo.FromPtr(details.DefaultBackendSetName)),
+			available.missing(SnapshotRuleSet, details.RuleSetNames...),
+			missingSnapshotCertificate(certificates, details.SslConfiguration),
+		)
+		if details.RoutingPolicyName != nil {
+			missing = cmp.Or(missing, available.missing(SnapshotRoutingPolicy, *details.RoutingPolicyName))
+		}
+		if err = restoreResource(SnapshotListener, lo.FromPtr(details.Name), missing, func() (*string, error) {
+			res, createErr := m.ociClient.CreateListener(ctx, loadbalancer.CreateListenerRequest{
+				LoadBalancerId:        &params.LoadBalancerID,
+				CreateListenerDetails: details,
+			})
+			return res.OpcWorkRequestId, createErr
+		}); err != nil {
+			return restore, err
+		}
+	}
+	return restore, nil
+}
+
+func (m *LoadBalancerSnapshotModel) createResource(
+	ctx context.Context,
+	loadBalancerID string,
+	resource RestoredResource,
+	create func() (*string, error),
+) error {
+	workRequestID, err := create()
+	if err != nil {
+		return fmt.Errorf("failed to create %s %s: %w", resource.Kind, resource.Name, err)
+	}
+	if workRequestID == nil {
+		return fmt.Errorf("failed to create %s %s: missing work request id", resource.Kind, resource.Name)
+	}
+	if err = m.workRequestsWatcher.WaitFor(ctx, *workRequestID); err != nil {
+		return fmt.Errorf("failed to wait for %s %s: %w", resource.Kind, resource.Name, err)
+	}
+	m.logger.InfoContext(ctx, "Restored OCI resource",
+		slog.String("loadBalancerId", loadBalancerID),
+		slog.String("kind", resource.Kind),
+		slog.String("name", resource.Name),
+	)
+	return nil
+}
+
+func snapshotResourceNames[T any](resources map[string]T) map[string]struct{} {
+	return lo.MapValues(resources, func(T, string) struct{} { return struct{}{} })
+}
+
+// snapshotRestoreAvailable holds names of resources available on the load balancer by kind.
+type snapshotRestoreAvailable map[string]map[string]struct{}
+
+func (a snapshotRestoreAvailable) has(kind, name string) bool {
+	_, ok := a[kind][name]
+	return ok
+}
+
+// missing returns the reason of skipping a resource referencing missing resources, empty if all exist.
+func (a snapshotRestoreAvailable) missing(kind string, names ...string) string {
+	for _, name := range names {
+		if name != "" && !a.has(kind, name) {
+			return fmt.Sprintf("%s %s not found", kind, name)
+		}
+	}
+	return ""
+}
+
+func missingSnapshotCertificate(
+	certificates map[string]struct{},
+	sslConfig *loadbalancer.SslConfigurationDetails,
+) string {
+	if sslConfig == nil || lo.FromPtr(sslConfig.CertificateName) == "" {
+		return ""
+	}
+	if _, ok := certificates[*sslConfig.CertificateName]; ok {
+		return ""
+	}
+	return "Certificate " + *sslConfig.CertificateName + " not found"
+}
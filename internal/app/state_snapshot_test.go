@@ -0,0 +1,364 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	"github.com/gemyago/oke-gateway-api/internal/types"
+)
+
+func TestLoadBalancerSnapshotModel(t *testing.T) {
+	configKey := apitypes.NamespacedName{Namespace: "oke-gw", Name: "edge-config"}
+
+	newModel := func(
+		t *testing.T,
+		loadBalancerID string,
+	) (*LoadBalancerSnapshotModel, *MockociLoadBalancerClient, *MockworkRequestsWatcher) {
+		config := types.GatewayConfig{
+			ObjectMeta: metav1.ObjectMeta{Namespace: configKey.Namespace, Name: configKey.Name},
+			Spec:       types.GatewayConfigSpec{LoadBalancerID: loadBalancerID},
+		}
+		k8sClient := fake.NewClientBuilder().
+			WithScheme(newL4TestScheme(t)).
+			WithObjects(&config).
+			Build()
+		ociClient := NewMockociLoadBalancerClient(t)
+		watcher := NewMockworkRequestsWatcher(t)
+		model := NewLoadBalancerSnapshotModel(LoadBalancerSnapshotModelDeps{
+			RootLogger:          diag.RootTestLogger(),
+			K8sReader:           k8sClient,
+			K8sClient:           k8sClient,
+			OciClient:           ociClient,
+			WorkRequestsWatcher: watcher,
+		})
+		return model, ociClient, watcher
+	}
+
+	forwardTo := func(backendSet string) loadbalancer.RoutingRule {
+		return loadbalancer.RoutingRule{
+			Name:      new("p0000_" + backendSet),
+			Condition: new("any(http.request.url.path sw '/')"),
+			Actions: []loadbalancer.Action{
+				loadbalancer.ForwardToBackendSet{BackendSetName: new(backendSet)},
+			},
+		}
+	}
+
+	t.Run("Capture", func(t *testing.T) {
+		t.Run("captures load balancer resources sorted by name", func(t *testing.T) {
+			loadBalancerID := faker.New().UUID().V4()
+			model, ociClient, _ := newModel(t, loadBalancerID)
+			now := time.Date(2026, 10, 16, 10, 30, 15, 500, time.UTC)
+			model.now = func() time.Time { return now }
+			healthChecker := &loadbalancer.HealthChecker{Protocol: new("TCP"), Port: new(8080)}
+			ruleItems := []loadbalancer.Rule{
+				loadbalancer.AddHttpRequestHeaderRule{Header: new("x-env"), Value: new("prod")},
+			}
+			ociClient.EXPECT().
+				GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{LoadBalancerId: &loadBalancerID}).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadbalancer.LoadBalancer{
+					Listeners: map[string]loadbalancer.Listener{
+						"https": {
+							DefaultBackendSetName: new("edge-default"),
+							Port:                  new(443),
+							Protocol:              new("HTTP"),
+							RoutingPolicyName:     new("https_policy"),
+							SslConfiguration:      &loadbalancer.SslConfiguration{CertificateName: new("edge-rev-1")},
+						},
+						"http": {
+							DefaultBackendSetName: new("edge-default"),
+							Port:                  new(80),
+							Protocol:              new("HTTP"),
+							RuleSetNames:          []string{"rs_edge"},
+						},
+					},
+					BackendSets: map[string]loadbalancer.BackendSet{
+						"edge-default": {Policy: new("ROUND_ROBIN"), HealthChecker: healthChecker},
+						"apps-web-80": {
+							Policy:        new("ROUND_ROBIN"),
+							HealthChecker: healthChecker,
+							Backends: []loadbalancer.Backend{
+								{IpAddress: new("10.0.0.1"), Port: new(8080), Drain: new(true)},
+							},
+						},
+					},
+					RuleSets: map[string]loadbalancer.RuleSet{"rs_edge": {Items: ruleItems}},
+					RoutingPolicies: map[string]loadbalancer.RoutingPolicy{
+						"https_policy": {
+							ConditionLanguageVersion: loadbalancer.RoutingPolicyConditionLanguageVersionV1,
+							Rules:                    []loadbalancer.RoutingRule{forwardTo("apps-web-80")},
+						},
+					},
+					Certificates: map[string]loadbalancer.Certificate{"edge-rev-1": {}, "admin-rev-2": {}},
+				}}, nil)
+
+			snapshot, err := model.Capture(t.Context(), configKey)
+
+			require.NoError(t, err)
+			assert.Equal(t, LoadBalancerSnapshotVersion, snapshot.Version)
+			assert.Equal(t, configKey.String(), snapshot.GatewayConfig)
+			assert.Equal(t, loadBalancerID, snapshot.LoadBalancerID)
+			assert.Equal(t, now.Truncate(time.Second), snapshot.CapturedAt)
+			assert.Equal(t, []string{"admin-rev-2", "edge-rev-1"}, snapshot.Certificates)
+
+			require.Len(t, snapshot.BackendSets, 2)
+			assert.Equal(t, "apps-web-80", *snapshot.BackendSets[0].Name)
+			assert.Equal(t, []loadbalancer.BackendDetails{
+				{IpAddress: new("10.0.0.1"), Port: new(8080), Drain: new(true)},
+			}, snapshot.BackendSets[0].Backends)
+			assert.Equal(t, &loadbalancer.HealthCheckerDetails{Protocol: new("TCP"), Port: new(8080)},
+				snapshot.BackendSets[0].HealthChecker)
+			assert.Equal(t, "edge-default", *snapshot.BackendSets[1].Name)
+
+			assert.Equal(t, []loadbalancer.CreateRuleSetDetails{{Name: new("rs_edge"), Items: ruleItems}},
+				snapshot.RuleSets)
+			assert.Equal(t, []loadbalancer.CreateRoutingPolicyDetails{
+				{
+					Name:                     new("https_policy"),
+					ConditionLanguageVersion: loadbalancer.CreateRoutingPolicyDetailsConditionLanguageVersionV1,
+					Rules:                    []loadbalancer.RoutingRule{forwardTo("apps-web-80")},
+				},
+			}, snapshot.RoutingPolicies)
+
+			require.Len(t, snapshot.Listeners, 2)
+			assert.Equal(t, loadbalancer.CreateListenerDetails{
+				Name:                  new("http"),
+				DefaultBackendSetName: new("edge-default"),
+				Port:                  new(80),
+				Protocol:              new("HTTP"),
+				RuleSetNames:          []string{"rs_edge"},
+			}, snapshot.Listeners[0])
+			assert.Equal(t, "https", *snapshot.Listeners[1].Name)
+			assert.Equal(t, "edge-rev-1", *snapshot.Listeners[1].SslConfiguration.CertificateName)
+		})
+
+		t.Run("fails when load balancer can not be read", func(t *testing.T) {
+			loadBalancerID := faker.New().UUID().V4()
+			model, ociClient, _ := newModel(t, loadBalancerID)
+			wantErr := errors.New(faker.New().Lorem().Sentence(3))
+			ociClient.EXPECT().
+				GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{LoadBalancerId: &loadBalancerID}).
+				Return(loadbalancer.GetLoadBalancerResponse{}, wantErr)
+
+			_, err := model.Capture(t.Context(), configKey)
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+
+	t.Run("Save and Load", func(t *testing.T) {
+		t.Run("stores snapshot in OkeLoadBalancerSnapshot", func(t *testing.T) {
+			model, _, _ := newModel(t, faker.New().UUID().V4())
+			snapshot := &LoadBalancerSnapshot{
+				Version:        LoadBalancerSnapshotVersion,
+				GatewayConfig:  configKey.String(),
+				LoadBalancerID: faker.New().UUID().V4(),
+				CapturedAt:     time.Date(2026, 10, 16, 10, 30, 0, 0, time.UTC),
+				Certificates:   []string{"edge-rev-1"},
+				BackendSets: []loadbalancer.CreateBackendSetDetails{
+					{Name: new("edge-default"), Policy: new("ROUND_ROBIN")},
+				},
+				Listeners: []loadbalancer.CreateListenerDetails{
+					{Name: new("http"), DefaultBackendSetName: new("edge-default"), Port: new(80)},
+				},
+			}
+
+			require.NoError(t, model.Save(t.Context(), snapshot))
+			snapshot.LoadBalancerID = faker.New().UUID().V4()
+			require.NoError(t, model.Save(t.Context(), snapshot))
+
+			var stored types.OkeLoadBalancerSnapshot
+			require.NoError(t, model.k8sReader.Get(t.Context(), configKey, &stored))
+			assert.Equal(t, configKey.Name, stored.Spec.GatewayConfigName)
+			assert.Equal(t, snapshot.LoadBalancerID, stored.Spec.LoadBalancerID)
+
+			got, err := model.Load(t.Context(), configKey)
+			require.NoError(t, err)
+			assert.Equal(t, snapshot, got)
+		})
+
+		t.Run("fails to load missing snapshot", func(t *testing.T) {
+			model, _, _ := newModel(t, faker.New().UUID().V4())
+
+			_, err := model.Load(t.Context(), apitypes.NamespacedName{Namespace: "oke-gw", Name: "missing"})
+
+			require.ErrorContains(t, err, "failed to get OkeLoadBalancerSnapshot oke-gw/missing")
+		})
+	})
+
+	t.Run("ParseLoadBalancerSnapshot", func(t *testing.T) {
+		_, err := ParseLoadBalancerSnapshot([]byte(`{"version": 2}`))
+		require.ErrorContains(t, err, "unsupported snapshot version 2")
+
+		_, err = ParseLoadBalancerSnapshot([]byte(`not json`))
+		require.ErrorContains(t, err, "failed to parse snapshot")
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		snapshot := &LoadBalancerSnapshot{
+			Version: LoadBalancerSnapshotVersion,
+			BackendSets: []loadbalancer.CreateBackendSetDetails{
+				{Name: new("edge-default"), Policy: new("ROUND_ROBIN")},
+				{
+					Name:     new("apps-web-80"),
+					Policy:   new("ROUND_ROBIN"),
+					Backends: []loadbalancer.BackendDetails{{IpAddress: new("10.0.0.1"), Port: new(8080)}},
+				},
+				{
+					Name:             new("apps-tls-443"),
+					Policy:           new("ROUND_ROBIN"),
+					SslConfiguration: &loadbalancer.SslConfigurationDetails{CertificateName: new("client-rev-1")},
+				},
+			},
+			RuleSets: []loadbalancer.CreateRuleSetDetails{{Name: new("rs_edge")}},
+			RoutingPolicies: []loadbalancer.CreateRoutingPolicyDetails{
+				{Name: new("http_policy"), Rules: []loadbalancer.RoutingRule{forwardTo("apps-web-80")}},
+				{Name: new("tls_policy"), Rules: []loadbalancer.RoutingRule{forwardTo("apps-tls-443")}},
+			},
+			Listeners: []loadbalancer.CreateListenerDetails{
+				{
+					Name:                  new("http"),
+					DefaultBackendSetName: new("edge-default"),
+					RoutingPolicyName:     new("http_policy"),
+					RuleSetNames:          []string{"rs_edge"},
+				},
+				{
+					Name:                  new("https"),
+					DefaultBackendSetName: new("edge-default"),
+					SslConfiguration:      &loadbalancer.SslConfigurationDetails{CertificateName: new("edge-rev-1")},
+				},
+			},
+		}
+
+		t.Run("creates missing resources in dependency order", func(t *testing.T) {
+			loadBalancerID := faker.New().UUID().V4()
+			model, ociClient, watcher := newModel(t, loadBalancerID)
+			ociClient.EXPECT().
+				GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{LoadBalancerId: &loadBalancerID}).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadbalancer.LoadBalancer{
+					BackendSets: map[string]loadbalancer.BackendSet{"edge-default": {}},
+				}}, nil)
+			workRequestID := faker.New().UUID().V4()
+			ociClient.EXPECT().
+				CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
+					LoadBalancerId: &loadBalancerID,
+					CreateBackendSetDetails: loadbalancer.CreateBackendSetDetails{
+						Name:   new("apps-web-80"),
+						Policy: new("ROUND_ROBIN"),
+					},
+				}).
+				Return(loadbalancer.CreateBackendSetResponse{OpcWorkRequestId: &workRequestID}, nil)
+			ociClient.EXPECT().
+				CreateRuleSet(t.Context(), loadbalancer.CreateRuleSetRequest{
+					LoadBalancerId:       &loadBalancerID,
+					CreateRuleSetDetails: snapshot.RuleSets[0],
+				}).
+				Return(loadbalancer.CreateRuleSetResponse{OpcWorkRequestId: &workRequestID}, nil)
+			ociClient.EXPECT().
+				CreateRoutingPolicy(t.Context(), loadbalancer.CreateRoutingPolicyRequest{
+					LoadBalancerId:             &loadBalancerID,
+					CreateRoutingPolicyDetails: snapshot.RoutingPolicies[0],
+				}).
+				Return(loadbalancer.CreateRoutingPolicyResponse{OpcWorkRequestId: &workRequestID}, nil)
+			ociClient.EXPECT().
+				CreateListener(t.Context(), loadbalancer.CreateListenerRequest{
+					LoadBalancerId:        &loadBalancerID,
+					CreateListenerDetails: snapshot.Listeners[0],
+				}).
+				Return(loadbalancer.CreateListenerResponse{OpcWorkRequestId: &workRequestID}, nil)
+			watcher.EXPECT().WaitFor(t.Context(), workRequestID).Return(nil).Times(4)
+
+			restore, err := model.Restore(t.Context(), snapshot, RestoreSnapshotParams{
+				LoadBalancerID: loadBalancerID,
+				SkipBackends:   true,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, &LoadBalancerRestore{
+				LoadBalancerID: loadBalancerID,
+				Resources: []RestoredResource{
+					{Kind: SnapshotBackendSet, Name: "edge-default", Result: SnapshotRestoreExists},
+					{Kind: SnapshotBackendSet, Name: "apps-web-80", Result: SnapshotRestoreCreated},
+					{
+						Kind:   SnapshotBackendSet,
+						Name:   "apps-tls-443",
+						Result: SnapshotRestoreSkipped,
+						Reason: "Certificate client-rev-1 not found",
+					},
+					{Kind: SnapshotRuleSet, Name: "rs_edge", Result: SnapshotRestoreCreated},
+					{Kind: SnapshotRoutingPolicy, Name: "http_policy", Result: SnapshotRestoreCreated},
+					{
+						Kind:   SnapshotRoutingPolicy,
+						Name:   "tls_policy",
+						Result: SnapshotRestoreSkipped,
+						Reason: "BackendSet apps-tls-443 not found",
+					},
+					{Kind: SnapshotListener, Name: "http", Result: SnapshotRestoreCreated},
+					{
+						Kind:   SnapshotListener,
+						Name:   "https",
+						Result: SnapshotRestoreSkipped,
+						Reason: "Certificate edge-rev-1 not found",
+					},
+				},
+			}, restore)
+		})
+
+		t.Run("stops on the first failure", func(t *testing.T) {
+			loadBalancerID := faker.New().UUID().V4()
+			model, ociClient, _ := newModel(t, loadBalancerID)
+			ociClient.EXPECT().
+				GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{LoadBalancerId: &loadBalancerID}).
+				Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadbalancer.LoadBalancer{
+					BackendSets: map[string]loadbalancer.BackendSet{"edge-default": {}},
+				}}, nil)
+			wantErr := errors.New(faker.New().Lorem().Sentence(3))
+			ociClient.EXPECT().
+				CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
+					LoadBalancerId:          &loadBalancerID,
+					CreateBackendSetDetails: snapshot.BackendSets[1],
+				}).
+				Return(loadbalancer.CreateBackendSetResponse{}, wantErr)
+
+			restore, err := model.Restore(t.Context(), snapshot, RestoreSnapshotParams{LoadBalancerID: loadBalancerID})
+
+			require.ErrorIs(t, err, wantErr)
+			assert.Equal(t, []RestoredResource{
+				{Kind: SnapshotBackendSet, Name: "edge-default", Result: SnapshotRestoreExists},
+			}, restore.Resources)
+		})
+
+		t.Run("uses the given region", func(t *testing.T) {
+			loadBalancerID := faker.New().UUID().V4()
+			region := "eu-amsterdam-1"
+			model, ociClient, _ := newModel(t, loadBalancerID)
+			inRegion := mock.MatchedBy(func(ctx context.Context) bool {
+				return ociapi.RegionFromContext(ctx) == region
+			})
+			wantErr := errors.New(faker.New().Lorem().Sentence(3))
+			ociClient.EXPECT().
+				GetLoadBalancer(inRegion, loadbalancer.GetLoadBalancerRequest{LoadBalancerId: &loadBalancerID}).
+				Return(loadbalancer.GetLoadBalancerResponse{}, wantErr)
+
+			_, err := model.Restore(t.Context(), snapshot, RestoreSnapshotParams{
+				LoadBalancerID: loadBalancerID,
+				Region:         region,
+			})
+
+			require.ErrorIs(t, err, wantErr)
+		})
+	})
+}
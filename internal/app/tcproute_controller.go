@@ -11,6 +11,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
 )
 
 // TCPRouteController reconciles TCPRoute resources for OCI Network Load Balancer.
@@ -45,6 +47,7 @@ func (r *TCPRouteController) Reconcile(ctx context.Context, req reconcile.Reques
 		finalizer:     NetworkLoadBalancerTCPRouteProgrammedFinalizer,
 		resolve:       r.tcpRouteModel.resolveRequest,
 		route:         func(details resolvedTCPRouteDetails) client.Object { return &details.tcpRoute },
+		config:        func(details resolvedTCPRouteDetails) types.GatewayConfig { return details.gatewayDetails.config },
 		deprovision:   r.tcpRouteModel.deprovisionRoute,
 		program:       r.tcpRouteModel.programRoute,
 		setProgrammed: r.tcpRouteModel.setProgrammed,
@@ -67,6 +70,7 @@ type reconcileL4RouteParams[D any] struct {
 	finalizer     string
 	resolve       func(context.Context, reconcile.Request) ([]D, error)
 	route         func(D) client.Object
+	config        func(D) types.GatewayConfig
 	deprovision   func(context.Context, D) error
 	program       func(context.Context, D) error
 	setProgrammed func(context.Context, D) error
@@ -92,7 +96,8 @@ func reconcileL4Route[D any](ctx context.Context, params reconcileL4RouteParams[
 	}
 
 	for _, resolvedRoute := range resolvedRoutes {
-		if err = reconcileResolvedL4Route(ctx, params, resolvedRoute); err != nil {
+		gatewayCtx := ociRegionContext(ctx, params.config(resolvedRoute))
+		if err = reconcileResolvedL4Route(gatewayCtx, params, resolvedRoute); err != nil {
 			var busyErr *networkLoadBalancerBusyError
 			if errors.As(err, &busyErr) {
 				params.logger.InfoContext(ctx, "OCI Network Load Balancer is busy, requeueing route",
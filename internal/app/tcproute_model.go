@@ -72,6 +72,7 @@ type tcpRouteModelImpl struct {
 	ociNetworkLoadBalancerAPI ociNetworkLoadBalancerClient
 	workRequestsWatcher       workRequestsWatcher
 	operationLocks            *networkLoadBalancerOperationLocks
+	endpointMetrics           *backendEndpointMetrics
 }
 
 func tcpRouteBackendRefName(backendRef gatewayv1.BackendRef, defaultNamespace string) apitypes.NamespacedName {
@@ -471,6 +472,10 @@ func endpointBackendsForSlices(
 ) []networkloadbalancer.BackendDetails {
 	backends := make([]networkloadbalancer.BackendDetails, 0)
 	for _, slice := range endpointSlices {
+		// FQDN endpoints of headless external Services can not be registered as backends.
+		if slice.AddressType == discoveryv1.AddressTypeFQDN {
+			continue
+		}
 		port, ok := l4EndpointPortForServicePort(servicePort, slice)
 		if !ok {
 			continue
@@ -494,6 +499,37 @@ func endpointBackendsForSlices(
 	return backends
 }
 
+// l4RouteSkippedFQDNEndpoints counts FQDN endpoints of the route backends. They are skipped
+// by endpointBackendsForSlices, so the route is programmed without them.
+func l4RouteSkippedFQDNEndpoints(
+	ctx context.Context,
+	k8sClient k8sClient,
+	routeNamespace string,
+	backendRefs []gatewayv1.BackendRef,
+) (int, error) {
+	var skipped int
+	for _, backendRef := range backendRefs {
+		if l4BackendRefWeight(backendRef) == 0 {
+			continue
+		}
+		namespace := string(lo.FromPtrOr(backendRef.Namespace, gatewayv1.Namespace(routeNamespace)))
+		var endpointSlices discoveryv1.EndpointSliceList
+		if err := k8sClient.List(ctx, &endpointSlices,
+			client.MatchingLabels{discoveryv1.LabelServiceName: string(backendRef.Name)},
+			client.InNamespace(namespace),
+		); err != nil {
+			return 0, fmt.Errorf("failed to list endpoint slices for backend %s/%s: %w",
+				namespace, backendRef.Name, err)
+		}
+		for _, slice := range endpointSlices.Items {
+			if slice.AddressType == discoveryv1.AddressTypeFQDN {
+				skipped += len(slice.Endpoints)
+			}
+		}
+	}
+	return skipped, nil
+}
+
 func mergeNetworkLoadBalancerBackend(
 	desired map[string]networkloadbalancer.BackendDetails,
 	backend networkloadbalancer.BackendDetails,
@@ -1036,7 +1072,7 @@ func (m *tcpRouteModelImpl) deprovisionRoute(
 	ctx context.Context,
 	details resolvedTCPRouteDetails,
 ) error {
-	return deprovisionL4Route(ctx, deprovisionL4RouteParams[resolvedTCPRouteDetails]{
+	err := deprovisionL4Route(ctx, deprovisionL4RouteParams[resolvedTCPRouteDetails]{
 		k8sClient:          m.client,
 		routeKind:          "TCPRoute",
 		routeToUpdate:      details.tcpRoute.DeepCopy(),
@@ -1048,6 +1084,11 @@ func (m *tcpRouteModelImpl) deprovisionRoute(
 		routeObject:        func(route resolvedTCPRouteDetails) client.Object { return &route.tcpRoute },
 		clearBackendSet:    func() error { return m.clearBackendSet(ctx, details) },
 	})
+	if err != nil {
+		return err
+	}
+	m.endpointMetrics.deleteRouteSkippedEndpoints("TCPRoute", details.tcpRoute.Namespace, details.tcpRoute.Name)
+	return nil
 }
 
 type deprovisionL4RouteParams[D any] struct {
@@ -1288,6 +1329,9 @@ func mergeL4RouteParentStatus(
 
 func (m *tcpRouteModelImpl) setProgrammed(ctx context.Context, details resolvedTCPRouteDetails) error {
 	routeToUpdate := details.tcpRoute.DeepCopy()
+	backendRefs := l4RouteBackendRefs(details.tcpRoute.Spec.Rules,
+		func(rule gatewayv1.TCPRouteRule) []gatewayv1.BackendRef { return rule.BackendRefs },
+	)
 	return setL4RouteProgrammed(ctx, setL4RouteProgrammedParams{
 		k8sClient:          m.client,
 		routeKind:          "TCPRoute",
@@ -1296,6 +1340,8 @@ func (m *tcpRouteModelImpl) setProgrammed(ctx context.Context, details resolvedT
 		finalizer:          NetworkLoadBalancerTCPRouteProgrammedFinalizer,
 		backendSetAnnotKey: NetworkLoadBalancerTCPRouteProgrammedBackendSetsAnnotation,
 		desiredBackendSets: desiredTCPRouteBackendSetNames(details),
+		backendRefs:        backendRefs,
+		endpointMetrics:    m.endpointMetrics,
 		updateParentStatus: func(conditions []metav1.Condition) error {
 			return m.updateParentStatus(ctx, resolvedTCPRouteDetails{
 				gatewayDetails:  details.gatewayDetails,
@@ -1307,6 +1353,18 @@ func (m *tcpRouteModelImpl) setProgrammed(ctx context.Context, details resolvedT
 	})
 }
 
+// l4RouteBackendRefs returns backend refs of all rules of the layer 4 route.
+func l4RouteBackendRefs[TRule any](
+	rules []TRule,
+	ruleBackendRefs func(TRule) []gatewayv1.BackendRef,
+) []gatewayv1.BackendRef {
+	backendRefs := make([]gatewayv1.BackendRef, 0)
+	for _, rule := range rules {
+		backendRefs = append(backendRefs, ruleBackendRefs(rule)...)
+	}
+	return backendRefs
+}
+
 type setL4RouteProgrammedParams struct {
 	k8sClient          k8sClient
 	routeKind          string
@@ -1315,10 +1373,35 @@ type setL4RouteProgrammedParams struct {
 	finalizer          string
 	backendSetAnnotKey string
 	desiredBackendSets map[string]struct{}
+	backendRefs        []gatewayv1.BackendRef
+	endpointMetrics    *backendEndpointMetrics
 	updateParentStatus func([]metav1.Condition) error
 }
 
 func setL4RouteProgrammed(ctx context.Context, params setL4RouteProgrammedParams) error {
+	skippedEndpoints, listErr := l4RouteSkippedFQDNEndpoints(
+		ctx,
+		params.k8sClient,
+		params.routeToUpdate.GetNamespace(),
+		params.backendRefs,
+	)
+	if listErr != nil {
+		return listErr
+	}
+	params.endpointMetrics.setRouteSkippedFQDNEndpoints(
+		params.routeKind,
+		params.routeToUpdate.GetNamespace(),
+		params.routeToUpdate.GetName(),
+		skippedEndpoints,
+	)
+	resolvedRefsMessage := "Backend references resolved"
+	if skippedEndpoints > 0 {
+		resolvedRefsMessage = fmt.Sprintf(
+			"Backend references resolved, %d FQDN endpoints skipped since they can not be registered as backends",
+			skippedEndpoints,
+		)
+	}
+
 	needsUpdate := controllerutil.AddFinalizer(params.routeToUpdate, params.finalizer)
 	if len(params.desiredBackendSets) > 0 {
 		setAnnotatedBackendSetNames(params.routeToUpdate, params.backendSetAnnotKey, params.desiredBackendSets)
@@ -1353,7 +1436,7 @@ func setL4RouteProgrammed(ctx context.Context, params setL4RouteProgrammedParams
 			Type:               string(gatewayv1.RouteConditionResolvedRefs),
 			Status:             metav1.ConditionTrue,
 			Reason:             string(gatewayv1.RouteReasonResolvedRefs),
-			Message:            "Backend references resolved",
+			Message:            resolvedRefsMessage,
 			ObservedGeneration: params.routeToUpdate.GetGeneration(),
 			LastTransitionTime: metav1.Now(),
 		},
@@ -1386,6 +1469,7 @@ type tcpRouteModelDeps struct {
 	OciNetworkLoadBalancerAPI ociNetworkLoadBalancerClient
 	WorkRequestsWatcher       workRequestsWatcher `name:"networkLoadBalancerWorkRequestsWatcher"`
 	OperationLocks            *networkLoadBalancerOperationLocks
+	EndpointMetrics           *backendEndpointMetrics `optional:"true"`
 }
 
 func newTCPRouteModel(deps tcpRouteModelDeps) *tcpRouteModelImpl {
@@ -1404,5 +1488,6 @@ func newTCPRouteModel(deps tcpRouteModelDeps) *tcpRouteModelImpl {
 		ociNetworkLoadBalancerAPI: deps.OciNetworkLoadBalancerAPI,
 		workRequestsWatcher:       watcher,
 		operationLocks:            operationLocks,
+		endpointMetrics:           deps.EndpointMetrics,
 	}
 }
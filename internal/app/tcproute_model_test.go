@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -503,6 +504,11 @@ func TestTCPRouteModel(t *testing.T) {
 				return nil
 			})
 
+		endpointMetrics, err := newBackendEndpointMetrics()
+		require.NoError(t, err)
+		endpointMetrics.routeSkippedEndpoints.Reset()
+		endpointMetrics.setRouteSkippedFQDNEndpoints("TCPRoute", "iot", "rtmp", 2)
+
 		nlbClient := &stubNetworkLoadBalancerClient{}
 		model := newTCPRouteModel(tcpRouteModelDeps{
 			RootLogger: diag.RootTestLogger(),
@@ -513,9 +519,10 @@ func TestTCPRouteModel(t *testing.T) {
 				},
 			},
 			OciNetworkLoadBalancerAPI: nlbClient,
+			EndpointMetrics:           endpointMetrics,
 		})
 
-		err := model.deprovisionRoute(t.Context(), resolvedTCPRouteDetails{
+		err = model.deprovisionRoute(t.Context(), resolvedTCPRouteDetails{
 			tcpRoute: currentRoute,
 			gatewayDetails: resolvedGatewayDetails{
 				gateway: gatewayv1.Gateway{
@@ -539,6 +546,7 @@ func TestTCPRouteModel(t *testing.T) {
 		assert.Equal(t, "bs_rtmp", lo.FromPtr(request.BackendSetName))
 		assert.False(t, lo.FromPtr(request.UpdateBackendSetDetails.IsPreserveSource))
 		assert.Empty(t, request.UpdateBackendSetDetails.Backends)
+		assert.Equal(t, 0, testutil.CollectAndCount(endpointMetrics.routeSkippedEndpoints))
 	})
 
 	t.Run("endpointBackendsForRoute rejects invalid and unavailable backends", func(t *testing.T) {
@@ -56,7 +56,8 @@ func (r *TLSRouteController) Reconcile(ctx context.Context, req reconcile.Reques
 	}
 
 	for _, resolvedRoute := range resolvedRoutes {
-		if err = r.reconcileResolvedRoute(ctx, req, resolvedRoute); err != nil {
+		gatewayCtx := ociRegionContext(ctx, resolvedRoute.gatewayDetails.config)
+		if err = r.reconcileResolvedRoute(gatewayCtx, req, resolvedRoute); err != nil {
 			var busyErr *networkLoadBalancerBusyError
 			if errors.As(err, &busyErr) {
 				r.logger.InfoContext(ctx, "OCI Network Load Balancer is busy, requeueing TLSRoute",
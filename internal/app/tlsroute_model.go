@@ -77,6 +77,7 @@ type tlsRouteModelImpl struct {
 	workRequestsWatcher       workRequestsWatcher
 	nlbWorkRequestsWatcher    workRequestsWatcher
 	operationLocks            *networkLoadBalancerOperationLocks
+	endpointMetrics           *backendEndpointMetrics
 }
 
 func tlsRouteKey(route gatewayv1.TLSRoute) string {
@@ -107,13 +108,12 @@ func tlsRouteMode(listener gatewayv1.Listener) (gatewayv1.TLSModeType, bool) {
 	return *listener.TLS.Mode, true
 }
 
-func tlsRouteBackendSetName(route gatewayv1.TLSRoute, listener gatewayv1.Listener) string {
-	return ociBackendSetNameFromService(corev1.Service{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: route.Namespace,
-			Name:      fmt.Sprintf("%s-%s", route.Name, listener.Name),
-		},
-	})
+func tlsRouteBackendSetName(
+	naming ociResourceNaming,
+	route gatewayv1.TLSRoute,
+	listener gatewayv1.Listener,
+) string {
+	return naming.backendSetName(route.Namespace, route.Name, string(listener.Name))
 }
 
 func annotatedLoadBalancerTLSRouteResources(route client.Object) map[string]string {
@@ -273,6 +273,14 @@ func (m *tlsRouteModelImpl) resolveParentGateway(
 			err,
 		)
 	}
+	if err := resolveGatewayClassConfig(ctx, m.client, &gatewayClass, &config); err != nil {
+		// Invalid class parameters are reported in the Gateway status
+		var statusErr *resourceStatusError
+		if errors.As(err, &statusErr) {
+			return resolvedGatewayDetails{}, false, nil
+		}
+		return resolvedGatewayDetails{}, false, err
+	}
 
 	return resolvedGatewayDetails{gateway: gateway, gatewayClass: gatewayClass, config: config}, true, nil
 }
@@ -622,7 +630,11 @@ func (m *tlsRouteModelImpl) programLoadBalancerTerminateRoute(
 		return fmt.Errorf("failed to get OCI Load Balancer %s: %w", loadBalancerID, err)
 	}
 
-	backendSetName := tlsRouteBackendSetName(details.tlsRoute, details.matchedListener)
+	backendSetName := tlsRouteBackendSetName(
+		ociResourceNamingFromConfig(details.gatewayDetails.config),
+		details.tlsRoute,
+		details.matchedListener,
+	)
 	backends, err := m.loadBalancerBackendsForRoute(ctx, details.tlsRoute)
 	if err != nil {
 		return err
@@ -937,7 +949,9 @@ func (m *tlsRouteModelImpl) tlsListenerSSLConfig(
 			),
 		)
 	}
-	sslConfig := &loadbalancer.SslConfigurationDetails{CertificateName: listenerCertificates[0].CertificateName}
+	sslConfig := &loadbalancer.SslConfigurationDetails{
+		CertificateName: selectListenerCertificate(&details.matchedListener, listenerCertificates).CertificateName,
+	}
 	applyListenerTLSOptions(sslConfig, details.matchedListener.TLS)
 	return sslConfig, nil
 }
@@ -1034,10 +1048,17 @@ func makeOciTLSListenerUpdateDetails(
 }
 
 func (m *tlsRouteModelImpl) deprovisionRoute(ctx context.Context, details resolvedTLSRouteDetails) error {
+	var err error
 	if details.gatewayDetails.gatewayClass.Spec.ControllerName == ControllerClassName {
-		return m.deprovisionLoadBalancerRoute(ctx, details)
+		err = m.deprovisionLoadBalancerRoute(ctx, details)
+	} else {
+		err = m.deprovisionNetworkLoadBalancerRoute(ctx, details)
+	}
+	if err != nil {
+		return err
 	}
-	return m.deprovisionNetworkLoadBalancerRoute(ctx, details)
+	m.endpointMetrics.deleteRouteSkippedEndpoints("TLSRoute", details.tlsRoute.Namespace, details.tlsRoute.Name)
+	return nil
 }
 
 func (m *tlsRouteModelImpl) deprovisionNetworkLoadBalancerRoute(
@@ -1133,7 +1154,11 @@ func (m *tlsRouteModelImpl) deleteLoadBalancerRouteResources(
 		ctx,
 		details.gatewayDetails.config.Spec.LoadBalancerID,
 		string(details.matchedListener.Name),
-		tlsRouteBackendSetName(details.tlsRoute, details.matchedListener),
+		tlsRouteBackendSetName(
+			ociResourceNamingFromConfig(details.gatewayDetails.config),
+			details.tlsRoute,
+			details.matchedListener,
+		),
 	)
 }
 
@@ -1560,7 +1585,11 @@ func (m *tlsRouteModelImpl) setProgrammed(ctx context.Context, details resolvedT
 	)
 	desiredBackendSets := map[string]struct{}{}
 	if details.gatewayDetails.gatewayClass.Spec.ControllerName == ControllerClassName {
-		backendSetName := tlsRouteBackendSetName(details.tlsRoute, details.matchedListener)
+		backendSetName := tlsRouteBackendSetName(
+			ociResourceNamingFromConfig(details.gatewayDetails.config),
+			details.tlsRoute,
+			details.matchedListener,
+		)
 		desiredBackendSets[backendSetName] = struct{}{}
 		setAnnotatedLoadBalancerTLSRouteResources(routeToUpdate,
 			map[string]string{string(details.matchedListener.Name): backendSetName},
@@ -1573,6 +1602,9 @@ func (m *tlsRouteModelImpl) setProgrammed(ctx context.Context, details resolvedT
 		setAnnotatedBackendSetNames(routeToUpdate, LoadBalancerTLSRouteProgrammedBackendSetAnnotation, nil)
 		setAnnotatedLoadBalancerTLSRouteResources(routeToUpdate, nil)
 	}
+	backendRefs := l4RouteBackendRefs(details.tlsRoute.Spec.Rules,
+		func(rule gatewayv1.TLSRouteRule) []gatewayv1.BackendRef { return rule.BackendRefs },
+	)
 	if err := setL4RouteProgrammed(ctx, setL4RouteProgrammedParams{
 		k8sClient:          m.client,
 		routeKind:          "TLSRoute",
@@ -1581,6 +1613,8 @@ func (m *tlsRouteModelImpl) setProgrammed(ctx context.Context, details resolvedT
 		finalizer:          finalizer,
 		backendSetAnnotKey: annotationKey,
 		desiredBackendSets: desiredBackendSets,
+		backendRefs:        backendRefs,
+		endpointMetrics:    m.endpointMetrics,
 		updateParentStatus: func(conditions []metav1.Condition) error {
 			return m.updateParentStatus(ctx, resolvedTLSRouteDetails{
 				gatewayDetails:  details.gatewayDetails,
@@ -1625,6 +1659,7 @@ type tlsRouteModelDeps struct {
 	NLBWorkRequestsWatcher    workRequestsWatcher `name:"networkLoadBalancerWorkRequestsWatcher"`
 	OperationLocks            *networkLoadBalancerOperationLocks
 	BackendTLS                backendTLSPolicyModel
+	EndpointMetrics           *backendEndpointMetrics `optional:"true"`
 }
 
 func newTLSRouteModel(deps tlsRouteModelDeps) *tlsRouteModelImpl {
@@ -1651,5 +1686,6 @@ func newTLSRouteModel(deps tlsRouteModelDeps) *tlsRouteModelImpl {
 		workRequestsWatcher:       watcher,
 		nlbWorkRequestsWatcher:    nlbWatcher,
 		operationLocks:            operationLocks,
+		endpointMetrics:           deps.EndpointMetrics,
 	}
 }
@@ -191,7 +191,7 @@ func TestTLSRouteModelResolveAndProgramALBTerminate(t *testing.T) {
 	require.NoError(t, k8sClient.Get(t.Context(), apitypes.NamespacedName{Namespace: "media", Name: "rtmps"}, &updated))
 	assert.Contains(t, updated.Finalizers, LoadBalancerTLSRouteProgrammedFinalizer)
 	assert.Equal(t,
-		map[string]string{"rtmps": tlsRouteBackendSetName(*route, listener)},
+		map[string]string{"rtmps": tlsRouteBackendSetName(ociResourceNaming{}, *route, listener)},
 		annotatedLoadBalancerTLSRouteResources(&updated),
 	)
 	assert.Len(t, updated.Status.Parents, 1)
@@ -496,7 +496,7 @@ func TestTLSRouteModelProgramLoadBalancerTerminateRouteErrors(t *testing.T) {
 			OciLoadBalancerAPI:   ociClient,
 			OciLoadBalancerModel: ociModel,
 		})
-		backendSetName := tlsRouteBackendSetName(route, listener)
+		backendSetName := tlsRouteBackendSetName(ociResourceNaming{}, route, listener)
 		ociClient.EXPECT().
 			GetLoadBalancer(t.Context(), mock.Anything).
 			Return(loadbalancer.GetLoadBalancerResponse{LoadBalancer: loadbalancer.LoadBalancer{
@@ -1800,7 +1800,11 @@ func TestTLSRouteModelDeleteLoadBalancerRouteResources(t *testing.T) {
 	ociClient.EXPECT().
 		DeleteBackendSet(t.Context(), mock.MatchedBy(func(request loadbalancer.DeleteBackendSetRequest) bool {
 			return lo.FromPtr(request.LoadBalancerId) == "lb-id" &&
-				lo.FromPtr(request.BackendSetName) == tlsRouteBackendSetName(details.tlsRoute, details.matchedListener)
+				lo.FromPtr(request.BackendSetName) == tlsRouteBackendSetName(
+					ociResourceNaming{},
+					details.tlsRoute,
+					details.matchedListener,
+				)
 		})).
 		Return(loadbalancer.DeleteBackendSetResponse{OpcWorkRequestId: &deleteBackendSetID}, nil)
 	watcher.EXPECT().WaitFor(t.Context(), deleteBackendSetID).Return(nil)
@@ -0,0 +1,29 @@
+package app
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const tracerName = "github.com/gemyago/oke-gateway-api/internal/app"
+
+// startSpan starts a child span of the reconcile trace. The returned function ends
+// the span and records the error if any. The context is returned as is if tracing
+// is not enabled.
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, func(err error)) {
+	spanCtx, span := otel.Tracer(tracerName).Start(ctx, name)
+	if !span.SpanContext().IsValid() {
+		return ctx, func(error) {}
+	}
+	span.SetAttributes(attrs...)
+	return spanCtx, func(err error) {
+		defer span.End()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestStartSpan(t *testing.T) {
+	t.Run("keeps context if tracing is not enabled", func(t *testing.T) {
+		ctx, endSpan := startSpan(t.Context(), faker.New().Lorem().Word())
+		endSpan(errors.New(faker.New().Lorem().Sentence(3)))
+
+		assert.Equal(t, t.Context(), ctx)
+	})
+
+	t.Run("starts child span and records error", func(t *testing.T) {
+		t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+		recorder := tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+		parentCtx, parentSpan := otel.Tracer(tracerName).Start(t.Context(), "parent")
+		name := faker.New().Lorem().Word()
+		attr := attribute.String(faker.New().Lorem().Word(), faker.New().Lorem().Word())
+		wantErr := errors.New(faker.New().Lorem().Sentence(3))
+
+		ctx, endSpan := startSpan(parentCtx, name, attr)
+		endSpan(wantErr)
+		parentSpan.End()
+
+		assert.Equal(t, parentSpan.SpanContext().TraceID(), trace.SpanContextFromContext(ctx).TraceID())
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		assert.Equal(t, name, spans[0].Name())
+		assert.Equal(t, parentSpan.SpanContext().SpanID(), spans[0].Parent().SpanID())
+		assert.Equal(t, []attribute.KeyValue{attr}, spans[0].Attributes())
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		assert.Equal(t, wantErr.Error(), spans[0].Status().Description)
+	})
+}
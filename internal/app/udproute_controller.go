@@ -9,6 +9,8 @@ import (
 	"go.uber.org/dig"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gemyago/oke-gateway-api/internal/types"
 )
 
 // UDPRouteController reconciles UDPRoute resources for OCI Network Load Balancer.
@@ -43,6 +45,7 @@ func (r *UDPRouteController) Reconcile(ctx context.Context, req reconcile.Reques
 		finalizer:     NetworkLoadBalancerUDPRouteProgrammedFinalizer,
 		resolve:       r.udpRouteModel.resolveRequest,
 		route:         func(details resolvedUDPRouteDetails) client.Object { return &details.udpRoute },
+		config:        func(details resolvedUDPRouteDetails) types.GatewayConfig { return details.gatewayDetails.config },
 		deprovision:   r.udpRouteModel.deprovisionRoute,
 		program:       r.udpRouteModel.programRoute,
 		setProgrammed: r.udpRouteModel.setProgrammed,
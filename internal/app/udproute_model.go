@@ -68,6 +68,7 @@ type udpRouteModelImpl struct {
 	ociNetworkLoadBalancerAPI ociNetworkLoadBalancerClient
 	workRequestsWatcher       workRequestsWatcher
 	operationLocks            *networkLoadBalancerOperationLocks
+	endpointMetrics           *backendEndpointMetrics
 }
 
 func udpRouteBackendRefName(backendRef gatewayv1.BackendRef, defaultNamespace string) apitypes.NamespacedName {
@@ -668,7 +669,7 @@ func (m *udpRouteModelImpl) deprovisionRoute(
 	ctx context.Context,
 	details resolvedUDPRouteDetails,
 ) error {
-	return deprovisionL4Route(ctx, deprovisionL4RouteParams[resolvedUDPRouteDetails]{
+	err := deprovisionL4Route(ctx, deprovisionL4RouteParams[resolvedUDPRouteDetails]{
 		k8sClient:          m.client,
 		routeKind:          "UDPRoute",
 		routeToUpdate:      details.udpRoute.DeepCopy(),
@@ -680,6 +681,11 @@ func (m *udpRouteModelImpl) deprovisionRoute(
 		routeObject:        func(route resolvedUDPRouteDetails) client.Object { return &route.udpRoute },
 		clearBackendSet:    func() error { return m.clearBackendSet(ctx, details) },
 	})
+	if err != nil {
+		return err
+	}
+	m.endpointMetrics.deleteRouteSkippedEndpoints("UDPRoute", details.udpRoute.Namespace, details.udpRoute.Name)
+	return nil
 }
 
 func (m *udpRouteModelImpl) deprovisionDetachedRoute(
@@ -785,6 +791,9 @@ func (m *udpRouteModelImpl) updateParentStatus(
 
 func (m *udpRouteModelImpl) setProgrammed(ctx context.Context, details resolvedUDPRouteDetails) error {
 	routeToUpdate := details.udpRoute.DeepCopy()
+	backendRefs := l4RouteBackendRefs(details.udpRoute.Spec.Rules,
+		func(rule gatewayv1.UDPRouteRule) []gatewayv1.BackendRef { return rule.BackendRefs },
+	)
 	return setL4RouteProgrammed(ctx, setL4RouteProgrammedParams{
 		k8sClient:          m.client,
 		routeKind:          "UDPRoute",
@@ -793,6 +802,8 @@ func (m *udpRouteModelImpl) setProgrammed(ctx context.Context, details resolvedU
 		finalizer:          NetworkLoadBalancerUDPRouteProgrammedFinalizer,
 		backendSetAnnotKey: NetworkLoadBalancerUDPRouteProgrammedBackendSetsAnnotation,
 		desiredBackendSets: desiredUDPRouteBackendSetNames(details),
+		backendRefs:        backendRefs,
+		endpointMetrics:    m.endpointMetrics,
 		updateParentStatus: func(conditions []metav1.Condition) error {
 			return m.updateParentStatus(ctx, resolvedUDPRouteDetails{
 				gatewayDetails:  details.gatewayDetails,
@@ -830,6 +841,7 @@ type udpRouteModelDeps struct {
 	OciNetworkLoadBalancerAPI ociNetworkLoadBalancerClient
 	WorkRequestsWatcher       workRequestsWatcher `name:"networkLoadBalancerWorkRequestsWatcher"`
 	OperationLocks            *networkLoadBalancerOperationLocks
+	EndpointMetrics           *backendEndpointMetrics `optional:"true"`
 }
 
 func newUDPRouteModel(deps udpRouteModelDeps) *udpRouteModelImpl {
@@ -848,5 +860,6 @@ func newUDPRouteModel(deps udpRouteModelDeps) *udpRouteModelImpl {
 		ociNetworkLoadBalancerAPI: deps.OciNetworkLoadBalancerAPI,
 		workRequestsWatcher:       watcher,
 		operationLocks:            operationLocks,
+		endpointMetrics:           deps.EndpointMetrics,
 	}
 }
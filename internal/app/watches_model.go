@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"path"
+	"strings"
 
 	"github.com/samber/lo"
 	"go.uber.org/dig"
@@ -33,15 +34,17 @@ const tlsRouteBackendServiceIndexKey = ".metadata.tlsBackendRefs.serviceName"
 
 // WatchesModel implements the WatchesModel interface.
 type WatchesModel struct {
-	k8sClient k8sClient
-	logger    *slog.Logger
+	k8sClient          k8sClient
+	logger             *slog.Logger
+	programmingMetrics *routeProgrammingMetrics
 }
 
 type WatchesModelDeps struct {
 	dig.In
 
-	K8sClient k8sClient
-	Logger    *slog.Logger
+	K8sClient          k8sClient
+	Logger             *slog.Logger
+	ProgrammingMetrics *routeProgrammingMetrics `optional:"true"`
 }
 
 // RegisterFieldIndexersOptions controls which optional route indexers are registered.
@@ -54,8 +57,9 @@ type RegisterFieldIndexersOptions struct {
 // NewWatchesModel creates a new watchesModel.
 func NewWatchesModel(deps WatchesModelDeps) *WatchesModel {
 	return &WatchesModel{
-		k8sClient: deps.K8sClient,
-		logger:    deps.Logger.WithGroup("watches-model"),
+		k8sClient:          deps.K8sClient,
+		logger:             deps.Logger.WithGroup("watches-model"),
+		programmingMetrics: deps.ProgrammingMetrics,
 	}
 }
 
@@ -616,6 +620,7 @@ func (m *WatchesModel) MapEndpointSliceToHTTPRoute(ctx context.Context, obj clie
 		requests = append(requests, reconcile.Request{
 			NamespacedName: client.ObjectKeyFromObject(&route),
 		})
+		m.programmingMetrics.observeChange(client.ObjectKeyFromObject(&route))
 		m.logger.InfoContext(ctx,
 			"Queueing HTTPRoute for reconciliation due to EndpointSlice change",
 			slog.String("httpRoute", client.ObjectKeyFromObject(&route).String()),
@@ -733,6 +738,159 @@ func (m *WatchesModel) MapGRPCRouteToHTTPRoute(ctx context.Context, obj client.O
 	)
 }
 
+// MapPodToHTTPRoute maps Pod events to HTTPRoute reconcile requests of the EndpointSlices
+// targeting the Pod, so the backend annotations of the Pod are applied when they change.
+func (m *WatchesModel) MapPodToHTTPRoute(ctx context.Context, obj client.Object) []reconcile.Request {
+	return m.mapPodToEndpointSliceRequests(ctx, obj, m.MapEndpointSliceToHTTPRoute)
+}
+
+func (m *WatchesModel) MapPodToGRPCRoute(ctx context.Context, obj client.Object) []reconcile.Request {
+	return m.mapPodToEndpointSliceRequests(ctx, obj, m.MapEndpointSliceToGRPCRoute)
+}
+
+// MapPodToGateway maps Pod events to reconcile requests of the Gateways with default
+// backend EndpointSlices targeting the Pod. Its signature matches handler.MapFunc.
+func (m *WatchesModel) MapPodToGateway(ctx context.Context, obj client.Object) []reconcile.Request {
+	return m.mapPodToEndpointSliceRequests(ctx, obj, m.MapEndpointSliceToGateway)
+}
+
+// mapPodToEndpointSliceRequests maps the Pod to requests of the EndpointSlices with endpoints
+// targeting the Pod. Pods are not indexed by the EndpointSlices, so the slices of the Pod
+// namespace are listed.
+func (m *WatchesModel) mapPodToEndpointSliceRequests(
+	ctx context.Context,
+	obj client.Object,
+	mapEndpointSlice func(ctx context.Context, obj client.Object) []reconcile.Request,
+) []reconcile.Request {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		m.logger.WarnContext(ctx, "Received non-Pod object", slog.Any("object", obj))
+		return nil
+	}
+
+	var sliceList discoveryv1.EndpointSliceList
+	if err := m.k8sClient.List(ctx, &sliceList, client.InNamespace(pod.Namespace)); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to list EndpointSlices for Pod change",
+			slog.String("pod", client.ObjectKeyFromObject(pod).String()),
+			diag.ErrAttr(err),
+		)
+		return nil
+	}
+
+	podKey := client.ObjectKeyFromObject(pod)
+	requests := make([]reconcile.Request, 0)
+	for _, slice := range sliceList.Items {
+		targetsPod := lo.ContainsBy(slice.Endpoints, func(endpoint discoveryv1.Endpoint) bool {
+			key, hasPod := endpointPodKey(slice.Namespace, endpoint)
+			return hasPod && key == podKey
+		})
+		if targetsPod {
+			requests = append(requests, mapEndpointSlice(ctx, &slice)...)
+		}
+	}
+	return lo.Uniq(requests)
+}
+
+// MapNodeToHTTPRoute maps Node events to HTTPRoutes of gateways with the NodePort backend mode,
+// so backends registered with node IPs follow the cluster scale events.
+func (m *WatchesModel) MapNodeToHTTPRoute(ctx context.Context, obj client.Object) []reconcile.Request {
+	gatewayKeys := m.nodePortBackendGatewayKeys(ctx, obj)
+	if len(gatewayKeys) == 0 {
+		return nil
+	}
+	return mapParentGatewaysToL7RouteRequests(
+		ctx,
+		m.logger,
+		m.k8sClient,
+		gatewayKeys,
+		httpRouteParentGatewayIndexKey,
+		"HTTPRoutes",
+		func() *gatewayv1.HTTPRouteList { return &gatewayv1.HTTPRouteList{} },
+		func(routeList *gatewayv1.HTTPRouteList, requestsByKey map[client.ObjectKey]reconcile.Request) {
+			for _, route := range routeList.Items {
+				if route.DeletionTimestamp != nil {
+					continue
+				}
+				key := client.ObjectKeyFromObject(&route)
+				requestsByKey[key] = reconcile.Request{NamespacedName: key}
+			}
+		},
+	)
+}
+
+func (m *WatchesModel) MapNodeToGRPCRoute(ctx context.Context, obj client.Object) []reconcile.Request {
+	gatewayKeys := m.nodePortBackendGatewayKeys(ctx, obj)
+	if len(gatewayKeys) == 0 {
+		return nil
+	}
+	return mapParentGatewaysToL7RouteRequests(
+		ctx,
+		m.logger,
+		m.k8sClient,
+		gatewayKeys,
+		grpcRouteParentGatewayIndexKey,
+		"GRPCRoutes",
+		func() *gatewayv1.GRPCRouteList { return &gatewayv1.GRPCRouteList{} },
+		func(routeList *gatewayv1.GRPCRouteList, requestsByKey map[client.ObjectKey]reconcile.Request) {
+			for _, route := range routeList.Items {
+				if route.DeletionTimestamp != nil {
+					continue
+				}
+				key := client.ObjectKeyFromObject(&route)
+				requestsByKey[key] = reconcile.Request{NamespacedName: key}
+			}
+		},
+	)
+}
+
+// nodePortBackendGatewayKeys returns parent gateway index keys of gateways
+// whose GatewayConfig uses the NodePort backend mode.
+func (m *WatchesModel) nodePortBackendGatewayKeys(ctx context.Context, obj client.Object) []string {
+	if _, ok := obj.(*corev1.Node); !ok {
+		m.logger.WarnContext(ctx, "Received non-Node object", slog.Any("object", obj))
+		return nil
+	}
+
+	var configs configtypes.GatewayConfigList
+	if err := m.k8sClient.List(ctx, &configs); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to list GatewayConfigs for Node change", diag.ErrAttr(err))
+		return nil
+	}
+	nodePortConfigs := make(map[client.ObjectKey]struct{})
+	for _, config := range configs.Items {
+		if config.Spec.BackendMode == backendModeNodePort {
+			nodePortConfigs[client.ObjectKeyFromObject(&config)] = struct{}{}
+		}
+	}
+	if len(nodePortConfigs) == 0 {
+		return nil
+	}
+
+	var gateways gatewayv1.GatewayList
+	if err := m.k8sClient.List(ctx, &gateways); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to list Gateways for Node change", diag.ErrAttr(err))
+		return nil
+	}
+	gatewayKeys := make([]string, 0, len(gateways.Items))
+	for _, gateway := range gateways.Items {
+		if gateway.Spec.Infrastructure == nil || gateway.Spec.Infrastructure.ParametersRef == nil {
+			continue
+		}
+		configKey := client.ObjectKey{
+			Namespace: gateway.Namespace,
+			Name:      gateway.Spec.Infrastructure.ParametersRef.Name,
+		}
+		if _, ok := nodePortConfigs[configKey]; ok {
+			gatewayKeys = append(gatewayKeys, path.Join(gateway.Namespace, gateway.Name))
+		}
+	}
+	m.logger.DebugContext(ctx, "Mapping Node change to gateways with NodePort backends",
+		slog.String("node", obj.GetName()),
+		slog.Any("gateways", gatewayKeys),
+	)
+	return gatewayKeys
+}
+
 func (m *WatchesModel) MapEndpointSliceToTCPRoute(ctx context.Context, obj client.Object) []reconcile.Request {
 	var routeList gatewayv1.TCPRouteList
 	return mapEndpointSliceToL4Route(
@@ -868,6 +1026,34 @@ func (m *WatchesModel) MapServiceToTLSRoute(ctx context.Context, obj client.Obje
 		tlsRouteBackendServiceIndexKey, "TLSRoutes")
 }
 
+// MapExternalBackendToHTTPRoute maps OkeExternalBackend events to HTTPRoute reconcile requests.
+// Routes are indexed by backendRef names regardless of the kind, so the service index is reused.
+func (m *WatchesModel) MapExternalBackendToHTTPRoute(ctx context.Context, obj client.Object) []reconcile.Request {
+	return m.mapExternalBackendToIndexedRoutes(ctx, obj, &gatewayv1.HTTPRouteList{},
+		httpRouteBackendServiceIndexKey, "HTTPRoutes")
+}
+
+func (m *WatchesModel) MapExternalBackendToGRPCRoute(ctx context.Context, obj client.Object) []reconcile.Request {
+	return m.mapExternalBackendToIndexedRoutes(ctx, obj, &gatewayv1.GRPCRouteList{},
+		grpcRouteBackendServiceIndexKey, "GRPCRoutes")
+}
+
+func (m *WatchesModel) mapExternalBackendToIndexedRoutes(
+	ctx context.Context,
+	obj client.Object,
+	routeList client.ObjectList,
+	indexKey string,
+	routeKind string,
+) []reconcile.Request {
+	externalBackend, ok := obj.(*configtypes.OkeExternalBackend)
+	if !ok {
+		m.logger.WarnContext(ctx, "Received non-OkeExternalBackend object", slog.Any("object", obj))
+		return nil
+	}
+	backendKey := path.Join(externalBackend.Namespace, externalBackend.Name)
+	return m.mapServiceKeyToIndexedRoutes(ctx, backendKey, routeList, indexKey, routeKind)
+}
+
 func (m *WatchesModel) mapBackendTLSPolicyToIndexedRoutes(
 	ctx context.Context,
 	obj client.Object,
@@ -1292,6 +1478,189 @@ func (m *WatchesModel) MapGatewayConfigToGateway(ctx context.Context, obj client
 	return requests
 }
 
+// MapGatewayClassConfigToGateway maps GatewayClassConfig events to reconcile requests of
+// Gateways of the classes referencing the config. Its signature matches handler.MapFunc.
+func (m *WatchesModel) MapGatewayClassConfigToGateway(ctx context.Context, obj client.Object) []reconcile.Request {
+	classConfig, ok := obj.(*configtypes.GatewayClassConfig)
+	if !ok {
+		m.logger.WarnContext(ctx, "Received non-GatewayClassConfig object", slog.Any("object", obj))
+		return nil
+	}
+
+	var gatewayClassList gatewayv1.GatewayClassList
+	if err := m.k8sClient.List(ctx, &gatewayClassList); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to list GatewayClasses for GatewayClassConfig change",
+			slog.String("gatewayClassConfig", classConfig.Name),
+			diag.ErrAttr(err),
+		)
+		return nil
+	}
+	classNames := make(map[gatewayv1.ObjectName]struct{})
+	for _, gatewayClass := range gatewayClassList.Items {
+		if gatewayClassReferencesConfig(&gatewayClass, classConfig.Name) {
+			classNames[gatewayv1.ObjectName(gatewayClass.Name)] = struct{}{}
+		}
+	}
+	if len(classNames) == 0 {
+		return nil
+	}
+
+	var gatewayList gatewayv1.GatewayList
+	if err := m.k8sClient.List(ctx, &gatewayList); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to list Gateways for GatewayClassConfig change",
+			slog.String("gatewayClassConfig", classConfig.Name),
+			diag.ErrAttr(err),
+		)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for _, gateway := range gatewayList.Items {
+		if gateway.DeletionTimestamp != nil || !gatewayUsesSupportedController(&gateway) {
+			continue
+		}
+		if _, found := classNames[gateway.Spec.GatewayClassName]; !found {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&gateway)})
+		m.logger.InfoContext(ctx,
+			"Queueing Gateway for reconciliation due to GatewayClassConfig change",
+			slog.String("gateway", client.ObjectKeyFromObject(&gateway).String()),
+			slog.String("gatewayClassConfig", classConfig.Name),
+			slog.Int64("gatewayClassConfigGeneration", classConfig.Generation),
+		)
+	}
+
+	return requests
+}
+
+// MapEndpointSliceToGateway maps EndpointSlice events of default backend Services
+// to reconcile requests of the Gateways using them. Its signature matches handler.MapFunc.
+func (m *WatchesModel) MapEndpointSliceToGateway(ctx context.Context, obj client.Object) []reconcile.Request {
+	epSlice, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		m.logger.WarnContext(ctx, "Received non-EndpointSlice object", slog.Any("object", obj))
+		return nil
+	}
+
+	svcName, ok := epSlice.Labels[discoveryv1.LabelServiceName]
+	if !ok {
+		return nil
+	}
+
+	return m.mapDefaultBackendServiceToGateway(ctx, epSlice.Namespace, svcName)
+}
+
+// MapServiceToGateway maps default backend Service events to reconcile requests of the
+// Gateways using them, so the default backend set health check follows the Service.
+// Its signature matches handler.MapFunc.
+func (m *WatchesModel) MapServiceToGateway(ctx context.Context, obj client.Object) []reconcile.Request {
+	service, ok := obj.(*corev1.Service)
+	if !ok {
+		m.logger.WarnContext(ctx, "Received non-Service object", slog.Any("object", obj))
+		return nil
+	}
+	return m.mapDefaultBackendServiceToGateway(ctx, service.Namespace, service.Name)
+}
+
+func (m *WatchesModel) mapDefaultBackendServiceToGateway(
+	ctx context.Context,
+	namespace string,
+	serviceName string,
+) []reconcile.Request {
+	var configList configtypes.GatewayConfigList
+	if err := m.k8sClient.List(ctx, &configList, client.InNamespace(namespace)); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to list GatewayConfigs for default backend Service change",
+			slog.String("service", namespace+"/"+serviceName),
+			diag.ErrAttr(err),
+		)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for _, config := range configList.Items {
+		if config.Spec.DefaultBackend == nil || config.Spec.DefaultBackend.ServiceName != serviceName {
+			continue
+		}
+		requests = append(requests, m.MapGatewayConfigToGateway(ctx, &config)...)
+	}
+
+	return requests
+}
+
+// MapListenerPolicyToGateway maps OkeListenerPolicy events to reconcile requests
+// of the Gateways whose GatewayConfig references the policy. Its signature matches handler.MapFunc.
+func (m *WatchesModel) MapListenerPolicyToGateway(ctx context.Context, obj client.Object) []reconcile.Request {
+	policy, ok := obj.(*configtypes.OkeListenerPolicy)
+	if !ok {
+		m.logger.WarnContext(ctx, "Received non-OkeListenerPolicy object", slog.Any("object", obj))
+		return nil
+	}
+
+	var configList configtypes.GatewayConfigList
+	if err := m.k8sClient.List(ctx, &configList, client.InNamespace(policy.Namespace)); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to list GatewayConfigs for OkeListenerPolicy change",
+			slog.String("listenerPolicy", client.ObjectKeyFromObject(policy).String()),
+			diag.ErrAttr(err),
+		)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for _, config := range configList.Items {
+		if config.Spec.ListenerPolicyName != policy.Name {
+			continue
+		}
+		requests = append(requests, m.MapGatewayConfigToGateway(ctx, &config)...)
+	}
+
+	return requests
+}
+
+// MapAccessPolicyToGateway maps OkeAccessPolicy events to reconcile requests
+// of the targeted Gateways. Its signature matches handler.MapFunc.
+func (m *WatchesModel) MapAccessPolicyToGateway(ctx context.Context, obj client.Object) []reconcile.Request {
+	policy, ok := obj.(*configtypes.OkeAccessPolicy)
+	if !ok {
+		m.logger.WarnContext(ctx, "Received non-OkeAccessPolicy object", slog.Any("object", obj))
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(policy.Spec.TargetRefs))
+	for _, ref := range policy.Spec.TargetRefs {
+		if ref.Group != gatewayv1.GroupName || ref.Kind != "Gateway" {
+			continue
+		}
+		request := reconcile.Request{NamespacedName: client.ObjectKey{
+			Namespace: policy.Namespace,
+			Name:      string(ref.Name),
+		}}
+		if !lo.Contains(requests, request) {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests
+}
+
+// MapGatewayToGatewayConfig maps Gateway events to the reconcile request of the referenced GatewayConfig.
+// Its signature matches handler.MapFunc.
+func (m *WatchesModel) MapGatewayToGatewayConfig(ctx context.Context, obj client.Object) []reconcile.Request {
+	gateway, ok := obj.(*gatewayv1.Gateway)
+	if !ok {
+		m.logger.WarnContext(ctx, "Received non-Gateway object", slog.Any("object", obj))
+		return nil
+	}
+	if gateway.Spec.Infrastructure == nil || gateway.Spec.Infrastructure.ParametersRef == nil {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{
+		Namespace: gateway.Namespace,
+		Name:      gateway.Spec.Infrastructure.ParametersRef.Name,
+	}}}
+}
+
 func gatewayUsesSupportedController(gateway *gatewayv1.Gateway) bool {
 	if gateway.Annotations == nil {
 		return false
@@ -1388,6 +1757,82 @@ func (m *WatchesModel) MapSecretToGateway(ctx context.Context, obj client.Object
 	return requests
 }
 
+// MapReferenceGrantToGateway maps ReferenceGrant events to reconcile requests of the Gateways
+// with listeners referencing certificate Secrets in the grant namespace.
+// Its signature matches handler.MapFunc.
+func (m *WatchesModel) MapReferenceGrantToGateway(ctx context.Context, obj client.Object) []reconcile.Request {
+	var gatewayList gatewayv1.GatewayList
+	return mapReferenceGrantToL4Route(ctx, m.logger, m.k8sClient, obj, &gatewayList, "Gateways",
+		func(gatewayList *gatewayv1.GatewayList, grant *gatewayv1beta1.ReferenceGrant) []reconcile.Request {
+			requests := make([]reconcile.Request, 0)
+			for _, gateway := range gatewayList.Items {
+				if gateway.DeletionTimestamp != nil {
+					continue
+				}
+				if gatewayReferencesSecretNamespace(gateway, grant.Namespace) {
+					requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&gateway)})
+				}
+			}
+			return requests
+		},
+	)
+}
+
+func gatewayReferencesSecretNamespace(gateway gatewayv1.Gateway, secretNamespace string) bool {
+	if gateway.Namespace == secretNamespace {
+		return false
+	}
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.TLS == nil {
+			continue
+		}
+		for _, ref := range listener.TLS.CertificateRefs {
+			if ref.Namespace != nil && string(*ref.Namespace) == secretNamespace {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MapConfigMapToGateway maps ConfigMap events to reconcile requests of the Gateways
+// with listeners referencing the ConfigMap as client CA. Its signature matches handler.MapFunc.
+func (m *WatchesModel) MapConfigMapToGateway(ctx context.Context, obj client.Object) []reconcile.Request {
+	configMap, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		m.logger.WarnContext(ctx, "Received non-ConfigMap object", slog.Any("object", obj))
+		return nil
+	}
+
+	var gatewayList gatewayv1.GatewayList
+	if err := m.k8sClient.List(ctx, &gatewayList, client.InNamespace(configMap.Namespace)); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to list Gateways for ConfigMap change",
+			slog.String("configMap", client.ObjectKeyFromObject(configMap).String()),
+			diag.ErrAttr(err),
+		)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for _, gateway := range gatewayList.Items {
+		if gateway.DeletionTimestamp != nil || !gatewayUsesSupportedController(&gateway) {
+			continue
+		}
+		referencesConfigMap := lo.ContainsBy(gateway.Spec.Listeners, func(listener gatewayv1.Listener) bool {
+			return listener.TLS != nil &&
+				strings.TrimSpace(string(listener.TLS.Options[ListenerTLSOptionClientCAConfigMap])) == configMap.Name
+		})
+		if !referencesConfigMap {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(&gateway),
+		})
+	}
+
+	return requests
+}
+
 func (m *WatchesModel) MapSecretToTLSRoute(ctx context.Context, obj client.Object) []reconcile.Request {
 	gatewayRequests := m.MapSecretToGateway(ctx, obj)
 	if len(gatewayRequests) == 0 {
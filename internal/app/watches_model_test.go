@@ -1136,6 +1136,142 @@ func TestWatchesModel(t *testing.T) {
 		})
 	})
 
+	t.Run("MapPodToHTTPRoute", func(t *testing.T) {
+		t.Run("queues HTTPRoutes of EndpointSlices targeting the pod", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := NewWatchesModel(deps)
+
+			svcName := faker.New().Internet().Domain()
+			ns := faker.New().Internet().User()
+			pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: faker.New().Internet().Slug()}}
+			podSlice := makeRandomEndpointSlice(
+				randomEndpointSliceWithNamespaceOpt(ns),
+				randomEndpointSliceWithServiceNameOpt(svcName),
+			)
+			podSlice.Endpoints = []discoveryv1.Endpoint{makeRandomEndpoint(), makeRandomEndpoint()}
+			podSlice.Endpoints[1].TargetRef = &corev1.ObjectReference{Kind: "Pod", Name: pod.Name}
+			otherSlice := makeRandomEndpointSlice(randomEndpointSliceWithNamespaceOpt(ns))
+			wantRoutes := []gatewayv1.HTTPRoute{makeRandomHTTPRoute(), makeRandomHTTPRoute()}
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().List(t.Context(), &discoveryv1.EndpointSliceList{}, client.InNamespace(ns)).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(
+						reflect.ValueOf([]discoveryv1.EndpointSlice{podSlice, otherSlice}),
+					)
+					return nil
+				})
+			mockK8sClient.EXPECT().List(
+				t.Context(),
+				&gatewayv1.HTTPRouteList{},
+				client.MatchingFields{httpRouteBackendServiceIndexKey: fmt.Sprintf("%v/%v", ns, svcName)},
+			).RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+				reflect.ValueOf(list).Elem().FieldByName("Items").Set(reflect.ValueOf(wantRoutes))
+				return nil
+			}).Once()
+
+			result := model.MapPodToHTTPRoute(t.Context(), &pod)
+
+			require.ElementsMatch(t, lo.Map(wantRoutes, func(route gatewayv1.HTTPRoute, _ int) reconcile.Request {
+				return reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&route)}
+			}), result)
+		})
+
+		t.Run("returns nil for non Pod objects", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := NewWatchesModel(deps)
+
+			result := model.MapPodToHTTPRoute(t.Context(), &corev1.Service{})
+
+			require.Nil(t, result)
+		})
+	})
+
+	t.Run("MapNodeToHTTPRoute", func(t *testing.T) {
+		t.Run("queues HTTPRoutes of gateways with NodePort backends", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := NewWatchesModel(deps)
+			namespace := faker.New().Internet().Slug()
+			nodePortConfig := makeRandomGatewayConfig()
+			nodePortConfig.Namespace = namespace
+			nodePortConfig.Name = "node-port-" + faker.New().Internet().Slug()
+			nodePortConfig.Spec.BackendMode = backendModeNodePort
+			podIPConfig := makeRandomGatewayConfig()
+			podIPConfig.Namespace = namespace
+			podIPConfig.Name = "pod-ip-" + faker.New().Internet().Slug()
+			makeGateway := func(configName string) gatewayv1.Gateway {
+				return *newRandomGateway(func(gateway *gatewayv1.Gateway) {
+					gateway.Namespace = namespace
+					gateway.Spec.Infrastructure = &gatewayv1.GatewayInfrastructure{
+						ParametersRef: &gatewayv1.LocalParametersReference{Name: configName},
+					}
+				})
+			}
+			nodePortGateway := makeGateway(nodePortConfig.Name)
+			podIPGateway := makeGateway(podIPConfig.Name)
+			wantRoutes := []gatewayv1.HTTPRoute{makeRandomHTTPRoute(), makeRandomHTTPRoute()}
+
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().List(t.Context(), &configtypes.GatewayConfigList{}).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(
+						reflect.ValueOf([]configtypes.GatewayConfig{nodePortConfig, podIPConfig}),
+					)
+					return nil
+				})
+			mockK8sClient.EXPECT().List(t.Context(), &gatewayv1.GatewayList{}).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(
+						reflect.ValueOf([]gatewayv1.Gateway{nodePortGateway, podIPGateway}),
+					)
+					return nil
+				})
+			mockK8sClient.EXPECT().List(
+				t.Context(),
+				&gatewayv1.HTTPRouteList{},
+				client.MatchingFields{
+					httpRouteParentGatewayIndexKey: fmt.Sprintf("%s/%s", namespace, nodePortGateway.Name),
+				},
+			).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(reflect.ValueOf(wantRoutes))
+					return nil
+				})
+
+			result := model.MapNodeToHTTPRoute(t.Context(), &corev1.Node{})
+
+			require.ElementsMatch(t, lo.Map(wantRoutes, func(route gatewayv1.HTTPRoute, _ int) reconcile.Request {
+				return reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&route)}
+			}), result)
+		})
+
+		t.Run("returns nil when no GatewayConfig uses NodePort backends", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := NewWatchesModel(deps)
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().List(t.Context(), &configtypes.GatewayConfigList{}).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(
+						reflect.ValueOf([]configtypes.GatewayConfig{makeRandomGatewayConfig()}),
+					)
+					return nil
+				})
+
+			result := model.MapNodeToHTTPRoute(t.Context(), &corev1.Node{})
+
+			require.Nil(t, result)
+		})
+
+		t.Run("returns nil for non Node objects", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := NewWatchesModel(deps)
+
+			result := model.MapNodeToHTTPRoute(t.Context(), &corev1.Service{})
+
+			require.Nil(t, result)
+		})
+	})
+
 	t.Run("MapHTTPRouteToGRPCRoute", func(t *testing.T) {
 		t.Run("queues GRPCRoutes with matching parent Gateway refs", func(t *testing.T) {
 			deps := makeMockDeps(t)
@@ -1877,6 +2013,45 @@ func TestWatchesModel(t *testing.T) {
 			require.Nil(t, model.MapReferenceGrantToUDPRoute(t.Context(), grant))
 		})
 
+		t.Run("maps ReferenceGrant changes to Gateways with cross namespace certificateRefs", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := NewWatchesModel(deps)
+			grant := &gatewayv1beta1.ReferenceGrant{ObjectMeta: metav1.ObjectMeta{Namespace: "certs", Name: "allow"}}
+			certsNamespace := gatewayv1.Namespace("certs")
+			makeGateway := func(namespace, name string, refs ...gatewayv1.SecretObjectReference) gatewayv1.Gateway {
+				return gatewayv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+					Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+						{Name: "http", Protocol: gatewayv1.HTTPProtocolType},
+						{Name: "https", Protocol: gatewayv1.HTTPSProtocolType, TLS: &gatewayv1.ListenerTLSConfig{
+							CertificateRefs: refs,
+						}},
+					}},
+				}
+			}
+			deleted := makeGateway("edge", "deleted", gatewayv1.SecretObjectReference{Name: "tls", Namespace: &certsNamespace})
+			deleted.DeletionTimestamp = &metav1.Time{}
+			gateways := []gatewayv1.Gateway{
+				makeGateway("edge", "public", gatewayv1.SecretObjectReference{Name: "tls", Namespace: &certsNamespace}),
+				makeGateway("edge", "local", gatewayv1.SecretObjectReference{Name: "tls"}),
+				makeGateway("certs", "same-namespace", gatewayv1.SecretObjectReference{
+					Name: "tls", Namespace: &certsNamespace,
+				}),
+				deleted,
+			}
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().List(t.Context(), &gatewayv1.GatewayList{}).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(reflect.ValueOf(gateways))
+					return nil
+				})
+
+			require.Equal(t, []reconcile.Request{
+				{NamespacedName: apitypes.NamespacedName{Namespace: "edge", Name: "public"}},
+			}, model.MapReferenceGrantToGateway(t.Context(), grant))
+			require.Nil(t, model.MapReferenceGrantToGateway(t.Context(), &corev1.Service{}))
+		})
+
 		t.Run("maps Gateway changes to attached L4 routes", func(t *testing.T) {
 			deps := makeMockDeps(t)
 			model := NewWatchesModel(deps)
@@ -2257,6 +2432,385 @@ func TestWatchesModel(t *testing.T) {
 
 			require.Nil(t, model.MapGatewayConfigToGateway(t.Context(), config))
 		})
+
+		t.Run("maps GatewayClassConfig to Gateways of referencing classes", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := NewWatchesModel(deps)
+			classConfig := &configtypes.GatewayClassConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "defaults"},
+			}
+			gatewayClasses := []gatewayv1.GatewayClass{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "oke"},
+					Spec: gatewayv1.GatewayClassSpec{
+						ParametersRef: &gatewayv1.ParametersReference{
+							Group: configtypes.GroupName,
+							Kind:  "GatewayClassConfig",
+							Name:  "defaults",
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "other"},
+					Spec: gatewayv1.GatewayClassSpec{
+						ParametersRef: &gatewayv1.ParametersReference{
+							Group: configtypes.GroupName,
+							Kind:  "GatewayClassConfig",
+							Name:  "other-defaults",
+						},
+					},
+				},
+				{ObjectMeta: metav1.ObjectMeta{Name: "no-params"}},
+			}
+			supportedAnnotations := map[string]string{ControllerClassName: "true"}
+			gateways := []gatewayv1.Gateway{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "edge", Annotations: supportedAnnotations},
+					Spec:       gatewayv1.GatewaySpec{GatewayClassName: "oke"},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "apps", Name: "web", Annotations: supportedAnnotations},
+					Spec:       gatewayv1.GatewaySpec{GatewayClassName: "oke"},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "other", Annotations: supportedAnnotations},
+					Spec:       gatewayv1.GatewaySpec{GatewayClassName: "other"},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "unsupported"},
+					Spec:       gatewayv1.GatewaySpec{GatewayClassName: "oke"},
+				},
+			}
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				List(t.Context(), &gatewayv1.GatewayClassList{}).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(reflect.ValueOf(gatewayClasses))
+					return nil
+				})
+			mockK8sClient.EXPECT().
+				List(t.Context(), &gatewayv1.GatewayList{}).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(reflect.ValueOf(gateways))
+					return nil
+				})
+
+			require.Equal(t, []reconcile.Request{
+				{NamespacedName: apitypes.NamespacedName{Namespace: "iot", Name: "edge"}},
+				{NamespacedName: apitypes.NamespacedName{Namespace: "apps", Name: "web"}},
+			}, model.MapGatewayClassConfigToGateway(t.Context(), classConfig))
+			require.Nil(t, model.MapGatewayClassConfigToGateway(t.Context(), &corev1.Service{}))
+		})
+
+		t.Run("skips GatewayClassConfig not referenced by classes", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := NewWatchesModel(deps)
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				List(t.Context(), &gatewayv1.GatewayClassList{}).
+				Return(nil)
+
+			require.Nil(t, model.MapGatewayClassConfigToGateway(t.Context(), &configtypes.GatewayClassConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "defaults"},
+			}))
+			mockK8sClient.AssertNotCalled(t, "List", t.Context(), &gatewayv1.GatewayList{})
+		})
+
+		t.Run("maps default backend EndpointSlice to Gateways", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := NewWatchesModel(deps)
+			epSlice := &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "iot",
+					Name:      "fallback-abc",
+					Labels:    map[string]string{discoveryv1.LabelServiceName: "fallback"},
+				},
+			}
+			configs := []configtypes.GatewayConfig{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "edge-config"},
+					Spec: configtypes.GatewayConfigSpec{
+						DefaultBackend: &configtypes.GatewayConfigDefaultBackend{ServiceName: "fallback", Port: 80},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "other-config"},
+					Spec: configtypes.GatewayConfigSpec{
+						DefaultBackend: &configtypes.GatewayConfigDefaultBackend{ServiceName: "other", Port: 80},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "plain-config"},
+				},
+			}
+			gateways := []gatewayv1.Gateway{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "iot",
+						Name:        "edge",
+						Annotations: map[string]string{ControllerClassName: "true"},
+					},
+					Spec: gatewayv1.GatewaySpec{
+						Infrastructure: &gatewayv1.GatewayInfrastructure{
+							ParametersRef: &gatewayv1.LocalParametersReference{
+								Name: "edge-config",
+							},
+						},
+					},
+				},
+			}
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				List(t.Context(), &configtypes.GatewayConfigList{}, client.InNamespace("iot")).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(reflect.ValueOf(configs))
+					return nil
+				}).Once()
+			mockK8sClient.EXPECT().
+				List(t.Context(), &gatewayv1.GatewayList{}, client.InNamespace("iot")).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(reflect.ValueOf(gateways))
+					return nil
+				}).Once()
+
+			require.Equal(t, []reconcile.Request{
+				{NamespacedName: apitypes.NamespacedName{Namespace: "iot", Name: "edge"}},
+			}, model.MapEndpointSliceToGateway(t.Context(), epSlice))
+			require.Nil(t, model.MapEndpointSliceToGateway(t.Context(), &corev1.Service{}))
+			require.Nil(t, model.MapEndpointSliceToGateway(t.Context(), &discoveryv1.EndpointSlice{}))
+		})
+
+		t.Run("maps default backend Service to Gateways", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := NewWatchesModel(deps)
+			service := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "fallback"},
+			}
+			configs := []configtypes.GatewayConfig{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "edge-config"},
+					Spec: configtypes.GatewayConfigSpec{
+						DefaultBackend: &configtypes.GatewayConfigDefaultBackend{ServiceName: "fallback", Port: 80},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "plain-config"},
+				},
+			}
+			gateways := []gatewayv1.Gateway{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "iot",
+						Name:        "edge",
+						Annotations: map[string]string{ControllerClassName: "true"},
+					},
+					Spec: gatewayv1.GatewaySpec{
+						Infrastructure: &gatewayv1.GatewayInfrastructure{
+							ParametersRef: &gatewayv1.LocalParametersReference{
+								Name: "edge-config",
+							},
+						},
+					},
+				},
+			}
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				List(t.Context(), &configtypes.GatewayConfigList{}, client.InNamespace("iot")).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(reflect.ValueOf(configs))
+					return nil
+				}).Once()
+			mockK8sClient.EXPECT().
+				List(t.Context(), &gatewayv1.GatewayList{}, client.InNamespace("iot")).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(reflect.ValueOf(gateways))
+					return nil
+				}).Once()
+
+			require.Equal(t, []reconcile.Request{
+				{NamespacedName: apitypes.NamespacedName{Namespace: "iot", Name: "edge"}},
+			}, model.MapServiceToGateway(t.Context(), service))
+			require.Nil(t, model.MapServiceToGateway(t.Context(), &discoveryv1.EndpointSlice{}))
+		})
+
+		t.Run("maps OkeListenerPolicy to Gateways", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := NewWatchesModel(deps)
+			policy := &configtypes.OkeListenerPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "limits"},
+			}
+			configs := []configtypes.GatewayConfig{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "edge-config"},
+					Spec:       configtypes.GatewayConfigSpec{ListenerPolicyName: "limits"},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "other-config"},
+					Spec:       configtypes.GatewayConfigSpec{ListenerPolicyName: "other"},
+				},
+			}
+			gateways := []gatewayv1.Gateway{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "iot",
+						Name:        "edge",
+						Annotations: map[string]string{ControllerClassName: "true"},
+					},
+					Spec: gatewayv1.GatewaySpec{
+						Infrastructure: &gatewayv1.GatewayInfrastructure{
+							ParametersRef: &gatewayv1.LocalParametersReference{
+								Name: "edge-config",
+							},
+						},
+					},
+				},
+			}
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				List(t.Context(), &configtypes.GatewayConfigList{}, client.InNamespace("iot")).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(reflect.ValueOf(configs))
+					return nil
+				}).Once()
+			mockK8sClient.EXPECT().
+				List(t.Context(), &gatewayv1.GatewayList{}, client.InNamespace("iot")).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(reflect.ValueOf(gateways))
+					return nil
+				}).Once()
+
+			require.Equal(t, []reconcile.Request{
+				{NamespacedName: apitypes.NamespacedName{Namespace: "iot", Name: "edge"}},
+			}, model.MapListenerPolicyToGateway(t.Context(), policy))
+			require.Nil(t, model.MapListenerPolicyToGateway(t.Context(), &corev1.Service{}))
+		})
+
+		t.Run("handles OkeListenerPolicy GatewayConfig list errors", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := NewWatchesModel(deps)
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				List(t.Context(), &configtypes.GatewayConfigList{}, client.InNamespace("iot")).
+				Return(errors.New("config list failed"))
+
+			require.Nil(t, model.MapListenerPolicyToGateway(t.Context(), &configtypes.OkeListenerPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "limits"},
+			}))
+		})
+
+		t.Run("maps OkeAccessPolicy to targeted Gateways", func(t *testing.T) {
+			model := NewWatchesModel(makeMockDeps(t))
+			gatewayRef := func(
+				name string,
+				sectionName *gatewayv1.SectionName,
+			) gatewayv1.LocalPolicyTargetReferenceWithSectionName {
+				return gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+					LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+						Group: gatewayv1.GroupName,
+						Kind:  "Gateway",
+						Name:  gatewayv1.ObjectName(name),
+					},
+					SectionName: sectionName,
+				}
+			}
+			policy := &configtypes.OkeAccessPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "admin-access"},
+				Spec: configtypes.OkeAccessPolicySpec{
+					TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+						gatewayRef("edge", nil),
+						gatewayRef("edge", new(gatewayv1.SectionName("admin"))),
+						gatewayRef("internal", nil),
+						{LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{Kind: "Service", Name: "api"}},
+					},
+				},
+			}
+
+			require.Equal(t, []reconcile.Request{
+				{NamespacedName: apitypes.NamespacedName{Namespace: "iot", Name: "edge"}},
+				{NamespacedName: apitypes.NamespacedName{Namespace: "iot", Name: "internal"}},
+			}, model.MapAccessPolicyToGateway(t.Context(), policy))
+			require.Nil(t, model.MapAccessPolicyToGateway(t.Context(), &corev1.Service{}))
+		})
+
+		t.Run("maps client CA ConfigMap to Gateways", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := NewWatchesModel(deps)
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "client-ca"},
+			}
+			makeGateway := func(name string, configMapName string, annotations map[string]string) gatewayv1.Gateway {
+				return gatewayv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: name, Annotations: annotations},
+					Spec: gatewayv1.GatewaySpec{Listeners: []gatewayv1.Listener{
+						{Name: "http", Protocol: gatewayv1.HTTPProtocolType},
+						{
+							Name:     "mtls",
+							Protocol: gatewayv1.HTTPSProtocolType,
+							TLS: &gatewayv1.ListenerTLSConfig{
+								Options: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+									ListenerTLSOptionClientCAConfigMap: gatewayv1.AnnotationValue(configMapName),
+								},
+							},
+						},
+					}},
+				}
+			}
+			supported := map[string]string{ControllerClassName: "true"}
+			deletionTime := metav1.Now()
+			deletingGateway := makeGateway("deleting", "client-ca", supported)
+			deletingGateway.DeletionTimestamp = &deletionTime
+			gateways := []gatewayv1.Gateway{
+				makeGateway("edge", "client-ca", supported),
+				makeGateway("other-ca", "other", supported),
+				makeGateway("unsupported", "client-ca", nil),
+				deletingGateway,
+			}
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				List(t.Context(), &gatewayv1.GatewayList{}, client.InNamespace("iot")).
+				RunAndReturn(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					reflect.ValueOf(list).Elem().FieldByName("Items").Set(reflect.ValueOf(gateways))
+					return nil
+				}).Once()
+
+			require.Equal(t, []reconcile.Request{
+				{NamespacedName: apitypes.NamespacedName{Namespace: "iot", Name: "edge"}},
+			}, model.MapConfigMapToGateway(t.Context(), configMap))
+			require.Nil(t, model.MapConfigMapToGateway(t.Context(), &corev1.Service{}))
+		})
+
+		t.Run("handles client CA ConfigMap Gateway list errors", func(t *testing.T) {
+			deps := makeMockDeps(t)
+			model := NewWatchesModel(deps)
+			mockK8sClient, _ := deps.K8sClient.(*Mockk8sClient)
+			mockK8sClient.EXPECT().
+				List(t.Context(), &gatewayv1.GatewayList{}, client.InNamespace("iot")).
+				Return(errors.New("gateway list failed"))
+
+			require.Nil(t, model.MapConfigMapToGateway(t.Context(), &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "client-ca"},
+			}))
+		})
+
+		t.Run("maps Gateway to referenced GatewayConfig", func(t *testing.T) {
+			model := NewWatchesModel(makeMockDeps(t))
+			gateway := &gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "iot", Name: "edge"},
+				Spec: gatewayv1.GatewaySpec{
+					Infrastructure: &gatewayv1.GatewayInfrastructure{
+						ParametersRef: &gatewayv1.LocalParametersReference{
+							Name: "edge-config",
+						},
+					},
+				},
+			}
+
+			require.Equal(t, []reconcile.Request{
+				{NamespacedName: apitypes.NamespacedName{Namespace: "iot", Name: "edge-config"}},
+			}, model.MapGatewayToGatewayConfig(t.Context(), gateway))
+			require.Nil(t, model.MapGatewayToGatewayConfig(t.Context(), &gatewayv1.Gateway{}))
+			require.Nil(t, model.MapGatewayToGatewayConfig(t.Context(), &corev1.Service{}))
+		})
 	})
 
 	t.Run("BackendTLSPolicy watches", func(t *testing.T) {
@@ -2342,7 +2896,18 @@ func TestWatchesModel(t *testing.T) {
 			NamespacedName: apitypes.NamespacedName{Namespace: namespace, Name: "tls"},
 		}}, model.MapServiceToTLSRoute(t.Context(), service))
 
+		externalBackend := &configtypes.OkeExternalBackend{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: serviceName},
+		}
+		require.ElementsMatch(t, []reconcile.Request{{
+			NamespacedName: apitypes.NamespacedName{Namespace: namespace, Name: "http"},
+		}}, model.MapExternalBackendToHTTPRoute(t.Context(), externalBackend))
+		require.ElementsMatch(t, []reconcile.Request{{
+			NamespacedName: apitypes.NamespacedName{Namespace: namespace, Name: "grpc"},
+		}}, model.MapExternalBackendToGRPCRoute(t.Context(), externalBackend))
+
 		require.Nil(t, model.MapBackendTLSPolicyToHTTPRoute(t.Context(), &corev1.Service{}))
+		require.Nil(t, model.MapExternalBackendToHTTPRoute(t.Context(), &corev1.Service{}))
 		require.Nil(t, model.MapConfigMapToHTTPRoute(t.Context(), &corev1.Service{}))
 		require.Nil(t, model.MapServiceToHTTPRoute(t.Context(), &corev1.ConfigMap{}))
 		require.False(t, backendTLSPolicyReferencesConfigMap(*policy, "other"))
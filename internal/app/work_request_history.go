@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"go.uber.org/dig"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+)
+
+// WorkRequestHistoryRecorder keeps the last OCI work requests awaited while reconciling
+// the resource in the WorkRequestHistoryAnnotation.
+type WorkRequestHistoryRecorder struct {
+	logger    *slog.Logger
+	k8sClient k8sClient
+	size      int
+}
+
+// WorkRequestHistoryRecorderDeps contains the dependencies for the WorkRequestHistoryRecorder.
+type WorkRequestHistoryRecorderDeps struct {
+	dig.In
+
+	RootLogger *slog.Logger
+	K8sClient  k8sClient
+
+	// Number of work requests to keep per resource. The history is not recorded if zero.
+	Size int `name:"config.reconcile.work-request-history"`
+}
+
+// NewWorkRequestHistoryRecorder creates a new WorkRequestHistoryRecorder.
+func NewWorkRequestHistoryRecorder(deps WorkRequestHistoryRecorderDeps) *WorkRequestHistoryRecorder {
+	return &WorkRequestHistoryRecorder{
+		logger:    deps.RootLogger.WithGroup("work-request-history"),
+		k8sClient: deps.K8sClient,
+		size:      deps.Size,
+	}
+}
+
+// Enabled reports whether the work request history is recorded.
+func (r *WorkRequestHistoryRecorder) Enabled() bool {
+	return r.size > 0
+}
+
+// Record appends the records to the work request history of the resource, keeping the
+// configured number of the most recent ones. The resource is fetched into obj. Deleted
+// resources are ignored.
+func (r *WorkRequestHistoryRecorder) Record(
+	ctx context.Context,
+	key client.ObjectKey,
+	obj client.Object,
+	records []ociapi.WorkRequestRecord,
+) error {
+	if !r.Enabled() || len(records) == 0 {
+		return nil
+	}
+	if err := r.k8sClient.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get %s: %w", key, err)
+	}
+
+	var history []ociapi.WorkRequestRecord
+	if value, ok := obj.GetAnnotations()[WorkRequestHistoryAnnotation]; ok {
+		if err := json.Unmarshal([]byte(value), &history); err != nil {
+			r.logger.WarnContext(ctx, "Discarding invalid work request history",
+				slog.String("resource", key.String()),
+				diag.ErrAttr(err),
+			)
+			history = nil
+		}
+	}
+	history = append(history, records...)
+	if len(history) > r.size {
+		history = history[len(history)-r.size:]
+	}
+	data, err := json.Marshal(history)
+	if err != nil {
+		return fmt.Errorf("failed to marshal work request history: %w", err)
+	}
+
+	original, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("unexpected object type %T", obj)
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[WorkRequestHistoryAnnotation] = string(data)
+	obj.SetAnnotations(annotations)
+	if err = r.k8sClient.Patch(ctx, obj, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to patch work request history of %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+)
+
+func TestWorkRequestHistoryRecorder(t *testing.T) {
+	key := client.ObjectKey{Namespace: "apps", Name: "edge"}
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	makeRecords := func(ids ...string) []ociapi.WorkRequestRecord {
+		records := make([]ociapi.WorkRequestRecord, 0, len(ids))
+		for _, id := range ids {
+			records = append(records, ociapi.WorkRequestRecord{
+				ID:        id,
+				Operation: "UpdateListener",
+				Status:    "SUCCEEDED",
+				Time:      now,
+			})
+		}
+		return records
+	}
+	newRecorder := func(t *testing.T, size int, annotations map[string]string) (
+		*WorkRequestHistoryRecorder, client.Client,
+	) {
+		k8sClient := fake.NewClientBuilder().WithScheme(newL4TestScheme(t)).WithObjects(&gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name, Annotations: annotations},
+		}).Build()
+		return NewWorkRequestHistoryRecorder(WorkRequestHistoryRecorderDeps{
+			RootLogger: diag.RootTestLogger(),
+			K8sClient:  k8sClient,
+			Size:       size,
+		}), k8sClient
+	}
+	readHistory := func(t *testing.T, k8sClient client.Client) []ociapi.WorkRequestRecord {
+		var gateway gatewayv1.Gateway
+		require.NoError(t, k8sClient.Get(t.Context(), key, &gateway))
+		value, ok := gateway.Annotations[WorkRequestHistoryAnnotation]
+		if !ok {
+			return nil
+		}
+		var history []ociapi.WorkRequestRecord
+		require.NoError(t, json.Unmarshal([]byte(value), &history))
+		return history
+	}
+
+	t.Run("appends records keeping the most recent ones", func(t *testing.T) {
+		existing, err := json.Marshal(makeRecords("wr-1", "wr-2"))
+		require.NoError(t, err)
+		recorder, k8sClient := newRecorder(t, 3, map[string]string{
+			WorkRequestHistoryAnnotation: string(existing),
+			"team":                       "edge",
+		})
+
+		require.NoError(t, recorder.Record(t.Context(), key, &gatewayv1.Gateway{}, makeRecords("wr-3", "wr-4")))
+
+		assert.Equal(t, makeRecords("wr-2", "wr-3", "wr-4"), readHistory(t, k8sClient))
+		var gateway gatewayv1.Gateway
+		require.NoError(t, k8sClient.Get(t.Context(), key, &gateway))
+		assert.Equal(t, "edge", gateway.Annotations["team"])
+	})
+
+	t.Run("replaces invalid history", func(t *testing.T) {
+		recorder, k8sClient := newRecorder(t, 3, map[string]string{
+			WorkRequestHistoryAnnotation: "not-json",
+		})
+
+		require.NoError(t, recorder.Record(t.Context(), key, &gatewayv1.Gateway{}, makeRecords("wr-1")))
+
+		assert.Equal(t, makeRecords("wr-1"), readHistory(t, k8sClient))
+	})
+
+	t.Run("does nothing if disabled", func(t *testing.T) {
+		recorder, k8sClient := newRecorder(t, 0, nil)
+
+		require.NoError(t, recorder.Record(t.Context(), key, &gatewayv1.Gateway{}, makeRecords("wr-1")))
+
+		assert.False(t, recorder.Enabled())
+		assert.Nil(t, readHistory(t, k8sClient))
+	})
+
+	t.Run("ignores deleted resources", func(t *testing.T) {
+		recorder, _ := newRecorder(t, 3, nil)
+
+		require.NoError(t, recorder.Record(
+			t.Context(),
+			client.ObjectKey{Namespace: key.Namespace, Name: "deleted"},
+			&gatewayv1.Gateway{},
+			makeRecords("wr-1"),
+		))
+	})
+}
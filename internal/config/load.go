@@ -26,9 +26,47 @@ func mergeResourceCfg(cfg *viper.Viper, resourceName string) error {
 	return nil
 }
 
+// ConfigFileVersion is the only supported version of the controller config file.
+const ConfigFileVersion = "v1"
+
+// mergeConfigFile merges the user provided YAML config file. The file must declare
+// a supported version and may only override keys that are known to the controller.
+func mergeConfigFile(cfg *viper.Viper, fileName string) error {
+	fileCfg := viper.New()
+	fileCfg.SetConfigFile(fileName)
+	fileCfg.SetConfigType("yaml")
+	if err := fileCfg.ReadInConfig(); err != nil {
+		return fmt.Errorf("failed to read config file %v: %w", fileName, err)
+	}
+
+	version := fileCfg.GetString("version")
+	if version != ConfigFileVersion {
+		return fmt.Errorf("config file %v: unsupported version %q, expected %q", fileName, version, ConfigFileVersion)
+	}
+
+	settings := fileCfg.AllSettings()
+	delete(settings, "version")
+
+	var unknownKeys []error
+	for _, key := range fileCfg.AllKeys() {
+		if key != "version" && !cfg.IsSet(key) {
+			unknownKeys = append(unknownKeys, fmt.Errorf("config file %v: unknown key %v", fileName, key))
+		}
+	}
+	if len(unknownKeys) > 0 {
+		return errors.Join(unknownKeys...)
+	}
+
+	if err := cfg.MergeConfigMap(settings); err != nil {
+		return fmt.Errorf("failed to merge config file %v: %w", fileName, err)
+	}
+	return nil
+}
+
 type LoadOpts struct {
 	env                   string
 	defaultConfigFileName string
+	configFileName        string
 }
 
 func NewLoadOpts() *LoadOpts {
@@ -45,6 +83,13 @@ func (opts *LoadOpts) WithEnv(val string) *LoadOpts {
 	return opts
 }
 
+// WithConfigFile sets the optional YAML config file that is merged
+// on top of the embedded env specific config.
+func (opts *LoadOpts) WithConfigFile(val string) *LoadOpts {
+	opts.configFileName = val
+	return opts
+}
+
 func New() *viper.Viper {
 	v := viper.New()
 	v.SetEnvPrefix("APP")
@@ -72,6 +117,12 @@ func Load(cfg *viper.Viper, opts *LoadOpts) error {
 		}
 	}
 
+	if opts.configFileName != "" {
+		if err := mergeConfigFile(cfg, opts.configFileName); err != nil {
+			return err
+		}
+	}
+
 	// Some common aliases to have cli params with the same name as config keys
 	cfg.RegisterAlias("defaultLogLevel", "log-level")
 	cfg.RegisterAlias("jsonLogs", "json-logs")
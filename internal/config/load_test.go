@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -44,4 +45,75 @@ func TestLoad(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 2*time.Minute, cfg.GetDuration("reconcile.drift-interval"))
 	})
+	t.Run("should load endpoints debounce from env", func(t *testing.T) {
+		t.Setenv("APP_RECONCILE_ENDPOINTS_DEBOUNCE", "5s")
+
+		cfg := New()
+		err := Load(cfg, NewLoadOpts())
+
+		require.NoError(t, err)
+		require.Equal(t, 5*time.Second, cfg.GetDuration("reconcile.endpoints-debounce"))
+	})
+	t.Run("should load config file", func(t *testing.T) {
+		configFile := writeConfigFile(t, `version: v1
+controller:
+  maxConcurrentReconciles: 5
+  syncPeriod: 30m
+reconcile:
+  drift-interval: 10m
+`)
+
+		cfg := New()
+		err := Load(cfg, NewLoadOpts().WithConfigFile(configFile))
+
+		require.NoError(t, err)
+		require.Equal(t, 5, cfg.GetInt("controller.maxConcurrentReconciles"))
+		require.Equal(t, 30*time.Minute, cfg.GetDuration("controller.syncPeriod"))
+		require.Equal(t, 10*time.Minute, cfg.GetDuration("reconcile.drift-interval"))
+		require.Equal(t, 30, cfg.GetInt("k8sapi.burst"))
+	})
+	t.Run("should prefer env over config file", func(t *testing.T) {
+		t.Setenv("APP_OCIAPI_TIMEOUT", "15s")
+		configFile := writeConfigFile(t, "version: v1\nociapi:\n  timeout: 30s\n")
+
+		cfg := New()
+		err := Load(cfg, NewLoadOpts().WithConfigFile(configFile))
+
+		require.NoError(t, err)
+		require.Equal(t, 15*time.Second, cfg.GetDuration("ociapi.timeout"))
+	})
+	t.Run("should fail if config file is not found", func(t *testing.T) {
+		cfg := New()
+		err := Load(cfg, NewLoadOpts().WithConfigFile(filepath.Join(t.TempDir(), "not-existing.yaml")))
+		require.ErrorContains(t, err, "failed to read config file")
+	})
+	t.Run("should fail if config file version is not supported", func(t *testing.T) {
+		configFile := writeConfigFile(t, "version: v2\n")
+
+		cfg := New()
+		err := Load(cfg, NewLoadOpts().WithConfigFile(configFile))
+		require.ErrorContains(t, err, `unsupported version "v2"`)
+	})
+	t.Run("should fail if config file version is missing", func(t *testing.T) {
+		configFile := writeConfigFile(t, "controller:\n  maxConcurrentReconciles: 5\n")
+
+		cfg := New()
+		err := Load(cfg, NewLoadOpts().WithConfigFile(configFile))
+		require.ErrorContains(t, err, "unsupported version")
+	})
+	t.Run("should fail if config file has unknown keys", func(t *testing.T) {
+		configFile := writeConfigFile(t, "version: v1\ncontroller:\n  maxReconciles: 5\n  workers: 2\n")
+
+		cfg := New()
+		err := Load(cfg, NewLoadOpts().WithConfigFile(configFile))
+		require.ErrorContains(t, err, "unknown key controller.maxreconciles")
+		require.ErrorContains(t, err, "unknown key controller.workers")
+	})
+}
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte(content), 0o600))
+	return configFile
 }
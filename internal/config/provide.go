@@ -56,19 +56,54 @@ func Provide(container *dig.Container, cfg *viper.Viper) error {
 		provideConfigValue(cfg, "httpServer.mode").asString(),
 		provideConfigValue(cfg, "httpServer.accessLogsLevel").asString(),
 
+		// controller manager config
+		provideConfigValue(cfg, "controller.maxConcurrentReconciles").asInt(),
+		provideConfigValue(cfg, "controller.syncPeriod").asDuration(),
+		provideConfigValue(cfg, "controller.metricsBindAddress").asString(),
+		provideConfigValue(cfg, "controller.healthProbeBindAddress").asString(),
+		provideConfigValue(cfg, "controller.ociReadinessInterval").asDuration(),
+
 		// k8sapi config
 		provideConfigValue(cfg, "k8sapi.noop").asBool(),
 		provideConfigValue(cfg, "k8sapi.inCluster").asBool(),
+		provideConfigValue(cfg, "k8sapi.qps").asInt(),
+		provideConfigValue(cfg, "k8sapi.burst").asInt(),
 		// ociapi config
 		provideConfigValue(cfg, "ociapi.noop").asBool(),
+		provideConfigValue(cfg, "ociapi.dryRun").asBool(),
+		provideConfigValue(cfg, "ociapi.timeout").asDuration(),
+		provideConfigValue(cfg, "ociapi.authProvider").asString(),
+		provideConfigValue(cfg, "ociapi.auditLog").asString(),
+		provideConfigValue(cfg, "ociapi.throttleCooldown").asDuration(),
+		provideConfigValue(cfg, "ociapi.workRequestTimeout").asDuration(),
 
 		// reconcile config
 		provideConfigValue(cfg, "reconcile.drift-interval").asDuration(),
+		provideConfigValue(cfg, "reconcile.endpoints-debounce").asDuration(),
+		provideConfigValue(cfg, "reconcile.backend-sync-concurrency").asInt(),
+		provideConfigValue(cfg, "reconcile.drain-timeout").asDuration(),
+		provideConfigValue(cfg, "reconcile.backend-health-interval").asDuration(),
+		provideConfigValue(cfg, "reconcile.retry-base-delay").asDuration(),
+		provideConfigValue(cfg, "reconcile.retry-max-delay").asDuration(),
+		provideConfigValue(cfg, "reconcile.failure-threshold").asInt(),
+		provideConfigValue(cfg, "reconcile.certificate-expiry-warning-days").asInt(),
+		provideConfigValue(cfg, "reconcile.load-balancer-metrics-interval").asDuration(),
+		provideConfigValue(cfg, "reconcile.work-request-history").asInt(),
+
+		// tracing config
+		provideConfigValue(cfg, "tracing.otlpEndpoint").asString(),
+		provideConfigValue(cfg, "tracing.insecure").asBool(),
+		provideConfigValue(cfg, "tracing.samplePercent").asInt(),
+
+		// route admission config
+		provideConfigValue(cfg, "routeAdmission.webhookUrl").asString(),
+		provideConfigValue(cfg, "routeAdmission.timeout").asDuration(),
 
 		// features config
 		provideConfigValue(cfg, "features.reconcileGatewayClass").asBool(),
 		provideConfigValue(cfg, "features.reconcileGateway").asBool(),
 		provideConfigValue(cfg, "features.reconcileNetworkLoadBalancerGateway").asBool(),
+		provideConfigValue(cfg, "features.reconcileGatewayConfig").asBool(),
 		provideConfigValue(cfg, "features.reconcileTCPRoute").asBool(),
 		provideConfigValue(cfg, "features.reconcileUDPRoute").asBool(),
 		provideConfigValue(cfg, "features.reconcileTLSRoute").asBool(),
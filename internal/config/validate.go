@@ -0,0 +1,123 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/viper"
+)
+
+// disabledBindAddress is the controller-runtime bind address value that disables the server.
+const disabledBindAddress = "0"
+
+func validateDuration(cfg *viper.Viper, key string) error {
+	value, err := cast.ToDurationE(cfg.Get(key))
+	if err != nil {
+		return fmt.Errorf("%v: invalid duration %q", key, cfg.GetString(key))
+	}
+	if value < 0 {
+		return fmt.Errorf("%v: must not be negative, got %v", key, value)
+	}
+	return nil
+}
+
+func validatePositiveDuration(cfg *viper.Viper, key string) error {
+	if err := validateDuration(cfg, key); err != nil {
+		return err
+	}
+	if cfg.GetDuration(key) == 0 {
+		return fmt.Errorf("%v: must be positive", key)
+	}
+	return nil
+}
+
+func validateMinInt(cfg *viper.Viper, key string, minValue int) error {
+	value, err := cast.ToIntE(cfg.Get(key))
+	if err != nil {
+		return fmt.Errorf("%v: invalid number %q", key, cfg.GetString(key))
+	}
+	if value < minValue {
+		return fmt.Errorf("%v: must be at least %d, got %d", key, minValue, value)
+	}
+	return nil
+}
+
+func validateIntRange(cfg *viper.Viper, key string, minValue, maxValue int) error {
+	if err := validateMinInt(cfg, key, minValue); err != nil {
+		return err
+	}
+	if value := cfg.GetInt(key); value > maxValue {
+		return fmt.Errorf("%v: must be at most %d, got %d", key, maxValue, value)
+	}
+	return nil
+}
+
+func validateMinDuration(cfg *viper.Viper, key string, minKey string) error {
+	if err := validateDuration(cfg, key); err != nil {
+		return err
+	}
+	if value, minValue := cfg.GetDuration(key), cfg.GetDuration(minKey); value < minValue {
+		return fmt.Errorf("%v: must not be less than %v (%v), got %v", key, minKey, minValue, value)
+	}
+	return nil
+}
+
+func validateBindAddress(cfg *viper.Viper, key string) error {
+	address := cfg.GetString(key)
+	if address == disabledBindAddress {
+		return nil
+	}
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%v: invalid bind address %q: %w", key, address, err)
+	}
+	if portNumber, portErr := strconv.Atoi(port); portErr != nil || portNumber < 0 || portNumber > 65535 {
+		return fmt.Errorf("%v: invalid port in bind address %q", key, address)
+	}
+	return nil
+}
+
+func validateOneOf(cfg *viper.Viper, key string, allowed ...string) error {
+	value := cfg.GetString(key)
+	if !slices.Contains(allowed, value) {
+		return fmt.Errorf("%v: unsupported value %q, expected one of %v", key, value, allowed)
+	}
+	return nil
+}
+
+// Validate checks the loaded config values. All problems are reported at once
+// so the config can be fixed before the controller manager is started.
+func Validate(cfg *viper.Viper) error {
+	return errors.Join(
+		validatePositiveDuration(cfg, "gracefulShutdownTimeout"),
+		validateMinInt(cfg, "controller.maxConcurrentReconciles", 1),
+		validatePositiveDuration(cfg, "controller.syncPeriod"),
+		validateBindAddress(cfg, "controller.metricsBindAddress"),
+		validateBindAddress(cfg, "controller.healthProbeBindAddress"),
+		validateDuration(cfg, "controller.ociReadinessInterval"),
+		validateMinInt(cfg, "k8sapi.qps", 1),
+		validateMinInt(cfg, "k8sapi.burst", 1),
+		validatePositiveDuration(cfg, "ociapi.timeout"),
+		validateOneOf(cfg, "ociapi.authProvider",
+			"userPrincipal", "instancePrincipal", "resourcePrincipal", "workloadIdentity"),
+		validateDuration(cfg, "ociapi.throttleCooldown"),
+		validatePositiveDuration(cfg, "ociapi.workRequestTimeout"),
+		validateDuration(cfg, "reconcile.drift-interval"),
+		validateDuration(cfg, "reconcile.endpoints-debounce"),
+		validateMinInt(cfg, "reconcile.backend-sync-concurrency", 1),
+		validateDuration(cfg, "reconcile.drain-timeout"),
+		validateDuration(cfg, "reconcile.backend-health-interval"),
+		validatePositiveDuration(cfg, "reconcile.retry-base-delay"),
+		validateMinDuration(cfg, "reconcile.retry-max-delay", "reconcile.retry-base-delay"),
+		validateMinInt(cfg, "reconcile.failure-threshold", 0),
+		validateMinInt(cfg, "reconcile.certificate-expiry-warning-days", 0),
+		validateDuration(cfg, "reconcile.load-balancer-metrics-interval"),
+		validateMinInt(cfg, "reconcile.work-request-history", 0),
+		validateIntRange(cfg, "tracing.samplePercent", 0, 100), //nolint:mnd // percent
+		validatePositiveDuration(cfg, "routeAdmission.timeout"),
+	)
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	loadDefaults := func(t *testing.T) *viper.Viper {
+		t.Helper()
+		cfg := New()
+		require.NoError(t, Load(cfg, NewLoadOpts()))
+		return cfg
+	}
+
+	t.Run("should accept default config", func(t *testing.T) {
+		require.NoError(t, Validate(loadDefaults(t)))
+	})
+
+	t.Run("should accept disabled and valid bind addresses", func(t *testing.T) {
+		cfg := loadDefaults(t)
+		cfg.Set("controller.metricsBindAddress", "0")
+		cfg.Set("controller.healthProbeBindAddress", "[::]:8081")
+
+		require.NoError(t, Validate(cfg))
+	})
+
+	t.Run("should report all invalid values", func(t *testing.T) {
+		cfg := loadDefaults(t)
+		cfg.Set("controller.maxConcurrentReconciles", 0)
+		cfg.Set("controller.syncPeriod", "soon")
+		cfg.Set("controller.metricsBindAddress", "localhost")
+		cfg.Set("controller.healthProbeBindAddress", ":99999")
+		cfg.Set("controller.ociReadinessInterval", "often")
+		cfg.Set("k8sapi.qps", -1)
+		cfg.Set("ociapi.timeout", "0s")
+		cfg.Set("ociapi.authProvider", "apiKey")
+		cfg.Set("ociapi.throttleCooldown", "-30s")
+		cfg.Set("ociapi.workRequestTimeout", "0s")
+		cfg.Set("reconcile.drift-interval", "-1m")
+		cfg.Set("reconcile.backend-sync-concurrency", 0)
+		cfg.Set("reconcile.drain-timeout", "later")
+		cfg.Set("reconcile.backend-health-interval", "-5m")
+		cfg.Set("reconcile.retry-base-delay", "10s")
+		cfg.Set("reconcile.retry-max-delay", "5s")
+		cfg.Set("reconcile.failure-threshold", -1)
+		cfg.Set("reconcile.certificate-expiry-warning-days", -2)
+		cfg.Set("reconcile.load-balancer-metrics-interval", "-1m")
+		cfg.Set("reconcile.work-request-history", -3)
+		cfg.Set("tracing.samplePercent", 101)
+		cfg.Set("routeAdmission.timeout", "0s")
+
+		err := Validate(cfg)
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "controller.maxConcurrentReconciles: must be at least 1, got 0")
+		assert.ErrorContains(t, err, `controller.syncPeriod: invalid duration "soon"`)
+		assert.ErrorContains(t, err, `controller.metricsBindAddress: invalid bind address "localhost"`)
+		assert.ErrorContains(t, err, `controller.healthProbeBindAddress: invalid port in bind address ":99999"`)
+		assert.ErrorContains(t, err, `controller.ociReadinessInterval: invalid duration "often"`)
+		assert.ErrorContains(t, err, "k8sapi.qps: must be at least 1, got -1")
+		assert.ErrorContains(t, err, "ociapi.timeout: must be positive")
+		assert.ErrorContains(t, err, `ociapi.authProvider: unsupported value "apiKey"`)
+		assert.ErrorContains(t, err, "ociapi.throttleCooldown: must not be negative")
+		assert.ErrorContains(t, err, "ociapi.workRequestTimeout: must be positive")
+		assert.ErrorContains(t, err, "reconcile.drift-interval: must not be negative")
+		assert.ErrorContains(t, err, "reconcile.backend-sync-concurrency: must be at least 1, got 0")
+		assert.ErrorContains(t, err, `reconcile.drain-timeout: invalid duration "later"`)
+		assert.ErrorContains(t, err, "reconcile.backend-health-interval: must not be negative")
+		assert.ErrorContains(t, err, "reconcile.retry-max-delay: must not be less than reconcile.retry-base-delay")
+		assert.ErrorContains(t, err, "reconcile.failure-threshold: must be at least 0, got -1")
+		assert.ErrorContains(t, err, "reconcile.certificate-expiry-warning-days: must be at least 0, got -2")
+		assert.ErrorContains(t, err, "reconcile.load-balancer-metrics-interval: must not be negative")
+		assert.ErrorContains(t, err, "reconcile.work-request-history: must be at least 0, got -3")
+		assert.ErrorContains(t, err, "tracing.samplePercent: must be at most 100, got 101")
+		assert.ErrorContains(t, err, "routeAdmission.timeout: must be positive")
+	})
+}
@@ -4,28 +4,61 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"reflect"
 	"slices"
+	"strings"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gemyago/oke-gateway-api/internal/app"
 	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
 )
 
 type controllerMiddleware[request comparable] func(
 	next reconcile.TypedReconciler[request],
 ) reconcile.TypedReconciler[request]
 
+const tracerName = "github.com/gemyago/oke-gateway-api/internal/k8s"
+
+// newTracingMiddleware starts the root span of the reconcile trace. The trace ID is used
+// as the correlation ID of the reconcile logs if tracing is enabled.
 func newTracingMiddleware() controllerMiddleware[reconcile.Request] {
 	return func(next reconcile.TypedReconciler[reconcile.Request]) reconcile.TypedReconciler[reconcile.Request] {
 		return reconcile.TypedFunc[reconcile.Request](
 			func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
-				diagCtx := diag.SetLogAttributesToContext(ctx, diag.LogAttributes{
-					CorrelationID: slog.StringValue(uuid.New().String()),
+				spanCtx, span := otel.Tracer(tracerName).Start(ctx, "Reconcile",
+					trace.WithAttributes(
+						attribute.String("k8s.namespace", req.Namespace),
+						attribute.String("k8s.name", req.Name),
+						attribute.String("reconcile.id", string(controller.ReconcileIDFromContext(ctx))),
+					),
+				)
+				if !span.SpanContext().IsValid() {
+					diagCtx := diag.SetLogAttributesToContext(ctx, diag.LogAttributes{
+						CorrelationID: slog.StringValue(uuid.New().String()),
+					})
+					return next.Reconcile(diagCtx, req)
+				}
+				defer span.End()
+
+				diagCtx := diag.SetLogAttributesToContext(spanCtx, diag.LogAttributes{
+					CorrelationID: slog.StringValue(span.SpanContext().TraceID().String()),
 				})
-				return next.Reconcile(diagCtx, req)
+				res, err := next.Reconcile(diagCtx, req)
+				if err != nil {
+					span.RecordError(err)
+					span.SetStatus(codes.Error, err.Error())
+				}
+				return res, err
 			},
 		)
 	}
@@ -35,9 +68,6 @@ func newErrorHandlingMiddleware(
 	logger *slog.Logger,
 ) controllerMiddleware[reconcile.Request] {
 	return func(next reconcile.TypedReconciler[reconcile.Request]) reconcile.TypedReconciler[reconcile.Request] {
-		// TODO: Handle and do not requeue some errors
-		// like 4xx errors from OCI
-
 		return reconcile.TypedFunc[reconcile.Request](
 			func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 				res, err := next.Reconcile(ctx, req)
@@ -52,6 +82,16 @@ func newErrorHandlingMiddleware(
 						return reconcile.Result{}, nil // Return nil error to stop reconciliation
 					}
 
+					if errorClass := ociapi.ClassifyError(err); errorClass.IsTerminal() {
+						// Retrying can not succeed until the resource or OCI side is changed
+						logger.ErrorContext(ctx, "Terminal OCI error, skipping requeue",
+							slog.Any("request", req),
+							slog.String("errorClass", string(errorClass)),
+							diag.ErrAttr(err),
+						)
+						return reconcile.Result{}, nil
+					}
+
 					if apierrors.IsConflict(err) {
 						logger.WarnContext(ctx, "Resource version conflict, requeueing",
 							slog.Any("request", req),
@@ -70,12 +110,65 @@ func newErrorHandlingMiddleware(
 	}
 }
 
+// newAuditMiddleware attributes OCI operations of the reconcile to the reconciled resource.
+func newAuditMiddleware(controllerName string) controllerMiddleware[reconcile.Request] {
+	return func(next reconcile.TypedReconciler[reconcile.Request]) reconcile.TypedReconciler[reconcile.Request] {
+		return reconcile.TypedFunc[reconcile.Request](
+			func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+				return next.Reconcile(ociapi.WithAuditInitiator(ctx, ociapi.AuditInitiator{
+					Controller:  controllerName,
+					Namespace:   req.Namespace,
+					Name:        req.Name,
+					ReconcileID: string(controller.ReconcileIDFromContext(ctx)),
+				}), req)
+			},
+		)
+	}
+}
+
+// newWorkRequestHistoryMiddleware collects OCI work requests awaited by the reconcile and
+// records them in the annotation of the reconciled resource, even if the reconcile failed.
+// Failures to record the history are logged and do not fail the reconcile.
+func newWorkRequestHistoryMiddleware(
+	logger *slog.Logger,
+	recorder *app.WorkRequestHistoryRecorder,
+	newObject func() client.Object,
+) controllerMiddleware[reconcile.Request] {
+	return func(next reconcile.TypedReconciler[reconcile.Request]) reconcile.TypedReconciler[reconcile.Request] {
+		if !recorder.Enabled() {
+			return next
+		}
+		return reconcile.TypedFunc[reconcile.Request](
+			func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+				historyCtx, history := ociapi.WithWorkRequestHistory(ctx)
+				res, err := next.Reconcile(historyCtx, req)
+				if records := history.Records(); len(records) > 0 {
+					if recordErr := recorder.Record(ctx, req.NamespacedName, newObject(), records); recordErr != nil {
+						logger.WarnContext(ctx, "Failed to record work request history",
+							slog.Any("request", req),
+							diag.ErrAttr(recordErr),
+						)
+					}
+				}
+				return res, err
+			},
+		)
+	}
+}
+
+// reconcilerName returns the name of the reconciler type without the Controller suffix,
+// e.g. HTTPRoute for *app.HTTPRouteController.
+func reconcilerName(ctrl reconcile.TypedReconciler[reconcile.Request]) string {
+	return strings.TrimSuffix(reflect.Indirect(reflect.ValueOf(ctrl)).Type().Name(), "Controller")
+}
+
 func wireupReconciler(
 	ctrl reconcile.TypedReconciler[reconcile.Request],
 	middlewares ...controllerMiddleware[reconcile.Request],
 ) reconcile.TypedReconciler[reconcile.Request] {
+	auditMiddleware := newAuditMiddleware(reconcilerName(ctrl))
 	for _, v := range slices.Backward(middlewares) {
 		ctrl = v(ctrl)
 	}
-	return ctrl
+	return auditMiddleware(ctrl)
 }
@@ -3,18 +3,33 @@ package k8s
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/jaswdr/faker/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/gemyago/oke-gateway-api/internal/app"
 	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
 )
 
 func TestErrorHandlingMiddleware(t *testing.T) {
@@ -90,6 +105,56 @@ func TestErrorHandlingMiddleware(t *testing.T) {
 		assert.Equal(t, reconcile.Result{}, actualResult)
 	})
 
+	t.Run("when next errors with terminal OCI error", func(t *testing.T) {
+		fake := faker.New()
+		logger := diag.RootTestLogger()
+		dummyReq := reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      fake.Lorem().Word(),
+				Namespace: fake.Lorem().Word(),
+			},
+		}
+		ociErr := fmt.Errorf("failed to create listener: %w", ociapi.NewRandomServiceError(
+			ociapi.RandomServiceErrorWithStatusCode(http.StatusBadRequest),
+			ociapi.RandomServiceErrorWithCode("LimitExceeded"),
+		))
+		next := reconcile.TypedFunc[reconcile.Request](
+			func(_ context.Context, req reconcile.Request) (reconcile.Result, error) {
+				assert.Equal(t, dummyReq, req)
+				return reconcile.Result{}, ociErr
+			})
+
+		middleware := newErrorHandlingMiddleware(logger)
+		ctrl := middleware(next)
+
+		actualResult, actualErr := ctrl.Reconcile(t.Context(), dummyReq)
+
+		require.NoError(t, actualErr)
+		assert.Equal(t, reconcile.Result{}, actualResult)
+	})
+
+	t.Run("when next errors with transient OCI error", func(t *testing.T) {
+		fake := faker.New()
+		logger := diag.RootTestLogger()
+		dummyReq := reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: fake.Lorem().Word()},
+		}
+		ociErr := ociapi.NewRandomServiceError(
+			ociapi.RandomServiceErrorWithStatusCode(http.StatusTooManyRequests),
+		)
+		next := reconcile.TypedFunc[reconcile.Request](
+			func(context.Context, reconcile.Request) (reconcile.Result, error) {
+				return reconcile.Result{}, ociErr
+			})
+
+		middleware := newErrorHandlingMiddleware(logger)
+		ctrl := middleware(next)
+
+		_, actualErr := ctrl.Reconcile(t.Context(), dummyReq)
+
+		require.ErrorIs(t, actualErr, ociErr)
+	})
+
 	t.Run("when next errors with conflict error", func(t *testing.T) {
 		fake := faker.New()
 		logger := diag.RootTestLogger()
@@ -142,6 +207,44 @@ func TestTracingMiddleware(t *testing.T) {
 		require.ErrorIs(t, actualErr, wantErr)
 		assert.Equal(t, wantResult, actualResult)
 	})
+
+	t.Run("should start reconcile span if tracing is enabled", func(t *testing.T) {
+		t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+		recorder := tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+		fake := faker.New()
+		wantErr := errors.New("dummy error")
+		dummyReq := reconcile.Request{NamespacedName: types.NamespacedName{
+			Namespace: fake.Lorem().Word(),
+			Name:      fake.Lorem().Word(),
+		}}
+		var traceID string
+		next := reconcile.TypedFunc[reconcile.Request](
+			func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				spanContext := trace.SpanContextFromContext(ctx)
+				require.True(t, spanContext.IsValid())
+				traceID = spanContext.TraceID().String()
+				assert.Equal(t, traceID, diag.GetLogAttributesFromContext(ctx).CorrelationID.String())
+				return reconcile.Result{}, wantErr
+			})
+
+		_, actualErr := newTracingMiddleware()(next).Reconcile(t.Context(), dummyReq)
+
+		require.ErrorIs(t, actualErr, wantErr)
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "Reconcile", spans[0].Name())
+		assert.Equal(t, traceID, spans[0].SpanContext().TraceID().String())
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		assert.Contains(t, spans[0].Attributes(), attribute.String("k8s.name", dummyReq.Name))
+		assert.Contains(t, spans[0].Attributes(), attribute.String("k8s.namespace", dummyReq.Namespace))
+	})
+}
+
+func TestReconcilerName(t *testing.T) {
+	assert.Equal(t, "HTTPRoute", reconcilerName(&app.HTTPRouteController{}))
+	assert.Equal(t, "Gateway", reconcilerName(&app.GatewayController{}))
 }
 
 func TestWireupReconciler(t *testing.T) {
@@ -216,3 +319,77 @@ func TestWireupReconciler(t *testing.T) {
 		assert.ErrorIs(t, actualErr, wantErr)
 	})
 }
+
+func TestWorkRequestHistoryMiddleware(t *testing.T) {
+	newRecorder := func(t *testing.T, size int) (*app.WorkRequestHistoryRecorder, client.Client) {
+		scheme := runtime.NewScheme()
+		require.NoError(t, gatewayv1.Install(scheme))
+		k8sClient := clientfake.NewClientBuilder().WithScheme(scheme).WithObjects(&gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "apps", Name: "edge"},
+		}).Build()
+		return app.NewWorkRequestHistoryRecorder(app.WorkRequestHistoryRecorderDeps{
+			RootLogger: diag.RootTestLogger(),
+			K8sClient:  k8sClient,
+			Size:       size,
+		}), k8sClient
+	}
+	newGateway := func() client.Object { return &gatewayv1.Gateway{} }
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "apps", Name: "edge"}}
+
+	t.Run("records work requests of the failed reconcile", func(t *testing.T) {
+		recorder, k8sClient := newRecorder(t, 5)
+		wantErr := errors.New("reconcile error")
+		next := reconcile.TypedFunc[reconcile.Request](
+			func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				history, ok := ociapi.WorkRequestHistoryFromContext(ctx)
+				require.True(t, ok)
+				history.Add(ociapi.WorkRequestRecord{ID: "wr-1", Operation: "UpdateListener", Status: "FAILED"})
+				return reconcile.Result{}, wantErr
+			},
+		)
+
+		ctrl := newWorkRequestHistoryMiddleware(diag.RootTestLogger(), recorder, newGateway)(next)
+		_, actualErr := ctrl.Reconcile(t.Context(), req)
+
+		require.ErrorIs(t, actualErr, wantErr)
+		var gateway gatewayv1.Gateway
+		require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, &gateway))
+		assert.JSONEq(t,
+			`[{"id":"wr-1","operation":"UpdateListener","status":"FAILED","time":"0001-01-01T00:00:00Z"}]`,
+			gateway.Annotations[app.WorkRequestHistoryAnnotation],
+		)
+	})
+
+	t.Run("does not record if the reconcile awaited no work requests", func(t *testing.T) {
+		recorder, k8sClient := newRecorder(t, 5)
+		next := reconcile.TypedFunc[reconcile.Request](
+			func(context.Context, reconcile.Request) (reconcile.Result, error) {
+				return reconcile.Result{}, nil
+			},
+		)
+
+		ctrl := newWorkRequestHistoryMiddleware(diag.RootTestLogger(), recorder, newGateway)(next)
+		_, actualErr := ctrl.Reconcile(t.Context(), req)
+
+		require.NoError(t, actualErr)
+		var gateway gatewayv1.Gateway
+		require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, &gateway))
+		assert.NotContains(t, gateway.Annotations, app.WorkRequestHistoryAnnotation)
+	})
+
+	t.Run("skips the history if disabled", func(t *testing.T) {
+		recorder, _ := newRecorder(t, 0)
+		next := reconcile.TypedFunc[reconcile.Request](
+			func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				_, ok := ociapi.WorkRequestHistoryFromContext(ctx)
+				assert.False(t, ok)
+				return reconcile.Result{}, nil
+			},
+		)
+
+		ctrl := newWorkRequestHistoryMiddleware(diag.RootTestLogger(), recorder, newGateway)(next)
+		_, actualErr := ctrl.Reconcile(t.Context(), req)
+
+		require.NoError(t, actualErr)
+	})
+}
@@ -4,6 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"go.uber.org/dig"
@@ -12,8 +17,10 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -24,6 +31,7 @@ import (
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/gemyago/oke-gateway-api/internal/app"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
 	configtypes "github.com/gemyago/oke-gateway-api/internal/types"
 )
 
@@ -31,24 +39,36 @@ import (
 type StartManagerDeps struct {
 	dig.In
 
-	RootLogger       *slog.Logger
-	Manager          manager.Manager
-	GatewayClassCtrl *app.GatewayClassController
-	GatewayCtrl      *app.GatewayController
-	NLBGatewayCtrl   *app.NetworkLoadBalancerGatewayController
-	HTTPRouteCtrl    *app.HTTPRouteController
-	GRPCRouteCtrl    *app.GRPCRouteController
-	TCPRouteCtrl     *app.TCPRouteController
-	UDPRouteCtrl     *app.UDPRouteController
-	TLSRouteCtrl     *app.TLSRouteController
-	BackendTLSCtrl   *app.BackendTLSPolicyController
-	WatchesModel     *app.WatchesModel
-	Config           *rest.Config
+	RootLogger        *slog.Logger
+	Manager           manager.Manager
+	GatewayClassCtrl  *app.GatewayClassController
+	GatewayCtrl       *app.GatewayController
+	NLBGatewayCtrl    *app.NetworkLoadBalancerGatewayController
+	GatewayConfigCtrl *app.GatewayConfigController
+	HTTPRouteCtrl     *app.HTTPRouteController
+	GRPCRouteCtrl     *app.GRPCRouteController
+	TCPRouteCtrl      *app.TCPRouteController
+	UDPRouteCtrl      *app.UDPRouteController
+	TLSRouteCtrl      *app.TLSRouteController
+	BackendTLSCtrl    *app.BackendTLSPolicyController
+	WatchesModel      *app.WatchesModel
+	OCIReadiness      *app.OCIReadinessProbe
+	WorkRequests      *app.WorkRequestHistoryRecorder
+	WorkRequestWaits  *ociapi.WorkRequestWaits `optional:"true"`
+	Config            *rest.Config
+
+	// requeue backoff of failed reconciliations
+	RetryBaseDelay time.Duration `name:"config.reconcile.retry-base-delay"`
+	RetryMaxDelay  time.Duration `name:"config.reconcile.retry-max-delay"`
+
+	// window to coalesce EndpointSlice changes into a single reconcile, zero disables it
+	EndpointsDebounce time.Duration `name:"config.reconcile.endpoints-debounce"`
 
 	// feature flags
 	ReconcileGatewayClass               bool `name:"config.features.reconcileGatewayClass"`
 	ReconcileGateway                    bool `name:"config.features.reconcileGateway"`
 	ReconcileNetworkLoadBalancerGateway bool `name:"config.features.reconcileNetworkLoadBalancerGateway"`
+	ReconcileGatewayConfig              bool `name:"config.features.reconcileGatewayConfig"`
 	ReconcileTCPRoute                   bool `name:"config.features.reconcileTCPRoute"`
 	ReconcileUDPRoute                   bool `name:"config.features.reconcileUDPRoute"`
 	ReconcileTLSRoute                   bool `name:"config.features.reconcileTLSRoute"`
@@ -81,6 +101,8 @@ type setupL4RouteControllerParams struct {
 	mapConfigMap        handler.MapFunc
 	mapService          handler.MapFunc
 	reconciler          reconcile.TypedReconciler[reconcile.Request]
+	workRequestWaits    *ociapi.WorkRequestWaits
+	options             controller.Options
 }
 
 type setupL7RouteControllerParams struct {
@@ -92,7 +114,24 @@ type setupL7RouteControllerParams struct {
 	mapBackendTLSPolicyToRoute handler.MapFunc
 	mapConfigMapToRoute        handler.MapFunc
 	mapServiceToRoute          handler.MapFunc
+	mapExternalBackendToRoute  handler.MapFunc
+	mapNodeToRoute             handler.MapFunc
+	mapPodToRoute              handler.MapFunc
 	reconciler                 reconcile.TypedReconciler[reconcile.Request]
+	workRequestWaits           *ociapi.WorkRequestWaits
+	options                    controller.Options
+	watchExternalBackend       bool
+	endpointsDebounce          time.Duration
+}
+
+// controllerCRDCapabilities reports which CRDs of the controller are installed. Helm does not
+// upgrade CRDs of installed charts, so CRDs added in later versions may be missing after an
+// upgrade. Watches of missing CRDs are skipped and the resources are treated as absent.
+type controllerCRDCapabilities struct {
+	GatewayClassConfig bool
+	OkeListenerPolicy  bool
+	OkeAccessPolicy    bool
+	OkeExternalBackend bool
 }
 
 type controllerSetupTask struct {
@@ -102,6 +141,18 @@ type controllerSetupTask struct {
 	setup       func() error
 }
 
+// newControllerOptions returns options of a single controller. Failed reconciliations are
+// requeued with exponential backoff between the configured delays instead of the
+// controller-runtime defaults. Each controller gets its own rate limiter.
+func newControllerOptions(deps StartManagerDeps) controller.Options {
+	return controller.Options{
+		RateLimiter: workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](
+			deps.RetryBaseDelay,
+			deps.RetryMaxDelay,
+		),
+	}
+}
+
 func l4RouteObjectPredicate() predicate.Funcs {
 	generationChanged := predicate.GenerationChangedPredicate{}
 	labelChanged := predicate.LabelChangedPredicate{}
@@ -159,6 +210,38 @@ func resourceKindAvailable(mapper meta.RESTMapper, groupKind schema.GroupKind, v
 	return true, nil
 }
 
+func detectControllerCRDCapabilities(
+	ctx context.Context,
+	logger *slog.Logger,
+	mapper meta.RESTMapper,
+) (controllerCRDCapabilities, error) {
+	var capabilities controllerCRDCapabilities
+	crds := []struct {
+		kind      string
+		available *bool
+	}{
+		{kind: "GatewayClassConfig", available: &capabilities.GatewayClassConfig},
+		{kind: "OkeListenerPolicy", available: &capabilities.OkeListenerPolicy},
+		{kind: "OkeAccessPolicy", available: &capabilities.OkeAccessPolicy},
+		{kind: "OkeExternalBackend", available: &capabilities.OkeExternalBackend},
+	}
+	for _, crd := range crds {
+		installed, err := resourceKindAvailable(
+			mapper,
+			schema.GroupKind{Group: configtypes.GroupName, Kind: crd.kind},
+			configtypes.Version,
+		)
+		if err != nil {
+			return controllerCRDCapabilities{}, fmt.Errorf("failed to detect %s availability: %w", crd.kind, err)
+		}
+		if !installed {
+			logger.WarnContext(ctx, crd.kind+" CRD is not installed; apply the controller CRDs to enable it")
+		}
+		*crd.available = installed
+	}
+	return capabilities, nil
+}
+
 func runControllerSetupTasks(ctx context.Context, logger *slog.Logger, tasks []controllerSetupTask) error {
 	for _, task := range tasks {
 		if !task.enabled {
@@ -208,10 +291,12 @@ func setupL4RouteController(
 ) error {
 	controllerBuilder := builder.ControllerManagedBy(mgr).
 		Named(params.name).
+		WithOptions(params.options).
 		For(
 			params.route,
 			builder.WithPredicates(l4RouteObjectPredicate()),
 		).
+		Watches(params.route, workRequestWaitsCancellation(params.workRequestWaits, params.reconciler)).
 		Watches(
 			&discoveryv1.EndpointSlice{},
 			handler.EnqueueRequestsFromMapFunc(params.mapEndpoint),
@@ -258,6 +343,7 @@ func setupL4RouteController(
 func coreControllerSetupTasks(
 	mgr manager.Manager,
 	deps StartManagerDeps,
+	crds controllerCRDCapabilities,
 	middlewares []controllerMiddleware[reconcile.Request],
 ) []controllerSetupTask {
 	return []controllerSetupTask{
@@ -268,6 +354,7 @@ func coreControllerSetupTasks(
 			setup: func() error {
 				return builder.ControllerManagedBy(mgr).
 					Named("gatewayclass").
+					WithOptions(newControllerOptions(deps)).
 					For(&gatewayv1.GatewayClass{}).
 					WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{})).
 					Complete(wireupReconciler(deps.GatewayClassCtrl, middlewares...))
@@ -278,14 +365,24 @@ func coreControllerSetupTasks(
 			disabledLog: "Gateway controller is disabled",
 			setupErr:    "failed to setup Gateway controller: %w",
 			setup: func() error {
-				return builder.ControllerManagedBy(mgr).
+				controllerBuilder := builder.ControllerManagedBy(mgr).
 					Named("gateway").
+					WithOptions(newControllerOptions(deps)).
 					For(
 						&gatewayv1.Gateway{},
 
 						// Applying predicates just on the gateway level. Secrets do not have generation incremented
 						// so secret updates will not trigger a reconciliation.
-						builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{})),
+						builder.WithPredicates(predicate.Or(
+							predicate.GenerationChangedPredicate{},
+							predicate.LabelChangedPredicate{},
+							pausedAnnotationChangedPredicate(),
+							annotationValueChangedPredicate(app.ProtectedListenersAnnotation),
+						)),
+					).
+					Watches(
+						&gatewayv1.Gateway{},
+						workRequestWaitsCancellation(deps.WorkRequestWaits, deps.GatewayCtrl),
 					).
 					Watches(
 						&corev1.Secret{},
@@ -297,7 +394,56 @@ func coreControllerSetupTasks(
 						handler.EnqueueRequestsFromMapFunc(deps.WatchesModel.MapGatewayConfigToGateway),
 						builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 					).
-					Complete(wireupReconciler(deps.GatewayCtrl, middlewares...))
+					Watches(
+						&discoveryv1.EndpointSlice{},
+						debouncedEnqueueRequestsFromMapFunc(
+							deps.EndpointsDebounce,
+							deps.WatchesModel.MapEndpointSliceToGateway,
+						),
+					).
+					Watches(
+						&corev1.Pod{},
+						handler.EnqueueRequestsFromMapFunc(deps.WatchesModel.MapPodToGateway),
+						builder.WithPredicates(podBackendAnnotationsChangedPredicate()),
+					).
+					Watches(
+						&corev1.Service{},
+						handler.EnqueueRequestsFromMapFunc(deps.WatchesModel.MapServiceToGateway),
+						builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+					).
+					Watches(
+						&corev1.ConfigMap{},
+						handler.EnqueueRequestsFromMapFunc(deps.WatchesModel.MapConfigMapToGateway),
+						builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+					).
+					Watches(
+						&gatewayv1beta1.ReferenceGrant{},
+						handler.EnqueueRequestsFromMapFunc(deps.WatchesModel.MapReferenceGrantToGateway),
+					)
+				if crds.GatewayClassConfig {
+					controllerBuilder = controllerBuilder.Watches(
+						&configtypes.GatewayClassConfig{},
+						handler.EnqueueRequestsFromMapFunc(deps.WatchesModel.MapGatewayClassConfigToGateway),
+						builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+					)
+				}
+				if crds.OkeListenerPolicy {
+					controllerBuilder = controllerBuilder.Watches(
+						&configtypes.OkeListenerPolicy{},
+						handler.EnqueueRequestsFromMapFunc(deps.WatchesModel.MapListenerPolicyToGateway),
+						builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+					)
+				}
+				if crds.OkeAccessPolicy {
+					controllerBuilder = controllerBuilder.Watches(
+						&configtypes.OkeAccessPolicy{},
+						handler.EnqueueRequestsFromMapFunc(deps.WatchesModel.MapAccessPolicyToGateway),
+						builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+					)
+				}
+				return controllerBuilder.Complete(wireupReconciler(deps.GatewayCtrl,
+					withWorkRequestHistory(deps, middlewares, func() client.Object { return &gatewayv1.Gateway{} })...,
+				))
 			},
 		},
 		{
@@ -307,10 +453,15 @@ func coreControllerSetupTasks(
 			setup: func() error {
 				return builder.ControllerManagedBy(mgr).
 					Named("networkloadbalancer-gateway").
+					WithOptions(newControllerOptions(deps)).
 					For(
 						&gatewayv1.Gateway{},
 						builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{})),
 					).
+					Watches(
+						&gatewayv1.Gateway{},
+						workRequestWaitsCancellation(deps.WorkRequestWaits, deps.NLBGatewayCtrl),
+					).
 					Watches(
 						&configtypes.GatewayConfig{},
 						handler.EnqueueRequestsFromMapFunc(deps.WatchesModel.MapGatewayConfigToGateway),
@@ -319,12 +470,33 @@ func coreControllerSetupTasks(
 					Complete(wireupReconciler(deps.NLBGatewayCtrl, middlewares...))
 			},
 		},
+		{
+			enabled:     deps.ReconcileGatewayConfig,
+			disabledLog: "GatewayConfig controller is disabled",
+			setupErr:    "failed to setup GatewayConfig controller: %w",
+			setup: func() error {
+				return builder.ControllerManagedBy(mgr).
+					Named("gatewayconfig").
+					WithOptions(newControllerOptions(deps)).
+					For(
+						&configtypes.GatewayConfig{},
+						builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+					).
+					Watches(
+						&gatewayv1.Gateway{},
+						handler.EnqueueRequestsFromMapFunc(deps.WatchesModel.MapGatewayToGatewayConfig),
+						builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+					).
+					Complete(wireupReconciler(deps.GatewayConfigCtrl, middlewares...))
+			},
+		},
 	}
 }
 
 func l7AndTLSControllerSetupTasks(
 	mgr manager.Manager,
 	deps StartManagerDeps,
+	crds controllerCRDCapabilities,
 	experimentalRoutes resolvedExperimentalRouteCapabilities,
 	middlewares []controllerMiddleware[reconcile.Request],
 ) []controllerSetupTask {
@@ -335,7 +507,9 @@ func l7AndTLSControllerSetupTasks(
 			setupErr:    "failed to setup HTTPRoute controller: %w",
 			setup: func() error {
 				deps.HTTPRouteCtrl.SetBackendTLSPolicyEnabled(experimentalRoutes.reconcileBackendTLSPolicy)
-				return setupHTTPRouteController(mgr, deps, experimentalRoutes.reconcileBackendTLSPolicy, middlewares)
+				return setupHTTPRouteController(
+					mgr, deps, crds, experimentalRoutes.reconcileBackendTLSPolicy, middlewares,
+				)
 			},
 		},
 		{
@@ -344,7 +518,9 @@ func l7AndTLSControllerSetupTasks(
 			setupErr:    "failed to setup GRPCRoute controller: %w",
 			setup: func() error {
 				deps.GRPCRouteCtrl.SetBackendTLSPolicyEnabled(experimentalRoutes.reconcileBackendTLSPolicy)
-				return setupGRPCRouteController(mgr, deps, experimentalRoutes.reconcileBackendTLSPolicy, middlewares)
+				return setupGRPCRouteController(
+					mgr, deps, crds, experimentalRoutes.reconcileBackendTLSPolicy, middlewares,
+				)
 			},
 		},
 		{
@@ -364,6 +540,8 @@ func l7AndTLSControllerSetupTasks(
 					mapConfigMap:        deps.WatchesModel.MapConfigMapToTLSRoute,
 					mapService:          deps.WatchesModel.MapServiceToTLSRoute,
 					reconciler:          deps.TLSRouteCtrl,
+					workRequestWaits:    deps.WorkRequestWaits,
+					options:             newControllerOptions(deps),
 				}, experimentalRoutes.reconcileBackendTLSPolicy, middlewares...)
 			},
 		},
@@ -374,6 +552,7 @@ func l7AndTLSControllerSetupTasks(
 			setup: func() error {
 				return builder.ControllerManagedBy(mgr).
 					Named("backendtlspolicy").
+					WithOptions(newControllerOptions(deps)).
 					For(&gatewayv1.BackendTLSPolicy{}).
 					Complete(wireupReconciler(deps.BackendTLSCtrl, middlewares...))
 			},
@@ -381,9 +560,23 @@ func l7AndTLSControllerSetupTasks(
 	}
 }
 
+// withWorkRequestHistory adds the innermost middleware recording OCI work requests
+// of the reconcile in the annotation of the reconciled resource.
+func withWorkRequestHistory(
+	deps StartManagerDeps,
+	middlewares []controllerMiddleware[reconcile.Request],
+	newObject func() client.Object,
+) []controllerMiddleware[reconcile.Request] {
+	return append(
+		slices.Clone(middlewares),
+		newWorkRequestHistoryMiddleware(deps.RootLogger, deps.WorkRequests, newObject),
+	)
+}
+
 func setupHTTPRouteController(
 	mgr manager.Manager,
 	deps StartManagerDeps,
+	crds controllerCRDCapabilities,
 	enableBackendTLSPolicy bool,
 	middlewares []controllerMiddleware[reconcile.Request],
 ) error {
@@ -396,13 +589,23 @@ func setupHTTPRouteController(
 		mapBackendTLSPolicyToRoute: deps.WatchesModel.MapBackendTLSPolicyToHTTPRoute,
 		mapConfigMapToRoute:        deps.WatchesModel.MapConfigMapToHTTPRoute,
 		mapServiceToRoute:          deps.WatchesModel.MapServiceToHTTPRoute,
+		mapExternalBackendToRoute:  deps.WatchesModel.MapExternalBackendToHTTPRoute,
+		mapNodeToRoute:             deps.WatchesModel.MapNodeToHTTPRoute,
+		mapPodToRoute:              deps.WatchesModel.MapPodToHTTPRoute,
 		reconciler:                 deps.HTTPRouteCtrl,
-	}, enableBackendTLSPolicy, middlewares)
+		workRequestWaits:           deps.WorkRequestWaits,
+		options:                    newControllerOptions(deps),
+		watchExternalBackend:       crds.OkeExternalBackend,
+		endpointsDebounce:          deps.EndpointsDebounce,
+	}, enableBackendTLSPolicy, withWorkRequestHistory(deps, middlewares,
+		func() client.Object { return &gatewayv1.HTTPRoute{} },
+	))
 }
 
 func setupGRPCRouteController(
 	mgr manager.Manager,
 	deps StartManagerDeps,
+	crds controllerCRDCapabilities,
 	enableBackendTLSPolicy bool,
 	middlewares []controllerMiddleware[reconcile.Request],
 ) error {
@@ -415,8 +618,17 @@ func setupGRPCRouteController(
 		mapBackendTLSPolicyToRoute: deps.WatchesModel.MapBackendTLSPolicyToGRPCRoute,
 		mapConfigMapToRoute:        deps.WatchesModel.MapConfigMapToGRPCRoute,
 		mapServiceToRoute:          deps.WatchesModel.MapServiceToGRPCRoute,
+		mapExternalBackendToRoute:  deps.WatchesModel.MapExternalBackendToGRPCRoute,
+		mapNodeToRoute:             deps.WatchesModel.MapNodeToGRPCRoute,
+		mapPodToRoute:              deps.WatchesModel.MapPodToGRPCRoute,
 		reconciler:                 deps.GRPCRouteCtrl,
-	}, enableBackendTLSPolicy, middlewares)
+		workRequestWaits:           deps.WorkRequestWaits,
+		options:                    newControllerOptions(deps),
+		watchExternalBackend:       crds.OkeExternalBackend,
+		endpointsDebounce:          deps.EndpointsDebounce,
+	}, enableBackendTLSPolicy, withWorkRequestHistory(deps, middlewares,
+		func() client.Object { return &gatewayv1.GRPCRoute{} },
+	))
 }
 
 func setupL7RouteController(
@@ -425,35 +637,56 @@ func setupL7RouteController(
 	enableBackendTLSPolicy bool,
 	middlewares []controllerMiddleware[reconcile.Request],
 ) error {
+	// Node events are not filtered by the route object predicate since node status
+	// changes do not change the generation, labels or annotations.
 	controllerBuilder := builder.ControllerManagedBy(mgr).
 		Named(params.name).
-		For(params.route).
+		WithOptions(params.options).
+		For(params.route, builder.WithPredicates(l7RouteObjectPredicate())).
+		Watches(params.route, workRequestWaitsCancellation(params.workRequestWaits, params.reconciler)).
 		Watches(
 			&discoveryv1.EndpointSlice{},
-			handler.EnqueueRequestsFromMapFunc(params.mapEndpoint),
+			debouncedEnqueueRequestsFromMapFunc(params.endpointsDebounce, params.mapEndpoint),
+			builder.WithPredicates(l7RouteObjectPredicate()),
 		).
 		Watches(
 			params.pairedRoute,
 			handler.EnqueueRequestsFromMapFunc(params.mapPairedRoute),
 			builder.WithPredicates(l7RouteObjectPredicate()),
 		).
-		WithEventFilter(l7RouteObjectPredicate())
+		Watches(
+			&corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(params.mapNodeToRoute),
+			builder.WithPredicates(nodeBackendPredicate()),
+		).
+		Watches(
+			&corev1.Pod{},
+			handler.EnqueueRequestsFromMapFunc(params.mapPodToRoute),
+			builder.WithPredicates(podBackendAnnotationsChangedPredicate()),
+		)
+	if params.watchExternalBackend {
+		controllerBuilder = controllerBuilder.Watches(
+			&configtypes.OkeExternalBackend{},
+			handler.EnqueueRequestsFromMapFunc(params.mapExternalBackendToRoute),
+			builder.WithPredicates(l7RouteObjectPredicate(), predicate.GenerationChangedPredicate{}),
+		)
+	}
 	if enableBackendTLSPolicy {
 		controllerBuilder = controllerBuilder.
 			Watches(
 				&gatewayv1.BackendTLSPolicy{},
 				handler.EnqueueRequestsFromMapFunc(params.mapBackendTLSPolicyToRoute),
-				builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+				builder.WithPredicates(l7RouteObjectPredicate(), predicate.ResourceVersionChangedPredicate{}),
 			).
 			Watches(
 				&corev1.ConfigMap{},
 				handler.EnqueueRequestsFromMapFunc(params.mapConfigMapToRoute),
-				builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+				builder.WithPredicates(l7RouteObjectPredicate(), predicate.ResourceVersionChangedPredicate{}),
 			).
 			Watches(
 				&corev1.Service{},
 				handler.EnqueueRequestsFromMapFunc(params.mapServiceToRoute),
-				builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+				builder.WithPredicates(l7RouteObjectPredicate(), predicate.ResourceVersionChangedPredicate{}),
 			)
 	}
 	return controllerBuilder.Complete(wireupReconciler(params.reconciler, middlewares...))
@@ -472,12 +705,14 @@ func l4RouteControllerSetupTasks(
 			setupErr:    "failed to setup TCPRoute controller: %w",
 			setup: func() error {
 				return setupL4RouteController(mgr, setupL4RouteControllerParams{
-					name:        "tcproute",
-					route:       &gatewayv1.TCPRoute{},
-					mapEndpoint: deps.WatchesModel.MapEndpointSliceToTCPRoute,
-					mapGrant:    deps.WatchesModel.MapReferenceGrantToTCPRoute,
-					mapGateway:  deps.WatchesModel.MapGatewayToTCPRoute,
-					reconciler:  deps.TCPRouteCtrl,
+					name:             "tcproute",
+					route:            &gatewayv1.TCPRoute{},
+					mapEndpoint:      deps.WatchesModel.MapEndpointSliceToTCPRoute,
+					mapGrant:         deps.WatchesModel.MapReferenceGrantToTCPRoute,
+					mapGateway:       deps.WatchesModel.MapGatewayToTCPRoute,
+					reconciler:       deps.TCPRouteCtrl,
+					workRequestWaits: deps.WorkRequestWaits,
+					options:          newControllerOptions(deps),
 				}, false, middlewares...)
 			},
 		},
@@ -487,12 +722,14 @@ func l4RouteControllerSetupTasks(
 			setupErr:    "failed to setup UDPRoute controller: %w",
 			setup: func() error {
 				return setupL4RouteController(mgr, setupL4RouteControllerParams{
-					name:        "udproute",
-					route:       &gatewayv1.UDPRoute{},
-					mapEndpoint: deps.WatchesModel.MapEndpointSliceToUDPRoute,
-					mapGrant:    deps.WatchesModel.MapReferenceGrantToUDPRoute,
-					mapGateway:  deps.WatchesModel.MapGatewayToUDPRoute,
-					reconciler:  deps.UDPRouteCtrl,
+					name:             "udproute",
+					route:            &gatewayv1.UDPRoute{},
+					mapEndpoint:      deps.WatchesModel.MapEndpointSliceToUDPRoute,
+					mapGrant:         deps.WatchesModel.MapReferenceGrantToUDPRoute,
+					mapGateway:       deps.WatchesModel.MapGatewayToUDPRoute,
+					reconciler:       deps.UDPRouteCtrl,
+					workRequestWaits: deps.WorkRequestWaits,
+					options:          newControllerOptions(deps),
 				}, false, middlewares...)
 			},
 		},
@@ -502,12 +739,231 @@ func l4RouteControllerSetupTasks(
 func l7RouteObjectPredicate() predicate.Funcs {
 	generationChanged := predicate.GenerationChangedPredicate{}
 	labelChanged := predicate.LabelChangedPredicate{}
-	annotationChanged := predicate.AnnotationChangedPredicate{}
 	return predicate.Funcs{
 		UpdateFunc: func(updateEvent event.UpdateEvent) bool {
 			return generationChanged.Update(updateEvent) ||
 				labelChanged.Update(updateEvent) ||
-				annotationChanged.Update(updateEvent)
+				annotationsChangedIgnoringWorkRequests(updateEvent)
+		},
+	}
+}
+
+// annotationsChangedIgnoringWorkRequests reports annotation changes other than the work
+// request history recorded by the controller after the reconcile.
+func annotationsChangedIgnoringWorkRequests(updateEvent event.UpdateEvent) bool {
+	if updateEvent.ObjectOld == nil || updateEvent.ObjectNew == nil {
+		return false
+	}
+	oldAnnotations := maps.Clone(updateEvent.ObjectOld.GetAnnotations())
+	newAnnotations := maps.Clone(updateEvent.ObjectNew.GetAnnotations())
+	delete(oldAnnotations, app.WorkRequestHistoryAnnotation)
+	delete(newAnnotations, app.WorkRequestHistoryAnnotation)
+	return !maps.Equal(oldAnnotations, newAnnotations)
+}
+
+// pausedAnnotationChangedPredicate passes updates toggling the paused annotation. Other
+// annotation changes are ignored since the controller updates gateway annotations itself.
+func pausedAnnotationChangedPredicate() predicate.Funcs {
+	return annotationValueChangedPredicate(app.PausedAnnotation)
+}
+
+// annotationValueChangedPredicate passes updates changing the value of the annotation.
+func annotationValueChangedPredicate(annotation string) predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(updateEvent event.UpdateEvent) bool {
+			return updateEvent.ObjectOld.GetAnnotations()[annotation] !=
+				updateEvent.ObjectNew.GetAnnotations()[annotation]
+		},
+	}
+}
+
+// podBackendAnnotationsChangedPredicate passes Pod updates changing the backend annotations.
+// Pods are added to and removed from the backends with their EndpointSlices, so other Pod
+// events are ignored.
+func podBackendAnnotationsChangedPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return false },
+		UpdateFunc: func(updateEvent event.UpdateEvent) bool {
+			oldAnnotations := updateEvent.ObjectOld.GetAnnotations()
+			newAnnotations := updateEvent.ObjectNew.GetAnnotations()
+			return slices.ContainsFunc([]string{
+				app.BackendWeightAnnotation,
+				app.BackendMaxConnectionsAnnotation,
+			}, func(annotation string) bool {
+				return oldAnnotations[annotation] != newAnnotations[annotation]
+			})
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+// nodeBackendPredicate passes Node events that may change NodePort backends of the routes:
+// nodes added or removed, and changes of the node readiness, addresses or scheduling.
+func nodeBackendPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(updateEvent event.UpdateEvent) bool {
+			oldNode, okOld := updateEvent.ObjectOld.(*corev1.Node)
+			newNode, okNew := updateEvent.ObjectNew.(*corev1.Node)
+			if !okOld || !okNew {
+				return false
+			}
+			_, oldExcluded := oldNode.Labels[corev1.LabelNodeExcludeBalancers]
+			_, newExcluded := newNode.Labels[corev1.LabelNodeExcludeBalancers]
+			return nodeReady(oldNode) != nodeReady(newNode) ||
+				oldNode.Spec.Unschedulable != newNode.Spec.Unschedulable ||
+				oldExcluded != newExcluded ||
+				!reflect.DeepEqual(oldNode.Status.Addresses, newNode.Status.Addresses)
+		},
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+func nodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// endpointsDebounceMaxWindows limits how many debounce windows a request may be delayed by
+// while the changes keep coming, so backends still follow long rollouts.
+const endpointsDebounceMaxWindows = 10
+
+// requestDebouncer enqueues requests once no new event of the request arrived within
+// the window. Every event re-arms the timer of the request, up to the max delay.
+type requestDebouncer struct {
+	window   time.Duration
+	maxDelay time.Duration
+
+	mu      sync.Mutex
+	pending map[reconcile.Request]*debouncedRequest
+}
+
+type debouncedRequest struct {
+	timer    *time.Timer
+	deadline time.Time
+}
+
+func newRequestDebouncer(window time.Duration) *requestDebouncer {
+	return &requestDebouncer{
+		window:   window,
+		maxDelay: window * endpointsDebounceMaxWindows,
+		pending:  make(map[reconcile.Request]*debouncedRequest),
+	}
+}
+
+func (d *requestDebouncer) add(
+	queue workqueue.TypedRateLimitingInterface[reconcile.Request],
+	request reconcile.Request,
+) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// The timer is re-armed only if it did not fire yet, otherwise a new one is started
+	if pending, ok := d.pending[request]; ok && pending.timer.Stop() {
+		pending.timer.Reset(min(d.window, time.Until(pending.deadline)))
+		return
+	}
+
+	pending := &debouncedRequest{deadline: time.Now().Add(d.maxDelay)}
+	pending.timer = time.AfterFunc(d.window, func() {
+		d.mu.Lock()
+		if d.pending[request] == pending {
+			delete(d.pending, request)
+		}
+		d.mu.Unlock()
+		queue.Add(request)
+	})
+	d.pending[request] = pending
+}
+
+// debouncedEnqueueRequestsFromMapFunc enqueues requests of the map function once no new event
+// of the request arrived within the debounce window. EndpointSlices churn a lot during rollouts,
+// so changes within the window are synced with a single reconcile instead of holding a worker
+// while waiting. Zero window enqueues requests immediately.
+func debouncedEnqueueRequestsFromMapFunc(window time.Duration, mapFn handler.MapFunc) handler.EventHandler {
+	if window <= 0 {
+		return handler.EnqueueRequestsFromMapFunc(mapFn)
+	}
+	debouncer := newRequestDebouncer(window)
+	enqueue := func(
+		ctx context.Context,
+		queue workqueue.TypedRateLimitingInterface[reconcile.Request],
+		objects ...client.Object,
+	) {
+		for _, obj := range objects {
+			for _, request := range mapFn(ctx, obj) {
+				debouncer.add(queue, request)
+			}
+		}
+	}
+	return handler.Funcs{
+		CreateFunc: func(
+			ctx context.Context,
+			createEvent event.CreateEvent,
+			queue workqueue.TypedRateLimitingInterface[reconcile.Request],
+		) {
+			enqueue(ctx, queue, createEvent.Object)
+		},
+		UpdateFunc: func(
+			ctx context.Context,
+			updateEvent event.UpdateEvent,
+			queue workqueue.TypedRateLimitingInterface[reconcile.Request],
+		) {
+			enqueue(ctx, queue, updateEvent.ObjectOld, updateEvent.ObjectNew)
+		},
+		DeleteFunc: func(
+			ctx context.Context,
+			deleteEvent event.DeleteEvent,
+			queue workqueue.TypedRateLimitingInterface[reconcile.Request],
+		) {
+			enqueue(ctx, queue, deleteEvent.Object)
+		},
+		GenericFunc: func(
+			ctx context.Context,
+			genericEvent event.GenericEvent,
+			queue workqueue.TypedRateLimitingInterface[reconcile.Request],
+		) {
+			enqueue(ctx, queue, genericEvent.Object)
+		},
+	}
+}
+
+// workRequestWaitsCancellation cancels OCI work request waits of the reconciler for the
+// resource once its deletion starts. The reconcile then stops waiting for the load balancer,
+// e.g. stuck in the UPDATING state, and the deletion is handled by the next reconcile.
+func workRequestWaitsCancellation(
+	waits *ociapi.WorkRequestWaits,
+	reconciler reconcile.TypedReconciler[reconcile.Request],
+) handler.EventHandler {
+	controllerName := reconcilerName(reconciler)
+	cancel := func(obj client.Object) {
+		waits.CancelOwner(ociapi.WorkRequestWaitOwner{
+			Controller: controllerName,
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		})
+	}
+	return handler.Funcs{
+		UpdateFunc: func(
+			_ context.Context,
+			updateEvent event.UpdateEvent,
+			_ workqueue.TypedRateLimitingInterface[reconcile.Request],
+		) {
+			if updateEvent.ObjectOld.GetDeletionTimestamp() == nil &&
+				updateEvent.ObjectNew.GetDeletionTimestamp() != nil {
+				cancel(updateEvent.ObjectNew)
+			}
+		},
+		DeleteFunc: func(
+			_ context.Context,
+			deleteEvent event.DeleteEvent,
+			_ workqueue.TypedRateLimitingInterface[reconcile.Request],
+		) {
+			cancel(deleteEvent.Object)
 		},
 	}
 }
@@ -547,13 +1003,24 @@ func StartManager(ctx context.Context, deps StartManagerDeps) error {
 		newTracingMiddleware(),
 		newErrorHandlingMiddleware(deps.RootLogger),
 	}
-	tasks := coreControllerSetupTasks(mgr, deps, middlewares)
-	tasks = append(tasks, l7AndTLSControllerSetupTasks(mgr, deps, experimentalRoutes, middlewares)...)
+	crds, detectErr := detectControllerCRDCapabilities(loggerCtx, logger, mgr.GetRESTMapper())
+	if detectErr != nil {
+		return detectErr
+	}
+
+	tasks := coreControllerSetupTasks(mgr, deps, crds, middlewares)
+	tasks = append(tasks, l7AndTLSControllerSetupTasks(mgr, deps, crds, experimentalRoutes, middlewares)...)
 	tasks = append(tasks, l4RouteControllerSetupTasks(mgr, deps, experimentalRoutes, middlewares)...)
 	if err := runControllerSetupTasks(loggerCtx, logger, tasks); err != nil {
 		return err
 	}
 
+	// OCI connectivity is a readiness concern only, restarting the controller does not
+	// help if the OCI API is unusable
+	if err := mgr.AddReadyzCheck("oci", deps.OCIReadiness.Check); err != nil {
+		return fmt.Errorf("failed to add OCI readiness check: %w", err)
+	}
+
 	logger.InfoContext(loggerCtx, "Starting controller manager")
 	return mgr.Start(loggerCtx)
 }
@@ -1,20 +1,33 @@
 package k8s
 
 import (
+	"context"
 	"errors"
+	"slices"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/gemyago/oke-gateway-api/internal/app"
 	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+	configtypes "github.com/gemyago/oke-gateway-api/internal/types"
 )
 
 func TestL7RouteObjectPredicate(t *testing.T) {
@@ -39,6 +52,89 @@ func TestL7RouteObjectPredicate(t *testing.T) {
 
 		assert.True(t, result)
 	})
+
+	t.Run("ignores work request history updates", func(t *testing.T) {
+		oldRoute := &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "api", Generation: 1},
+		}
+		newRoute := oldRoute.DeepCopy()
+		newRoute.Annotations = map[string]string{app.WorkRequestHistoryAnnotation: "[]"}
+
+		result := l7RouteObjectPredicate().Update(event.UpdateEvent{
+			ObjectOld: oldRoute,
+			ObjectNew: newRoute,
+		})
+
+		assert.False(t, result)
+	})
+}
+
+func TestNodeBackendPredicate(t *testing.T) {
+	newNode := func() *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1", ResourceVersion: "1"},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				Addresses:  []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "10.0.0.1"}},
+			},
+		}
+	}
+
+	t.Run("accepts node scale events", func(t *testing.T) {
+		assert.True(t, nodeBackendPredicate().Create(event.CreateEvent{Object: newNode()}))
+		assert.True(t, nodeBackendPredicate().Delete(event.DeleteEvent{Object: newNode()}))
+	})
+
+	t.Run("accepts backend related updates", func(t *testing.T) {
+		oldNode := newNode()
+		notReadyNode := oldNode.DeepCopy()
+		notReadyNode.Status.Conditions[0].Status = corev1.ConditionFalse
+		cordonedNode := oldNode.DeepCopy()
+		cordonedNode.Spec.Unschedulable = true
+		excludedNode := oldNode.DeepCopy()
+		excludedNode.Labels = map[string]string{corev1.LabelNodeExcludeBalancers: ""}
+		readdressedNode := oldNode.DeepCopy()
+		readdressedNode.Status.Addresses[0].Address = "10.0.0.2"
+
+		for _, newNode := range []*corev1.Node{notReadyNode, cordonedNode, excludedNode, readdressedNode} {
+			assert.True(t, nodeBackendPredicate().Update(event.UpdateEvent{ObjectOld: oldNode, ObjectNew: newNode}))
+		}
+	})
+
+	t.Run("ignores other updates", func(t *testing.T) {
+		oldNode := newNode()
+		newNode := oldNode.DeepCopy()
+		newNode.ResourceVersion = "2"
+		newNode.Annotations = map[string]string{"example.com/heartbeat": "now"}
+
+		assert.False(t, nodeBackendPredicate().Update(event.UpdateEvent{ObjectOld: oldNode, ObjectNew: newNode}))
+	})
+}
+
+func TestPodBackendAnnotationsChangedPredicate(t *testing.T) {
+	newPod := func(annotations map[string]string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "pod-1", Annotations: annotations},
+		}
+	}
+
+	t.Run("accepts backend annotation changes", func(t *testing.T) {
+		for _, annotation := range []string{app.BackendWeightAnnotation, app.BackendMaxConnectionsAnnotation} {
+			assert.True(t, podBackendAnnotationsChangedPredicate().Update(event.UpdateEvent{
+				ObjectOld: newPod(nil),
+				ObjectNew: newPod(map[string]string{annotation: "300"}),
+			}))
+		}
+	})
+
+	t.Run("ignores other pod events", func(t *testing.T) {
+		assert.False(t, podBackendAnnotationsChangedPredicate().Create(event.CreateEvent{Object: newPod(nil)}))
+		assert.False(t, podBackendAnnotationsChangedPredicate().Delete(event.DeleteEvent{Object: newPod(nil)}))
+		assert.False(t, podBackendAnnotationsChangedPredicate().Update(event.UpdateEvent{
+			ObjectOld: newPod(map[string]string{app.BackendWeightAnnotation: "10"}),
+			ObjectNew: newPod(map[string]string{app.BackendWeightAnnotation: "10", "team": "edge"}),
+		}))
+	})
 }
 
 func TestStartManager(t *testing.T) {
@@ -64,6 +160,57 @@ func TestStartManager(t *testing.T) {
 	})
 }
 
+func TestPausedAnnotationChangedPredicate(t *testing.T) {
+	newGateway := func(annotations map[string]string) *gatewayv1.Gateway {
+		return &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "demo",
+				Name:        "gateway",
+				Annotations: annotations,
+			},
+		}
+	}
+
+	t.Run("accepts paused annotation changes", func(t *testing.T) {
+		result := pausedAnnotationChangedPredicate().Update(event.UpdateEvent{
+			ObjectOld: newGateway(nil),
+			ObjectNew: newGateway(map[string]string{app.PausedAnnotation: "true"}),
+		})
+
+		assert.True(t, result)
+	})
+
+	t.Run("ignores other annotation changes", func(t *testing.T) {
+		result := pausedAnnotationChangedPredicate().Update(event.UpdateEvent{
+			ObjectOld: newGateway(map[string]string{app.PausedAnnotation: "true"}),
+			ObjectNew: newGateway(map[string]string{
+				app.PausedAnnotation:    "true",
+				"example.com/reconcile": "after",
+			}),
+		})
+
+		assert.False(t, result)
+	})
+}
+
+func TestAnnotationValueChangedPredicate(t *testing.T) {
+	newGateway := func(annotations map[string]string) *gatewayv1.Gateway {
+		return &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "gateway", Annotations: annotations},
+		}
+	}
+	changed := annotationValueChangedPredicate(app.ProtectedListenersAnnotation)
+
+	assert.True(t, changed.Update(event.UpdateEvent{
+		ObjectOld: newGateway(map[string]string{app.ProtectedListenersAnnotation: "http"}),
+		ObjectNew: newGateway(map[string]string{app.ProtectedListenersAnnotation: "http,https"}),
+	}))
+	assert.False(t, changed.Update(event.UpdateEvent{
+		ObjectOld: newGateway(map[string]string{app.ProtectedListenersAnnotation: "http"}),
+		ObjectNew: newGateway(map[string]string{app.ProtectedListenersAnnotation: "http", "team": "edge"}),
+	}))
+}
+
 func TestL4RouteObjectPredicate(t *testing.T) {
 	fake := faker.New()
 	newPod := func() *corev1.Pod {
@@ -163,6 +310,37 @@ func TestDetectExperimentalRouteCapabilities(t *testing.T) {
 	})
 }
 
+func TestDetectControllerCRDCapabilities(t *testing.T) {
+	t.Run("detects installed CRDs", func(t *testing.T) {
+		mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{
+			{Group: configtypes.GroupName, Version: configtypes.Version},
+		})
+		for _, kind := range []string{"GatewayClassConfig", "OkeListenerPolicy"} {
+			mapper.Add(schema.GroupVersionKind{
+				Group:   configtypes.GroupName,
+				Version: configtypes.Version,
+				Kind:    kind,
+			}, meta.RESTScopeNamespace)
+		}
+
+		got, err := detectControllerCRDCapabilities(t.Context(), diag.RootTestLogger(), mapper)
+
+		require.NoError(t, err)
+		assert.Equal(t, controllerCRDCapabilities{
+			GatewayClassConfig: true,
+			OkeListenerPolicy:  true,
+		}, got)
+	})
+
+	t.Run("returns non discovery errors", func(t *testing.T) {
+		wantErr := errors.New("discovery failed")
+
+		_, err := detectControllerCRDCapabilities(t.Context(), diag.RootTestLogger(), failingRESTMapper{err: wantErr})
+
+		require.ErrorIs(t, err, wantErr)
+	})
+}
+
 func TestResolveExperimentalRouteCapabilities(t *testing.T) {
 	t.Run("disables L4 routes when only standard CRDs are installed", func(t *testing.T) {
 		mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{
@@ -231,3 +409,181 @@ type failingRESTMapper struct {
 func (m failingRESTMapper) RESTMapping(_ schema.GroupKind, _ ...string) (*meta.RESTMapping, error) {
 	return nil, m.err
 }
+
+type funcNLBWorkRequestsClient func() networkloadbalancer.OperationStatusEnum
+
+func (f funcNLBWorkRequestsClient) GetWorkRequest(
+	_ context.Context,
+	_ networkloadbalancer.GetWorkRequestRequest,
+) (networkloadbalancer.GetWorkRequestResponse, error) {
+	return networkloadbalancer.GetWorkRequestResponse{
+		WorkRequest: networkloadbalancer.WorkRequest{Status: f()},
+	}, nil
+}
+
+func TestWorkRequestWaitsCancellation(t *testing.T) {
+	newGateway := func() *gatewayv1.Gateway {
+		return &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: faker.New().Lorem().Word(),
+				Name:      faker.New().UUID().V4(),
+			},
+		}
+	}
+
+	// waitFor waits for a work request of the Gateway reconcile and sends the event to
+	// the handler while the work request is in progress.
+	waitFor := func(t *testing.T, gateway *gatewayv1.Gateway, sendEvent func(handler.EventHandler)) error {
+		t.Helper()
+		waits, err := ociapi.NewWorkRequestWaits()
+		require.NoError(t, err)
+		eventHandler := workRequestWaitsCancellation(waits, &app.GatewayController{})
+		polls := 0
+		watcher := ociapi.NewNetworkLoadBalancerWorkRequestsWatcher(ociapi.NetworkLoadBalancerWorkRequestsWatcherDeps{
+			Client: funcNLBWorkRequestsClient(func() networkloadbalancer.OperationStatusEnum {
+				polls++
+				if polls == 1 {
+					sendEvent(eventHandler)
+					return networkloadbalancer.OperationStatusInProgress
+				}
+				return networkloadbalancer.OperationStatusSucceeded
+			}),
+			RootLogger: diag.RootTestLogger(),
+			Waits:      waits,
+			Timeout:    time.Minute,
+		})
+		ctx := ociapi.WithAuditInitiator(t.Context(), ociapi.AuditInitiator{
+			Controller: "Gateway",
+			Namespace:  gateway.Namespace,
+			Name:       gateway.Name,
+		})
+		return watcher.WaitFor(ctx, faker.New().UUID().V4())
+	}
+
+	t.Run("cancels waits once deletion starts", func(t *testing.T) {
+		gateway := newGateway()
+		deleting := gateway.DeepCopy()
+		deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+		err := waitFor(t, gateway, func(eventHandler handler.EventHandler) {
+			eventHandler.Update(t.Context(), event.UpdateEvent{ObjectOld: gateway, ObjectNew: deleting}, nil)
+		})
+
+		require.ErrorIs(t, err, ociapi.ErrWorkRequestWaitCancelled)
+	})
+
+	t.Run("cancels waits of deleted resource", func(t *testing.T) {
+		gateway := newGateway()
+
+		err := waitFor(t, gateway, func(eventHandler handler.EventHandler) {
+			eventHandler.Delete(t.Context(), event.DeleteEvent{Object: gateway}, nil)
+		})
+
+		require.ErrorIs(t, err, ociapi.ErrWorkRequestWaitCancelled)
+	})
+
+	t.Run("keeps waits of resources being deleted and of other resources", func(t *testing.T) {
+		gateway := newGateway()
+		deleting := gateway.DeepCopy()
+		deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		other := newGateway()
+
+		err := waitFor(t, gateway, func(eventHandler handler.EventHandler) {
+			eventHandler.Update(t.Context(), event.UpdateEvent{ObjectOld: deleting, ObjectNew: deleting}, nil)
+			eventHandler.Delete(t.Context(), event.DeleteEvent{Object: other}, nil)
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+type recordingQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+
+	mu    sync.Mutex
+	added []reconcile.Request
+}
+
+func (q *recordingQueue) Add(request reconcile.Request) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.added = append(q.added, request)
+}
+
+func (q *recordingQueue) addedRequests() []reconcile.Request {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return slices.Clone(q.added)
+}
+
+func TestDebouncedEnqueueRequestsFromMapFunc(t *testing.T) {
+	newSlice := func() *discoveryv1.EndpointSlice {
+		return &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: faker.New().Lorem().Word(),
+				Name:      faker.New().UUID().V4(),
+			},
+		}
+	}
+	mapToRequest := func(_ context.Context, obj client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(obj)}}
+	}
+
+	t.Run("enqueues requests once the window passes", func(t *testing.T) {
+		window := 20 * time.Millisecond
+		eventHandler := debouncedEnqueueRequestsFromMapFunc(window, mapToRequest)
+		oldSlice := newSlice()
+		updatedSlice := newSlice()
+		queue := &recordingQueue{}
+
+		eventHandler.Update(t.Context(), event.UpdateEvent{ObjectOld: oldSlice, ObjectNew: updatedSlice}, queue)
+		eventHandler.Delete(t.Context(), event.DeleteEvent{Object: updatedSlice}, queue)
+
+		assert.Empty(t, queue.addedRequests())
+		require.Eventually(t, func() bool { return len(queue.addedRequests()) == 2 }, time.Second, window/4)
+		assert.ElementsMatch(t, []reconcile.Request{
+			{NamespacedName: client.ObjectKeyFromObject(oldSlice)},
+			{NamespacedName: client.ObjectKeyFromObject(updatedSlice)},
+		}, queue.addedRequests())
+	})
+
+	t.Run("re-arms the window on every event", func(t *testing.T) {
+		window := 50 * time.Millisecond
+		eventHandler := debouncedEnqueueRequestsFromMapFunc(window, mapToRequest)
+		slice := newSlice()
+		queue := &recordingQueue{}
+
+		for range 10 {
+			eventHandler.Generic(t.Context(), event.GenericEvent{Object: slice}, queue)
+			time.Sleep(window / 5)
+		}
+
+		assert.Empty(t, queue.addedRequests())
+		require.Eventually(t, func() bool { return len(queue.addedRequests()) == 1 }, time.Second, window/4)
+	})
+
+	t.Run("enqueues requests after the max delay while events keep coming", func(t *testing.T) {
+		debouncer := newRequestDebouncer(20 * time.Millisecond)
+		debouncer.maxDelay = 60 * time.Millisecond
+		request := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(newSlice())}
+		queue := &recordingQueue{}
+
+		deadline := time.Now().Add(200 * time.Millisecond)
+		for time.Now().Before(deadline) && len(queue.addedRequests()) == 0 {
+			debouncer.add(queue, request)
+			time.Sleep(5 * time.Millisecond)
+		}
+
+		assert.NotEmpty(t, queue.addedRequests())
+	})
+
+	t.Run("enqueues requests immediately when disabled", func(t *testing.T) {
+		eventHandler := debouncedEnqueueRequestsFromMapFunc(0, mapToRequest)
+		slice := newSlice()
+		queue := &recordingQueue{}
+
+		eventHandler.Create(t.Context(), event.CreateEvent{Object: slice}, queue)
+
+		assert.Equal(t, []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(slice)}}, queue.addedRequests())
+	})
+}
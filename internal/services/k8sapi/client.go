@@ -7,21 +7,30 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"time"
 
 	"go.uber.org/dig"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/client-go/util/homedir"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlconfig "sigs.k8s.io/controller-runtime/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/gemyago/oke-gateway-api/internal/types"
 )
 
+// eventReportingController is the name of the controller reported in events.
+const eventReportingController = "oke-gateway-api"
+
 type controllerManager struct {
 	manager.Manager
 }
@@ -38,6 +47,10 @@ type ConfigDeps struct {
 	// This can be set via APP_K8SAPI_NOOP env variable
 	Noop      bool `name:"config.k8sapi.noop"`
 	InCluster bool `name:"config.k8sapi.inCluster"`
+
+	// Client side rate limits of the Kubernetes API requests
+	QPS   int `name:"config.k8sapi.qps"`
+	Burst int `name:"config.k8sapi.burst"`
 }
 
 func newConfig(deps ConfigDeps) (*rest.Config, error) {
@@ -46,7 +59,17 @@ func newConfig(deps ConfigDeps) (*rest.Config, error) {
 		return &rest.Config{}, nil
 	}
 
-	if deps.InCluster {
+	cfg, err := newRestConfig(deps.InCluster)
+	if err != nil {
+		return nil, err
+	}
+	cfg.QPS = float32(deps.QPS)
+	cfg.Burst = deps.Burst
+	return cfg, nil
+}
+
+func newRestConfig(inCluster bool) (*rest.Config, error) {
+	if inCluster {
 		cfg, err := rest.InClusterConfig()
 		if err != nil {
 			return nil, fmt.Errorf("failed to get in-cluster config: %w", err)
@@ -65,7 +88,20 @@ func newConfig(deps ConfigDeps) (*rest.Config, error) {
 	return clientcmd.BuildConfigFromFlags("", kubeconfig)
 }
 
-func newManager(config *rest.Config) (*controllerManager, error) {
+type ManagerDeps struct {
+	dig.In
+
+	Config *rest.Config
+
+	MaxConcurrentReconciles int           `name:"config.controller.maxConcurrentReconciles"`
+	SyncPeriod              time.Duration `name:"config.controller.syncPeriod"`
+
+	// Use "0" to disable the metrics or health probe server
+	MetricsBindAddress     string `name:"config.controller.metricsBindAddress"`
+	HealthProbeBindAddress string `name:"config.controller.healthProbeBindAddress"`
+}
+
+func newManager(deps ManagerDeps) (*controllerManager, error) {
 	scheme := runtime.NewScheme()
 
 	if err := clientgoscheme.AddToScheme(scheme); err != nil {
@@ -84,15 +120,43 @@ func newManager(config *rest.Config) (*controllerManager, error) {
 		return nil, fmt.Errorf("failed to add gateway api v1beta1 scheme: %w", err)
 	}
 
-	mgr, err := manager.New(config, manager.Options{
+	mgr, err := manager.New(deps.Config, manager.Options{
 		Scheme: scheme,
+		Cache: cache.Options{
+			SyncPeriod: &deps.SyncPeriod,
+		},
+		Controller: ctrlconfig.Controller{
+			MaxConcurrentReconciles: deps.MaxConcurrentReconciles,
+		},
+		Metrics: metricsserver.Options{
+			BindAddress: deps.MetricsBindAddress,
+		},
+		HealthProbeBindAddress: deps.HealthProbeBindAddress,
 	})
 	if err != nil {
 		return nil, err
 	}
+
+	if err = mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+		return nil, fmt.Errorf("failed to add health check: %w", err)
+	}
+	if err = mgr.AddReadyzCheck("ping", healthz.Ping); err != nil {
+		return nil, fmt.Errorf("failed to add ready check: %w", err)
+	}
 	return &controllerManager{Manager: mgr}, nil
 }
 
 func newClient(manager *controllerManager) *controllerClient {
 	return &controllerClient{Client: manager.GetClient()}
 }
+
+// newAPIReader returns a reader that queries the API server directly. Unlike the
+// manager client it does not require the manager cache to be started.
+func newAPIReader(manager *controllerManager) client.Reader {
+	return manager.GetAPIReader()
+}
+
+// newEventRecorder returns a recorder of events reported on the reconciled resources.
+func newEventRecorder(manager *controllerManager) events.EventRecorder {
+	return manager.GetEventRecorder(eventReportingController)
+}
@@ -15,5 +15,7 @@ func Register(container *dig.Container) error {
 		di.ProvideAs[*controllerManager, manager.Manager],
 		newClient,
 		di.ProvideAs[*controllerClient, client.Client],
+		newAPIReader,
+		newEventRecorder,
 	)
 }
@@ -0,0 +1,204 @@
+package ociapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+
+	"go.uber.org/dig"
+
+	"github.com/gemyago/oke-gateway-api/internal/services"
+)
+
+const (
+	auditOutputStdout = "stdout"
+	auditOutputStderr = "stderr"
+	auditFileMode     = 0o600
+)
+
+// AuditInitiator is the Kubernetes resource whose reconcile initiated OCI operations.
+type AuditInitiator struct {
+	Controller  string
+	Namespace   string
+	Name        string
+	ReconcileID string
+}
+
+type auditInitiatorContextKey struct{}
+
+// WithAuditInitiator returns the context that attributes OCI operations to the initiator.
+func WithAuditInitiator(ctx context.Context, initiator AuditInitiator) context.Context {
+	return context.WithValue(ctx, auditInitiatorContextKey{}, initiator)
+}
+
+// AuditLog records every mutating OCI operation as a JSON line of a dedicated stream,
+// for change-management evidence. A nil AuditLog is valid and records nothing.
+type AuditLog struct {
+	logger *slog.Logger
+}
+
+type AuditLogDeps struct {
+	dig.In
+
+	RootLogger    *slog.Logger
+	ShutdownHooks *services.ShutdownHooks
+
+	// stdout, stderr or path of the file to append records to. Disabled if empty.
+	Output string `name:"config.ociapi.auditLog"`
+}
+
+func NewAuditLog(deps AuditLogDeps) (*AuditLog, error) {
+	var output io.Writer
+	switch deps.Output {
+	case "":
+		return nil, nil //nolint:nilnil // audit log is disabled
+	case auditOutputStdout:
+		output = os.Stdout
+	case auditOutputStderr:
+		output = os.Stderr
+	default:
+		file, err := os.OpenFile(deps.Output, os.O_APPEND|os.O_CREATE|os.O_WRONLY, auditFileMode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open audit log %s: %w", deps.Output, err)
+		}
+		deps.ShutdownHooks.RegisterNoCtx("oci-audit-log", file.Close)
+		output = file
+	}
+
+	deps.RootLogger.Info("Recording mutating OCI operations to the audit log",
+		slog.String("output", deps.Output),
+	)
+	return newAuditLogWithOutput(output), nil
+}
+
+func newAuditLogWithOutput(output io.Writer) *AuditLog {
+	return &AuditLog{logger: slog.New(slog.NewJSONHandler(output, nil))}
+}
+
+// isMutatingOperation reports whether the OCI operation changes resources.
+func isMutatingOperation(operation string) bool {
+	return !strings.HasPrefix(operation, "Get") && !strings.HasPrefix(operation, "List")
+}
+
+// record writes the audit record of the OCI operation if it is mutating.
+func (a *AuditLog) record(ctx context.Context, request any, response any, err error) {
+	if a == nil {
+		return
+	}
+	operation := ociOperationName(request)
+	if !isMutatingOperation(operation) {
+		return
+	}
+
+	summary := auditRequestSummary(request)
+	attrs := []slog.Attr{
+		slog.String("operation", operation),
+		slog.String("region", RegionFromContext(ctx)),
+		slog.String("resourceName", auditResourceName(summary)),
+		{Key: "request", Value: auditSummaryAttrs(summary)},
+	}
+	if httpResponse, ok := response.(interface{ HTTPResponse() *http.Response }); ok {
+		if rawResponse := httpResponse.HTTPResponse(); rawResponse != nil {
+			attrs = append(attrs,
+				slog.String("opcRequestId", rawResponse.Header.Get("opc-request-id")),
+				slog.String("workRequestId", rawResponse.Header.Get("opc-work-request-id")),
+			)
+		}
+	}
+	if initiator, ok := ctx.Value(auditInitiatorContextKey{}).(AuditInitiator); ok {
+		attrs = append(attrs, slog.Group("initiator",
+			slog.String("controller", initiator.Controller),
+			slog.String("namespace", initiator.Namespace),
+			slog.String("name", initiator.Name),
+			slog.String("reconcileId", initiator.ReconcileID),
+		))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("outcome", "failed"), slog.String("error", err.Error()))
+	} else {
+		attrs = append(attrs, slog.String("outcome", "succeeded"))
+	}
+
+	// Audit records are not subject to the log level of the controller
+	a.logger.LogAttrs(ctx, slog.LevelInfo, "OCI operation", attrs...)
+}
+
+type auditField struct {
+	name  string
+	value string
+}
+
+// auditRequestSummary collects identifiers and names of the request path and body.
+// Other request values are skipped, so secrets like certificate private keys
+// are never recorded.
+func auditRequestSummary(request any) []auditField {
+	var summary []auditField
+	requestValue := reflect.Indirect(reflect.ValueOf(request))
+	if requestValue.Kind() != reflect.Struct {
+		return summary
+	}
+	for i := range requestValue.NumField() {
+		field, value := requestValue.Type().Field(i), requestValue.Field(i)
+		if strings.HasSuffix(field.Name, "Details") {
+			detailsValue := reflect.Indirect(value)
+			if detailsValue.Kind() != reflect.Struct {
+				continue
+			}
+			for j := range detailsValue.NumField() {
+				summary = appendAuditField(summary,
+					detailsValue.Type().Field(j), detailsValue.Field(j), "json")
+			}
+			continue
+		}
+		summary = appendAuditField(summary, field, value, "name")
+	}
+	return summary
+}
+
+func appendAuditField(
+	summary []auditField,
+	field reflect.StructField,
+	value reflect.Value,
+	nameTag string,
+) []auditField {
+	if field.Name == "OpcRequestId" || field.Name == "OpcRetryToken" ||
+		(!strings.HasSuffix(field.Name, "Id") && !strings.HasSuffix(field.Name, "Name")) {
+		return summary
+	}
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.String {
+		return summary
+	}
+	name, _, _ := strings.Cut(field.Tag.Get(nameTag), ",")
+	if name == "" {
+		name = field.Name
+	}
+	return append(summary, auditField{name: name, value: value.Elem().String()})
+}
+
+// auditResourceName returns the most specific name of the summary, or the last id if
+// the request has no names.
+func auditResourceName(summary []auditField) string {
+	var resourceName string
+	for _, field := range summary {
+		if strings.HasSuffix(strings.ToLower(field.name), "name") {
+			resourceName = field.value
+		}
+	}
+	if resourceName == "" && len(summary) > 0 {
+		resourceName = summary[len(summary)-1].value
+	}
+	return resourceName
+}
+
+func auditSummaryAttrs(summary []auditField) slog.Value {
+	attrs := make([]slog.Attr, len(summary))
+	for i, field := range summary {
+		attrs[i] = slog.String(field.name, field.value)
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,161 @@
+package ociapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+	"github.com/gemyago/oke-gateway-api/internal/services"
+)
+
+func TestAuditLog(t *testing.T) {
+	readRecords := func(t *testing.T, output *bytes.Buffer) []map[string]any {
+		var records []map[string]any
+		decoder := json.NewDecoder(output)
+		for decoder.More() {
+			var record map[string]any
+			require.NoError(t, decoder.Decode(&record))
+			records = append(records, record)
+		}
+		return records
+	}
+
+	t.Run("should record mutating operation", func(t *testing.T) {
+		fake := faker.New()
+		var output bytes.Buffer
+		audit := newAuditLogWithOutput(&output)
+		region := fake.Lorem().Word()
+		initiator := AuditInitiator{
+			Controller:  "HTTPRoute",
+			Namespace:   fake.Internet().Slug(),
+			Name:        fake.Internet().Slug(),
+			ReconcileID: fake.UUID().V4(),
+		}
+		ctx := WithAuditInitiator(WithRegion(t.Context(), region), initiator)
+		request := loadbalancer.UpdateBackendSetRequest{
+			LoadBalancerId: new(fake.UUID().V4()),
+			BackendSetName: new(fake.Internet().Slug()),
+			OpcRequestId:   new(fake.UUID().V4()),
+		}
+		rawResponse := &http.Response{Header: http.Header{}}
+		rawResponse.Header.Set("opc-request-id", fake.UUID().V4())
+		rawResponse.Header.Set("opc-work-request-id", fake.UUID().V4())
+
+		audit.record(ctx, request, loadbalancer.UpdateBackendSetResponse{RawResponse: rawResponse}, nil)
+
+		records := readRecords(t, &output)
+		require.Len(t, records, 1)
+		assert.Equal(t, "UpdateBackendSet", records[0]["operation"])
+		assert.Equal(t, region, records[0]["region"])
+		assert.Equal(t, *request.BackendSetName, records[0]["resourceName"])
+		assert.Equal(t, map[string]any{
+			"loadBalancerId": *request.LoadBalancerId,
+			"backendSetName": *request.BackendSetName,
+		}, records[0]["request"])
+		assert.Equal(t, rawResponse.Header.Get("opc-request-id"), records[0]["opcRequestId"])
+		assert.Equal(t, rawResponse.Header.Get("opc-work-request-id"), records[0]["workRequestId"])
+		assert.Equal(t, map[string]any{
+			"controller":  initiator.Controller,
+			"namespace":   initiator.Namespace,
+			"name":        initiator.Name,
+			"reconcileId": initiator.ReconcileID,
+		}, records[0]["initiator"])
+		assert.Equal(t, "succeeded", records[0]["outcome"])
+	})
+
+	t.Run("should record names of request details and failure", func(t *testing.T) {
+		fake := faker.New()
+		var output bytes.Buffer
+		audit := newAuditLogWithOutput(&output)
+		request := loadbalancer.CreateCertificateRequest{
+			LoadBalancerId: new(fake.UUID().V4()),
+			CreateCertificateDetails: loadbalancer.CreateCertificateDetails{
+				CertificateName: new(fake.Internet().Domain()),
+				PrivateKey:      new(fake.Lorem().Sentence(5)),
+				Passphrase:      new(fake.Lorem().Word()),
+			},
+		}
+		wantErr := errors.New(fake.Lorem().Sentence(3))
+
+		audit.record(t.Context(), request, nil, wantErr)
+
+		records := readRecords(t, &output)
+		require.Len(t, records, 1)
+		assert.Equal(t, "CreateCertificate", records[0]["operation"])
+		assert.Equal(t, *request.CreateCertificateDetails.CertificateName, records[0]["resourceName"])
+		assert.Equal(t, map[string]any{
+			"loadBalancerId":  *request.LoadBalancerId,
+			"certificateName": *request.CreateCertificateDetails.CertificateName,
+		}, records[0]["request"])
+		assert.Equal(t, "failed", records[0]["outcome"])
+		assert.Equal(t, wantErr.Error(), records[0]["error"])
+		assert.NotContains(t, records[0], "initiator")
+	})
+
+	t.Run("should not record read operations", func(t *testing.T) {
+		var output bytes.Buffer
+		audit := newAuditLogWithOutput(&output)
+
+		audit.record(t.Context(), loadbalancer.GetLoadBalancerRequest{}, nil, nil)
+		audit.record(t.Context(), loadbalancer.ListWorkRequestsRequest{}, nil, nil)
+
+		assert.Empty(t, output.String())
+	})
+
+	t.Run("should ignore records of nil audit log", func(t *testing.T) {
+		var audit *AuditLog
+		assert.NotPanics(t, func() {
+			audit.record(t.Context(), loadbalancer.DeleteBackendSetRequest{}, nil, nil)
+		})
+	})
+
+	t.Run("NewAuditLog", func(t *testing.T) {
+		t.Run("should be disabled if output is empty", func(t *testing.T) {
+			audit, err := NewAuditLog(AuditLogDeps{
+				RootLogger:    diag.RootTestLogger(),
+				ShutdownHooks: services.NewTestShutdownHooks(),
+			})
+
+			require.NoError(t, err)
+			assert.Nil(t, audit)
+		})
+
+		t.Run("should append records to the file", func(t *testing.T) {
+			hooks := services.NewTestShutdownHooks()
+			output := filepath.Join(t.TempDir(), "audit.log")
+
+			audit, err := NewAuditLog(AuditLogDeps{
+				RootLogger:    diag.RootTestLogger(),
+				ShutdownHooks: hooks,
+				Output:        output,
+			})
+			require.NoError(t, err)
+			audit.record(t.Context(), loadbalancer.DeleteBackendSetRequest{}, nil, nil)
+			require.NoError(t, hooks.PerformShutdown(t.Context()))
+
+			data, err := os.ReadFile(output)
+			require.NoError(t, err)
+			assert.Contains(t, string(data), `"operation":"DeleteBackendSet"`)
+		})
+
+		t.Run("should fail if the file can not be opened", func(t *testing.T) {
+			_, err := NewAuditLog(AuditLogDeps{
+				RootLogger:    diag.RootTestLogger(),
+				ShutdownHooks: services.NewTestShutdownHooks(),
+				Output:        filepath.Join(t.TempDir(), "missing", "audit.log"),
+			})
+
+			require.ErrorContains(t, err, "failed to open audit log")
+		})
+	})
+}
@@ -3,10 +3,16 @@ package ociapi
 import (
 	"fmt"
 	"log/slog"
+	"net/http"
+	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/dns"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/logging"
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
 	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 	"go.uber.org/dig"
 )
@@ -20,6 +26,23 @@ type LoadBalancerConfigDeps struct {
 
 	// This can be set via APP_OCIAPI_NOOP env variable
 	Noop bool `name:"config.ociapi.noop"`
+
+	// Timeout of a single OCI API request
+	Timeout time.Duration `name:"config.ociapi.timeout"`
+
+	// Records mutating operations of regional clients, nil if disabled
+	AuditLog *AuditLog `optional:"true"`
+
+	// Period after a throttled request to prioritize load balancer control plane requests
+	// over backend updates. Prioritization is disabled if zero.
+	ThrottleCooldown time.Duration `name:"config.ociapi.throttleCooldown"`
+}
+
+// applyClientTimeout overrides the request timeout of the SDK default http client.
+func applyClientTimeout(baseClient *common.BaseClient, timeout time.Duration) {
+	if httpClient, ok := baseClient.HTTPClient.(*http.Client); ok {
+		httpClient.Timeout = timeout
+	}
 }
 
 func newLoadBalancerClient(
@@ -34,6 +57,7 @@ func newLoadBalancerClient(
 	if err != nil {
 		return loadbalancer.LoadBalancerClient{}, fmt.Errorf("failed to create load balancer client: %w", err)
 	}
+	applyClientTimeout(&client.BaseClient, deps.Timeout)
 	return client, nil
 }
 
@@ -52,6 +76,7 @@ func newNetworkLoadBalancerClient(
 			err,
 		)
 	}
+	applyClientTimeout(&client.BaseClient, deps.Timeout)
 	return client, nil
 }
 
@@ -70,5 +95,82 @@ func newCertificatesManagementClient(
 			err,
 		)
 	}
+	applyClientTimeout(&client.BaseClient, deps.Timeout)
+	return client, nil
+}
+
+func newLoggingManagementClient(
+	deps LoadBalancerConfigDeps,
+) (logging.LoggingManagementClient, error) {
+	if deps.Noop {
+		deps.RootLogger.Warn("OCI API client is in noop mode")
+		return logging.LoggingManagementClient{}, nil
+	}
+
+	client, err := logging.NewLoggingManagementClientWithConfigurationProvider(deps.ConfigProvider)
+	if err != nil {
+		return logging.LoggingManagementClient{}, fmt.Errorf(
+			"failed to create logging management client: %w",
+			err,
+		)
+	}
+	applyClientTimeout(&client.BaseClient, deps.Timeout)
+	return client, nil
+}
+
+func newVirtualNetworkClient(
+	deps LoadBalancerConfigDeps,
+) (core.VirtualNetworkClient, error) {
+	if deps.Noop {
+		deps.RootLogger.Warn("OCI API client is in noop mode")
+		return core.VirtualNetworkClient{}, nil
+	}
+
+	client, err := core.NewVirtualNetworkClientWithConfigurationProvider(deps.ConfigProvider)
+	if err != nil {
+		return core.VirtualNetworkClient{}, fmt.Errorf(
+			"failed to create virtual network client: %w",
+			err,
+		)
+	}
+	applyClientTimeout(&client.BaseClient, deps.Timeout)
+	return client, nil
+}
+
+func newDNSClient(
+	deps LoadBalancerConfigDeps,
+) (dns.DnsClient, error) {
+	if deps.Noop {
+		deps.RootLogger.Warn("OCI API client is in noop mode")
+		return dns.DnsClient{}, nil
+	}
+
+	client, err := dns.NewDnsClientWithConfigurationProvider(deps.ConfigProvider)
+	if err != nil {
+		return dns.DnsClient{}, fmt.Errorf(
+			"failed to create DNS client: %w",
+			err,
+		)
+	}
+	applyClientTimeout(&client.BaseClient, deps.Timeout)
+	return client, nil
+}
+
+func newMonitoringClient(
+	deps LoadBalancerConfigDeps,
+) (monitoring.MonitoringClient, error) {
+	if deps.Noop {
+		deps.RootLogger.Warn("OCI API client is in noop mode")
+		return monitoring.MonitoringClient{}, nil
+	}
+
+	client, err := monitoring.NewMonitoringClientWithConfigurationProvider(deps.ConfigProvider)
+	if err != nil {
+		return monitoring.MonitoringClient{}, fmt.Errorf(
+			"failed to create monitoring client: %w",
+			err,
+		)
+	}
+	applyClientTimeout(&client.BaseClient, deps.Timeout)
 	return client, nil
 }
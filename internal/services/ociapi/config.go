@@ -1,12 +1,63 @@
 package ociapi
 
 import (
+	"fmt"
+	"log/slog"
+
 	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/common/auth"
+	"go.uber.org/dig"
+)
+
+// Supported values of the ociapi.authProvider config.
+const (
+	AuthProviderUserPrincipal     = "userPrincipal"
+	AuthProviderInstancePrincipal = "instancePrincipal"
+	AuthProviderResourcePrincipal = "resourcePrincipal"
+	AuthProviderWorkloadIdentity  = "workloadIdentity"
 )
 
+type ConfigProviderDeps struct {
+	dig.In
+
+	RootLogger *slog.Logger
+
+	Noop bool `name:"config.ociapi.noop"`
+
+	// This can be set via APP_OCIAPI_AUTHPROVIDER env variable
+	AuthProvider string `name:"config.ociapi.authProvider"`
+}
+
+// newConfigProvider creates the OCI configuration provider for the selected auth provider.
+// Principal based providers refresh the security token automatically.
+//
 //nolint:ireturn // OCI SDK configuration providers are intentionally interface-based.
-func newConfigProvider() (common.ConfigurationProvider, error) {
-	// TODO: This needs more advanced setup and support in cluster config
-	configProvider := common.DefaultConfigProvider()
+func newConfigProvider(deps ConfigProviderDeps) (common.ConfigurationProvider, error) {
+	if deps.Noop {
+		// Principal based providers reach out to the metadata service on creation
+		return common.DefaultConfigProvider(), nil
+	}
+
+	deps.RootLogger.Info("Using OCI auth provider", slog.String("authProvider", deps.AuthProvider))
+
+	var (
+		configProvider common.ConfigurationProvider
+		err            error
+	)
+	switch deps.AuthProvider {
+	case AuthProviderUserPrincipal:
+		configProvider = common.DefaultConfigProvider()
+	case AuthProviderInstancePrincipal:
+		configProvider, err = auth.InstancePrincipalConfigurationProvider()
+	case AuthProviderResourcePrincipal:
+		configProvider, err = auth.ResourcePrincipalConfigurationProvider()
+	case AuthProviderWorkloadIdentity:
+		configProvider, err = auth.OkeWorkloadIdentityConfigurationProvider()
+	default:
+		return nil, fmt.Errorf("unsupported OCI auth provider: %q", deps.AuthProvider)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s OCI config provider: %w", deps.AuthProvider, err)
+	}
 	return configProvider, nil
 }
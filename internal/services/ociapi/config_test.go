@@ -0,0 +1,46 @@
+package ociapi
+
+import (
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+)
+
+func TestNewConfigProvider(t *testing.T) {
+	t.Run("should create user principal provider", func(t *testing.T) {
+		configProvider, err := newConfigProvider(ConfigProviderDeps{
+			RootLogger:   diag.RootTestLogger(),
+			AuthProvider: AuthProviderUserPrincipal,
+		})
+
+		require.NoError(t, err)
+		assert.NotNil(t, configProvider)
+	})
+
+	t.Run("should skip principal providers in noop mode", func(t *testing.T) {
+		configProvider, err := newConfigProvider(ConfigProviderDeps{
+			RootLogger:   diag.RootTestLogger(),
+			Noop:         true,
+			AuthProvider: AuthProviderInstancePrincipal,
+		})
+
+		require.NoError(t, err)
+		assert.NotNil(t, configProvider)
+	})
+
+	t.Run("should fail for unsupported provider", func(t *testing.T) {
+		authProvider := faker.New().Lorem().Word()
+
+		_, err := newConfigProvider(ConfigProviderDeps{
+			RootLogger:   diag.RootTestLogger(),
+			AuthProvider: authProvider,
+		})
+
+		require.ErrorContains(t, err, "unsupported OCI auth provider")
+		assert.ErrorContains(t, err, authProvider)
+	})
+}
@@ -0,0 +1,78 @@
+package ociapi
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+)
+
+// ErrorClass is the class of the OCI API error that determines how reconcilers handle it.
+type ErrorClass string
+
+const (
+	// ErrorClassUnknown is any other error, e.g. network errors or server side failures.
+	// Such errors are treated as transient.
+	ErrorClassUnknown ErrorClass = ""
+
+	// ErrorClassThrottled indicates that the request rate limit is exceeded. Transient.
+	ErrorClassThrottled ErrorClass = "Throttled"
+
+	// ErrorClassConflict indicates that the resource is busy, e.g. another work request
+	// is in progress on the load balancer. Transient.
+	ErrorClassConflict ErrorClass = "Conflict"
+
+	// ErrorClassQuotaExceeded indicates that a service limit or compartment quota is
+	// exceeded. Terminal until the limit is raised or resources are freed.
+	ErrorClassQuotaExceeded ErrorClass = "QuotaExceeded"
+
+	// ErrorClassInvalidParameter indicates that OCI rejected the request as invalid,
+	// e.g. an unsupported value derived from the resource spec. Terminal.
+	ErrorClassInvalidParameter ErrorClass = "InvalidParameter"
+
+	// ErrorClassNotAuthorized indicates that the credentials are rejected or the
+	// controller is not allowed to perform the operation. Terminal.
+	ErrorClassNotAuthorized ErrorClass = "NotAuthorized"
+)
+
+// IsTerminal reports whether retrying the request without changes can not succeed.
+func (c ErrorClass) IsTerminal() bool {
+	switch c {
+	case ErrorClassQuotaExceeded, ErrorClassInvalidParameter, ErrorClassNotAuthorized:
+		return true
+	case ErrorClassUnknown, ErrorClassThrottled, ErrorClassConflict:
+		return false
+	}
+	return false
+}
+
+// ClassifyError returns the class of the OCI service error found in the error chain.
+// Not found errors are not classified since reconcilers handle them explicitly.
+func ClassifyError(err error) ErrorClass {
+	var serviceErr common.ServiceError
+	if !errors.As(err, &serviceErr) {
+		return ErrorClassUnknown
+	}
+	code := serviceErr.GetCode()
+	switch {
+	case serviceErr.GetHTTPStatusCode() == http.StatusTooManyRequests:
+		return ErrorClassThrottled
+	case strings.Contains(code, "LimitExceeded") || strings.Contains(code, "QuotaExceeded"):
+		return ErrorClassQuotaExceeded
+	case serviceErr.GetHTTPStatusCode() == http.StatusUnauthorized ||
+		serviceErr.GetHTTPStatusCode() == http.StatusForbidden:
+		return ErrorClassNotAuthorized
+	case serviceErr.GetHTTPStatusCode() == http.StatusConflict:
+		return ErrorClassConflict
+	case serviceErr.GetHTTPStatusCode() == http.StatusBadRequest:
+		return ErrorClassInvalidParameter
+	default:
+		return ErrorClassUnknown
+	}
+}
+
+// IsTerminalError reports whether the error chain contains the terminal OCI error.
+func IsTerminalError(err error) bool {
+	return ClassifyError(err).IsTerminal()
+}
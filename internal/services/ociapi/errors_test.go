@@ -0,0 +1,107 @@
+package ociapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyError(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		err          error
+		wantClass    ErrorClass
+		wantTerminal bool
+	}{
+		{
+			name:      "non service error",
+			err:       errors.New(faker.New().Lorem().Sentence(3)),
+			wantClass: ErrorClassUnknown,
+		},
+		{
+			name: "throttled",
+			err: NewRandomServiceError(
+				RandomServiceErrorWithStatusCode(http.StatusTooManyRequests),
+				RandomServiceErrorWithCode("TooManyRequests"),
+			),
+			wantClass: ErrorClassThrottled,
+		},
+		{
+			name: "conflict",
+			err: NewRandomServiceError(
+				RandomServiceErrorWithStatusCode(http.StatusConflict),
+				RandomServiceErrorWithCode("IncorrectState"),
+			),
+			wantClass: ErrorClassConflict,
+		},
+		{
+			name: "limit exceeded",
+			err: NewRandomServiceError(
+				RandomServiceErrorWithStatusCode(http.StatusBadRequest),
+				RandomServiceErrorWithCode("LimitExceeded"),
+			),
+			wantClass:    ErrorClassQuotaExceeded,
+			wantTerminal: true,
+		},
+		{
+			name: "quota exceeded",
+			err: NewRandomServiceError(
+				RandomServiceErrorWithStatusCode(http.StatusBadRequest),
+				RandomServiceErrorWithCode("QuotaExceeded"),
+			),
+			wantClass:    ErrorClassQuotaExceeded,
+			wantTerminal: true,
+		},
+		{
+			name: "invalid parameter",
+			err: NewRandomServiceError(
+				RandomServiceErrorWithStatusCode(http.StatusBadRequest),
+				RandomServiceErrorWithCode("InvalidParameter"),
+			),
+			wantClass:    ErrorClassInvalidParameter,
+			wantTerminal: true,
+		},
+		{
+			name: "not authenticated",
+			err: NewRandomServiceError(
+				RandomServiceErrorWithStatusCode(http.StatusUnauthorized),
+				RandomServiceErrorWithCode("NotAuthenticated"),
+			),
+			wantClass:    ErrorClassNotAuthorized,
+			wantTerminal: true,
+		},
+		{
+			name: "wrapped service error",
+			err: fmt.Errorf("failed to create backend set: %w", NewRandomServiceError(
+				RandomServiceErrorWithStatusCode(http.StatusForbidden),
+			)),
+			wantClass:    ErrorClassNotAuthorized,
+			wantTerminal: true,
+		},
+		{
+			name: "not found",
+			err: NewRandomServiceError(
+				RandomServiceErrorWithStatusCode(http.StatusNotFound),
+				RandomServiceErrorWithCode("NotAuthorizedOrNotFound"),
+			),
+			wantClass: ErrorClassUnknown,
+		},
+		{
+			name: "server error",
+			err: NewRandomServiceError(
+				RandomServiceErrorWithStatusCode(http.StatusInternalServerError),
+				RandomServiceErrorWithCode("InternalServerError"),
+			),
+			wantClass: ErrorClassUnknown,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantClass, ClassifyError(tc.err))
+			assert.Equal(t, tc.wantTerminal, IsTerminalError(tc.err))
+		})
+	}
+}
@@ -0,0 +1,587 @@
+package ociapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/dns"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/logging"
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+)
+
+type regionContextKey struct{}
+
+// WithRegion returns the context that makes regional clients send requests to the given region.
+// Empty region means the region of the configuration provider.
+func WithRegion(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, regionContextKey{}, region)
+}
+
+// RegionFromContext returns the region set with WithRegion.
+func RegionFromContext(ctx context.Context) string {
+	region, _ := ctx.Value(regionContextKey{}).(string)
+	return region
+}
+
+// regionalClients holds the default client and lazily created clients of other regions.
+type regionalClients[TClient any] struct {
+	defaultClient TClient
+	newClient     func(region string) (TClient, error)
+
+	mu      sync.Mutex
+	clients map[string]TClient
+
+	audit     *AuditLog
+	scheduler *throttleScheduler
+}
+
+func newRegionalClients[TClient any](
+	defaultClient TClient,
+	newClient func(region string) (TClient, error),
+) *regionalClients[TClient] {
+	return &regionalClients[TClient]{
+		defaultClient: defaultClient,
+		newClient:     newClient,
+		clients:       make(map[string]TClient),
+	}
+}
+
+// withAudit makes the clients record mutating operations to the audit log.
+func (c *regionalClients[TClient]) withAudit(audit *AuditLog) *regionalClients[TClient] {
+	c.audit = audit
+	return c
+}
+
+// withThrottleScheduler makes the clients prioritize control plane requests while throttled.
+func (c *regionalClients[TClient]) withThrottleScheduler(scheduler *throttleScheduler) *regionalClients[TClient] {
+	c.scheduler = scheduler
+	return c
+}
+
+func (c *regionalClients[TClient]) get(ctx context.Context) (TClient, error) {
+	region := RegionFromContext(ctx)
+	if region == "" {
+		return c.defaultClient, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if client, ok := c.clients[region]; ok {
+		return client, nil
+	}
+	client, err := c.newClient(region)
+	if err != nil {
+		var zero TClient
+		return zero, fmt.Errorf("failed to create client for region %s: %w", region, err)
+	}
+	c.clients[region] = client
+	return client, nil
+}
+
+func callInRegion[TClient, TRequest, TResponse any](
+	ctx context.Context,
+	clients *regionalClients[TClient],
+	call func(TClient, context.Context, TRequest) (TResponse, error),
+	request TRequest,
+) (TResponse, error) {
+	request = withRetryToken(request)
+	ctx, endSpan := startOCICallSpan(ctx, request)
+	client, err := clients.get(ctx)
+	release := func() {}
+	if err == nil {
+		release, err = clients.scheduler.acquire(ctx, request)
+	}
+	if err != nil {
+		endSpan(nil, err)
+		clients.audit.record(ctx, request, nil, err)
+		var zero TResponse
+		return zero, err
+	}
+	response, err := call(client, ctx, request)
+	release()
+	clients.scheduler.observe(ctx, request, err)
+	endSpan(response, err)
+	clients.audit.record(ctx, request, response, err)
+	return response, err
+}
+
+// RegionalLoadBalancerClient sends OCI Load Balancer requests to the region of the context.
+type RegionalLoadBalancerClient struct {
+	clients *regionalClients[loadbalancer.LoadBalancerClient]
+}
+
+func newRegionalLoadBalancerClient(
+	deps LoadBalancerConfigDeps,
+	defaultClient loadbalancer.LoadBalancerClient,
+) *RegionalLoadBalancerClient {
+	return &RegionalLoadBalancerClient{
+		clients: newRegionalClients(defaultClient, func(region string) (loadbalancer.LoadBalancerClient, error) {
+			client, err := newLoadBalancerClient(deps)
+			if err != nil {
+				return client, err
+			}
+			client.SetRegion(region)
+			return client, nil
+		}).
+			withAudit(deps.AuditLog).
+			withThrottleScheduler(newThrottleScheduler(deps.RootLogger, deps.ThrottleCooldown)),
+	}
+}
+
+func (c *RegionalLoadBalancerClient) GetLoadBalancer(
+	ctx context.Context, request loadbalancer.GetLoadBalancerRequest,
+) (loadbalancer.GetLoadBalancerResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.GetLoadBalancer, request)
+}
+
+func (c *RegionalLoadBalancerClient) CreateBackendSet(
+	ctx context.Context, request loadbalancer.CreateBackendSetRequest,
+) (loadbalancer.CreateBackendSetResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.CreateBackendSet, request)
+}
+
+func (c *RegionalLoadBalancerClient) DeleteBackendSet(
+	ctx context.Context, request loadbalancer.DeleteBackendSetRequest,
+) (loadbalancer.DeleteBackendSetResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.DeleteBackendSet, request)
+}
+
+func (c *RegionalLoadBalancerClient) GetBackendSet(
+	ctx context.Context, request loadbalancer.GetBackendSetRequest,
+) (loadbalancer.GetBackendSetResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.GetBackendSet, request)
+}
+
+func (c *RegionalLoadBalancerClient) GetBackendSetHealth(
+	ctx context.Context, request loadbalancer.GetBackendSetHealthRequest,
+) (loadbalancer.GetBackendSetHealthResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.GetBackendSetHealth, request)
+}
+
+func (c *RegionalLoadBalancerClient) GetBackendHealth(
+	ctx context.Context, request loadbalancer.GetBackendHealthRequest,
+) (loadbalancer.GetBackendHealthResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.GetBackendHealth, request)
+}
+
+func (c *RegionalLoadBalancerClient) UpdateBackendSet(
+	ctx context.Context, request loadbalancer.UpdateBackendSetRequest,
+) (loadbalancer.UpdateBackendSetResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.UpdateBackendSet, request)
+}
+
+func (c *RegionalLoadBalancerClient) CreateBackend(
+	ctx context.Context, request loadbalancer.CreateBackendRequest,
+) (loadbalancer.CreateBackendResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.CreateBackend, request)
+}
+
+func (c *RegionalLoadBalancerClient) CreateListener(
+	ctx context.Context, request loadbalancer.CreateListenerRequest,
+) (loadbalancer.CreateListenerResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.CreateListener, request)
+}
+
+func (c *RegionalLoadBalancerClient) UpdateListener(
+	ctx context.Context, request loadbalancer.UpdateListenerRequest,
+) (loadbalancer.UpdateListenerResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.UpdateListener, request)
+}
+
+func (c *RegionalLoadBalancerClient) DeleteListener(
+	ctx context.Context, request loadbalancer.DeleteListenerRequest,
+) (loadbalancer.DeleteListenerResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.DeleteListener, request)
+}
+
+func (c *RegionalLoadBalancerClient) CreateHostname(
+	ctx context.Context, request loadbalancer.CreateHostnameRequest,
+) (loadbalancer.CreateHostnameResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.CreateHostname, request)
+}
+
+func (c *RegionalLoadBalancerClient) GetHostname(
+	ctx context.Context, request loadbalancer.GetHostnameRequest,
+) (loadbalancer.GetHostnameResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.GetHostname, request)
+}
+
+func (c *RegionalLoadBalancerClient) CreateRuleSet(
+	ctx context.Context, request loadbalancer.CreateRuleSetRequest,
+) (loadbalancer.CreateRuleSetResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.CreateRuleSet, request)
+}
+
+func (c *RegionalLoadBalancerClient) UpdateRuleSet(
+	ctx context.Context, request loadbalancer.UpdateRuleSetRequest,
+) (loadbalancer.UpdateRuleSetResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.UpdateRuleSet, request)
+}
+
+func (c *RegionalLoadBalancerClient) DeleteRuleSet(
+	ctx context.Context, request loadbalancer.DeleteRuleSetRequest,
+) (loadbalancer.DeleteRuleSetResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.DeleteRuleSet, request)
+}
+
+func (c *RegionalLoadBalancerClient) GetRuleSet(
+	ctx context.Context, request loadbalancer.GetRuleSetRequest,
+) (loadbalancer.GetRuleSetResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.GetRuleSet, request)
+}
+
+func (c *RegionalLoadBalancerClient) GetRoutingPolicy(
+	ctx context.Context, request loadbalancer.GetRoutingPolicyRequest,
+) (loadbalancer.GetRoutingPolicyResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.GetRoutingPolicy, request)
+}
+
+func (c *RegionalLoadBalancerClient) CreateRoutingPolicy(
+	ctx context.Context, request loadbalancer.CreateRoutingPolicyRequest,
+) (loadbalancer.CreateRoutingPolicyResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.CreateRoutingPolicy, request)
+}
+
+func (c *RegionalLoadBalancerClient) UpdateRoutingPolicy(
+	ctx context.Context, request loadbalancer.UpdateRoutingPolicyRequest,
+) (loadbalancer.UpdateRoutingPolicyResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.UpdateRoutingPolicy, request)
+}
+
+func (c *RegionalLoadBalancerClient) DeleteRoutingPolicy(
+	ctx context.Context, request loadbalancer.DeleteRoutingPolicyRequest,
+) (loadbalancer.DeleteRoutingPolicyResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.DeleteRoutingPolicy, request)
+}
+
+func (c *RegionalLoadBalancerClient) CreateCertificate(
+	ctx context.Context, request loadbalancer.CreateCertificateRequest,
+) (loadbalancer.CreateCertificateResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.CreateCertificate, request)
+}
+
+func (c *RegionalLoadBalancerClient) DeleteCertificate(
+	ctx context.Context, request loadbalancer.DeleteCertificateRequest,
+) (loadbalancer.DeleteCertificateResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.DeleteCertificate, request)
+}
+
+func (c *RegionalLoadBalancerClient) GetWorkRequest(
+	ctx context.Context, request loadbalancer.GetWorkRequestRequest,
+) (loadbalancer.GetWorkRequestResponse, error) {
+	return callInRegion(ctx, c.clients, loadbalancer.LoadBalancerClient.GetWorkRequest, request)
+}
+
+// RegionalNetworkLoadBalancerClient sends OCI Network Load Balancer requests to the region of the context.
+type RegionalNetworkLoadBalancerClient struct {
+	clients *regionalClients[networkloadbalancer.NetworkLoadBalancerClient]
+}
+
+func newRegionalNetworkLoadBalancerClient(
+	deps LoadBalancerConfigDeps,
+	defaultClient networkloadbalancer.NetworkLoadBalancerClient,
+) *RegionalNetworkLoadBalancerClient {
+	return &RegionalNetworkLoadBalancerClient{
+		clients: newRegionalClients(
+			defaultClient,
+			func(region string) (networkloadbalancer.NetworkLoadBalancerClient, error) {
+				client, err := newNetworkLoadBalancerClient(deps)
+				if err != nil {
+					return client, err
+				}
+				client.SetRegion(region)
+				return client, nil
+			},
+		).
+			withAudit(deps.AuditLog).
+			withThrottleScheduler(newThrottleScheduler(deps.RootLogger, deps.ThrottleCooldown)),
+	}
+}
+
+func (c *RegionalNetworkLoadBalancerClient) GetNetworkLoadBalancer(
+	ctx context.Context, request networkloadbalancer.GetNetworkLoadBalancerRequest,
+) (networkloadbalancer.GetNetworkLoadBalancerResponse, error) {
+	return callInRegion(ctx, c.clients, networkloadbalancer.NetworkLoadBalancerClient.GetNetworkLoadBalancer, request)
+}
+
+func (c *RegionalNetworkLoadBalancerClient) CreateListener(
+	ctx context.Context, request networkloadbalancer.CreateListenerRequest,
+) (networkloadbalancer.CreateListenerResponse, error) {
+	return callInRegion(ctx, c.clients, networkloadbalancer.NetworkLoadBalancerClient.CreateListener, request)
+}
+
+func (c *RegionalNetworkLoadBalancerClient) UpdateListener(
+	ctx context.Context, request networkloadbalancer.UpdateListenerRequest,
+) (networkloadbalancer.UpdateListenerResponse, error) {
+	return callInRegion(ctx, c.clients, networkloadbalancer.NetworkLoadBalancerClient.UpdateListener, request)
+}
+
+func (c *RegionalNetworkLoadBalancerClient) DeleteListener(
+	ctx context.Context, request networkloadbalancer.DeleteListenerRequest,
+) (networkloadbalancer.DeleteListenerResponse, error) {
+	return callInRegion(ctx, c.clients, networkloadbalancer.NetworkLoadBalancerClient.DeleteListener, request)
+}
+
+func (c *RegionalNetworkLoadBalancerClient) CreateBackendSet(
+	ctx context.Context, request networkloadbalancer.CreateBackendSetRequest,
+) (networkloadbalancer.CreateBackendSetResponse, error) {
+	return callInRegion(ctx, c.clients, networkloadbalancer.NetworkLoadBalancerClient.CreateBackendSet, request)
+}
+
+func (c *RegionalNetworkLoadBalancerClient) UpdateBackendSet(
+	ctx context.Context, request networkloadbalancer.UpdateBackendSetRequest,
+) (networkloadbalancer.UpdateBackendSetResponse, error) {
+	return callInRegion(ctx, c.clients, networkloadbalancer.NetworkLoadBalancerClient.UpdateBackendSet, request)
+}
+
+func (c *RegionalNetworkLoadBalancerClient) DeleteBackendSet(
+	ctx context.Context, request networkloadbalancer.DeleteBackendSetRequest,
+) (networkloadbalancer.DeleteBackendSetResponse, error) {
+	return callInRegion(ctx, c.clients, networkloadbalancer.NetworkLoadBalancerClient.DeleteBackendSet, request)
+}
+
+func (c *RegionalNetworkLoadBalancerClient) CreateBackend(
+	ctx context.Context, request networkloadbalancer.CreateBackendRequest,
+) (networkloadbalancer.CreateBackendResponse, error) {
+	return callInRegion(ctx, c.clients, networkloadbalancer.NetworkLoadBalancerClient.CreateBackend, request)
+}
+
+func (c *RegionalNetworkLoadBalancerClient) UpdateBackend(
+	ctx context.Context, request networkloadbalancer.UpdateBackendRequest,
+) (networkloadbalancer.UpdateBackendResponse, error) {
+	return callInRegion(ctx, c.clients, networkloadbalancer.NetworkLoadBalancerClient.UpdateBackend, request)
+}
+
+func (c *RegionalNetworkLoadBalancerClient) DeleteBackend(
+	ctx context.Context, request networkloadbalancer.DeleteBackendRequest,
+) (networkloadbalancer.DeleteBackendResponse, error) {
+	return callInRegion(ctx, c.clients, networkloadbalancer.NetworkLoadBalancerClient.DeleteBackend, request)
+}
+
+func (c *RegionalNetworkLoadBalancerClient) GetWorkRequest(
+	ctx context.Context, request networkloadbalancer.GetWorkRequestRequest,
+) (networkloadbalancer.GetWorkRequestResponse, error) {
+	return callInRegion(ctx, c.clients, networkloadbalancer.NetworkLoadBalancerClient.GetWorkRequest, request)
+}
+
+// RegionalCertificatesManagementClient sends OCI Certificates requests to the region of the context.
+type RegionalCertificatesManagementClient struct {
+	clients *regionalClients[certificatesmanagement.CertificatesManagementClient]
+}
+
+func newRegionalCertificatesManagementClient(
+	deps LoadBalancerConfigDeps,
+	defaultClient certificatesmanagement.CertificatesManagementClient,
+) *RegionalCertificatesManagementClient {
+	return &RegionalCertificatesManagementClient{
+		clients: newRegionalClients(
+			defaultClient,
+			func(region string) (certificatesmanagement.CertificatesManagementClient, error) {
+				client, err := newCertificatesManagementClient(deps)
+				if err != nil {
+					return client, err
+				}
+				client.SetRegion(region)
+				return client, nil
+			},
+		).withAudit(deps.AuditLog),
+	}
+}
+
+func (c *RegionalCertificatesManagementClient) CreateCaBundle(
+	ctx context.Context, request certificatesmanagement.CreateCaBundleRequest,
+) (certificatesmanagement.CreateCaBundleResponse, error) {
+	return callInRegion(ctx, c.clients, certificatesmanagement.CertificatesManagementClient.CreateCaBundle, request)
+}
+
+func (c *RegionalCertificatesManagementClient) GetCaBundle(
+	ctx context.Context, request certificatesmanagement.GetCaBundleRequest,
+) (certificatesmanagement.GetCaBundleResponse, error) {
+	return callInRegion(ctx, c.clients, certificatesmanagement.CertificatesManagementClient.GetCaBundle, request)
+}
+
+func (c *RegionalCertificatesManagementClient) ListCaBundles(
+	ctx context.Context, request certificatesmanagement.ListCaBundlesRequest,
+) (certificatesmanagement.ListCaBundlesResponse, error) {
+	return callInRegion(ctx, c.clients, certificatesmanagement.CertificatesManagementClient.ListCaBundles, request)
+}
+
+func (c *RegionalCertificatesManagementClient) UpdateCaBundle(
+	ctx context.Context, request certificatesmanagement.UpdateCaBundleRequest,
+) (certificatesmanagement.UpdateCaBundleResponse, error) {
+	return callInRegion(ctx, c.clients, certificatesmanagement.CertificatesManagementClient.UpdateCaBundle, request)
+}
+
+func (c *RegionalCertificatesManagementClient) DeleteCaBundle(
+	ctx context.Context, request certificatesmanagement.DeleteCaBundleRequest,
+) (certificatesmanagement.DeleteCaBundleResponse, error) {
+	return callInRegion(ctx, c.clients, certificatesmanagement.CertificatesManagementClient.DeleteCaBundle, request)
+}
+
+// RegionalLoggingManagementClient sends OCI Logging requests to the region of the context.
+type RegionalLoggingManagementClient struct {
+	clients *regionalClients[logging.LoggingManagementClient]
+}
+
+func newRegionalLoggingManagementClient(
+	deps LoadBalancerConfigDeps,
+	defaultClient logging.LoggingManagementClient,
+) *RegionalLoggingManagementClient {
+	return &RegionalLoggingManagementClient{
+		clients: newRegionalClients(defaultClient, func(region string) (logging.LoggingManagementClient, error) {
+			client, err := newLoggingManagementClient(deps)
+			if err != nil {
+				return client, err
+			}
+			client.SetRegion(region)
+			return client, nil
+		}).withAudit(deps.AuditLog),
+	}
+}
+
+func (c *RegionalLoggingManagementClient) ListLogs(
+	ctx context.Context, request logging.ListLogsRequest,
+) (logging.ListLogsResponse, error) {
+	return callInRegion(ctx, c.clients, logging.LoggingManagementClient.ListLogs, request)
+}
+
+func (c *RegionalLoggingManagementClient) GetLog(
+	ctx context.Context, request logging.GetLogRequest,
+) (logging.GetLogResponse, error) {
+	return callInRegion(ctx, c.clients, logging.LoggingManagementClient.GetLog, request)
+}
+
+func (c *RegionalLoggingManagementClient) CreateLog(
+	ctx context.Context, request logging.CreateLogRequest,
+) (logging.CreateLogResponse, error) {
+	return callInRegion(ctx, c.clients, logging.LoggingManagementClient.CreateLog, request)
+}
+
+func (c *RegionalLoggingManagementClient) UpdateLog(
+	ctx context.Context, request logging.UpdateLogRequest,
+) (logging.UpdateLogResponse, error) {
+	return callInRegion(ctx, c.clients, logging.LoggingManagementClient.UpdateLog, request)
+}
+
+func (c *RegionalLoggingManagementClient) GetWorkRequest(
+	ctx context.Context, request logging.GetWorkRequestRequest,
+) (logging.GetWorkRequestResponse, error) {
+	return callInRegion(ctx, c.clients, logging.LoggingManagementClient.GetWorkRequest, request)
+}
+
+// RegionalVirtualNetworkClient sends OCI Networking requests to the region of the context.
+type RegionalVirtualNetworkClient struct {
+	clients *regionalClients[core.VirtualNetworkClient]
+}
+
+func newRegionalVirtualNetworkClient(
+	deps LoadBalancerConfigDeps,
+	defaultClient core.VirtualNetworkClient,
+) *RegionalVirtualNetworkClient {
+	return &RegionalVirtualNetworkClient{
+		clients: newRegionalClients(defaultClient, func(region string) (core.VirtualNetworkClient, error) {
+			client, err := newVirtualNetworkClient(deps)
+			if err != nil {
+				return client, err
+			}
+			client.SetRegion(region)
+			return client, nil
+		}).withAudit(deps.AuditLog),
+	}
+}
+
+func (c *RegionalVirtualNetworkClient) ListNetworkSecurityGroupSecurityRules(
+	ctx context.Context, request core.ListNetworkSecurityGroupSecurityRulesRequest,
+) (core.ListNetworkSecurityGroupSecurityRulesResponse, error) {
+	return callInRegion(ctx, c.clients, core.VirtualNetworkClient.ListNetworkSecurityGroupSecurityRules, request)
+}
+
+func (c *RegionalVirtualNetworkClient) AddNetworkSecurityGroupSecurityRules(
+	ctx context.Context, request core.AddNetworkSecurityGroupSecurityRulesRequest,
+) (core.AddNetworkSecurityGroupSecurityRulesResponse, error) {
+	return callInRegion(ctx, c.clients, core.VirtualNetworkClient.AddNetworkSecurityGroupSecurityRules, request)
+}
+
+func (c *RegionalVirtualNetworkClient) RemoveNetworkSecurityGroupSecurityRules(
+	ctx context.Context, request core.RemoveNetworkSecurityGroupSecurityRulesRequest,
+) (core.RemoveNetworkSecurityGroupSecurityRulesResponse, error) {
+	return callInRegion(ctx, c.clients, core.VirtualNetworkClient.RemoveNetworkSecurityGroupSecurityRules, request)
+}
+
+// RegionalDNSClient sends OCI DNS requests to the region of the context.
+type RegionalDNSClient struct {
+	clients *regionalClients[dns.DnsClient]
+}
+
+func newRegionalDNSClient(
+	deps LoadBalancerConfigDeps,
+	defaultClient dns.DnsClient,
+) *RegionalDNSClient {
+	return &RegionalDNSClient{
+		clients: newRegionalClients(defaultClient, func(region string) (dns.DnsClient, error) {
+			client, err := newDNSClient(deps)
+			if err != nil {
+				return client, err
+			}
+			client.SetRegion(region)
+			return client, nil
+		}).withAudit(deps.AuditLog),
+	}
+}
+
+func (c *RegionalDNSClient) GetZone(
+	ctx context.Context, request dns.GetZoneRequest,
+) (dns.GetZoneResponse, error) {
+	return callInRegion(ctx, c.clients, dns.DnsClient.GetZone, request)
+}
+
+func (c *RegionalDNSClient) GetDomainRecords(
+	ctx context.Context, request dns.GetDomainRecordsRequest,
+) (dns.GetDomainRecordsResponse, error) {
+	return callInRegion(ctx, c.clients, dns.DnsClient.GetDomainRecords, request)
+}
+
+func (c *RegionalDNSClient) UpdateRRSet(
+	ctx context.Context, request dns.UpdateRRSetRequest,
+) (dns.UpdateRRSetResponse, error) {
+	return callInRegion(ctx, c.clients, dns.DnsClient.UpdateRRSet, request)
+}
+
+func (c *RegionalDNSClient) DeleteRRSet(
+	ctx context.Context, request dns.DeleteRRSetRequest,
+) (dns.DeleteRRSetResponse, error) {
+	return callInRegion(ctx, c.clients, dns.DnsClient.DeleteRRSet, request)
+}
+
+// RegionalMonitoringClient sends OCI Monitoring requests to the region of the context.
+// It only reads metrics, so requests are not audited.
+type RegionalMonitoringClient struct {
+	clients *regionalClients[monitoring.MonitoringClient]
+}
+
+func newRegionalMonitoringClient(
+	deps LoadBalancerConfigDeps,
+	defaultClient monitoring.MonitoringClient,
+) *RegionalMonitoringClient {
+	return &RegionalMonitoringClient{
+		clients: newRegionalClients(defaultClient, func(region string) (monitoring.MonitoringClient, error) {
+			client, err := newMonitoringClient(deps)
+			if err != nil {
+				return client, err
+			}
+			client.SetRegion(region)
+			return client, nil
+		}),
+	}
+}
+
+func (c *RegionalMonitoringClient) SummarizeMetricsData(
+	ctx context.Context, request monitoring.SummarizeMetricsDataRequest,
+) (monitoring.SummarizeMetricsDataResponse, error) {
+	return callInRegion(ctx, c.clients, monitoring.MonitoringClient.SummarizeMetricsData, request)
+}
@@ -0,0 +1,83 @@
+package ociapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegionalClients(t *testing.T) {
+	type testClient struct {
+		region string
+	}
+
+	t.Run("should use default client when region is not set", func(t *testing.T) {
+		defaultClient := testClient{region: faker.New().Lorem().Word()}
+		clients := newRegionalClients(defaultClient, func(string) (testClient, error) {
+			assert.Fail(t, "unexpected client creation")
+			return testClient{}, nil
+		})
+
+		client, err := clients.get(t.Context())
+
+		require.NoError(t, err)
+		assert.Equal(t, defaultClient, client)
+	})
+
+	t.Run("should create and cache client per region", func(t *testing.T) {
+		created := map[string]int{}
+		clients := newRegionalClients(testClient{}, func(region string) (testClient, error) {
+			created[region]++
+			return testClient{region: region}, nil
+		})
+		region1 := "region-1-" + faker.New().Lorem().Word()
+		region2 := "region-2-" + faker.New().Lorem().Word()
+
+		for range 2 {
+			client, err := clients.get(WithRegion(t.Context(), region1))
+			require.NoError(t, err)
+			assert.Equal(t, testClient{region: region1}, client)
+
+			client, err = clients.get(WithRegion(t.Context(), region2))
+			require.NoError(t, err)
+			assert.Equal(t, testClient{region: region2}, client)
+		}
+
+		assert.Equal(t, map[string]int{region1: 1, region2: 1}, created)
+	})
+
+	t.Run("should fail when client can not be created", func(t *testing.T) {
+		wantErr := errors.New(faker.New().Lorem().Sentence(5))
+		clients := newRegionalClients(testClient{}, func(string) (testClient, error) {
+			return testClient{}, wantErr
+		})
+
+		_, err := clients.get(WithRegion(t.Context(), faker.New().Lorem().Word()))
+
+		require.ErrorIs(t, err, wantErr)
+	})
+
+	t.Run("callInRegion", func(t *testing.T) {
+		region := faker.New().Lorem().Word()
+		clients := newRegionalClients(testClient{}, func(region string) (testClient, error) {
+			return testClient{region: region}, nil
+		})
+		request := faker.New().Lorem().Word()
+
+		response, err := callInRegion(
+			WithRegion(t.Context(), region),
+			clients,
+			func(client testClient, _ context.Context, request string) (string, error) {
+				return client.region + "/" + request, nil
+			},
+			request,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, region+"/"+request, response)
+	})
+}
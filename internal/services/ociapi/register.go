@@ -1,8 +1,6 @@
 package ociapi
 
 import (
-	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
-	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 	"go.uber.org/dig"
 
 	"github.com/gemyago/oke-gateway-api/internal/di"
@@ -11,12 +9,27 @@ import (
 func Register(container *dig.Container) error {
 	return di.ProvideAll(container,
 		newConfigProvider,
+		NewAuditLog,
 		newLoadBalancerClient,
 		newNetworkLoadBalancerClient,
 		newCertificatesManagementClient,
+		newLoggingManagementClient,
+		newVirtualNetworkClient,
+		newDNSClient,
+		newMonitoringClient,
+		newRegionalLoadBalancerClient,
+		newRegionalNetworkLoadBalancerClient,
+		newRegionalCertificatesManagementClient,
+		newRegionalLoggingManagementClient,
+		newRegionalVirtualNetworkClient,
+		newRegionalDNSClient,
+		newRegionalMonitoringClient,
+		NewWorkRequestWaits,
 		NewWorkRequestsWatcher,
 		NewNetworkLoadBalancerWorkRequestsWatcher,
-		func(c loadbalancer.LoadBalancerClient) workRequestsClient { return c },
-		func(c networkloadbalancer.NetworkLoadBalancerClient) networkLoadBalancerWorkRequestsClient { return c },
+		NewLoggingWorkRequestsWatcher,
+		func(c *RegionalLoadBalancerClient) workRequestsClient { return c },
+		func(c *RegionalNetworkLoadBalancerClient) networkLoadBalancerWorkRequestsClient { return c },
+		func(c *RegionalLoggingManagementClient) loggingWorkRequestsClient { return c },
 	)
 }
@@ -0,0 +1,57 @@
+package ociapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// withRetryToken sets the opc-retry-token of create requests that have none.
+//
+// The SDK generates a random token per call, so a create retried by a later reconcile,
+// e.g. after the request timed out while OCI still processed it, attempts a duplicate
+// creation and fails with a confusing conflict. The token derived here is deterministic
+// for the identity and the desired state of the created resource: OCI recognizes the
+// retry and returns the result of the original request instead. Changing the desired
+// state produces a new token.
+func withRetryToken[TRequest any](request TRequest) TRequest {
+	if !strings.HasPrefix(ociOperationName(request), "Create") {
+		return request
+	}
+	requestValue := reflect.ValueOf(&request).Elem()
+	if requestValue.Kind() != reflect.Struct {
+		return request
+	}
+	tokenField := requestValue.FieldByName("OpcRetryToken")
+	if !tokenField.IsValid() || tokenField.Type() != reflect.TypeFor[*string]() || !tokenField.IsNil() {
+		return request
+	}
+	if token, ok := deterministicRetryToken(requestValue); ok {
+		tokenField.Set(reflect.ValueOf(&token))
+	}
+	return request
+}
+
+// deterministicRetryToken hashes the operation, path parameters and details of the request.
+// Per request metadata, like the request id and retry policy, is excluded.
+func deterministicRetryToken(requestValue reflect.Value) (string, bool) {
+	hash := sha256.New()
+	hash.Write([]byte(requestValue.Type().Name()))
+	for i := range requestValue.NumField() {
+		field := requestValue.Type().Field(i)
+		if !field.IsExported() || field.Name == "OpcRequestId" || field.Name == "OpcRetryToken" ||
+			field.Name == "RequestMetadata" {
+			continue
+		}
+		data, err := json.Marshal(requestValue.Field(i).Interface())
+		if err != nil {
+			return "", false
+		}
+		hash.Write([]byte(field.Name))
+		hash.Write(data)
+	}
+	// The hex encoded sha256 fits the 64 characters limit of OCI retry tokens
+	return hex.EncodeToString(hash.Sum(nil)), true
+}
@@ -0,0 +1,71 @@
+package ociapi
+
+import (
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRetryToken(t *testing.T) {
+	makeRequest := func() loadbalancer.CreateBackendSetRequest {
+		fake := faker.New()
+		return loadbalancer.CreateBackendSetRequest{
+			LoadBalancerId: new(fake.UUID().V4()),
+			CreateBackendSetDetails: loadbalancer.CreateBackendSetDetails{
+				Name:   new(fake.Internet().Slug()),
+				Policy: new("ROUND_ROBIN"),
+				HealthChecker: &loadbalancer.HealthCheckerDetails{
+					Protocol: new("TCP"),
+					Port:     new(fake.IntBetween(1, 65535)),
+				},
+			},
+		}
+	}
+
+	t.Run("should set deterministic token of create requests", func(t *testing.T) {
+		request := makeRequest()
+
+		first := withRetryToken(request)
+		second := withRetryToken(request)
+
+		require.NotNil(t, first.OpcRetryToken)
+		assert.Len(t, *first.OpcRetryToken, 64)
+		assert.Equal(t, first.OpcRetryToken, second.OpcRetryToken)
+		assert.Nil(t, request.OpcRetryToken)
+	})
+
+	t.Run("should ignore request id", func(t *testing.T) {
+		request := makeRequest()
+		withRequestID := request
+		withRequestID.OpcRequestId = new(faker.New().UUID().V4())
+
+		assert.Equal(t, withRetryToken(request).OpcRetryToken, withRetryToken(withRequestID).OpcRetryToken)
+	})
+
+	t.Run("should change token with desired state", func(t *testing.T) {
+		request := makeRequest()
+		changed := request
+		changed.Policy = new("IP_HASH")
+
+		assert.NotEqual(t, withRetryToken(request).OpcRetryToken, withRetryToken(changed).OpcRetryToken)
+	})
+
+	t.Run("should keep explicit token", func(t *testing.T) {
+		request := makeRequest()
+		request.OpcRetryToken = new(faker.New().UUID().V4())
+
+		assert.Equal(t, request, withRetryToken(request))
+	})
+
+	t.Run("should not set token of other requests", func(t *testing.T) {
+		request := loadbalancer.UpdateBackendSetRequest{
+			LoadBalancerId: new(faker.New().UUID().V4()),
+		}
+
+		assert.Nil(t, withRetryToken(request).OpcRetryToken)
+		assert.Equal(t, "value", withRetryToken("value"))
+	})
+}
@@ -0,0 +1,135 @@
+package ociapi
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// requestPriority is the priority of the mutating OCI request while OCI throttles the client.
+type requestPriority int
+
+const (
+	// priorityControlPlane requests program listeners, certificates, hostnames, rule sets,
+	// routing policies and backend sets themselves.
+	priorityControlPlane requestPriority = iota
+
+	// priorityBulk requests update backends of backend sets, e.g. on endpoint changes
+	// of a node drain. There may be hundreds of them at once.
+	priorityBulk
+)
+
+// operationPriority returns the priority of the mutating OCI operation.
+func operationPriority(operation string) requestPriority {
+	if operation == "UpdateBackendSet" || strings.HasSuffix(operation, "Backend") {
+		return priorityBulk
+	}
+	return priorityControlPlane
+}
+
+// throttleScheduler orders mutating OCI requests of a client once OCI throttled it, so
+// control plane mutations are not starved by bulk backend updates. Requests are not
+// delayed until OCI responds with 429, and again once no request was throttled for the
+// cooldown. While throttled, bulk requests wait until no control plane request is in
+// flight and are sent one at a time. A nil throttleScheduler does not delay requests.
+type throttleScheduler struct {
+	logger   *slog.Logger
+	cooldown time.Duration
+	now      func() time.Time
+
+	mu                   sync.Mutex
+	throttledUntil       time.Time
+	controlPlaneInFlight int
+	bulkInFlight         bool
+
+	// closed and replaced on each change of the in flight requests
+	changed chan struct{}
+}
+
+// newThrottleScheduler creates the scheduler of the client, nil if the cooldown is zero.
+func newThrottleScheduler(logger *slog.Logger, cooldown time.Duration) *throttleScheduler {
+	if cooldown <= 0 {
+		return nil
+	}
+	return &throttleScheduler{
+		logger:   logger,
+		cooldown: cooldown,
+		now:      time.Now,
+		changed:  make(chan struct{}),
+	}
+}
+
+func (s *throttleScheduler) notifyLocked() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// acquire waits until the request can be sent. The returned function must be called
+// once the response is received.
+func (s *throttleScheduler) acquire(ctx context.Context, request any) (func(), error) {
+	operation := ociOperationName(request)
+	if s == nil || !isMutatingOperation(operation) {
+		return func() {}, nil
+	}
+	if operationPriority(operation) == priorityControlPlane {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.controlPlaneInFlight++
+		return func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.controlPlaneInFlight--
+			s.notifyLocked()
+		}, nil
+	}
+
+	for {
+		s.mu.Lock()
+		throttledFor := s.throttledUntil.Sub(s.now())
+		if throttledFor <= 0 {
+			s.mu.Unlock()
+			return func() {}, nil
+		}
+		if s.controlPlaneInFlight == 0 && !s.bulkInFlight {
+			s.bulkInFlight = true
+			s.mu.Unlock()
+			return func() {
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				s.bulkInFlight = false
+				s.notifyLocked()
+			}, nil
+		}
+		changed := s.changed
+		s.mu.Unlock()
+
+		timer := time.NewTimer(throttledFor)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-changed:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+// observe extends the throttled period if OCI throttled the request.
+func (s *throttleScheduler) observe(ctx context.Context, request any, err error) {
+	if s == nil || ClassifyError(err) != ErrorClassThrottled {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	if !s.throttledUntil.After(now) {
+		s.logger.WarnContext(ctx, "OCI throttles requests, deferring backend updates",
+			slog.String("operation", ociOperationName(request)),
+			slog.Duration("cooldown", s.cooldown),
+		)
+	}
+	s.throttledUntil = now.Add(s.cooldown)
+}
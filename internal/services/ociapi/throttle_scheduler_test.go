@@ -0,0 +1,118 @@
+package ociapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+)
+
+func TestThrottleScheduler(t *testing.T) {
+	throttledErr := NewRandomServiceError(RandomServiceErrorWithStatusCode(http.StatusTooManyRequests))
+	newThrottledScheduler := func(t *testing.T) *throttleScheduler {
+		scheduler := newThrottleScheduler(diag.RootTestLogger(), time.Minute)
+		require.NotNil(t, scheduler)
+		scheduler.observe(t.Context(), loadbalancer.UpdateListenerRequest{}, throttledErr)
+		return scheduler
+	}
+
+	t.Run("classifies operations", func(t *testing.T) {
+		assert.Equal(t, priorityBulk, operationPriority("UpdateBackendSet"))
+		assert.Equal(t, priorityBulk, operationPriority("CreateBackend"))
+		assert.Equal(t, priorityBulk, operationPriority("DeleteBackend"))
+		assert.Equal(t, priorityControlPlane, operationPriority("CreateBackendSet"))
+		assert.Equal(t, priorityControlPlane, operationPriority("UpdateListener"))
+		assert.Equal(t, priorityControlPlane, operationPriority("CreateCertificate"))
+	})
+
+	t.Run("is disabled without cooldown", func(t *testing.T) {
+		assert.Nil(t, newThrottleScheduler(diag.RootTestLogger(), 0))
+
+		var scheduler *throttleScheduler
+		release, err := scheduler.acquire(t.Context(), loadbalancer.UpdateBackendSetRequest{})
+		require.NoError(t, err)
+		release()
+		scheduler.observe(t.Context(), loadbalancer.UpdateBackendSetRequest{}, throttledErr)
+	})
+
+	t.Run("does not delay requests if not throttled", func(t *testing.T) {
+		scheduler := newThrottleScheduler(diag.RootTestLogger(), time.Minute)
+		releaseListener, err := scheduler.acquire(t.Context(), loadbalancer.UpdateListenerRequest{})
+		require.NoError(t, err)
+		defer releaseListener()
+
+		releaseBackends, err := scheduler.acquire(t.Context(), loadbalancer.UpdateBackendSetRequest{})
+		require.NoError(t, err)
+		releaseBackends()
+	})
+
+	t.Run("does not delay reads while throttled", func(t *testing.T) {
+		scheduler := newThrottledScheduler(t)
+		releaseListener, err := scheduler.acquire(t.Context(), loadbalancer.UpdateListenerRequest{})
+		require.NoError(t, err)
+		defer releaseListener()
+
+		releaseRead, err := scheduler.acquire(t.Context(), loadbalancer.GetBackendSetRequest{})
+		require.NoError(t, err)
+		releaseRead()
+	})
+
+	t.Run("defers bulk requests until control plane requests complete", func(t *testing.T) {
+		scheduler := newThrottledScheduler(t)
+		releaseListener, err := scheduler.acquire(t.Context(), loadbalancer.UpdateListenerRequest{})
+		require.NoError(t, err)
+
+		acquired := make(chan struct{})
+		go func() {
+			releaseBackends, acquireErr := scheduler.acquire(
+				context.Background(), loadbalancer.UpdateBackendSetRequest{},
+			)
+			assert.NoError(t, acquireErr)
+			close(acquired)
+			releaseBackends()
+		}()
+
+		select {
+		case <-acquired:
+			t.Fatal("bulk request was sent while control plane request is in flight")
+		case <-time.After(50 * time.Millisecond):
+		}
+		releaseListener()
+		select {
+		case <-acquired:
+		case <-time.After(time.Second):
+			t.Fatal("bulk request was not sent after control plane request completed")
+		}
+	})
+
+	t.Run("sends bulk requests one at a time while throttled", func(t *testing.T) {
+		scheduler := newThrottledScheduler(t)
+		releaseFirst, err := scheduler.acquire(t.Context(), loadbalancer.UpdateBackendSetRequest{})
+		require.NoError(t, err)
+		defer releaseFirst()
+
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		defer cancel()
+		_, err = scheduler.acquire(ctx, loadbalancer.CreateBackendRequest{})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("stops deferring bulk requests after cooldown", func(t *testing.T) {
+		scheduler := newThrottledScheduler(t)
+		now := time.Now()
+		scheduler.now = func() time.Time { return now.Add(2 * time.Minute) }
+		releaseListener, err := scheduler.acquire(t.Context(), loadbalancer.UpdateListenerRequest{})
+		require.NoError(t, err)
+		defer releaseListener()
+
+		releaseBackends, err := scheduler.acquire(t.Context(), loadbalancer.UpdateBackendSetRequest{})
+		require.NoError(t, err)
+		releaseBackends()
+	})
+}
@@ -0,0 +1,78 @@
+package ociapi
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/gemyago/oke-gateway-api/internal/services/ociapi"
+
+// ociOperationName returns the name of the OCI operation from the request type,
+// e.g. UpdateBackendSet for UpdateBackendSetRequest.
+func ociOperationName(request any) string {
+	return strings.TrimSuffix(reflect.TypeOf(request).Name(), "Request")
+}
+
+// startOCICallSpan starts the span of the OCI API call. The returned function ends the
+// span and records the OCI request and work request ids of the response.
+func startOCICallSpan(ctx context.Context, request any) (context.Context, func(response any, err error)) {
+	spanCtx, span := otel.Tracer(tracerName).Start(ctx, "oci."+ociOperationName(request),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("oci.region", RegionFromContext(ctx))),
+	)
+	if !span.SpanContext().IsValid() {
+		// Tracing is not enabled
+		return ctx, func(any, error) {}
+	}
+	return spanCtx, func(response any, err error) {
+		defer span.End()
+		if httpResponse, ok := response.(interface{ HTTPResponse() *http.Response }); ok {
+			if rawResponse := httpResponse.HTTPResponse(); rawResponse != nil {
+				span.SetAttributes(
+					attribute.Int("http.response.status_code", rawResponse.StatusCode),
+					attribute.String("oci.opc_request_id", rawResponse.Header.Get("opc-request-id")),
+				)
+				if workRequestID := rawResponse.Header.Get("opc-work-request-id"); workRequestID != "" {
+					span.SetAttributes(attribute.String("oci.work_request_id", workRequestID))
+				}
+			}
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+}
+
+// startWorkRequestWaitSpan starts the span of waiting for the work request. The returned
+// function ends the span and records the final status of the work request.
+func startWorkRequestWaitSpan(
+	ctx context.Context,
+	config workRequestWaitConfig,
+) (context.Context, func(status string, err error)) {
+	spanCtx, span := otel.Tracer(tracerName).Start(ctx, "oci.WaitForWorkRequest",
+		trace.WithAttributes(
+			attribute.String("oci.work_request_id", config.workRequestID),
+			attribute.String("oci.work_request_kind", config.description),
+		),
+	)
+	if !span.SpanContext().IsValid() {
+		// Tracing is not enabled
+		return ctx, func(string, error) {}
+	}
+	return spanCtx, func(status string, err error) {
+		defer span.End()
+		span.SetAttributes(attribute.String("oci.work_request_status", status))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package ociapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestTracing(t *testing.T) {
+	type testClient struct{}
+
+	enableTracing := func(t *testing.T) *tracetest.SpanRecorder {
+		t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+		recorder := tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+		return recorder
+	}
+
+	t.Run("ociOperationName", func(t *testing.T) {
+		assert.Equal(t, "UpdateBackendSet", ociOperationName(loadbalancer.UpdateBackendSetRequest{}))
+		assert.Equal(t, "GetWorkRequest", ociOperationName(loadbalancer.GetWorkRequestRequest{}))
+	})
+
+	t.Run("should keep context if tracing is not enabled", func(t *testing.T) {
+		clients := newRegionalClients(testClient{}, nil)
+
+		_, err := callInRegion(t.Context(), clients,
+			func(_ testClient, ctx context.Context, _ loadbalancer.GetLoadBalancerRequest) (
+				loadbalancer.GetLoadBalancerResponse, error,
+			) {
+				assert.Equal(t, t.Context(), ctx)
+				return loadbalancer.GetLoadBalancerResponse{}, nil
+			},
+			loadbalancer.GetLoadBalancerRequest{},
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should record span of OCI call", func(t *testing.T) {
+		recorder := enableTracing(t)
+		clients := newRegionalClients(testClient{}, nil)
+		opcRequestID := faker.New().UUID().V4()
+		workRequestID := faker.New().UUID().V4()
+		rawResponse := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+		rawResponse.Header.Set("opc-request-id", opcRequestID)
+		rawResponse.Header.Set("opc-work-request-id", workRequestID)
+
+		_, err := callInRegion(t.Context(), clients,
+			func(_ testClient, ctx context.Context, _ loadbalancer.UpdateBackendSetRequest) (
+				loadbalancer.UpdateBackendSetResponse, error,
+			) {
+				assert.True(t, trace.SpanContextFromContext(ctx).IsValid())
+				return loadbalancer.UpdateBackendSetResponse{RawResponse: rawResponse}, nil
+			},
+			loadbalancer.UpdateBackendSetRequest{},
+		)
+
+		require.NoError(t, err)
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "oci.UpdateBackendSet", spans[0].Name())
+		assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+		assert.Contains(t, spans[0].Attributes(), attribute.String("oci.opc_request_id", opcRequestID))
+		assert.Contains(t, spans[0].Attributes(), attribute.String("oci.work_request_id", workRequestID))
+		assert.Contains(t, spans[0].Attributes(), attribute.Int("http.response.status_code", http.StatusOK))
+	})
+
+	t.Run("should record error of OCI call", func(t *testing.T) {
+		recorder := enableTracing(t)
+		clients := newRegionalClients(testClient{}, nil)
+		wantErr := errors.New(faker.New().Lorem().Sentence(3))
+
+		_, err := callInRegion(t.Context(), clients,
+			func(testClient, context.Context, loadbalancer.GetLoadBalancerRequest) (
+				loadbalancer.GetLoadBalancerResponse, error,
+			) {
+				return loadbalancer.GetLoadBalancerResponse{}, wantErr
+			},
+			loadbalancer.GetLoadBalancerRequest{},
+		)
+
+		require.ErrorIs(t, err, wantErr)
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+	})
+
+	t.Run("should record span of work request wait", func(t *testing.T) {
+		recorder := enableTracing(t)
+		workRequestID := faker.New().UUID().V4()
+
+		err := waitForWorkRequest(t.Context(), workRequestWaitConfig{
+			workRequestID:   workRequestID,
+			pollInterval:    defaultPollInterval,
+			maxPollDuration: defaultMaxPollDuration,
+			description:     "work request",
+			getStatus: func(ctx context.Context) (workRequestState, error) {
+				assert.True(t, trace.SpanContextFromContext(ctx).IsValid())
+				return workRequestState{
+					status:    string(loadbalancer.WorkRequestLifecycleStateSucceeded),
+					succeeded: true,
+				}, nil
+			},
+		})
+
+		require.NoError(t, err)
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "oci.WaitForWorkRequest", spans[0].Name())
+		assert.Contains(t, spans[0].Attributes(), attribute.String("oci.work_request_id", workRequestID))
+		assert.Contains(t, spans[0].Attributes(),
+			attribute.String("oci.work_request_status", string(loadbalancer.WorkRequestLifecycleStateSucceeded)))
+	})
+}
@@ -0,0 +1,71 @@
+package ociapi
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// workRequestStatusUnknown is recorded if the status of the work request was never read.
+const workRequestStatusUnknown = "UNKNOWN"
+
+// WorkRequestRecord is an OCI work request the controller waited for and its outcome.
+type WorkRequestRecord struct {
+	ID        string    `json:"id"`
+	Operation string    `json:"operation,omitempty"`
+	Status    string    `json:"status"`
+	Time      time.Time `json:"time"`
+}
+
+// WorkRequestHistory collects work requests awaited with the context it was attached to,
+// e.g. by a single reconcile. It is safe for concurrent use.
+type WorkRequestHistory struct {
+	mu      sync.Mutex
+	records []WorkRequestRecord
+}
+
+type workRequestHistoryContextKey struct{}
+
+// WithWorkRequestHistory returns the context that collects awaited work requests to the returned history.
+func WithWorkRequestHistory(ctx context.Context) (context.Context, *WorkRequestHistory) {
+	history := &WorkRequestHistory{}
+	return context.WithValue(ctx, workRequestHistoryContextKey{}, history), history
+}
+
+// WorkRequestHistoryFromContext returns the history attached to the context with WithWorkRequestHistory.
+func WorkRequestHistoryFromContext(ctx context.Context) (*WorkRequestHistory, bool) {
+	history, ok := ctx.Value(workRequestHistoryContextKey{}).(*WorkRequestHistory)
+	return history, ok
+}
+
+// Add appends the record to the history.
+func (h *WorkRequestHistory) Add(record WorkRequestRecord) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, record)
+}
+
+// Records returns the collected work requests in the order they were completed.
+func (h *WorkRequestHistory) Records() []WorkRequestRecord {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return slices.Clone(h.records)
+}
+
+func recordWorkRequest(ctx context.Context, workRequestID string, state workRequestState) {
+	history, ok := WorkRequestHistoryFromContext(ctx)
+	if !ok {
+		return
+	}
+	status := state.status
+	if status == "" {
+		status = workRequestStatusUnknown
+	}
+	history.Add(WorkRequestRecord{
+		ID:        workRequestID,
+		Operation: state.operation,
+		Status:    status,
+		Time:      time.Now().UTC().Truncate(time.Second),
+	})
+}
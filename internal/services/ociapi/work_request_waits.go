@@ -0,0 +1,124 @@
+package ociapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Kinds of the work requests reported by the in-flight gauge.
+const (
+	workRequestKindLoadBalancer        = "load_balancer"
+	workRequestKindNetworkLoadBalancer = "network_load_balancer"
+	workRequestKindLogging             = "logging"
+)
+
+// ErrWorkRequestWaitCancelled is the cause of the work request waits cancelled because
+// the resource that started them is deleted.
+var ErrWorkRequestWaitCancelled = errors.New("work request wait cancelled, resource is deleted")
+
+// WorkRequestWaitOwner is the resource a work request wait was started by, as attributed
+// by the AuditInitiator of the context.
+type WorkRequestWaitOwner struct {
+	Controller string
+	Namespace  string
+	Name       string
+}
+
+// WorkRequestWaits tracks work request waits in progress. It exposes the number of waits
+// in flight and cancels waits of the resources being deleted, e.g. when OCI keeps the load
+// balancer in the UPDATING state. A nil value is valid and tracks nothing.
+type WorkRequestWaits struct {
+	inFlight *prometheus.GaugeVec
+
+	mu      sync.Mutex
+	nextID  uint64
+	cancels map[WorkRequestWaitOwner]map[uint64]context.CancelCauseFunc
+}
+
+// start tracks the wait of the work request of the kind. The returned context is cancelled
+// with ErrWorkRequestWaitCancelled if the owner of the wait is cancelled. The returned
+// function must be called once the wait is over.
+func (w *WorkRequestWaits) start(ctx context.Context, kind string) (context.Context, func()) {
+	if w == nil {
+		return ctx, func() {}
+	}
+	w.inFlight.WithLabelValues(kind).Inc()
+	initiator, ok := ctx.Value(auditInitiatorContextKey{}).(AuditInitiator)
+	if !ok {
+		return ctx, func() { w.inFlight.WithLabelValues(kind).Dec() }
+	}
+
+	owner := WorkRequestWaitOwner{
+		Controller: initiator.Controller,
+		Namespace:  initiator.Namespace,
+		Name:       initiator.Name,
+	}
+	waitCtx, cancel := context.WithCancelCause(ctx)
+	w.mu.Lock()
+	w.nextID++
+	id := w.nextID
+	if w.cancels[owner] == nil {
+		w.cancels[owner] = make(map[uint64]context.CancelCauseFunc)
+	}
+	w.cancels[owner][id] = cancel
+	w.mu.Unlock()
+
+	return waitCtx, func() {
+		w.mu.Lock()
+		delete(w.cancels[owner], id)
+		if len(w.cancels[owner]) == 0 {
+			delete(w.cancels, owner)
+		}
+		w.mu.Unlock()
+		cancel(nil)
+		w.inFlight.WithLabelValues(kind).Dec()
+	}
+}
+
+// CancelOwner cancels the waits in progress started by the owner and returns their number.
+func (w *WorkRequestWaits) CancelOwner(owner WorkRequestWaitOwner) int {
+	if w == nil {
+		return 0
+	}
+	w.mu.Lock()
+	cancels := w.cancels[owner]
+	delete(w.cancels, owner)
+	w.mu.Unlock()
+
+	for _, cancel := range cancels {
+		cancel(ErrWorkRequestWaitCancelled)
+	}
+	return len(cancels)
+}
+
+// NewWorkRequestWaits creates the tracker of the work request waits and registers the
+// in-flight gauge with the controller-runtime metrics registry.
+func NewWorkRequestWaits() (*WorkRequestWaits, error) {
+	inFlight := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "oke_gateway",
+		Subsystem: "oci",
+		Name:      "work_requests_in_flight",
+		Help:      "Number of OCI work requests the controller is waiting for.",
+	}, []string{"kind"})
+	if err := metrics.Registry.Register(inFlight); err != nil {
+		var alreadyRegistered prometheus.AlreadyRegisteredError
+		if !errors.As(err, &alreadyRegistered) {
+			return nil, fmt.Errorf("failed to register work request metrics: %w", err)
+		}
+		existing, ok := alreadyRegistered.ExistingCollector.(*prometheus.GaugeVec)
+		if !ok {
+			return nil, fmt.Errorf("unexpected metrics collector: %T", alreadyRegistered.ExistingCollector)
+		}
+		inFlight = existing
+	}
+
+	return &WorkRequestWaits{
+		inFlight: inFlight,
+		cancels:  make(map[WorkRequestWaitOwner]map[uint64]context.CancelCauseFunc),
+	}, nil
+}
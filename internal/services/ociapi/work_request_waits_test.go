@@ -0,0 +1,119 @@
+package ociapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+)
+
+type funcNetworkLoadBalancerWorkRequestsClient func(
+	ctx context.Context,
+) networkloadbalancer.OperationStatusEnum
+
+func (f funcNetworkLoadBalancerWorkRequestsClient) GetWorkRequest(
+	ctx context.Context,
+	_ networkloadbalancer.GetWorkRequestRequest,
+) (networkloadbalancer.GetWorkRequestResponse, error) {
+	return networkloadbalancer.GetWorkRequestResponse{
+		WorkRequest: networkloadbalancer.WorkRequest{Status: f(ctx)},
+	}, nil
+}
+
+func TestWorkRequestWaits(t *testing.T) {
+	newWatcher := func(
+		t *testing.T,
+		waits *WorkRequestWaits,
+		client funcNetworkLoadBalancerWorkRequestsClient,
+	) *NetworkLoadBalancerWorkRequestsWatcher {
+		t.Helper()
+		return NewNetworkLoadBalancerWorkRequestsWatcher(NetworkLoadBalancerWorkRequestsWatcherDeps{
+			Client:       client,
+			RootLogger:   diag.RootTestLogger(),
+			Waits:        waits,
+			pollInterval: time.Hour,
+		})
+	}
+
+	makeOwner := func() WorkRequestWaitOwner {
+		fake := faker.New()
+		return WorkRequestWaitOwner{
+			Controller: "Gateway",
+			Namespace:  fake.Lorem().Word(),
+			Name:       fake.UUID().V4(),
+		}
+	}
+
+	withOwner := func(ctx context.Context, owner WorkRequestWaitOwner) context.Context {
+		return WithAuditInitiator(ctx, AuditInitiator{
+			Controller: owner.Controller,
+			Namespace:  owner.Namespace,
+			Name:       owner.Name,
+		})
+	}
+
+	t.Run("reports waits in flight", func(t *testing.T) {
+		waits, err := NewWorkRequestWaits()
+		require.NoError(t, err)
+		inFlight := waits.inFlight.WithLabelValues(workRequestKindNetworkLoadBalancer)
+		before := testutil.ToFloat64(inFlight)
+		var during float64
+		watcher := newWatcher(t, waits, func(context.Context) networkloadbalancer.OperationStatusEnum {
+			during = testutil.ToFloat64(inFlight)
+			return networkloadbalancer.OperationStatusSucceeded
+		})
+
+		require.NoError(t, watcher.WaitFor(withOwner(t.Context(), makeOwner()), faker.New().UUID().V4()))
+
+		assert.InDelta(t, before+1, during, 0)
+		assert.InDelta(t, before, testutil.ToFloat64(inFlight), 0)
+		assert.Empty(t, waits.cancels)
+	})
+
+	t.Run("cancels waits of the owner", func(t *testing.T) {
+		waits, err := NewWorkRequestWaits()
+		require.NoError(t, err)
+		owner := makeOwner()
+		var cancelled int
+		watcher := newWatcher(t, waits, func(context.Context) networkloadbalancer.OperationStatusEnum {
+			assert.Zero(t, waits.CancelOwner(makeOwner()))
+			cancelled = waits.CancelOwner(owner)
+			return networkloadbalancer.OperationStatusInProgress
+		})
+
+		err = watcher.WaitFor(withOwner(t.Context(), owner), faker.New().UUID().V4())
+
+		require.ErrorIs(t, err, ErrWorkRequestWaitCancelled)
+		assert.Equal(t, 1, cancelled)
+		assert.Empty(t, waits.cancels)
+	})
+
+	t.Run("does not cancel waits without owner", func(t *testing.T) {
+		waits, err := NewWorkRequestWaits()
+		require.NoError(t, err)
+		watcher := newWatcher(t, waits, func(ctx context.Context) networkloadbalancer.OperationStatusEnum {
+			assert.Empty(t, waits.cancels)
+			require.NoError(t, ctx.Err())
+			return networkloadbalancer.OperationStatusSucceeded
+		})
+
+		require.NoError(t, watcher.WaitFor(t.Context(), faker.New().UUID().V4()))
+	})
+
+	t.Run("nil value tracks nothing", func(t *testing.T) {
+		var waits *WorkRequestWaits
+		watcher := newWatcher(t, waits, func(context.Context) networkloadbalancer.OperationStatusEnum {
+			return networkloadbalancer.OperationStatusSucceeded
+		})
+
+		require.NoError(t, watcher.WaitFor(withOwner(t.Context(), makeOwner()), faker.New().UUID().V4()))
+		assert.Zero(t, waits.CancelOwner(makeOwner()))
+	})
+}
@@ -2,12 +2,15 @@ package ociapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/logging"
 	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/samber/lo"
 	"go.uber.org/dig"
 )
 
@@ -24,6 +27,7 @@ type workRequestsClient interface {
 type WorkRequestsWatcher struct {
 	client          workRequestsClient
 	logger          *slog.Logger
+	waits           *WorkRequestWaits
 	pollInterval    time.Duration
 	maxPollDuration time.Duration
 }
@@ -40,6 +44,24 @@ type networkLoadBalancerWorkRequestsClient interface {
 type NetworkLoadBalancerWorkRequestsWatcher struct {
 	client          networkLoadBalancerWorkRequestsClient
 	logger          *slog.Logger
+	waits           *WorkRequestWaits
+	pollInterval    time.Duration
+	maxPollDuration time.Duration
+}
+
+// loggingWorkRequestsClient defines OCI Logging work request operations.
+type loggingWorkRequestsClient interface {
+	GetWorkRequest(
+		ctx context.Context,
+		request logging.GetWorkRequestRequest,
+	) (logging.GetWorkRequestResponse, error)
+}
+
+// LoggingWorkRequestsWatcher watches OCI Logging work requests.
+type LoggingWorkRequestsWatcher struct {
+	client          loggingWorkRequestsClient
+	logger          *slog.Logger
+	waits           *WorkRequestWaits
 	pollInterval    time.Duration
 	maxPollDuration time.Duration
 }
@@ -49,6 +71,10 @@ type WorkRequestsWatcherDeps struct {
 
 	Client     workRequestsClient
 	RootLogger *slog.Logger
+	Waits      *WorkRequestWaits `optional:"true"`
+
+	// Timeout of a single work request wait
+	Timeout time.Duration `name:"config.ociapi.workRequestTimeout"`
 
 	pollInterval    time.Duration
 	maxPollDuration time.Duration
@@ -59,6 +85,24 @@ type NetworkLoadBalancerWorkRequestsWatcherDeps struct {
 
 	Client     networkLoadBalancerWorkRequestsClient
 	RootLogger *slog.Logger
+	Waits      *WorkRequestWaits `optional:"true"`
+
+	// Timeout of a single work request wait
+	Timeout time.Duration `name:"config.ociapi.workRequestTimeout"`
+
+	pollInterval    time.Duration
+	maxPollDuration time.Duration
+}
+
+type LoggingWorkRequestsWatcherDeps struct {
+	dig.In `ignore-unexported:"true"`
+
+	Client     loggingWorkRequestsClient
+	RootLogger *slog.Logger
+	Waits      *WorkRequestWaits `optional:"true"`
+
+	// Timeout of a single work request wait
+	Timeout time.Duration `name:"config.ociapi.workRequestTimeout"`
 
 	pollInterval    time.Duration
 	maxPollDuration time.Duration
@@ -72,6 +116,9 @@ func NewWorkRequestsWatcher(deps WorkRequestsWatcherDeps) *WorkRequestsWatcher {
 		deps.pollInterval = defaultPollInterval
 	}
 
+	if deps.maxPollDuration == 0 {
+		deps.maxPollDuration = deps.Timeout
+	}
 	if deps.maxPollDuration == 0 {
 		deps.maxPollDuration = defaultMaxPollDuration
 	}
@@ -79,6 +126,7 @@ func NewWorkRequestsWatcher(deps WorkRequestsWatcherDeps) *WorkRequestsWatcher {
 	return &WorkRequestsWatcher{
 		client:          deps.Client,
 		logger:          deps.RootLogger.WithGroup("oci-work-requests"),
+		waits:           deps.Waits,
 		pollInterval:    deps.pollInterval,
 		maxPollDuration: deps.maxPollDuration,
 	}
@@ -91,6 +139,9 @@ func NewNetworkLoadBalancerWorkRequestsWatcher(
 		deps.pollInterval = defaultPollInterval
 	}
 
+	if deps.maxPollDuration == 0 {
+		deps.maxPollDuration = deps.Timeout
+	}
 	if deps.maxPollDuration == 0 {
 		deps.maxPollDuration = defaultMaxPollDuration
 	}
@@ -98,6 +149,28 @@ func NewNetworkLoadBalancerWorkRequestsWatcher(
 	return &NetworkLoadBalancerWorkRequestsWatcher{
 		client:          deps.Client,
 		logger:          deps.RootLogger.WithGroup("oci-network-load-balancer-work-requests"),
+		waits:           deps.Waits,
+		pollInterval:    deps.pollInterval,
+		maxPollDuration: deps.maxPollDuration,
+	}
+}
+
+func NewLoggingWorkRequestsWatcher(deps LoggingWorkRequestsWatcherDeps) *LoggingWorkRequestsWatcher {
+	if deps.pollInterval == 0 {
+		deps.pollInterval = defaultPollInterval
+	}
+
+	if deps.maxPollDuration == 0 {
+		deps.maxPollDuration = deps.Timeout
+	}
+	if deps.maxPollDuration == 0 {
+		deps.maxPollDuration = defaultMaxPollDuration
+	}
+
+	return &LoggingWorkRequestsWatcher{
+		client:          deps.Client,
+		logger:          deps.RootLogger.WithGroup("oci-logging-work-requests"),
+		waits:           deps.Waits,
 		pollInterval:    deps.pollInterval,
 		maxPollDuration: deps.maxPollDuration,
 	}
@@ -111,20 +184,23 @@ func (w *WorkRequestsWatcher) WaitFor(ctx context.Context, workRequestID string)
 
 	return waitForWorkRequest(ctx, workRequestWaitConfig{
 		logger:          w.logger,
+		waits:           w.waits,
 		workRequestID:   workRequestID,
 		pollInterval:    w.pollInterval,
 		maxPollDuration: w.maxPollDuration,
 		description:     "work request",
-		getStatus: func() (string, bool, bool, error) {
-			response, err := w.client.GetWorkRequest(ctx, request)
+		kind:            workRequestKindLoadBalancer,
+		getStatus: func(pollCtx context.Context) (workRequestState, error) {
+			response, err := w.client.GetWorkRequest(pollCtx, request)
 			if err != nil {
-				return "", false, false, fmt.Errorf("failed to get work request %s: %w", workRequestID, err)
+				return workRequestState{}, fmt.Errorf("failed to get work request %s: %w", workRequestID, err)
 			}
-			status := string(response.WorkRequest.LifecycleState)
-			return status,
-				response.WorkRequest.LifecycleState == loadbalancer.WorkRequestLifecycleStateSucceeded,
-				response.WorkRequest.LifecycleState == loadbalancer.WorkRequestLifecycleStateFailed,
-				nil
+			return workRequestState{
+				status:    string(response.WorkRequest.LifecycleState),
+				operation: lo.FromPtr(response.WorkRequest.Type),
+				succeeded: response.WorkRequest.LifecycleState == loadbalancer.WorkRequestLifecycleStateSucceeded,
+				failed:    response.WorkRequest.LifecycleState == loadbalancer.WorkRequestLifecycleStateFailed,
+			}, nil
 		},
 	})
 }
@@ -137,68 +213,142 @@ func (w *NetworkLoadBalancerWorkRequestsWatcher) WaitFor(ctx context.Context, wo
 
 	return waitForWorkRequest(ctx, workRequestWaitConfig{
 		logger:          w.logger,
+		waits:           w.waits,
 		workRequestID:   workRequestID,
 		pollInterval:    w.pollInterval,
 		maxPollDuration: w.maxPollDuration,
 		description:     "network load balancer work request",
-		getStatus: func() (string, bool, bool, error) {
-			response, err := w.client.GetWorkRequest(ctx, request)
+		kind:            workRequestKindNetworkLoadBalancer,
+		getStatus: func(pollCtx context.Context) (workRequestState, error) {
+			response, err := w.client.GetWorkRequest(pollCtx, request)
 			if err != nil {
-				return "", false, false, fmt.Errorf(
+				return workRequestState{}, fmt.Errorf(
 					"failed to get network load balancer work request %s: %w",
 					workRequestID,
 					err,
 				)
 			}
-			status := string(response.WorkRequest.Status)
-			return status,
-				response.WorkRequest.Status == networkloadbalancer.OperationStatusSucceeded,
-				response.WorkRequest.Status == networkloadbalancer.OperationStatusFailed,
-				nil
+			return workRequestState{
+				status:    string(response.WorkRequest.Status),
+				operation: string(response.WorkRequest.OperationType),
+				succeeded: response.WorkRequest.Status == networkloadbalancer.OperationStatusSucceeded,
+				failed:    response.WorkRequest.Status == networkloadbalancer.OperationStatusFailed,
+			}, nil
+		},
+	})
+}
+
+// WaitFor waits for an OCI Logging work request to succeed.
+func (w *LoggingWorkRequestsWatcher) WaitFor(ctx context.Context, workRequestID string) error {
+	request := logging.GetWorkRequestRequest{
+		WorkRequestId: &workRequestID,
+	}
+
+	return waitForWorkRequest(ctx, workRequestWaitConfig{
+		logger:          w.logger,
+		waits:           w.waits,
+		workRequestID:   workRequestID,
+		pollInterval:    w.pollInterval,
+		maxPollDuration: w.maxPollDuration,
+		description:     "logging work request",
+		kind:            workRequestKindLogging,
+		getStatus: func(pollCtx context.Context) (workRequestState, error) {
+			response, err := w.client.GetWorkRequest(pollCtx, request)
+			if err != nil {
+				return workRequestState{}, fmt.Errorf("failed to get logging work request %s: %w", workRequestID, err)
+			}
+			return workRequestState{
+				status:    string(response.WorkRequest.Status),
+				operation: string(response.WorkRequest.OperationType),
+				succeeded: response.WorkRequest.Status == logging.OperationStatusSucceeded,
+				failed:    response.WorkRequest.Status == logging.OperationStatusFailed,
+			}, nil
 		},
 	})
 }
 
 type workRequestWaitConfig struct {
 	logger          *slog.Logger
+	waits           *WorkRequestWaits
 	workRequestID   string
 	pollInterval    time.Duration
 	maxPollDuration time.Duration
 	description     string
-	getStatus       func() (status string, succeeded bool, failed bool, err error)
+	kind            string
+	getStatus       func(ctx context.Context) (workRequestState, error)
+}
+
+// workRequestState is the state of the work request reported by OCI.
+type workRequestState struct {
+	status    string
+	operation string
+	succeeded bool
+	failed    bool
 }
 
 func waitForWorkRequest(ctx context.Context, config workRequestWaitConfig) error {
+	waitCtx, done := config.waits.start(ctx, config.kind)
+	defer done()
+
+	spanCtx, endSpan := startWorkRequestWaitSpan(waitCtx, config)
+	state, err := pollWorkRequest(spanCtx, config)
+	endSpan(state.status, err)
+	recordWorkRequest(ctx, config.workRequestID, state)
+	return err
+}
+
+func pollWorkRequest(ctx context.Context, config workRequestWaitConfig) (workRequestState, error) {
 	intervalTicker := time.NewTicker(config.pollInterval)
 	defer intervalTicker.Stop()
 
 	deadlineTicker := time.NewTimer(config.maxPollDuration)
 	defer deadlineTicker.Stop()
 
+	var state workRequestState
 	for {
-		status, succeeded, failed, err := config.getStatus()
+		current, err := config.getStatus(ctx)
 		if err != nil {
-			return err
+			if cancelledErr := waitCancelledError(ctx, config); cancelledErr != nil {
+				return state, cancelledErr
+			}
+			// The last known state is reported if the status can not be read
+			return state, err
 		}
-		if succeeded {
-			return nil
+		state = current
+		if state.succeeded {
+			return state, nil
 		}
-		if failed {
-			return fmt.Errorf("%s %s is in %s state", config.description, config.workRequestID, status)
+		if state.failed {
+			return state, fmt.Errorf("%s %s is in %s state", config.description, config.workRequestID, state.status)
 		}
 
 		config.logger.DebugContext(
 			ctx, config.description+" is in progress",
 			slog.String("workRequestID", config.workRequestID),
-			slog.String("status", status),
+			slog.String("status", state.status),
 		)
 
 		select {
 		case <-intervalTicker.C:
 		case <-deadlineTicker.C:
-			return fmt.Errorf("%s %s timed out: %w", config.description, config.workRequestID, context.DeadlineExceeded)
+			return state, fmt.Errorf(
+				"%s %s timed out: %w", config.description, config.workRequestID, context.DeadlineExceeded,
+			)
 		case <-ctx.Done():
-			return fmt.Errorf("%s %s timed out: %w", config.description, config.workRequestID, context.Canceled)
+			if cancelledErr := waitCancelledError(ctx, config); cancelledErr != nil {
+				return state, cancelledErr
+			}
+			return state, fmt.Errorf("%s %s timed out: %w", config.description, config.workRequestID, context.Canceled)
 		}
 	}
 }
+
+// waitCancelledError returns the error of the wait cancelled because its owner is deleted,
+// nil if the context was not cancelled for this reason.
+func waitCancelledError(ctx context.Context, config workRequestWaitConfig) error {
+	cause := context.Cause(ctx)
+	if !errors.Is(cause, ErrWorkRequestWaitCancelled) {
+		return nil
+	}
+	return fmt.Errorf("%s %s: %w", config.description, config.workRequestID, cause)
+}
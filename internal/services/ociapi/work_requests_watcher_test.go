@@ -9,7 +9,9 @@ import (
 
 	"github.com/jaswdr/faker/v2"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/logging"
 	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/gemyago/oke-gateway-api/internal/diag"
@@ -34,6 +36,21 @@ func (s *stubNetworkLoadBalancerWorkRequestsClient) GetWorkRequest(
 	return response, nil
 }
 
+type stubLoggingWorkRequestsClient struct {
+	responses []logging.GetWorkRequestResponse
+	requests  []logging.GetWorkRequestRequest
+}
+
+func (s *stubLoggingWorkRequestsClient) GetWorkRequest(
+	_ context.Context,
+	request logging.GetWorkRequestRequest,
+) (logging.GetWorkRequestResponse, error) {
+	s.requests = append(s.requests, request)
+	response := s.responses[0]
+	s.responses = s.responses[1:]
+	return response, nil
+}
+
 func TestWorkRequestsWatcher(t *testing.T) {
 	newMockDeps := func(t *testing.T) WorkRequestsWatcherDeps {
 		return WorkRequestsWatcherDeps{
@@ -83,6 +100,43 @@ func TestWorkRequestsWatcher(t *testing.T) {
 			require.NoError(t, err)
 		})
 
+		t.Run("records work requests in history of the context", func(t *testing.T) {
+			fake := faker.New()
+			deps := newMockDeps(t)
+			w := NewWorkRequestsWatcher(deps)
+			succeededID := fake.UUID().V4()
+			failedID := fake.UUID().V4()
+			mockClient, _ := deps.Client.(*MockworkRequestsClient)
+			historyCtx, history := WithWorkRequestHistory(t.Context())
+
+			mockClient.EXPECT().GetWorkRequest(
+				historyCtx,
+				loadbalancer.GetWorkRequestRequest{WorkRequestId: &succeededID},
+			).Return(loadbalancer.GetWorkRequestResponse{WorkRequest: loadbalancer.WorkRequest{
+				Type:           new("CreateListener"),
+				LifecycleState: loadbalancer.WorkRequestLifecycleStateSucceeded,
+			}}, nil).Once()
+			mockClient.EXPECT().GetWorkRequest(
+				historyCtx,
+				loadbalancer.GetWorkRequestRequest{WorkRequestId: &failedID},
+			).Return(loadbalancer.GetWorkRequestResponse{}, errors.New(fake.Lorem().Sentence(3))).Once()
+
+			require.NoError(t, w.WaitFor(historyCtx, succeededID))
+			require.Error(t, w.WaitFor(historyCtx, failedID))
+
+			records := history.Records()
+			require.Len(t, records, 2)
+			assert.Equal(t, succeededID, records[0].ID)
+			assert.Equal(t, "CreateListener", records[0].Operation)
+			assert.Equal(t, string(loadbalancer.WorkRequestLifecycleStateSucceeded), records[0].Status)
+			assert.WithinDuration(t, time.Now(), records[0].Time, time.Minute)
+			assert.Equal(t, WorkRequestRecord{
+				ID:     failedID,
+				Status: workRequestStatusUnknown,
+				Time:   records[1].Time,
+			}, records[1])
+		})
+
 		errorStates := []loadbalancer.WorkRequestLifecycleStateEnum{
 			loadbalancer.WorkRequestLifecycleStateFailed,
 		}
@@ -306,3 +360,53 @@ func TestNetworkLoadBalancerWorkRequestsWatcher(t *testing.T) {
 		}
 	})
 }
+
+func TestLoggingWorkRequestsWatcher(t *testing.T) {
+	makeResponse := func(status logging.OperationStatusEnum) logging.GetWorkRequestResponse {
+		return logging.GetWorkRequestResponse{
+			WorkRequest: logging.WorkRequest{
+				Status: status,
+			},
+		}
+	}
+
+	t.Run("WaitFor succeeds using logging work request client", func(t *testing.T) {
+		client := &stubLoggingWorkRequestsClient{
+			responses: []logging.GetWorkRequestResponse{
+				makeResponse(logging.OperationStatusAccepted),
+				makeResponse(logging.OperationStatusInProgress),
+				makeResponse(logging.OperationStatusSucceeded),
+			},
+		}
+		watcher := NewLoggingWorkRequestsWatcher(LoggingWorkRequestsWatcherDeps{
+			Client:       client,
+			RootLogger:   diag.RootTestLogger(),
+			pollInterval: 1 * time.Millisecond,
+		})
+		workRequestID := faker.New().UUID().V4()
+
+		err := watcher.WaitFor(t.Context(), workRequestID)
+
+		require.NoError(t, err)
+		require.Len(t, client.requests, 3)
+		require.Equal(t, workRequestID, *client.requests[0].WorkRequestId)
+	})
+
+	t.Run("WaitFor returns logging failure states", func(t *testing.T) {
+		client := &stubLoggingWorkRequestsClient{
+			responses: []logging.GetWorkRequestResponse{
+				makeResponse(logging.OperationStatusFailed),
+			},
+		}
+		watcher := NewLoggingWorkRequestsWatcher(LoggingWorkRequestsWatcherDeps{
+			Client:       client,
+			RootLogger:   diag.RootTestLogger(),
+			pollInterval: 1 * time.Millisecond,
+		})
+
+		err := watcher.WaitFor(t.Context(), faker.New().UUID().V4())
+
+		require.ErrorContains(t, err, "logging work request")
+		require.ErrorContains(t, err, string(logging.OperationStatusFailed))
+	})
+}
@@ -14,5 +14,6 @@ func Register(container *dig.Container) error {
 		di.ProvideAs[TimeProviderFn, TimeProvider],
 		di.ProvideValue(time.NewTicker),
 		NewShutdownHooks,
+		NewTracerProvider,
 	)
 }
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/dig"
+)
+
+const (
+	tracingServiceName = "oke-gateway-api"
+	percentBase        = 100
+)
+
+type TracerProviderDeps struct {
+	dig.In
+
+	RootLogger    *slog.Logger
+	ShutdownHooks *ShutdownHooks
+
+	// OTLP gRPC endpoint of the traces collector, e.g. otel-collector:4317.
+	// Tracing is disabled if empty.
+	OTLPEndpoint string `name:"config.tracing.otlpEndpoint"`
+
+	// Disables TLS of the OTLP exporter
+	Insecure bool `name:"config.tracing.insecure"`
+
+	// Percentage of root spans to sample
+	SamplePercent int `name:"config.tracing.samplePercent"`
+}
+
+// NewTracerProvider creates the OpenTelemetry tracer provider exporting spans via OTLP
+// and installs it globally, so spans started with otel.Tracer are exported.
+// Returns nil if the OTLP endpoint is not configured, the global provider is noop then.
+func NewTracerProvider(deps TracerProviderDeps) (*sdktrace.TracerProvider, error) {
+	if deps.OTLPEndpoint == "" {
+		return nil, nil //nolint:nilnil // tracing is disabled
+	}
+
+	exporterOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(deps.OTLPEndpoint)}
+	if deps.Insecure {
+		exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(context.Background(), exporterOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(
+			sdktrace.TraceIDRatioBased(float64(deps.SamplePercent)/percentBase),
+		)),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", tracingServiceName),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	deps.ShutdownHooks.Register("tracer-provider", provider.Shutdown)
+
+	deps.RootLogger.Info("Exporting traces via OTLP",
+		slog.String("endpoint", deps.OTLPEndpoint),
+		slog.Int("samplePercent", deps.SamplePercent),
+	)
+	return provider, nil
+}
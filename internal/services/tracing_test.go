@@ -0,0 +1,48 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/gemyago/oke-gateway-api/internal/diag"
+)
+
+func TestNewTracerProvider(t *testing.T) {
+	t.Run("should not create provider if endpoint is not configured", func(t *testing.T) {
+		hooks := NewTestShutdownHooks()
+
+		provider, err := NewTracerProvider(TracerProviderDeps{
+			RootLogger:    diag.RootTestLogger(),
+			ShutdownHooks: hooks,
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, provider)
+		assert.Empty(t, hooks.hooks)
+	})
+
+	t.Run("should install global provider and register shutdown", func(t *testing.T) {
+		t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+		hooks := NewTestShutdownHooks()
+
+		provider, err := NewTracerProvider(TracerProviderDeps{
+			RootLogger:    diag.RootTestLogger(),
+			ShutdownHooks: hooks,
+			OTLPEndpoint:  faker.New().Internet().Domain() + ":4317",
+			Insecure:      true,
+			SamplePercent: 50,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, provider)
+		assert.Same(t, provider, otel.GetTracerProvider())
+		require.Len(t, hooks.hooks, 1)
+		assert.Equal(t, "tracer-provider", hooks.hooks[0].name)
+		require.NoError(t, hooks.PerformShutdown(t.Context()))
+	})
+}
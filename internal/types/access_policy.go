@@ -0,0 +1,40 @@
+package types
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OkeAccessPolicy is the Schema for the oke-access-policies API. It restricts
+// source IP addresses allowed to reach the targeted Gateway listeners.
+type OkeAccessPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec OkeAccessPolicySpec `json:"spec"`
+}
+
+// OkeAccessPolicySpec defines the desired state of OkeAccessPolicy.
+type OkeAccessPolicySpec struct {
+	// TargetRefs identifies Gateways the policy applies to. If sectionName is set, the policy
+	// applies to the listener with that name only, otherwise to all listeners of the Gateway.
+	// +required
+	TargetRefs []gatewayv1.LocalPolicyTargetReferenceWithSectionName `json:"targetRefs"`
+
+	// AllowedSourceCIDRs is a list of CIDR blocks allowed to reach the listeners.
+	// Requests from other addresses are rejected by the load balancer.
+	// +required
+	AllowedSourceCIDRs []string `json:"allowedSourceCidrs"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OkeAccessPolicyList contains a list of OkeAccessPolicy.
+type OkeAccessPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []OkeAccessPolicy `json:"items"`
+}
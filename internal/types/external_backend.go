@@ -0,0 +1,85 @@
+package types
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OkeExternalBackend is the Schema for the oke-external-backends API. It describes
+// endpoints outside of the cluster that routes can reference via backendRefs.
+type OkeExternalBackend struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec OkeExternalBackendSpec `json:"spec"`
+}
+
+// OkeExternalBackendSpec defines the desired state of OkeExternalBackend.
+type OkeExternalBackendSpec struct {
+	// Endpoints is a list of IP:port pairs to register as load balancer backends
+	// +required
+	Endpoints []OkeExternalBackendEndpoint `json:"endpoints"`
+
+	// HealthCheck configures the health checker of the backend set
+	// +optional
+	HealthCheck *OkeExternalBackendHealthCheck `json:"healthCheck,omitempty"`
+}
+
+// OkeExternalBackendEndpoint defines a single external endpoint.
+type OkeExternalBackendEndpoint struct {
+	// IP is the IP address of the endpoint
+	// +required
+	IP string `json:"ip"`
+
+	// Port is the port of the endpoint
+	// +required
+	Port int32 `json:"port"`
+}
+
+// OkeExternalBackendHealthCheck defines the health checker of the external backend set.
+type OkeExternalBackendHealthCheck struct {
+	// Protocol is the health check protocol, either TCP, HTTP or GRPC. Defaults to TCP.
+	// GRPC checks the HTTP health endpoint served on another port than the backend port,
+	// or the backend port with TCP.
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+
+	// Port is the health check port. Defaults to the port of the first endpoint.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// URLPath is the path of HTTP health check requests
+	// +optional
+	URLPath string `json:"urlPath,omitempty"`
+
+	// ReturnCode is the expected status code of HTTP health check responses
+	// +optional
+	ReturnCode int32 `json:"returnCode,omitempty"`
+
+	// ResponseBodyRegex is the regular expression HTTP health check response bodies must match
+	// +optional
+	ResponseBodyRegex string `json:"responseBodyRegex,omitempty"`
+
+	// IntervalMillis is the interval between health checks in milliseconds
+	// +optional
+	IntervalMillis int32 `json:"intervalMillis,omitempty"`
+
+	// TimeoutMillis is the health check timeout in milliseconds
+	// +optional
+	TimeoutMillis int32 `json:"timeoutMillis,omitempty"`
+
+	// Retries is the number of retries before the endpoint is considered unhealthy
+	// +optional
+	Retries int32 `json:"retries,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OkeExternalBackendList contains a list of OkeExternalBackend.
+type OkeExternalBackendList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []OkeExternalBackend `json:"items"`
+}
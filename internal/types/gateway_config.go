@@ -1,6 +1,7 @@
 package types
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -20,6 +21,238 @@ type GatewayConfigSpec struct {
 	// LoadBalancerID is the OCID of the OCI Load Balancer to be used by the gateway
 	// +required
 	LoadBalancerID string `json:"loadBalancerId"`
+
+	// InternalLoadBalancerID is the OCID of a private OCI Load Balancer programmed with the
+	// listeners marked as internal, so the gateway has both public and private entry points.
+	// +optional
+	InternalLoadBalancerID string `json:"internalLoadBalancerId,omitempty"`
+
+	// LoadBalancerType is the data plane of the load balancer: application for the OCI Load
+	// Balancer or network for the OCI Network Load Balancer. Gateways of a GatewayClass
+	// programming another type are not accepted. Any type is accepted if not set.
+	// +optional
+	LoadBalancerType string `json:"loadBalancerType,omitempty"`
+
+	// Region is the OCI region of the load balancer. Defaults to the region of the controller OCI config.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// CompartmentID is the OCID of the compartment for OCI resources created for the gateway
+	// (e.g. CA bundles). Defaults to the compartment of the GatewayClassConfig or the load balancer.
+	// +optional
+	CompartmentID string `json:"compartmentId,omitempty"`
+
+	// Logging configures OCI Logging for the load balancer access and error logs
+	// +optional
+	Logging *GatewayConfigLogging `json:"logging,omitempty"`
+
+	// NetworkSecurityGroup configures ingress rules of the load balancer NSG for the gateway listeners
+	// +optional
+	NetworkSecurityGroup *GatewayConfigNetworkSecurityGroup `json:"networkSecurityGroup,omitempty"`
+
+	// DNS configures OCI DNS records of the gateway listener hostnames
+	// +optional
+	DNS *GatewayConfigDNS `json:"dns,omitempty"`
+
+	// DefaultBackend configures the Service receiving requests not matched by any route.
+	// If not set, unmatched requests are forwarded to an empty backend set.
+	// +optional
+	DefaultBackend *GatewayConfigDefaultBackend `json:"defaultBackend,omitempty"`
+
+	// DefaultBackendSet configures the backend set receiving requests not matched by any route.
+	// If not set, the health check of the default backend set is derived from DefaultBackend,
+	// or is a TCP check of port 80 if DefaultBackend is not set either.
+	// +optional
+	DefaultBackendSet *GatewayConfigDefaultBackendSet `json:"defaultBackendSet,omitempty"`
+
+	// Listeners configures individual listeners of the gateway, matched by the listener name.
+	// +optional
+	Listeners []GatewayConfigListener `json:"listeners,omitempty"`
+
+	// SharedLoadBalancer indicates that the load balancer is shared with other gateways.
+	// Names of listeners, routing policies and the default backend set are then prefixed
+	// with a gateway specific prefix, and only resources with that prefix are removed.
+	// All gateways referencing the same load balancer must enable it.
+	// +optional
+	SharedLoadBalancer bool `json:"sharedLoadBalancer,omitempty"`
+
+	// AdoptedListeners are names of OCI listeners of the load balancer configured outside of
+	// the controller. They are not removed while missing in the gateway spec, so existing setups
+	// can be migrated to the gateway one listener at a time. A gateway listener programming
+	// the OCI listener of the same name takes it over.
+	// +optional
+	AdoptedListeners []string `json:"adoptedListeners,omitempty"`
+
+	// ListenerPolicyName is the name of the OkeListenerPolicy in the namespace of the GatewayConfig.
+	// Rules of the policy are applied to all HTTP and HTTPS listeners of the gateway.
+	// +optional
+	ListenerPolicyName string `json:"listenerPolicyName,omitempty"`
+
+	// FreeformTags are free-form tags of OCI resources created for the gateway (e.g. CA bundles).
+	// Merged with the tags of the GatewayClassConfig.
+	// +optional
+	FreeformTags map[string]string `json:"freeformTags,omitempty"`
+
+	// NamePrefix is the prefix of OCI listener, routing policy and default backend set names
+	// programmed for the gateway. Defaults to the prefix of the GatewayClassConfig.
+	// +optional
+	NamePrefix string `json:"namePrefix,omitempty"`
+
+	// Naming configures the naming scheme of OCI listeners and backend sets. Defaults to the naming
+	// of the GatewayClassConfig, or to the `<namespace>-<name>` scheme if not set there.
+	// +optional
+	Naming *OCIResourceNaming `json:"naming,omitempty"`
+
+	// BackendIPFamilies are IP families of endpoints registered as backends of the load balancer.
+	// Endpoints of other families are skipped. Defaults to IPv4. Set both IPv4 and IPv6
+	// to program dual-stack Services if the load balancer supports IPv6.
+	// +optional
+	BackendIPFamilies []corev1.IPFamily `json:"backendIpFamilies,omitempty"`
+
+	// BackendMode defines what is registered as backends of Service backendRefs.
+	// PodIP (default) registers pod IPs and requires VCN-native pod networking.
+	// NodePort registers node IPs with the Service nodePort.
+	// +optional
+	BackendMode string `json:"backendMode,omitempty"`
+
+	// BackendTopology configures topology aware registration of pod backends, so the load balancer
+	// prefers backends in its own availability domains.
+	// +optional
+	BackendTopology *GatewayConfigBackendTopology `json:"backendTopology,omitempty"`
+
+	// ScaleToZero configures backend sets of Services without ready endpoints. If not set,
+	// the backend set is emptied and the load balancer responds with 502.
+	// +optional
+	ScaleToZero *GatewayConfigScaleToZero `json:"scaleToZero,omitempty"`
+}
+
+// GatewayConfigScaleToZero defines the backends of Services scaled to zero.
+type GatewayConfigScaleToZero struct {
+	// Mode is Drain to keep the last known backends registered in the drain state,
+	// or Fallback to register the endpoints of the fallback Service until endpoints return.
+	// +required
+	Mode string `json:"mode"`
+
+	// Fallback is the Service registered in the Fallback mode
+	// +optional
+	Fallback *GatewayConfigDefaultBackend `json:"fallback,omitempty"`
+}
+
+// GatewayConfigBackendTopology defines how endpoint zones affect the backend registration.
+// Endpoints are local if their topology hints or zone match one of the zones.
+type GatewayConfigBackendTopology struct {
+	// Zones of the load balancer, for example availability domains, matched against the endpoint zones
+	// +required
+	Zones []string `json:"zones"`
+
+	// Mode is Prefer (default) to register local endpoints with a higher weight, or Restrict to
+	// register local endpoints only. All endpoints are registered if none of them is local.
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// LocalWeight is the weight of local endpoints in the Prefer mode. Defaults to 10.
+	// Other endpoints get the weight 1. Weights set with pod annotations take precedence.
+	// +optional
+	LocalWeight int `json:"localWeight,omitempty"`
+}
+
+// OCIResourceNaming defines the naming scheme of OCI listeners and backend sets programmed by the controller.
+// Names are built from parts, such as the namespace, name and port of the backend Service.
+type OCIResourceNaming struct {
+	// Separator joins the name parts. Defaults to "-".
+	// +optional
+	Separator string `json:"separator,omitempty"`
+
+	// HashSuffixLength is the number of hex characters of the name parts hash appended to the name,
+	// so parts containing the separator can not produce the same name. 0 disables the suffix.
+	// +optional
+	HashSuffixLength int `json:"hashSuffixLength,omitempty"`
+}
+
+// GatewayConfigDefaultBackend defines the Service that serves unmatched traffic of the gateway.
+type GatewayConfigDefaultBackend struct {
+	// ServiceName is the name of the Service in the namespace of the GatewayConfig
+	// +required
+	ServiceName string `json:"serviceName"`
+
+	// Port is the port of the Service
+	// +required
+	Port int32 `json:"port"`
+}
+
+// GatewayConfigDefaultBackendSet defines the backend set that serves unmatched traffic of the gateway.
+type GatewayConfigDefaultBackendSet struct {
+	// HealthCheck configures the health check of the default backend set, including its port and
+	// protocol. Unset port defaults to the port of DefaultBackend, or to 80 if it is not set.
+	// +optional
+	HealthCheck *OkeExternalBackendHealthCheck `json:"healthCheck,omitempty"`
+}
+
+// GatewayConfigListener defines options of a single listener of the gateway.
+type GatewayConfigListener struct {
+	// Name is the name of the Gateway listener
+	// +required
+	Name string `json:"name"`
+
+	// DefaultBackendSetName is the name of the OCI backend set receiving requests of the listener
+	// not matched by any route. The backend set must exist on the load balancer and is not managed
+	// by the controller. Defaults to the default backend set of the gateway.
+	// +optional
+	DefaultBackendSetName string `json:"defaultBackendSetName,omitempty"`
+
+	// Internal programs the listener on the load balancer referenced by InternalLoadBalancerID
+	// instead of the one referenced by LoadBalancerID. TLS listeners can not be internal.
+	// +optional
+	Internal bool `json:"internal,omitempty"`
+}
+
+// GatewayConfigNetworkSecurityGroup defines the network security group managed by the controller.
+type GatewayConfigNetworkSecurityGroup struct {
+	// ID is the OCID of the network security group attached to the load balancer
+	// +required
+	ID string `json:"id"`
+
+	// SourceCIDRs is a list of CIDR blocks allowed to reach the listeners. Defaults to 0.0.0.0/0.
+	// +optional
+	SourceCIDRs []string `json:"sourceCidrs,omitempty"`
+}
+
+// GatewayConfigDNS defines the OCI DNS zone holding the records of the listener hostnames.
+type GatewayConfigDNS struct {
+	// ZoneID is the OCID of the OCI DNS zone the listener hostname records are managed in
+	// +required
+	ZoneID string `json:"zoneId"`
+
+	// TTL is the time to live of the records in seconds. Defaults to 300.
+	// +optional
+	TTL int32 `json:"ttl,omitempty"`
+}
+
+// GatewayConfigLogging defines OCI Logging settings of the load balancer.
+type GatewayConfigLogging struct {
+	// LogGroupID is the OCID of the OCI Logging log group that holds the load balancer logs
+	// +required
+	LogGroupID string `json:"logGroupId"`
+
+	// AccessLog configures the load balancer access log
+	// +optional
+	AccessLog *GatewayConfigLog `json:"accessLog,omitempty"`
+
+	// ErrorLog configures the load balancer error log
+	// +optional
+	ErrorLog *GatewayConfigLog `json:"errorLog,omitempty"`
+}
+
+// GatewayConfigLog defines a single OCI Logging service log of the load balancer.
+type GatewayConfigLog struct {
+	// Enabled indicates if the log should be collected
+	// +required
+	Enabled bool `json:"enabled"`
+
+	// LogID is the OCID of an existing log to use. If not set, the log is looked up
+	// in the log group by the load balancer source and created if missing.
+	// +optional
+	LogID string `json:"logId,omitempty"`
 }
 
 // GatewayConfigStatus defines the observed state of GatewayConfig.
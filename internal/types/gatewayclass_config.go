@@ -0,0 +1,48 @@
+package types
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GatewayClassConfig is the Schema for the gatewayclassconfigs API.
+// It is cluster scoped and referenced by the GatewayClass parametersRef. It holds defaults
+// inherited by GatewayConfigs of all gateways of the class.
+type GatewayClassConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec GatewayClassConfigSpec `json:"spec"`
+}
+
+// GatewayClassConfigSpec defines defaults of GatewayConfigs of the gateway class.
+// Values set in the GatewayConfig take precedence.
+type GatewayClassConfigSpec struct {
+	// CompartmentID is the default OCID of the compartment for OCI resources created for gateways
+	// +optional
+	CompartmentID string `json:"compartmentId,omitempty"`
+
+	// FreeformTags are default free-form tags of OCI resources created for gateways.
+	// Tags with the same key set in the GatewayConfig take precedence.
+	// +optional
+	FreeformTags map[string]string `json:"freeformTags,omitempty"`
+
+	// NamePrefix is the default prefix of OCI listener, routing policy and default backend set names
+	// +optional
+	NamePrefix string `json:"namePrefix,omitempty"`
+
+	// Naming is the default naming scheme of OCI listeners and backend sets
+	// +optional
+	Naming *OCIResourceNaming `json:"naming,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GatewayClassConfigList contains a list of GatewayClassConfig.
+type GatewayClassConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []GatewayClassConfig `json:"items"`
+}
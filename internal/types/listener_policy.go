@@ -0,0 +1,88 @@
+package types
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OkeListenerPolicy is the Schema for the oke-listener-policies API. It describes
+// request and connection limits enforced by the load balancer listeners of gateways
+// that reference the policy in their GatewayConfig.
+type OkeListenerPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec OkeListenerPolicySpec `json:"spec"`
+}
+
+// OkeListenerPolicySpec defines the desired state of OkeListenerPolicy.
+type OkeListenerPolicySpec struct {
+	// HTTPHeader configures limits of the HTTP request headers
+	// +optional
+	HTTPHeader *OkeListenerPolicyHTTPHeader `json:"httpHeader,omitempty"`
+
+	// AllowedMethods restricts HTTP methods accepted by the listeners
+	// +optional
+	AllowedMethods *OkeListenerPolicyAllowedMethods `json:"allowedMethods,omitempty"`
+
+	// MaxConnections limits the number of concurrent connections per client IP address
+	// +optional
+	MaxConnections *OkeListenerPolicyMaxConnections `json:"maxConnections,omitempty"`
+}
+
+// OkeListenerPolicyHTTPHeader defines limits of the HTTP request headers.
+type OkeListenerPolicyHTTPHeader struct {
+	// MaxSizeInKB is the maximum size of the request headers in KB.
+	// Allowed values are 8, 16, 32 and 64.
+	// +optional
+	MaxSizeInKB int32 `json:"maxSizeInKB,omitempty"`
+
+	// AllowInvalidCharacters indicates if header names with invalid characters are accepted
+	// +optional
+	AllowInvalidCharacters *bool `json:"allowInvalidCharacters,omitempty"`
+}
+
+// OkeListenerPolicyAllowedMethods defines the list of accepted HTTP methods.
+type OkeListenerPolicyAllowedMethods struct {
+	// Methods is a list of accepted HTTP methods, e.g. GET, POST
+	// +required
+	Methods []string `json:"methods"`
+
+	// StatusCode is the response status code for requests with other methods. Defaults to 405.
+	// +optional
+	StatusCode int32 `json:"statusCode,omitempty"`
+}
+
+// OkeListenerPolicyMaxConnections defines connection limits per client IP address.
+type OkeListenerPolicyMaxConnections struct {
+	// Default is the maximum number of connections from a single IP address
+	// not matched by any of the perIP entries
+	// +required
+	Default int32 `json:"default"`
+
+	// PerIP overrides the limit for specific IP addresses
+	// +optional
+	PerIP []OkeListenerPolicyIPMaxConnections `json:"perIP,omitempty"`
+}
+
+// OkeListenerPolicyIPMaxConnections defines the connection limit of a list of IP addresses.
+type OkeListenerPolicyIPMaxConnections struct {
+	// IPAddresses is a list of IP addresses or CIDR blocks the limit applies to
+	// +required
+	IPAddresses []string `json:"ipAddresses"`
+
+	// MaxConnections is the maximum number of connections from each of the IP addresses
+	// +required
+	MaxConnections int32 `json:"maxConnections"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OkeListenerPolicyList contains a list of OkeListenerPolicy.
+type OkeListenerPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []OkeListenerPolicy `json:"items"`
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OkeLoadBalancerSnapshot is the Schema for the oke-load-balancer-snapshots API.
+// It holds the OCI Load Balancer configuration captured by the snapshot command,
+// so it is backed up together with other cluster resources and can be restored
+// on a new load balancer. The object has the same namespace and name as the GatewayConfig.
+type OkeLoadBalancerSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec OkeLoadBalancerSnapshotSpec `json:"spec"`
+}
+
+// OkeLoadBalancerSnapshotSpec defines the captured load balancer configuration.
+type OkeLoadBalancerSnapshotSpec struct {
+	// GatewayConfigName is the name of the GatewayConfig the snapshot was captured for
+	// +required
+	GatewayConfigName string `json:"gatewayConfigName"`
+
+	// LoadBalancerID is the OCID of the captured load balancer
+	// +required
+	LoadBalancerID string `json:"loadBalancerId"`
+
+	// CapturedAt is the time the snapshot was captured
+	// +required
+	CapturedAt metav1.Time `json:"capturedAt"`
+
+	// Document is the snapshot document in the format printed by the snapshot command
+	// +required
+	Document runtime.RawExtension `json:"document"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OkeLoadBalancerSnapshotList contains a list of OkeLoadBalancerSnapshot.
+type OkeLoadBalancerSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []OkeLoadBalancerSnapshot `json:"items"`
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OkeGatewayProgrammingState is the Schema for the oke-gateway-programming-states API.
+// It is maintained by the controller and records load balancer resources programmed
+// for a Gateway. The object has the same namespace and name as the Gateway.
+type OkeGatewayProgrammingState struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec OkeGatewayProgrammingStateSpec `json:"spec"`
+}
+
+// OkeGatewayProgrammingStateSpec defines the programmed state of the Gateway.
+type OkeGatewayProgrammingStateSpec struct {
+	// Certificates is a list of load balancer certificate names programmed for the gateway listeners
+	// +optional
+	Certificates []string `json:"certificates,omitempty"`
+
+	// Routes is a list of routes programmed on the gateway load balancer
+	// +optional
+	Routes []OkeGatewayProgrammedRoute `json:"routes,omitempty"`
+}
+
+// OkeGatewayProgrammedRoute defines load balancer resources programmed for a single route.
+type OkeGatewayProgrammedRoute struct {
+	// Kind is the kind of the route, e.g. HTTPRoute
+	// +required
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the route
+	// +required
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the route
+	// +required
+	Name string `json:"name"`
+
+	// PolicyRules is a list of programmed routing policy rules in the listener/rule format
+	// +optional
+	PolicyRules []string `json:"policyRules,omitempty"`
+
+	// BackendSets is a list of load balancer backend set names referenced by the route
+	// +optional
+	BackendSets []string `json:"backendSets,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OkeGatewayProgrammingStateList contains a list of OkeGatewayProgrammingState.
+type OkeGatewayProgrammingStateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []OkeGatewayProgrammingState `json:"items"`
+}
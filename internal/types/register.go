@@ -17,8 +17,20 @@ const (
 func AddKnownTypes(scheme *runtime.Scheme) error {
 	groupVersion := schema.GroupVersion{Group: GroupName, Version: Version}
 	scheme.AddKnownTypes(groupVersion,
+		&GatewayClassConfig{},
+		&GatewayClassConfigList{},
 		&GatewayConfig{},
 		&GatewayConfigList{},
+		&OkeExternalBackend{},
+		&OkeExternalBackendList{},
+		&OkeGatewayProgrammingState{},
+		&OkeGatewayProgrammingStateList{},
+		&OkeListenerPolicy{},
+		&OkeListenerPolicyList{},
+		&OkeLoadBalancerSnapshot{},
+		&OkeLoadBalancerSnapshotList{},
+		&OkeAccessPolicy{},
+		&OkeAccessPolicyList{},
 	)
 	metav1.AddToGroupVersion(scheme, groupVersion)
 	return nil
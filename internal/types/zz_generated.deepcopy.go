@@ -7,16 +7,103 @@
 package types
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayClassConfig) DeepCopyInto(out *GatewayClassConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassConfig.
+func (in *GatewayClassConfig) DeepCopy() *GatewayClassConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayClassConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GatewayClassConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayClassConfigList) DeepCopyInto(out *GatewayClassConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GatewayClassConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassConfigList.
+func (in *GatewayClassConfigList) DeepCopy() *GatewayClassConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayClassConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GatewayClassConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayClassConfigSpec) DeepCopyInto(out *GatewayClassConfigSpec) {
+	*out = *in
+	if in.FreeformTags != nil {
+		in, out := &in.FreeformTags, &out.FreeformTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Naming != nil {
+		in, out := &in.Naming, &out.Naming
+		*out = new(OCIResourceNaming)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassConfigSpec.
+func (in *GatewayClassConfigSpec) DeepCopy() *GatewayClassConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayClassConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayConfig) DeepCopyInto(out *GatewayConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -38,6 +125,76 @@ func (in *GatewayConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigBackendTopology) DeepCopyInto(out *GatewayConfigBackendTopology) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigBackendTopology.
+func (in *GatewayConfigBackendTopology) DeepCopy() *GatewayConfigBackendTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigBackendTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigDNS) DeepCopyInto(out *GatewayConfigDNS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigDNS.
+func (in *GatewayConfigDNS) DeepCopy() *GatewayConfigDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigDefaultBackend) DeepCopyInto(out *GatewayConfigDefaultBackend) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigDefaultBackend.
+func (in *GatewayConfigDefaultBackend) DeepCopy() *GatewayConfigDefaultBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigDefaultBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigDefaultBackendSet) DeepCopyInto(out *GatewayConfigDefaultBackendSet) {
+	*out = *in
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(OkeExternalBackendHealthCheck)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigDefaultBackendSet.
+func (in *GatewayConfigDefaultBackendSet) DeepCopy() *GatewayConfigDefaultBackendSet {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigDefaultBackendSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayConfigList) DeepCopyInto(out *GatewayConfigList) {
 	*out = *in
@@ -70,9 +227,166 @@ func (in *GatewayConfigList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigListener) DeepCopyInto(out *GatewayConfigListener) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigListener.
+func (in *GatewayConfigListener) DeepCopy() *GatewayConfigListener {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigLog) DeepCopyInto(out *GatewayConfigLog) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigLog.
+func (in *GatewayConfigLog) DeepCopy() *GatewayConfigLog {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigLogging) DeepCopyInto(out *GatewayConfigLogging) {
+	*out = *in
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(GatewayConfigLog)
+		**out = **in
+	}
+	if in.ErrorLog != nil {
+		in, out := &in.ErrorLog, &out.ErrorLog
+		*out = new(GatewayConfigLog)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigLogging.
+func (in *GatewayConfigLogging) DeepCopy() *GatewayConfigLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigNetworkSecurityGroup) DeepCopyInto(out *GatewayConfigNetworkSecurityGroup) {
+	*out = *in
+	if in.SourceCIDRs != nil {
+		in, out := &in.SourceCIDRs, &out.SourceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigNetworkSecurityGroup.
+func (in *GatewayConfigNetworkSecurityGroup) DeepCopy() *GatewayConfigNetworkSecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigNetworkSecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConfigScaleToZero) DeepCopyInto(out *GatewayConfigScaleToZero) {
+	*out = *in
+	if in.Fallback != nil {
+		in, out := &in.Fallback, &out.Fallback
+		*out = new(GatewayConfigDefaultBackend)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigScaleToZero.
+func (in *GatewayConfigScaleToZero) DeepCopy() *GatewayConfigScaleToZero {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConfigScaleToZero)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayConfigSpec) DeepCopyInto(out *GatewayConfigSpec) {
 	*out = *in
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(GatewayConfigLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkSecurityGroup != nil {
+		in, out := &in.NetworkSecurityGroup, &out.NetworkSecurityGroup
+		*out = new(GatewayConfigNetworkSecurityGroup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(GatewayConfigDNS)
+		**out = **in
+	}
+	if in.DefaultBackend != nil {
+		in, out := &in.DefaultBackend, &out.DefaultBackend
+		*out = new(GatewayConfigDefaultBackend)
+		**out = **in
+	}
+	if in.DefaultBackendSet != nil {
+		in, out := &in.DefaultBackendSet, &out.DefaultBackendSet
+		*out = new(GatewayConfigDefaultBackendSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]GatewayConfigListener, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdoptedListeners != nil {
+		in, out := &in.AdoptedListeners, &out.AdoptedListeners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FreeformTags != nil {
+		in, out := &in.FreeformTags, &out.FreeformTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Naming != nil {
+		in, out := &in.Naming, &out.Naming
+		*out = new(OCIResourceNaming)
+		**out = **in
+	}
+	if in.BackendIPFamilies != nil {
+		in, out := &in.BackendIPFamilies, &out.BackendIPFamilies
+		*out = make([]corev1.IPFamily, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackendTopology != nil {
+		in, out := &in.BackendTopology, &out.BackendTopology
+		*out = new(GatewayConfigBackendTopology)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleToZero != nil {
+		in, out := &in.ScaleToZero, &out.ScaleToZero
+		*out = new(GatewayConfigScaleToZero)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigSpec.
@@ -106,3 +420,571 @@ func (in *GatewayConfigStatus) DeepCopy() *GatewayConfigStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIResourceNaming) DeepCopyInto(out *OCIResourceNaming) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIResourceNaming.
+func (in *OCIResourceNaming) DeepCopy() *OCIResourceNaming {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIResourceNaming)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeAccessPolicy) DeepCopyInto(out *OkeAccessPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeAccessPolicy.
+func (in *OkeAccessPolicy) DeepCopy() *OkeAccessPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeAccessPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OkeAccessPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeAccessPolicyList) DeepCopyInto(out *OkeAccessPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OkeAccessPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeAccessPolicyList.
+func (in *OkeAccessPolicyList) DeepCopy() *OkeAccessPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeAccessPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OkeAccessPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeAccessPolicySpec) DeepCopyInto(out *OkeAccessPolicySpec) {
+	*out = *in
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]apisv1.LocalPolicyTargetReferenceWithSectionName, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedSourceCIDRs != nil {
+		in, out := &in.AllowedSourceCIDRs, &out.AllowedSourceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeAccessPolicySpec.
+func (in *OkeAccessPolicySpec) DeepCopy() *OkeAccessPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeAccessPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeExternalBackend) DeepCopyInto(out *OkeExternalBackend) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeExternalBackend.
+func (in *OkeExternalBackend) DeepCopy() *OkeExternalBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeExternalBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OkeExternalBackend) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeExternalBackendEndpoint) DeepCopyInto(out *OkeExternalBackendEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeExternalBackendEndpoint.
+func (in *OkeExternalBackendEndpoint) DeepCopy() *OkeExternalBackendEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeExternalBackendEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeExternalBackendHealthCheck) DeepCopyInto(out *OkeExternalBackendHealthCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeExternalBackendHealthCheck.
+func (in *OkeExternalBackendHealthCheck) DeepCopy() *OkeExternalBackendHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeExternalBackendHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeExternalBackendList) DeepCopyInto(out *OkeExternalBackendList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OkeExternalBackend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeExternalBackendList.
+func (in *OkeExternalBackendList) DeepCopy() *OkeExternalBackendList {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeExternalBackendList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OkeExternalBackendList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeExternalBackendSpec) DeepCopyInto(out *OkeExternalBackendSpec) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]OkeExternalBackendEndpoint, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(OkeExternalBackendHealthCheck)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeExternalBackendSpec.
+func (in *OkeExternalBackendSpec) DeepCopy() *OkeExternalBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeExternalBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeGatewayProgrammedRoute) DeepCopyInto(out *OkeGatewayProgrammedRoute) {
+	*out = *in
+	if in.PolicyRules != nil {
+		in, out := &in.PolicyRules, &out.PolicyRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackendSets != nil {
+		in, out := &in.BackendSets, &out.BackendSets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeGatewayProgrammedRoute.
+func (in *OkeGatewayProgrammedRoute) DeepCopy() *OkeGatewayProgrammedRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeGatewayProgrammedRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeGatewayProgrammingState) DeepCopyInto(out *OkeGatewayProgrammingState) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeGatewayProgrammingState.
+func (in *OkeGatewayProgrammingState) DeepCopy() *OkeGatewayProgrammingState {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeGatewayProgrammingState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OkeGatewayProgrammingState) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeGatewayProgrammingStateList) DeepCopyInto(out *OkeGatewayProgrammingStateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OkeGatewayProgrammingState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeGatewayProgrammingStateList.
+func (in *OkeGatewayProgrammingStateList) DeepCopy() *OkeGatewayProgrammingStateList {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeGatewayProgrammingStateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OkeGatewayProgrammingStateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeGatewayProgrammingStateSpec) DeepCopyInto(out *OkeGatewayProgrammingStateSpec) {
+	*out = *in
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]OkeGatewayProgrammedRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeGatewayProgrammingStateSpec.
+func (in *OkeGatewayProgrammingStateSpec) DeepCopy() *OkeGatewayProgrammingStateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeGatewayProgrammingStateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeListenerPolicy) DeepCopyInto(out *OkeListenerPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeListenerPolicy.
+func (in *OkeListenerPolicy) DeepCopy() *OkeListenerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeListenerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OkeListenerPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeListenerPolicyAllowedMethods) DeepCopyInto(out *OkeListenerPolicyAllowedMethods) {
+	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeListenerPolicyAllowedMethods.
+func (in *OkeListenerPolicyAllowedMethods) DeepCopy() *OkeListenerPolicyAllowedMethods {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeListenerPolicyAllowedMethods)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeListenerPolicyHTTPHeader) DeepCopyInto(out *OkeListenerPolicyHTTPHeader) {
+	*out = *in
+	if in.AllowInvalidCharacters != nil {
+		in, out := &in.AllowInvalidCharacters, &out.AllowInvalidCharacters
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeListenerPolicyHTTPHeader.
+func (in *OkeListenerPolicyHTTPHeader) DeepCopy() *OkeListenerPolicyHTTPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeListenerPolicyHTTPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeListenerPolicyIPMaxConnections) DeepCopyInto(out *OkeListenerPolicyIPMaxConnections) {
+	*out = *in
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeListenerPolicyIPMaxConnections.
+func (in *OkeListenerPolicyIPMaxConnections) DeepCopy() *OkeListenerPolicyIPMaxConnections {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeListenerPolicyIPMaxConnections)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeListenerPolicyList) DeepCopyInto(out *OkeListenerPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OkeListenerPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeListenerPolicyList.
+func (in *OkeListenerPolicyList) DeepCopy() *OkeListenerPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeListenerPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OkeListenerPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeListenerPolicyMaxConnections) DeepCopyInto(out *OkeListenerPolicyMaxConnections) {
+	*out = *in
+	if in.PerIP != nil {
+		in, out := &in.PerIP, &out.PerIP
+		*out = make([]OkeListenerPolicyIPMaxConnections, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeListenerPolicyMaxConnections.
+func (in *OkeListenerPolicyMaxConnections) DeepCopy() *OkeListenerPolicyMaxConnections {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeListenerPolicyMaxConnections)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeListenerPolicySpec) DeepCopyInto(out *OkeListenerPolicySpec) {
+	*out = *in
+	if in.HTTPHeader != nil {
+		in, out := &in.HTTPHeader, &out.HTTPHeader
+		*out = new(OkeListenerPolicyHTTPHeader)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedMethods != nil {
+		in, out := &in.AllowedMethods, &out.AllowedMethods
+		*out = new(OkeListenerPolicyAllowedMethods)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(OkeListenerPolicyMaxConnections)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeListenerPolicySpec.
+func (in *OkeListenerPolicySpec) DeepCopy() *OkeListenerPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeListenerPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeLoadBalancerSnapshot) DeepCopyInto(out *OkeLoadBalancerSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeLoadBalancerSnapshot.
+func (in *OkeLoadBalancerSnapshot) DeepCopy() *OkeLoadBalancerSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeLoadBalancerSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OkeLoadBalancerSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeLoadBalancerSnapshotList) DeepCopyInto(out *OkeLoadBalancerSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OkeLoadBalancerSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeLoadBalancerSnapshotList.
+func (in *OkeLoadBalancerSnapshotList) DeepCopy() *OkeLoadBalancerSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeLoadBalancerSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OkeLoadBalancerSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OkeLoadBalancerSnapshotSpec) DeepCopyInto(out *OkeLoadBalancerSnapshotSpec) {
+	*out = *in
+	in.CapturedAt.DeepCopyInto(&out.CapturedAt)
+	in.Document.DeepCopyInto(&out.Document)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OkeLoadBalancerSnapshotSpec.
+func (in *OkeLoadBalancerSnapshotSpec) DeepCopy() *OkeLoadBalancerSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OkeLoadBalancerSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,81 @@
+// Package httpfilter defines the extension point for HTTPRoute filters of type ExtensionRef.
+//
+// Extensions are compiled into the controller binary. An extension package registers
+// its handlers with Register from an init function and is enabled by a blank import
+// in cmd/controller/extensions.go.
+package httpfilter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// Request describes a single ExtensionRef filter of an HTTPRoute rule.
+type Request struct {
+	// Route is the HTTPRoute that holds the filter. It must not be modified.
+	Route *gatewayv1.HTTPRoute
+
+	// RuleIndex is the index of the rule in Route.Spec.Rules that holds the filter.
+	RuleIndex int
+
+	// Ref is the ExtensionRef of the filter.
+	Ref gatewayv1.LocalObjectReference
+}
+
+// Extension translates ExtensionRef filters of a particular group and kind.
+type Extension interface {
+	// RoutingCondition returns an OCI routing policy condition fragment for the filter.
+	// The fragment is combined with the rule matches using all(). An empty fragment
+	// leaves the rule condition unchanged.
+	RoutingCondition(ctx context.Context, req Request) (string, error)
+}
+
+// ExtensionFunc adapts an ordinary function to the Extension interface.
+type ExtensionFunc func(ctx context.Context, req Request) (string, error)
+
+// RoutingCondition calls f(ctx, req).
+func (f ExtensionFunc) RoutingCondition(ctx context.Context, req Request) (string, error) {
+	return f(ctx, req)
+}
+
+type extensionKey struct {
+	group gatewayv1.Group
+	kind  gatewayv1.Kind
+}
+
+// Extensions register from init functions of their packages, so the registry has to be global.
+//
+//nolint:gochecknoglobals // see above
+var registry = struct {
+	sync.RWMutex
+	extensions map[extensionKey]Extension
+}{
+	extensions: make(map[extensionKey]Extension),
+}
+
+// Register makes the extension available for ExtensionRef filters with the given group and kind.
+// It panics if the extension is nil or if an extension is already registered for the group and kind.
+func Register(group gatewayv1.Group, kind gatewayv1.Kind, extension Extension) {
+	if extension == nil {
+		panic(fmt.Sprintf("httpfilter: Register extension for %s/%s is nil", group, kind))
+	}
+	key := extensionKey{group: group, kind: kind}
+
+	registry.Lock()
+	defer registry.Unlock()
+	if _, found := registry.extensions[key]; found {
+		panic(fmt.Sprintf("httpfilter: Register called twice for %s/%s", group, kind))
+	}
+	registry.extensions[key] = extension
+}
+
+// Lookup returns the extension registered for the group and kind.
+func Lookup(group gatewayv1.Group, kind gatewayv1.Kind) (Extension, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	extension, found := registry.extensions[extensionKey{group: group, kind: kind}]
+	return extension, found
+}
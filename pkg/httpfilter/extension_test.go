@@ -0,0 +1,58 @@
+package httpfilter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestRegister(t *testing.T) {
+	fake := faker.New()
+	randomGroup := func() gatewayv1.Group {
+		return gatewayv1.Group(fake.Internet().Domain())
+	}
+
+	t.Run("should lookup registered extension", func(t *testing.T) {
+		group := randomGroup()
+		wantCondition := fake.Lorem().Sentence(3)
+		Register(group, "Canary", ExtensionFunc(func(_ context.Context, _ Request) (string, error) {
+			return wantCondition, nil
+		}))
+
+		extension, found := Lookup(group, "Canary")
+
+		require.True(t, found)
+		gotCondition, err := extension.RoutingCondition(t.Context(), Request{})
+		require.NoError(t, err)
+		assert.Equal(t, wantCondition, gotCondition)
+	})
+
+	t.Run("should not find extension of other kind", func(t *testing.T) {
+		group := randomGroup()
+		Register(group, "Canary", ExtensionFunc(func(_ context.Context, _ Request) (string, error) {
+			return "", nil
+		}))
+
+		_, found := Lookup(group, "Mirror")
+
+		assert.False(t, found)
+	})
+
+	t.Run("should panic on duplicate registration", func(t *testing.T) {
+		group := randomGroup()
+		extension := ExtensionFunc(func(_ context.Context, _ Request) (string, error) {
+			return "", nil
+		})
+		Register(group, "Canary", extension)
+
+		assert.Panics(t, func() { Register(group, "Canary", extension) })
+	})
+
+	t.Run("should panic on nil extension", func(t *testing.T) {
+		assert.Panics(t, func() { Register(randomGroup(), "Canary", nil) })
+	})
+}
@@ -0,0 +1,268 @@
+// Package testsupport provides in-memory fakes of OCI services used by the controller.
+//
+// The fakes allow running integration tests of the controller (or of tools built
+// around it) without OCI credentials. They implement the subset of the OCI SDK
+// client methods the controller uses and keep the state in memory.
+package testsupport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+)
+
+// FakeLoadBalancerClient is a stateful in-memory implementation of the OCI Load Balancer
+// client. Mutating operations return a work request ID. Changes become visible once the
+// work request succeeds, which happens immediately unless configured otherwise with
+// FakeLoadBalancerClientWithWorkRequestPolls.
+//
+// The client also implements GetWorkRequest, so it can be used with the work requests watcher.
+type FakeLoadBalancerClient struct {
+	mu sync.Mutex
+
+	loadBalancers map[string]*loadbalancer.LoadBalancer
+	workRequests  map[string]*fakeWorkRequest
+	lastID        int
+
+	workRequestPolls    int
+	failOperations      map[string]error
+	failWorkRequestsFor map[string]bool
+}
+
+type fakeWorkRequest struct {
+	workRequest  loadbalancer.WorkRequest
+	pendingPolls int
+	apply        func()
+}
+
+type FakeLoadBalancerClientOpt func(*FakeLoadBalancerClient)
+
+// FakeLoadBalancerClientWithWorkRequestPolls makes work requests report IN_PROGRESS state
+// for the given number of GetWorkRequest calls before they succeed.
+func FakeLoadBalancerClientWithWorkRequestPolls(polls int) FakeLoadBalancerClientOpt {
+	return func(c *FakeLoadBalancerClient) {
+		c.workRequestPolls = polls
+	}
+}
+
+func NewFakeLoadBalancerClient(opts ...FakeLoadBalancerClientOpt) *FakeLoadBalancerClient {
+	c := &FakeLoadBalancerClient{
+		loadBalancers:       map[string]*loadbalancer.LoadBalancer{},
+		workRequests:        map[string]*fakeWorkRequest{},
+		failOperations:      map[string]error{},
+		failWorkRequestsFor: map[string]bool{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// AddLoadBalancer adds the load balancer to the fake and returns its ID.
+// An ID is generated if the load balancer has none.
+func (c *FakeLoadBalancerClient) AddLoadBalancer(lb loadbalancer.LoadBalancer) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if lb.Id == nil {
+		lb.Id = new(c.nextID("ocid1.loadbalancer.oc1..fake"))
+	}
+	if lb.LifecycleState == "" {
+		lb.LifecycleState = loadbalancer.LoadBalancerLifecycleStateActive
+	}
+	lb.Listeners = lo.Assign(map[string]loadbalancer.Listener{}, lb.Listeners)
+	lb.Hostnames = lo.Assign(map[string]loadbalancer.Hostname{}, lb.Hostnames)
+	lb.Certificates = lo.Assign(map[string]loadbalancer.Certificate{}, lb.Certificates)
+	lb.BackendSets = lo.Assign(map[string]loadbalancer.BackendSet{}, lb.BackendSets)
+	lb.RuleSets = lo.Assign(map[string]loadbalancer.RuleSet{}, lb.RuleSets)
+	lb.RoutingPolicies = lo.Assign(map[string]loadbalancer.RoutingPolicy{}, lb.RoutingPolicies)
+	c.loadBalancers[*lb.Id] = &lb
+	return *lb.Id
+}
+
+// FailNext makes the next call of the operation (e.g. "CreateBackendSet") return the error.
+func (c *FakeLoadBalancerClient) FailNext(operation string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failOperations[operation] = err
+}
+
+// FailNextWorkRequest makes the work request of the next call of the operation end
+// in FAILED state. The change requested by the operation is not applied.
+func (c *FakeLoadBalancerClient) FailNextWorkRequest(operation string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failWorkRequestsFor[operation] = true
+}
+
+func (c *FakeLoadBalancerClient) nextID(prefix string) string {
+	c.lastID++
+	return prefix + "-" + strconv.Itoa(c.lastID)
+}
+
+// mutate validates the change with the load balancer state and schedules it with a work request.
+// The change function returns a function applying the change, it is invoked when the work request succeeds.
+func (c *FakeLoadBalancerClient) mutate(
+	operation string,
+	loadBalancerID *string,
+	change func(lb *loadbalancer.LoadBalancer) (func(), error),
+) (*string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err, ok := c.failOperations[operation]; ok {
+		delete(c.failOperations, operation)
+		return nil, err
+	}
+	lb, ok := c.loadBalancers[lo.FromPtr(loadBalancerID)]
+	if !ok {
+		return nil, notFoundError("LoadBalancer", lo.FromPtr(loadBalancerID))
+	}
+	apply, err := change(lb)
+	if err != nil {
+		return nil, err
+	}
+
+	workRequest := &fakeWorkRequest{
+		workRequest: loadbalancer.WorkRequest{
+			Id:             new(c.nextID("ocid1.loadbalancerworkrequest.oc1..fake")),
+			LoadBalancerId: lb.Id,
+			Type:           new(operation),
+			LifecycleState: loadbalancer.WorkRequestLifecycleStateAccepted,
+			Message:        new(operation),
+			TimeAccepted:   &common.SDKTime{Time: time.Now()},
+		},
+		pendingPolls: c.workRequestPolls,
+		apply:        apply,
+	}
+	if c.failWorkRequestsFor[operation] {
+		delete(c.failWorkRequestsFor, operation)
+		workRequest.workRequest.LifecycleState = loadbalancer.WorkRequestLifecycleStateFailed
+		workRequest.apply = nil
+	} else if workRequest.pendingPolls == 0 {
+		workRequest.complete()
+	}
+	c.workRequests[*workRequest.workRequest.Id] = workRequest
+	return workRequest.workRequest.Id, nil
+}
+
+func (w *fakeWorkRequest) complete() {
+	w.apply()
+	w.apply = nil
+	w.workRequest.LifecycleState = loadbalancer.WorkRequestLifecycleStateSucceeded
+	w.workRequest.TimeFinished = &common.SDKTime{Time: time.Now()}
+}
+
+func (c *FakeLoadBalancerClient) GetWorkRequest(
+	_ context.Context,
+	request loadbalancer.GetWorkRequestRequest,
+) (loadbalancer.GetWorkRequestResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	workRequest, ok := c.workRequests[lo.FromPtr(request.WorkRequestId)]
+	if !ok {
+		return loadbalancer.GetWorkRequestResponse{}, notFoundError("WorkRequest", lo.FromPtr(request.WorkRequestId))
+	}
+	if workRequest.apply != nil {
+		if workRequest.pendingPolls > 0 {
+			workRequest.pendingPolls--
+			workRequest.workRequest.LifecycleState = loadbalancer.WorkRequestLifecycleStateInProgress
+		} else {
+			workRequest.complete()
+		}
+	}
+	return loadbalancer.GetWorkRequestResponse{WorkRequest: workRequest.workRequest}, nil
+}
+
+// read returns a copy of the load balancer resource, so callers can not modify the state.
+func read[T any](
+	c *FakeLoadBalancerClient,
+	operation string,
+	loadBalancerID *string,
+	get func(lb *loadbalancer.LoadBalancer) (any, error),
+) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result T
+	if err, ok := c.failOperations[operation]; ok {
+		delete(c.failOperations, operation)
+		return result, err
+	}
+	lb, ok := c.loadBalancers[lo.FromPtr(loadBalancerID)]
+	if !ok {
+		return result, notFoundError("LoadBalancer", lo.FromPtr(loadBalancerID))
+	}
+	resource, err := get(lb)
+	if err != nil {
+		return result, err
+	}
+	return convert[T](resource)
+}
+
+// convert copies the value to the target type with a JSON round trip. OCI SDK
+// details types share JSON names with corresponding resource types, so this
+// turns e.g. CreateListenerDetails into a Listener.
+func convert[T any](value any) (T, error) {
+	var result T
+	data, err := json.Marshal(value)
+	if err != nil {
+		return result, fmt.Errorf("failed to marshal %T: %w", value, err)
+	}
+	if err = json.Unmarshal(data, &result); err != nil {
+		return result, fmt.Errorf("failed to unmarshal %T: %w", result, err)
+	}
+	return result, nil
+}
+
+// serviceError mimics errors returned by OCI services, so common.IsServiceError
+// recognizes errors returned by the fakes.
+type serviceError struct {
+	statusCode   int
+	code         string
+	message      string
+	opcRequestID string
+}
+
+func (e serviceError) GetHTTPStatusCode() int  { return e.statusCode }
+func (e serviceError) GetMessage() string      { return e.message }
+func (e serviceError) GetCode() string         { return e.code }
+func (e serviceError) GetOpcRequestID() string { return e.opcRequestID }
+
+func (e serviceError) Error() string {
+	return fmt.Sprintf("Error returned by fake OCI service. Http Status Code: %d. Error Code: %s. Message: %s",
+		e.statusCode, e.code, e.message)
+}
+
+func notFoundError(kind string, name string) error {
+	return serviceError{
+		statusCode: http.StatusNotFound,
+		code:       "NotAuthorizedOrNotFound",
+		message:    fmt.Sprintf("%s %s not found", kind, name),
+	}
+}
+
+func conflictError(kind string, name string) error {
+	return serviceError{
+		statusCode: http.StatusConflict,
+		code:       "Conflict",
+		message:    fmt.Sprintf("%s %s already exists", kind, name),
+	}
+}
+
+func invalidParameterError(message string) error {
+	return serviceError{
+		statusCode: http.StatusBadRequest,
+		code:       "InvalidParameter",
+		message:    message,
+	}
+}
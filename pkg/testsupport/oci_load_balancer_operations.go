@@ -0,0 +1,512 @@
+package testsupport
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/samber/lo"
+)
+
+func (c *FakeLoadBalancerClient) GetLoadBalancer(
+	_ context.Context,
+	request loadbalancer.GetLoadBalancerRequest,
+) (loadbalancer.GetLoadBalancerResponse, error) {
+	lb, err := read[loadbalancer.LoadBalancer](c, "GetLoadBalancer", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (any, error) { return lb, nil })
+	return loadbalancer.GetLoadBalancerResponse{LoadBalancer: lb}, err
+}
+
+func makeBackends(details []loadbalancer.BackendDetails) ([]loadbalancer.Backend, error) {
+	backends, err := convert[[]loadbalancer.Backend](details)
+	if err != nil {
+		return nil, err
+	}
+	for i := range backends {
+		backends[i].Name = new(backendName(backends[i].IpAddress, backends[i].Port))
+	}
+	return backends, nil
+}
+
+func backendName(ipAddress *string, port *int) string {
+	return lo.FromPtr(ipAddress) + ":" + strconv.Itoa(lo.FromPtr(port))
+}
+
+func (c *FakeLoadBalancerClient) CreateBackendSet(
+	_ context.Context,
+	request loadbalancer.CreateBackendSetRequest,
+) (loadbalancer.CreateBackendSetResponse, error) {
+	workRequestID, err := c.mutate("CreateBackendSet", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.CreateBackendSetDetails.Name)
+			if _, exists := lb.BackendSets[name]; exists {
+				return nil, conflictError("BackendSet", name)
+			}
+			backendSet, convertErr := convert[loadbalancer.BackendSet](request.CreateBackendSetDetails)
+			if convertErr != nil {
+				return nil, convertErr
+			}
+			if backendSet.Backends, convertErr = makeBackends(request.CreateBackendSetDetails.Backends); convertErr != nil {
+				return nil, convertErr
+			}
+			return func() { lb.BackendSets[name] = backendSet }, nil
+		})
+	return loadbalancer.CreateBackendSetResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+func (c *FakeLoadBalancerClient) UpdateBackendSet(
+	_ context.Context,
+	request loadbalancer.UpdateBackendSetRequest,
+) (loadbalancer.UpdateBackendSetResponse, error) {
+	workRequestID, err := c.mutate("UpdateBackendSet", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.BackendSetName)
+			if _, exists := lb.BackendSets[name]; !exists {
+				return nil, notFoundError("BackendSet", name)
+			}
+			backendSet, convertErr := convert[loadbalancer.BackendSet](request.UpdateBackendSetDetails)
+			if convertErr != nil {
+				return nil, convertErr
+			}
+			backendSet.Name = &name
+			if backendSet.Backends, convertErr = makeBackends(request.UpdateBackendSetDetails.Backends); convertErr != nil {
+				return nil, convertErr
+			}
+			return func() { lb.BackendSets[name] = backendSet }, nil
+		})
+	return loadbalancer.UpdateBackendSetResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+// backendSetUsage returns a description of the first OCI resource using the backend set.
+func backendSetUsage(lb *loadbalancer.LoadBalancer, name string) string {
+	for listenerName, listener := range lb.Listeners {
+		if lo.FromPtr(listener.DefaultBackendSetName) == name {
+			return "listener " + listenerName
+		}
+	}
+	for policyName, policy := range lb.RoutingPolicies {
+		for _, rule := range policy.Rules {
+			for _, action := range rule.Actions {
+				if forward, ok := action.(loadbalancer.ForwardToBackendSet); ok && lo.FromPtr(forward.BackendSetName) == name {
+					return "routing policy " + policyName
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func (c *FakeLoadBalancerClient) DeleteBackendSet(
+	_ context.Context,
+	request loadbalancer.DeleteBackendSetRequest,
+) (loadbalancer.DeleteBackendSetResponse, error) {
+	workRequestID, err := c.mutate("DeleteBackendSet", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.BackendSetName)
+			if _, exists := lb.BackendSets[name]; !exists {
+				return nil, notFoundError("BackendSet", name)
+			}
+			if usage := backendSetUsage(lb, name); usage != "" {
+				return nil, invalidParameterError(fmt.Sprintf("Backend set %s is used in %s", name, usage))
+			}
+			return func() { delete(lb.BackendSets, name) }, nil
+		})
+	return loadbalancer.DeleteBackendSetResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+func (c *FakeLoadBalancerClient) GetBackendSet(
+	_ context.Context,
+	request loadbalancer.GetBackendSetRequest,
+) (loadbalancer.GetBackendSetResponse, error) {
+	backendSet, err := read[loadbalancer.BackendSet](c, "GetBackendSet", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (any, error) {
+			name := lo.FromPtr(request.BackendSetName)
+			backendSet, exists := lb.BackendSets[name]
+			if !exists {
+				return nil, notFoundError("BackendSet", name)
+			}
+			return backendSet, nil
+		})
+	return loadbalancer.GetBackendSetResponse{BackendSet: backendSet}, err
+}
+
+// GetBackendSetHealth reports all backend sets as healthy.
+func (c *FakeLoadBalancerClient) GetBackendSetHealth(
+	_ context.Context,
+	request loadbalancer.GetBackendSetHealthRequest,
+) (loadbalancer.GetBackendSetHealthResponse, error) {
+	health, err := read[loadbalancer.BackendSetHealth](c, "GetBackendSetHealth", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (any, error) {
+			name := lo.FromPtr(request.BackendSetName)
+			backendSet, exists := lb.BackendSets[name]
+			if !exists {
+				return nil, notFoundError("BackendSet", name)
+			}
+			return loadbalancer.BackendSetHealth{
+				Status:                    loadbalancer.BackendSetHealthStatusOk,
+				TotalBackendCount:         new(len(backendSet.Backends)),
+				WarningStateBackendNames:  []string{},
+				CriticalStateBackendNames: []string{},
+				UnknownStateBackendNames:  []string{},
+			}, nil
+		})
+	return loadbalancer.GetBackendSetHealthResponse{BackendSetHealth: health}, err
+}
+
+// GetBackendHealth reports all backends as healthy.
+func (c *FakeLoadBalancerClient) GetBackendHealth(
+	_ context.Context,
+	request loadbalancer.GetBackendHealthRequest,
+) (loadbalancer.GetBackendHealthResponse, error) {
+	health, err := read[loadbalancer.BackendHealth](c, "GetBackendHealth", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (any, error) {
+			name := lo.FromPtr(request.BackendSetName)
+			backendSet, exists := lb.BackendSets[name]
+			if !exists {
+				return nil, notFoundError("BackendSet", name)
+			}
+			if !lo.ContainsBy(backendSet.Backends, func(backend loadbalancer.Backend) bool {
+				return lo.FromPtr(backend.Name) == lo.FromPtr(request.BackendName)
+			}) {
+				return nil, notFoundError("Backend", lo.FromPtr(request.BackendName))
+			}
+			return loadbalancer.BackendHealth{Status: loadbalancer.BackendHealthStatusOk}, nil
+		})
+	return loadbalancer.GetBackendHealthResponse{BackendHealth: health}, err
+}
+
+func (c *FakeLoadBalancerClient) CreateBackend(
+	_ context.Context,
+	request loadbalancer.CreateBackendRequest,
+) (loadbalancer.CreateBackendResponse, error) {
+	workRequestID, err := c.mutate("CreateBackend", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			backendSetName := lo.FromPtr(request.BackendSetName)
+			backendSet, exists := lb.BackendSets[backendSetName]
+			if !exists {
+				return nil, notFoundError("BackendSet", backendSetName)
+			}
+			backend, convertErr := convert[loadbalancer.Backend](request.CreateBackendDetails)
+			if convertErr != nil {
+				return nil, convertErr
+			}
+			backend.Name = new(backendName(backend.IpAddress, backend.Port))
+			if lo.ContainsBy(backendSet.Backends, func(existing loadbalancer.Backend) bool {
+				return lo.FromPtr(existing.Name) == *backend.Name
+			}) {
+				return nil, conflictError("Backend", *backend.Name)
+			}
+			return func() {
+				backendSet.Backends = append(slices.Clone(backendSet.Backends), backend)
+				lb.BackendSets[backendSetName] = backendSet
+			}, nil
+		})
+	return loadbalancer.CreateBackendResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+func (c *FakeLoadBalancerClient) CreateListener(
+	_ context.Context,
+	request loadbalancer.CreateListenerRequest,
+) (loadbalancer.CreateListenerResponse, error) {
+	workRequestID, err := c.mutate("CreateListener", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.CreateListenerDetails.Name)
+			if _, exists := lb.Listeners[name]; exists {
+				return nil, conflictError("Listener", name)
+			}
+			listener, convertErr := convert[loadbalancer.Listener](request.CreateListenerDetails)
+			if convertErr != nil {
+				return nil, convertErr
+			}
+			if validateErr := validateListenerReferences(lb, listener); validateErr != nil {
+				return nil, validateErr
+			}
+			return func() { lb.Listeners[name] = listener }, nil
+		})
+	return loadbalancer.CreateListenerResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+func (c *FakeLoadBalancerClient) UpdateListener(
+	_ context.Context,
+	request loadbalancer.UpdateListenerRequest,
+) (loadbalancer.UpdateListenerResponse, error) {
+	workRequestID, err := c.mutate("UpdateListener", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.ListenerName)
+			if _, exists := lb.Listeners[name]; !exists {
+				return nil, notFoundError("Listener", name)
+			}
+			listener, convertErr := convert[loadbalancer.Listener](request.UpdateListenerDetails)
+			if convertErr != nil {
+				return nil, convertErr
+			}
+			listener.Name = &name
+			if validateErr := validateListenerReferences(lb, listener); validateErr != nil {
+				return nil, validateErr
+			}
+			return func() { lb.Listeners[name] = listener }, nil
+		})
+	return loadbalancer.UpdateListenerResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+func validateListenerReferences(lb *loadbalancer.LoadBalancer, listener loadbalancer.Listener) error {
+	if name := lo.FromPtr(listener.DefaultBackendSetName); name != "" {
+		if _, exists := lb.BackendSets[name]; !exists {
+			return invalidParameterError(fmt.Sprintf("Backend set %s does not exist", name))
+		}
+	}
+	if name := lo.FromPtr(listener.RoutingPolicyName); name != "" {
+		if _, exists := lb.RoutingPolicies[name]; !exists {
+			return invalidParameterError(fmt.Sprintf("Routing policy %s does not exist", name))
+		}
+	}
+	for _, name := range listener.RuleSetNames {
+		if _, exists := lb.RuleSets[name]; !exists {
+			return invalidParameterError(fmt.Sprintf("Rule set %s does not exist", name))
+		}
+	}
+	return nil
+}
+
+func (c *FakeLoadBalancerClient) DeleteListener(
+	_ context.Context,
+	request loadbalancer.DeleteListenerRequest,
+) (loadbalancer.DeleteListenerResponse, error) {
+	workRequestID, err := c.mutate("DeleteListener", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.ListenerName)
+			if _, exists := lb.Listeners[name]; !exists {
+				return nil, notFoundError("Listener", name)
+			}
+			return func() { delete(lb.Listeners, name) }, nil
+		})
+	return loadbalancer.DeleteListenerResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+func (c *FakeLoadBalancerClient) CreateHostname(
+	_ context.Context,
+	request loadbalancer.CreateHostnameRequest,
+) (loadbalancer.CreateHostnameResponse, error) {
+	workRequestID, err := c.mutate("CreateHostname", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.CreateHostnameDetails.Name)
+			if _, exists := lb.Hostnames[name]; exists {
+				return nil, conflictError("Hostname", name)
+			}
+			hostname := loadbalancer.Hostname{Name: &name, Hostname: request.CreateHostnameDetails.Hostname}
+			return func() { lb.Hostnames[name] = hostname }, nil
+		})
+	return loadbalancer.CreateHostnameResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+func (c *FakeLoadBalancerClient) GetHostname(
+	_ context.Context,
+	request loadbalancer.GetHostnameRequest,
+) (loadbalancer.GetHostnameResponse, error) {
+	hostname, err := read[loadbalancer.Hostname](c, "GetHostname", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (any, error) {
+			name := lo.FromPtr(request.Name)
+			hostname, exists := lb.Hostnames[name]
+			if !exists {
+				return nil, notFoundError("Hostname", name)
+			}
+			return hostname, nil
+		})
+	return loadbalancer.GetHostnameResponse{Hostname: hostname}, err
+}
+
+func (c *FakeLoadBalancerClient) CreateRuleSet(
+	_ context.Context,
+	request loadbalancer.CreateRuleSetRequest,
+) (loadbalancer.CreateRuleSetResponse, error) {
+	workRequestID, err := c.mutate("CreateRuleSet", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.CreateRuleSetDetails.Name)
+			if _, exists := lb.RuleSets[name]; exists {
+				return nil, conflictError("RuleSet", name)
+			}
+			ruleSet, convertErr := convert[loadbalancer.RuleSet](request.CreateRuleSetDetails)
+			if convertErr != nil {
+				return nil, convertErr
+			}
+			return func() { lb.RuleSets[name] = ruleSet }, nil
+		})
+	return loadbalancer.CreateRuleSetResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+func (c *FakeLoadBalancerClient) UpdateRuleSet(
+	_ context.Context,
+	request loadbalancer.UpdateRuleSetRequest,
+) (loadbalancer.UpdateRuleSetResponse, error) {
+	workRequestID, err := c.mutate("UpdateRuleSet", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.RuleSetName)
+			if _, exists := lb.RuleSets[name]; !exists {
+				return nil, notFoundError("RuleSet", name)
+			}
+			ruleSet, convertErr := convert[loadbalancer.RuleSet](request.UpdateRuleSetDetails)
+			if convertErr != nil {
+				return nil, convertErr
+			}
+			ruleSet.Name = &name
+			return func() { lb.RuleSets[name] = ruleSet }, nil
+		})
+	return loadbalancer.UpdateRuleSetResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+func (c *FakeLoadBalancerClient) DeleteRuleSet(
+	_ context.Context,
+	request loadbalancer.DeleteRuleSetRequest,
+) (loadbalancer.DeleteRuleSetResponse, error) {
+	workRequestID, err := c.mutate("DeleteRuleSet", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.RuleSetName)
+			if _, exists := lb.RuleSets[name]; !exists {
+				return nil, notFoundError("RuleSet", name)
+			}
+			for listenerName, listener := range lb.Listeners {
+				if slices.Contains(listener.RuleSetNames, name) {
+					return nil, invalidParameterError(fmt.Sprintf("Rule set %s is used in listener %s", name, listenerName))
+				}
+			}
+			return func() { delete(lb.RuleSets, name) }, nil
+		})
+	return loadbalancer.DeleteRuleSetResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+func (c *FakeLoadBalancerClient) GetRuleSet(
+	_ context.Context,
+	request loadbalancer.GetRuleSetRequest,
+) (loadbalancer.GetRuleSetResponse, error) {
+	ruleSet, err := read[loadbalancer.RuleSet](c, "GetRuleSet", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (any, error) {
+			name := lo.FromPtr(request.RuleSetName)
+			ruleSet, exists := lb.RuleSets[name]
+			if !exists {
+				return nil, notFoundError("RuleSet", name)
+			}
+			return ruleSet, nil
+		})
+	return loadbalancer.GetRuleSetResponse{RuleSet: ruleSet}, err
+}
+
+func (c *FakeLoadBalancerClient) GetRoutingPolicy(
+	_ context.Context,
+	request loadbalancer.GetRoutingPolicyRequest,
+) (loadbalancer.GetRoutingPolicyResponse, error) {
+	policy, err := read[loadbalancer.RoutingPolicy](c, "GetRoutingPolicy", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (any, error) {
+			name := lo.FromPtr(request.RoutingPolicyName)
+			policy, exists := lb.RoutingPolicies[name]
+			if !exists {
+				return nil, notFoundError("RoutingPolicy", name)
+			}
+			return policy, nil
+		})
+	return loadbalancer.GetRoutingPolicyResponse{RoutingPolicy: policy}, err
+}
+
+func (c *FakeLoadBalancerClient) CreateRoutingPolicy(
+	_ context.Context,
+	request loadbalancer.CreateRoutingPolicyRequest,
+) (loadbalancer.CreateRoutingPolicyResponse, error) {
+	workRequestID, err := c.mutate("CreateRoutingPolicy", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.CreateRoutingPolicyDetails.Name)
+			if _, exists := lb.RoutingPolicies[name]; exists {
+				return nil, conflictError("RoutingPolicy", name)
+			}
+			policy, convertErr := convert[loadbalancer.RoutingPolicy](request.CreateRoutingPolicyDetails)
+			if convertErr != nil {
+				return nil, convertErr
+			}
+			return func() { lb.RoutingPolicies[name] = policy }, nil
+		})
+	return loadbalancer.CreateRoutingPolicyResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+func (c *FakeLoadBalancerClient) UpdateRoutingPolicy(
+	_ context.Context,
+	request loadbalancer.UpdateRoutingPolicyRequest,
+) (loadbalancer.UpdateRoutingPolicyResponse, error) {
+	workRequestID, err := c.mutate("UpdateRoutingPolicy", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.RoutingPolicyName)
+			if _, exists := lb.RoutingPolicies[name]; !exists {
+				return nil, notFoundError("RoutingPolicy", name)
+			}
+			policy, convertErr := convert[loadbalancer.RoutingPolicy](request.UpdateRoutingPolicyDetails)
+			if convertErr != nil {
+				return nil, convertErr
+			}
+			policy.Name = &name
+			return func() { lb.RoutingPolicies[name] = policy }, nil
+		})
+	return loadbalancer.UpdateRoutingPolicyResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+func (c *FakeLoadBalancerClient) DeleteRoutingPolicy(
+	_ context.Context,
+	request loadbalancer.DeleteRoutingPolicyRequest,
+) (loadbalancer.DeleteRoutingPolicyResponse, error) {
+	workRequestID, err := c.mutate("DeleteRoutingPolicy", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.RoutingPolicyName)
+			if _, exists := lb.RoutingPolicies[name]; !exists {
+				return nil, notFoundError("RoutingPolicy", name)
+			}
+			for listenerName, listener := range lb.Listeners {
+				if lo.FromPtr(listener.RoutingPolicyName) == name {
+					return nil, invalidParameterError(
+						fmt.Sprintf("Routing policy %s is used in listener %s", name, listenerName),
+					)
+				}
+			}
+			return func() { delete(lb.RoutingPolicies, name) }, nil
+		})
+	return loadbalancer.DeleteRoutingPolicyResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+func (c *FakeLoadBalancerClient) CreateCertificate(
+	_ context.Context,
+	request loadbalancer.CreateCertificateRequest,
+) (loadbalancer.CreateCertificateResponse, error) {
+	workRequestID, err := c.mutate("CreateCertificate", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.CreateCertificateDetails.CertificateName)
+			if _, exists := lb.Certificates[name]; exists {
+				return nil, conflictError("Certificate", name)
+			}
+			certificate := loadbalancer.Certificate{
+				CertificateName:   &name,
+				PublicCertificate: request.CreateCertificateDetails.PublicCertificate,
+				CaCertificate:     request.CreateCertificateDetails.CaCertificate,
+			}
+			return func() { lb.Certificates[name] = certificate }, nil
+		})
+	return loadbalancer.CreateCertificateResponse{OpcWorkRequestId: workRequestID}, err
+}
+
+func (c *FakeLoadBalancerClient) DeleteCertificate(
+	_ context.Context,
+	request loadbalancer.DeleteCertificateRequest,
+) (loadbalancer.DeleteCertificateResponse, error) {
+	workRequestID, err := c.mutate("DeleteCertificate", request.LoadBalancerId,
+		func(lb *loadbalancer.LoadBalancer) (func(), error) {
+			name := lo.FromPtr(request.CertificateName)
+			if _, exists := lb.Certificates[name]; !exists {
+				return nil, notFoundError("Certificate", name)
+			}
+			for listenerName, listener := range lb.Listeners {
+				if listener.SslConfiguration != nil && lo.FromPtr(listener.SslConfiguration.CertificateName) == name {
+					return nil, invalidParameterError(
+						fmt.Sprintf("Certificate %s is used in listener %s", name, listenerName),
+					)
+				}
+			}
+			return func() { delete(lb.Certificates, name) }, nil
+		})
+	return loadbalancer.DeleteCertificateResponse{OpcWorkRequestId: workRequestID}, err
+}
@@ -0,0 +1,235 @@
+package testsupport
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeLoadBalancerClient(t *testing.T) {
+	fake := faker.New()
+
+	createBackendSet := func(
+		t *testing.T,
+		client *FakeLoadBalancerClient,
+		loadBalancerID string,
+	) loadbalancer.CreateBackendSetDetails {
+		details := loadbalancer.CreateBackendSetDetails{
+			Name:   new("bs-" + fake.Lorem().Word()),
+			Policy: new("ROUND_ROBIN"),
+			HealthChecker: &loadbalancer.HealthCheckerDetails{
+				Protocol: new("HTTP"),
+				Port:     new(fake.IntBetween(1, 65535)),
+			},
+			Backends: []loadbalancer.BackendDetails{
+				{IpAddress: new(fake.Internet().Ipv4()), Port: new(fake.IntBetween(1, 65535))},
+			},
+		}
+		_, err := client.CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
+			LoadBalancerId:          &loadBalancerID,
+			CreateBackendSetDetails: details,
+		})
+		require.NoError(t, err)
+		return details
+	}
+
+	t.Run("should create and get backend set", func(t *testing.T) {
+		client := NewFakeLoadBalancerClient()
+		loadBalancerID := client.AddLoadBalancer(loadbalancer.LoadBalancer{})
+		details := createBackendSet(t, client, loadBalancerID)
+
+		got, err := client.GetBackendSet(t.Context(), loadbalancer.GetBackendSetRequest{
+			LoadBalancerId: &loadBalancerID,
+			BackendSetName: details.Name,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, *details.Name, *got.Name)
+		assert.Equal(t, *details.Policy, *got.Policy)
+		require.Len(t, got.Backends, 1)
+		assert.Equal(t, *details.Backends[0].IpAddress, *got.Backends[0].IpAddress)
+		assert.Equal(t, backendName(details.Backends[0].IpAddress, details.Backends[0].Port), *got.Backends[0].Name)
+
+		lb, err := client.GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+			LoadBalancerId: &loadBalancerID,
+		})
+		require.NoError(t, err)
+		assert.Contains(t, lb.BackendSets, *details.Name)
+	})
+
+	t.Run("should apply changes when work request succeeds", func(t *testing.T) {
+		client := NewFakeLoadBalancerClient(FakeLoadBalancerClientWithWorkRequestPolls(2))
+		loadBalancerID := client.AddLoadBalancer(loadbalancer.LoadBalancer{})
+		details := loadbalancer.CreateBackendSetDetails{
+			Name:   new("bs-" + fake.Lorem().Word()),
+			Policy: new("ROUND_ROBIN"),
+		}
+		response, err := client.CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
+			LoadBalancerId:          &loadBalancerID,
+			CreateBackendSetDetails: details,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, response.OpcWorkRequestId)
+
+		getBackendSet := func() error {
+			_, getErr := client.GetBackendSet(t.Context(), loadbalancer.GetBackendSetRequest{
+				LoadBalancerId: &loadBalancerID,
+				BackendSetName: details.Name,
+			})
+			return getErr
+		}
+		getWorkRequestState := func() loadbalancer.WorkRequestLifecycleStateEnum {
+			workRequest, getErr := client.GetWorkRequest(t.Context(), loadbalancer.GetWorkRequestRequest{
+				WorkRequestId: response.OpcWorkRequestId,
+			})
+			require.NoError(t, getErr)
+			return workRequest.LifecycleState
+		}
+
+		require.Error(t, getBackendSet())
+		assert.Equal(t, loadbalancer.WorkRequestLifecycleStateInProgress, getWorkRequestState())
+		assert.Equal(t, loadbalancer.WorkRequestLifecycleStateInProgress, getWorkRequestState())
+		require.Error(t, getBackendSet())
+		assert.Equal(t, loadbalancer.WorkRequestLifecycleStateSucceeded, getWorkRequestState())
+		require.NoError(t, getBackendSet())
+	})
+
+	t.Run("should not apply changes of failed work request", func(t *testing.T) {
+		client := NewFakeLoadBalancerClient()
+		loadBalancerID := client.AddLoadBalancer(loadbalancer.LoadBalancer{})
+		client.FailNextWorkRequest("CreateRuleSet")
+
+		name := "rs-" + fake.Lorem().Word()
+		response, err := client.CreateRuleSet(t.Context(), loadbalancer.CreateRuleSetRequest{
+			LoadBalancerId:       &loadBalancerID,
+			CreateRuleSetDetails: loadbalancer.CreateRuleSetDetails{Name: &name},
+		})
+		require.NoError(t, err)
+
+		workRequest, err := client.GetWorkRequest(t.Context(), loadbalancer.GetWorkRequestRequest{
+			WorkRequestId: response.OpcWorkRequestId,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, loadbalancer.WorkRequestLifecycleStateFailed, workRequest.LifecycleState)
+
+		_, err = client.GetRuleSet(t.Context(), loadbalancer.GetRuleSetRequest{
+			LoadBalancerId: &loadBalancerID,
+			RuleSetName:    &name,
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("should return configured operation error once", func(t *testing.T) {
+		client := NewFakeLoadBalancerClient()
+		loadBalancerID := client.AddLoadBalancer(loadbalancer.LoadBalancer{})
+		wantErr := errors.New(fake.Lorem().Sentence(3))
+		client.FailNext("GetLoadBalancer", wantErr)
+
+		request := loadbalancer.GetLoadBalancerRequest{LoadBalancerId: &loadBalancerID}
+		_, err := client.GetLoadBalancer(t.Context(), request)
+		require.ErrorIs(t, err, wantErr)
+		_, err = client.GetLoadBalancer(t.Context(), request)
+		require.NoError(t, err)
+	})
+
+	t.Run("should return service errors", func(t *testing.T) {
+		client := NewFakeLoadBalancerClient()
+		loadBalancerID := client.AddLoadBalancer(loadbalancer.LoadBalancer{})
+
+		_, err := client.GetLoadBalancer(t.Context(), loadbalancer.GetLoadBalancerRequest{
+			LoadBalancerId: new(fake.UUID().V4()),
+		})
+		serviceErr, ok := common.IsServiceError(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, serviceErr.GetHTTPStatusCode())
+
+		details := createBackendSet(t, client, loadBalancerID)
+		_, err = client.CreateBackendSet(t.Context(), loadbalancer.CreateBackendSetRequest{
+			LoadBalancerId:          &loadBalancerID,
+			CreateBackendSetDetails: details,
+		})
+		serviceErr, ok = common.IsServiceError(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusConflict, serviceErr.GetHTTPStatusCode())
+	})
+
+	t.Run("should reject listener referencing missing resources", func(t *testing.T) {
+		client := NewFakeLoadBalancerClient()
+		loadBalancerID := client.AddLoadBalancer(loadbalancer.LoadBalancer{})
+
+		_, err := client.CreateListener(t.Context(), loadbalancer.CreateListenerRequest{
+			LoadBalancerId: &loadBalancerID,
+			CreateListenerDetails: loadbalancer.CreateListenerDetails{
+				Name:                  new("listener-" + fake.Lorem().Word()),
+				DefaultBackendSetName: new("missing-" + fake.Lorem().Word()),
+				Port:                  new(fake.IntBetween(1, 65535)),
+				Protocol:              new("HTTP"),
+			},
+		})
+		serviceErr, ok := common.IsServiceError(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, serviceErr.GetHTTPStatusCode())
+	})
+
+	t.Run("should reject deleting backend set used by listener", func(t *testing.T) {
+		client := NewFakeLoadBalancerClient()
+		loadBalancerID := client.AddLoadBalancer(loadbalancer.LoadBalancer{})
+		details := createBackendSet(t, client, loadBalancerID)
+		listenerName := "listener-" + fake.Lorem().Word()
+		_, err := client.CreateListener(t.Context(), loadbalancer.CreateListenerRequest{
+			LoadBalancerId: &loadBalancerID,
+			CreateListenerDetails: loadbalancer.CreateListenerDetails{
+				Name:                  &listenerName,
+				DefaultBackendSetName: details.Name,
+				Port:                  new(fake.IntBetween(1, 65535)),
+				Protocol:              new("HTTP"),
+			},
+		})
+		require.NoError(t, err)
+
+		deleteRequest := loadbalancer.DeleteBackendSetRequest{
+			LoadBalancerId: &loadBalancerID,
+			BackendSetName: details.Name,
+		}
+		_, err = client.DeleteBackendSet(t.Context(), deleteRequest)
+		require.Error(t, err)
+
+		_, err = client.DeleteListener(t.Context(), loadbalancer.DeleteListenerRequest{
+			LoadBalancerId: &loadBalancerID,
+			ListenerName:   &listenerName,
+		})
+		require.NoError(t, err)
+		_, err = client.DeleteBackendSet(t.Context(), deleteRequest)
+		require.NoError(t, err)
+	})
+
+	t.Run("should add backend to backend set", func(t *testing.T) {
+		client := NewFakeLoadBalancerClient()
+		loadBalancerID := client.AddLoadBalancer(loadbalancer.LoadBalancer{})
+		details := createBackendSet(t, client, loadBalancerID)
+
+		backend := loadbalancer.CreateBackendDetails{
+			IpAddress: new(fake.Internet().Ipv4()),
+			Port:      new(fake.IntBetween(1, 65535)),
+		}
+		_, err := client.CreateBackend(t.Context(), loadbalancer.CreateBackendRequest{
+			LoadBalancerId:       &loadBalancerID,
+			BackendSetName:       details.Name,
+			CreateBackendDetails: backend,
+		})
+		require.NoError(t, err)
+
+		health, err := client.GetBackendHealth(t.Context(), loadbalancer.GetBackendHealthRequest{
+			LoadBalancerId: &loadBalancerID,
+			BackendSetName: details.Name,
+			BackendName:    new(backendName(backend.IpAddress, backend.Port)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, loadbalancer.BackendHealthStatusOk, health.Status)
+	})
+}